@@ -0,0 +1,111 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"io"
+	"sync"
+)
+
+// A ByteAppender is a buffer that lets callers append to its underlying byte
+// slice directly. WriteFloat32 and WriteFloat64 format numbers in place into
+// a ByteAppender rather than going through an intermediate buffer.
+type ByteAppender interface {
+	// BufferPtr returns a pointer to the buffer's contents. Appending to
+	// the slice and storing the result through the pointer extends the
+	// buffer.
+	BufferPtr() *[]byte
+}
+
+// ByteSlice adapts a byte slice to ByteAppender and io.Writer. Buffers that
+// expose their contents as a []byte field, such as bytebufferpool.ByteBuffer,
+// can be adapted without copying with Appender(&buf.B).
+type ByteSlice []byte
+
+// BufferPtr implements ByteAppender.
+func (s *ByteSlice) BufferPtr() *[]byte { return (*[]byte)(s) }
+
+// Write appends p to s. It always returns len(p), nil.
+func (s *ByteSlice) Write(p []byte) (n int, err error) {
+	*s = append(*s, p...)
+	return len(p), nil
+}
+
+// Appender returns a *ByteSlice that appends to *p.
+func Appender(p *[]byte) *ByteSlice { return (*ByteSlice)(p) }
+
+// WriteFloat64 writes the string form of f, as generated by FormatFloat64,
+// to w. If w is a ByteAppender, the number is formatted directly into its
+// buffer; otherwise WriteFloat64 formats into a pooled buffer and calls
+// w.Write once, which suits writers such as a fasthttp response body writer.
+// Neither way allocates once the buffers have grown.
+func WriteFloat64(w io.Writer, f float64) (n int, err error) {
+	return writeFloat(w, nil, f, 64)
+}
+
+// WriteFloat32 is like WriteFloat64 for the 32-bit floating point number f.
+func WriteFloat32(w io.Writer, f float32) (n int, err error) {
+	return writeFloat(w, nil, float64(f), 32)
+}
+
+// WriteFloat64 writes the string form of x, as generated by f.FormatFloat64,
+// to w, in the manner described for the top-level WriteFloat64.
+func (f *Formatter) WriteFloat64(w io.Writer, x float64) (n int, err error) {
+	return writeFloat(w, f, x, 64)
+}
+
+// WriteFloat32 writes the string form of x, as generated by f.FormatFloat32,
+// to w, in the manner described for the top-level WriteFloat64.
+func (f *Formatter) WriteFloat32(w io.Writer, x float32) (n int, err error) {
+	return writeFloat(w, f, float64(x), 32)
+}
+
+// writeFloat implements the Write functions. A nil f means the top-level
+// formatting functions. For bitSize 32, x holds a float32 exactly.
+func writeFloat(w io.Writer, f *Formatter, x float64, bitSize int) (n int, err error) {
+	if a, ok := w.(ByteAppender); ok {
+		p := a.BufferPtr()
+		start := len(*p)
+		*p = appendFloat(*p, f, x, bitSize)
+		return len(*p) - start, nil
+	}
+	p := writeBufPool.Get().(*[]byte)
+	b := appendFloat((*p)[:0], f, x, bitSize)
+	n, err = w.Write(b)
+	*p = b
+	writeBufPool.Put(p)
+	return n, err
+}
+
+// writeBufPool holds the buffers that writeFloat formats into for writers
+// that are not ByteAppenders. A buffer on the stack would escape through
+// w.Write.
+var writeBufPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, 32)
+		return &b
+	},
+}
+
+func appendFloat(b []byte, f *Formatter, x float64, bitSize int) []byte {
+	switch {
+	case f == nil && bitSize == 32:
+		return AppendFloat32(b, float32(x))
+	case f == nil:
+		return AppendFloat64(b, x)
+	case bitSize == 32:
+		return f.AppendFloat32(b, float32(x))
+	default:
+		return f.AppendFloat64(b, x)
+	}
+}
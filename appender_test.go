@@ -0,0 +1,95 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+// fakeByteBuffer mimics buffers like bytebufferpool.ByteBuffer.
+type fakeByteBuffer struct {
+	B []byte
+}
+
+func (b *fakeByteBuffer) Write(p []byte) (int, error) {
+	b.B = append(b.B, p...)
+	return len(p), nil
+}
+
+func (b *fakeByteBuffer) BufferPtr() *[]byte { return &b.B }
+
+func TestWriteFloat(t *testing.T) {
+	var fb fakeByteBuffer
+	var buf bytes.Buffer
+	s := ByteSlice("x=")
+	for _, w := range []interface {
+		Write([]byte) (int, error)
+	}{&fb, &buf, writerFunc(func(p []byte) (int, error) { s = append(s, p...); return len(p), nil })} {
+		n, err := WriteFloat64(w, 1.5)
+		if n != 7 || err != nil {
+			t.Errorf("WriteFloat64(%T): got (%d, %v); want (7, nil)", w, n, err)
+		}
+		if _, err := WriteFloat32(w, 0.1); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := (&Formatter{Annotate: true}).WriteFloat64(w, 2); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := (&Formatter{}).WriteFloat32(w, 3); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := "1.5e+001e-012e+00 (normal)3e+00"
+	if got := string(fb.B); got != want {
+		t.Errorf("ByteAppender: got %q; want %q", got, want)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("bytes.Buffer: got %q; want %q", got, want)
+	}
+	if got := string(s); got != "x="+want {
+		t.Errorf("io.Writer: got %q; want %q", got, "x="+want)
+	}
+}
+
+func TestWriteFloatAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector drops pooled buffers")
+	}
+	f := &Formatter{Width: 12}
+	if n := testing.AllocsPerRun(100, func() {
+		WriteFloat64(ioutil.Discard, 1.5)
+		WriteFloat32(ioutil.Discard, 0.1)
+		f.WriteFloat64(ioutil.Discard, -2.25)
+	}); n != 0 {
+		t.Errorf("got %v allocs; want 0", n)
+	}
+}
+
+func TestAppender(t *testing.T) {
+	b := []byte("n=")
+	if _, err := WriteFloat64(Appender(&b), 100); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Appender(&b).Write([]byte(" ok")); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), "n=1e+02 ok"; got != want {
+		t.Errorf("got %q; want %q", got, want)
+	}
+}
+
+type writerFunc func([]byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) { return f(p) }
@@ -0,0 +1,267 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// A Backend computes the shortest decimal form of floating-point numbers: the
+// first stage of conversion. Its methods must return the same results as
+// Decimal32 and Decimal64.
+//
+// Backends let a Formatter switch conversion algorithms at runtime, for
+// instance to compare a new implementation against an established one on
+// production traffic.
+type Backend interface {
+	Decimal32(f float32) FloatDecimal
+	Decimal64(f float64) FloatDecimal
+}
+
+var (
+	backendsMu sync.RWMutex
+	backends   = map[string]Backend{
+		"ryu":              ryuBackend{},
+		"strconv":          strconvBackend{},
+		"reference":        referenceBackend{},
+		"strconv-fallback": fallbackBackend{},
+	}
+)
+
+// RegisterBackend makes a backend available by the provided name.
+// These backends are registered by default:
+//
+//	ryu               this package's implementation
+//	strconv           digits computed by strconv.AppendFloat
+//	dragonbox         Junekey Jeon's Dragonbox algorithm (not in ryu_compact builds)
+//	reference         exact arithmetic with math/big, slowly
+//	strconv-fallback  ryu, falling back to strconv for any result that does
+//	                  not parse back to the number
+//
+// If RegisterBackend is called twice with the same name or if b is nil, it
+// panics.
+func RegisterBackend(name string, b Backend) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	if b == nil {
+		panic("ryu: RegisterBackend backend is nil")
+	}
+	if _, dup := backends[name]; dup {
+		panic("ryu: RegisterBackend called twice for backend " + name)
+	}
+	backends[name] = b
+}
+
+// LookupBackend returns the backend registered with the given name.
+func LookupBackend(name string) (b Backend, ok bool) {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	b, ok = backends[name]
+	return b, ok
+}
+
+// Backends returns a sorted list of the names of the registered backends.
+func Backends() []string {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type ryuBackend struct{}
+
+func (ryuBackend) Decimal32(f float32) FloatDecimal { return Decimal32(f) }
+func (ryuBackend) Decimal64(f float64) FloatDecimal { return Decimal64(f) }
+
+type strconvBackend struct{}
+
+func (strconvBackend) Decimal32(f float32) FloatDecimal {
+	d := decodeBits32(math.Float32bits(f))
+	if d.Class == ClassNormal || d.Class == ClassSubnormal {
+		var buf [32]byte
+		d.Digits, d.Exp = parseShortestE(strconv.AppendFloat(buf[:0], float64(f), 'e', -1, 32))
+	}
+	return d
+}
+
+func (strconvBackend) Decimal64(f float64) FloatDecimal {
+	d := decodeBits64(math.Float64bits(f))
+	if d.Class == ClassNormal || d.Class == ClassSubnormal {
+		var buf [32]byte
+		d.Digits, d.Exp = parseShortestE(strconv.AppendFloat(buf[:0], f, 'e', -1, 64))
+	}
+	return d
+}
+
+// referenceBackend computes the shortest decimals from their definition,
+// with exact arithmetic, as a slow but simple reference for the others.
+type referenceBackend struct{}
+
+func (referenceBackend) Decimal32(f float32) FloatDecimal {
+	u := math.Float32bits(f)
+	d := decodeBits32(u)
+	if d.Class == ClassNormal || d.Class == ClassSubnormal {
+		d.Digits, d.Exp = referenceShortest(uint64(u), &float32info)
+	}
+	return d
+}
+
+func (referenceBackend) Decimal64(f float64) FloatDecimal {
+	u := math.Float64bits(f)
+	d := decodeBits64(u)
+	if d.Class == ClassNormal || d.Class == ClassSubnormal {
+		d.Digits, d.Exp = referenceShortest(u, &float64info)
+	}
+	return d
+}
+
+// referenceShortest returns the shortest decimal m × 10^e that lies in the
+// rounding interval of the finite nonzero number with bits u in the format
+// described by info, and of those the nearest to it, or the even one of the
+// two nearest. The interval is closed if the mantissa is even.
+func referenceShortest(u uint64, info *floatInfo) (m uint64, e int32) {
+	mant := u & (uint64(1)<<info.mantBits - 1)
+	exp := int((u >> info.mantBits) & (uint64(1)<<info.expBits - 1))
+	e2 := exp - int(info.bias) - int(info.mantBits)
+	if exp == 0 {
+		e2++
+	} else {
+		mant |= uint64(1) << info.mantBits
+	}
+	// In units of 2^(e2-2), the number is 4×mant and the interval runs
+	// halfway to its neighbors, of which the lower is closer for powers
+	// of 2 other than the smallest normal number.
+	v := new(big.Int).SetUint64(4 * mant)
+	hi := new(big.Int).SetUint64(4*mant + 2)
+	lo := new(big.Int).SetUint64(4*mant - 2)
+	if mant == uint64(1)<<info.mantBits && exp > 1 {
+		lo.SetUint64(4*mant - 1)
+	}
+	closed := mant%2 == 0
+
+	// The candidates are c × 10^k for decreasing k, starting from one
+	// above the number.
+	k := int(math.Ceil(float64(e2-2+bits.Len64(4*mant))*math.Log10(2))) + 1
+	for ; ; k-- {
+		// The interval is [lo/den, hi/den] in units of 10^k.
+		den := big.NewInt(1)
+		scale := func(x *big.Int) *big.Int {
+			x = new(big.Int).Set(x)
+			if e2-2 >= 0 {
+				x.Lsh(x, uint(e2-2))
+			} else {
+				den.Lsh(big.NewInt(1), uint(2-e2))
+			}
+			if k < 0 {
+				x.Mul(x, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-k)), nil))
+			}
+			return x
+		}
+		vs, his, los := scale(v), scale(hi), scale(lo)
+		if k > 0 {
+			den.Mul(den, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(k)), nil))
+		}
+		cHi, rem := new(big.Int).QuoRem(his, den, new(big.Int))
+		if rem.Sign() == 0 && !closed {
+			cHi.Sub(cHi, big.NewInt(1))
+		}
+		cLo, rem := new(big.Int).QuoRem(los, den, new(big.Int))
+		if rem.Sign() != 0 || !closed {
+			cLo.Add(cLo, big.NewInt(1))
+		}
+		if cHi.Sign() <= 0 || cLo.Cmp(cHi) > 0 {
+			continue
+		}
+		// Round the number to the nearest multiple of 10^k, to even on
+		// a tie, and then into the interval.
+		c, rem := new(big.Int).QuoRem(vs, den, new(big.Int))
+		switch rem.Lsh(rem, 1).Cmp(den) {
+		case 1:
+			c.Add(c, big.NewInt(1))
+		case 0:
+			c.Add(c, big.NewInt(int64(c.Bit(0))))
+		}
+		if c.Cmp(cLo) < 0 {
+			c = cLo
+		} else if c.Cmp(cHi) > 0 {
+			c = cHi
+		}
+		return c.Uint64(), int32(k)
+	}
+}
+
+// fallbackBackend computes the decimals with Ryu and checks that they parse
+// back to the number, using strconv's instead if they do not. It guards a
+// rollout of Ryu with a correctness check that costs less than strconv.
+type fallbackBackend struct{}
+
+func (fallbackBackend) Decimal32(f float32) FloatDecimal {
+	d := Decimal32(f)
+	if !roundTrips(d, uint64(math.Float32bits(f)), &float32info) {
+		return strconvBackend{}.Decimal32(f)
+	}
+	return d
+}
+
+func (fallbackBackend) Decimal64(f float64) FloatDecimal {
+	d := Decimal64(f)
+	if !roundTrips(d, math.Float64bits(f), &float64info) {
+		return strconvBackend{}.Decimal64(f)
+	}
+	return d
+}
+
+// roundTrips reports whether d, if finite and nonzero, parses back to the
+// number with bits u in the format described by info.
+func roundTrips(d FloatDecimal, u uint64, info *floatInfo) bool {
+	if d.Class != ClassNormal && d.Class != ClassSubnormal {
+		return true
+	}
+	p := parsedDecimal{neg: d.Neg, m10: d.Digits, e10: d.Exp, digits: int32(decimalLen64(d.Digits))}
+	return p.toBits(info, ToNearestEven) == u
+}
+
+// parseShortestE parses the output of strconv.AppendFloat(b, f, 'e', -1, n)
+// for a finite nonzero f.
+func parseShortestE(b []byte) (digits uint64, exp int32) {
+	i := 0
+	if b[i] == '-' {
+		i++
+	}
+	for ; b[i] != 'e'; i++ {
+		if b[i] == '.' {
+			continue
+		}
+		digits = digits*10 + uint64(b[i]-'0')
+		exp--
+	}
+	exp++ // the first digit is before the '.'
+	neg := b[i+1] == '-'
+	var e int32
+	for _, c := range b[i+2:] {
+		e = e*10 + int32(c-'0')
+	}
+	if neg {
+		e = -e
+	}
+	return digits, exp + e
+}
@@ -0,0 +1,131 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "math"
+
+// AppendFloat64s appends the string forms of the numbers in fs, as generated
+// by FormatFloat64 and separated by sep, to b and returns the extended buffer.
+func AppendFloat64s(b []byte, fs []float64, sep string) []byte {
+	for i, f := range fs {
+		if i > 0 {
+			b = append(b, sep...)
+		}
+		b = AppendFloat64(b, f)
+	}
+	return b
+}
+
+// AppendFloat64sFunc is like AppendFloat64s but formats each number with the
+// Formatter returned by choose, which is called with the index and value of
+// each element. A nil Formatter means the default formatting of
+// FormatFloat64.
+//
+// AppendFloat64sFunc lets a single pass format values that need different
+// treatment, such as a row of coordinates followed by confidences.
+func AppendFloat64sFunc(b []byte, fs []float64, sep string, choose func(i int, f float64) *Formatter) []byte {
+	for i, f := range fs {
+		if i > 0 {
+			b = append(b, sep...)
+		}
+		if fm := choose(i, f); fm != nil {
+			b = fm.AppendFloat64(b, f)
+		} else {
+			b = AppendFloat64(b, f)
+		}
+	}
+	return b
+}
+
+// AppendFloat32s appends the string forms of the numbers in fs, as generated
+// by FormatFloat32 and separated by sep, to b and returns the extended buffer.
+func AppendFloat32s(b []byte, fs []float32, sep string) []byte {
+	for i, f := range fs {
+		if i > 0 {
+			b = append(b, sep...)
+		}
+		b = AppendFloat32(b, f)
+	}
+	return b
+}
+
+// AppendFloat32sFunc is like AppendFloat32s but formats each number with the
+// Formatter returned by choose, as described for AppendFloat64sFunc.
+func AppendFloat32sFunc(b []byte, fs []float32, sep string, choose func(i int, f float32) *Formatter) []byte {
+	for i, f := range fs {
+		if i > 0 {
+			b = append(b, sep...)
+		}
+		if fm := choose(i, f); fm != nil {
+			b = fm.AppendFloat32(b, f)
+		} else {
+			b = AppendFloat32(b, f)
+		}
+	}
+	return b
+}
+
+// ParseFloats64 parses each of fields as by ParseFloat64 and stores the
+// results in dst, which must be at least as long as fields. It returns the
+// number of fields parsed. If a field cannot be parsed, ParseFloats64 stops
+// and returns its index and the error, a *strconv.NumError, that
+// ParseFloat64 would return; as with ParseFloat64, an out-of-range field is
+// still stored in dst as ±Inf.
+//
+// The fields take the same single-pass path as ParseFloat64, and need not be
+// converted to strings first.
+func ParseFloats64(dst []float64, fields [][]byte) (int, error) {
+	return stdParser.ParseFloats64(dst, fields)
+}
+
+// ParseFloats32 is like ParseFloats64 but parses float32s as by
+// ParseFloat32.
+func ParseFloats32(dst []float32, fields [][]byte) (int, error) {
+	return stdParser.ParseFloats32(dst, fields)
+}
+
+// ParseFloats64 is like the top-level ParseFloats64.
+func (p *Parser) ParseFloats64(dst []float64, fields [][]byte) (int, error) {
+	dst = dst[:len(fields)]
+	for i, field := range fields {
+		u, err := p.parseField(bytesString(field), &float64info)
+		dst[i] = math.Float64frombits(u)
+		if err != nil {
+			return i, err
+		}
+	}
+	return len(fields), nil
+}
+
+// ParseFloats32 is like the top-level ParseFloats32.
+func (p *Parser) ParseFloats32(dst []float32, fields [][]byte) (int, error) {
+	dst = dst[:len(fields)]
+	for i, field := range fields {
+		u, err := p.parseField(bytesString(field), &float32info)
+		dst[i] = math.Float32frombits(uint32(u))
+		if err != nil {
+			return i, err
+		}
+	}
+	return len(fields), nil
+}
+
+// parseField parses all of s. The top-level functions take the single-pass
+// path of ParseFloat64.
+func (p *Parser) parseField(s string, info *floatInfo) (uint64, error) {
+	if p == &stdParser {
+		return parseStd(s, info)
+	}
+	u, _, err := p.parseFloat(s, info, false, nil)
+	return u, err
+}
@@ -0,0 +1,116 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestAppendFloat64s(t *testing.T) {
+	fs := []float64{1.5, -2, math.Inf(1)}
+	if got, want := string(AppendFloat64s([]byte("x="), fs, ",")), "x=1.5e+00,-2e+00,+Inf"; got != want {
+		t.Errorf("AppendFloat64s: got %q; want %q", got, want)
+	}
+	if got := string(AppendFloat64s(nil, nil, ",")); got != "" {
+		t.Errorf("AppendFloat64s(nil): got %q; want empty", got)
+	}
+	if got, want := string(AppendFloat32s(nil, []float32{0.1, 3}, " ")), "1e-01 3e+00"; got != want {
+		t.Errorf("AppendFloat32s: got %q; want %q", got, want)
+	}
+}
+
+func TestAppendFloat64sFunc(t *testing.T) {
+	annotate := &Formatter{Annotate: true}
+	choose := func(i int, f float64) *Formatter {
+		if i%2 == 1 {
+			return annotate
+		}
+		return nil
+	}
+	fs := []float64{1, 2, 3, math.NaN()}
+	want := "1e+00 2e+00 (normal) 3e+00 NaN (quiet, payload 0x1)"
+	if got := string(AppendFloat64sFunc(nil, fs, " ", choose)); got != want {
+		t.Errorf("AppendFloat64sFunc: got %q; want %q", got, want)
+	}
+	choose32 := func(i int, f float32) *Formatter {
+		if f < 0 {
+			return annotate
+		}
+		return nil
+	}
+	want = "1e+00,-1e+00 (normal)"
+	if got := string(AppendFloat32sFunc(nil, []float32{1, -1}, ",", choose32)); got != want {
+		t.Errorf("AppendFloat32sFunc: got %q; want %q", got, want)
+	}
+}
+
+func TestParseFloats64(t *testing.T) {
+	fields := [][]byte{[]byte("1.5"), []byte("-2e3"), []byte("0x1p-2"), []byte("1e400"), []byte("7")}
+	dst := make([]float64, len(fields))
+	n, err := ParseFloats64(dst, fields[:3])
+	if n != 3 || err != nil {
+		t.Fatalf("ParseFloats64: got (%d, %v); want (3, nil)", n, err)
+	}
+	if want := []float64{1.5, -2e3, 0.25, 0, 0}; !reflect.DeepEqual(dst, want) {
+		t.Errorf("ParseFloats64: got %v; want %v", dst, want)
+	}
+	n, err = ParseFloats64(dst, fields)
+	if n != 3 || numErr(err) != strconv.ErrRange || !math.IsInf(dst[3], 1) || dst[4] != 0 {
+		t.Errorf("ParseFloats64: got (%d, %v) and %v; want range error at index 3", n, err, dst)
+	}
+
+	dst32 := make([]float32, 3)
+	n, err = ParseFloats32(dst32, [][]byte{[]byte("0.1"), []byte("x"), []byte("1")})
+	if n != 1 || numErr(err) != strconv.ErrSyntax || dst32[0] != 0.1 {
+		t.Errorf("ParseFloats32: got (%d, %v) and %v; want syntax error at index 1", n, err, dst32)
+	}
+
+	p := Parser{Underscores: true}
+	n, err = p.ParseFloats64(dst, [][]byte{[]byte("1_0")})
+	if n != 1 || err != nil || dst[0] != 10 {
+		t.Errorf("Parser.ParseFloats64: got (%d, %v) and %g; want (1, nil) and 10", n, err, dst[0])
+	}
+}
+
+var benchFields = func() [][]byte {
+	fields := make([][]byte, 1000)
+	for i := range fields {
+		fields[i] = strconv.AppendFloat(nil, float64(i)*1.2345, 'g', -1, 64)
+	}
+	return fields
+}()
+
+func BenchmarkParseFloats64(b *testing.B) {
+	dst := make([]float64, len(benchFields))
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseFloats64(dst, benchFields); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseFloat64Loop(b *testing.B) {
+	dst := make([]float64, len(benchFields))
+	for i := 0; i < b.N; i++ {
+		for j, field := range benchFields {
+			f, err := ParseFloat64(string(field))
+			if err != nil {
+				b.Fatal(err)
+			}
+			dst[j] = f
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// FormatBigFloat converts x to the shortest decimal string that rounds back
+// to x at x's precision, rounding to nearest with ties to even, in the format
+// of FormatFloat64, as in "1e-01" for big.NewFloat(0.1). The exponent has as
+// many digits as it needs. Zeros and infinities are printed as by
+// FormatFloat64. Unlike x.Text('e', -1), it accounts for the closer lower
+// neighbor of a power of 2: with 2 bits of precision, 2^-13 is "1.2e-04",
+// since 1e-04 rounds to the number below it.
+//
+// This is Ryu generalized to any precision: the interval of decimals that
+// round to x is computed with exact integer arithmetic in place of the
+// tables, so the time taken grows with the precision of x and with the
+// magnitude of its exponent.
+func FormatBigFloat(x *big.Float) string {
+	b := make([]byte, 0, 24)
+	return unsafeString(AppendBigFloat(b, x))
+}
+
+// AppendBigFloat appends the string form of x, as generated by
+// FormatBigFloat, to b and returns the extended buffer.
+func AppendBigFloat(b []byte, x *big.Float) []byte {
+	neg := x.Signbit()
+	switch {
+	case x.IsInf():
+		return appendSpecial(b, neg, false, true)
+	case x.Sign() == 0:
+		return appendSpecial(b, neg, true, true)
+	}
+	// x is m2 × 2^e2 with m2 in [2^(prec-1), 2^prec), and as in
+	// float64ToDecimal, e2 has 2 extra bits for the bounds. With no
+	// subnormals, the lower bound is only closer for the smallest m2.
+	prec := int(x.Prec())
+	mant := new(big.Float)
+	exp := x.MantExp(mant)
+	m2, _ := mant.SetMantExp(mant, prec).Int(nil)
+	m2.Abs(m2)
+	mmShift := int64(1)
+	if m2.BitLen() == prec && m2.TrailingZeroBits() == uint(prec-1) {
+		mmShift = 0
+	}
+	out, e10 := toDecimalBig(m2, exp-prec-2, mmShift)
+
+	if neg {
+		b = append(b, '-')
+	}
+	start := len(b)
+	b = out.Append(b, 10)
+	nd := len(b) - start
+	if nd > 1 {
+		b = insertByte(b, start+1, '.')
+	}
+	// Print at least two exponent digits, as FormatFloat64 does.
+	b = append(b, 'e')
+	e := e10 + nd - 1
+	if e < 0 {
+		b = append(b, '-')
+		e = -e
+	} else {
+		b = append(b, '+')
+	}
+	if e < 10 {
+		b = append(b, '0')
+	}
+	return strconv.AppendInt(b, int64(e), 10)
+}
+
+// Lower bounds on log_10(2) and log_10(5), for estimating q below. They are
+// low enough that float64 rounding cannot make an estimate exceed the true
+// logarithm, at the cost of an extra digit or two for the largest exponents.
+const (
+	log10Of2 = 0.301029995
+	log10Of5 = 0.698970004
+)
+
+// toDecimalBig runs steps 2-4 of Ryu on m2 × 2^e2 like toDecimal64, but with
+// exact arithmetic. Step 3 chooses q as Ryu does, though only estimating
+// floor(log_10(2^e2)) or floor(log_10(5^-e2)), so that the scaled interval is
+// at least 15 units wide and at least one digit is removed in step 4 whenever
+// vr is inexact. It returns the shortest decimal out × 10^e10 in the interval.
+func toDecimalBig(m2 *big.Int, e2 int, mmShift int64) (out *big.Int, e10 int) {
+	acceptBounds := m2.Bit(0) == 0
+
+	// Step 2: Determine the interval of valid decimal representations.
+	mv := new(big.Int).Lsh(m2, 2)
+	mp := new(big.Int).Add(mv, big.NewInt(2))
+	mm := new(big.Int).Sub(mv, big.NewInt(1+mmShift))
+
+	// Step 3: Convert to a decimal power base. Each of vr, vp, and vm is
+	// floor(m × mul / den) for its m.
+	var mul, den *big.Int
+	if e2 >= 0 {
+		q := int(float64(e2)*log10Of2) - 1
+		if q < 0 {
+			q = 0
+		}
+		e10 = q
+		mul = new(big.Int).Lsh(big.NewInt(1), uint(e2))
+		den = pow10Big(q)
+	} else {
+		q := int(float64(-e2)*log10Of5) - 1
+		if q < 0 {
+			q = 0
+		}
+		e10 = q + e2
+		mul = new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(-e2-q)), nil)
+		den = new(big.Int).Lsh(big.NewInt(1), uint(q))
+	}
+	scale := func(m *big.Int) (v *big.Int, exact bool) {
+		v, r := new(big.Int).QuoRem(new(big.Int).Mul(m, mul), den, new(big.Int))
+		return v, r.Sign() == 0
+	}
+	vr, vrIsTrailingZeros := scale(mv)
+	vp, vpIsTrailingZeros := scale(mp)
+	vm, vmIsTrailingZeros := scale(mm)
+	vmIsTrailingZeros = vmIsTrailingZeros && acceptBounds
+	if vpIsTrailingZeros && !acceptBounds {
+		vp.Sub(vp, big.NewInt(1))
+	}
+
+	// Step 4: Find the shortest decimal representation
+	// in the interval of valid representations.
+	ten := big.NewInt(10)
+	var (
+		removed          int
+		lastRemovedDigit int64
+		vpDiv10          = new(big.Int)
+		vmDiv10          = new(big.Int)
+		rem              = new(big.Int)
+	)
+	for {
+		vpDiv10.Quo(vp, ten)
+		vmDiv10.QuoRem(vm, ten, rem)
+		if vpDiv10.Cmp(vmDiv10) <= 0 {
+			break
+		}
+		vmIsTrailingZeros = vmIsTrailingZeros && rem.Sign() == 0
+		vrIsTrailingZeros = vrIsTrailingZeros && lastRemovedDigit == 0
+		vr.QuoRem(vr, ten, rem)
+		lastRemovedDigit = rem.Int64()
+		vp, vpDiv10 = vpDiv10, vp
+		vm, vmDiv10 = vmDiv10, vm
+		removed++
+	}
+	if vmIsTrailingZeros {
+		for {
+			vmDiv10.QuoRem(vm, ten, rem)
+			if rem.Sign() != 0 {
+				break
+			}
+			vrIsTrailingZeros = vrIsTrailingZeros && lastRemovedDigit == 0
+			vr.QuoRem(vr, ten, rem)
+			lastRemovedDigit = rem.Int64()
+			vp.Quo(vp, ten)
+			vm, vmDiv10 = vmDiv10, vm
+			removed++
+		}
+	}
+	if vrIsTrailingZeros && lastRemovedDigit == 5 && vr.Bit(0) == 0 {
+		// Round even if the exact number is .....50..0.
+		lastRemovedDigit = 4
+	}
+	// We need to take vr + 1 if vr is outside bounds
+	// or we need to round up.
+	if (vr.Cmp(vm) == 0 && (!acceptBounds || !vmIsTrailingZeros)) || lastRemovedDigit >= 5 {
+		vr.Add(vr, big.NewInt(1))
+	}
+	return vr, e10 + removed
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestFormatBigFloat(t *testing.T) {
+	pow2 := func(prec uint, e int) *big.Float {
+		x := new(big.Float).SetPrec(prec).SetInt64(1)
+		return x.SetMantExp(x, e)
+	}
+	for _, tt := range []struct {
+		x    *big.Float
+		want string
+	}{
+		{new(big.Float), "0e+00"},
+		{new(big.Float).Neg(new(big.Float)), "-0e+00"},
+		{big.NewFloat(0.1), "1e-01"},
+		{big.NewFloat(-2), "-2e+00"},
+		{big.NewFloat(math.MaxFloat64), "1.7976931348623157e+308"},
+		// Without subnormals, this has all 53 bits of precision.
+		{big.NewFloat(5e-324), "4.9406564584124654e-324"},
+		// 1000 rounds to 1024 with 1 bit, and everything from 768 to 1536
+		// rounds back to it.
+		{new(big.Float).SetPrec(1).SetInt64(1000), "1e+03"},
+		// 1e-04 is below the midpoint with the number below 2^-13, which
+		// is only half as far away as the one above.
+		{pow2(2, -13), "1.2e-04"},
+		{pow2(64, 100000), "9.9900209301438450794e+30102"},
+		{new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3)),
+			"3.333333333333333333333333333333333333333333333333333333333334e-01"},
+		{new(big.Float).SetInf(false), "+Inf"},
+		{new(big.Float).SetInf(true), "-Inf"},
+	} {
+		if got := FormatBigFloat(tt.x); got != tt.want {
+			t.Errorf("FormatBigFloat(%s): got %q; want %q", tt.x.Text('p', 0), got, tt.want)
+		}
+	}
+}
+
+func TestFormatBigFloatFloat64(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		f := math.Float64frombits(r.Uint64())
+		if math.IsNaN(f) || math.IsInf(f, 0) || math.Abs(f) < math.SmallestNonzeroFloat64*(1<<52) {
+			continue
+		}
+		if got, want := FormatBigFloat(big.NewFloat(f)), FormatFloat64(f); got != want {
+			t.Errorf("FormatBigFloat(%v): got %q; want %q", f, got, want)
+		}
+	}
+}
+
+func TestFormatBigFloatRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 2000
+	if testing.Short() {
+		n = 200
+	}
+	for i := 0; i < n; i++ {
+		prec := uint(1 + r.Intn(300))
+		m := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), prec))
+		m.SetBit(m, int(prec-1), 1)
+		if i%4 == 0 {
+			// Powers of 2, where the number below is closer.
+			m.Lsh(big.NewInt(1), prec-1)
+		}
+		e := r.Intn(4000) - 2000 - int(prec)
+		if i%4 == 1 {
+			// Exponents near 0, where the trailing zero checks matter.
+			e = r.Intn(20) - int(prec)
+		}
+		x := new(big.Float).SetPrec(prec).SetInt(m)
+		x.SetMantExp(x, e)
+		if i%2 == 0 {
+			x.Neg(x)
+		}
+
+		xr, _ := new(big.Float).Abs(x).Rat(nil)
+		ulp, _ := new(big.Float).SetMantExp(big.NewFloat(1), e).Rat(nil)
+		pred := new(big.Rat).Sub(xr, ulp)
+		if m.TrailingZeroBits() == prec-1 {
+			pred.Add(pred, new(big.Rat).Quo(ulp, big.NewRat(2, 1)))
+		}
+		succ := new(big.Rat).Add(xr, ulp)
+		name := fmt.Sprintf("%d-bit %s", prec, x.Text('p', 0))
+		checkShortestExact(t, name, FormatBigFloat(x), x.Signbit(), xr, pred, succ, m.Bit(0) == 0)
+	}
+}
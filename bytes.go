@@ -0,0 +1,148 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+// The functions in this file are the []byte forms of the parsing functions.
+// They convert b to a string without copying, so like the Prefix functions
+// they do not allocate, except to return an error or to parse a number with
+// more than 17 significant digits.
+
+// ParseFloat64Bytes is like ParseFloat64 but parses b.
+func ParseFloat64Bytes(b []byte) (float64, error) {
+	return ParseFloat64(bytesString(b))
+}
+
+// ParseFloat32Bytes is like ParseFloat32 but parses b.
+func ParseFloat32Bytes(b []byte) (float32, error) {
+	return ParseFloat32(bytesString(b))
+}
+
+// ParseFloat64BitsBytes is like ParseFloat64Bits but parses b.
+func ParseFloat64BitsBytes(b []byte) (uint64, error) {
+	return ParseFloat64Bits(bytesString(b))
+}
+
+// ParseFloat32BitsBytes is like ParseFloat32Bits but parses b.
+func ParseFloat32BitsBytes(b []byte) (uint32, error) {
+	return ParseFloat32Bits(bytesString(b))
+}
+
+// ParseFloat64ExactBytes is like ParseFloat64Exact but parses b.
+func ParseFloat64ExactBytes(b []byte) (float64, error) {
+	return stdParser.ParseFloat64Exact(bytesString(b))
+}
+
+// ParseFloat32ExactBytes is like ParseFloat32Exact but parses b.
+func ParseFloat32ExactBytes(b []byte) (float32, error) {
+	return stdParser.ParseFloat32Exact(bytesString(b))
+}
+
+// ParseFloat64RoundedBytes is like ParseFloat64Rounded but parses b.
+func ParseFloat64RoundedBytes(b []byte) (f float64, rounded bool, err error) {
+	return stdParser.ParseFloat64Rounded(bytesString(b))
+}
+
+// ParseFloat32RoundedBytes is like ParseFloat32Rounded but parses b.
+func ParseFloat32RoundedBytes(b []byte) (f float32, rounded bool, err error) {
+	return stdParser.ParseFloat32Rounded(bytesString(b))
+}
+
+// ParseFloat16Bytes is like ParseFloat16 but parses b.
+func ParseFloat16Bytes(b []byte) (uint16, error) {
+	return stdParser.ParseFloat16(bytesString(b))
+}
+
+// ParseBFloat16Bytes is like ParseBFloat16 but parses b.
+func ParseBFloat16Bytes(b []byte) (uint16, error) {
+	return stdParser.ParseBFloat16(bytesString(b))
+}
+
+// ParseDecimal64Bytes is like ParseDecimal64 but parses b.
+func ParseDecimal64Bytes(b []byte) (m uint64, e int32, neg bool, err error) {
+	return stdParser.ParseDecimal64(bytesString(b))
+}
+
+// ParseComplex128Bytes is like ParseComplex128 but parses b.
+func ParseComplex128Bytes(b []byte) (complex128, error) {
+	return complexParser.ParseComplex128(bytesString(b))
+}
+
+// ParseComplex64Bytes is like ParseComplex64 but parses b.
+func ParseComplex64Bytes(b []byte) (complex64, error) {
+	return complexParser.ParseComplex64(bytesString(b))
+}
+
+// ParseFloat64Bytes is like the ParseFloat64 method but parses b.
+func (p *Parser) ParseFloat64Bytes(b []byte) (float64, error) {
+	return p.ParseFloat64(bytesString(b))
+}
+
+// ParseFloat32Bytes is like the ParseFloat32 method but parses b.
+func (p *Parser) ParseFloat32Bytes(b []byte) (float32, error) {
+	return p.ParseFloat32(bytesString(b))
+}
+
+// ParseFloat64BitsBytes is like the ParseFloat64Bits method but parses b.
+func (p *Parser) ParseFloat64BitsBytes(b []byte) (uint64, error) {
+	return p.ParseFloat64Bits(bytesString(b))
+}
+
+// ParseFloat32BitsBytes is like the ParseFloat32Bits method but parses b.
+func (p *Parser) ParseFloat32BitsBytes(b []byte) (uint32, error) {
+	return p.ParseFloat32Bits(bytesString(b))
+}
+
+// ParseFloat64ExactBytes is like the ParseFloat64Exact method but parses b.
+func (p *Parser) ParseFloat64ExactBytes(b []byte) (float64, error) {
+	return p.ParseFloat64Exact(bytesString(b))
+}
+
+// ParseFloat32ExactBytes is like the ParseFloat32Exact method but parses b.
+func (p *Parser) ParseFloat32ExactBytes(b []byte) (float32, error) {
+	return p.ParseFloat32Exact(bytesString(b))
+}
+
+// ParseFloat64RoundedBytes is like the ParseFloat64Rounded method but parses b.
+func (p *Parser) ParseFloat64RoundedBytes(b []byte) (f float64, rounded bool, err error) {
+	return p.ParseFloat64Rounded(bytesString(b))
+}
+
+// ParseFloat32RoundedBytes is like the ParseFloat32Rounded method but parses b.
+func (p *Parser) ParseFloat32RoundedBytes(b []byte) (f float32, rounded bool, err error) {
+	return p.ParseFloat32Rounded(bytesString(b))
+}
+
+// ParseFloat16Bytes is like the ParseFloat16 method but parses b.
+func (p *Parser) ParseFloat16Bytes(b []byte) (uint16, error) {
+	return p.ParseFloat16(bytesString(b))
+}
+
+// ParseBFloat16Bytes is like the ParseBFloat16 method but parses b.
+func (p *Parser) ParseBFloat16Bytes(b []byte) (uint16, error) {
+	return p.ParseBFloat16(bytesString(b))
+}
+
+// ParseDecimal64Bytes is like the ParseDecimal64 method but parses b.
+func (p *Parser) ParseDecimal64Bytes(b []byte) (m uint64, e int32, neg bool, err error) {
+	return p.ParseDecimal64(bytesString(b))
+}
+
+// ParseComplex128Bytes is like the ParseComplex128 method but parses b.
+func (p *Parser) ParseComplex128Bytes(b []byte) (complex128, error) {
+	return p.ParseComplex128(bytesString(b))
+}
+
+// ParseComplex64Bytes is like the ParseComplex64 method but parses b.
+func (p *Parser) ParseComplex64Bytes(b []byte) (complex64, error) {
+	return p.ParseComplex64(bytesString(b))
+}
@@ -0,0 +1,121 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "testing"
+
+var bytesTests = []string{"0", "-1.5", "0.1", "1e400", "1e-400", "0x1p-3", "1+2i", "x", ""}
+
+func TestParseBytes(t *testing.T) {
+	for _, s := range bytesTests {
+		b := []byte(s)
+		check := func(name string, got, want interface{}, err, wantErr error) {
+			t.Helper()
+			if got != want || !sameError(err, wantErr) {
+				t.Errorf("%sBytes(%q): got (%v, %v); want (%v, %v)", name, s, got, err, want, wantErr)
+			}
+		}
+		f64, err := ParseFloat64Bytes(b)
+		want64, wantErr := ParseFloat64(s)
+		check("ParseFloat64", f64, want64, err, wantErr)
+		f32, err := ParseFloat32Bytes(b)
+		want32, wantErr := ParseFloat32(s)
+		check("ParseFloat32", f32, want32, err, wantErr)
+		u64, err := ParseFloat64BitsBytes(b)
+		wantU64, wantErr := ParseFloat64Bits(s)
+		check("ParseFloat64Bits", u64, wantU64, err, wantErr)
+		u32, err := ParseFloat32BitsBytes(b)
+		wantU32, wantErr := ParseFloat32Bits(s)
+		check("ParseFloat32Bits", u32, wantU32, err, wantErr)
+		f64, err = ParseFloat64ExactBytes(b)
+		want64, wantErr = ParseFloat64Exact(s)
+		check("ParseFloat64Exact", f64, want64, err, wantErr)
+		f32, err = ParseFloat32ExactBytes(b)
+		want32, wantErr = ParseFloat32Exact(s)
+		check("ParseFloat32Exact", f32, want32, err, wantErr)
+		f64, rounded, err := ParseFloat64RoundedBytes(b)
+		want64, wantRounded, wantErr := ParseFloat64Rounded(s)
+		check("ParseFloat64Rounded", [2]interface{}{f64, rounded}, [2]interface{}{want64, wantRounded}, err, wantErr)
+		f32, rounded, err = ParseFloat32RoundedBytes(b)
+		want32, wantRounded, wantErr = ParseFloat32Rounded(s)
+		check("ParseFloat32Rounded", [2]interface{}{f32, rounded}, [2]interface{}{want32, wantRounded}, err, wantErr)
+		h, err := ParseFloat16Bytes(b)
+		wantH, wantErr := ParseFloat16(s)
+		check("ParseFloat16", h, wantH, err, wantErr)
+		h, err = ParseBFloat16Bytes(b)
+		wantH, wantErr = ParseBFloat16(s)
+		check("ParseBFloat16", h, wantH, err, wantErr)
+		m, e, neg, err := ParseDecimal64Bytes(b)
+		wantM, wantE, wantNeg, wantErr := ParseDecimal64(s)
+		check("ParseDecimal64", [3]interface{}{m, e, neg}, [3]interface{}{wantM, wantE, wantNeg}, err, wantErr)
+		c128, err := ParseComplex128Bytes(b)
+		wantC128, wantErr := ParseComplex128(s)
+		check("ParseComplex128", c128, wantC128, err, wantErr)
+		c64, err := ParseComplex64Bytes(b)
+		wantC64, wantErr := ParseComplex64(s)
+		check("ParseComplex64", c64, wantC64, err, wantErr)
+
+		p := Parser{Specials: StrconvSpecials}
+		f64, err = p.ParseFloat64Bytes(b)
+		want64, wantErr = p.ParseFloat64(s)
+		check("Parser.ParseFloat64", f64, want64, err, wantErr)
+	}
+}
+
+// TestParseComplexBytes checks that the []byte forms of the ParseComplex
+// functions accept what the string forms do, the special values included.
+func TestParseComplexBytes(t *testing.T) {
+	for _, s := range complexTests {
+		got, err := ParseComplex128Bytes([]byte(s))
+		want, wantErr := ParseComplex128(s)
+		if !sameComplex(got, want) || !sameError(err, wantErr) {
+			t.Errorf("ParseComplex128Bytes(%q): got (%v, %v); want (%v, %v)", s, got, err, want, wantErr)
+		}
+		got64, err := ParseComplex64Bytes([]byte(s))
+		want64, wantErr := ParseComplex64(s)
+		if !sameComplex(complex128(got64), complex128(want64)) || !sameError(err, wantErr) {
+			t.Errorf("ParseComplex64Bytes(%q): got (%v, %v); want (%v, %v)", s, got64, err, want64, wantErr)
+		}
+	}
+}
+
+// TestParseBytesNoCopy checks that errors do not alias the input.
+func TestParseBytesNoCopy(t *testing.T) {
+	b := []byte("1.5x")
+	_, err := ParseFloat64Bytes(b)
+	b[0] = '9'
+	if want := `strconv.ParseFloat: parsing "1.5x": invalid syntax`; err == nil || err.Error() != want {
+		t.Errorf("got error %v; want %s", err, want)
+	}
+}
+
+func TestParseBytesAllocs(t *testing.T) {
+	b := []byte("-12345.678e-3")
+	half := []byte("0.5")
+	c := []byte("(1.5-2e3i)")
+	var sink float64
+	for name, fn := range map[string]func(){
+		"ParseFloat64":        func() { sink, _ = ParseFloat64Bytes(b) },
+		"ParseFloat32":        func() { f, _ := ParseFloat32Bytes(b); sink = float64(f) },
+		"ParseFloat64Exact":   func() { sink, _ = ParseFloat64ExactBytes(half) },
+		"ParseFloat64Rounded": func() { sink, _, _ = ParseFloat64RoundedBytes(b) },
+		"ParseFloat16":        func() { h, _ := ParseFloat16Bytes(b); sink = float64(h) },
+		"ParseDecimal64":      func() { m, _, _, _ := ParseDecimal64Bytes(b); sink = float64(m) },
+		"ParseComplex128":     func() { z, _ := ParseComplex128Bytes(c); sink = real(z) },
+	} {
+		if n := testing.AllocsPerRun(100, fn); n != 0 {
+			t.Errorf("%sBytes: %v allocations; want 0", name, n)
+		}
+	}
+	floatSink = sink
+}
@@ -0,0 +1,47 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+// Canonicalize parses b, a number in the syntax accepted by ParseFloat64, and
+// returns the shortest representation of the nearest float64 as formatted by
+// FormatFloat64. Numbers with the same float64 value, such as "1.50",
+// "15e-1", and "0x1.8p0", therefore have the same canonical form, "1.5e+00",
+// which makes it suitable for cache and deduplication keys. If b cannot be
+// parsed, Canonicalize returns nil and the error of ParseFloat64.
+func Canonicalize(b []byte) ([]byte, error) {
+	return stdParser.AppendCanonical(nil, b)
+}
+
+// AppendCanonical is like Canonicalize but appends the canonical form of b to
+// dst and returns the extended buffer. If b cannot be parsed, it returns dst
+// unchanged and the error.
+func AppendCanonical(dst, b []byte) ([]byte, error) {
+	return stdParser.AppendCanonical(dst, b)
+}
+
+// Canonicalize is like the top-level Canonicalize but parses b in the syntax
+// configured by p. Infinities and NaNs accepted by p.Specials are formatted
+// as "+Inf", "-Inf", and "NaN".
+func (p *Parser) Canonicalize(b []byte) ([]byte, error) {
+	return p.AppendCanonical(nil, b)
+}
+
+// AppendCanonical is like the top-level AppendCanonical but parses b in the
+// syntax configured by p.
+func (p *Parser) AppendCanonical(dst, b []byte) ([]byte, error) {
+	u, _, err := p.parseFloat(bytesString(b), &float64info, false, nil)
+	if err != nil {
+		return dst, err
+	}
+	return AppendFloat64Bits(dst, u), nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestCanonicalize(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want string
+	}{
+		{"1.50", "1.5e+00"},
+		{"15e-1", "1.5e+00"},
+		{"0x1.8p0", "1.5e+00"},
+		{"+0.000", "0e+00"},
+		{"-0", "-0e+00"},
+		{"0.1000000000000000055511151231257827", "1e-01"},
+		{"123456789012345678901234567890", "1.2345678901234568e+29"},
+		{"1e-400", "0e+00"},
+	} {
+		got, err := Canonicalize([]byte(tt.in))
+		if string(got) != tt.want || err != nil {
+			t.Errorf("Canonicalize(%q): got (%q, %v); want %q", tt.in, got, err, tt.want)
+		}
+	}
+	for _, tt := range []struct {
+		in      string
+		wantErr error
+	}{
+		{"", strconv.ErrSyntax},
+		{"1.5x", strconv.ErrSyntax},
+		{"inf", strconv.ErrSyntax},
+		{"1e400", strconv.ErrRange},
+	} {
+		got, err := AppendCanonical([]byte("x"), []byte(tt.in))
+		if string(got) != "x" || numErr(err) != tt.wantErr {
+			t.Errorf("AppendCanonical(%q): got (%q, %v); want (\"x\", %v)", tt.in, got, err, tt.wantErr)
+		}
+	}
+
+	p := Parser{DecimalComma: true, Specials: StrconvSpecials}
+	for _, tt := range []struct {
+		in   string
+		want string
+	}{
+		{"2,50", "2.5e+00"},
+		{"-Infinity", "-Inf"},
+		{"nan", "NaN"},
+	} {
+		got, err := p.AppendCanonical([]byte("k="), []byte(tt.in))
+		if string(got) != "k="+tt.want || err != nil {
+			t.Errorf("Parser.AppendCanonical(%q): got (%q, %v); want %q", tt.in, got, err, "k="+tt.want)
+		}
+	}
+}
+
+// TestCanonicalizeIdempotent checks that canonical forms are their own
+// canonical forms and that numbers that differ only in their spelling have
+// the same canonical form.
+func TestCanonicalizeIdempotent(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		s := randomDigits(r, 2+r.Intn(20)) + "e" + strconv.Itoa(r.Intn(500)-250)
+		c, err := Canonicalize([]byte(s))
+		if err != nil {
+			t.Fatalf("Canonicalize(%q): %v", s, err)
+		}
+		if c2, err := Canonicalize(c); string(c2) != string(c) || err != nil {
+			t.Fatalf("Canonicalize(%q) = %q, but Canonicalize(%q) = (%q, %v)", s, c, c, c2, err)
+		}
+		f, _ := ParseFloat64(s)
+		if c3, _ := Canonicalize([]byte(strconv.FormatFloat(f, 'f', -1, 64))); string(c3) != string(c) {
+			t.Fatalf("Canonicalize(%q) = %q, but the 'f' form gives %q", s, c, c3)
+		}
+	}
+}
+
+func BenchmarkCanonicalize(b *testing.B) {
+	in := []byte("1234.5678000")
+	buf := make([]byte, 0, 32)
+	for i := 0; i < b.N; i++ {
+		buf, _ = AppendCanonical(buf[:0], in)
+	}
+}
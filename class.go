@@ -0,0 +1,102 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"strconv"
+)
+
+// A Class is the IEEE 754 category of a floating-point value.
+type Class uint8
+
+const (
+	ClassZero Class = iota
+	ClassSubnormal
+	ClassNormal
+	ClassInf
+	ClassNaN
+)
+
+var classNames = [...]string{
+	ClassZero:      "zero",
+	ClassSubnormal: "subnormal",
+	ClassNormal:    "normal",
+	ClassInf:       "infinite",
+	ClassNaN:       "NaN",
+}
+
+func (c Class) String() string {
+	if int(c) < len(classNames) {
+		return classNames[c]
+	}
+	return "Class(" + strconv.Itoa(int(c)) + ")"
+}
+
+// Classify32 reports the class of f.
+func Classify32(f float32) Class {
+	u := math.Float32bits(f)
+	mant := u & (uint32(1)<<mantBits32 - 1)
+	exp := (u >> mantBits32) & (uint32(1)<<expBits32 - 1)
+	return classify(exp == 0, exp == uint32(1)<<expBits32-1, mant == 0)
+}
+
+// Classify64 reports the class of f.
+func Classify64(f float64) Class {
+	u := math.Float64bits(f)
+	mant := u & (uint64(1)<<mantBits64 - 1)
+	exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1)
+	return classify(exp == 0, exp == uint64(1)<<expBits64-1, mant == 0)
+}
+
+func classify(expZero, expMax, mantZero bool) Class {
+	switch {
+	case expMax && mantZero:
+		return ClassInf
+	case expMax:
+		return ClassNaN
+	case expZero && mantZero:
+		return ClassZero
+	case expZero:
+		return ClassSubnormal
+	default:
+		return ClassNormal
+	}
+}
+
+// appendClass appends a parenthesized description of the class of d.
+func appendClass(b []byte, d FloatDecimal) []byte {
+	b = append(b, " ("...)
+	switch d.Class {
+	case ClassZero:
+		if d.Neg {
+			b = append(b, "-0"...)
+		} else {
+			b = append(b, "+0"...)
+		}
+	case ClassNaN:
+		if d.Neg {
+			b = append(b, "negative "...)
+		}
+		if d.Quiet {
+			b = append(b, "quiet"...)
+		} else {
+			b = append(b, "signaling"...)
+		}
+		b = append(b, ", payload 0x"...)
+		b = strconv.AppendUint(b, d.Payload, 16)
+	default:
+		b = append(b, d.Class.String()...)
+	}
+	return append(b, ')')
+}
@@ -0,0 +1,214 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+// Command ryu converts floating-point values between representations.
+//
+// Usage:
+//
+//	ryu [-32] [-f format] [-group n] [value ...]
+//
+// Each value may be a decimal number (1.5e-3), a hexadecimal float
+// (0x1.8p+01), or a raw IEEE bit pattern (0x4008000000000000). For each value,
+// ryu prints its bit pattern, hexadecimal float form, exact decimal expansion,
+// and shortest decimal representation as computed by package ryu. With -f,
+// only the named format (bits, hex, exact, or shortest) is printed. With
+// -group, the fractional digits of the decimal forms are separated into groups
+// of n digits by spaces, which keeps long exact expansions readable.
+//
+// Negative numbers may be given as arguments directly, as in "ryu -1.5",
+// unless they look like a flag: "ryu -32" sets the -32 flag. After "--",
+// every argument is a value, as in "ryu -- -32".
+//
+// With no arguments, values are read from standard input, one per line.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cespare/ryu"
+)
+
+var (
+	use32  = flag.Bool("32", false, "treat values as float32")
+	format = flag.String("f", "", "print only this `format` (bits, hex, exact, or shortest)")
+	group  = flag.Int("group", 0, "separate fractional digits into groups of `n`")
+)
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: ryu [-32] [-f format] [-group n] [--] [value ...]\n")
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "Negative numbers are values unless they name a flag; -- ends the flags, as in ryu -- -32.\n")
+	}
+	flag.CommandLine.Parse(numberArgs(os.Args[1:]))
+	switch *format {
+	case "", "bits", "hex", "exact", "shortest":
+	default:
+		log.Fatalf("unknown format %q", *format)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	ok := true
+	convert := func(s string) {
+		if err := convert(w, s); err != nil {
+			log.Print(err)
+			ok = false
+		}
+	}
+	if flag.NArg() > 0 {
+		for _, arg := range flag.Args() {
+			convert(arg)
+		}
+	} else {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if s := strings.TrimSpace(scanner.Text()); s != "" {
+				convert(s)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if !ok {
+		w.Flush()
+		os.Exit(1)
+	}
+}
+
+// A value is a float32 or float64 along with its raw bits.
+type value struct {
+	is32 bool
+	bits uint64
+}
+
+func (v value) float64() float64 {
+	if v.is32 {
+		return float64(math.Float32frombits(uint32(v.bits)))
+	}
+	return math.Float64frombits(v.bits)
+}
+
+func parseValue(s string) (value, error) {
+	v := value{is32: *use32}
+	bitSize := 64
+	if v.is32 {
+		bitSize = 32
+	}
+	lower := strings.ToLower(s)
+	if strings.HasPrefix(lower, "0x") && !strings.Contains(lower, "p") {
+		bits, err := strconv.ParseUint(s[2:], 16, bitSize)
+		if err != nil {
+			return v, fmt.Errorf("bad bit pattern %q: %s", s, err)
+		}
+		v.bits = bits
+		return v, nil
+	}
+	var err error
+	if v.is32 {
+		var bits uint32
+		bits, err = parser.ParseFloat32Bits(s)
+		v.bits = uint64(bits)
+	} else {
+		v.bits, err = parser.ParseFloat64Bits(s)
+	}
+	return v, err
+}
+
+// parser also accepts strconv.ParseFloat's spellings of infinity and NaN.
+var parser = ryu.Parser{Specials: ryu.StrconvSpecials}
+
+func convert(w *bufio.Writer, s string) error {
+	v, err := parseValue(s)
+	if err != nil {
+		return err
+	}
+	if *format != "" {
+		fmt.Fprintln(w, v.format(*format))
+		return nil
+	}
+	fmt.Fprintf(w, "%s\n", s)
+	for _, name := range []string{"bits", "hex", "exact", "shortest"} {
+		fmt.Fprintf(w, "  %-9s %s\n", name+":", v.format(name))
+	}
+	return nil
+}
+
+func (v value) format(name string) string {
+	switch name {
+	case "bits":
+		if v.is32 {
+			return fmt.Sprintf("%#08x", v.bits)
+		}
+		return fmt.Sprintf("%#016x", v.bits)
+	case "hex":
+		bitSize := 64
+		if v.is32 {
+			bitSize = 32
+		}
+		return ryu.FormatFloat(v.float64(), 'x', -1, bitSize)
+	case "exact":
+		// Every float64 is a multiple of 2^-1074, whose expansion has
+		// 1074 fractional digits; the trailing zeros are trimmed.
+		f := ryu.Formatter{TrimZeros: true, FractionGroup: *group}
+		return f.FormatFloat64Fixed(v.float64(), 1074)
+	case "shortest":
+		f := ryu.Formatter{FractionGroup: *group}
+		if v.is32 {
+			return f.FormatFloat32Bits(uint32(v.bits))
+		}
+		return f.FormatFloat64Bits(v.bits)
+	}
+	panic("unreachable")
+}
+
+// numberArgs returns args with "--" inserted before the first one that is a
+// negative number rather than a flag, so that the flag package, which would
+// take it for an undefined flag, leaves it and the rest of args as values.
+func numberArgs(args []string) []string {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if a == "--" || !strings.HasPrefix(a, "-") {
+			// The flag package stops here by itself.
+			return args
+		}
+		name := strings.TrimPrefix(a[1:], "-")
+		if k := strings.IndexByte(name, '='); k >= 0 {
+			name = name[:k]
+		} else if fl := flag.Lookup(name); fl != nil && !isBoolFlag(fl) {
+			i++ // skip the flag's value
+			continue
+		}
+		if flag.Lookup(name) != nil {
+			continue
+		}
+		if _, err := parser.ParseFloat64(a); err == nil || errors.Is(err, strconv.ErrRange) {
+			return append(append(args[:i:i], "--"), args[i:]...)
+		}
+	}
+	return args
+}
+
+func isBoolFlag(fl *flag.Flag) bool {
+	b, ok := fl.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
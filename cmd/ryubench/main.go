@@ -0,0 +1,386 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+// Command ryubench compares the speed of package ryu against strconv. Besides
+// the top-level functions of both packages, it times a Formatter with each of
+// ryu's registered backends, named "formatter/" and the backend's name.
+//
+// Usage:
+//
+//	ryubench [flags]
+//
+// For each selected value distribution, bit size, implementation, and mode,
+// ryubench formats a fixed sample of values in a loop and reports the time per
+// call. The modes append and format time the shortest form with the Append
+// and Format functions. The modes fixed, exp, and general time AppendFloat
+// with the formats 'f', 'e', and 'g' and the precision given by -prec; the
+// Formatter impls lay out these forms without their backends, so only ryu and
+// strconv run in them. The output may be a text table, CSV, or the standard
+// Go benchmark format, which can be fed to benchstat:
+//
+//	ryubench -format benchstat -count 10 > new.txt
+//	benchstat new.txt
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/cespare/ryu"
+)
+
+var (
+	distFlag  = flag.String("dist", "all", "comma-separated `list` of value distributions, or all")
+	implFlag  = flag.String("impl", "all", "comma-separated `list` of implementations, or all")
+	bitsFlag  = flag.String("bits", "32,64", "comma-separated `list` of bit sizes to benchmark")
+	modeFlag  = flag.String("mode", "append", "comma-separated `list` of modes to benchmark (append, format, fixed, exp, general)")
+	prec      = flag.Int("prec", 6, "`precision` for the modes fixed, exp, and general")
+	format    = flag.String("format", "text", "output `format`: text, csv, or benchstat")
+	count     = flag.Int("count", 1, "run each benchmark `n` times")
+	samples   = flag.Int("n", 1000, "number of distinct values per distribution")
+	seed      = flag.Int64("seed", 1, "random seed for generating values")
+	listFlags = flag.Bool("list", false, "list the available distributions and implementations and exit")
+)
+
+// A dist generates values of a particular shape.
+type dist struct {
+	name  string
+	desc  string
+	gen   func(r *rand.Rand) float64
+	gen32 func(r *rand.Rand) float32 // nil if float32(gen) has the shape
+}
+
+var dists = []dist{
+	{"bits", "uniformly random bit patterns (finite only)", func(r *rand.Rand) float64 {
+		for {
+			f := math.Float64frombits(r.Uint64())
+			if !math.IsNaN(f) && !math.IsInf(f, 0) {
+				return f
+			}
+		}
+	}, func(r *rand.Rand) float32 {
+		for {
+			f := math.Float32frombits(r.Uint32())
+			if !math.IsNaN(float64(f)) && !math.IsInf(float64(f), 0) {
+				return f
+			}
+		}
+	}},
+	{"unit", "uniform in [0, 1)", func(r *rand.Rand) float64 {
+		return r.Float64()
+	}, nil},
+	{"int", "integers below 2^31", func(r *rand.Rand) float64 {
+		return float64(r.Int31())
+	}, nil},
+	{"short", "decimals with at most 6 significant digits", func(r *rand.Rand) float64 {
+		return float64(r.Intn(1e6)) * math.Pow10(r.Intn(20)-10)
+	}, nil},
+	{"subnormal", "subnormals of the bit size", func(r *rand.Rand) float64 {
+		return math.Float64frombits(r.Uint64() & (1<<52 - 1))
+	}, func(r *rand.Rand) float32 {
+		return math.Float32frombits(r.Uint32() & (1<<23 - 1))
+	}},
+}
+
+// sample returns n values of d for each bit size. The float32s come from
+// gen32 if d has one, since rounding the float64s would turn most of them
+// into infinities or zeros.
+func (d dist) sample(n int) ([]float64, []float32) {
+	r := rand.New(rand.NewSource(*seed))
+	vals := make([]float64, n)
+	for i := range vals {
+		vals[i] = d.gen(r)
+	}
+	vals32 := make([]float32, n)
+	if d.gen32 == nil {
+		for i, f := range vals {
+			vals32[i] = float32(f)
+		}
+		return vals, vals32
+	}
+	r = rand.New(rand.NewSource(*seed))
+	for i := range vals32 {
+		vals32[i] = d.gen32(r)
+	}
+	return vals, vals32
+}
+
+// An impl is a formatting implementation under test.
+type impl struct {
+	name     string
+	append32 func([]byte, float32) []byte
+	append64 func([]byte, float64) []byte
+	format32 func(float32) string
+	format64 func(float64) string
+
+	// appendFloat, if not nil, is an AppendFloat function with the
+	// signature of strconv.AppendFloat, used by the precision modes.
+	appendFloat func(b []byte, f float64, fmt byte, prec, bitSize int) []byte
+}
+
+// precisionModes maps the precision modes to the formats they use.
+var precisionModes = map[string]byte{
+	"fixed":   'f',
+	"exp":     'e',
+	"general": 'g',
+}
+
+// impls returns the top-level ryu and strconv functions, which are the
+// baselines, followed by an impl for each registered ryu backend.
+func impls() []impl {
+	ims := []impl{
+		{"ryu", ryu.AppendFloat32, ryu.AppendFloat64, ryu.FormatFloat32, ryu.FormatFloat64, ryu.AppendFloat},
+		{
+			"strconv",
+			func(b []byte, f float32) []byte { return strconv.AppendFloat(b, float64(f), 'e', -1, 32) },
+			func(b []byte, f float64) []byte { return strconv.AppendFloat(b, f, 'e', -1, 64) },
+			func(f float32) string { return strconv.FormatFloat(float64(f), 'e', -1, 32) },
+			func(f float64) string { return strconv.FormatFloat(f, 'e', -1, 64) },
+			strconv.AppendFloat,
+		},
+	}
+	for _, name := range ryu.Backends() {
+		b, _ := ryu.LookupBackend(name)
+		f := &ryu.Formatter{Backend: b}
+		ims = append(ims, impl{"formatter/" + name, f.AppendFloat32, f.AppendFloat64, f.FormatFloat32, f.FormatFloat64, nil})
+	}
+	return ims
+}
+
+type result struct {
+	dist string
+	bits int
+	mode string
+	impl string
+	r    testing.BenchmarkResult
+}
+
+func (r result) name() string {
+	return fmt.Sprintf("%s/%d/%s/%s", r.dist, r.bits, r.mode, r.impl)
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Parse()
+	if flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *listFlags {
+		list()
+		return
+	}
+	switch *format {
+	case "text", "csv", "benchstat":
+	default:
+		log.Fatalf("unknown output format %q", *format)
+	}
+	if *samples <= 0 {
+		log.Fatalf("-n must be positive, not %d", *samples)
+	}
+	if *prec < 0 {
+		log.Fatalf("-prec must not be negative, not %d", *prec)
+	}
+
+	selDists := selectDists(*distFlag)
+	selImpls := selectImpls(*implFlag)
+	var bitSizes []int
+	for _, s := range strings.Split(*bitsFlag, ",") {
+		switch s {
+		case "32":
+			bitSizes = append(bitSizes, 32)
+		case "64":
+			bitSizes = append(bitSizes, 64)
+		default:
+			log.Fatalf("bad bit size %q", s)
+		}
+	}
+
+	modes := strings.Split(*modeFlag, ",")
+	for _, mode := range modes {
+		if _, ok := precisionModes[mode]; !ok && mode != "append" && mode != "format" {
+			log.Fatalf("bad mode %q", mode)
+		}
+	}
+
+	var results []result
+	for _, d := range selDists {
+		vals, vals32 := d.sample(*samples)
+		for _, bits := range bitSizes {
+			for _, mode := range modes {
+				for _, im := range selImpls {
+					if _, ok := precisionModes[mode]; ok && im.appendFloat == nil {
+						continue
+					}
+					for i := 0; i < *count; i++ {
+						res := result{dist: d.name, bits: bits, mode: mode, impl: im.name}
+						res.r = run(im, bits, mode, vals, vals32)
+						results = append(results, res)
+						if *format == "benchstat" {
+							printBenchstat(res)
+						}
+					}
+				}
+			}
+		}
+	}
+	switch *format {
+	case "text":
+		printText(results)
+	case "csv":
+		printCSV(results)
+	}
+}
+
+var sink string
+
+func run(im impl, bits int, mode string, vals []float64, vals32 []float32) testing.BenchmarkResult {
+	if verb, ok := precisionModes[mode]; ok {
+		if bits == 32 {
+			// The float32s are converted back to float64s exactly.
+			vals = make([]float64, len(vals32))
+			for i, f := range vals32 {
+				vals[i] = float64(f)
+			}
+		}
+		return testing.Benchmark(func(b *testing.B) {
+			var buf []byte
+			for i := 0; i < b.N; i++ {
+				buf = im.appendFloat(buf[:0], vals[i%len(vals)], verb, *prec, bits)
+			}
+		})
+	}
+	switch {
+	case bits == 32 && mode == "append":
+		return testing.Benchmark(func(b *testing.B) {
+			var buf []byte
+			for i := 0; i < b.N; i++ {
+				buf = im.append32(buf[:0], vals32[i%len(vals32)])
+			}
+		})
+	case bits == 32:
+		return testing.Benchmark(func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sink = im.format32(vals32[i%len(vals32)])
+			}
+		})
+	case mode == "append":
+		return testing.Benchmark(func(b *testing.B) {
+			var buf []byte
+			for i := 0; i < b.N; i++ {
+				buf = im.append64(buf[:0], vals[i%len(vals)])
+			}
+		})
+	default:
+		return testing.Benchmark(func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sink = im.format64(vals[i%len(vals)])
+			}
+		})
+	}
+}
+
+func selectDists(s string) []dist {
+	if s == "all" {
+		return dists
+	}
+	var sel []dist
+outer:
+	for _, name := range strings.Split(s, ",") {
+		for _, d := range dists {
+			if d.name == name {
+				sel = append(sel, d)
+				continue outer
+			}
+		}
+		log.Fatalf("unknown distribution %q (see -list)", name)
+	}
+	return sel
+}
+
+func selectImpls(s string) []impl {
+	ims := impls()
+	if s == "all" {
+		return ims
+	}
+	var sel []impl
+outer:
+	for _, name := range strings.Split(s, ",") {
+		for _, im := range ims {
+			if im.name == name {
+				sel = append(sel, im)
+				continue outer
+			}
+		}
+		log.Fatalf("unknown implementation %q (see -list)", name)
+	}
+	return sel
+}
+
+func list() {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Distributions:")
+	for _, d := range dists {
+		fmt.Fprintf(w, "  %s\t%s\n", d.name, d.desc)
+	}
+	fmt.Fprintln(w, "Implementations:")
+	for _, im := range impls() {
+		fmt.Fprintf(w, "  %s\n", im.name)
+	}
+	w.Flush()
+}
+
+func nsPerOp(r testing.BenchmarkResult) float64 {
+	return float64(r.T.Nanoseconds()) / float64(r.N)
+}
+
+func printText(results []result) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "dist\tbits\tmode\timpl\tns/op\t")
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%.2f\t\n", r.dist, r.bits, r.mode, r.impl, nsPerOp(r.r))
+	}
+	w.Flush()
+}
+
+func printCSV(results []result) {
+	w := csv.NewWriter(os.Stdout)
+	w.Write([]string{"dist", "bits", "mode", "impl", "iterations", "ns_per_op"})
+	for _, r := range results {
+		w.Write([]string{
+			r.dist,
+			strconv.Itoa(r.bits),
+			r.mode,
+			r.impl,
+			strconv.Itoa(r.r.N),
+			strconv.FormatFloat(nsPerOp(r.r), 'f', 2, 64),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func printBenchstat(r result) {
+	fmt.Printf("BenchmarkFormat/%s-%d\t%d\t%.2f ns/op\n", r.name(), runtime.GOMAXPROCS(0), r.r.N, nsPerOp(r.r))
+}
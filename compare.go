@@ -0,0 +1,67 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "math"
+
+// CompareDecimal compares the exact value of the number s, in the syntax
+// accepted by ParseFloat64, with f and returns -1 if s < f, 0 if s == f, and
+// +1 if s > f. Unlike comparing ParseFloat64(s) with f, it does not round s:
+// "0.1" is greater than the float64 0.1, which is
+// 0.1000000000000000055511151231257827... rounded down. Numbers too large or
+// small for a float64 are compared exactly too, so "1e400" is less than +Inf.
+// As in cmp.Compare, a NaN f is less than any number, and -0 equals 0.
+//
+// CompareDecimal does not allocate unless s has more than 17 significant
+// digits. If s is malformed, it returns the error of ParseFloat64.
+func CompareDecimal(s string, f float64) (int, error) {
+	return stdParser.CompareDecimal(s, f)
+}
+
+// CompareDecimal is like the top-level CompareDecimal but accepts the syntax
+// configured by p. A NaN accepted by p.Specials is equal to a NaN f and less
+// than any other f.
+func (p *Parser) CompareDecimal(s string, f float64) (int, error) {
+	// Truncating s toward zero gives a float64 t that is either s itself
+	// or the neighbor of s on the side of zero. In the second case no
+	// float64 lies strictly between s and t, so f compares with s as it
+	// compares with t, except that f == t is on t's side.
+	q := *p
+	q.Rounding = ToZero
+	q.Range = RangeSaturate
+	exact := true
+	u, _, err := q.parseFloat(s, &float64info, false, &exact)
+	if err != nil {
+		return 0, err
+	}
+	t := math.Float64frombits(u)
+	switch {
+	case t != t:
+		if f != f {
+			return 0, nil
+		}
+		return -1, nil
+	case f != f:
+		return 1, nil
+	case exact && t == f:
+		return 0, nil
+	case t < f:
+		return -1, nil
+	case t > f:
+		return 1, nil
+	case u>>63 != 0:
+		// s is negative and strictly less than t == f.
+		return -1, nil
+	}
+	return 1, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestCompareDecimal(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		f    float64
+		want int
+	}{
+		{"0.1", 0.1, -1},
+		{"0.1000000000000000055511151231257827021181583404541015625", 0.1, 0},
+		{"0.10000000000000000555111512312578270211815834045410156251", 0.1, 1},
+		{"0.5", 0.5, 0},
+		{"-0.5", 0.5, -1},
+		{"0", math.Copysign(0, -1), 0},
+		{"-0", 0, 0},
+		{"1e-400", 0, 1},
+		{"-1e-400", 0, -1},
+		{"-1e-400", -5e-324, 1},
+		{"1e400", math.MaxFloat64, 1},
+		{"1e400", math.Inf(1), -1},
+		{"-1e400", math.Inf(-1), 1},
+		{"0x1p-1074", 5e-324, 0},
+		{"0x1.00000000000001p0", 1, 1},
+		{"9007199254740993", 9007199254740992, 1},
+		{"9007199254740993", 9007199254740994, -1},
+		{"1", math.NaN(), 1},
+	} {
+		got, err := CompareDecimal(tt.s, tt.f)
+		if got != tt.want || err != nil {
+			t.Errorf("CompareDecimal(%q, %g): got (%d, %v); want %d", tt.s, tt.f, got, err, tt.want)
+		}
+	}
+	if _, err := CompareDecimal("1x", 1); numErr(err) != strconv.ErrSyntax {
+		t.Errorf("CompareDecimal(1x): got error %v; want syntax error", err)
+	}
+
+	p := Parser{Specials: StrconvSpecials}
+	for _, tt := range []struct {
+		s    string
+		f    float64
+		want int
+	}{
+		{"inf", math.Inf(1), 0},
+		{"-inf", math.MaxFloat64, -1},
+		{"nan", math.NaN(), 0},
+		{"nan", math.Inf(-1), -1},
+	} {
+		got, err := p.CompareDecimal(tt.s, tt.f)
+		if got != tt.want || err != nil {
+			t.Errorf("Parser.CompareDecimal(%q, %g): got (%d, %v); want %d", tt.s, tt.f, got, err, tt.want)
+		}
+	}
+}
+
+// TestCompareDecimalRandom checks CompareDecimal against exact rational
+// arithmetic for random numbers and the float64s around them.
+func TestCompareDecimalRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		s := randomDigits(r, 2+r.Intn(22)) + "e" + strconv.Itoa(r.Intn(700)-350)
+		if r.Intn(2) == 0 {
+			s = "-" + s
+		}
+		exact, _ := new(big.Rat).SetString(s)
+		g, _ := strconv.ParseFloat(s, 64)
+		for _, f := range []float64{g, math.Nextafter(g, math.Inf(1)), math.Nextafter(g, math.Inf(-1)), 0} {
+			var want int
+			switch {
+			case math.IsInf(f, 1):
+				want = -1
+			case math.IsInf(f, -1):
+				want = 1
+			default:
+				want = exact.Cmp(new(big.Rat).SetFloat64(f))
+			}
+			got, err := CompareDecimal(s, f)
+			if got != want || err != nil {
+				t.Fatalf("CompareDecimal(%q, %g): got (%d, %v); want %d", s, f, got, err, want)
+			}
+		}
+	}
+}
+
+func TestCompareDecimalAllocs(t *testing.T) {
+	var c int
+	if n := testing.AllocsPerRun(100, func() { c, _ = CompareDecimal("0.30000000000000004", 0.3) }); n != 0 {
+		t.Errorf("CompareDecimal: %v allocations; want 0", n)
+	}
+	if c != 1 {
+		t.Errorf("CompareDecimal(0.30000000000000004, 0.3) = %d; want 1", c)
+	}
+}
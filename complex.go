@@ -0,0 +1,113 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"strconv"
+)
+
+// ParseComplex128 converts s to a complex128 in the syntax of
+// strconv.ParseComplex: a real part, an imaginary part followed by 'i', or
+// both as in "1.5+2e3i", optionally in parentheses. Each part is a number in
+// the syntax accepted by ParseFloat64 or one of the spellings of infinity and
+// NaN accepted by strconv, as in "NaN+Infi" or "-infinityi", and is rounded
+// to the nearest float64. So every string strconv.FormatComplex produces is
+// accepted.
+//
+// Errors are *strconv.NumError values with Func set to "ParseComplex". If a
+// part is out of range, the result holds the corresponding infinity and
+// err.Err is strconv.ErrRange.
+func ParseComplex128(s string) (complex128, error) {
+	return complexParser.ParseComplex128(s)
+}
+
+// ParseComplex64 is like ParseComplex128 but rounds each part to the nearest
+// float32.
+func ParseComplex64(s string) (complex64, error) {
+	return complexParser.ParseComplex64(s)
+}
+
+// complexParser implements the top-level ParseComplex functions. Unlike
+// stdParser, it accepts the special values, as strconv.ParseComplex does.
+var complexParser = Parser{Specials: StrconvSpecials}
+
+// ParseComplex128 is like the top-level ParseComplex128. Each part is parsed
+// in the syntax configured by p.
+func (p *Parser) ParseComplex128(s string) (complex128, error) {
+	re, im, err := p.parseComplex(s, &float64info)
+	return complex(math.Float64frombits(re), math.Float64frombits(im)), err
+}
+
+// ParseComplex64 is like the top-level ParseComplex64. Each part is parsed in
+// the syntax configured by p.
+func (p *Parser) ParseComplex64(s string) (complex64, error) {
+	re, im, err := p.parseComplex(s, &float32info)
+	return complex(math.Float32frombits(uint32(re)), math.Float32frombits(uint32(im))), err
+}
+
+// parseComplex returns the bits of the real and imaginary parts of s in the
+// format described by info.
+func (p *Parser) parseComplex(s string, info *floatInfo) (re, im uint64, err error) {
+	orig := s
+	if p.TrimSpace {
+		// Whitespace is allowed around the whole number, not around
+		// its parts.
+		s, _ = trimSpace(s, false)
+		q := *p
+		q.TrimSpace = false
+		p = &q
+	}
+	if len(s) >= 2 && s[0] == '(' && s[len(s)-1] == ')' {
+		s = s[1 : len(s)-1]
+	}
+	re, n, err := p.parseFloat(s, info, true, nil)
+	if n == 0 {
+		return 0, 0, complexError(orig, strconv.ErrSyntax)
+	}
+	outOfRange := err != nil
+	s = s[n:]
+	switch {
+	case s == "":
+		im = 0
+	case s == "i":
+		re, im = 0, re
+	case s[0] == '+' || s[0] == '-':
+		if s[0] == '+' {
+			// The imaginary part may have its own sign, as in "1+-2i",
+			// but "1++2i" is malformed.
+			s = s[1:]
+			if s != "" && s[0] == '+' {
+				return 0, 0, complexError(orig, strconv.ErrSyntax)
+			}
+		}
+		im, n, err = p.parseFloat(s, info, true, nil)
+		if n == 0 || s[n:] != "i" {
+			return 0, 0, complexError(orig, strconv.ErrSyntax)
+		}
+		outOfRange = outOfRange || err != nil
+	default:
+		return 0, 0, complexError(orig, strconv.ErrSyntax)
+	}
+	if outOfRange {
+		return re, im, complexError(orig, strconv.ErrRange)
+	}
+	return re, im, nil
+}
+
+// complexError is like numError for the ParseComplex functions.
+func complexError(s string, err error) *strconv.NumError {
+	e := numError(s, err)
+	e.Func = "ParseComplex"
+	return e
+}
@@ -0,0 +1,90 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+var complexTests = []string{
+	"0", "1.5", "-2e3", "2i", "-2.5e-3i", "1e5i",
+	"1+2i", "(1+2i)", "+1+2i", "1-2i", "1+-2i", "-0.1-0.2i",
+	"0x1p2+0x1p1i", "1e400+1i", "1+1e400i", "1e-400+1e-400i",
+	"inf+nani", "-infi", "nan", "(-Inf-Infi)", "NaN", "Inf", "-Infi", "1+NaNi",
+	"+Inf-Infi", "infinity+infinityi", "(NaN+NaNi)",
+	"i", "+i", "1+i", "1-i", "1++2i", "1+2j", "1+2", "1 + 2i",
+	"(1+2i", "1+2i)", "(", "()", "", "1e+i", "1+2ii", "((1))",
+}
+
+func TestParseComplex(t *testing.T) {
+	p := Parser{Specials: StrconvSpecials}
+	for _, s := range complexTests {
+		for _, parse := range []struct {
+			name string
+			c128 func(string) (complex128, error)
+			c64  func(string) (complex64, error)
+		}{
+			{"", ParseComplex128, ParseComplex64},
+			{"Parser.", p.ParseComplex128, p.ParseComplex64},
+		} {
+			got, err := parse.c128(s)
+			want, wantErr := strconv.ParseComplex(s, 128)
+			if !sameError(err, wantErr) {
+				t.Errorf("%sParseComplex128(%q): got error %v; want %v", parse.name, s, err, wantErr)
+			} else if !sameComplex(got, want) {
+				t.Errorf("%sParseComplex128(%q): got %v; want %v", parse.name, s, got, want)
+			}
+			got64, err := parse.c64(s)
+			want, wantErr = strconv.ParseComplex(s, 64)
+			if !sameError(err, wantErr) {
+				t.Errorf("%sParseComplex64(%q): got error %v; want %v", parse.name, s, err, wantErr)
+			} else if !sameComplex(complex128(got64), want) {
+				t.Errorf("%sParseComplex64(%q): got %v; want %v", parse.name, s, got64, want)
+			}
+		}
+	}
+	if _, err := stdParser.ParseComplex128("inf"); numErr(err) != strconv.ErrSyntax {
+		t.Errorf("Parser{}.ParseComplex128(inf): got error %v; want syntax error", err)
+	}
+	if _, err := ParseComplex64("1+2j"); err.(*strconv.NumError).Func != "ParseComplex" {
+		t.Errorf("ParseComplex64(1+2j): got error %v; want ParseComplex error", err)
+	}
+	q := Parser{DecimalComma: true}
+	if c, err := q.ParseComplex128("(1,5-0,25i)"); c != 1.5-0.25i || err != nil {
+		t.Errorf("ParseComplex128 with decimal comma: got (%v, %v); want (1.5-0.25i, nil)", c, err)
+	}
+}
+
+func sameComplex(x, y complex128) bool {
+	same := func(a, b float64) bool {
+		return math.Float64bits(a) == math.Float64bits(b) || math.IsNaN(a) && math.IsNaN(b)
+	}
+	return same(real(x), real(y)) && same(imag(x), imag(y))
+}
+
+func TestParseComplexFormatComplex(t *testing.T) {
+	specials := []float64{math.Inf(1), math.Inf(-1), math.NaN(), 0, 1.5}
+	for _, re := range specials {
+		for _, im := range specials {
+			c := complex(re, im)
+			for _, verb := range []byte{'e', 'g'} {
+				s := strconv.FormatComplex(c, verb, -1, 128)
+				if got, err := ParseComplex128(s); err != nil || !sameComplex(got, c) {
+					t.Errorf("ParseComplex128(%q): got (%v, %v); want %v", s, got, err, c)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+)
+
+// Conversion happens in two stages. The first stage turns a binary
+// floating-point number into a FloatDecimal: the shortest decimal that rounds
+// back to the same number, plus its sign and class. The second stage, a
+// Renderer, turns the FloatDecimal into text. A Formatter combines a Backend,
+// which implements the first stage, with a Renderer.
+
+// A FloatDecimal is the decimal form of a floating-point number.
+type FloatDecimal struct {
+	// For finite nonzero values, the magnitude of the value is
+	// Digits * 10^Exp. Digits has no trailing zeros and at most 17 digits.
+	// Digits and Exp are zero for zeros, infinities, and NaNs.
+	Digits uint64
+	Exp    int32
+
+	// Neg is the sign bit of the value.
+	Neg bool
+
+	Class Class
+
+	// For NaNs, Quiet reports whether the NaN is quiet and Payload holds the
+	// remaining mantissa bits.
+	Quiet   bool
+	Payload uint64
+}
+
+// A Renderer produces the text form of a FloatDecimal.
+type Renderer interface {
+	// AppendDecimal appends the text form of d to b and returns the
+	// extended buffer.
+	AppendDecimal(b []byte, d FloatDecimal) []byte
+}
+
+// Decimal32 returns the shortest decimal form of f.
+// The digits are those printed by FormatFloat32.
+func Decimal32(f float32) FloatDecimal {
+	return Decimal32Bits(math.Float32bits(f))
+}
+
+// Decimal32Bits returns the shortest decimal form of the float32 with the
+// IEEE 754 binary representation u. See FormatFloat32Bits.
+func Decimal32Bits(u uint32) FloatDecimal {
+	d := decodeBits32(u)
+	if d.Class == ClassNormal || d.Class == ClassSubnormal {
+		mant := u & (uint32(1)<<mantBits32 - 1)
+		exp := (u >> mantBits32) & (uint32(1)<<expBits32 - 1)
+		dd, ok := float32ToDecimalExactInt(mant, exp)
+		if !ok {
+			dd = float32ToDecimal(mant, exp)
+		}
+		d.Digits, d.Exp = uint64(dd.m), dd.e
+	}
+	return d
+}
+
+// Decimal64 returns the shortest decimal form of f.
+// The digits are those printed by FormatFloat64.
+func Decimal64(f float64) FloatDecimal {
+	return Decimal64Bits(math.Float64bits(f))
+}
+
+// Decimal64Bits returns the shortest decimal form of the float64 with the
+// IEEE 754 binary representation u. See FormatFloat64Bits.
+func Decimal64Bits(u uint64) FloatDecimal {
+	d := decodeBits64(u)
+	if d.Class == ClassNormal || d.Class == ClassSubnormal {
+		mant := u & (uint64(1)<<mantBits64 - 1)
+		exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1)
+		dd, ok := float64ToDecimalExactInt(mant, exp)
+		if !ok {
+			dd = float64ToDecimal(mant, exp)
+		}
+		d.Digits, d.Exp = dd.m, dd.e
+	}
+	return d
+}
+
+// decodeBits32 returns a FloatDecimal for the float32 with bits u with every
+// field but Digits and Exp filled in.
+func decodeBits32(u uint32) FloatDecimal {
+	mant := u & (uint32(1)<<mantBits32 - 1)
+	exp := (u >> mantBits32) & (uint32(1)<<expBits32 - 1)
+	d := FloatDecimal{
+		Neg:   u>>(mantBits32+expBits32) != 0,
+		Class: classify(exp == 0, exp == uint32(1)<<expBits32-1, mant == 0),
+	}
+	if d.Class == ClassNaN {
+		d.Quiet = mant>>(mantBits32-1) != 0
+		d.Payload = uint64(mant & (uint32(1)<<(mantBits32-1) - 1))
+	}
+	return d
+}
+
+// decodeBits64 returns a FloatDecimal for the float64 with bits u with every
+// field but Digits and Exp filled in.
+func decodeBits64(u uint64) FloatDecimal {
+	mant := u & (uint64(1)<<mantBits64 - 1)
+	exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1)
+	d := FloatDecimal{
+		Neg:   u>>(mantBits64+expBits64) != 0,
+		Class: classify(exp == 0, exp == uint64(1)<<expBits64-1, mant == 0),
+	}
+	if d.Class == ClassNaN {
+		d.Quiet = mant>>(mantBits64-1) != 0
+		d.Payload = mant & (uint64(1)<<(mantBits64-1) - 1)
+	}
+	return d
+}
+
+// appendShortestE appends d in the format of FormatFloat64.
+func appendShortestE(b []byte, d FloatDecimal) []byte {
+	switch d.Class {
+	case ClassNormal, ClassSubnormal:
+		return dec64{m: d.Digits, e: d.Exp}.append(b, d.Neg)
+	default:
+		return appendSpecial(b, d.Neg, d.Class == ClassZero, d.Class != ClassNaN)
+	}
+}
+
+// appendFractionalE appends the finite nonzero d in the form "0.15e+01", with
+// the mantissa in [0.1, 1).
+func appendFractionalE(b []byte, d FloatDecimal) []byte {
+	if d.Neg {
+		b = append(b, '-')
+	}
+	b = append(b, "0."...)
+	n := len(b)
+	b = strconv.AppendUint(b, d.Digits, 10)
+	return appendExponent(b, d.Exp+int32(len(b)-n))
+}
+
+// appendExponent appends 'e' and the exponent exp with a sign and at least
+// two digits, as in dec64.append.
+func appendExponent(b []byte, exp int32) []byte {
+	b = append(b, 'e')
+	if exp < 0 {
+		b = append(b, '-')
+		exp = -exp
+	} else {
+		b = append(b, '+')
+	}
+	if exp < 10 {
+		b = append(b, '0')
+	}
+	return strconv.AppendInt(b, int64(exp), 10)
+}
+
+// groupFraction inserts sep between each group of n digits after the first
+// decimal point in b[start:].
+func groupFraction(b []byte, start, n int, sep string) []byte {
+	i := bytes.IndexByte(b[start:], '.')
+	if i < 0 {
+		return b
+	}
+	i += start + 1
+	j := i
+	for j < len(b) && '0' <= b[j] && b[j] <= '9' {
+		j++
+	}
+	if j-i <= n {
+		return b
+	}
+	var buf [32]byte
+	tail := append(buf[:0], b[i:]...)
+	b = b[:i]
+	for k := 0; k < j-i; k++ {
+		if k > 0 && k%n == 0 {
+			b = append(b, sep...)
+		}
+		b = append(b, tail[k])
+	}
+	return append(b, tail[j-i:]...)
+}
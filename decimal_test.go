@@ -0,0 +1,127 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestDecimal64(t *testing.T) {
+	for _, tt := range []struct {
+		x    float64
+		want FloatDecimal
+	}{
+		{1.5, FloatDecimal{Digits: 15, Exp: -1, Class: ClassNormal}},
+		{-100, FloatDecimal{Digits: 1, Exp: 2, Neg: true, Class: ClassNormal}},
+		{5e-324, FloatDecimal{Digits: 5, Exp: -324, Class: ClassSubnormal}},
+		{math.MaxFloat64, FloatDecimal{Digits: 17976931348623157, Exp: 292, Class: ClassNormal}},
+		{math.Copysign(0, -1), FloatDecimal{Neg: true, Class: ClassZero}},
+		{math.Inf(1), FloatDecimal{Class: ClassInf}},
+		{math.Float64frombits(0x7ff0000000000003), FloatDecimal{Class: ClassNaN, Payload: 3}},
+		{math.NaN(), FloatDecimal{Class: ClassNaN, Quiet: true, Payload: 1}},
+	} {
+		if got := Decimal64(tt.x); got != tt.want {
+			t.Errorf("Decimal64(%g): got %+v; want %+v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal32(t *testing.T) {
+	for _, tt := range []struct {
+		x    float32
+		want FloatDecimal
+	}{
+		{1.5, FloatDecimal{Digits: 15, Exp: -1, Class: ClassNormal}},
+		{-1e-45, FloatDecimal{Digits: 1, Exp: -45, Neg: true, Class: ClassSubnormal}},
+		{math.MaxFloat32, FloatDecimal{Digits: 34028235, Exp: 31, Class: ClassNormal}},
+		{0, FloatDecimal{Class: ClassZero}},
+	} {
+		if got := Decimal32(tt.x); got != tt.want {
+			t.Errorf("Decimal32(%g): got %+v; want %+v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestStrconvBackend(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1e4; i++ {
+		x := math.Float64frombits(r.Uint64())
+		if got, want := (strconvBackend{}).Decimal64(x), Decimal64(x); got != want {
+			t.Fatalf("Decimal64(%g): got %+v; want %+v", x, got, want)
+		}
+		y := math.Float32frombits(r.Uint32())
+		if got, want := (strconvBackend{}).Decimal32(y), Decimal32(y); got != want {
+			t.Fatalf("Decimal32(%g): got %+v; want %+v", y, got, want)
+		}
+	}
+}
+
+// fixedRenderer renders finite numbers in the style of %f.
+type fixedRenderer struct{}
+
+func (fixedRenderer) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	if d.Class == ClassInf || d.Class == ClassNaN {
+		return appendShortestE(b, d)
+	}
+	if d.Neg {
+		b = append(b, '-')
+	}
+	if d.Class == ClassZero {
+		return append(b, '0')
+	}
+	s := strconv.FormatUint(d.Digits, 10)
+	switch n := int(d.Exp) + len(s); {
+	case d.Exp >= 0:
+		b = append(b, s...)
+		for i := int32(0); i < d.Exp; i++ {
+			b = append(b, '0')
+		}
+	case n > 0:
+		b = append(b, s[:n]...)
+		b = append(b, '.')
+		b = append(b, s[n:]...)
+	default:
+		b = append(b, "0."...)
+		for i := n; i < 0; i++ {
+			b = append(b, '0')
+		}
+		b = append(b, s...)
+	}
+	return b
+}
+
+type emptyRenderer struct{}
+
+func (emptyRenderer) AppendDecimal(b []byte, d FloatDecimal) []byte { return b }
+
+func TestEmptyRenderer(t *testing.T) {
+	f := Formatter{Renderer: emptyRenderer{}}
+	if got := f.FormatFloat64(1); got != "" {
+		t.Errorf("FormatFloat64(1): got %q; want \"\"", got)
+	}
+	if got := f.FormatFloat32(1); got != "" {
+		t.Errorf("FormatFloat32(1): got %q; want \"\"", got)
+	}
+}
+
+func TestCustomRenderer(t *testing.T) {
+	f := Formatter{Renderer: fixedRenderer{}}
+	for _, x := range append(genericTestCases, float64TestCases...) {
+		if got, want := f.FormatFloat64(x), strconv.FormatFloat(x, 'f', -1, 64); got != want {
+			t.Errorf("FormatFloat64(%g): got %q; want %q", x, got, want)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+//go:build !ryu_compact
+// +build !ryu_compact
+
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is a Go translation of the reference implementation
+// of Dragonbox by Junekey Jeon, which may be found at
+// https://github.com/jk-jeon/dragonbox. That source code is licensed under
+// Apache 2.0 with LLVM Exceptions.
+
+package ryu
+
+import (
+	"math"
+	"math/bits"
+)
+
+// The Dragonbox backend is left out of compact builds with its tables.
+func init() {
+	backends["dragonbox"] = dragonboxBackend{}
+}
+
+// dragonboxBackend computes the shortest decimals with Dragonbox, an
+// algorithm by Junekey Jeon that, like Ryu, finds the shortest decimal in
+// the rounding interval of a number with a single multiplication by a cached
+// power of 10, but tries the digits of the shortest candidate first. It
+// gives the same results as Ryu: the interval is closed for even mantissas,
+// and ties between the nearest candidates go to the even one.
+type dragonboxBackend struct{}
+
+func (dragonboxBackend) Decimal32(f float32) FloatDecimal {
+	u := math.Float32bits(f)
+	d := decodeBits32(u)
+	if d.Class != ClassNormal && d.Class != ClassSubnormal {
+		return d
+	}
+	mant := u & (uint32(1)<<mantBits32 - 1)
+	exp := int((u >> mantBits32) & (uint32(1)<<expBits32 - 1))
+	var m uint32
+	var e int32
+	switch {
+	case exp == 0:
+		m, e = dragonbox32(mant, 1-bias32-mantBits32)
+	case mant == 0 && exp > 1:
+		m, e = dragonboxShorter32(exp - bias32 - mantBits32)
+	default:
+		m, e = dragonbox32(mant|1<<mantBits32, exp-bias32-mantBits32)
+	}
+	d.Digits, d.Exp = uint64(m), e
+	return d
+}
+
+func (dragonboxBackend) Decimal64(f float64) FloatDecimal {
+	u := math.Float64bits(f)
+	d := decodeBits64(u)
+	if d.Class != ClassNormal && d.Class != ClassSubnormal {
+		return d
+	}
+	mant := u & (uint64(1)<<mantBits64 - 1)
+	exp := int((u >> mantBits64) & (uint64(1)<<expBits64 - 1))
+	switch {
+	case exp == 0:
+		d.Digits, d.Exp = dragonbox64(mant, 1-bias64-mantBits64)
+	case mant == 0 && exp > 1:
+		// The lower neighbor of a power of 2 is closer, except for the
+		// smallest normal number, whose lower neighbor is subnormal.
+		d.Digits, d.Exp = dragonboxShorter64(exp - bias64 - mantBits64)
+	default:
+		d.Digits, d.Exp = dragonbox64(mant|1<<mantBits64, exp-bias64-mantBits64)
+	}
+	return d
+}
+
+// dragonbox64 returns the shortest decimal m × 10^e in the rounding interval
+// of the float64 fc × 2^e2 whose neighbors are equally far away.
+func dragonbox64(fc uint64, e2 int) (m uint64, e int32) {
+	const (
+		kappa        = 2
+		bigDivisor   = 1000 // 10^(kappa+1)
+		smallDivisor = 100  // 10^kappa
+	)
+	twoFc := fc << 1
+	include := fc%2 == 0
+
+	// Step 1: compute the scaled interval. zi is the right endpoint and
+	// deltai the width of the interval, scaled by 10^-minusK.
+	minusK := floorLog10Pow2(e2) - kappa
+	cache := dragonboxCache64[-minusK-dragonboxMinK64]
+	beta := e2 + floorLog2Pow10(-minusK)
+	deltai := uint32(cache.hi >> (64 - 1 - beta))
+	zi, zIsInteger := dragonboxMul64((twoFc|1)<<beta, cache)
+
+	// Step 2: try the larger divisor, which gives the shortest candidate.
+	m = zi / bigDivisor
+	r := uint32(zi - bigDivisor*m)
+	larger := false
+	switch {
+	case r < deltai:
+		// Exclude the right endpoint if necessary.
+		if r == 0 && zIsInteger && !include {
+			m--
+			r = bigDivisor
+		} else {
+			larger = true
+		}
+	case r == deltai:
+		// Compare the fractional parts.
+		xParity, xIsInteger := dragonboxParity64(twoFc-1, cache, beta)
+		larger = xParity || xIsInteger && include
+	}
+	if larger {
+		for m%10 == 0 {
+			m /= 10
+			minusK++
+		}
+		return m, int32(minusK + kappa + 1)
+	}
+
+	// Step 3: find the digits with the smaller divisor, which are those
+	// of the number itself rounded to nearest.
+	m *= 10
+	dist := r - deltai/2 + smallDivisor/2
+	approxYParity := (dist^(smallDivisor/2))&1 != 0
+	divisible := dist%smallDivisor == 0
+	m += uint64(dist / smallDivisor)
+	if divisible {
+		// yi is either zi - epsiloni or one less; the parities tell which.
+		yParity, yIsInteger := dragonboxParity64(twoFc, cache, beta)
+		if yParity != approxYParity || m%2 != 0 && yIsInteger {
+			m--
+		}
+	}
+	return m, int32(minusK + kappa)
+}
+
+// dragonboxShorter64 is like dragonbox64 for the float64 2^52 × 2^e2, whose
+// lower neighbor is half as far away as its upper one.
+func dragonboxShorter64(e2 int) (m uint64, e int32) {
+	minusK := floorLog10Pow2MinusLog10_4Over3(e2)
+	beta := e2 + floorLog2Pow10(-minusK)
+	cache := dragonboxCache64[-minusK-dragonboxMinK64].hi
+
+	// The interval is closed: the mantissa is even.
+	xi := (cache - cache>>(mantBits64+2)) >> (64 - mantBits64 - 1 - beta)
+	zi := (cache + cache>>(mantBits64+1)) >> (64 - mantBits64 - 1 - beta)
+	if e2 < 2 || e2 > 3 {
+		// The left endpoint is not an integer.
+		xi++
+	}
+
+	// Try the larger divisor.
+	m = zi / 10
+	if m*10 >= xi {
+		e = int32(minusK + 1)
+		for m%10 == 0 {
+			m /= 10
+			e++
+		}
+		return m, e
+	}
+
+	// Otherwise, round the number itself up, or to even on a tie.
+	m = (cache>>(64-mantBits64-2-beta) + 1) / 2
+	if m%2 != 0 && e2 == -77 {
+		m--
+	} else if m < xi {
+		m++
+	}
+	return m, int32(minusK)
+}
+
+// dragonboxMul64 returns the integer part of u × cache / 2^128 and whether
+// it has no fractional part.
+func dragonboxMul64(u uint64, cache uint128) (uint64, bool) {
+	hi, lo := bits.Mul64(u, cache.hi)
+	mid, _ := bits.Mul64(u, cache.lo)
+	lo, carry := bits.Add64(lo, mid, 0)
+	return hi + carry, lo == 0
+}
+
+// dragonboxParity64 returns the parity of the integer part of
+// twoF × cache × 2^beta / 2^128 and whether it has no fractional part.
+func dragonboxParity64(twoF uint64, cache uint128, beta int) (parity, isInteger bool) {
+	mid, lo := bits.Mul64(twoF, cache.lo)
+	hi := twoF*cache.hi + mid
+	return (hi>>(64-beta))&1 != 0, hi<<beta|lo>>(64-beta) == 0
+}
+
+// dragonbox32 is like dragonbox64 for float32s.
+func dragonbox32(fc uint32, e2 int) (m uint32, e int32) {
+	const (
+		kappa        = 1
+		bigDivisor   = 100 // 10^(kappa+1)
+		smallDivisor = 10  // 10^kappa
+	)
+	twoFc := fc << 1
+	include := fc%2 == 0
+
+	minusK := floorLog10Pow2(e2) - kappa
+	cache := dragonboxCache32[-minusK-dragonboxMinK32]
+	beta := e2 + floorLog2Pow10(-minusK)
+	deltai := uint32(cache >> (64 - 1 - beta))
+	zi, zIsInteger := dragonboxMul32((twoFc|1)<<beta, cache)
+
+	m = zi / bigDivisor
+	r := zi - bigDivisor*m
+	larger := false
+	switch {
+	case r < deltai:
+		if r == 0 && zIsInteger && !include {
+			m--
+			r = bigDivisor
+		} else {
+			larger = true
+		}
+	case r == deltai:
+		xParity, xIsInteger := dragonboxParity32(twoFc-1, cache, beta)
+		larger = xParity || xIsInteger && include
+	}
+	if larger {
+		for m%10 == 0 {
+			m /= 10
+			minusK++
+		}
+		return m, int32(minusK + kappa + 1)
+	}
+
+	m *= 10
+	dist := r - deltai/2 + smallDivisor/2
+	approxYParity := (dist^(smallDivisor/2))&1 != 0
+	divisible := dist%smallDivisor == 0
+	m += dist / smallDivisor
+	if divisible {
+		yParity, yIsInteger := dragonboxParity32(twoFc, cache, beta)
+		if yParity != approxYParity || m%2 != 0 && yIsInteger {
+			m--
+		}
+	}
+	return m, int32(minusK + kappa)
+}
+
+// dragonboxShorter32 is like dragonboxShorter64 for float32s.
+func dragonboxShorter32(e2 int) (m uint32, e int32) {
+	minusK := floorLog10Pow2MinusLog10_4Over3(e2)
+	beta := e2 + floorLog2Pow10(-minusK)
+	cache := dragonboxCache32[-minusK-dragonboxMinK32]
+
+	xi := uint32((cache - cache>>(mantBits32+2)) >> (64 - mantBits32 - 1 - beta))
+	zi := uint32((cache + cache>>(mantBits32+1)) >> (64 - mantBits32 - 1 - beta))
+	if e2 < 2 || e2 > 3 {
+		xi++
+	}
+
+	m = zi / 10
+	if m*10 >= xi {
+		e = int32(minusK + 1)
+		for m%10 == 0 {
+			m /= 10
+			e++
+		}
+		return m, e
+	}
+
+	m = (uint32(cache>>(64-mantBits32-2-beta)) + 1) / 2
+	if m%2 != 0 && e2 == -35 {
+		m--
+	} else if m < xi {
+		m++
+	}
+	return m, int32(minusK)
+}
+
+// dragonboxMul32 returns the integer part of u × cache / 2^64 and whether it
+// has no fractional part.
+func dragonboxMul32(u uint32, cache uint64) (uint32, bool) {
+	hi, _ := bits.Mul64(uint64(u)<<32, cache)
+	return uint32(hi >> 32), uint32(hi) == 0
+}
+
+// dragonboxParity32 returns the parity of the integer part of
+// twoF × cache × 2^beta / 2^64 and whether it has no fractional part.
+func dragonboxParity32(twoF uint32, cache uint64, beta int) (parity, isInteger bool) {
+	r := uint64(twoF) * cache
+	return (r>>(64-beta))&1 != 0, uint32(r>>(32-beta)) == 0
+}
+
+// floorLog10Pow2 returns floor(log_10(2^e)) for |e| <= 2620.
+func floorLog10Pow2(e int) int {
+	return (e * 315653) >> 20
+}
+
+// floorLog2Pow10 returns floor(log_2(10^e)) for |e| <= 1233.
+func floorLog2Pow10(e int) int {
+	return (e * 1741647) >> 19
+}
+
+// floorLog10Pow2MinusLog10_4Over3 returns floor(log_10(2^e) - log_10(4/3))
+// for |e| <= 2936.
+func floorLog10Pow2MinusLog10_4Over3(e int) int {
+	return (e*631305 - 261663) >> 21
+}
@@ -0,0 +1,110 @@
+//go:build !ryu_compact
+// +build !ryu_compact
+
+// Copyright 2020 The Go Authors. All rights reserved.
+// Modifications copyright 2019 Caleb Spare
+//
+// The code in this file is adapted from the Eisel–Lemire implementation of
+// the Go standard library's strconv package, which may be found at
+// https://go.dev/src/strconv/eisel_lemire.go. That source code is licensed
+// under the following BSD-style license and this code is derivative work
+// thereof:
+//
+//	Copyright 2009 The Go Authors.
+//
+//	Redistribution and use in source and binary forms, with or without
+//	modification, are permitted provided that the following conditions are
+//	met:
+//
+//	   * Redistributions of source code must retain the above copyright
+//	notice, this list of conditions and the following disclaimer.
+//	   * Redistributions in binary form must reproduce the above
+//	copyright notice, this list of conditions and the following disclaimer
+//	in the documentation and/or other materials provided with the
+//	distribution.
+//	   * Neither the name of Google LLC nor the names of its
+//	contributors may be used to endorse or promote products derived from
+//	this software without specific prior written permission.
+//
+//	THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+//	"AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+//	LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+//	A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+//	OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+//	SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+//	LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+//	DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+//	THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+//	(INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//	OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package ryu
+
+import "math/bits"
+
+// eiselLemire converts d to the nearest number, ties to even, in the format
+// described by info using the Eisel–Lemire algorithm, as described in Daniel
+// Lemire's "Number Parsing at a Gigabyte per Second" and used by
+// strconv.ParseFloat. It multiplies m10 by a 128-bit approximation of 10^e10
+// and reports ok = false if that approximation is not precise enough to round
+// correctly. It also gives up on subnormal and infinite results.
+func (d parsedDecimal) eiselLemire(info *floatInfo) (u uint64, ok bool) {
+	sign := boolToUint64(d.neg) << (info.mantBits + info.expBits)
+	if d.m10 == 0 {
+		return sign, true
+	}
+	if d.e10 < pow10MinExp10 || d.e10 > pow10MaxExp10 {
+		return 0, false
+	}
+	pow := pow10Split10[d.e10-pow10MinExp10]
+
+	// Normalize m10 so that its top bit is set. The product of m10 and
+	// 10^e10 then has its top bit at bit 127 or 126 of xHi:xLo, and the
+	// result has the binary exponent e2 or e2-1 (biased).
+	clz := bits.LeadingZeros64(d.m10)
+	m := d.m10 << uint(clz)
+	e2 := int64(217706*int64(d.e10))>>16 + 64 + int64(info.bias) - int64(clz)
+
+	// The result needs the top mantBits+3 bits of the product: the
+	// significand, a rounding bit, and room for the top bit to be at 126.
+	// If the bits below them are all ones in the 64-bit truncated product,
+	// the truncated part of 10^e10 might carry into them, so include the
+	// low half of the power.
+	extra := 64 - 3 - info.mantBits
+	mask := uint64(1)<<extra - 1
+	xHi, xLo := bits.Mul64(m, pow.hi)
+	if xHi&mask == mask && xLo+m < m {
+		yHi, yLo := bits.Mul64(m, pow.lo)
+		mergedHi, mergedLo := xHi, xLo+yHi
+		if mergedLo < xLo {
+			mergedHi++
+		}
+		if mergedHi&mask == mask && mergedLo+1 == 0 && yLo+m < m {
+			return 0, false
+		}
+		xHi, xLo = mergedHi, mergedLo
+	}
+
+	// Keep mantBits+2 bits: the significand and a rounding bit.
+	msb := xHi >> 63
+	mant := xHi >> (uint(msb) + extra)
+	e2 -= int64(1 ^ msb)
+
+	// A product that ends exactly at the rounding bit might be a halfway
+	// case that needs the exact value to be decided.
+	if xLo == 0 && xHi&mask == 0 && mant&3 == 1 {
+		return 0, false
+	}
+
+	// Round to mantBits+1 bits, ties to even.
+	mant += mant & 1
+	mant >>= 1
+	if mant>>(info.mantBits+1) > 0 {
+		mant >>= 1
+		e2++
+	}
+	if e2 <= 0 || e2 >= 1<<info.expBits-1 {
+		return 0, false
+	}
+	return sign | uint64(e2)<<info.mantBits | mant&(uint64(1)<<info.mantBits-1), true
+}
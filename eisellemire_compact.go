@@ -0,0 +1,22 @@
+//go:build ryu_compact
+// +build ryu_compact
+
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+// eiselLemire always reports ok = false in compact builds, which leave out
+// its table of powers of 10, so that numbers are parsed with Ryu alone.
+func (d parsedDecimal) eiselLemire(info *floatInfo) (u uint64, ok bool) {
+	return 0, false
+}
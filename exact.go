@@ -0,0 +1,146 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// ErrInexact is the Err field of the *strconv.NumError returned by the Exact
+// parsing functions when a number is not exactly representable.
+var ErrInexact = errors.New("value not exactly representable")
+
+// ParseFloat64Exact is like ParseFloat64 but also requires that s be exactly
+// representable as a float64. If it is not, ParseFloat64Exact returns the
+// nearest float64 and an error with err.Err = ErrInexact. For example, "0.5"
+// is exact but "0.1" is not. Out-of-range numbers are still reported with
+// strconv.ErrRange.
+func ParseFloat64Exact(s string) (float64, error) {
+	return stdParser.ParseFloat64Exact(s)
+}
+
+// ParseFloat32Exact is like ParseFloat64Exact but requires that s be exactly
+// representable as a float32.
+func ParseFloat32Exact(s string) (float32, error) {
+	return stdParser.ParseFloat32Exact(s)
+}
+
+// ParseFloat64Exact is like the top-level ParseFloat64Exact.
+func (p *Parser) ParseFloat64Exact(s string) (float64, error) {
+	var exact bool
+	u, _, err := p.parseFloat(s, &float64info, false, &exact)
+	if err == nil && !exact {
+		err = numError(s, ErrInexact)
+	}
+	return math.Float64frombits(u), err
+}
+
+// ParseFloat32Exact is like the top-level ParseFloat32Exact.
+func (p *Parser) ParseFloat32Exact(s string) (float32, error) {
+	var exact bool
+	u, _, err := p.parseFloat(s, &float32info, false, &exact)
+	if err == nil && !exact {
+		err = numError(s, ErrInexact)
+	}
+	return math.Float32frombits(uint32(u)), err
+}
+
+// ParseFloat64Rounded is like ParseFloat64 but also reports whether the
+// result was rounded, that is, whether it differs from the value of s. It
+// reports the same thing as ParseFloat64Exact without treating rounding as
+// an error. Infinities and NaNs are not rounded; overflow to infinity is.
+func ParseFloat64Rounded(s string) (f float64, rounded bool, err error) {
+	return stdParser.ParseFloat64Rounded(s)
+}
+
+// ParseFloat32Rounded is like ParseFloat64Rounded but returns the nearest
+// float32 and reports whether it was rounded.
+func ParseFloat32Rounded(s string) (f float32, rounded bool, err error) {
+	return stdParser.ParseFloat32Rounded(s)
+}
+
+// ParseFloat64Rounded is like the top-level ParseFloat64Rounded.
+func (p *Parser) ParseFloat64Rounded(s string) (f float64, rounded bool, err error) {
+	// parseFloat leaves exact alone if s is not a number.
+	exact := true
+	u, _, err := p.parseFloat(s, &float64info, false, &exact)
+	return math.Float64frombits(u), !exact, err
+}
+
+// ParseFloat32Rounded is like the top-level ParseFloat32Rounded.
+func (p *Parser) ParseFloat32Rounded(s string) (f float32, rounded bool, err error) {
+	// parseFloat leaves exact alone if s is not a number.
+	exact := true
+	u, _, err := p.parseFloat(s, &float32info, false, &exact)
+	return math.Float32frombits(uint32(u)), !exact, err
+}
+
+// exact reports whether d is exactly representable in the format described
+// by info. It is computed directly from m10 and e10, independently of the
+// conversion in toBits.
+func (d parsedDecimal) exact(info *floatInfo) bool {
+	if d.m10 == 0 {
+		return true
+	}
+	m := d.m10
+	if d.e10 >= 0 {
+		// m10 × 10^e10 = (m10 × 5^e10) × 2^e10. Once the factors of 2 are
+		// removed from m10, if m10 × 5^e10 overflows, it has more than 64
+		// significant bits.
+		tz := bits.TrailingZeros64(m)
+		m >>= uint(tz)
+		for i := int32(0); i < d.e10; i++ {
+			hi, lo := bits.Mul64(m, 5)
+			if hi != 0 {
+				return false
+			}
+			m = lo
+		}
+		return representable(m, int(d.e10)+tz, info)
+	}
+	// m10 × 10^e10 = (m10 / 5^-e10) × 2^e10, which is a binary fraction
+	// only if 5^-e10 divides m10.
+	p := uint64(1)
+	for i := d.e10; i < 0; i++ {
+		if p > m/5 {
+			return false
+		}
+		p *= 5
+	}
+	if m%p != 0 {
+		return false
+	}
+	return representable(m/p, int(d.e10), info)
+}
+
+// exact reports whether h is exactly representable in the format described
+// by info.
+func (h parsedHex) exact(info *floatInfo) bool {
+	return !h.sticky && (h.mant == 0 || representable(h.mant, int(h.exp), info))
+}
+
+// representable reports whether m × 2^e, where m is nonzero, is a finite
+// number in the format described by info.
+func representable(m uint64, e int, info *floatInfo) bool {
+	tz := bits.TrailingZeros64(m)
+	m >>= uint(tz)
+	e += tz
+	n := bits.Len64(m)
+	// The lowest bit of a number may be 2^(1-bias-mantBits), the unit of a
+	// subnormal, and the highest 2^bias.
+	return n <= int(info.mantBits)+1 &&
+		e >= 1-int(info.bias)-int(info.mantBits) &&
+		e+n-1 <= int(info.bias)
+}
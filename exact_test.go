@@ -0,0 +1,161 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestParseFloat64Exact(t *testing.T) {
+	for _, tt := range []struct {
+		s       string
+		wantErr error
+	}{
+		{"0", nil},
+		{"-0.0e99999", nil},
+		{"0.5", nil},
+		{"0.1", ErrInexact},
+		{"1.25e2", nil},
+		{"9007199254740992", nil}, // 2^53
+		{"9007199254740993", ErrInexact},
+		{"1e22", nil},
+		{"1e23", ErrInexact},
+		{"725062627983360e7", nil},
+		{"4.9406564584124654e-324", ErrInexact},
+		{"1.7976931348623157e308", ErrInexact},
+		{"1e-400", ErrInexact},
+		{"1e400", strconv.ErrRange},
+		{"0x1.8p-1074", ErrInexact},
+		{"0x1p-1074", nil},
+		{"0x1.fffffffffffffp1023", nil},
+		{"0x1.00000000000000000000000001p0", ErrInexact},
+		{"0.500000000000000000000000000000", nil},
+		{"9.31322574615478515625e-10", nil}, // 2^-30
+		{"9.31322574615478515626e-10", ErrInexact},
+		{"9007199254740993.0000000000000000000", ErrInexact},
+		{"1e-40000000000000000000000", ErrInexact},
+		{"x", strconv.ErrSyntax},
+	} {
+		f, err := ParseFloat64Exact(tt.s)
+		if numErr(err) != tt.wantErr {
+			t.Errorf("ParseFloat64Exact(%q): got error %v; want %v", tt.s, err, tt.wantErr)
+		}
+		want, _ := ParseFloat64(tt.s)
+		if math.Float64bits(f) != math.Float64bits(want) {
+			t.Errorf("ParseFloat64Exact(%q): got %g; want %g", tt.s, f, want)
+		}
+	}
+	p := Parser{Specials: StrconvSpecials}
+	if _, err := p.ParseFloat64Exact("-inf"); err != nil {
+		t.Errorf("ParseFloat64Exact(-inf): %v", err)
+	}
+}
+
+func TestParseFloat32Exact(t *testing.T) {
+	for _, tt := range []struct {
+		s       string
+		wantErr error
+	}{
+		{"0.5", nil},
+		{"16777216", nil}, // 2^24
+		{"16777217", ErrInexact},
+		{"1e10", nil},
+		{"1e11", ErrInexact},
+		{"1.401298464324817e-45", ErrInexact},
+		{"0x1p-149", nil},
+		{"0x1p-150", ErrInexact},
+		{"0x1.fffffep127", nil},
+	} {
+		if _, err := ParseFloat32Exact(tt.s); numErr(err) != tt.wantErr {
+			t.Errorf("ParseFloat32Exact(%q): got error %v; want %v", tt.s, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseExactRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1e4; i++ {
+		var s string
+		switch r.Intn(3) {
+		case 0:
+			// A number with a short binary expansion.
+			f := math.Ldexp(float64(r.Int63n(1<<20)), r.Intn(60)-40)
+			s = strconv.FormatFloat(f, 'e', -1, 64)
+		case 1:
+			m := r.Int63n(1e15)
+			s = strconv.FormatInt(m, 10) + "e" + strconv.Itoa(r.Intn(60)-30)
+		case 2:
+			// The same, written out with too many digits for s2d.
+			f := math.Ldexp(float64(r.Int63n(1<<40)), r.Intn(200)-150)
+			s = new(big.Rat).SetFloat64(f).FloatString(150 + r.Intn(3))
+		}
+		x, ok := new(big.Rat).SetString(s)
+		if !ok {
+			t.Fatalf("bad test input %q", s)
+		}
+
+		f, err := ParseFloat64Exact(s)
+		want := new(big.Rat).SetFloat64(f).Cmp(x) == 0
+		if (err == nil) != want {
+			t.Fatalf("ParseFloat64Exact(%q): got error %v; want exact = %t", s, err, want)
+		}
+		f32, err := ParseFloat32Exact(s)
+		if numErr(err) == strconv.ErrRange {
+			continue
+		}
+		want = new(big.Rat).SetFloat64(float64(f32)).Cmp(x) == 0
+		if (err == nil) != want {
+			t.Fatalf("ParseFloat32Exact(%q): got error %v; want exact = %t", s, err, want)
+		}
+	}
+}
+
+func TestParseFloatRounded(t *testing.T) {
+	for _, tt := range []struct {
+		s         string
+		rounded64 bool
+		rounded32 bool
+		wantErr   error
+	}{
+		{"0", false, false, nil},
+		{"0.5", false, false, nil},
+		{"0.1", true, true, nil},
+		{"16777217", false, true, nil},
+		{"0x1.000002p0", false, false, nil},
+		{"0x1.0000001p0", false, true, nil},
+		{"1e-400", true, true, nil},
+		{"1e400", true, true, strconv.ErrRange},
+		{"123456789012345678901234567890", true, true, nil},
+		{"1267650600228229401496703205376", false, false, nil},
+		{"x", false, false, strconv.ErrSyntax},
+	} {
+		f, rounded, err := ParseFloat64Rounded(tt.s)
+		want, _ := ParseFloat64(tt.s)
+		if f != want || rounded != tt.rounded64 || numErr(err) != tt.wantErr {
+			t.Errorf("ParseFloat64Rounded(%q): got (%g, %t, %v); want (%g, %t, %v)", tt.s, f, rounded, err, want, tt.rounded64, tt.wantErr)
+		}
+		f32, rounded, err := ParseFloat32Rounded(tt.s)
+		want32, _ := ParseFloat32(tt.s)
+		if f32 != want32 || rounded != tt.rounded32 || numErr(err) != tt.wantErr {
+			t.Errorf("ParseFloat32Rounded(%q): got (%g, %t, %v); want (%g, %t, %v)", tt.s, f32, rounded, err, want32, tt.rounded32, tt.wantErr)
+		}
+	}
+	p := Parser{Specials: StrconvSpecials}
+	if _, rounded, err := p.ParseFloat64Rounded("-inf"); rounded || err != nil {
+		t.Errorf("ParseFloat64Rounded(-inf): got (%t, %v); want (false, nil)", rounded, err)
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"math"
+	"strconv"
+)
+
+// FormatFloat64Exp converts the 64-bit floating point number f to a string
+// in scientific notation with prec digits after the decimal point, that is,
+// with prec+1 significant digits, rounding to nearest with ties to even. It
+// is the equivalent of calling strconv.FormatFloat(f, 'e', prec, 64). A
+// negative prec is treated as 0.
+func FormatFloat64Exp(f float64, prec int) string {
+	b := make([]byte, 0, 24+prec)
+	return unsafeString(AppendFloat64Exp(b, f, prec))
+}
+
+// AppendFloat64Exp appends the string form of the 64-bit floating point
+// number f with prec digits after the decimal point, as generated by
+// FormatFloat64Exp, to b and returns the extended buffer.
+func AppendFloat64Exp(b []byte, f float64, prec int) []byte {
+	return appendFloat64Exp(b, f, prec, ToNearestEven)
+}
+
+func appendFloat64Exp(b []byte, f float64, prec int, mode RoundingMode) []byte {
+	if prec < 0 {
+		prec = 0
+	}
+	u := math.Float64bits(f)
+	neg := u>>(mantBits64+expBits64) != 0
+	mant := u & (uint64(1)<<mantBits64 - 1)
+	exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1)
+	if exp == uint64(1)<<expBits64-1 {
+		return appendSpecial(b, neg, false, mant == 0)
+	}
+	if neg {
+		b = append(b, '-')
+	}
+	if exp == 0 && mant == 0 {
+		b = append(b, '0')
+		if prec > 0 {
+			b = append(b, '.')
+		}
+		b = appendZeros(b, prec)
+		return append(b, "e+00"...)
+	}
+	m2, e2 := unpack64(mant, exp)
+	return appendExp64(b, m2, e2, prec, neg, mode)
+}
+
+// appendExp64 appends m2 × 2^e2, which is positive, in scientific notation
+// with prec digits after the decimal point, rounded according to mode for a
+// number with the sign neg.
+func appendExp64(b []byte, m2 uint64, e2 int32, prec int, neg bool, mode RoundingMode) []byte {
+	start := len(b)
+	b, exp := appendExpDigits(b, m2, e2, prec+1, neg, mode)
+	if prec > 0 {
+		b = insertByte(b, start+1, '.')
+	}
+	return appendExponent(b, int32(exp))
+}
+
+// appendExpDigits appends the first want significant digits of m2 × 2^e2,
+// which is positive, rounded according to mode for a number with the sign
+// neg, and returns the decimal exponent of the first digit. This is upstream
+// Ryu's d2exp. It computes 9 digits at a time like appendFixed64, starting
+// from the most significant nonzero block, until it has want digits.
+func appendExpDigits(b []byte, m2 uint64, e2 int32, want int, neg bool, mode RoundingMode) ([]byte, int) {
+	start := len(b)
+	var (
+		printed   int    // significant digits appended so far
+		digits    uint32 // the block that did not fit
+		avail     int    // the number of digits in that block
+		exp       int    // the decimal exponent of the first digit
+		lastDigit uint32 // the digit after the last one printed
+		cut       bool   // whether lastDigit is known
+	)
+	switch {
+	case e2 >= 64-mantBits64:
+		idx := (e2 + 15) / 16
+		j := 16*idx + fixedAdditionalBits - e2
+		off := int(pow10OffsetFixed[idx])
+		for i := int(pow10OffsetFixed[idx+1]) - off - 1; i >= 0; i-- {
+			digits = mulShiftMod1e9(m2<<8, &pow10SplitFixed[off+i], j+8)
+			if printed != 0 {
+				if printed+9 > want {
+					avail = 9
+					break
+				}
+				b = appendDigits(b, digits, 9)
+				printed += 9
+			} else if digits != 0 {
+				avail = decimalLen32(digits)
+				exp = 9*i + avail - 1
+				if avail > want {
+					break
+				}
+				b = appendDigits(b, digits, avail)
+				printed = avail
+				avail = 0
+			}
+		}
+	case e2 >= -mantBits64:
+		// The integer part fits in a uint64 and is printed directly.
+		var ip uint64
+		if e2 >= 0 {
+			ip = m2 << uint(e2)
+		} else {
+			ip = m2 >> uint(-e2)
+		}
+		if ip != 0 {
+			b = strconv.AppendUint(b, ip, 10)
+			printed = len(b) - start
+			exp = printed - 1
+			if printed > want {
+				lastDigit = uint32(b[start+want] - '0')
+				b = b[:start+want]
+				printed = want
+				cut = true
+			}
+		}
+	}
+	if e2 < 0 && avail == 0 && !cut {
+		idx := -e2 / 16
+		j := fixedAdditionalBits + (-e2 - 16*idx)
+		minBlock := int(minBlock2Fixed[idx])
+		for i := minBlock; ; i++ {
+			p := int(pow10Offset2Fixed[idx]) + i - minBlock
+			if p >= int(pow10Offset2Fixed[idx+1]) {
+				// The remaining digits are all zero.
+				break
+			}
+			digits = mulShiftMod1e9(m2<<8, &pow10Split2Fixed[p], j+8)
+			if printed != 0 {
+				if printed+9 > want {
+					avail = 9
+					break
+				}
+				b = appendDigits(b, digits, 9)
+				printed += 9
+			} else if digits != 0 {
+				avail = decimalLen32(digits)
+				exp = -9*(i+1) + avail - 1
+				if avail > want {
+					break
+				}
+				b = appendDigits(b, digits, avail)
+				printed = avail
+				avail = 0
+			}
+		}
+	}
+
+	// Append the leading digits of the last block and round using the
+	// digit after them.
+	n := want - printed
+	if avail > n {
+		digits /= uint32(powersOf10[avail-n-1])
+		lastDigit = digits % 10
+		digits /= 10
+	}
+	// Nothing follows lastDigit if m2 × 2^e2 × 10^(want-exp) is an
+	// integer. This only matters if lastDigit is 0 or 5.
+	exact := true
+	if lastDigit%5 == 0 {
+		rexp := want - exp
+		requiredTwos := -int(e2) - rexp
+		exact = requiredTwos <= 0 ||
+			requiredTwos < 60 && multipleOfPowerOfTwo64(m2, uint32(requiredTwos))
+		if rexp < 0 {
+			exact = exact && multipleOfPowerOfFive64(m2, uint32(-rexp))
+		}
+	}
+	roundUp := mode.roundDigits(neg, lastDigit, exact)
+	if avail == 0 {
+		b = appendZeros(b, n)
+	} else {
+		b = appendDigits(b, digits, n)
+	}
+	if roundUp != 0 {
+		// A carry out of the leading digit gives 10...0, which is
+		// 1.0...0 with the next exponent.
+		end := len(b)
+		if b = roundUpDecimal(b, start, roundUp == 2); len(b) > end {
+			b = b[:end]
+			exp++
+		}
+	}
+
+	return b, exp
+}
+
+// insertByte inserts c at b[i].
+func insertByte(b []byte, i int, c byte) []byte {
+	b = append(b, 0)
+	copy(b[i+1:], b[i:])
+	b[i] = c
+	return b
+}
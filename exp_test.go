@@ -0,0 +1,123 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestFormatFloat64Exp(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		prec int
+		want string
+	}{
+		{0, 0, "0e+00"},
+		{0, 3, "0.000e+00"},
+		{math.Copysign(0, -1), 1, "-0.0e+00"},
+		{1, 0, "1e+00"},
+		{1, 5, "1.00000e+00"},
+		{125, 1, "1.2e+02"},
+		{135, 1, "1.4e+02"},
+		{-2.5, 0, "-2e+00"},
+		{9.5, 0, "1e+01"},
+		{-99.96, 2, "-1.00e+02"},
+		{0.1, 20, "1.00000000000000005551e-01"},
+		{1e23, 5, "1.00000e+23"},
+		{1e23, 16, "9.9999999999999992e+22"},
+		{1e23, 30, "9.999999999999999161139200000000e+22"},
+		{123456789012345680000, 0, "1e+20"},
+		{math.MaxFloat64, 16, "1.7976931348623157e+308"},
+		{5e-324, 0, "5e-324"},
+		{5e-324, 3, "4.941e-324"},
+		{2.2250738585072014e-308, 4, "2.2251e-308"},
+		{1.5, -1, "2e+00"},
+		{math.Inf(1), 2, "+Inf"},
+		{math.Inf(-1), 2, "-Inf"},
+		{math.NaN(), 2, "NaN"},
+	} {
+		if got := FormatFloat64Exp(tt.f, tt.prec); got != tt.want {
+			t.Errorf("FormatFloat64Exp(%b, %d): got %q; want %q", tt.f, tt.prec, got, tt.want)
+		}
+	}
+	if got := string(AppendFloat64Exp([]byte("x="), 9.96, 1)); got != "x=1.0e+01" {
+		t.Errorf("AppendFloat64Exp(\"x=\", 9.96, 1): got %q; want \"x=1.0e+01\"", got)
+	}
+}
+
+func TestFormatFloat64ExpRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 1000000
+	if testing.Short() {
+		n = 100000
+	}
+	for i := 0; i < n; i++ {
+		var f float64
+		var prec int
+		switch i % 4 {
+		case 0:
+			// Any float64.
+			f = math.Float64frombits(r.Uint64())
+			prec = r.Intn(25)
+		case 1:
+			// A number of moderate size.
+			f = r.NormFloat64() * math.Pow(10, float64(r.Intn(40)-20))
+			prec = r.Intn(25)
+		case 2:
+			// A short binary number, which is often a tie.
+			f = math.Ldexp(float64(r.Int63n(1<<20)), r.Intn(80)-40)
+			prec = r.Intn(8)
+		case 3:
+			// All the digits.
+			f = math.Float64frombits(r.Uint64())
+			prec = r.Intn(800)
+		}
+		got := FormatFloat64Exp(f, prec)
+		want := strconv.FormatFloat(f, 'e', prec, 64)
+		if got != want {
+			t.Fatalf("FormatFloat64Exp(%b, %d): got %q; want %q", f, prec, got, want)
+		}
+	}
+}
+
+func BenchmarkAppendFloat64Exp(b *testing.B) {
+	buf := make([]byte, 0, 400)
+	for _, bc := range fixedBenchCases {
+		name := strconv.FormatFloat(bc.f, 'g', -1, 64) + "/" + strconv.Itoa(bc.prec)
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkb = AppendFloat64Exp(buf[:0], bc.f, bc.prec)
+			}
+		})
+	}
+}
+
+func BenchmarkStrconvAppendFloat64Exp(b *testing.B) {
+	buf := make([]byte, 0, 400)
+	for _, bc := range fixedBenchCases {
+		name := strconv.FormatFloat(bc.f, 'g', -1, 64) + "/" + strconv.Itoa(bc.prec)
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkb = strconv.AppendFloat(buf[:0], bc.f, 'e', bc.prec, 64)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "math"
+
+// convert is like toBits but first tries the faster algorithms that apply
+// to the shape of d, all of which round to nearest, ties to even. Small
+// numbers such as 1.5 and 12345e-3 are exact in floating-point arithmetic;
+// most others are converted by the Eisel–Lemire algorithm, leaving Ryu for
+// the rare cases it cannot decide, subnormals, and the other rounding modes.
+func (d parsedDecimal) convert(info *floatInfo, mode RoundingMode) uint64 {
+	if mode != ToNearestEven {
+		return d.toBits(info, mode)
+	}
+	if u, ok := d.exactFloat(info); ok {
+		return u
+	}
+	// Eisel–Lemire gives up on subnormal results, so leave numbers less
+	// than 10^-(floor(log10(2^(bias-1)))+1), which are surely subnormal,
+	// to Ryu directly.
+	if d.digits+d.e10 > -int32(log10Pow2(info.bias-1))-1 {
+		if u, ok := d.eiselLemire(info); ok {
+			return u
+		}
+	}
+	return d.toBits(info, mode)
+}
+
+// float64pow10 and float32pow10 are the powers of 10 that are exactly
+// representable as a float64 and a float32.
+var (
+	float64pow10 = [...]float64{
+		1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+		1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+		1e20, 1e21, 1e22,
+	}
+	float32pow10 = [...]float32{1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10}
+)
+
+// exactFloat converts d with a single floating-point multiplication or
+// division when m10 and 10^e10 are both exactly representable, in which case
+// the operation rounds correctly (Clinger's fast path). It applies only to
+// float64 and float32.
+func (d parsedDecimal) exactFloat(info *floatInfo) (u uint64, ok bool) {
+	switch info {
+	case &float64info:
+		if d.m10 >= 1<<53 {
+			return 0, false
+		}
+		f := float64(d.m10)
+		if d.neg {
+			f = -f
+		}
+		e := int(d.e10)
+		switch {
+		case e < 0 && e >= -22:
+			f /= float64pow10[-e]
+		case e > 22 && e <= 22+15:
+			// Move some of the zeros into the integer part if it stays
+			// exact, as for 1e30.
+			f *= float64pow10[e-22]
+			if f > 1e15 || f < -1e15 {
+				return 0, false
+			}
+			f *= 1e22
+		case e >= 0 && e <= 22:
+			f *= float64pow10[e]
+		default:
+			return 0, false
+		}
+		return math.Float64bits(f), true
+	case &float32info:
+		if d.m10 >= 1<<24 {
+			return 0, false
+		}
+		f := float32(d.m10)
+		if d.neg {
+			f = -f
+		}
+		e := int(d.e10)
+		switch {
+		case e < 0 && e >= -10:
+			f /= float32pow10[-e]
+		case e > 10 && e <= 10+7:
+			f *= float32pow10[e-10]
+			if f > 1e7 || f < -1e7 {
+				return 0, false
+			}
+			f *= 1e10
+		case e >= 0 && e <= 10:
+			f *= float32pow10[e]
+		default:
+			return 0, false
+		}
+		return uint64(math.Float32bits(f)), true
+	}
+	return 0, false
+}
@@ -0,0 +1,128 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var fastPathInfos = []struct {
+	name string
+	info *floatInfo
+}{
+	{"float64", &float64info},
+	{"float32", &float32info},
+	{"float16", &float16info},
+	{"bfloat16", &bfloat16info},
+}
+
+// TestFastPaths checks that the fast paths, when they apply, agree with Ryu.
+func TestFastPaths(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 1000000
+	if testing.Short() {
+		n = 100000
+	}
+	for _, fi := range fastPathInfos {
+		var exact, el int
+		for i := 0; i < n; i++ {
+			d := randomParsedDecimal(r, fi.info)
+			want := d.toBits(fi.info, ToNearestEven)
+			if u, ok := d.exactFloat(fi.info); ok {
+				exact++
+				if u != want {
+					t.Fatalf("%s: exactFloat(%+v) = %#x; want %#x", fi.name, d, u, want)
+				}
+			}
+			if u, ok := d.eiselLemire(fi.info); ok {
+				el++
+				if u != want {
+					t.Fatalf("%s: eiselLemire(%+v) = %#x; want %#x", fi.name, d, u, want)
+				}
+			}
+		}
+		t.Logf("%s: exactFloat %d, eiselLemire %d of %d", fi.name, exact, el, n)
+	}
+}
+
+// randomParsedDecimal returns a number of up to 17 digits whose exponent is
+// usually within the range of info. Half of them are halfway between two
+// numbers of the format or near it, which the fast paths must get right or
+// decline.
+func randomParsedDecimal(r *rand.Rand, info *floatInfo) parsedDecimal {
+	var d parsedDecimal
+	d.neg = r.Intn(2) == 0
+	if r.Intn(2) == 0 {
+		digits := 1 + r.Intn(maxParseDigits)
+		d.m10 = 1 + uint64(r.Int63n(int64(powersOf10[digits]-1)))
+		lo, hi := int(info.minExp10)-digits, int(info.maxExp10)
+		d.e10 = int32(lo + r.Intn(hi-lo+1))
+	} else {
+		// Build the midpoint (2m+1) × 2^-k of a random significand m
+		// with mantBits+1 bits, written exactly as the decimal
+		// (2m+1) × 5^k × 10^-k.
+		m := uint64(1)<<info.mantBits | uint64(r.Int63n(1<<info.mantBits))
+		mid := 2*m + 1
+		for mid <= powersOf10[maxParseDigits]/5 && r.Intn(8) != 0 {
+			mid *= 5
+			d.e10--
+		}
+		for mid%10 == 0 {
+			mid /= 10
+			d.e10++
+		}
+		d.m10 = mid
+		if r.Intn(2) == 0 {
+			// Just above or below the midpoint.
+			d.m10 = d.m10*10 + uint64(r.Intn(2)*18-9)
+			d.e10--
+			if d.m10 >= powersOf10[maxParseDigits] {
+				d.m10 /= 10
+				d.e10++
+			}
+		}
+	}
+	d.digits = int32(decimalLen64(d.m10))
+	return d
+}
+
+func BenchmarkConvert(b *testing.B) {
+	for _, s := range []string{
+		"1.5",
+		"3.141592653589793",
+		"1.7976931348623157e308",
+		"4.9406564584124654e-300",
+		"6.226662346353213e-309",
+	} {
+		d, _, _ := stdParser.scanDecimal(s)
+		b.Run(s, func(b *testing.B) {
+			b.Run("Ryu", func(b *testing.B) {
+				var u uint64
+				for i := 0; i < b.N; i++ {
+					u += d.toBits(&float64info, ToNearestEven)
+				}
+				bitsSink = u
+			})
+			b.Run("Fast", func(b *testing.B) {
+				var u uint64
+				for i := 0; i < b.N; i++ {
+					u += d.convert(&float64info, ToNearestEven)
+				}
+				bitsSink = u
+			})
+		})
+	}
+}
+
+var bitsSink uint64
@@ -0,0 +1,280 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+// FormatFloat64Fixed converts the 64-bit floating point number f to a string
+// in decimal notation with prec digits after the decimal point, rounding to
+// nearest with ties to even. It is the equivalent of calling
+// strconv.FormatFloat(f, 'f', prec, 64). A negative prec is treated as 0.
+//
+// This makes it suitable for amounts of money at a fixed scale: the output
+// never has an exponent, and it is the exact binary value of f that is
+// rounded, with banker's rounding of true ties such as 0.125. A decimal
+// literal that is not exactly representable rounds according to the float64
+// it became: 2.675, stored as 2.67499999999999982..., gives "2.67" for prec
+// 2. Formatter.FormatFloat64Fixed offers the other rounding modes.
+func FormatFloat64Fixed(f float64, prec int) string {
+	b := make([]byte, 0, 24+prec)
+	return unsafeString(AppendFloat64Fixed(b, f, prec))
+}
+
+// AppendFloat64Fixed appends the string form of the 64-bit floating point
+// number f with prec digits after the decimal point, as generated by
+// FormatFloat64Fixed, to b and returns the extended buffer.
+func AppendFloat64Fixed(b []byte, f float64, prec int) []byte {
+	return appendFloat64Fixed(b, f, prec, ToNearestEven)
+}
+
+func appendFloat64Fixed(b []byte, f float64, prec int, mode RoundingMode) []byte {
+	if prec < 0 {
+		prec = 0
+	}
+	u := math.Float64bits(f)
+	neg := u>>(mantBits64+expBits64) != 0
+	mant := u & (uint64(1)<<mantBits64 - 1)
+	exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1)
+	if exp == uint64(1)<<expBits64-1 {
+		return appendSpecial(b, neg, false, mant == 0)
+	}
+	if neg {
+		b = append(b, '-')
+	}
+	if exp == 0 && mant == 0 {
+		b = append(b, '0')
+		if prec > 0 {
+			b = append(b, '.')
+		}
+		return appendZeros(b, prec)
+	}
+	m2, e2 := unpack64(mant, exp)
+	return appendFixed64(b, m2, e2, prec, neg, mode)
+}
+
+// unpack64 returns m2 and e2 such that the finite float64 with the given
+// mantissa and biased exponent has magnitude m2 × 2^e2.
+func unpack64(mant, exp uint64) (m2 uint64, e2 int32) {
+	if exp == 0 {
+		return mant, 1 - bias64 - mantBits64
+	}
+	return uint64(1)<<mantBits64 | mant, int32(exp) - bias64 - mantBits64
+}
+
+// appendFixed64 appends m2 × 2^e2, which is positive, with prec digits after
+// the decimal point, rounded according to mode for a number with the sign
+// neg. This is upstream Ryu's d2fixed: each block of 9 digits is computed
+// independently from m2 and a 192-bit multiplier, so only the requested
+// digits are ever generated.
+func appendFixed64(b []byte, m2 uint64, e2 int32, prec int, neg bool, mode RoundingMode) []byte {
+	start := len(b)
+
+	// Print the integer part. If it fits in a uint64, it is printed
+	// directly. Otherwise it has at least 20 digits, which are computed
+	// 9 at a time from the most significant block down.
+	switch {
+	case e2 >= 64-mantBits64:
+		idx := (e2 + 15) / 16
+		j := 16*idx + fixedAdditionalBits - e2
+		off := int(pow10OffsetFixed[idx])
+		nonzero := false
+		for i := int(pow10OffsetFixed[idx+1]) - off - 1; i >= 0; i-- {
+			digits := mulShiftMod1e9(m2<<8, &pow10SplitFixed[off+i], j+8)
+			if nonzero {
+				b = appendDigits(b, digits, 9)
+			} else if digits != 0 {
+				b = appendDigits(b, digits, decimalLen32(digits))
+				nonzero = true
+			}
+		}
+	case e2 >= 0:
+		b = strconv.AppendUint(b, m2<<uint(e2), 10)
+	case e2 >= -mantBits64:
+		b = strconv.AppendUint(b, m2>>uint(-e2), 10)
+	default:
+		b = append(b, '0')
+	}
+	if prec > 0 {
+		b = append(b, '.')
+	}
+	if e2 >= 0 {
+		return appendZeros(b, prec)
+	}
+
+	// Print the fractional part, 9 digits at a time. The digit after the
+	// last one printed decides the rounding.
+	idx := -e2 / 16
+	j := fixedAdditionalBits + (-e2 - 16*idx)
+	blocks := prec/9 + 1
+	minBlock := int(minBlock2Fixed[idx])
+	if blocks <= minBlock {
+		// The digits printed and the one after them are all zero, but
+		// the number is not.
+		b = appendZeros(b, prec)
+		if mode.roundDigits(neg, 0, false) != 0 {
+			b = roundUpDecimal(b, start, false)
+		}
+		return b
+	}
+	b = appendZeros(b, 9*minBlock)
+	roundUp := 0
+	for i := minBlock; i < blocks; i++ {
+		p := int(pow10Offset2Fixed[idx]) + i - minBlock
+		if p >= int(pow10Offset2Fixed[idx+1]) {
+			// The remaining digits are all zero.
+			return appendZeros(b, prec-9*i)
+		}
+		digits := mulShiftMod1e9(m2<<8, &pow10Split2Fixed[p], j+8)
+		if i < blocks-1 {
+			b = appendDigits(b, digits, 9)
+			continue
+		}
+		n := prec - 9*i
+		var lastDigit uint32
+		if k := 9 - n; k > 0 {
+			digits /= uint32(powersOf10[k-1])
+			lastDigit = digits % 10
+			digits /= 10
+		}
+		// Nothing follows lastDigit if m2 × 2^e2 × 10^(prec+1) is an
+		// integer. This only matters if lastDigit is 0 or 5.
+		exact := true
+		if lastDigit%5 == 0 {
+			requiredTwos := -int(e2) - prec - 1
+			exact = requiredTwos <= 0 ||
+				requiredTwos < 60 && multipleOfPowerOfTwo64(m2, uint32(requiredTwos))
+		}
+		roundUp = mode.roundDigits(neg, lastDigit, exact)
+		b = appendDigits(b, digits, n)
+	}
+	if roundUp != 0 {
+		b = roundUpDecimal(b, start, roundUp == 2)
+	}
+	return b
+}
+
+// roundDigits reports how m rounds the digits of a number with the sign neg
+// that are kept when the rest are cut off: 0 to leave them, 1 to add one unit
+// in the last place, or 2 to add one only if the last digit is odd. lastDigit
+// is the first digit cut off and exact reports whether those after it are
+// all zero.
+func (m RoundingMode) roundDigits(neg bool, lastDigit uint32, exact bool) int {
+	switch m {
+	case ToNearestEven:
+		if lastDigit == 5 && exact {
+			return 2
+		}
+		return boolToInt(lastDigit >= 5)
+	case ToNearestAway:
+		return boolToInt(lastDigit >= 5)
+	}
+	return boolToInt((lastDigit != 0 || !exact) && m.away(neg))
+}
+
+// roundUpDecimal adds one unit in the last place to the nonnegative decimal
+// number in b[start:], unless halfEven is set and the last digit is even. A
+// carry out of the leading digit lengthens the number by one digit.
+func roundUpDecimal(b []byte, start int, halfEven bool) []byte {
+	dot := -1
+	for i := len(b) - 1; i >= start; i-- {
+		switch c := b[i]; c {
+		case '.':
+			dot = i
+		case '9':
+			b[i] = '0'
+			halfEven = false
+		default:
+			if !halfEven || (c-'0')%2 != 0 {
+				b[i] = c + 1
+			}
+			return b
+		}
+	}
+	// Every digit was a 9 and is now a 0.
+	b[start] = '1'
+	if dot >= 0 {
+		b[dot] = '0'
+		b[dot+1] = '.'
+	}
+	return append(b, '0')
+}
+
+// mulShiftMod1e9 returns floor(m × mul / 2^j) mod 10^9, where mul is a
+// 192-bit number stored low word first and j is in [128, 180].
+func mulShiftMod1e9(m uint64, mul *[3]uint64, j int32) uint32 {
+	high0, _ := bits.Mul64(m, mul[0])
+	high1, low1 := bits.Mul64(m, mul[1])
+	high2, low2 := bits.Mul64(m, mul[2])
+	_, c := bits.Add64(low1, high0, 0)
+	lo, c := bits.Add64(low2, high1, c)
+	hi := high2 + c
+	// {lo, hi} is the product shifted right by 128 bits.
+	v := uint128{lo: shiftRight128(uint128{lo: lo, hi: hi}, j-128), hi: hi >> uint(j-128)}
+	return uint128Mod1e9(v)
+}
+
+// uint128Mod1e9 returns v mod 10^9.
+func uint128Mod1e9(v uint128) uint32 {
+	// v / 10^9 is computed by multiplying with a 128-bit reciprocal and
+	// shifting right by 29. Only the low 32 bits of the quotient are
+	// needed, so only the low 64 bits of the high half of the product are
+	// computed.
+	q := umul256Hi128Lo64(v.hi, v.lo, 0x89705F4136B4A597, 0x31680A88F8953031)
+	return uint32(v.lo) - 1e9*uint32(q>>29)
+}
+
+// umul256Hi128Lo64 returns bits 128 through 191 of the 256-bit product of
+// the 128-bit numbers {aLo, aHi} and {bLo, bHi}.
+func umul256Hi128Lo64(aHi, aLo, bHi, bLo uint64) uint64 {
+	b00Hi, _ := bits.Mul64(aLo, bLo)
+	b01Hi, b01Lo := bits.Mul64(aLo, bHi)
+	b10Hi, b10Lo := bits.Mul64(aHi, bLo)
+	b11Lo := aHi * bHi
+	mid1Lo, c := bits.Add64(b10Lo, b00Hi, 0)
+	mid1Hi := b10Hi + c
+	_, c = bits.Add64(b01Lo, mid1Lo, 0)
+	mid2Hi := b01Hi + c
+	return b11Lo + mid1Hi + mid2Hi
+}
+
+// appendDigits appends the n low-order decimal digits of v, including any
+// leading zeros.
+func appendDigits(b []byte, v uint32, n int) []byte {
+	i := len(b)
+	b = appendZeros(b, n)
+	for k := len(b) - 1; k >= i; k-- {
+		b[k] = '0' + byte(v%10)
+		v /= 10
+	}
+	return b
+}
+
+const zeros = "00000000000000000000000000000000"
+
+// appendZeros appends n '0' digits.
+func appendZeros(b []byte, n int) []byte {
+	for n > len(zeros) {
+		b = append(b, zeros...)
+		n -= len(zeros)
+	}
+	return append(b, zeros[:n]...)
+}
@@ -0,0 +1,158 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestFormatFloat64Fixed(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		prec int
+		want string
+	}{
+		{0, 0, "0"},
+		{0, 3, "0.000"},
+		{math.Copysign(0, -1), 2, "-0.00"},
+		{1, 0, "1"},
+		{0.5, 0, "0"},
+		{1.5, 0, "2"},
+		{2.5, 0, "2"},
+		{-2.5, 0, "-2"},
+		{0.125, 2, "0.12"},
+		{0.375, 2, "0.38"},
+		{0.1, 20, "0.10000000000000000555"},
+		{9.995, 2, "9.99"}, // 9.995 is slightly below 9.995
+		{9.996, 2, "10.00"},
+		{2.675, 2, "2.67"},
+		{1e20, 2, "100000000000000000000.00"},
+		{-99.96, 1, "-100.0"},
+		{999999999.5, 0, "1000000000"},
+		{123456789012345680000, 1, "123456789012345683968.0"},
+		{1e23, 0, "99999999999999991611392"},
+		{math.MaxFloat64, 0, "179769313486231570814527423731704356798070567525844996598917476803157260780028538760589558632766878171540458953514382464234321326889464182768467546703537516986049910576551282076245490090389328944075868508455133942304583236903222948165808559332123348274797826204144723168738177180919299881250404026184124858368"},
+		{5e-324, 3, "0.000"},
+		{1e-5, 5, "0.00001"},
+		{4e-6, 5, "0.00000"},
+		{6e-6, 5, "0.00001"},
+		{1.5, -1, "2"},
+		{math.Inf(1), 2, "+Inf"},
+		{math.Inf(-1), 2, "-Inf"},
+		{math.NaN(), 2, "NaN"},
+	} {
+		if got := FormatFloat64Fixed(tt.f, tt.prec); got != tt.want {
+			t.Errorf("FormatFloat64Fixed(%b, %d): got %q; want %q", tt.f, tt.prec, got, tt.want)
+		}
+	}
+	// The smallest subnormal has 1074 digits after the point.
+	want := strconv.FormatFloat(5e-324, 'f', 1074, 64)
+	if got := FormatFloat64Fixed(5e-324, 1074); got != want {
+		t.Errorf("FormatFloat64Fixed(5e-324, 1074): got %q; want %q", got, want)
+	}
+	if got := string(AppendFloat64Fixed([]byte("x="), -9.96, 1)); got != "x=-10.0" {
+		t.Errorf("AppendFloat64Fixed(\"x=\", -9.96, 1): got %q; want \"x=-10.0\"", got)
+	}
+}
+
+func TestFormatFloat64FixedRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 1000000
+	if testing.Short() {
+		n = 100000
+	}
+	for i := 0; i < n; i++ {
+		var f float64
+		var prec int
+		switch i % 4 {
+		case 0:
+			// Any float64.
+			f = math.Float64frombits(r.Uint64())
+			prec = r.Intn(30)
+		case 1:
+			// A number of moderate size.
+			f = r.NormFloat64() * math.Pow(10, float64(r.Intn(40)-20))
+			prec = r.Intn(30)
+		case 2:
+			// A short binary fraction, which is often a tie.
+			f = float64(r.Int63n(1<<20)) / float64(int64(1)<<uint(r.Intn(30)))
+			prec = r.Intn(12)
+		case 3:
+			// All the digits.
+			f = math.Float64frombits(r.Uint64())
+			prec = r.Intn(1100)
+		}
+		got := FormatFloat64Fixed(f, prec)
+		want := strconv.FormatFloat(f, 'f', prec, 64)
+		if got != want {
+			t.Fatalf("FormatFloat64Fixed(%b, %d): got %q; want %q", f, prec, got, want)
+		}
+	}
+}
+
+func TestUint128Mod1e9(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		v := uint128{lo: r.Uint64(), hi: r.Uint64() >> uint(r.Intn(64))}
+		x := new(big.Int).SetUint64(v.hi)
+		x.Lsh(x, 64).Add(x, new(big.Int).SetUint64(v.lo))
+		want := x.Mod(x, big.NewInt(1e9)).Uint64()
+		if got := uint128Mod1e9(v); uint64(got) != want {
+			t.Fatalf("uint128Mod1e9(%v): got %d; want %d", v, got, want)
+		}
+	}
+}
+
+var fixedBenchCases = []struct {
+	f    float64
+	prec int
+}{
+	{1, 2},
+	{0.3, 2},
+	{-1234.5678, 2},
+	{1e6, 6},
+	{6.226662346353213e-309, 17},
+}
+
+func BenchmarkAppendFloat64Fixed(b *testing.B) {
+	buf := make([]byte, 0, 400)
+	for _, bc := range fixedBenchCases {
+		name := strconv.FormatFloat(bc.f, 'g', -1, 64) + "/" + strconv.Itoa(bc.prec)
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkb = AppendFloat64Fixed(buf[:0], bc.f, bc.prec)
+			}
+		})
+	}
+}
+
+func BenchmarkStrconvAppendFloat64Fixed(b *testing.B) {
+	buf := make([]byte, 0, 400)
+	for _, bc := range fixedBenchCases {
+		name := strconv.FormatFloat(bc.f, 'g', -1, 64) + "/" + strconv.Itoa(bc.prec)
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sinkb = strconv.AppendFloat(buf[:0], bc.f, 'f', bc.prec, 64)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import "math/bits"
+
+const (
+	mantBits128 = 112
+	expBits128  = 15
+	bias128     = 16383
+)
+
+// FormatFloat128 converts the IEEE 754 binary128 (quadruple precision) number
+// whose bits are hi<<64 | lo, as stored by C's __float128 or Fortran's
+// real(16) on little-endian machines in the order lo, hi, to the shortest
+// string that identifies it among the binary128 numbers. The format is that
+// of FormatFloat64 with exponents of up to 4 digits, as in "1e-01" for the
+// binary128 number nearest 0.1 or "1.189731495357231765085759326628007e+4932"
+// for the largest one.
+//
+// It uses the generalized form of Ryu that upstream applies to the 80- and
+// 128-bit formats, with 256-bit table entries, so it is slower than
+// FormatFloat64.
+func FormatFloat128(hi, lo uint64) string {
+	b := make([]byte, 0, 48)
+	return unsafeString(AppendFloat128(b, hi, lo))
+}
+
+// AppendFloat128 appends the string form of the binary128 number whose bits
+// are hi<<64 | lo, as generated by FormatFloat128, to b and returns the
+// extended buffer.
+func AppendFloat128(b []byte, hi, lo uint64) []byte {
+	neg := hi>>(mantBits128+expBits128-64) != 0
+	mant := uint128{lo: lo, hi: hi & (uint64(1)<<(mantBits128-64) - 1)}
+	exp := uint32(hi>>(mantBits128-64)) & (1<<expBits128 - 1)
+	mantZero := mant == uint128{}
+	if exp == 1<<expBits128-1 || (exp == 0 && mantZero) {
+		return appendSpecial(b, neg, exp == 0, mantZero)
+	}
+	// As in float64ToDecimal, e2 has 2 extra bits for the bounds.
+	var e2 int32
+	m2 := mant
+	if exp == 0 {
+		e2 = 1 - bias128 - mantBits128 - 2
+	} else {
+		e2 = int32(exp) - bias128 - mantBits128 - 2
+		m2.hi |= 1 << (mantBits128 - 64)
+	}
+	mmShift := boolToUint64(!mantZero || exp <= 1)
+	return toDecimal128(m2, e2, mmShift).append(b, neg)
+}
+
+// dec128 is a floating decimal type representing m * 10^e.
+type dec128 struct {
+	m uint128
+	e int32
+}
+
+func (d dec128) append(b []byte, neg bool) []byte {
+	if neg {
+		b = append(b, '-')
+	}
+
+	// A binary128 number has at most 36 significant digits.
+	var buf [40]byte
+	i := len(buf)
+	for out := d.m; out != (uint128{}); {
+		var digit uint64
+		out, digit = divMod128(out, 10)
+		i--
+		buf[i] = '0' + byte(digit)
+	}
+	digits := buf[i:]
+	b = append(b, digits[0])
+	if len(digits) > 1 {
+		b = append(b, '.')
+		b = append(b, digits[1:]...)
+	}
+
+	// Print the exponent with at least two digits, as FormatFloat64 does.
+	b = append(b, 'e')
+	exp := d.e + int32(len(digits)) - 1
+	if exp < 0 {
+		b = append(b, '-')
+		exp = -exp
+	} else {
+		b = append(b, '+')
+	}
+	if exp >= 1000 {
+		b = append(b, '0'+byte(exp/1000))
+	}
+	if exp >= 100 {
+		b = append(b, '0'+byte(exp/100%10))
+	}
+	return append(b, '0'+byte(exp/10%10), '0'+byte(exp%10))
+}
+
+// toDecimal128 runs steps 2-4 of Ryu on the value m2 * 2^e2, with e2 and
+// mmShift as for toDecimal64, using 128-bit decimal intermediates and the
+// 256-bit tables. This is upstream's generic_binary_to_decimal: unlike
+// toDecimal64, it has no specialized loop for the common case.
+func toDecimal128(m2 uint128, e2 int32, mmShift uint64) dec128 {
+	even := m2.lo&1 == 0
+	acceptBounds := even
+
+	// Step 2: Determine the interval of valid decimal representations.
+	mv := shiftLeft128(m2, 2)
+	mp := add128(mv, 2)
+	mm := sub128(mv, 1+mmShift)
+
+	// Step 3: Convert to a decimal power base using 256-bit arithmetic.
+	var (
+		vr, vp, vm        uint128
+		e10               int32
+		vmIsTrailingZeros bool
+		vrIsTrailingZeros bool
+	)
+	if e2 >= 0 {
+		q := log10Pow2Long(e2) - boolToUint32(e2 > 3)
+		e10 = int32(q)
+		k := pow5InvNumBits128 + pow5BitsLong(int32(q)) - 1
+		i := -e2 + int32(q) + k
+		mul := &pow5InvSplit128[q]
+		vr = mulShift128(mv, mul, i)
+		vp = mulShift128(mp, mul, i)
+		vm = mulShift128(mm, mul, i)
+		// 5^55 is more than 4*m2 can be, so vr, vp, and vm are never
+		// exact beyond that.
+		if q <= 55 {
+			// Only one of mp, mv, and mm can be a multiple of 5, if any.
+			if mod128(mv, 5) == 0 {
+				vrIsTrailingZeros = multipleOfPowerOfFive128(mv, q)
+			} else if acceptBounds {
+				vmIsTrailingZeros = multipleOfPowerOfFive128(mm, q)
+			} else if multipleOfPowerOfFive128(mp, q) {
+				vp = sub128(vp, 1)
+			}
+		}
+	} else {
+		q := log10Pow5Long(-e2) - boolToUint32(-e2 > 1)
+		e10 = int32(q) + e2
+		i := -e2 - int32(q)
+		k := pow5BitsLong(i) - pow5NumBits128
+		j := int32(q) - k
+		mul := &pow5Split128[i]
+		vr = mulShift128(mv, mul, j)
+		vp = mulShift128(mp, mul, j)
+		vm = mulShift128(mm, mul, j)
+		if q <= 1 {
+			// As in toDecimal64.
+			vrIsTrailingZeros = true
+			if acceptBounds {
+				vmIsTrailingZeros = mmShift == 1
+			} else {
+				vp = sub128(vp, 1)
+			}
+		} else if q < 127 {
+			vrIsTrailingZeros = multipleOfPowerOfTwo128(mv, q-1)
+		}
+	}
+
+	// Step 4: Find the shortest decimal representation
+	// in the interval of valid representations.
+	var removed int32
+	var lastRemovedDigit uint64
+	for {
+		vpDiv10, _ := divMod128(vp, 10)
+		vmDiv10, vmMod10 := divMod128(vm, 10)
+		if !less128(vmDiv10, vpDiv10) {
+			break
+		}
+		vmIsTrailingZeros = vmIsTrailingZeros && vmMod10 == 0
+		vrIsTrailingZeros = vrIsTrailingZeros && lastRemovedDigit == 0
+		vr, lastRemovedDigit = divMod128(vr, 10)
+		vp = vpDiv10
+		vm = vmDiv10
+		removed++
+	}
+	if vmIsTrailingZeros {
+		for {
+			vmDiv10, vmMod10 := divMod128(vm, 10)
+			if vmMod10 != 0 {
+				break
+			}
+			vrIsTrailingZeros = vrIsTrailingZeros && lastRemovedDigit == 0
+			vr, lastRemovedDigit = divMod128(vr, 10)
+			vp, _ = divMod128(vp, 10)
+			vm = vmDiv10
+			removed++
+		}
+	}
+	if vrIsTrailingZeros && lastRemovedDigit == 5 && vr.lo%2 == 0 {
+		// Round even if the exact number is .....50..0.
+		lastRemovedDigit = 4
+	}
+	out := vr
+	// We need to take vr + 1 if vr is outside bounds
+	// or we need to round up.
+	if (vr == vm && (!acceptBounds || !vmIsTrailingZeros)) || lastRemovedDigit >= 5 {
+		out = add128(out, 1)
+	}
+	return dec128{m: out, e: e10 + removed}
+}
+
+// log10Pow2Long is log10Pow2 for e in [0, 2^15], using 64-bit arithmetic.
+func log10Pow2Long(e int32) uint32 {
+	assert(e >= 0, "e >= 0")
+	assert(e <= 1<<15, "e <= 1<<15")
+	return uint32(uint64(e) * 169464822037455 >> 49)
+}
+
+// log10Pow5Long is log10Pow5 for e in [0, 2^15], using 64-bit arithmetic.
+func log10Pow5Long(e int32) uint32 {
+	assert(e >= 0, "e >= 0")
+	assert(e <= 1<<15, "e <= 1<<15")
+	return uint32(uint64(e) * 196742565691928 >> 48)
+}
+
+// pow5BitsLong is pow5Bits for e in [0, 2^15], using 64-bit arithmetic.
+func pow5BitsLong(e int32) int32 {
+	assert(e >= 0, "e >= 0")
+	assert(e <= 1<<15, "e <= 1<<15")
+	return int32(uint64(e)*163391164108059>>46 + 1)
+}
+
+// mulShift128 returns the 384-bit product of m and the 256-bit table entry
+// mul, shifted right by j bits and truncated to 128 bits.
+func mulShift128(m uint128, mul *[4]uint64, j int32) uint128 {
+	// The product, low word first, with room for the shifts below.
+	var p [8]uint64
+	for k, w := range [2]uint64{m.lo, m.hi} {
+		var carry uint64
+		for l := 0; l < 4; l++ {
+			hi, lo := bits.Mul64(w, mul[l])
+			var c uint64
+			p[k+l], c = bits.Add64(p[k+l], lo, 0)
+			hi += c
+			p[k+l], c = bits.Add64(p[k+l], carry, 0)
+			carry = hi + c
+		}
+		p[k+4] = carry
+	}
+	n, s := j/64, uint(j%64)
+	if s == 0 {
+		return uint128{lo: p[n], hi: p[n+1]}
+	}
+	return uint128{
+		lo: p[n]>>s | p[n+1]<<(64-s),
+		hi: p[n+1]>>s | p[n+2]<<(64-s),
+	}
+}
+
+// divMod128 returns v / d and v % d.
+func divMod128(v uint128, d uint64) (uint128, uint64) {
+	hi, r := bits.Div64(0, v.hi, d)
+	lo, r := bits.Div64(r, v.lo, d)
+	return uint128{lo: lo, hi: hi}, r
+}
+
+func mod128(v uint128, d uint64) uint64 {
+	_, r := divMod128(v, d)
+	return r
+}
+
+func add128(v uint128, x uint64) uint128 {
+	lo, c := bits.Add64(v.lo, x, 0)
+	return uint128{lo: lo, hi: v.hi + c}
+}
+
+func sub128(v uint128, x uint64) uint128 {
+	lo, b := bits.Sub64(v.lo, x, 0)
+	return uint128{lo: lo, hi: v.hi - b}
+}
+
+// shiftLeft128 returns v << s for s in (0, 64).
+func shiftLeft128(v uint128, s uint) uint128 {
+	return uint128{lo: v.lo << s, hi: v.hi<<s | v.lo>>(64-s)}
+}
+
+func less128(v, w uint128) bool {
+	return v.hi < w.hi || v.hi == w.hi && v.lo < w.lo
+}
+
+func multipleOfPowerOfFive128(v uint128, p uint32) bool {
+	for n := uint32(0); n < p; n++ {
+		q, r := divMod128(v, 5)
+		if r != 0 {
+			return false
+		}
+		v = q
+	}
+	return true
+}
+
+func multipleOfPowerOfTwo128(v uint128, p uint32) bool {
+	if v.lo != 0 {
+		return uint32(bits.TrailingZeros64(v.lo)) >= p
+	}
+	return 64+uint32(bits.TrailingZeros64(v.hi)) >= p
+}
@@ -0,0 +1,252 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFormatFloat128(t *testing.T) {
+	for _, tt := range []struct {
+		hi, lo uint64
+		want   string
+	}{
+		{0, 0, "0e+00"},
+		{0x8000000000000000, 0, "-0e+00"},
+		{0x3fff000000000000, 0, "1e+00"},
+		{0xc000000000000000, 0, "-2e+00"},
+		{0x3ffb999999999999, 0x999999999999999a, "1e-01"},
+		{0x4000921fb54442d1, 0x8469898cc51701b8, "3.1415926535897932384626433832795028e+00"},
+		{0x7ffeffffffffffff, 0xffffffffffffffff, "1.189731495357231765085759326628007e+4932"},
+		{0x0001000000000000, 0, "3.3621031431120935062626778173217526e-4932"},
+		{0, 1, "6e-4966"},
+		{0x7fff000000000000, 0, "+Inf"},
+		{0xffff000000000000, 0, "-Inf"},
+		{0x7fff800000000000, 0, "NaN"},
+	} {
+		if got := FormatFloat128(tt.hi, tt.lo); got != tt.want {
+			t.Errorf("FormatFloat128(%#x, %#x): got %q; want %q", tt.hi, tt.lo, got, tt.want)
+		}
+	}
+}
+
+func TestFormatFloat128PowersOf10(t *testing.T) {
+	// Every power of 10 up to 10^48 is exact in binary128.
+	p := big.NewInt(1)
+	for i := 0; i <= 48; i++ {
+		hi, lo := float128FromInt(p)
+		want := "1e+" + strconv.Itoa(i)
+		if i < 10 {
+			want = "1e+0" + strconv.Itoa(i)
+		}
+		if got := FormatFloat128(hi, lo); got != want {
+			t.Errorf("FormatFloat128(10^%d): got %q; want %q", i, got, want)
+		}
+		p.Mul(p, big.NewInt(10))
+	}
+}
+
+func TestFormatFloat128Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 1000
+	if testing.Short() {
+		n = 200
+	}
+	for i := 0; i < n; i++ {
+		hi, lo := r.Uint64(), r.Uint64()
+		switch i % 4 {
+		case 1:
+			// Exponents near 0, where the trailing zero checks matter.
+			hi = hi&^(0x7fff<<48) | uint64(bias128-120+r.Intn(240))<<48
+		case 2:
+			// Short mantissas.
+			hi &^= 1<<uint(48-r.Intn(48)) - 1
+			lo = 0
+		case 3:
+			// Subnormals.
+			hi &^= 0x7fff << 48
+		}
+		if hi>>48&0x7fff == 0x7fff {
+			continue
+		}
+		checkShortest128(t, hi, lo)
+	}
+}
+
+func TestLog10Pow2Long(t *testing.T) {
+	// d2 and d5 are floor(log_10(2^e)) and floor(log_10(5^e)), kept up to
+	// date by comparing with the next powers of 10, t2 and t5.
+	p2, p5 := big.NewInt(1), big.NewInt(1)
+	t2, t5 := big.NewInt(10), big.NewInt(10)
+	var d2, d5 uint32
+	for e := int32(0); e <= 1<<15; e++ {
+		for p2.Cmp(t2) >= 0 {
+			d2++
+			t2.Mul(t2, big.NewInt(10))
+		}
+		for p5.Cmp(t5) >= 0 {
+			d5++
+			t5.Mul(t5, big.NewInt(10))
+		}
+		if got := log10Pow2Long(e); got != d2 {
+			t.Fatalf("log10Pow2Long(%d): got %d; want %d", e, got, d2)
+		}
+		if got := log10Pow5Long(e); got != d5 {
+			t.Fatalf("log10Pow5Long(%d): got %d; want %d", e, got, d5)
+		}
+		want := int32(p5.BitLen())
+		if e == 0 {
+			want = 1
+		}
+		if got := pow5BitsLong(e); got != want {
+			t.Fatalf("pow5BitsLong(%d): got %d; want %d", e, got, want)
+		}
+		p2.Lsh(p2, 1)
+		p5.Mul(p5, big.NewInt(5))
+	}
+}
+
+// checkShortest128 is checkShortest for the finite binary128 number with the
+// bits hi<<64 | lo, which it checks against the exact rounding interval.
+func checkShortest128(t *testing.T, hi, lo uint64) {
+	t.Helper()
+	s := FormatFloat128(hi, lo)
+	neg := hi>>63 != 0
+	hi &^= 1 << 63
+	var pred *big.Rat
+	if hi != 0 || lo != 0 {
+		phi, plo := hi, lo-1
+		if lo == 0 {
+			phi--
+		}
+		pred = float128Rat(phi, plo)
+	}
+	shi, slo := hi, lo+1
+	if slo == 0 {
+		shi++
+	}
+	name := fmt.Sprintf("%#x %#x", hi, lo)
+	checkShortestExact(t, name, s, neg, float128Rat(hi, lo), pred, float128Rat(shi, slo), lo&1 == 0)
+}
+
+// checkShortestExact is checkShortest for s, the formatting of the
+// nonnegative number x with the sign neg, checked against the exact rounding
+// interval between x and its neighbors pred, which is nil if x is 0, and
+// succ. Ties round to x if even is set.
+func checkShortestExact(t *testing.T, name, s string, neg bool, x, pred, succ *big.Rat, even bool) {
+	t.Helper()
+	if neg != strings.HasPrefix(s, "-") {
+		t.Errorf("%s: %q has the wrong sign", name, s)
+		return
+	}
+	s = strings.TrimPrefix(s, "-")
+	roundsToX := func(s string) bool {
+		return roundsTo(s, x, pred, succ, even)
+	}
+	if !roundsToX(s) {
+		t.Errorf("%s: %q does not round back", name, s)
+		return
+	}
+	if x.Sign() == 0 {
+		return
+	}
+	// x is a dyadic rational, so its digits are exact in a Float of the
+	// default precision SetRat chooses.
+	xf := new(big.Float).SetRat(x)
+	mant := s[:strings.IndexByte(s, 'e')]
+	n := len(strings.Replace(mant, ".", "", 1))
+	if n > 1 {
+		e := strings.Split(xf.Text('e', n-2), "e")
+		m, _ := new(big.Int).SetString(strings.Replace(e[0], ".", "", 1), 10)
+		e10, _ := strconv.Atoi(e[1])
+		for d := int64(-1); d <= 1; d++ {
+			c := new(big.Int).Add(m, big.NewInt(d)).String() + "e" + strconv.Itoa(e10-(n-2))
+			if roundsToX(c) {
+				t.Errorf("%s: %q is shorter than %q", name, c, s)
+			}
+		}
+	}
+	c := xf.Text('e', n-1)
+	if c != s && c[strings.IndexByte(c, 'e'):] == s[strings.IndexByte(s, 'e'):] && roundsToX(c) {
+		t.Errorf("%s: %q is closer than %q", name, c, s)
+	}
+}
+
+// roundsTo reports whether the nonnegative decimal s rounds to x, given its
+// neighbors as for checkShortestExact.
+func roundsTo(s string, x, pred, succ *big.Rat, even bool) bool {
+	v, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return false
+	}
+	if pred == nil {
+		if v.Sign() != 0 {
+			return false
+		}
+	} else {
+		low := new(big.Rat).Add(x, pred)
+		low.Quo(low, big.NewRat(2, 1))
+		if c := v.Cmp(low); c < 0 || c == 0 && !even {
+			return false
+		}
+	}
+	high := new(big.Rat).Add(x, succ)
+	high.Quo(high, big.NewRat(2, 1))
+	c := v.Cmp(high)
+	return c < 0 || c == 0 && even
+}
+
+// float128Rat returns the value of the nonnegative binary128 number with the
+// bits hi<<64 | lo, taking infinity as 2^16384.
+func float128Rat(hi, lo uint64) *big.Rat {
+	exp := int(hi >> 48)
+	m := new(big.Int).SetUint64(hi & (1<<48 - 1))
+	m.Lsh(m, 64).Or(m, new(big.Int).SetUint64(lo))
+	if exp == 0 {
+		exp = 1
+	} else {
+		m.SetBit(m, mantBits128, 1)
+	}
+	r := new(big.Rat).SetInt(m)
+	if e := exp - bias128 - mantBits128; e >= 0 {
+		return r.Mul(r, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(e))))
+	} else {
+		return r.Quo(r, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(-e))))
+	}
+}
+
+// float128FromInt returns the bits of the positive integer x, which must be
+// exact in binary128.
+func float128FromInt(x *big.Int) (hi, lo uint64) {
+	e := x.BitLen() - 1
+	m := new(big.Int).Set(x)
+	if e <= mantBits128 {
+		m.Lsh(m, uint(mantBits128-e))
+	} else {
+		m.Rsh(m, uint(e-mantBits128))
+	}
+	m.SetBit(m, mantBits128, 0)
+	lo = new(big.Int).And(m, new(big.Int).SetUint64(^uint64(0))).Uint64()
+	hi = new(big.Int).Rsh(m, 64).Uint64() | uint64(e+bias128)<<48
+	return hi, lo
+}
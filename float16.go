@@ -0,0 +1,92 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+// FormatFloat16 converts the IEEE 754 binary16 (half precision) number with
+// the bits u to the shortest string that ParseFloat16 converts back to u, in
+// the format of FormatFloat32, as in "1e-01" for 0x2e66, which FormatFloat32
+// of the same value widened to float32 prints as "9.9975586e-02". It uses the
+// 32-bit algorithm with tables of its own.
+func FormatFloat16(u uint16) string {
+	b := make([]byte, 0, 10)
+	return unsafeString(AppendFloat16(b, u))
+}
+
+// AppendFloat16 appends the string form of the binary16 number with the bits
+// u, as generated by FormatFloat16, to b and returns the extended buffer.
+func AppendFloat16(b []byte, u uint16) []byte {
+	return appendFloat16(b, uint32(u), &float16info, &float16Tables, false)
+}
+
+var float16Tables = pow5Tables32{
+	split:    pow5Split16[:],
+	invSplit: pow5InvSplit16[:],
+	bits:     pow5NumBits16,
+	invBits:  pow5InvNumBits16,
+}
+
+// appendFloat16 appends the shortest form of the number with the bits u in
+// the binary format of at most 16 bits described by info, whose exponents are
+// covered by t. If finiteMax is set, the format has no infinities and the
+// largest exponent holds finite numbers, except for the NaN with an all-ones
+// mantissa, as in the E4M3 8-bit format.
+func appendFloat16(b []byte, u uint32, info *floatInfo, t *pow5Tables32, finiteMax bool) []byte {
+	neg := u>>(info.mantBits+info.expBits)&1 != 0
+	mant := u & (uint32(1)<<info.mantBits - 1)
+	exp := (u >> info.mantBits) & (uint32(1)<<info.expBits - 1)
+	switch {
+	case finiteMax && exp == uint32(1)<<info.expBits-1 && mant == uint32(1)<<info.mantBits-1:
+		return appendSpecial(b, neg, false, false)
+	case !finiteMax && exp == uint32(1)<<info.expBits-1, exp == 0 && mant == 0:
+		return appendSpecial(b, neg, exp == 0, mant == 0)
+	}
+	// As in float32ToDecimal, e2 has 2 extra bits for the bounds.
+	var e2 int32
+	var m2 uint32
+	if exp == 0 {
+		e2 = 1 - int32(info.bias) - int32(info.mantBits) - 2
+		m2 = mant
+	} else {
+		e2 = int32(exp) - int32(info.bias) - int32(info.mantBits) - 2
+		m2 = uint32(1)<<info.mantBits | mant
+	}
+	return toDecimal32(m2, e2, boolToUint32(mant != 0 || exp <= 1), t).append(b, neg)
+}
+
+// FormatBFloat16 converts the bfloat16 number with the bits u, which has the
+// 8-bit exponent of a float32 and a 7-bit mantissa, to the shortest string
+// that ParseBFloat16 converts back to u, in the format of FormatFloat32, as
+// in "1e-01" for 0x3dcd.
+func FormatBFloat16(u uint16) string {
+	b := make([]byte, 0, 10)
+	return unsafeString(AppendBFloat16(b, u))
+}
+
+// AppendBFloat16 appends the string form of the bfloat16 number with the
+// bits u, as generated by FormatBFloat16, to b and returns the extended
+// buffer.
+func AppendBFloat16(b []byte, u uint16) []byte {
+	return appendFloat16(b, uint32(u), &bfloat16info, &bfloat16Tables, false)
+}
+
+var bfloat16Tables = pow5Tables32{
+	split:    pow5SplitBF16[:],
+	invSplit: pow5InvSplitBF16[:],
+	bits:     pow5NumBitsBF16,
+	invBits:  pow5InvNumBitsBF16,
+}
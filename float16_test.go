@@ -0,0 +1,155 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFormatFloat16(t *testing.T) {
+	for _, tt := range []struct {
+		u    uint16
+		want string
+	}{
+		{0x0000, "0e+00"},
+		{0x8000, "-0e+00"},
+		{0x3c00, "1e+00"},
+		{0x3555, "3.333e-01"},
+		{0x2e66, "1e-01"},
+		{0x7bff, "6.55e+04"},
+		{0x0001, "6e-08"},
+		{0x03ff, "6.1e-05"},
+		{0x0400, "6.104e-05"},
+		{0x8400, "-6.104e-05"},
+		{0x7c00, "+Inf"},
+		{0xfc00, "-Inf"},
+		{0x7e00, "NaN"},
+	} {
+		if got := FormatFloat16(tt.u); got != tt.want {
+			t.Errorf("FormatFloat16(%#04x): got %q; want %q", tt.u, got, tt.want)
+		}
+	}
+}
+
+func TestFormatFloat16Exhaustive(t *testing.T) {
+	for u := 0; u < 1<<16; u++ {
+		if u>>mantBits16&(1<<expBits16-1) == 1<<expBits16-1 {
+			continue
+		}
+		s := FormatFloat16(uint16(u))
+		checkShortest(t, uint32(u), float16Value(uint16(u)), s, func(s string) (uint32, error) {
+			v, err := ParseFloat16(s)
+			return uint32(v), err
+		})
+	}
+}
+
+// float16Value returns the value of the finite binary16 number with the bits
+// u.
+func float16Value(u uint16) float64 {
+	mant := float64(u & (1<<mantBits16 - 1))
+	exp := int(u >> mantBits16 & (1<<expBits16 - 1))
+	v := math.Ldexp(mant, 1-bias16-mantBits16)
+	if exp != 0 {
+		v = math.Ldexp(mant+1<<mantBits16, exp-bias16-mantBits16)
+	}
+	if u>>(mantBits16+expBits16) != 0 {
+		v = -v
+	}
+	return v
+}
+
+// checkShortest checks that s, the formatting of the finite number x with the
+// bits u in a format whose parser is parse, parses back to u, that no string
+// with fewer digits does, and that no string of as many digits with the same
+// exponent closer to x does. Like the other Ryu outputs, s may be 1e-40 where
+// 9e-41 is closer, when both have the fewest digits possible.
+func checkShortest(t *testing.T, u uint32, x float64, s string, parse func(string) (uint32, error)) {
+	t.Helper()
+	if v, err := parse(s); err != nil || v != u {
+		t.Errorf("%#x: %q parses as %#x, %v", u, s, v, err)
+		return
+	}
+	if x == 0 {
+		return
+	}
+	mant := strings.TrimPrefix(s[:strings.IndexByte(s, 'e')], "-")
+	n := len(strings.Replace(mant, ".", "", 1))
+	if n > 1 {
+		// The three nearest numbers of n-1 digits cover every such number
+		// that could round to x.
+		e := strings.Split(strconv.FormatFloat(math.Abs(x), 'e', n-2, 64), "e")
+		m, _ := strconv.ParseInt(strings.Replace(e[0], ".", "", 1), 10, 64)
+		e10, _ := strconv.Atoi(e[1])
+		for d := int64(-1); d <= 1; d++ {
+			c := strconv.FormatInt(m+d, 10) + "e" + strconv.Itoa(e10-(n-2))
+			if x < 0 {
+				c = "-" + c
+			}
+			if v, err := parse(c); err == nil && v == u {
+				t.Errorf("%#x: %q is shorter than %q", u, c, s)
+			}
+		}
+	}
+	if c := strconv.FormatFloat(x, 'e', n-1, 64); c != s && c[len(c)-4:] == s[len(s)-4:] {
+		if v, err := parse(c); err == nil && v == u {
+			t.Errorf("%#x: %q is closer than %q", u, c, s)
+		}
+	}
+}
+
+func TestFormatBFloat16(t *testing.T) {
+	for _, tt := range []struct {
+		u    uint16
+		want string
+	}{
+		{0x0000, "0e+00"},
+		{0x8000, "-0e+00"},
+		{0x3f80, "1e+00"},
+		{0x3dcd, "1e-01"},
+		{0x3eab, "3.34e-01"},
+		{0x7f7f, "3.39e+38"},
+		{0x0001, "1e-40"},
+		{0x0080, "1.18e-38"},
+		{0xc0a0, "-5e+00"},
+		{0x7f80, "+Inf"},
+		{0xff80, "-Inf"},
+		{0x7fc0, "NaN"},
+	} {
+		if got := FormatBFloat16(tt.u); got != tt.want {
+			t.Errorf("FormatBFloat16(%#04x): got %q; want %q", tt.u, got, tt.want)
+		}
+	}
+}
+
+func TestFormatBFloat16Exhaustive(t *testing.T) {
+	for u := 0; u < 1<<16; u++ {
+		if u>>mantBitsBF16&(1<<expBitsBF16-1) == 1<<expBitsBF16-1 {
+			continue
+		}
+		s := FormatBFloat16(uint16(u))
+		x := float64(math.Float32frombits(uint32(u) << 16))
+		checkShortest(t, uint32(u), x, s, func(s string) (uint32, error) {
+			v, err := ParseBFloat16(s)
+			return uint32(v), err
+		})
+	}
+}
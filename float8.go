@@ -0,0 +1,75 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+var (
+	float8E4M3info = floatInfo{mantBitsE4M3, expBitsE4M3, biasE4M3, -4, 3}
+	float8E5M2info = floatInfo{mantBitsE5M2, expBitsE5M2, biasE5M2, -6, 5}
+)
+
+// FormatFloat8E4M3 converts the 8-bit E4M3 number with the bits u to the
+// shortest string that identifies it among the E4M3 numbers, in the format of
+// FormatFloat32, as in "4.5e+02" for 0x7e, the largest, whose exact value is
+// 448. E4M3 is the format of the OCP 8-bit floating point specification
+// (E4M3FN in some frameworks) with a 4-bit exponent, biased by 7, and a 3-bit
+// mantissa. It has no infinities and spends the largest exponent on finite
+// numbers; only 0x7f and 0xff, whose mantissa is all ones, are NaN, and they
+// are printed as "NaN" like every other NaN.
+func FormatFloat8E4M3(u uint8) string {
+	b := make([]byte, 0, 10)
+	return unsafeString(AppendFloat8E4M3(b, u))
+}
+
+// AppendFloat8E4M3 appends the string form of the E4M3 number with the bits
+// u, as generated by FormatFloat8E4M3, to b and returns the extended buffer.
+func AppendFloat8E4M3(b []byte, u uint8) []byte {
+	return appendFloat16(b, uint32(u), &float8E4M3info, &float8E4M3Tables, true)
+}
+
+// FormatFloat8E5M2 converts the 8-bit E5M2 number with the bits u to the
+// shortest string that identifies it among the E5M2 numbers, in the format of
+// FormatFloat32, as in "6e+04" for 0x7b, the largest finite one, whose exact
+// value is 57344. E5M2 is the other format of the OCP 8-bit specification,
+// with a 5-bit exponent, biased by 15, and a 2-bit mantissa. It follows the
+// IEEE conventions, like a binary16 number with its low 8 bits cut off: the
+// largest exponent holds +Inf and -Inf and the NaNs.
+func FormatFloat8E5M2(u uint8) string {
+	b := make([]byte, 0, 10)
+	return unsafeString(AppendFloat8E5M2(b, u))
+}
+
+// AppendFloat8E5M2 appends the string form of the E5M2 number with the bits
+// u, as generated by FormatFloat8E5M2, to b and returns the extended buffer.
+func AppendFloat8E5M2(b []byte, u uint8) []byte {
+	return appendFloat16(b, uint32(u), &float8E5M2info, &float8E5M2Tables, false)
+}
+
+var (
+	float8E4M3Tables = pow5Tables32{
+		split:    pow5SplitE4M3[:],
+		invSplit: pow5InvSplitE4M3[:],
+		bits:     pow5NumBitsE4M3,
+		invBits:  pow5InvNumBitsE4M3,
+	}
+	float8E5M2Tables = pow5Tables32{
+		split:    pow5SplitE5M2[:],
+		invSplit: pow5InvSplitE5M2[:],
+		bits:     pow5NumBitsE5M2,
+		invBits:  pow5InvNumBitsE5M2,
+	}
+)
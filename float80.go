@@ -0,0 +1,67 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+const (
+	mantBits80 = 64 // including the explicit integer bit
+	expBits80  = 15
+	bias80     = 16383
+)
+
+// FormatFloat80 converts the x87 80-bit extended precision number with the
+// sign and exponent se, the top 16 bits of its pattern, and the significand
+// mant, the low 64 bits including the explicit integer bit, to the shortest
+// string that identifies it among the 80-bit numbers. The format is that of
+// FormatFloat128, as in "1e-01" for se = 0x3ffb and mant = 0xcccccccccccccccd.
+//
+// The patterns that x87 processors since the 80387 reject as invalid
+// operands, which are the unnormals, with a nonzero exponent and a clear
+// integer bit, and the pseudo-infinities and pseudo-NaNs, are printed as
+// "NaN". The pseudo-denormals, with a zero exponent and a set integer bit,
+// are printed as the numbers those processors take them for.
+func FormatFloat80(se uint16, mant uint64) string {
+	b := make([]byte, 0, 32)
+	return unsafeString(AppendFloat80(b, se, mant))
+}
+
+// AppendFloat80 appends the string form of the 80-bit number with the sign
+// and exponent se and the significand mant, as generated by FormatFloat80,
+// to b and returns the extended buffer.
+func AppendFloat80(b []byte, se uint16, mant uint64) []byte {
+	neg := se>>expBits80 != 0
+	exp := uint32(se) & (1<<expBits80 - 1)
+	integer := mant>>(mantBits80-1) != 0
+	switch {
+	case exp == 0 && mant == 0:
+		return appendSpecial(b, neg, true, true)
+	case exp != 0 && !integer:
+		return appendSpecial(b, neg, false, false)
+	case exp == 1<<expBits80-1:
+		return appendSpecial(b, neg, false, mant<<1 == 0)
+	}
+	// As in float64ToDecimal, e2 has 2 extra bits for the bounds. With
+	// an explicit integer bit, the mantissa is already m2.
+	var e2 int32
+	if exp == 0 {
+		e2 = 1 - bias80 - (mantBits80 - 1) - 2
+	} else {
+		e2 = int32(exp) - bias80 - (mantBits80 - 1) - 2
+	}
+	mmShift := boolToUint64(mant != 1<<(mantBits80-1) || exp <= 1)
+	return toDecimal128(uint128{lo: mant}, e2, mmShift).append(b, neg)
+}
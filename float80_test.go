@@ -0,0 +1,110 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestFormatFloat80(t *testing.T) {
+	for _, tt := range []struct {
+		se   uint16
+		mant uint64
+		want string
+	}{
+		{0x0000, 0, "0e+00"},
+		{0x8000, 0, "-0e+00"},
+		{0x3fff, 1 << 63, "1e+00"},
+		{0xc000, 1 << 63, "-2e+00"},
+		{0x3ffb, 0xcccccccccccccccd, "1e-01"},
+		{0x4000, 0xc90fdaa22168c235, "3.1415926535897932385e+00"},
+		{0x7ffe, 0xffffffffffffffff, "1.189731495357231765e+4932"},
+		{0x0001, 1 << 63, "3.3621031431120935063e-4932"},
+		{0x0000, 1, "4e-4951"},
+		// A pseudo-denormal is the smallest normal number.
+		{0x0000, 1 << 63, "3.3621031431120935063e-4932"},
+		{0x7fff, 1 << 63, "+Inf"},
+		{0xffff, 1 << 63, "-Inf"},
+		{0x7fff, 3 << 62, "NaN"},
+		{0x7fff, 1<<63 | 1, "NaN"},
+		// Pseudo-infinity, pseudo-NaN, and unnormals.
+		{0x7fff, 0, "NaN"},
+		{0x7fff, 1 << 62, "NaN"},
+		{0x3fff, 1 << 62, "NaN"},
+		{0x3fff, 0, "NaN"},
+	} {
+		if got := FormatFloat80(tt.se, tt.mant); got != tt.want {
+			t.Errorf("FormatFloat80(%#04x, %#x): got %q; want %q", tt.se, tt.mant, got, tt.want)
+		}
+	}
+}
+
+func TestFormatFloat80Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 1000
+	if testing.Short() {
+		n = 100
+	}
+	for i := 0; i < n; i++ {
+		se, mant := uint16(r.Uint32()), r.Uint64()|1<<63
+		switch i % 4 {
+		case 1:
+			// Exponents near 0, where the trailing zero checks matter.
+			se = se&0x8000 | uint16(bias80-70+r.Intn(140))
+		case 2:
+			// Short mantissas.
+			mant &^= 1<<uint(r.Intn(63)) - 1
+		case 3:
+			// Subnormals.
+			se &= 0x8000
+			mant &^= 1 << 63
+		}
+		exp := se & 0x7fff
+		if exp == 0x7fff {
+			continue
+		}
+		x, ulp := float80Rat(exp, mant), float80Rat(exp, 1)
+		var pred *big.Rat
+		if mant != 0 {
+			pred = new(big.Rat).Sub(x, ulp)
+			if mant == 1<<63 && exp > 1 {
+				pred.Add(pred, new(big.Rat).Quo(ulp, big.NewRat(2, 1)))
+			}
+		}
+		succ := new(big.Rat).Add(x, ulp)
+		name := fmt.Sprintf("%#04x %#x", se, mant)
+		checkShortestExact(t, name, FormatFloat80(se, mant), se>>15 != 0, x, pred, succ, mant&1 == 0)
+	}
+}
+
+// float80Rat returns the value of the nonnegative 80-bit number with the
+// exponent exp and the significand mant.
+func float80Rat(exp uint16, mant uint64) *big.Rat {
+	r := new(big.Rat).SetInt(new(big.Int).SetUint64(mant))
+	e := int(exp) - bias80 - (mantBits80 - 1)
+	if exp == 0 {
+		e++
+	}
+	if e >= 0 {
+		return r.Mul(r, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(e))))
+	}
+	return r.Quo(r, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(-e))))
+}
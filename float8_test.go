@@ -0,0 +1,136 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestFormatFloat8(t *testing.T) {
+	for _, tt := range []struct {
+		u    uint8
+		e4m3 string
+		e5m2 string
+	}{
+		{0x00, "0e+00", "0e+00"},
+		{0x80, "-0e+00", "-0e+00"},
+		{0x01, "2e-03", "2e-05"},
+		{0x38, "1e+00", "5e-01"},
+		{0x3c, "1.5e+00", "1e+00"},
+		{0x78, "2.6e+02", "3.3e+04"},
+		{0x7b, "3.5e+02", "6e+04"},
+		{0x7c, "4e+02", "+Inf"},
+		{0xfc, "-4e+02", "-Inf"},
+		{0x7d, "4.2e+02", "NaN"},
+		{0x7e, "4.5e+02", "NaN"},
+		{0x7f, "NaN", "NaN"},
+		{0xfe, "-4.5e+02", "NaN"},
+		{0xff, "NaN", "NaN"},
+	} {
+		if got := FormatFloat8E4M3(tt.u); got != tt.e4m3 {
+			t.Errorf("FormatFloat8E4M3(%#02x): got %q; want %q", tt.u, got, tt.e4m3)
+		}
+		if got := FormatFloat8E5M2(tt.u); got != tt.e5m2 {
+			t.Errorf("FormatFloat8E5M2(%#02x): got %q; want %q", tt.u, got, tt.e5m2)
+		}
+	}
+}
+
+func TestFormatFloat8Exhaustive(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		info      floatInfo
+		finiteMax bool
+		format    func(uint8) string
+	}{
+		{"E4M3", float8E4M3info, true, FormatFloat8E4M3},
+		{"E5M2", float8E5M2info, false, FormatFloat8E5M2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			values, bits := float8Values(tt.info, tt.finiteMax)
+			parse := func(s string) (uint32, error) {
+				x, _ := new(big.Rat).SetString(strings.TrimPrefix(s, "-"))
+				u := nearestFloat8(values, bits, x)
+				if strings.HasPrefix(s, "-") {
+					u |= 0x80
+				}
+				return u, nil
+			}
+			for i, u := range bits[:len(bits)-1] {
+				x, _ := values[i].Float64()
+				checkShortest(t, uint32(u), x, tt.format(u), parse)
+				checkShortest(t, uint32(u|0x80), -x, tt.format(u|0x80), parse)
+			}
+		})
+	}
+}
+
+// float8Values returns the nonnegative finite numbers of the 8-bit format
+// described by info in increasing order, with their bits, followed by the
+// bits of infinity, or of NaN if finiteMax is set as for appendFloat16, and
+// the number one unit in the last place above the largest finite one, which
+// stands in for it in rounding.
+func float8Values(info floatInfo, finiteMax bool) ([]*big.Rat, []uint8) {
+	var values []*big.Rat
+	var bits []uint8
+	top := 1<<(info.mantBits+info.expBits) - 1<<info.mantBits
+	if finiteMax {
+		top = 1<<(info.mantBits+info.expBits) - 1
+	}
+	for u := 0; u < top; u++ {
+		mant := u & (1<<info.mantBits - 1)
+		exp := u >> info.mantBits
+		if exp > 0 {
+			mant |= 1 << info.mantBits
+		} else {
+			exp = 1
+		}
+		f := math.Ldexp(float64(mant), exp-int(info.bias)-int(info.mantBits))
+		values = append(values, new(big.Rat).SetFloat64(f))
+		bits = append(bits, uint8(u))
+	}
+	n := len(values)
+	ulp := new(big.Rat).Sub(values[n-1], values[n-2])
+	values = append(values, new(big.Rat).Add(values[n-1], ulp))
+	bits = append(bits, uint8(top))
+	return values, bits
+}
+
+// nearestFloat8 returns the bits of the 8-bit number nearest to x, which is
+// nonnegative, rounding ties to even, given the values and bits of
+// float8Values.
+func nearestFloat8(values []*big.Rat, bits []uint8, x *big.Rat) uint32 {
+	i := sort.Search(len(values), func(i int) bool { return values[i].Cmp(x) >= 0 })
+	if i == len(values) {
+		return uint32(bits[i-1])
+	}
+	if i == 0 || values[i].Cmp(x) == 0 {
+		return uint32(bits[i])
+	}
+	mid := new(big.Rat).Add(values[i-1], values[i])
+	mid.Quo(mid, big.NewRat(2, 1))
+	switch c := x.Cmp(mid); {
+	case c < 0, c == 0 && bits[i-1]&1 == 0:
+		return uint32(bits[i-1])
+	}
+	return uint32(bits[i])
+}
@@ -0,0 +1,206 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"strconv"
+)
+
+// FormatFloat converts the floating-point number f to a string according to
+// the format fmt and precision prec, exactly like strconv.FormatFloat, whose
+// documentation describes the arguments. It rounds the result assuming that
+// the original was obtained from a floating-point value of bitSize bits (32
+// for float32, 64 for float64).
+//
+// The formats 'e', 'E', 'f', 'g', and 'G' use Ryu: its shortest algorithm
+// for prec -1 and the fixed-precision algorithms otherwise. The formats 'b',
+// 'x', and 'X', which print the binary mantissa and exponent, need no decimal
+// conversion.
+func FormatFloat(f float64, fmt byte, prec, bitSize int) string {
+	n := 24
+	if prec > 0 {
+		n += prec
+	}
+	return unsafeString(AppendFloat(make([]byte, 0, n), f, fmt, prec, bitSize))
+}
+
+// AppendFloat appends the string form of the floating-point number f, as
+// generated by FormatFloat, to b and returns the extended buffer, exactly
+// like strconv.AppendFloat.
+func AppendFloat(b []byte, f float64, fmt byte, prec, bitSize int) []byte {
+	var u uint64
+	switch bitSize {
+	case 32:
+		// The precision formats are exact for any float64, and
+		// float32s convert to float64s exactly.
+		f = float64(float32(f))
+		u = math.Float64bits(f)
+	case 64:
+		u = math.Float64bits(f)
+	default:
+		panic("ryu: illegal AppendFloat/FormatFloat bitSize")
+	}
+	// As in strconv, infinities and NaNs are formatted the same way in
+	// every format, even an invalid one.
+	if exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1); exp == uint64(1)<<expBits64-1 {
+		mant := u & (uint64(1)<<mantBits64 - 1)
+		return appendSpecial(b, u>>(mantBits64+expBits64) != 0, false, mant == 0)
+	}
+	switch fmt {
+	case 'e', 'E', 'f', 'g', 'G':
+	case 'b':
+		if bitSize == 32 {
+			return appendBinary(b, uint64(math.Float32bits(float32(f))), &float32info)
+		}
+		return appendBinary(b, u, &float64info)
+	case 'x', 'X':
+		return appendHex64(b, f, prec, fmt)
+	default:
+		return append(b, '%', fmt)
+	}
+	var d FloatDecimal
+	if prec < 0 {
+		if bitSize == 32 {
+			d = Decimal32(float32(f))
+		} else {
+			d = Decimal64(f)
+		}
+	}
+
+	start := len(b)
+	switch {
+	case fmt == 'e' || fmt == 'E':
+		if prec < 0 {
+			b = appendShortestE(b, d)
+		} else {
+			b = AppendFloat64Exp(b, f, prec)
+		}
+	case fmt == 'f':
+		if prec < 0 {
+			b = appendShortestF(b, d)
+		} else {
+			b = AppendFloat64Fixed(b, f, prec)
+		}
+	default:
+		if prec < 0 {
+			b = appendShortestG(b, d)
+		} else {
+			b = AppendFloat64General(b, f, prec)
+		}
+	}
+	if fmt == 'E' || fmt == 'G' {
+		for i := start; i < len(b); i++ {
+			if b[i] == 'e' {
+				b[i] = 'E'
+				break
+			}
+		}
+	}
+	return b
+}
+
+// FormatFloat32NoExp converts the 32-bit floating point number f to the
+// shortest string that parses back to f, like FormatFloat32, but in
+// positional notation whatever its magnitude, as in "0.000001234" rather than
+// "1.234e-06". It is the equivalent of calling
+// strconv.FormatFloat(float64(f), 'f', -1, 32).
+func FormatFloat32NoExp(f float32) string {
+	b := make([]byte, 0, 24)
+	return unsafeString(AppendFloat32NoExp(b, f))
+}
+
+// AppendFloat32NoExp appends the string form of the 32-bit floating point
+// number f, as generated by FormatFloat32NoExp, to b and returns the
+// extended buffer.
+func AppendFloat32NoExp(b []byte, f float32) []byte {
+	return appendShortestF(b, Decimal32(f))
+}
+
+// FormatFloat64NoExp converts the 64-bit floating point number f to the
+// shortest string that parses back to f, like FormatFloat64, but in
+// positional notation whatever its magnitude, as in "0.000001234" rather than
+// "1.234e-06". It is the equivalent of calling
+// strconv.FormatFloat(f, 'f', -1, 64).
+func FormatFloat64NoExp(f float64) string {
+	b := make([]byte, 0, 24)
+	return unsafeString(AppendFloat64NoExp(b, f))
+}
+
+// AppendFloat64NoExp appends the string form of the 64-bit floating point
+// number f, as generated by FormatFloat64NoExp, to b and returns the
+// extended buffer.
+func AppendFloat64NoExp(b []byte, f float64) []byte {
+	return appendShortestF(b, Decimal64(f))
+}
+
+// appendShortestF appends the digits of d in positional notation, as
+// strconv's 'f' format does with precision -1.
+func appendShortestF(b []byte, d FloatDecimal) []byte {
+	if d.Class == ClassInf || d.Class == ClassNaN {
+		return appendSpecial(b, d.Neg, false, d.Class == ClassInf)
+	}
+	if d.Neg {
+		b = append(b, '-')
+	}
+	if d.Class == ClassZero {
+		return append(b, '0')
+	}
+	start := len(b)
+	b = strconv.AppendUint(b, d.Digits, 10)
+	return positional(b, start, int(d.Exp)+len(b)-start-1)
+}
+
+// appendShortestG appends the digits of d as strconv's 'g' format does with
+// precision -1.
+func appendShortestG(b []byte, d FloatDecimal) []byte {
+	if usesExponentG(d) {
+		return appendShortestE(b, d)
+	}
+	return appendShortestF(b, d)
+}
+
+// usesExponentG reports whether strconv's 'g' format with precision -1 prints
+// d in scientific notation, which it does for finite nonzero numbers whose
+// decimal exponent is less than -4 or at least 6.
+func usesExponentG(d FloatDecimal) bool {
+	if d.Class != ClassNormal && d.Class != ClassSubnormal {
+		return false
+	}
+	exp := int(d.Exp) + decimalLen64(d.Digits) - 1
+	return exp < -4 || exp >= 6
+}
+
+// appendBinary appends the finite number with the bits u in the format info
+// as strconv's 'b' format does: the integer mantissa in decimal, then 'p' and
+// the signed power of two, as in "-4503599627370496p-52".
+func appendBinary(b []byte, u uint64, info *floatInfo) []byte {
+	if u>>(info.mantBits+info.expBits) != 0 {
+		b = append(b, '-')
+	}
+	mant := u & (uint64(1)<<info.mantBits - 1)
+	exp := int((u >> info.mantBits) & (uint64(1)<<info.expBits - 1))
+	if exp == 0 {
+		// Subnormals have the exponent of the smallest normal numbers.
+		exp = 1
+	} else {
+		mant |= uint64(1) << info.mantBits
+	}
+	exp -= int(info.bias) + int(info.mantBits)
+	b = strconv.AppendUint(b, mant, 10)
+	b = append(b, 'p')
+	if exp >= 0 {
+		b = append(b, '+')
+	}
+	return strconv.AppendInt(b, int64(exp), 10)
+}
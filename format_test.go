@@ -0,0 +1,183 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFormatFloat(t *testing.T) {
+	for _, tt := range []struct {
+		f       float64
+		fmt     byte
+		prec    int
+		bitSize int
+		want    string
+	}{
+		{1.5, 'e', -1, 64, "1.5e+00"},
+		{1.5, 'E', 3, 64, "1.500E+00"},
+		{0.1, 'f', -1, 64, "0.1"},
+		{0.1, 'f', -1, 32, "0.1"},
+		{0.1, 'f', 10, 32, "0.1000000015"},
+		{0.1, 'g', 20, 64, "0.10000000000000000555"},
+		{123456, 'g', -1, 64, "123456"},
+		{1234567, 'g', -1, 64, "1.234567e+06"},
+		{1234567, 'G', -1, 64, "1.234567E+06"},
+		{0.0001, 'g', -1, 64, "0.0001"},
+		{0.00001, 'g', -1, 64, "1e-05"},
+		{1e21, 'f', -1, 64, "1000000000000000000000"},
+		{0, 'e', -1, 64, "0e+00"},
+		{0, 'f', -1, 64, "0"},
+		{math.Copysign(0, -1), 'g', -1, 64, "-0"},
+		{0, 'e', 2, 64, "0.00e+00"},
+		{16777217, 'f', -1, 32, "16777216"},
+		{math.MaxFloat64, 'g', -1, 32, "+Inf"},
+		{math.NaN(), 'f', 2, 64, "NaN"},
+		{math.Inf(-1), 'q', 2, 64, "-Inf"},
+		{1, 'q', 2, 64, "%q"},
+		{1, 'b', -1, 64, "4503599627370496p-52"},
+		{-1, 'b', 5, 32, "-8388608p-23"},
+		{0, 'b', -1, 64, "0p-1074"},
+		{math.Copysign(0, -1), 'b', -1, 32, "-0p-149"},
+		{5e-324, 'b', -1, 64, "1p-1074"},
+		{math.MaxFloat64, 'b', -1, 64, "9007199254740991p+971"},
+		{1, 'x', -1, 64, "0x1p+00"},
+		{-0.1, 'X', -1, 32, "-0X1.99999AP-04"},
+		{1e-45, 'x', 2, 32, "0x1.00p-149"},
+	} {
+		if got := FormatFloat(tt.f, tt.fmt, tt.prec, tt.bitSize); got != tt.want {
+			t.Errorf("FormatFloat(%b, %q, %d, %d): got %q; want %q",
+				tt.f, tt.fmt, tt.prec, tt.bitSize, got, tt.want)
+		}
+	}
+}
+
+func TestFormatFloatBitSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("FormatFloat with bitSize 16 did not panic")
+		}
+	}()
+	FormatFloat(1, 'e', -1, 16)
+}
+
+// TestFormatFloatStrconv checks that AppendFloat matches
+// strconv.AppendFloat.
+func TestFormatFloatStrconv(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	const formats = "eEfgGbxX"
+	n := 1000000
+	if testing.Short() {
+		n = 100000
+	}
+	for i := 0; i < n; i++ {
+		var f float64
+		switch i % 3 {
+		case 0:
+			// Any float64.
+			f = math.Float64frombits(r.Uint64())
+		case 1:
+			// A number near the switch between notations.
+			f = r.NormFloat64() * math.Pow(10, float64(r.Intn(40)-20))
+		case 2:
+			// A short binary number, which is often a tie.
+			f = math.Ldexp(float64(r.Int63n(1<<20)), r.Intn(80)-40)
+		}
+		fmt := formats[r.Intn(len(formats))]
+		prec := r.Intn(25) - 1
+		if r.Intn(3) == 0 {
+			prec = -1
+		}
+		bitSize := 64
+		if r.Intn(2) == 0 {
+			bitSize = 32
+		}
+		got := AppendFloat([]byte("x="), f, fmt, prec, bitSize)
+		want := strconv.AppendFloat([]byte("x="), f, fmt, prec, bitSize)
+		if string(got) != string(want) {
+			t.Fatalf("AppendFloat(\"x=\", %b, %q, %d, %d): got %q; want %q", f, fmt, prec, bitSize, got, want)
+		}
+	}
+}
+
+func TestAppendFloatAllocs(t *testing.T) {
+	b := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, fmt := range []byte("efgEb") {
+			b = AppendFloat(b[:0], -1234.5678, fmt, -1, 64)
+			b = AppendFloat(b[:0], -1234.5678, fmt, 6, 64)
+		}
+	})
+	if allocs > 0 {
+		t.Errorf("AppendFloat allocates %.0f times; want 0", allocs)
+	}
+}
+
+func BenchmarkFormatFloat(b *testing.B) {
+	for _, bc := range []struct {
+		name string
+		fmt  byte
+		prec int
+	}{
+		{"e-1", 'e', -1},
+		{"f-1", 'f', -1},
+		{"g-1", 'g', -1},
+		{"f2", 'f', 2},
+		{"e6", 'e', 6},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sink = FormatFloat(-1234.5678, bc.fmt, bc.prec, 64)
+			}
+		})
+	}
+}
+
+func TestFormatFloatNoExp(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{1.234e-6, "0.000001234"},
+		{-1.5, "-1.5"},
+		{1e21, "1000000000000000000000"},
+		{123456789, "123456789"},
+		{0, "0"},
+		{math.Copysign(0, -1), "-0"},
+		{5e-324, "0." + strings.Repeat("0", 323) + "5"},
+		{math.Inf(-1), "-Inf"},
+	} {
+		if got := FormatFloat64NoExp(tt.f); got != tt.want {
+			t.Errorf("FormatFloat64NoExp(%b): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+	if got, want := FormatFloat32NoExp(1e-7), "0.0000001"; got != want {
+		t.Errorf("FormatFloat32NoExp(1e-7): got %q; want %q", got, want)
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		u := r.Uint64()
+		f := math.Float64frombits(u)
+		if got, want := FormatFloat64NoExp(f), strconv.FormatFloat(f, 'f', -1, 64); got != want {
+			t.Fatalf("FormatFloat64NoExp(%b): got %q; want %q", f, got, want)
+		}
+		f32 := math.Float32frombits(uint32(u))
+		if got, want := FormatFloat32NoExp(f32), strconv.FormatFloat(float64(f32), 'f', -1, 32); got != want {
+			t.Fatalf("FormatFloat32NoExp(%b): got %q; want %q", f32, got, want)
+		}
+	}
+}
@@ -0,0 +1,927 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+// A Formatter converts floating-point numbers to strings according to its
+// configuration. The zero value is ready to use and formats like
+// FormatFloat32 and FormatFloat64.
+//
+// A Formatter is also a Renderer: its AppendDecimal method renders a
+// FloatDecimal according to the Formatter's options.
+type Formatter struct {
+	// Backend computes the decimal form of each number.
+	// If nil, this package's implementation of Ryu is used.
+	Backend Backend
+
+	// Renderer turns the decimal form into text.
+	// If nil, the Formatter renders it itself (see AppendDecimal).
+	// A Renderer is in charge of the whole text: of the options below, only
+	// PositiveZero and the padding by Width, LeftAlign and ZeroPad apply to
+	// its output. It is not used when PrecisionKind is not
+	// PrecisionShortest; the precision methods render their digits
+	// themselves.
+	Renderer Renderer
+
+	// Notation selects between scientific and positional notation.
+	Notation Notation
+
+	// AutoRange, unless zero, is the range [AutoRange[0], AutoRange[1]) of
+	// decimal exponents that NotationAuto prints in positional notation;
+	// numbers outside it get an exponent. The zero value stands for the
+	// range of strconv's 'g' format, [-4, 6) for the shortest forms and
+	// [-4, Precision) for significant digits. JavaScript uses [-6, 21).
+	AutoRange [2]int
+
+	// Precision, unless PrecisionKind is PrecisionShortest, is the number of
+	// digits that FormatFloat32 and FormatFloat64 round to, counted as
+	// PrecisionKind says, according to Rounding. This makes them one entry
+	// point for the precision methods: in NotationScientific, the default,
+	// they format like FormatFloat64Exp, and in NotationPositional like
+	// FormatFloat64Fixed, whichever kind of digits Precision counts. In
+	// NotationAuto, significant digits are formatted like
+	// FormatFloat64General, and decimal places in the notation that the
+	// shortest form would use. In NotationEngineering, the digits are
+	// counted as in NotationScientific but laid out with an exponent that
+	// is a multiple of 3.
+	Precision     int
+	PrecisionKind PrecisionKind
+
+	// FractionalMantissa normalizes the mantissa into [0.1, 1) instead of
+	// [1, 10), as in "0.15e+01" for 1.5, the convention of Fortran's E
+	// format. The digits are unchanged. Zeros, infinities, and NaNs are
+	// formatted as usual. It applies only to the shortest forms in
+	// NotationScientific and NotationAuto.
+	FractionalMantissa bool
+
+	// FractionGroup, if positive, separates the digits after the decimal
+	// point into groups of this many digits, as in "1.234 567 8e+00" for a
+	// group of 3, following SI typesetting rules.
+	FractionGroup int
+
+	// FractionSeparator separates the groups of fractional digits. If empty,
+	// a space is used; "\u2009" (thin space) is the typographic choice.
+	FractionSeparator string
+
+	// DecimalPoint, if not zero, replaces the '.' of the number, as in
+	// "1,5e+00" for ',', the convention of most European locales.
+	DecimalPoint rune
+
+	// IntegerGroup, if positive, separates the digits before the decimal
+	// point into groups of this many digits, counting from the point, as in
+	// "1,234,567.5" for 3. IntegerGroup2, if positive, is the size of the
+	// groups after the first, as in "12,34,567.5" for the Indian grouping
+	// of 3 and 2. Zeros added by ZeroPad are not grouped.
+	IntegerGroup  int
+	IntegerGroup2 int
+
+	// IntegerSeparator separates the groups of integer digits. If empty, a
+	// ',' is used.
+	IntegerSeparator string
+
+	// Digits, unless zero, are the digits 0 through 9 used in place of the
+	// ASCII ones, such as ArabicIndicDigits, in finite numbers and their
+	// exponents. The digits of Superscript exponents are not affected.
+	Digits [10]rune
+
+	// PointZero adds ".0" to finite numbers that would have no decimal
+	// point, as in "3.0" and "3.0e+00" for 3, for consumers such as TOML
+	// that need to see that a number is a float. It takes effect after
+	// TrimZeros.
+	PointZero bool
+
+	// ExpDigits, if positive, is the minimum number of digits of the
+	// exponent, which is padded with zeros: 1 gives "1.5e+5", as
+	// JavaScript prints it, and 3 gives "1.5e+005". The default is 2, as
+	// in C's printf.
+	ExpDigits int
+
+	// CompactExp leaves out the '+' of positive exponents and, unless
+	// ExpDigits asks for them, their leading zeros, as in "1e15" and
+	// "1e-7", to save space in large payloads.
+	CompactExp bool
+
+	// Superscript writes the exponent as a power of ten with superscript
+	// digits, as in "1.5×10⁻³" and "2×10¹⁵", for labels in reports and
+	// plots. Like CompactExp, it leaves out leading zeros unless ExpDigits
+	// asks for them. It overrides ExpMarker and FortranD.
+	Superscript bool
+
+	// FortranD writes 'D' in place of 'e', as in "1.5D+00", the double
+	// precision exponent that legacy Fortran input decks and namelists
+	// expect. It is the same as an ExpMarker of "D".
+	FortranD bool
+
+	// ExpMarker, if not empty, is written in place of the 'e' that starts
+	// the exponent, as in "1.5*10^+00" for "*10^" or "1.5d+00" for "d". It
+	// is used as given, without Upper, Digits, or UnicodeMinus, and takes
+	// precedence over FortranD. Superscript takes precedence over both.
+	ExpMarker string
+
+	// Upper uses a capital E for the exponent and capitals for infinities
+	// and NaNs, as in "1.5E+10", "+INF", and "NAN", like printf's %E and
+	// %G.
+	Upper bool
+
+	// UnicodeMinus writes the minus signs of the number and of its exponent
+	// as U+2212 MINUS SIGN, as in "−1.5e−03", which typographic style
+	// guides call for in text meant for people.
+	UnicodeMinus bool
+
+	// NaNPayload writes NaNs with their sign and payload, the mantissa bits
+	// below the quiet bit, in hexadecimal, as in "NaN(0x1)" for math.NaN()
+	// and "-sNaN(0x2a)" for a negative signaling NaN, to tell apart the NaNs
+	// in debugging output. A NaN spelling takes precedence.
+	NaNPayload bool
+
+	// Inf, NegInf, and NaN, if not empty, are the spellings of positive
+	// infinity, negative infinity, and NaN in place of "+Inf", "-Inf", and
+	// "NaN", as in "Infinity", ".inf", or "null". If only Inf is set,
+	// negative infinity is Inf after a '-', without any leading '+': "+inf"
+	// gives "-inf". The spellings are used as given, without Upper, Plus,
+	// or Space.
+	Inf    string
+	NegInf string
+	NaN    string
+
+	// Width, if positive, is the minimum width of the number in runes.
+	// Shorter numbers are padded with spaces on the left, or on the right
+	// if LeftAlign is set, as by printf's width. An annotation is not
+	// counted; with a custom Renderer, its whole output is.
+	Width int
+
+	// LeftAlign pads on the right, as printf's '-' flag does.
+	LeftAlign bool
+
+	// ZeroPad pads on the left with zeros after the sign, as in "-001.5"
+	// for a Width of 6, as printf's '0' flag does. Infinities, NaNs, and
+	// left-aligned numbers are padded with spaces.
+	ZeroPad bool
+
+	// PositiveZero formats negative zero as positive zero, as in "0e+00"
+	// rather than "-0e+00", for consumers that mishandle the sign of zero.
+	// Only zero itself is affected: a negative number that rounds to zero
+	// keeps its sign, as in "-0.00".
+	PositiveZero bool
+
+	// Plus puts a '+' before numbers that are not negative, NaNs
+	// included, as printf's '+' flag does.
+	Plus bool
+
+	// Space puts a space before numbers that are not negative, in place of
+	// the '+' of "+Inf", as printf's ' ' flag does, so that columns of
+	// numbers of mixed signs line up. Plus takes precedence.
+	Space bool
+
+	// Annotate adds the class of the value after the number, as in
+	// "1.5e-310 (subnormal)", "-0e+00 (-0)", or "NaN (quiet, payload 0x1)".
+	// It is intended for debugging output.
+	Annotate bool
+
+	// Rounding is the rounding mode of the methods that format with a
+	// precision, such as AppendFloat64Fixed. The shortest forms are never
+	// rounded: they always parse back to the same number.
+	Rounding RoundingMode
+
+	// TrimZeros removes the trailing zeros of the fraction, and then a
+	// trailing decimal point, from the output of the precision methods after
+	// rounding, as in "2.5" rather than "2.500" or "3" rather than "3.00".
+	// It is the "alt" form for compact output with a maximum precision; the
+	// General methods always trim.
+	TrimZeros bool
+}
+
+// Digit sets for Formatter.Digits.
+var (
+	// ArabicIndicDigits are the digits of Arabic: ٠١٢٣٤٥٦٧٨٩.
+	ArabicIndicDigits = [10]rune{'٠', '١', '٢', '٣', '٤', '٥', '٦', '٧', '٨', '٩'}
+	// PersianDigits are the extended Arabic-Indic digits of Persian and
+	// Urdu: ۰۱۲۳۴۵۶۷۸۹.
+	PersianDigits = [10]rune{'۰', '۱', '۲', '۳', '۴', '۵', '۶', '۷', '۸', '۹'}
+	// DevanagariDigits are the digits of Hindi and Marathi: ०१२३४५६७८९.
+	DevanagariDigits = [10]rune{'०', '१', '२', '३', '४', '५', '६', '७', '८', '९'}
+	// FullwidthDigits are the fullwidth forms used in East Asian text:
+	// ０１２３４５６７８９.
+	FullwidthDigits = [10]rune{'０', '１', '２', '３', '４', '５', '６', '７', '８', '９'}
+)
+
+// isPlain reports whether f sets no options but Backend and Renderer, so
+// that its AppendDecimal formats like the top-level functions.
+func (f *Formatter) isPlain() bool {
+	g := *f
+	g.Backend, g.Renderer = nil, nil
+	return g == Formatter{}
+}
+
+// plainBackend reports whether f is plain (see isPlain) and has no Renderer,
+// and returns its backend, or nil for Ryu, whose results the top-level
+// functions compute faster themselves.
+func (f *Formatter) plainBackend() (b Backend, ok bool) {
+	if f.Renderer != nil || !f.isPlain() {
+		return nil, false
+	}
+	if _, isRyu := f.Backend.(ryuBackend); isRyu {
+		return nil, true
+	}
+	return f.Backend, true
+}
+
+func (f *Formatter) backend() Backend {
+	if f.Backend == nil {
+		return ryuBackend{}
+	}
+	return f.Backend
+}
+
+// render appends d as rendered by f.Renderer, or by f itself if it is nil.
+func (f *Formatter) render(b []byte, d FloatDecimal) []byte {
+	if f.Renderer == nil {
+		return f.AppendDecimal(b, d)
+	}
+	if f.PositiveZero && d.Class == ClassZero {
+		d.Neg = false
+	}
+	start := len(b)
+	return f.pad(f.Renderer.AppendDecimal(b, d), start, 0)
+}
+
+// FormatFloat32 converts the 32-bit floating point number x to a string.
+func (f *Formatter) FormatFloat32(x float32) string {
+	b := make([]byte, 0, 15)
+	return unsafeString(f.AppendFloat32(b, x))
+}
+
+// AppendFloat32 appends the string form of the 32-bit floating point number x,
+// as generated by f.FormatFloat32, to b and returns the extended buffer.
+func (f *Formatter) AppendFloat32(b []byte, x float32) []byte {
+	if be, ok := f.plainBackend(); ok {
+		if be == nil {
+			return AppendFloat32(b, x)
+		}
+		return appendShortestE(b, be.Decimal32(x))
+	}
+	if f.PrecisionKind != PrecisionShortest {
+		return f.appendPrecision(b, float64(x), decodeBits32(math.Float32bits(x)))
+	}
+	return f.render(b, f.backend().Decimal32(x))
+}
+
+// FormatFloat64 converts the 64-bit floating point number x to a string.
+func (f *Formatter) FormatFloat64(x float64) string {
+	b := make([]byte, 0, 24)
+	return unsafeString(f.AppendFloat64(b, x))
+}
+
+// AppendFloat64 appends the string form of the 64-bit floating point number x,
+// as generated by f.FormatFloat64, to b and returns the extended buffer.
+func (f *Formatter) AppendFloat64(b []byte, x float64) []byte {
+	if be, ok := f.plainBackend(); ok {
+		if be == nil {
+			return AppendFloat64(b, x)
+		}
+		return appendShortestE(b, be.Decimal64(x))
+	}
+	if f.PrecisionKind != PrecisionShortest {
+		return f.appendPrecision(b, x, decodeBits64(math.Float64bits(x)))
+	}
+	return f.render(b, f.backend().Decimal64(x))
+}
+
+// FormatFloat32Bits is like FormatFloat32 for the float32 with the IEEE 754
+// binary representation u. See the top-level FormatFloat32Bits.
+func (f *Formatter) FormatFloat32Bits(u uint32) string {
+	b := make([]byte, 0, 15)
+	return unsafeString(f.AppendFloat32Bits(b, u))
+}
+
+// AppendFloat32Bits appends the string form of the float32 with the IEEE 754
+// binary representation u, as generated by f.FormatFloat32Bits, to b and
+// returns the extended buffer.
+func (f *Formatter) AppendFloat32Bits(b []byte, u uint32) []byte {
+	if be, ok := f.plainBackend(); ok && be == nil {
+		return AppendFloat32Bits(b, u)
+	}
+	if f.PrecisionKind != PrecisionShortest {
+		return f.appendPrecision(b, float64(math.Float32frombits(u)), decodeBits32(u))
+	}
+	// Only finite values, which cannot be altered by a trip through a
+	// floating-point register, are passed to the backend.
+	d := decodeBits32(u)
+	if d.Class == ClassNormal || d.Class == ClassSubnormal {
+		d = f.backend().Decimal32(math.Float32frombits(u))
+	}
+	return f.render(b, d)
+}
+
+// FormatFloat64Bits is like FormatFloat64 for the float64 with the IEEE 754
+// binary representation u. See the top-level FormatFloat64Bits.
+func (f *Formatter) FormatFloat64Bits(u uint64) string {
+	b := make([]byte, 0, 24)
+	return unsafeString(f.AppendFloat64Bits(b, u))
+}
+
+// AppendFloat64Bits appends the string form of the float64 with the IEEE 754
+// binary representation u, as generated by f.FormatFloat64Bits, to b and
+// returns the extended buffer.
+func (f *Formatter) AppendFloat64Bits(b []byte, u uint64) []byte {
+	if be, ok := f.plainBackend(); ok && be == nil {
+		return AppendFloat64Bits(b, u)
+	}
+	if f.PrecisionKind != PrecisionShortest {
+		return f.appendPrecision(b, math.Float64frombits(u), decodeBits64(u))
+	}
+	d := decodeBits64(u)
+	if d.Class == ClassNormal || d.Class == ClassSubnormal {
+		d = f.backend().Decimal64(math.Float64frombits(u))
+	}
+	return f.render(b, d)
+}
+
+// AppendDecimal appends the text form of d according to f's options, ignoring
+// f.Backend and f.Renderer, and returns the extended buffer.
+func (f *Formatter) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	if f.isPlain() {
+		return appendShortestE(b, d)
+	}
+	if f.PositiveZero && d.Class == ClassZero {
+		d.Neg = false
+	}
+	start := len(b)
+	switch {
+	case f.Notation == NotationPositional:
+		b = appendShortestF(b, d)
+	case f.Notation == NotationAuto && f.autoPositional(d):
+		b = appendShortestF(b, d)
+	case f.Notation == NotationEngineering && (d.Class == ClassNormal || d.Class == ClassSubnormal):
+		b = appendEngineeringDecimal(b, d)
+	case f.FractionalMantissa && (d.Class == ClassNormal || d.Class == ClassSubnormal):
+		b = appendFractionalE(b, d)
+	default:
+		b = appendShortestE(b, d)
+	}
+	return f.finish(b, start, d)
+}
+
+// group applies f.FractionGroup to the number in b[start:].
+func (f *Formatter) group(b []byte, start int) []byte {
+	if f.FractionGroup > 0 {
+		sep := f.FractionSeparator
+		if sep == "" {
+			sep = " "
+		}
+		b = groupFraction(b, start, f.FractionGroup, sep)
+	}
+	return b
+}
+
+// The precision methods below round according to f.Rounding and trim zeros
+// if f.TrimZeros is set. They lay out their digits themselves, ignoring
+// Backend, Renderer, Notation, AutoRange, Precision, PrecisionKind, and
+// FractionalMantissa; all of f's other options, such as PointZero, Upper,
+// DecimalPoint, the grouping, Digits, and Width, apply as they do to the
+// shortest form.
+
+// FormatFloat64Fixed is like the top-level FormatFloat64Fixed but rounds
+// according to f.Rounding.
+func (f *Formatter) FormatFloat64Fixed(x float64, prec int) string {
+	b := make([]byte, 0, 24+prec)
+	return unsafeString(f.AppendFloat64Fixed(b, x, prec))
+}
+
+// AppendFloat64Fixed appends the string form of x with prec digits after the
+// decimal point, as generated by f.FormatFloat64Fixed, to b and returns the
+// extended buffer.
+func (f *Formatter) AppendFloat64Fixed(b []byte, x float64, prec int) []byte {
+	start := len(b)
+	return f.decorate(appendFloat64Fixed(b, x, prec, f.Rounding), start, decodeBits64(math.Float64bits(x)))
+}
+
+// FormatFloat64Exp is like the top-level FormatFloat64Exp but rounds
+// according to f.Rounding.
+func (f *Formatter) FormatFloat64Exp(x float64, prec int) string {
+	b := make([]byte, 0, 24+prec)
+	return unsafeString(f.AppendFloat64Exp(b, x, prec))
+}
+
+// AppendFloat64Exp appends the string form of x in scientific notation with
+// prec digits after the decimal point, as generated by f.FormatFloat64Exp, to
+// b and returns the extended buffer.
+func (f *Formatter) AppendFloat64Exp(b []byte, x float64, prec int) []byte {
+	start := len(b)
+	return f.decorate(appendFloat64Exp(b, x, prec, f.Rounding), start, decodeBits64(math.Float64bits(x)))
+}
+
+// FormatFloat64General is like the top-level FormatFloat64General but rounds
+// according to f.Rounding.
+func (f *Formatter) FormatFloat64General(x float64, prec int) string {
+	b := make([]byte, 0, 24)
+	return unsafeString(f.AppendFloat64General(b, x, prec))
+}
+
+// AppendFloat64General appends the string form of x with prec significant
+// digits, as generated by f.FormatFloat64General, to b and returns the
+// extended buffer.
+func (f *Formatter) AppendFloat64General(b []byte, x float64, prec int) []byte {
+	start := len(b)
+	return f.decorate(appendFloat64General(b, x, prec, f.Rounding, [2]int{}), start, decodeBits64(math.Float64bits(x)))
+}
+
+// FormatFloat64Percent is like the top-level FormatFloat64Percent but rounds
+// according to f.Rounding.
+func (f *Formatter) FormatFloat64Percent(x float64, prec int) string {
+	b := make([]byte, 0, 24+prec)
+	return unsafeString(f.AppendFloat64Percent(b, x, prec))
+}
+
+// AppendFloat64Percent appends the percentage form of x, as generated by
+// f.FormatFloat64Percent, to b and returns the extended buffer.
+func (f *Formatter) AppendFloat64Percent(b []byte, x float64, prec int) []byte {
+	start := len(b)
+	return f.decorate(appendScaled(b, x, prec, 2, f.Rounding, "%"), start, decodeBits64(math.Float64bits(x)))
+}
+
+// FormatFloat64Permille is like the top-level FormatFloat64Permille but
+// rounds according to f.Rounding.
+func (f *Formatter) FormatFloat64Permille(x float64, prec int) string {
+	b := make([]byte, 0, 24+prec)
+	return unsafeString(f.AppendFloat64Permille(b, x, prec))
+}
+
+// AppendFloat64Permille appends the per mille form of x, as generated by
+// f.FormatFloat64Permille, to b and returns the extended buffer.
+func (f *Formatter) AppendFloat64Permille(b []byte, x float64, prec int) []byte {
+	start := len(b)
+	return f.decorate(appendScaled(b, x, prec, 3, f.Rounding, "‰"), start, decodeBits64(math.Float64bits(x)))
+}
+
+// autoPositional reports whether NotationAuto prints the shortest form d in
+// positional notation.
+func (f *Formatter) autoPositional(d FloatDecimal) bool {
+	if f.AutoRange == [2]int{} {
+		return !usesExponentG(d)
+	}
+	if d.Class != ClassNormal && d.Class != ClassSubnormal {
+		return true
+	}
+	exp := int(d.Exp) + decimalLen64(d.Digits) - 1
+	return f.AutoRange[0] <= exp && exp < f.AutoRange[1]
+}
+
+// appendPrecision appends x rounded to f.Precision digits of the kind
+// f.PrecisionKind. d is the class of the number that x came from.
+func (f *Formatter) appendPrecision(b []byte, x float64, d FloatDecimal) []byte {
+	start := len(b)
+	prec := f.Precision
+	sci := f.Notation == NotationScientific
+	if f.Notation == NotationAuto {
+		// The exponent of the shortest form is that of x itself.
+		sci = !f.autoPositional(Decimal64(x))
+	}
+	switch {
+	case f.Notation == NotationEngineering:
+		b = appendFloat64Engineering(b, x, prec, f.PrecisionKind == PrecisionSignificant, f.Rounding)
+	case f.PrecisionKind == PrecisionSignificant && f.Notation == NotationAuto:
+		b = appendFloat64General(b, x, prec, f.Rounding, f.AutoRange)
+	case f.PrecisionKind == PrecisionSignificant && sci:
+		b = appendFloat64Exp(b, x, prec-1, f.Rounding)
+	case f.PrecisionKind == PrecisionSignificant:
+		b = appendFloat64SigPositional(b, x, prec, f.Rounding)
+	case sci:
+		b = appendFloat64Exp(b, x, prec, f.Rounding)
+	default:
+		b = appendFloat64Fixed(b, x, prec, f.Rounding)
+	}
+	return f.decorate(b, start, d)
+}
+
+// decorate applies f.PositiveZero, f.TrimZeros, and the options that finish
+// applies to the number of the class d formatted in b[start:].
+func (f *Formatter) decorate(b []byte, start int, d FloatDecimal) []byte {
+	if f.PositiveZero && d.Class == ClassZero && d.Neg {
+		b = append(b[:start], b[start+1:]...)
+		d.Neg = false
+	}
+	if f.TrimZeros {
+		b = trimZeros(b, start)
+	}
+	return f.finish(b, start, d)
+}
+
+// finish applies the options common to all forms, f.PointZero through
+// f.Annotate, to the number of the class d formatted in b[start:].
+func (f *Formatter) finish(b []byte, start int, d FloatDecimal) []byte {
+	if f.NaNPayload && d.Class == ClassNaN {
+		b = appendNaNPayload(b[:start], d)
+	}
+	if f.PointZero {
+		b = pointZero(b, start)
+	}
+	// A NaN payload may contain the hex digit 'e', which is no exponent.
+	if isFinite(d) {
+		b = f.exponent(b, start)
+		if f.Superscript {
+			b = superscript(b, start)
+		}
+	}
+	if f.Upper {
+		upper(b[start:])
+	}
+	// The marker replaces the exponent's 'e' last, so that the options in
+	// between, Digits in particular, leave it as given; pad counts it ahead.
+	marker, extra := f.marker(), 0
+	if marker == "" || f.Superscript || !isFinite(d) || bytes.IndexAny(b[start:], "eE") < 0 {
+		marker = ""
+	} else {
+		extra = utf8.RuneCountInString(marker) - 1
+	}
+	b = f.group(b, start)
+	if f.DecimalPoint != 0 && f.DecimalPoint != '.' {
+		b = decimalPoint(b, start, f.DecimalPoint)
+	}
+	if f.IntegerGroup > 0 {
+		b = f.groupInteger(b, start)
+	}
+	b = f.sign(b, start)
+	if f.UnicodeMinus {
+		b = unicodeMinus(b, start)
+	}
+	b = f.special(b, start, d)
+	b = f.pad(b, start, extra)
+	if f.Digits != ([10]rune{}) && isFinite(d) {
+		b = mapDigits(b, start, &f.Digits)
+	}
+	if marker != "" {
+		b = expMarker(b, start, marker)
+	}
+	if f.Annotate {
+		b = appendClass(b, d)
+	}
+	return b
+}
+
+// upper converts the ASCII letters of b to upper case.
+func upper(b []byte) {
+	for i, c := range b {
+		if 'a' <= c && c <= 'z' {
+			b[i] = c - 'a' + 'A'
+		}
+	}
+}
+
+// sign applies f.Plus and f.Space to the number in b[start:].
+func (f *Formatter) sign(b []byte, start int) []byte {
+	if !f.Plus && !f.Space || start == len(b) || b[start] == '-' {
+		return b
+	}
+	if b[start] == '+' {
+		if !f.Plus {
+			b[start] = ' '
+		}
+		return b
+	}
+	c := byte(' ')
+	if f.Plus {
+		c = '+'
+	}
+	return insertByte(b, start, c)
+}
+
+// isFinite reports whether d is neither an infinity nor a NaN.
+func isFinite(d FloatDecimal) bool {
+	return d.Class != ClassInf && d.Class != ClassNaN
+}
+
+// marker returns the replacement of the exponent's 'e' that f asks for, if
+// any.
+func (f *Formatter) marker() string {
+	if f.ExpMarker == "" && f.FortranD {
+		return "D"
+	}
+	return f.ExpMarker
+}
+
+// expMarker replaces the 'e' or 'E' that starts the exponent of the number in
+// b[start:], if it has one, with marker. The exponent is the last 'e' or 'E',
+// after any in the separators.
+func expMarker(b []byte, start int, marker string) []byte {
+	i := bytes.LastIndexAny(b[start:], "eE")
+	if i < 0 {
+		return b
+	}
+	i += start
+	if n := len(marker) - 1; n > 0 {
+		b = append(b, marker[1:]...)
+		copy(b[i+1+n:], b[i+1:len(b)-n])
+	} else {
+		b = append(b[:i+1+n], b[i+1:]...)
+	}
+	copy(b[i:], marker)
+	return b
+}
+
+// appendNaNPayload appends the NaN d with its sign and payload.
+func appendNaNPayload(b []byte, d FloatDecimal) []byte {
+	if d.Neg {
+		b = append(b, '-')
+	}
+	if !d.Quiet {
+		b = append(b, 's')
+	}
+	b = append(b, "NaN(0x"...)
+	b = strconv.AppendUint(b, d.Payload, 16)
+	return append(b, ')')
+}
+
+// special replaces the infinity or NaN in b[start:], whose class is that of
+// d, with its spelling in f, if it has one.
+func (f *Formatter) special(b []byte, start int, d FloatDecimal) []byte {
+	var s string
+	switch {
+	case d.Class == ClassNaN:
+		s = f.NaN
+	case d.Class != ClassInf:
+	case !d.Neg:
+		s = f.Inf
+	case f.NegInf != "":
+		s = f.NegInf
+	case f.Inf != "":
+		b = append(b[:start], '-')
+		if f.Inf[0] == '+' {
+			return append(b, f.Inf[1:]...)
+		}
+		return append(b, f.Inf...)
+	}
+	if s == "" {
+		return b
+	}
+	return append(b[:start], s...)
+}
+
+// groupInteger applies f.IntegerGroup and f.IntegerGroup2 to the number in
+// b[start:].
+func (f *Formatter) groupInteger(b []byte, start int) []byte {
+	i := start
+	if i < len(b) && b[i] == '-' {
+		i++
+	}
+	j := i
+	for j < len(b) && '0' <= b[j] && b[j] <= '9' {
+		j++
+	}
+	if j-i <= f.IntegerGroup {
+		return b
+	}
+	group2 := f.IntegerGroup2
+	if group2 <= 0 {
+		group2 = f.IntegerGroup
+	}
+	sep := f.IntegerSeparator
+	if sep == "" {
+		sep = ","
+	}
+	var buf [32]byte
+	tail := append(buf[:0], b[i:]...)
+	b = b[:i]
+	n := j - i
+	for k := 0; k < n; k++ {
+		if k > 0 && groupBefore(n-k, f.IntegerGroup, group2) {
+			b = append(b, sep...)
+		}
+		b = append(b, tail[k])
+	}
+	return append(b, tail[n:]...)
+}
+
+// mapDigits replaces the ASCII digits in b[start:] with those of digits.
+func mapDigits(b []byte, start int, digits *[10]rune) []byte {
+	n := len(b)
+	for _, c := range b[start:n] {
+		if '0' <= c && c <= '9' {
+			b = appendSpaces(b, utf8.RuneLen(digits[c-'0'])-1)
+		}
+	}
+	// Work from the end so that no byte is overwritten before it is read.
+	j := len(b)
+	for i := n - 1; i >= start; i-- {
+		c := b[i]
+		if c < '0' || c > '9' {
+			j--
+			b[j] = c
+			continue
+		}
+		r := digits[c-'0']
+		j -= utf8.RuneLen(r)
+		utf8.EncodeRune(b[j:], r)
+	}
+	return b
+}
+
+// decimalPoint replaces the '.' in b[start:], if there is one, with r.
+func decimalPoint(b []byte, start int, r rune) []byte {
+	i := bytes.IndexByte(b[start:], '.')
+	if i < 0 {
+		return b
+	}
+	i += start
+	var buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], r)
+	b = append(b, buf[1:n]...)
+	copy(b[i+n:], b[i+1:len(b)-n+1])
+	copy(b[i:], buf[:n])
+	return b
+}
+
+const minusSign = "\u2212"
+
+// unicodeMinus replaces the '-' signs in b[start:] with minusSign.
+func unicodeMinus(b []byte, start int) []byte {
+	for {
+		i := bytes.IndexByte(b[start:], '-')
+		if i < 0 {
+			return b
+		}
+		i += start
+		b = append(b, minusSign[1:]...)
+		copy(b[i+len(minusSign):], b[i+1:len(b)-len(minusSign)+1])
+		copy(b[i:], minusSign)
+		start = i + len(minusSign)
+	}
+}
+
+// pad pads the number in b[start:] to f.Width, counting extra runes that
+// are yet to be added.
+func (f *Formatter) pad(b []byte, start, extra int) []byte {
+	n := f.Width - utf8.RuneCount(b[start:]) - extra
+	if n <= 0 {
+		return b
+	}
+	if f.LeftAlign {
+		return appendSpaces(b, n)
+	}
+	// Zeros go after the sign, and only before digits.
+	i := start
+	if i < len(b) && (b[i] == '-' || b[i] == '+' || b[i] == ' ') {
+		i++
+	} else if bytes.HasPrefix(b[i:], []byte(minusSign)) {
+		i += len(minusSign)
+	}
+	c := byte(' ')
+	if f.ZeroPad && i < len(b) && '0' <= b[i] && b[i] <= '9' {
+		c = '0'
+	} else {
+		i = start
+	}
+	b = appendSpaces(b, n)
+	copy(b[i+n:], b[i:len(b)-n])
+	for j := i; j < i+n; j++ {
+		b[j] = c
+	}
+	return b
+}
+
+const spaces = "                                "
+
+// appendSpaces appends n spaces.
+func appendSpaces(b []byte, n int) []byte {
+	for n > len(spaces) {
+		b = append(b, spaces...)
+		n -= len(spaces)
+	}
+	return append(b, spaces[:n]...)
+}
+
+// pointZero inserts ".0" after the integer digits of the number in b[start:]
+// if it is finite and has no decimal point.
+func pointZero(b []byte, start int) []byte {
+	i := start
+	if i < len(b) && b[i] == '-' {
+		i++
+	}
+	if i == len(b) || b[i] < '0' || b[i] > '9' || bytes.IndexByte(b[i:], '.') >= 0 {
+		return b
+	}
+	for i < len(b) && '0' <= b[i] && b[i] <= '9' {
+		i++
+	}
+	b = append(b, ".0"...)
+	copy(b[i+2:], b[i:len(b)-2])
+	b[i] = '.'
+	b[i+1] = '0'
+	return b
+}
+
+// exponent applies f.ExpDigits and f.CompactExp to the number in b[start:].
+func (f *Formatter) exponent(b []byte, start int) []byte {
+	n := f.ExpDigits
+	if n == 0 && (f.CompactExp || f.Superscript) {
+		n = 1
+	}
+	if n > 0 {
+		b = expDigits(b, start, n)
+	}
+	if f.CompactExp {
+		if i := bytes.IndexByte(b[start:], 'e'); i >= 0 && b[start+i+1] == '+' {
+			b = append(b[:start+i+1], b[start+i+2:]...)
+		}
+	}
+	return b
+}
+
+var superscripts = [10]string{"⁰", "¹", "²", "³", "⁴", "⁵", "⁶", "⁷", "⁸", "⁹"}
+
+// superscript rewrites the exponent of the number in b[start:], if it has
+// one, as a power of ten with superscript digits.
+func superscript(b []byte, start int) []byte {
+	i := bytes.IndexByte(b[start:], 'e')
+	if i < 0 {
+		return b
+	}
+	i += start
+	var buf [32]byte
+	tail := append(buf[:0], b[i+1:]...)
+	b = append(b[:i], "×10"...)
+	k := 0
+	if k < len(tail) && (tail[k] == '+' || tail[k] == '-') {
+		if tail[k] == '-' {
+			b = append(b, "⁻"...)
+		}
+		k++
+	}
+	for ; k < len(tail) && '0' <= tail[k] && tail[k] <= '9'; k++ {
+		b = append(b, superscripts[tail[k]-'0']...)
+	}
+	return append(b, tail[k:]...)
+}
+
+// expDigits rewrites the exponent of the number in b[start:], if it has one,
+// with the leading zeros that give it at least n digits.
+func expDigits(b []byte, start, n int) []byte {
+	i := bytes.IndexByte(b[start:], 'e')
+	if i < 0 {
+		return b
+	}
+	i += start + 2 // after the sign
+	j := i
+	for j+1 < len(b) && b[j] == '0' && '0' <= b[j+1] && b[j+1] <= '9' {
+		j++
+	}
+	end := j
+	for end < len(b) && '0' <= b[end] && b[end] <= '9' {
+		end++
+	}
+	// Replace the zeros in b[i:j] with as many as needed.
+	z := n - (end - j)
+	if z < 0 {
+		z = 0
+	}
+	if k := z - (j - i); k > 0 {
+		b = appendZeros(b, k)
+		copy(b[j+k:], b[j:len(b)-k])
+	} else {
+		copy(b[i+z:], b[j:])
+		b = b[:len(b)+k]
+	}
+	for k := i; k < i+z; k++ {
+		b[k] = '0'
+	}
+	return b
+}
+
+// trimZeros removes the trailing zeros of the fraction of the number in
+// b[start:], and the decimal point if no digits follow it, moving any
+// exponent or suffix left to close the gap.
+func trimZeros(b []byte, start int) []byte {
+	dot := bytes.IndexByte(b[start:], '.')
+	if dot < 0 {
+		return b
+	}
+	dot += start
+	end := dot + 1
+	for end < len(b) && '0' <= b[end] && b[end] <= '9' {
+		end++
+	}
+	i := end
+	for b[i-1] == '0' {
+		i--
+	}
+	if i == dot+1 {
+		i = dot
+	}
+	return append(b[:i], b[end:]...)
+}
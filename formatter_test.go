@@ -0,0 +1,923 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestBackends(t *testing.T) {
+	names := Backends()
+	for _, name := range []string{"reference", "ryu", "strconv", "strconv-fallback"} {
+		if i := sort.SearchStrings(names, name); i == len(names) || names[i] != name {
+			t.Fatalf("Backends(): got %q; want %s among them", names, name)
+		}
+	}
+	for _, name := range names {
+		b, ok := LookupBackend(name)
+		if !ok {
+			t.Fatalf("LookupBackend(%q) failed", name)
+		}
+		f := Formatter{Backend: b}
+		for _, x := range append(genericTestCases, float64TestCases...) {
+			if got, want := f.FormatFloat64(x), FormatFloat64(x); got != want {
+				t.Errorf("%s: FormatFloat64(%g): got %q; want %q", name, x, got, want)
+			}
+			if got, want := f.FormatFloat32(float32(x)), FormatFloat32(float32(x)); got != want {
+				t.Errorf("%s: FormatFloat32(%g): got %q; want %q", name, x, got, want)
+			}
+		}
+	}
+	if _, ok := LookupBackend("nonexistent"); ok {
+		t.Error("LookupBackend found a nonexistent backend")
+	}
+}
+
+// TestBackendsRandom checks that the backends agree with Ryu on random numbers
+// and on the powers of 2, whose rounding intervals are lopsided.
+func TestBackendsRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, name := range Backends() {
+		b, _ := LookupBackend(name)
+		n := 100000
+		if name == "reference" {
+			n = 2000
+		}
+		check := func(u64 uint64, u32 uint32) {
+			t.Helper()
+			x, y := math.Float64frombits(u64), math.Float32frombits(u32)
+			if got, want := b.Decimal64(x), Decimal64(x); got != want {
+				t.Errorf("%s: Decimal64(%g): got %+v; want %+v", name, x, got, want)
+			}
+			if got, want := b.Decimal32(y), Decimal32(y); got != want {
+				t.Errorf("%s: Decimal32(%g): got %+v; want %+v", name, y, got, want)
+			}
+		}
+		for e := uint64(0); e < 1<<expBits64-1; e++ {
+			check(e<<mantBits64, uint32(e%(1<<expBits32-1))<<mantBits32)
+		}
+		for i := 0; i < n; i++ {
+			check(r.Uint64(), r.Uint32())
+		}
+	}
+}
+
+// TestFallbackBackend checks that the strconv-fallback backend replaces
+// decimals that do not parse back to the number.
+func TestFallbackBackend(t *testing.T) {
+	for _, tt := range []struct {
+		d    FloatDecimal
+		bits uint64
+		want bool
+	}{
+		{FloatDecimal{Digits: 15, Exp: -1, Class: ClassNormal}, math.Float64bits(1.5), true},
+		{FloatDecimal{Digits: 16, Exp: -1, Class: ClassNormal}, math.Float64bits(1.5), false},
+		{FloatDecimal{Digits: 15, Exp: -1, Neg: true, Class: ClassNormal}, math.Float64bits(1.5), false},
+		{FloatDecimal{Class: ClassNaN}, math.Float64bits(math.NaN()), true},
+	} {
+		if got := roundTrips(tt.d, tt.bits, &float64info); got != tt.want {
+			t.Errorf("roundTrips(%+v, %#x): got %t; want %t", tt.d, tt.bits, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkFormatterBackend(b *testing.B) {
+	for _, name := range Backends() {
+		be, _ := LookupBackend(name)
+		f := Formatter{Backend: be}
+		b.Run(name, func(b *testing.B) {
+			var buf []byte
+			for i := 0; i < b.N; i++ {
+				buf = f.AppendFloat64(buf[:0], -123.45)
+			}
+			sinkb = buf
+		})
+	}
+}
+
+func TestRegisterBackendDuplicate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("RegisterBackend did not panic for a duplicate name")
+		}
+	}()
+	RegisterBackend("ryu", ryuBackend{})
+}
+
+func TestFormatterZero(t *testing.T) {
+	var f Formatter
+	for _, x := range genericTestCases {
+		if got, want := f.FormatFloat64(x), strconv.FormatFloat(x, 'e', -1, 64); got != want {
+			t.Errorf("FormatFloat64(%g): got %q; want %q", x, got, want)
+		}
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	f := Formatter{Annotate: true}
+	for _, tt := range []struct {
+		x    float64
+		want string
+	}{
+		{1.5, "1.5e+00 (normal)"},
+		{1.5e-310, "1.5e-310 (subnormal)"},
+		{0, "0e+00 (+0)"},
+		{math.Copysign(0, -1), "-0e+00 (-0)"},
+		{math.Inf(-1), "-Inf (infinite)"},
+		{math.NaN(), "NaN (quiet, payload 0x1)"},
+		{math.Float64frombits(0x7ff0000000000003), "NaN (signaling, payload 0x3)"},
+		{math.Float64frombits(0xfff8000000000000), "NaN (negative quiet, payload 0x0)"},
+	} {
+		if got := f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("FormatFloat64(%#x): got %q; want %q", math.Float64bits(tt.x), got, tt.want)
+		}
+	}
+	if got, want := f.FormatFloat32(math.Float32frombits(0x7fc00000)), "NaN (quiet, payload 0x0)"; got != want {
+		t.Errorf("FormatFloat32(quiet NaN): got %q; want %q", got, want)
+	}
+	if got, want := f.FormatFloat32(1e-40), "1e-40 (subnormal)"; got != want {
+		t.Errorf("FormatFloat32(1e-40): got %q; want %q", got, want)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	for _, tt := range []struct {
+		x    float64
+		want Class
+	}{
+		{0, ClassZero},
+		{5e-324, ClassSubnormal},
+		{math.SmallestNonzeroFloat64 * (1 << 52), ClassNormal},
+		{-1, ClassNormal},
+		{math.Inf(1), ClassInf},
+		{math.NaN(), ClassNaN},
+	} {
+		if got := Classify64(tt.x); got != tt.want {
+			t.Errorf("Classify64(%g): got %s; want %s", tt.x, got, tt.want)
+		}
+		if tt.x != 0 && math.Abs(tt.x) < 1 {
+			continue // not representable as a float32
+		}
+		if got := Classify32(float32(tt.x)); got != tt.want {
+			t.Errorf("Classify32(%g): got %s; want %s", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFractionalMantissa(t *testing.T) {
+	f := Formatter{FractionalMantissa: true}
+	for _, tt := range []struct {
+		x    float64
+		want string
+	}{
+		{1.5, "0.15e+01"},
+		{-100, "-0.1e+03"},
+		{0.01, "0.1e-01"},
+		{0.5, "0.5e+00"},
+		{123456789, "0.123456789e+09"},
+		{5e-324, "0.5e-323"},
+		{math.MaxFloat64, "0.17976931348623157e+309"},
+		{0, "0e+00"},
+		{math.Inf(1), "+Inf"},
+	} {
+		if got := f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("FormatFloat64(%g): got %q; want %q", tt.x, got, tt.want)
+		}
+	}
+	if got, want := f.FormatFloat32(0.25), "0.25e+00"; got != want {
+		t.Errorf("FormatFloat32(0.25): got %q; want %q", got, want)
+	}
+}
+
+func TestFormatterBits(t *testing.T) {
+	f := Formatter{Annotate: true}
+	for _, tt := range []struct {
+		u    uint64
+		want string
+	}{
+		{0x3ff8000000000000, "1.5e+00 (normal)"},
+		{0x7ff0000000000001, "NaN (signaling, payload 0x1)"},
+		{0xfff8000000000002, "NaN (negative quiet, payload 0x2)"},
+	} {
+		if got := f.FormatFloat64Bits(tt.u); got != tt.want {
+			t.Errorf("FormatFloat64Bits(%#x): got %q; want %q", tt.u, got, tt.want)
+		}
+	}
+	if got, want := f.FormatFloat32Bits(0x7f800003), "NaN (signaling, payload 0x3)"; got != want {
+		t.Errorf("FormatFloat32Bits(0x7f800003): got %q; want %q", got, want)
+	}
+	if got, want := (&Formatter{}).FormatFloat32Bits(0x3fc00000), "1.5e+00"; got != want {
+		t.Errorf("FormatFloat32Bits(0x3fc00000): got %q; want %q", got, want)
+	}
+}
+
+func TestFractionGroup(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{FractionGroup: 3}, math.Pi, "3.141 592 653 589 793e+00"},
+		{Formatter{FractionGroup: 3}, 1.234, "1.234e+00"},
+		{Formatter{FractionGroup: 3}, 1.2345, "1.234 5e+00"},
+		{Formatter{FractionGroup: 3}, 7, "7e+00"},
+		{Formatter{FractionGroup: 5, FractionSeparator: "\u2009"}, -math.Pi, "-3.14159\u200926535\u200989793e+00"},
+		{Formatter{FractionGroup: 2, FractionalMantissa: true, Annotate: true}, 12345, "0.12 34 5e+05 (normal)"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFormatterPrecision(t *testing.T) {
+	f := Formatter{FractionGroup: 3, Annotate: true, Rounding: ToZero}
+	for _, tt := range []struct {
+		got  string
+		want string
+	}{
+		{f.FormatFloat64Fixed(math.Pi, 7), "3.141 592 6 (normal)"},
+		{f.FormatFloat64Exp(-math.Pi*1e10, 4), "-3.141 5e+10 (normal)"},
+		{f.FormatFloat64General(1e-310, 4), "9.999e-311 (subnormal)"},
+		{f.FormatFloat64Fixed(math.Inf(1), 2), "+Inf (infinite)"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("got %q; want %q", tt.got, tt.want)
+		}
+	}
+}
+
+func TestTrimZeros(t *testing.T) {
+	f := Formatter{TrimZeros: true}
+	for _, tt := range []struct {
+		got  string
+		want string
+	}{
+		{f.FormatFloat64Fixed(2.5, 3), "2.5"},
+		{f.FormatFloat64Fixed(2.9999, 2), "3"},
+		{f.FormatFloat64Fixed(100, 2), "100"},
+		{f.FormatFloat64Fixed(-0.0001, 3), "-0"},
+		{f.FormatFloat64Fixed(120, 0), "120"},
+		{f.FormatFloat64Exp(1e23, 4), "1e+23"},
+		{f.FormatFloat64Exp(-1.2345e-7, 6), "-1.2345e-07"},
+		{f.FormatFloat64Exp(100, 0), "1e+02"},
+		{f.FormatFloat64General(0.5, 5), "0.5"},
+		{f.FormatFloat64Fixed(math.NaN(), 2), "NaN"},
+		{(&Formatter{TrimZeros: true, FractionGroup: 3}).FormatFloat64Fixed(1.5, 8), "1.5"},
+		{(&Formatter{TrimZeros: true, FractionGroup: 3}).FormatFloat64Exp(1.5e10, 8), "1.5e+10"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("got %q; want %q", tt.got, tt.want)
+		}
+	}
+}
+
+func TestNotation(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{Notation: NotationPositional}, 1.234e-6, "0.000001234"},
+		{Formatter{Notation: NotationPositional}, 0, "0"},
+		{Formatter{Notation: NotationAuto}, 1.234e-6, "1.234e-06"},
+		{Formatter{Notation: NotationAuto}, 0.0001234, "0.0001234"},
+		{Formatter{Notation: NotationAuto}, 123456, "123456"},
+		{Formatter{Notation: NotationAuto}, 1234567, "1.234567e+06"},
+		{Formatter{Notation: NotationAuto}, math.Inf(1), "+Inf"},
+		{Formatter{Notation: NotationAuto, FractionalMantissa: true}, 1.5, "1.5"},
+		{Formatter{Notation: NotationAuto, FractionalMantissa: true}, 1.5e10, "0.15e+11"},
+		{Formatter{Notation: NotationPositional, FractionGroup: 3}, math.Pi, "3.141 592 653 589 793"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+	if got, want := NotationAuto.String(), "NotationAuto"; got != want {
+		t.Errorf("NotationAuto.String() = %q; want %q", got, want)
+	}
+
+	auto := Formatter{Notation: NotationAuto}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		u := r.Uint64()
+		x := math.Float64frombits(u)
+		if i%2 == 0 {
+			x = r.NormFloat64() * math.Pow(10, float64(r.Intn(20)-10))
+		}
+		if got, want := auto.FormatFloat64(x), strconv.FormatFloat(x, 'g', -1, 64); got != want {
+			t.Fatalf("FormatFloat64(%b): got %q; want %q", x, got, want)
+		}
+		x32 := math.Float32frombits(uint32(u))
+		if got, want := auto.FormatFloat32(x32), strconv.FormatFloat(float64(x32), 'g', -1, 32); got != want {
+			t.Fatalf("FormatFloat32(%b): got %q; want %q", x32, got, want)
+		}
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	sig := func(n Notation, prec int) Formatter {
+		return Formatter{Notation: n, Precision: prec, PrecisionKind: PrecisionSignificant}
+	}
+	dec := func(n Notation, prec int) Formatter {
+		return Formatter{Notation: n, Precision: prec, PrecisionKind: PrecisionDecimals}
+	}
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{sig(NotationScientific, 3), 123456, "1.23e+05"},
+		{sig(NotationScientific, 0), 123456, "1e+05"},
+		{sig(NotationPositional, 3), 123456, "123000"},
+		{sig(NotationPositional, 3), 1.5, "1.50"},
+		{sig(NotationPositional, 3), 0.0012, "0.00120"},
+		{sig(NotationPositional, 3), 0.0009996, "0.00100"},
+		{sig(NotationPositional, 3), 0, "0.00"},
+		{sig(NotationAuto, 3), 123456, "1.23e+05"},
+		{sig(NotationAuto, 3), 1.5, "1.5"},
+		{dec(NotationScientific, 2), 123456, "1.23e+05"},
+		{dec(NotationPositional, 2), 123456.789, "123456.79"},
+		{dec(NotationPositional, 0), -0.5, "-0"},
+		{dec(NotationAuto, 2), 123456.789, "123456.79"},
+		{dec(NotationAuto, 2), 1234567.89, "1.23e+06"},
+		{dec(NotationAuto, 2), 0.0001, "0.00"},
+		{dec(NotationAuto, 2), 0.00009, "9.00e-05"},
+		{dec(NotationPositional, 2), math.NaN(), "NaN"},
+		{Formatter{Precision: 2, PrecisionKind: PrecisionDecimals, Rounding: ToPositiveInf, TrimZeros: true}, 1.001, "1.01e+00"},
+		{Formatter{Precision: 4, PrecisionKind: PrecisionSignificant, Notation: NotationPositional, FractionGroup: 2, Annotate: true}, math.Pi, "3.14 2 (normal)"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+
+	f := dec(NotationPositional, 10)
+	if got, want := f.FormatFloat32(0.1), "0.1000000015"; got != want {
+		t.Errorf("%+v: FormatFloat32(0.1): got %q; want %q", f, got, want)
+	}
+	f.Annotate = true
+	if got, want := f.FormatFloat32Bits(0x7fc00001), "NaN (quiet, payload 0x1)"; got != want {
+		t.Errorf("%+v: FormatFloat32Bits(0x7fc00001): got %q; want %q", f, got, want)
+	}
+}
+
+func TestEngineering(t *testing.T) {
+	eng := Formatter{Notation: NotationEngineering}
+	sig := func(prec int) Formatter {
+		return Formatter{Notation: NotationEngineering, Precision: prec, PrecisionKind: PrecisionSignificant}
+	}
+	dec := func(prec int) Formatter {
+		return Formatter{Notation: NotationEngineering, Precision: prec, PrecisionKind: PrecisionDecimals}
+	}
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{eng, 12500, "12.5e+03"},
+		{eng, 1.5, "1.5e+00"},
+		{eng, 100, "100e+00"},
+		{eng, 1e4, "10e+03"},
+		{eng, -1.234e-6, "-1.234e-06"},
+		{eng, 0.000123, "123e-06"},
+		{eng, 5e-324, "5e-324"},
+		{eng, 0, "0e+00"},
+		{eng, math.Inf(1), "+Inf"},
+		{sig(2), 123456, "120e+03"},
+		{sig(4), 123456, "123.5e+03"},
+		{sig(1), 0.00999, "10e-03"},
+		{sig(3), 0.999999, "1.00e+00"},
+		{sig(3), 0, "0.00e+00"},
+		{dec(2), 12345, "12.34e+03"},
+		{dec(2), 12346, "12.35e+03"},
+		{dec(1), 999.96, "1.0e+03"},
+		{dec(1), 99.96, "100.0e+00"},
+		{dec(0), -0.001234, "-1e-03"},
+		{dec(2), 0, "0.00e+00"},
+		{dec(2), math.NaN(), "NaN"},
+		{Formatter{Notation: NotationEngineering, Precision: 1, PrecisionKind: PrecisionDecimals, Rounding: ToPositiveInf}, 999.01, "999.1e+00"},
+		{Formatter{Notation: NotationEngineering, Precision: 0, PrecisionKind: PrecisionDecimals, Rounding: ToPositiveInf}, 999.01, "1e+03"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestWidth(t *testing.T) {
+	pattern, err := CompilePattern("0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixed := func(f Formatter) Formatter {
+		f.Precision = 2
+		f.PrecisionKind = PrecisionDecimals
+		f.Notation = NotationPositional
+		return f
+	}
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{Width: 10}, 1.5, "   1.5e+00"},
+		{Formatter{Width: 10, LeftAlign: true}, 1.5, "1.5e+00   "},
+		{Formatter{Width: 10, ZeroPad: true}, -1.5, "-001.5e+00"},
+		{Formatter{Width: 10, ZeroPad: true, LeftAlign: true}, -1.5, "-1.5e+00  "},
+		{Formatter{Width: 3}, -1.5, "-1.5e+00"},
+		{Formatter{Width: 6, ZeroPad: true}, math.Inf(1), "  +Inf"},
+		{Formatter{Width: 6, ZeroPad: true}, math.NaN(), "   NaN"},
+		{Formatter{Width: 40}, 1, "                                   1e+00"},
+		{fixed(Formatter{Width: 8}), 3.14159, "    3.14"},
+		{fixed(Formatter{Width: 8, ZeroPad: true}), -3.14159, "-0003.14"},
+		{fixed(Formatter{Width: 8, Annotate: true}), 3.14159, "    3.14 (normal)"},
+		{fixed(Formatter{Width: 12, FractionGroup: 1, FractionSeparator: " "}), 3.14159, "       3.1 4"},
+		{Formatter{Width: 8, Renderer: pattern}, 2.25, "     2.2"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+	f := Formatter{Width: 8}
+	if got, want := f.FormatFloat64Percent(0.5, 1), "   50.0%"; got != want {
+		t.Errorf("%+v: FormatFloat64Percent(0.5, 1): got %q; want %q", f, got, want)
+	}
+}
+
+func TestExpDigits(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{ExpDigits: 1}, 1.5e5, "1.5e+5"},
+		{Formatter{ExpDigits: 1}, 1.5e-15, "1.5e-15"},
+		{Formatter{ExpDigits: 1}, 1.5e-300, "1.5e-300"},
+		{Formatter{ExpDigits: 2}, 1.5e5, "1.5e+05"},
+		{Formatter{ExpDigits: 3}, 1.5e5, "1.5e+005"},
+		{Formatter{ExpDigits: 3}, -1.5e-15, "-1.5e-015"},
+		{Formatter{ExpDigits: 3}, 1.5e300, "1.5e+300"},
+		{Formatter{ExpDigits: 5}, 1, "1e+00000"},
+		{Formatter{ExpDigits: 1}, 0, "0e+0"},
+		{Formatter{ExpDigits: 3}, math.Inf(-1), "-Inf"},
+		{Formatter{ExpDigits: 3, Notation: NotationAuto}, 1234.5, "1234.5"},
+		{Formatter{ExpDigits: 1, Notation: NotationEngineering}, 12500, "12.5e+3"},
+		{Formatter{ExpDigits: 3, Width: 10, Annotate: true}, 2, "    2e+000 (normal)"},
+		{Formatter{ExpDigits: 1, Precision: 3, PrecisionKind: PrecisionSignificant}, 0.0123456, "1.23e-2"},
+		{Formatter{ExpDigits: 3, Precision: 1, PrecisionKind: PrecisionDecimals, FractionGroup: 1}, 0.0123456, "1.2e-002"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestSignFlags(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{Plus: true}, 1.5, "+1.5e+00"},
+		{Formatter{Plus: true}, -1.5, "-1.5e+00"},
+		{Formatter{Plus: true}, 0, "+0e+00"},
+		{Formatter{Plus: true}, math.Copysign(0, -1), "-0e+00"},
+		{Formatter{Plus: true}, math.Inf(1), "+Inf"},
+		{Formatter{Plus: true}, math.NaN(), "+NaN"},
+		{Formatter{Space: true}, 1.5, " 1.5e+00"},
+		{Formatter{Space: true}, -1.5, "-1.5e+00"},
+		{Formatter{Space: true}, math.Inf(1), " Inf"},
+		{Formatter{Space: true}, math.Inf(-1), "-Inf"},
+		{Formatter{Space: true}, math.NaN(), " NaN"},
+		{Formatter{Plus: true, Space: true}, 1.5, "+1.5e+00"},
+		{Formatter{Space: true, Width: 8, ZeroPad: true, Precision: 2, PrecisionKind: PrecisionDecimals, Notation: NotationPositional}, 1.5, " 0001.50"},
+		{Formatter{Plus: true, Width: 8, Precision: 2, PrecisionKind: PrecisionDecimals, Notation: NotationPositional}, 1.5, "   +1.50"},
+		{Formatter{Plus: true, Width: 8, ZeroPad: true}, math.Inf(1), "    +Inf"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+	f := Formatter{Plus: true}
+	if got, want := f.FormatFloat64Percent(0.5, 0), "+50%"; got != want {
+		t.Errorf("%+v: FormatFloat64Percent(0.5, 0): got %q; want %q", f, got, want)
+	}
+}
+
+func TestUpper(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{Upper: true}, 1.5e10, "1.5E+10"},
+		{Formatter{Upper: true}, math.Inf(1), "+INF"},
+		{Formatter{Upper: true}, math.Inf(-1), "-INF"},
+		{Formatter{Upper: true}, math.NaN(), "NAN"},
+		{Formatter{Upper: true, Notation: NotationAuto}, 1.5, "1.5"},
+		{Formatter{Upper: true, Annotate: true}, 0, "0E+00 (+0)"},
+		{Formatter{Upper: true, ExpDigits: 3, Precision: 2, PrecisionKind: PrecisionDecimals}, -1.5e-7, "-1.50E-007"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestCompactExp(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{CompactExp: true}, 1e15, "1e15"},
+		{Formatter{CompactExp: true}, 1e-7, "1e-7"},
+		{Formatter{CompactExp: true}, -1.5e-300, "-1.5e-300"},
+		{Formatter{CompactExp: true}, 1, "1e0"},
+		{Formatter{CompactExp: true}, math.Inf(1), "+Inf"},
+		{Formatter{CompactExp: true, ExpDigits: 2}, 1e5, "1e05"},
+		{Formatter{CompactExp: true, Upper: true}, 1e5, "1E5"},
+		{Formatter{CompactExp: true, Notation: NotationAuto}, 1e21, "1e21"},
+		{Formatter{CompactExp: true, Precision: 3, PrecisionKind: PrecisionSignificant}, 123456, "1.23e5"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestAutoRange(t *testing.T) {
+	js := Formatter{Notation: NotationAuto, AutoRange: [2]int{-7, 21}}
+	sig := js
+	sig.Precision = 3
+	sig.PrecisionKind = PrecisionSignificant
+	dec := js
+	dec.Precision = 2
+	dec.PrecisionKind = PrecisionDecimals
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{js, 1e20, "100000000000000000000"},
+		{js, 1e21, "1e+21"},
+		{js, 1.5e-7, "0.00000015"},
+		{js, 1e-8, "1e-08"},
+		{js, 0, "0"},
+		{js, math.NaN(), "NaN"},
+		{Formatter{Notation: NotationAuto, AutoRange: [2]int{0, 3}}, 0.5, "5e-01"},
+		{Formatter{Notation: NotationAuto, AutoRange: [2]int{0, 3}}, 999, "999"},
+		{sig, 123456789, "123000000"},
+		{sig, 1.5, "1.5"},
+		{sig, 1.23456e-8, "1.23e-08"},
+		{sig, 9.9999e20, "1e+21"},
+		{dec, 123456789, "123456789.00"},
+		{dec, 1e-8, "1.00e-08"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestPointZero(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{PointZero: true}, 3, "3.0e+00"},
+		{Formatter{PointZero: true}, 3.5, "3.5e+00"},
+		{Formatter{PointZero: true}, math.Copysign(0, -1), "-0.0e+00"},
+		{Formatter{PointZero: true}, math.Inf(1), "+Inf"},
+		{Formatter{PointZero: true}, math.NaN(), "NaN"},
+		{Formatter{PointZero: true, Notation: NotationAuto}, 3, "3.0"},
+		{Formatter{PointZero: true, Notation: NotationAuto}, 1e21, "1.0e+21"},
+		{Formatter{PointZero: true, Notation: NotationPositional}, 120, "120.0"},
+		{Formatter{PointZero: true, Notation: NotationPositional, Precision: 2, PrecisionKind: PrecisionDecimals, TrimZeros: true}, 2.001, "2.0"},
+		{Formatter{PointZero: true, Notation: NotationPositional, Precision: 0, PrecisionKind: PrecisionDecimals}, 2.5, "2.0"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+	f := Formatter{PointZero: true}
+	if got, want := f.FormatFloat64Percent(0.5, 0), "50.0%"; got != want {
+		t.Errorf("%+v: FormatFloat64Percent(0.5, 0): got %q; want %q", f, got, want)
+	}
+}
+
+func TestSpecialSpellings(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{Inf: "Infinity"}, inf, "Infinity"},
+		{Formatter{Inf: "Infinity"}, -inf, "-Infinity"},
+		{Formatter{Inf: "Infinity"}, nan, "NaN"},
+		{Formatter{Inf: "Infinity"}, 1.5, "1.5e+00"},
+		{Formatter{Inf: "+inf", NaN: "nan"}, -inf, "-inf"},
+		{Formatter{Inf: "+inf", NaN: "nan"}, nan, "nan"},
+		{Formatter{Inf: ".inf", NaN: ".nan"}, -inf, "-.inf"},
+		{Formatter{Inf: "null", NegInf: "null", NaN: "null"}, -inf, "null"},
+		{Formatter{NegInf: "-oo"}, inf, "+Inf"},
+		{Formatter{NegInf: "-oo"}, -inf, "-oo"},
+		{Formatter{Inf: "inf", Upper: true, Plus: true}, inf, "inf"},
+		{Formatter{Inf: "inf", Upper: true, Plus: true}, 2, "+2E+00"},
+		{Formatter{NaN: "nan", Width: 5}, nan, "  nan"},
+		{Formatter{NaN: "nan", Annotate: true}, nan, "nan (quiet, payload 0x1)"},
+		{Formatter{Inf: "Infinity", PrecisionKind: PrecisionDecimals, Precision: 2}, -inf, "-Infinity"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+	f := Formatter{Inf: "Infinity"}
+	if got, want := f.FormatFloat64Percent(inf, 1), "Infinity"; got != want {
+		t.Errorf("FormatFloat64Percent(+Inf, 1): got %q; want %q", got, want)
+	}
+	if got, want := f.FormatFloat32(float32(-inf)), "-Infinity"; got != want {
+		t.Errorf("FormatFloat32(-Inf): got %q; want %q", got, want)
+	}
+}
+
+func TestPositiveZero(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{PositiveZero: true}, negZero, "0e+00"},
+		{Formatter{PositiveZero: true}, 0, "0e+00"},
+		{Formatter{PositiveZero: true}, -1.5, "-1.5e+00"},
+		{Formatter{PositiveZero: true, Notation: NotationPositional}, negZero, "0"},
+		{Formatter{PositiveZero: true, Plus: true}, negZero, "+0e+00"},
+		{Formatter{PositiveZero: true, Annotate: true}, negZero, "0e+00 (+0)"},
+		{Formatter{PositiveZero: true, PrecisionKind: PrecisionDecimals, Precision: 2, Notation: NotationPositional}, negZero, "0.00"},
+		{Formatter{PositiveZero: true, PrecisionKind: PrecisionDecimals, Precision: 2, Notation: NotationPositional}, -0.001, "-0.00"},
+		{Formatter{PositiveZero: true, Renderer: PythonRepr().Renderer}, negZero, "0.0"},
+		{Formatter{Notation: NotationPositional}, negZero, "-0"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+	f := Formatter{PositiveZero: true}
+	if got, want := f.FormatFloat64Fixed(negZero, 1), "0.0"; got != want {
+		t.Errorf("FormatFloat64Fixed(-0, 1): got %q; want %q", got, want)
+	}
+	if got, want := f.FormatFloat32(float32(negZero)), "0e+00"; got != want {
+		t.Errorf("FormatFloat32(-0): got %q; want %q", got, want)
+	}
+	if got, want := f.FormatFloat64Bits(1<<63), "0e+00"; got != want {
+		t.Errorf("FormatFloat64Bits(1<<63): got %q; want %q", got, want)
+	}
+}
+
+func TestSuperscript(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{Superscript: true}, 1.5e-3, "1.5×10⁻³"},
+		{Formatter{Superscript: true}, 2e15, "2×10¹⁵"},
+		{Formatter{Superscript: true}, 1.25, "1.25×10⁰"},
+		{Formatter{Superscript: true}, -5e-324, "-5×10⁻³²⁴"},
+		{Formatter{Superscript: true}, math.Inf(1), "+Inf"},
+		{Formatter{Superscript: true, ExpDigits: 2}, 1e7, "1×10⁰⁷"},
+		{Formatter{Superscript: true, Upper: true}, math.NaN(), "NAN"},
+		{Formatter{Superscript: true, Notation: NotationAuto}, 123.5, "123.5"},
+		{Formatter{Superscript: true, Notation: NotationAuto}, 1e21, "1×10²¹"},
+		{Formatter{Superscript: true, Notation: NotationEngineering}, 12345, "12.345×10³"},
+		{Formatter{Superscript: true, Width: 10}, 1.5e-3, "  1.5×10⁻³"},
+		{Formatter{Superscript: true, Annotate: true}, 1e-310, "1×10⁻³¹⁰ (subnormal)"},
+		{Formatter{Superscript: true, PrecisionKind: PrecisionSignificant, Precision: 3}, 1234, "1.23×10³"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestUnicodeMinus(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{UnicodeMinus: true}, -1.5e-3, "−1.5e−03"},
+		{Formatter{UnicodeMinus: true}, 1.5e3, "1.5e+03"},
+		{Formatter{UnicodeMinus: true}, math.Inf(-1), "−Inf"},
+		{Formatter{UnicodeMinus: true}, math.Copysign(0, -1), "−0e+00"},
+		{Formatter{UnicodeMinus: true, Inf: "inf"}, math.Inf(-1), "-inf"},
+		{Formatter{UnicodeMinus: true, Notation: NotationPositional}, -0.25, "−0.25"},
+		{Formatter{UnicodeMinus: true, Superscript: true}, -1.5e-3, "−1.5×10⁻³"},
+		{Formatter{UnicodeMinus: true, Width: 8, ZeroPad: true}, -1.5, "−1.5e+00"},
+		{Formatter{UnicodeMinus: true, Width: 6, ZeroPad: true, Notation: NotationPositional}, -1.5, "−001.5"},
+		{Formatter{UnicodeMinus: true, Width: 6, Notation: NotationPositional}, -1.5, "  −1.5"},
+		{Formatter{UnicodeMinus: true, Annotate: true}, math.Copysign(0, -1), "−0e+00 (-0)"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalPoint(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{DecimalPoint: ','}, 1.5, "1,5e+00"},
+		{Formatter{DecimalPoint: ','}, 2, "2e+00"},
+		{Formatter{DecimalPoint: ','}, math.NaN(), "NaN"},
+		{Formatter{DecimalPoint: '.'}, 1.5, "1.5e+00"},
+		{Formatter{DecimalPoint: '·', Notation: NotationPositional}, -12.25, "-12·25"},
+		{Formatter{DecimalPoint: '٫', Notation: NotationPositional, Width: 6}, 1.25, "  1٫25"},
+		{Formatter{DecimalPoint: ',', FractionGroup: 3}, 1.2345678, "1,234 567 8e+00"},
+		{Formatter{DecimalPoint: ',', PointZero: true, Notation: NotationPositional}, 3, "3,0"},
+		{Formatter{DecimalPoint: ',', PrecisionKind: PrecisionDecimals, Precision: 2, Notation: NotationPositional}, 1234.5, "1234,50"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+	f := Formatter{DecimalPoint: ','}
+	if got, want := f.FormatFloat64Fixed(19.99, 2), "19,99"; got != want {
+		t.Errorf("FormatFloat64Fixed(19.99, 2): got %q; want %q", got, want)
+	}
+}
+
+func TestIntegerGroup(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{IntegerGroup: 3, Notation: NotationPositional}, 1234567.5, "1,234,567.5"},
+		{Formatter{IntegerGroup: 3, Notation: NotationPositional}, -123456, "-123,456"},
+		{Formatter{IntegerGroup: 3, Notation: NotationPositional}, 123, "123"},
+		{Formatter{IntegerGroup: 3, Notation: NotationPositional}, 0.0001234, "0.0001234"},
+		{Formatter{IntegerGroup: 3, Notation: NotationPositional}, math.Inf(-1), "-Inf"},
+		{Formatter{IntegerGroup: 3, IntegerGroup2: 2, Notation: NotationPositional}, 1234567.5, "12,34,567.5"},
+		{Formatter{IntegerGroup: 3, IntegerGroup2: 2, Notation: NotationPositional}, 1234, "1,234"},
+		{Formatter{IntegerGroup: 4, IntegerSeparator: " ", Notation: NotationPositional}, 123456789, "1 2345 6789"},
+		{Formatter{IntegerGroup: 3, IntegerSeparator: ".", DecimalPoint: ',', Notation: NotationPositional}, 1234567.25, "1.234.567,25"},
+		{Formatter{IntegerGroup: 3, FractionGroup: 3, Notation: NotationPositional}, 1234.56789, "1,234.567 89"},
+		{Formatter{IntegerGroup: 3, Notation: NotationEngineering}, 123456, "123.456e+03"},
+		{Formatter{IntegerGroup: 3, Plus: true, Width: 8, Notation: NotationPositional}, 12345, " +12,345"},
+		{Formatter{IntegerGroup: 3, PrecisionKind: PrecisionDecimals, Precision: 2, Notation: NotationPositional}, 9876543.219, "9,876,543.22"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+	f := Formatter{IntegerGroup: 3}
+	if got, want := f.FormatFloat64Fixed(1e20, 0), "100,000,000,000,000,000,000"; got != want {
+		t.Errorf("FormatFloat64Fixed(1e20, 0): got %q; want %q", got, want)
+	}
+}
+
+func TestDigits(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{Digits: ArabicIndicDigits}, -1.25e-7, "-١.٢٥e-٠٧"},
+		{Formatter{Digits: ArabicIndicDigits, DecimalPoint: '٫', Notation: NotationPositional}, 3.5, "٣٫٥"},
+		{Formatter{Digits: PersianDigits, Notation: NotationPositional}, 1404, "۱۴۰۴"},
+		{Formatter{Digits: DevanagariDigits, IntegerGroup: 3, IntegerGroup2: 2, Notation: NotationPositional}, 1234567, "१२,३४,५६७"},
+		{Formatter{Digits: FullwidthDigits, Notation: NotationPositional}, 0, "０"},
+		{Formatter{Digits: FullwidthDigits, Width: 5, ZeroPad: true, Notation: NotationPositional}, 2.5, "００２.５"},
+		{Formatter{Digits: FullwidthDigits, Superscript: true}, 1e-5, "１×１０⁻⁵"},
+		{Formatter{Digits: FullwidthDigits, Inf: "1e999"}, math.Inf(1), "1e999"},
+		{Formatter{Digits: FullwidthDigits, Annotate: true}, 5e-324, "５e-３２４ (subnormal)"},
+		{Formatter{Digits: [10]rune{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9'}}, 1.5, "1.5e+00"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestNaNPayload(t *testing.T) {
+	f := Formatter{NaNPayload: true}
+	for _, tt := range []struct {
+		u    uint64
+		want string
+	}{
+		{math.Float64bits(math.NaN()), "NaN(0x1)"},
+		{0x7ff8000000000000, "NaN(0x0)"},
+		{0xfff8000000000000, "-NaN(0x0)"},
+		{0x7ff000000000002a, "sNaN(0x2a)"},
+		{0xfff7ffffffffffff, "-sNaN(0x7ffffffffffff)"},
+		{0x7ff0000000000000, "+Inf"},
+		{math.Float64bits(1.5), "1.5e+00"},
+	} {
+		if got := f.FormatFloat64Bits(tt.u); got != tt.want {
+			t.Errorf("FormatFloat64Bits(%#x): got %q; want %q", tt.u, got, tt.want)
+		}
+	}
+	for _, tt := range []struct {
+		f    Formatter
+		u    uint32
+		want string
+	}{
+		{Formatter{NaNPayload: true}, 0x7fc00001, "NaN(0x1)"},
+		{Formatter{NaNPayload: true}, 0xff800003, "-sNaN(0x3)"},
+		{Formatter{NaNPayload: true, Upper: true}, 0x7fc0000a, "NAN(0XA)"},
+		{Formatter{NaNPayload: true, Plus: true}, 0x7fc00001, "+NaN(0x1)"},
+		{Formatter{NaNPayload: true, NaN: "null"}, 0x7fc00001, "null"},
+		{Formatter{NaNPayload: true, Annotate: true}, 0x7f800001, "sNaN(0x1) (signaling, payload 0x1)"},
+		{Formatter{NaNPayload: true, CompactExp: true}, 0x7fc0000e, "NaN(0xe)"},
+		{Formatter{NaNPayload: true, ExpDigits: 3}, 0x7fc000ee, "NaN(0xee)"},
+		{Formatter{NaNPayload: true, Superscript: true}, 0x7fc0000e, "NaN(0xe)"},
+		{Formatter{NaNPayload: true, Superscript: true, CompactExp: true, ExpDigits: 2}, 0xff80e0e0, "-sNaN(0xe0e0)"},
+	} {
+		if got := tt.f.FormatFloat32Bits(tt.u); got != tt.want {
+			t.Errorf("%+v: FormatFloat32Bits(%#x): got %q; want %q", tt.f, tt.u, got, tt.want)
+		}
+	}
+	f.PrecisionKind = PrecisionDecimals
+	if got, want := f.FormatFloat64Bits(0x7ff8000000000005), "NaN(0x5)"; got != want {
+		t.Errorf("with a precision: FormatFloat64Bits(0x7ff8000000000005): got %q; want %q", got, want)
+	}
+	f.ExpDigits = 3
+	if got, want := f.FormatFloat64Bits(0x7ff800000000000e), "NaN(0xe)"; got != want {
+		t.Errorf("with a precision and ExpDigits: FormatFloat64Bits(0x7ff800000000000e): got %q; want %q", got, want)
+	}
+}
+
+func TestFortranD(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{FortranD: true}, 1.5, "1.5D+00"},
+		{Formatter{FortranD: true}, -2.5e-300, "-2.5D-300"},
+		{Formatter{FortranD: true, Upper: true}, 1e10, "1D+10"},
+		{Formatter{FortranD: true}, math.Inf(1), "+Inf"},
+		{Formatter{FortranD: true}, math.NaN(), "NaN"},
+		{Formatter{FortranD: true, Notation: NotationAuto}, 0.5, "0.5"},
+		{Formatter{FortranD: true, PointZero: true}, 3, "3.0D+00"},
+		{Formatter{FortranD: true, PrecisionKind: PrecisionDecimals, Precision: 6}, 0.1, "1.000000D-01"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestExpMarker(t *testing.T) {
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+	}{
+		{Formatter{ExpMarker: "E"}, 1.5, "1.5E+00"},
+		{Formatter{ExpMarker: "d"}, -2.5e-7, "-2.5d-07"},
+		{Formatter{ExpMarker: "d", Upper: true}, math.Inf(-1), "-INF"},
+		{Formatter{ExpMarker: "d", Upper: true}, 2.5e-7, "2.5d-07"},
+		{Formatter{ExpMarker: "*10^"}, 1.5e-3, "1.5*10^-03"},
+		{Formatter{ExpMarker: "*10^", CompactExp: true}, 1.5e3, "1.5*10^3"},
+		{Formatter{ExpMarker: " x 10^", Width: 14}, 1.5e3, "  1.5 x 10^+03"},
+		{Formatter{ExpMarker: "e", FortranD: true}, 1.5, "1.5e+00"},
+		{Formatter{ExpMarker: "E", Notation: NotationAuto}, 150, "150"},
+		{Formatter{ExpMarker: "^", Digits: FullwidthDigits}, 1e5, "１^+０５"},
+		{Formatter{ExpMarker: "*10^", Digits: ArabicIndicDigits}, 1.5e10, "١.٥*10^+١٠"},
+		{Formatter{ExpMarker: "*10^", Digits: ArabicIndicDigits, Width: 12, ZeroPad: true}, 1.5e10, "٠٠١.٥*10^+١٠"},
+		{Formatter{ExpMarker: "x-10^", UnicodeMinus: true}, -1.5e-3, "−1.5x-10^−03"},
+		{Formatter{ExpMarker: ".10^", DecimalPoint: ','}, 2e3, "2.10^+03"},
+		{Formatter{ExpMarker: " e ", IntegerGroup: 3, IntegerSeparator: "e", Notation: NotationPositional}, 1234, "1e234"},
+		{Formatter{ExpMarker: "*10^", LeftAlign: true, Width: 12}, 1.5e3, "1.5*10^+03  "},
+		{Formatter{ExpMarker: "*10^", Superscript: true}, 1.5e-3, "1.5×10⁻³"},
+		{Formatter{FortranD: true, Superscript: true}, 2e15, "2×10¹⁵"},
+		{Formatter{ExpMarker: "E", PrecisionKind: PrecisionSignificant, Precision: 2}, 0.000123, "1.2E-04"},
+	} {
+		if got := tt.f.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%+v: FormatFloat64(%g): got %q; want %q", tt.f, tt.x, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import "math"
+
+// FormatFloat64General converts the 64-bit floating point number f to a
+// string with prec significant digits, rounding to nearest with ties to even,
+// and removes any trailing zeros. Like printf's %g, it uses scientific
+// notation if the decimal exponent is less than -4 or at least prec, and
+// positional notation otherwise. It is the equivalent of calling
+// strconv.FormatFloat(f, 'g', prec, 64). A prec less than 1 is treated as 1.
+func FormatFloat64General(f float64, prec int) string {
+	b := make([]byte, 0, 24)
+	return unsafeString(AppendFloat64General(b, f, prec))
+}
+
+// AppendFloat64General appends the string form of the 64-bit floating point
+// number f with prec significant digits, as generated by
+// FormatFloat64General, to b and returns the extended buffer.
+func AppendFloat64General(b []byte, f float64, prec int) []byte {
+	return appendFloat64General(b, f, prec, ToNearestEven, [2]int{})
+}
+
+// appendFloat64General is like AppendFloat64General but rounds according to
+// mode and, unless r is zero, prints exponents in [r[0], r[1]) positionally.
+func appendFloat64General(b []byte, f float64, prec int, mode RoundingMode, r [2]int) []byte {
+	if prec < 1 {
+		prec = 1
+	}
+	u := math.Float64bits(f)
+	neg := u>>(mantBits64+expBits64) != 0
+	mant := u & (uint64(1)<<mantBits64 - 1)
+	exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1)
+	if exp == uint64(1)<<expBits64-1 {
+		return appendSpecial(b, neg, false, mant == 0)
+	}
+	if neg {
+		b = append(b, '-')
+	}
+	if exp == 0 && mant == 0 {
+		return append(b, '0')
+	}
+	m2, e2 := unpack64(mant, exp)
+	return appendGeneral64(b, m2, e2, prec, neg, mode, r)
+}
+
+// appendGeneral64 appends m2 × 2^e2, which is positive, rounded to prec
+// significant digits according to mode for a number with the sign neg, in the
+// notation chosen by strconv's 'g' format, or by the range r as in
+// appendFloat64General.
+func appendGeneral64(b []byte, m2 uint64, e2 int32, prec int, neg bool, mode RoundingMode, r [2]int) []byte {
+	start := len(b)
+	b, exp := appendExpDigits(b, m2, e2, prec, neg, mode)
+	for len(b) > start+1 && b[len(b)-1] == '0' {
+		b = b[:len(b)-1]
+	}
+	nd := len(b) - start
+	lo, hi := r[0], r[1]
+	if r == [2]int{} {
+		// As in strconv, if the trimmed digits are all before the
+		// decimal point, an exponent below their count is printed
+		// positionally even if it is not below prec.
+		lo, hi = -4, prec
+		if hi > nd && nd >= exp+1 {
+			hi = nd
+		}
+	}
+	if exp < lo || exp >= hi {
+		if nd > 1 {
+			b = insertByte(b, start+1, '.')
+		}
+		return appendExponent(b, int32(exp))
+	}
+	return positional(b, start, exp)
+}
+
+// appendFloat64SigPositional appends f rounded according to mode to prec
+// significant digits, in positional notation and with any trailing zeros, as
+// in "1.50" or "0.00120" for 3 digits. A prec less than 1 is treated as 1.
+func appendFloat64SigPositional(b []byte, f float64, prec int, mode RoundingMode) []byte {
+	if prec < 1 {
+		prec = 1
+	}
+	u := math.Float64bits(f)
+	neg := u>>(mantBits64+expBits64) != 0
+	mant := u & (uint64(1)<<mantBits64 - 1)
+	exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1)
+	if exp == uint64(1)<<expBits64-1 {
+		return appendSpecial(b, neg, false, mant == 0)
+	}
+	if neg {
+		b = append(b, '-')
+	}
+	if exp == 0 && mant == 0 {
+		b = append(b, '0')
+		if prec > 1 {
+			b = append(b, '.')
+		}
+		return appendZeros(b, prec-1)
+	}
+	m2, e2 := unpack64(mant, exp)
+	start := len(b)
+	b, e10 := appendExpDigits(b, m2, e2, prec, neg, mode)
+	return positional(b, start, e10)
+}
+
+// positional rewrites the significant digits in b[start:], the first of
+// which has the decimal exponent exp, in positional notation, adding zeros
+// and a decimal point as needed.
+func positional(b []byte, start, exp int) []byte {
+	nd := len(b) - start
+	switch dp := exp + 1; {
+	case dp <= 0:
+		// 0.000ddd
+		n := 2 - dp
+		b = appendZeros(b, n)
+		copy(b[start+n:], b[start:start+nd])
+		for i := start; i < start+n; i++ {
+			b[i] = '0'
+		}
+		b[start+1] = '.'
+	case dp < nd:
+		b = insertByte(b, start+dp, '.')
+	default:
+		b = appendZeros(b, dp-nd)
+	}
+	return b
+}
+
+// FormatFloat64Sig rounds the 64-bit floating point number f to n
+// significant decimal digits, to nearest with ties to even, and returns the
+// shortest string that identifies the rounded value in the format of
+// FormatFloat64, as in "1.23e+05" for 123456 and n = 3 or "1e+00" for 0.99
+// and n = 1. If the rounded value is not exactly a float64, it is the float64
+// nearest to it that is printed, unless that overflows; then the rounded
+// digits themselves are. An n less than 1 is treated as 1.
+func FormatFloat64Sig(f float64, n int) string {
+	b := make([]byte, 0, 24)
+	return unsafeString(AppendFloat64Sig(b, f, n))
+}
+
+// AppendFloat64Sig appends the string form of the 64-bit floating point
+// number f rounded to n significant digits, as generated by
+// FormatFloat64Sig, to b and returns the extended buffer.
+func AppendFloat64Sig(b []byte, f float64, n int) []byte {
+	u := math.Float64bits(f)
+	mant := u & (uint64(1)<<mantBits64 - 1)
+	exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1)
+	// 17 digits identify every float64, so rounding to as many leaves f.
+	if n >= 17 || exp == uint64(1)<<expBits64-1 || exp == 0 && mant == 0 {
+		return appendShortestE(b, Decimal64Bits(u))
+	}
+	if n < 1 {
+		n = 1
+	}
+	neg := u>>(mantBits64+expBits64) != 0
+	m2, e2 := unpack64(mant, exp)
+	var buf [16]byte
+	digits, e10 := appendExpDigits(buf[:0], m2, e2, n, neg, ToNearestEven)
+	pd := parsedDecimal{neg: neg, e10: int32(e10 - n + 1), digits: int32(n)}
+	for _, c := range digits {
+		pd.m10 = 10*pd.m10 + uint64(c-'0')
+	}
+	if r := pd.convert(&float64info, ToNearestEven); !math.IsInf(math.Float64frombits(r), 0) {
+		return appendShortestE(b, Decimal64Bits(r))
+	}
+	for pd.m10%10 == 0 {
+		pd.m10 /= 10
+		pd.e10++
+	}
+	return appendShortestE(b, FloatDecimal{Digits: pd.m10, Exp: pd.e10, Neg: neg, Class: ClassNormal})
+}
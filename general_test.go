@@ -0,0 +1,140 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestFormatFloat64General(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		prec int
+		want string
+	}{
+		{0, 3, "0"},
+		{math.Copysign(0, -1), 3, "-0"},
+		{1, 5, "1"},
+		{1.5, 0, "2"},
+		{2.5, 1, "2"},
+		{-1234.5678, 6, "-1234.57"},
+		{1234.5678, 3, "1.23e+03"},
+		{100000, 3, "1e+05"},
+		{100000, 10, "100000"},
+		{123456, 6, "123456"},
+		{1e21, 25, "1000000000000000000000"},
+		{0.0001, 3, "0.0001"},
+		{0.00001234, 3, "1.23e-05"},
+		{0.000099996, 4, "0.0001"},
+		{0.1, 20, "0.10000000000000000555"},
+		{9.9999, 3, "10"},
+		{999.96, 4, "1000"},
+		{999996, 5, "1e+06"},
+		{math.MaxFloat64, 17, "1.7976931348623157e+308"},
+		{5e-324, 2, "4.9e-324"},
+		{math.Inf(1), 2, "+Inf"},
+		{math.Inf(-1), 2, "-Inf"},
+		{math.NaN(), 2, "NaN"},
+	} {
+		if got := FormatFloat64General(tt.f, tt.prec); got != tt.want {
+			t.Errorf("FormatFloat64General(%b, %d): got %q; want %q", tt.f, tt.prec, got, tt.want)
+		}
+	}
+	if got := string(AppendFloat64General([]byte("x="), 0.25, 1)); got != "x=0.2" {
+		t.Errorf("AppendFloat64General(\"x=\", 0.25, 1): got %q; want \"x=0.2\"", got)
+	}
+}
+
+func TestFormatFloat64GeneralRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 1000000
+	if testing.Short() {
+		n = 100000
+	}
+	for i := 0; i < n; i++ {
+		var f float64
+		var prec int
+		switch i % 3 {
+		case 0:
+			// Any float64.
+			f = math.Float64frombits(r.Uint64())
+			prec = 1 + r.Intn(25)
+		case 1:
+			// A number near the switch between notations.
+			f = r.NormFloat64() * math.Pow(10, float64(r.Intn(30)-10))
+			prec = 1 + r.Intn(25)
+		case 2:
+			// A short binary number, which is often a tie.
+			f = math.Ldexp(float64(r.Int63n(1<<20)), r.Intn(80)-40)
+			prec = 1 + r.Intn(8)
+		}
+		got := FormatFloat64General(f, prec)
+		want := strconv.FormatFloat(f, 'g', prec, 64)
+		if got != want {
+			t.Fatalf("FormatFloat64General(%b, %d): got %q; want %q", f, prec, got, want)
+		}
+	}
+}
+
+func TestFormatFloat64Sig(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		n    int
+		want string
+	}{
+		{123456, 3, "1.23e+05"},
+		{0.99, 1, "1e+00"},
+		{-0.125, 2, "-1.2e-01"},
+		{0.1, 5, "1e-01"},
+		{0.1, 17, "1e-01"},
+		{0.1, 30, "1e-01"},
+		{math.Pi, 0, "3e+00"},
+		{math.Pi, 16, "3.141592653589793e+00"},
+		{math.MaxFloat64, 1, "2e+308"},
+		{math.MaxFloat64, 16, "1.797693134862316e+308"},
+		{5e-324, 3, "5e-324"},
+		{0, 3, "0e+00"},
+		{math.Inf(-1), 3, "-Inf"},
+	} {
+		if got := FormatFloat64Sig(tt.f, tt.n); got != tt.want {
+			t.Errorf("FormatFloat64Sig(%b, %d): got %q; want %q", tt.f, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFormatFloat64SigRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		f := math.Float64frombits(r.Uint64())
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			continue
+		}
+		n := 1 + r.Intn(17)
+		want, err := strconv.ParseFloat(strconv.FormatFloat(f, 'e', n-1, 64), 64)
+		if err != nil {
+			// The rounded value overflows.
+			continue
+		}
+		if got := FormatFloat64Sig(f, n); got != FormatFloat64(want) {
+			t.Fatalf("FormatFloat64Sig(%b, %d): got %q; want %q", f, n, got, FormatFloat64(want))
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+//go:build go1.18
+// +build go1.18
+
+package ryu
+
+import "unsafe"
+
+// A Float is a type whose underlying type is float32 or float64.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Parse converts s to the nearest value of type T, which must be a
+// floating-point type. It is ParseFloat32 or ParseFloat64, according to the
+// size of T.
+func Parse[T Float](s string) (T, error) {
+	var zero T
+	if unsafe.Sizeof(zero) == 4 {
+		f, err := ParseFloat32(s)
+		return T(f), err
+	}
+	f, err := ParseFloat64(s)
+	return T(f), err
+}
+
+// ParseBytes is like Parse but parses b. See ParseFloat64Bytes.
+func ParseBytes[T Float](b []byte) (T, error) {
+	return Parse[T](bytesString(b))
+}
@@ -0,0 +1,49 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+//go:build go1.18
+// +build go1.18
+
+package ryu
+
+import (
+	"strconv"
+	"testing"
+)
+
+type celsius float32
+
+func TestParseGeneric(t *testing.T) {
+	for _, s := range []string{"0.1", "16777217", "1e39", "-2.5e-3", "1e-50", "x"} {
+		f64, err := Parse[float64](s)
+		want64, wantErr := ParseFloat64(s)
+		if f64 != want64 || !sameError(err, wantErr) {
+			t.Errorf("Parse[float64](%q): got (%g, %v); want (%g, %v)", s, f64, err, want64, wantErr)
+		}
+		f32, err := Parse[float32](s)
+		want32, wantErr := ParseFloat32(s)
+		if f32 != want32 || !sameError(err, wantErr) {
+			t.Errorf("Parse[float32](%q): got (%g, %v); want (%g, %v)", s, f32, err, want32, wantErr)
+		}
+		f32, err = ParseBytes[float32]([]byte(s))
+		if f32 != want32 || !sameError(err, wantErr) {
+			t.Errorf("ParseBytes[float32](%q): got (%g, %v); want (%g, %v)", s, f32, err, want32, wantErr)
+		}
+		c, err := Parse[celsius](s)
+		if c != celsius(want32) || !sameError(err, wantErr) {
+			t.Errorf("Parse[celsius](%q): got (%g, %v); want (%g, %v)", s, c, err, want32, wantErr)
+		}
+	}
+	if _, err := Parse[float64]("1e400"); numErr(err) != strconv.ErrRange {
+		t.Errorf("Parse[float64](1e400): got error %v; want range error", err)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "math"
+
+// FormatFloat64Hex converts the 64-bit floating point number f to a
+// hexadecimal floating-point string such as "-0x1.8p+01", with a leading 1
+// digit unless f is zero and a binary exponent of at least two decimal
+// digits. A prec of -1 uses the fewest hexadecimal digits after the point
+// that represent f exactly; otherwise the mantissa is rounded to prec digits,
+// to nearest with ties to even. It is the equivalent of calling
+// strconv.FormatFloat(f, 'x', prec, 64).
+func FormatFloat64Hex(f float64, prec int) string {
+	b := make([]byte, 0, 24)
+	return unsafeString(AppendFloat64Hex(b, f, prec))
+}
+
+// AppendFloat64Hex appends the string form of the 64-bit floating point
+// number f, as generated by FormatFloat64Hex, to b and returns the extended
+// buffer.
+func AppendFloat64Hex(b []byte, f float64, prec int) []byte {
+	return appendHex64(b, f, prec, 'x')
+}
+
+// appendHex64 appends f in hexadecimal with the letters of fmt, 'x' or 'X'.
+func appendHex64(b []byte, f float64, prec int, fmt byte) []byte {
+	u := math.Float64bits(f)
+	neg := u>>(mantBits64+expBits64) != 0
+	mant := u & (uint64(1)<<mantBits64 - 1)
+	exp := int((u >> mantBits64) & (uint64(1)<<expBits64 - 1))
+	if exp == 1<<expBits64-1 {
+		return appendSpecial(b, neg, false, mant == 0)
+	}
+	if exp == 0 {
+		exp = 1
+	} else {
+		mant |= uint64(1) << mantBits64
+	}
+	exp -= bias64
+	if mant == 0 {
+		exp = 0
+	}
+
+	// Move the leading 1, if any, to bit 60, so that each hexadecimal digit
+	// of the fraction is a group of 4 bits below it.
+	mant <<= 60 - mantBits64
+	for mant != 0 && mant&(1<<60) == 0 {
+		mant <<= 1
+		exp--
+	}
+	if prec >= 0 && prec < 15 {
+		shift := uint(prec * 4)
+		extra := (mant << shift) & (1<<60 - 1)
+		mant >>= 60 - shift
+		if extra|mant&1 > 1<<59 {
+			mant++
+		}
+		mant <<= 60 - shift
+		if mant&(1<<61) != 0 {
+			// Rounding carried into the next power of two.
+			mant >>= 1
+			exp++
+		}
+	}
+
+	digits := "0123456789abcdef"
+	p := byte('p')
+	if fmt == 'X' {
+		digits = "0123456789ABCDEF"
+		p = 'P'
+	}
+	if neg {
+		b = append(b, '-')
+	}
+	b = append(b, '0', fmt, '0'+byte(mant>>60&1))
+	mant <<= 4
+	if prec < 0 && mant != 0 {
+		b = append(b, '.')
+		for ; mant != 0; mant <<= 4 {
+			b = append(b, digits[mant>>60&15])
+		}
+	} else if prec > 0 {
+		b = append(b, '.')
+		for i := 0; i < prec; i++ {
+			b = append(b, digits[mant>>60&15])
+			mant <<= 4
+		}
+	}
+	i := len(b)
+	b = appendExponent(b, int32(exp))
+	b[i] = p
+	return b
+}
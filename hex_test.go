@@ -0,0 +1,69 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestFormatFloat64Hex(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		prec int
+		want string
+	}{
+		{0, -1, "0x0p+00"},
+		{math.Copysign(0, -1), 2, "-0x0.00p+00"},
+		{1, -1, "0x1p+00"},
+		{-3, -1, "-0x1.8p+01"},
+		{0.1, -1, "0x1.999999999999ap-04"},
+		{0.1, 3, "0x1.99ap-04"},
+		{0.1, 20, "0x1.999999999999a0000000p-04"},
+		{1.5, 0, "0x1p+01"},
+		{1.03125, 1, "0x1.0p+00"},
+		{1.09375, 1, "0x1.2p+00"},
+		{1.9999999999999998, 3, "0x1.000p+01"},
+		{math.MaxFloat64, -1, "0x1.fffffffffffffp+1023"},
+		{5e-324, -1, "0x1p-1074"},
+		{2.2250738585072014e-308, -1, "0x1p-1022"},
+		{math.Inf(1), 2, "+Inf"},
+		{math.NaN(), -1, "NaN"},
+	} {
+		if got := FormatFloat64Hex(tt.f, tt.prec); got != tt.want {
+			t.Errorf("FormatFloat64Hex(%b, %d): got %q; want %q", tt.f, tt.prec, got, tt.want)
+		}
+	}
+	if got := string(AppendFloat64Hex([]byte("x="), 255, -1)); got != "x=0x1.fep+07" {
+		t.Errorf("AppendFloat64Hex(\"x=\", 255, -1): got %q; want \"x=0x1.fep+07\"", got)
+	}
+}
+
+func TestFormatFloat64HexRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		f := math.Float64frombits(r.Uint64())
+		if i%2 == 0 {
+			// A short binary number, which is often a tie.
+			f = math.Ldexp(float64(r.Int63n(1<<20)), r.Intn(80)-40)
+		}
+		prec := r.Intn(18) - 1
+		got := FormatFloat64Hex(f, prec)
+		want := strconv.FormatFloat(f, 'x', prec, 64)
+		if got != want {
+			t.Fatalf("FormatFloat64Hex(%b, %d): got %q; want %q", f, prec, got, want)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "math"
+
+// FormatFloat64Human converts the 64-bit floating point number f to a short
+// string for people to read: f is rounded to sig significant digits, to
+// nearest with ties to even, and scaled by the SI prefix that leaves one to
+// three digits before the decimal point, and trailing zeros are removed, as
+// in "1.2k" for 1234 and 2 digits, "3.45M" for 3451234 and 3 digits, "999"
+// for 999, and "15m" for 0.015. Numbers outside the range of the prefixes,
+// from q (10^-30) to Q (10^30), are formatted in scientific notation, as in
+// "1.2e+33". Micro is written "µ", and a sig less than 1 is treated as 1.
+func FormatFloat64Human(f float64, sig int) string {
+	b := make([]byte, 0, 24)
+	return unsafeString(AppendFloat64Human(b, f, sig))
+}
+
+// AppendFloat64Human appends the human-readable form of f with sig
+// significant digits, as generated by FormatFloat64Human, to b and returns
+// the extended buffer.
+func AppendFloat64Human(b []byte, f float64, sig int) []byte {
+	if sig < 1 {
+		sig = 1
+	}
+	u := math.Float64bits(f)
+	neg := u>>(mantBits64+expBits64) != 0
+	mant := u & (uint64(1)<<mantBits64 - 1)
+	exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1)
+	if exp == uint64(1)<<expBits64-1 {
+		return appendSpecial(b, neg, false, mant == 0)
+	}
+	if neg {
+		b = append(b, '-')
+	}
+	if exp == 0 && mant == 0 {
+		return append(b, '0')
+	}
+	m2, e2 := unpack64(mant, exp)
+	start := len(b)
+	b, e10 := appendExpDigits(b, m2, e2, sig, neg, ToNearestEven)
+	for len(b) > start+1 && b[len(b)-1] == '0' {
+		b = b[:len(b)-1]
+	}
+	e3 := floor3(e10)
+	if e3 < -3*len(siSmall) || e3 > 3*len(siLarge) {
+		if len(b) > start+1 {
+			b = insertByte(b, start+1, '.')
+		}
+		return appendExponent(b, int32(e10))
+	}
+	b = positional(b, start, e10-e3)
+	switch {
+	case e3 > 0:
+		b = append(b, siLarge[e3/3-1]...)
+	case e3 < 0:
+		b = append(b, siSmall[-e3/3-1]...)
+	}
+	return b
+}
+
+// siLarge and siSmall are the SI prefixes for 10^3, 10^6, ... and 10^-3,
+// 10^-6, ....
+var (
+	siLarge = [...]string{"k", "M", "G", "T", "P", "E", "Z", "Y", "R", "Q"}
+	siSmall = [...]string{"m", "µ", "n", "p", "f", "a", "z", "y", "r", "q"}
+)
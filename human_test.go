@@ -0,0 +1,58 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFormatFloat64Human(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		sig  int
+		want string
+	}{
+		{0, 3, "0"},
+		{math.Copysign(0, -1), 3, "-0"},
+		{1234, 2, "1.2k"},
+		{3451234, 3, "3.45M"},
+		{-3451234, 3, "-3.45M"},
+		{999, 3, "999"},
+		{999.5, 3, "1k"},
+		{999499, 3, "999k"},
+		{999500, 3, "1M"},
+		{123456, 1, "100k"},
+		{1000, 5, "1k"},
+		{1.5, 3, "1.5"},
+		{0.015, 2, "15m"},
+		{0.5, 2, "500m"},
+		{2.5e-6, 2, "2.5µ"},
+		{1e-30, 2, "1q"},
+		{9.99e-31, 2, "1q"},
+		{9.94e-31, 2, "9.9e-31"},
+		{1.5e30, 3, "1.5Q"},
+		{999.9e30, 3, "1e+33"},
+		{1.2345e40, 2, "1.2e+40"},
+		{5e-324, 3, "4.94e-324"},
+		{12.5, 2, "12"},
+		{42, 0, "40"},
+		{math.Inf(1), 2, "+Inf"},
+		{math.Inf(-1), 2, "-Inf"},
+		{math.NaN(), 2, "NaN"},
+	} {
+		if got := FormatFloat64Human(tt.f, tt.sig); got != tt.want {
+			t.Errorf("FormatFloat64Human(%g, %d): got %q; want %q", tt.f, tt.sig, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrLineProtocolNonFinite is returned when an infinity or NaN, which the
+// InfluxDB line protocol cannot represent, is to be formatted as a float
+// field value.
+var ErrLineProtocolNonFinite = errors.New("ryu: infinity or NaN is not a line protocol float")
+
+// AppendLineProtocolFloat64 appends f as a float field value of the InfluxDB
+// line protocol to b and returns the extended buffer. The value is the
+// shortest that parses back to f, in positional notation as InfluxDB itself
+// writes floats, as in "1", "0.000001234", or "-1.5". An integral value needs
+// no decimal point, since only integer fields carry the "i" suffix. The line
+// protocol has no infinities or NaNs: for them, AppendLineProtocolFloat64
+// returns b unchanged and ErrLineProtocolNonFinite. It does not allocate unless b must
+// grow.
+func AppendLineProtocolFloat64(b []byte, f float64) ([]byte, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return b, ErrLineProtocolNonFinite
+	}
+	return appendShortestF(b, Decimal64(f)), nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAppendLineProtocolFloat64(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{-1.5, "-1.5"},
+		{1.234e-6, "0.000001234"},
+		{1e21, "1000000000000000000000"},
+		{123456.789, "123456.789"},
+	} {
+		b, err := AppendLineProtocolFloat64([]byte("v="), tt.f)
+		if err != nil || string(b) != "v="+tt.want {
+			t.Errorf("AppendLineProtocolFloat64(%g): got (%q, %v); want (%q, nil)", tt.f, b, err, "v="+tt.want)
+		}
+	}
+	for _, x := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		if b, err := AppendLineProtocolFloat64([]byte("v="), x); err != ErrLineProtocolNonFinite || string(b) != "v=" {
+			t.Errorf("AppendLineProtocolFloat64(%g): got (%q, %v); want (\"v=\", ErrLineProtocolNonFinite)", x, b, err)
+		}
+	}
+	buf := make([]byte, 0, 64)
+	if n := testing.AllocsPerRun(100, func() { AppendLineProtocolFloat64(buf, 1234.5678e-9) }); n != 0 {
+		t.Errorf("AppendLineProtocolFloat64 allocates %.1f times per call; want 0", n)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+// ErrNonFinite is returned when an infinity or NaN, which RFC 8259 does not
+// allow in JSON, is to be formatted as a JSON number.
+var ErrNonFinite = errors.New("ryu: infinity or NaN is not a JSON number")
+
+// AppendJSONFloat64 appends the string form of f, as generated by
+// FormatFloat64, which is a valid JSON number, to b and returns the extended
+// buffer. If f is an infinity or NaN, it returns b unchanged and
+// ErrNonFinite.
+func AppendJSONFloat64(b []byte, f float64) ([]byte, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return b, ErrNonFinite
+	}
+	return AppendFloat64(b, f), nil
+}
+
+// AppendJSONFloat32 is like AppendJSONFloat64 for the 32-bit floating point
+// number f.
+func AppendJSONFloat32(b []byte, f float32) ([]byte, error) {
+	if math.IsInf(float64(f), 0) || math.IsNaN(float64(f)) {
+		return b, ErrNonFinite
+	}
+	return AppendFloat32(b, f), nil
+}
+
+// AppendJCSFloat64 appends f serialized as RFC 8785, the JSON
+// Canonicalization Scheme, requires, which is the format of the ECMAScript
+// profile, to b and returns the extended buffer. The output matches other
+// JCS implementations byte for byte, so documents can be hashed and signed.
+// If f is an infinity or NaN, it returns b unchanged and ErrNonFinite.
+func AppendJCSFloat64(b []byte, f float64) ([]byte, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return b, ErrNonFinite
+	}
+	return ECMAScript().AppendFloat64(b, f), nil
+}
+
+// AppendJSONFloat64 is like the top-level AppendJSONFloat64 but formats x as
+// f.AppendFloat64 does. An infinity or NaN for which f has a spelling, such
+// as "null" or "0", is replaced by it instead of being an error. It is up to
+// the caller to choose options, such as the default notation, that give
+// valid JSON numbers.
+func (f *Formatter) AppendJSONFloat64(b []byte, x float64) ([]byte, error) {
+	if !f.spells(x) {
+		return b, ErrNonFinite
+	}
+	return f.AppendFloat64(b, x), nil
+}
+
+// AppendJSONFloat32 is like f.AppendJSONFloat64 for the 32-bit floating
+// point number x.
+func (f *Formatter) AppendJSONFloat32(b []byte, x float32) ([]byte, error) {
+	if !f.spells(float64(x)) {
+		return b, ErrNonFinite
+	}
+	return f.AppendFloat32(b, x), nil
+}
+
+// spells reports whether x is finite or f has a spelling for it.
+func (f *Formatter) spells(x float64) bool {
+	switch {
+	case math.IsNaN(x):
+		return f.NaN != ""
+	case math.IsInf(x, 1):
+		return f.Inf != ""
+	case math.IsInf(x, -1):
+		return f.NegInf != "" || f.Inf != ""
+	}
+	return true
+}
+
+// ParseJSONNumber parses the JSON number at the start of b, as defined by
+// RFC 8259:
+//
+//	[ - ] ( 0 | [1-9] digits ) [ . digits ] [ (e|E) [ + | - ] digits ]
+//
+// and returns the nearest float64 and the length of the number. It checks
+// the grammar and converts the number in a single pass, so a JSON decoder need
+// not scan the token twice.
+//
+// The number ends at the first byte that cannot continue it. If b does not
+// start with a number, or if the number is malformed because it is followed
+// by a byte that could only continue an invalid number (as in "01", "1.",
+// "1.e5", or "1e+"), n is 0 and err.Err is strconv.ErrSyntax. Otherwise the
+// results and errors are those of ParseFloat64 for b[:n].
+func ParseJSONNumber(b []byte) (f float64, n int, err error) {
+	s := bytesString(b)
+	d, n, tooLong := scanJSON(s)
+	if n <= 0 {
+		if n = -n; n < len(s) {
+			n++ // include the offending byte
+		}
+		return 0, 0, numError(s[:n], strconv.ErrSyntax)
+	}
+	var u uint64
+	if tooLong {
+		u, _ = stdParser.parseLong(s[:n], &float64info)
+	} else {
+		u = d.convert(&float64info, ToNearestEven)
+	}
+	u, n, err = stdParser.checkRange(s[:n], u, d.m10 != 0 || tooLong, &float64info)
+	return math.Float64frombits(u), n, err
+}
+
+// scanJSON parses the JSON number at the start of s and returns it along with
+// its length n. If s does not start with a valid JSON number, n is minus the
+// offset of the first byte at which the number is invalid. If the number has
+// too many significant digits, tooLong is set and d is incomplete.
+func scanJSON(s string) (d parsedDecimal, n int, tooLong bool) {
+	i := 0
+	if i < len(s) && s[i] == '-' {
+		d.neg = true
+		i++
+	}
+	var zeros, fracLen int
+	switch {
+	case i < len(s) && s[i] == '0':
+		i++
+	case i < len(s) && '1' <= s[i] && s[i] <= '9':
+		if len(s)-i >= 8 {
+			i += d.scanDigits8(s, i, &zeros)
+		}
+		for ; i < len(s) && isDigit(s[i]); i++ {
+			if !d.addDigit(s[i], &zeros) {
+				tooLong = true
+			}
+		}
+	default:
+		return d, -i, false
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		start := i
+		if len(s)-i >= 8 {
+			i += d.scanDigits8(s, i, &zeros)
+		}
+		for ; i < len(s) && isDigit(s[i]); i++ {
+			if !d.addDigit(s[i], &zeros) {
+				tooLong = true
+			}
+		}
+		if i == start {
+			return d, -i, false
+		}
+		fracLen = i - start
+	}
+	exp := 0
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		expNeg := false
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			expNeg = s[i] == '-'
+			i++
+		}
+		start := i
+		for ; i < len(s) && isDigit(s[i]); i++ {
+			if exp < maxExp10 {
+				exp = 10*exp + int(s[i]-'0')
+			}
+		}
+		if i == start {
+			return d, -i, false
+		}
+		if expNeg {
+			exp = -exp
+		}
+	}
+	if i < len(s) {
+		switch s[i] {
+		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '.', 'e', 'E', '+', '-':
+			// The number cannot continue with these bytes: they are
+			// a digit after a leading 0, a second '.' or exponent,
+			// or a sign that does not start an exponent.
+			return d, -i, false
+		}
+	}
+	d.setExp(exp - fracLen + zeros)
+	return d, i, tooLong
+}
@@ -0,0 +1,211 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestParseJSONNumber(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want float64
+		n    int
+	}{
+		{"0", 0, 1},
+		{"-0", math.Copysign(0, -1), 2},
+		{"1.5", 1.5, 3},
+		{"-12.5e-1", -1.25, 8},
+		{"1E+2", 100, 4},
+		{"0.000123", 0.000123, 8},
+		{"123456789012345678901234567890", 1.2345678901234568e29, 30},
+		{"0.12345678901234567890", 0.12345678901234568, 22},
+		{"7,", 7, 1},
+		{"7]", 7, 1},
+		{"7}", 7, 1},
+		{"7 8", 7, 1},
+		{"0x", 0, 1},
+	} {
+		f, n, err := ParseJSONNumber([]byte(tt.s))
+		if err != nil || math.Float64bits(f) != math.Float64bits(tt.want) || n != tt.n {
+			t.Errorf("ParseJSONNumber(%q): got (%g, %d, %v); want (%g, %d, nil)", tt.s, f, n, err, tt.want, tt.n)
+		}
+	}
+	for _, tt := range []struct {
+		s   string
+		num string
+	}{
+		{"", ""},
+		{"-", "-"},
+		{"+1", "+"},
+		{".5", "."},
+		{"01", "01"},
+		{"-01", "-01"},
+		{"1.", "1."},
+		{"1.e5", "1.e"},
+		{"1e", "1e"},
+		{"1e+", "1e+"},
+		{"1.5.2", "1.5."},
+		{"1e5e", "1e5e"},
+		{"1-2", "1-"},
+		{"Infinity", "I"},
+		{"NaN", "N"},
+	} {
+		_, n, err := ParseJSONNumber([]byte(tt.s))
+		e, ok := err.(*strconv.NumError)
+		if n != 0 || !ok || e.Err != strconv.ErrSyntax || e.Num != tt.num {
+			t.Errorf("ParseJSONNumber(%q): got (%d, %v); want syntax error for %q", tt.s, n, err, tt.num)
+		}
+	}
+	f, n, err := ParseJSONNumber([]byte("-1e400,"))
+	if !math.IsInf(f, -1) || n != 6 || numErr(err) != strconv.ErrRange {
+		t.Errorf("ParseJSONNumber(-1e400): got (%g, %d, %v); want (-Inf, 6, range error)", f, n, err)
+	}
+}
+
+// TestParseJSONNumberGrammar checks ParseJSONNumber against encoding/json on
+// random short strings.
+func TestParseJSONNumberGrammar(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	const alphabet = "-+.eE0019"
+	for i := 0; i < 1e5; i++ {
+		b := make([]byte, 1+r.Intn(8))
+		for j := range b {
+			b[j] = alphabet[r.Intn(len(alphabet))]
+		}
+		f, n, err := ParseJSONNumber(b)
+		if !json.Valid(b) {
+			if err == nil && n == len(b) {
+				t.Fatalf("ParseJSONNumber(%q) accepted an invalid number", b)
+			}
+			continue
+		}
+		want, wantErr := strconv.ParseFloat(string(b), 64)
+		if n != len(b) || !sameError(err, wantErr) || f != want {
+			t.Fatalf("ParseJSONNumber(%q): got (%g, %d, %v); want (%g, %d, %v)", b, f, n, err, want, len(b), wantErr)
+		}
+	}
+}
+
+func BenchmarkParseJSONNumber(b *testing.B) {
+	for _, s := range parseBenchCases {
+		buf := []byte(s)
+		b.Run(s, func(b *testing.B) {
+			var f float64
+			for i := 0; i < b.N; i++ {
+				f, _, _ = ParseJSONNumber(buf)
+			}
+			floatSink = f
+		})
+	}
+}
+
+func TestAppendJSONFloat64(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+	for _, x := range []float64{0, math.Copysign(0, -1), 1.5, 1e21, 5e-324, -math.MaxFloat64} {
+		b, err := AppendJSONFloat64(nil, x)
+		if err != nil || string(b) != FormatFloat64(x) {
+			t.Errorf("AppendJSONFloat64(%g): got (%q, %v); want (%q, nil)", x, b, err, FormatFloat64(x))
+		}
+		var y float64
+		if err := json.Unmarshal(b, &y); err != nil || y != x {
+			t.Errorf("json.Unmarshal(%q): got (%g, %v); want %g", b, y, err, x)
+		}
+	}
+	for _, x := range []float32{1.1, -3.4e38, 1e-45} {
+		b, err := AppendJSONFloat32(nil, x)
+		if err != nil || string(b) != FormatFloat32(x) {
+			t.Errorf("AppendJSONFloat32(%g): got (%q, %v); want (%q, nil)", x, b, err, FormatFloat32(x))
+		}
+	}
+	for _, x := range []float64{inf, -inf, nan} {
+		if b, err := AppendJSONFloat64([]byte("x"), x); err != ErrNonFinite || string(b) != "x" {
+			t.Errorf("AppendJSONFloat64(%g): got (%q, %v); want (\"x\", ErrNonFinite)", x, b, err)
+		}
+		if b, err := AppendJSONFloat32(nil, float32(x)); err != ErrNonFinite || len(b) != 0 {
+			t.Errorf("AppendJSONFloat32(%g): got (%q, %v); want (\"\", ErrNonFinite)", x, b, err)
+		}
+	}
+
+	for _, tt := range []struct {
+		f    Formatter
+		x    float64
+		want string
+		err  error
+	}{
+		{Formatter{Notation: NotationAuto}, 1.5, "1.5", nil},
+		{Formatter{Notation: NotationAuto}, inf, "", ErrNonFinite},
+		{Formatter{NaN: "null"}, nan, "null", nil},
+		{Formatter{NaN: "null"}, inf, "", ErrNonFinite},
+		{Formatter{Inf: "null"}, inf, "null", nil},
+		{Formatter{Inf: "1e999"}, -inf, "-1e999", nil},
+		{Formatter{NegInf: "0"}, -inf, "0", nil},
+		{Formatter{NegInf: "0"}, inf, "", ErrNonFinite},
+	} {
+		b, err := tt.f.AppendJSONFloat64(nil, tt.x)
+		if string(b) != tt.want || err != tt.err {
+			t.Errorf("%+v: AppendJSONFloat64(%g): got (%q, %v); want (%q, %v)", tt.f, tt.x, b, err, tt.want, tt.err)
+		}
+		b, err = tt.f.AppendJSONFloat32(nil, float32(tt.x))
+		if string(b) != tt.want || err != tt.err {
+			t.Errorf("%+v: AppendJSONFloat32(%g): got (%q, %v); want (%q, %v)", tt.f, tt.x, b, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestAppendJCSFloat64(t *testing.T) {
+	// These are the IEEE 754 test values of RFC 8785, Appendix B.
+	for _, tt := range []struct {
+		u    uint64
+		want string
+	}{
+		{0x0000000000000000, "0"},
+		{0x8000000000000000, "0"},
+		{0x0000000000000001, "5e-324"},
+		{0x8000000000000001, "-5e-324"},
+		{0x7fefffffffffffff, "1.7976931348623157e+308"},
+		{0xffefffffffffffff, "-1.7976931348623157e+308"},
+		{0x4340000000000000, "9007199254740992"},
+		{0xc340000000000000, "-9007199254740992"},
+		{0x4430000000000000, "295147905179352830000"},
+		{0x44b52d02c7e14af5, "9.999999999999997e+22"},
+		{0x44b52d02c7e14af6, "1e+23"},
+		{0x44b52d02c7e14af7, "1.0000000000000001e+23"},
+		{0x444b1ae4d6e2ef4e, "999999999999999700000"},
+		{0x444b1ae4d6e2ef4f, "999999999999999900000"},
+		{0x444b1ae4d6e2ef50, "1e+21"},
+		{0x3eb0c6f7a0b5ed8c, "9.999999999999997e-7"},
+		{0x3eb0c6f7a0b5ed8d, "0.000001"},
+		{0x41b3de4355555553, "333333333.3333332"},
+		{0x41b3de4355555554, "333333333.33333325"},
+		{0x41b3de4355555555, "333333333.3333333"},
+		{0x41b3de4355555556, "333333333.3333334"},
+		{0x41b3de4355555557, "333333333.33333343"},
+		{0xbecbf647612f3696, "-0.0000033333333333333333"},
+		{0x43143ff3c1cb0959, "1424953923781206.2"},
+	} {
+		b, err := AppendJCSFloat64(nil, math.Float64frombits(tt.u))
+		if err != nil || string(b) != tt.want {
+			t.Errorf("AppendJCSFloat64(%#x): got (%q, %v); want (%q, nil)", tt.u, b, err, tt.want)
+		}
+	}
+	for _, x := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		if b, err := AppendJCSFloat64(nil, x); err != ErrNonFinite || len(b) != 0 {
+			t.Errorf("AppendJCSFloat64(%g): got (%q, %v); want (\"\", ErrNonFinite)", x, b, err)
+		}
+	}
+}
@@ -22,24 +22,52 @@
 // That source code is licensed under Apache 2.0 and this code is derivative
 // work thereof.
 
+//go:build ignore
 // +build ignore
 
-// This program generates tables.go.
+// This program generates the lookup tables used by the Ryu algorithm.
+//
+// With -variants, it writes each of the table files used by package ryu's
+// build modes (see the variants list below). Otherwise it writes a single
+// file, named by -o, which is then required. Flags select which table sets
+// are written (by default, the 32- and 64-bit tables), their bit widths, the
+// table layout, and an optional build constraint for the generated file:
+//
+//	go run maketables.go -formats 64:121:122 -layout compressed -tags ryu_compact -o tables_compact.go
+//
+// Each entry in -formats is a table set name (16, bf16, 32, 64, or 128),
+// optionally followed by :pow5bits:pow5invbits to override the default bit
+// widths. The set named 10 is instead the table of 128-bit powers of 10 used
+// by the Eisel–Lemire parsing algorithm, the set named fixed holds the
+// tables of the fixed-precision float64 formatting algorithm (upstream's
+// d2fixed), and the set named dragonbox holds the cached powers of 10 of the
+// Dragonbox algorithm; none of them has bit widths or a layout.
+//
+// Tables for other IEEE-style layouts may be generated with -spec, which takes
+// a comma-separated list of name:mantbits:expbits[:bias] entries. For each one
+// the generator derives the table sizes and bit widths and also writes the
+// mantBits, expBits, and bias constants for the layout:
+//
+//	go run maketables.go -formats '' -spec E4M3:3:4:7 -o tables_e4m3.go
 
 package main
 
 import (
 	"bytes"
+	"errors"
+	"flag"
 	"fmt"
+	"go/build/constraint"
 	"go/format"
 	"io/ioutil"
 	"log"
 	"math/big"
+	"strconv"
+	"strings"
 )
 
-var header = []byte(`// Code generated by running "go generate". DO NOT EDIT.
-
-// Copyright 2018 Ulf Adams
+// header is the copyright header of the Ryu tables.
+var header = []byte(`// Copyright 2018 Ulf Adams
 // Modifications copyright 2019 Caleb Spare
 //
 // The contents of this file may be used under the terms of the Apache License,
@@ -56,99 +84,786 @@ var header = []byte(`// Code generated by running "go generate". DO NOT EDIT.
 // Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
 // code is licensed under Apache 2.0 and this code is derivative work thereof.
 
-package ryu
+`)
+
+// plainHeader is the copyright header of the tables that are not Ryu's.
+var plainHeader = []byte(`// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
 
 `)
 
-const (
-	posTableSize32   = 47
-	negTableSize32   = 31
-	pow5NumBits32    = 61 // max 63
-	pow5InvNumBits32 = 59 // max 63
+// goHeader is the copyright header of the Eisel–Lemire table, which comes from
+// strconv.
+var goHeader = []byte(`// Copyright 2020 The Go Authors. All rights reserved.
+// Modifications copyright 2019 Caleb Spare
+//
+// The table in this file is that of the Eisel–Lemire implementation of the
+// Go standard library's strconv package, which may be found at
+// https://go.dev/src/strconv/eisel_lemire.go. That source code is licensed
+// under the following BSD-style license and this code is derivative work
+// thereof:
+//
+//	Copyright 2009 The Go Authors.
+//
+//	Redistribution and use in source and binary forms, with or without
+//	modification, are permitted provided that the following conditions are
+//	met:
+//
+//	   * Redistributions of source code must retain the above copyright
+//	notice, this list of conditions and the following disclaimer.
+//	   * Redistributions in binary form must reproduce the above
+//	copyright notice, this list of conditions and the following disclaimer
+//	in the documentation and/or other materials provided with the
+//	distribution.
+//	   * Neither the name of Google LLC nor the names of its
+//	contributors may be used to endorse or promote products derived from
+//	this software without specific prior written permission.
+//
+//	THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+//	"AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+//	LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+//	A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+//	OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+//	SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+//	LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+//	DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+//	THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+//	(INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//	OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+`)
 
-	posTableSize64   = 326
-	negTableSize64   = 291 + 1
-	pow5NumBits64    = 121 // max 127
-	pow5InvNumBits64 = 122 // max 127
+// A tableSet describes the pair of pow5 tables used by one floating-point
+// format. The 16 and bf16 sets are sized for IEEE binary16 and bfloat16 and
+// are used with the same 32-bit arithmetic as the 32 set. The 128 set serves
+// both binary128 and the x87 80-bit format, whose largest numbers need the
+// last 14 entries of pow5InvSplit128.
+type tableSet struct {
+	name        string // identifier suffix, as in pow5Split64
+	posSize     int    // entries in pow5Split
+	negSize     int    // entries in pow5InvSplit
+	pow5Bits    int    // bits per pow5Split entry
+	pow5InvBits int    // bits per pow5InvSplit entry
+
+	// For table sets given by -spec, the layout is also written out.
+	layout *ieeeLayout
+
+	// powersOf10 marks the Eisel–Lemire table, which holds 10^q for q in
+	// [-negSize, posSize).
+	powersOf10 bool
+
+	// fixed marks the fixed-precision tables.
+	fixed bool
+
+	// dragonbox marks the tables of the Dragonbox backend.
+	dragonbox bool
+
+	// header is the copyright header of the tables, if not that of Ryu.
+	header []byte
+}
+
+// An ieeeLayout describes a binary floating-point format with an implicit
+// leading mantissa bit.
+type ieeeLayout struct {
+	mantBits int
+	expBits  int
+	bias     int
+}
+
+var tableSets = map[string]tableSet{
+	"16":        {name: "16", posSize: 10, negSize: 1, pow5Bits: 61, pow5InvBits: 59},
+	"bf16":      {name: "BF16", posSize: 43, negSize: 36, pow5Bits: 61, pow5InvBits: 59},
+	"32":        {name: "32", posSize: 47, negSize: 31, pow5Bits: 61, pow5InvBits: 59},
+	"64":        {name: "64", posSize: 326, negSize: 342, pow5Bits: 121, pow5InvBits: 122},
+	"128":       {name: "128", posSize: 4968, negSize: 4911 + 1, pow5Bits: 249, pow5InvBits: 249},
+	"10":        {name: "10", posSize: 309, negSize: 342, powersOf10: true, header: goHeader},
+	"fixed":     {name: "Fixed", fixed: true},
+	"dragonbox": {name: "Dragonbox", dragonbox: true, header: plainHeader},
+}
+
+// pow5TableSize is the number of small powers of 5 stored by the compressed
+// layout. Every pow5TableSize-th entry of the full tables is stored and the
+// entries in between are computed by multiplying by a small power of 5.
+const pow5TableSize = 26
+
+var (
+	formats = flag.String("formats", "32,64", "comma-separated `list` of table sets (16, bf16, 32, 64, 128, 10, fixed, dragonbox), each optionally suffixed with :pow5bits:pow5invbits")
+	specs   = flag.String("spec", "", "comma-separated `list` of name:mantbits:expbits[:bias] layouts to generate tables for")
+	output  = flag.String("o", "", "output `file` (required without -variants)")
+	layout  = flag.String("layout", "full", "table layout: full or compressed")
+	tags    = flag.String("tags", "", "build constraint `expression` for the generated file")
+	pkg     = flag.String("pkg", "ryu", "package name of the generated file")
+
+	variantsFlag = flag.Bool("variants", false, "write all of package ryu's build-tagged table files, ignoring -formats, -spec, -layout, -tags, and -o")
 )
 
 func main() {
-	b := bytes.NewBuffer(header)
-
-	fmt.Fprintf(b, "const pow5NumBits32 = %d\n", pow5NumBits32)
-	fmt.Fprintln(b, "var pow5Split32 = [...]uint64{")
-	for i := int64(0); i < posTableSize32; i++ {
-		pow5 := big.NewInt(5)
-		pow5.Exp(pow5, big.NewInt(i), nil)
-		shift := pow5.BitLen() - pow5NumBits32
-		rsh(pow5, shift)
-		fmt.Fprintf(b, "%d,", pow5.Uint64())
-		if i%4 == 3 {
+	log.SetFlags(0)
+	flag.Parse()
+	if flag.NArg() > 0 {
+		flag.Usage()
+		log.Fatal("unexpected arguments")
+	}
+	// There is no default output file: the 32- and 64-bit tables of the
+	// default -formats would redeclare those written by -variants.
+	if *output == "" && !*variantsFlag {
+		flag.Usage()
+		log.Fatal("-o or -variants is required")
+	}
+	vs := []variant{{
+		file:    *output,
+		formats: *formats,
+		specs:   *specs,
+		layout:  *layout,
+		tags:    *tags,
+	}}
+	if *variantsFlag {
+		vs = variants
+	}
+	for _, v := range vs {
+		if err := v.generate(); err != nil {
+			log.Fatalf("%s: %s", v.file, err)
+		}
+	}
+}
+
+// A variant is a generated table file.
+type variant struct {
+	file    string
+	formats string // as for -formats
+	specs   string // as for -spec
+	layout  string // as for -layout
+	tags    string // as for -tags
+}
+
+// variants are the table files of package ryu written by -variants. Their
+// build constraints select exactly one copy of each table for each build
+// mode:
+//
+//	default:     full 32- and 64-bit tables
+//	ryu_compact: compressed 64-bit tables (RYU_OPTIMIZE_SIZE)
+//	ryu_64only:  no 32-bit tables; float32s use the 64-bit algorithm
+//
+// The powers of 10 for the Eisel–Lemire fast path are left out of compact
+// builds, which parse with Ryu alone. Like upstream, the fixed-precision
+// tables have no compact form.
+var variants = []variant{
+	{file: "tables32.go", formats: "32", layout: "full", tags: "!ryu_64only"},
+	{file: "tables64.go", formats: "64", layout: "full", tags: "!ryu_compact"},
+	{file: "tables64_compact.go", formats: "64", layout: "compressed", tags: "ryu_compact"},
+	{file: "tables10.go", formats: "10", layout: "full", tags: "!ryu_compact"},
+	{file: "tablesfixed.go", formats: "fixed", layout: "full"},
+	{file: "tablesdragonbox.go", formats: "dragonbox", layout: "full", tags: "!ryu_compact"},
+}
+
+func (v variant) generate() error {
+	var sets []tableSet
+	for _, spec := range splitList(v.formats) {
+		ts, err := parseTableSet(spec)
+		if err != nil {
+			return err
+		}
+		sets = append(sets, ts)
+	}
+	for _, spec := range splitList(v.specs) {
+		ts, err := parseSpec(spec)
+		if err != nil {
+			return err
+		}
+		sets = append(sets, ts)
+	}
+	if len(sets) == 0 {
+		return errors.New("no tables selected")
+	}
+
+	var b bytes.Buffer
+	if v.tags != "" {
+		// The // +build lines keep toolchains before Go 1.17 from
+		// compiling every variant.
+		expr, err := constraint.Parse("//go:build " + v.tags)
+		if err != nil {
+			return fmt.Errorf("bad -tags: %s", err)
+		}
+		plus, err := constraint.PlusBuildLines(expr)
+		if err != nil {
+			return fmt.Errorf("bad -tags: %s", err)
+		}
+		fmt.Fprintf(&b, "//go:build %s\n", expr)
+		for _, line := range plus {
+			fmt.Fprintf(&b, "%s\n", line)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("// Code generated by running \"go generate\". DO NOT EDIT.\n\n")
+	var written [][]byte
+	for _, ts := range sets {
+		h := ts.header
+		if h == nil {
+			h = header
+		}
+		if !containsHeader(written, h) {
+			b.Write(h)
+			written = append(written, h)
+		}
+	}
+	fmt.Fprintf(&b, "package %s\n\n", *pkg)
+	for _, ts := range sets {
+		if l := ts.layout; l != nil {
+			fmt.Fprintf(&b, "const (\nmantBits%[1]s = %[2]d\nexpBits%[1]s = %[3]d\nbias%[1]s = %[4]d\n)\n\n",
+				ts.name, l.mantBits, l.expBits, l.bias)
+		}
+		if ts.powersOf10 {
+			writePowersOf10(&b, ts)
+			continue
+		}
+		if ts.fixed {
+			writeFixed(&b, ts)
+			continue
+		}
+		if ts.dragonbox {
+			writeDragonbox(&b, ts)
+			continue
+		}
+		switch v.layout {
+		case "full":
+			writeFull(&b, ts)
+		case "compressed":
+			if err := writeCompressed(&b, ts); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown layout %q", v.layout)
+		}
+	}
+
+	text, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(v.file, text, 0644)
+}
+
+// containsHeader reports whether headers contains h.
+func containsHeader(headers [][]byte, h []byte) bool {
+	for _, g := range headers {
+		if bytes.Equal(g, h) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTableSet(spec string) (tableSet, error) {
+	parts := strings.Split(spec, ":")
+	ts, ok := tableSets[parts[0]]
+	if !ok {
+		return ts, fmt.Errorf("unknown table set %q", parts[0])
+	}
+	switch len(parts) {
+	case 1:
+		return ts, nil
+	case 3:
+		if ts.powersOf10 || ts.fixed || ts.dragonbox {
+			return ts, fmt.Errorf("bad table set %q: the %s set has no bit widths", spec, parts[0])
+		}
+	default:
+		return ts, fmt.Errorf("bad table set %q: want name or name:pow5bits:pow5invbits", spec)
+	}
+	var err error
+	if ts.pow5Bits, err = strconv.Atoi(parts[1]); err != nil {
+		return ts, fmt.Errorf("bad pow5 bit width in %q: %s", spec, err)
+	}
+	if ts.pow5InvBits, err = strconv.Atoi(parts[2]); err != nil {
+		return ts, fmt.Errorf("bad pow5inv bit width in %q: %s", spec, err)
+	}
+	if ts.pow5Bits < 1 || ts.pow5InvBits < 1 || ts.pow5Bits > 255 || ts.pow5InvBits > 255 {
+		return ts, fmt.Errorf("bad table set %q: bit widths must be in [1, 255]", spec)
+	}
+	return ts, nil
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// parseSpec parses a name:mantbits:expbits[:bias] layout and derives the
+// tables it needs. The bias defaults to 2^(expbits-1)-1.
+func parseSpec(spec string) (tableSet, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) != 3 && len(parts) != 4 {
+		return tableSet{}, fmt.Errorf("bad spec %q: want name:mantbits:expbits[:bias]", spec)
+	}
+	name := parts[0]
+	if name == "" || !isIdent(name) {
+		return tableSet{}, fmt.Errorf("bad spec %q: name must be a Go identifier suffix", spec)
+	}
+	var nums []int
+	for _, p := range parts[1:] {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return tableSet{}, fmt.Errorf("bad spec %q: %s", spec, err)
+		}
+		nums = append(nums, n)
+	}
+	l := ieeeLayout{mantBits: nums[0], expBits: nums[1]}
+	if len(nums) == 3 {
+		l.bias = nums[2]
+	} else if l.expBits > 0 {
+		l.bias = 1<<uint(l.expBits-1) - 1
+	}
+	if l.mantBits < 1 || l.mantBits > 125 || l.expBits < 2 || l.expBits > 20 {
+		return tableSet{}, fmt.Errorf("bad spec %q: need 1 <= mantbits <= 125 and 2 <= expbits <= 20", spec)
+	}
+	return l.tableSet(name), nil
+}
+
+func isIdent(s string) bool {
+	for _, r := range s {
+		if !(r == '_' || '0' <= r && r <= '9' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// tableSet derives the tables needed to run Ryu on l. Layouts whose scaled
+// mantissa 4*m2 fits in a uint32 use the 32-bit arithmetic (which also needs
+// the entries next to the last ones for computing the last removed digit);
+// those that fit in a uint64 use the 64-bit arithmetic; anything wider uses
+// 256-bit entries.
+func (l ieeeLayout) tableSet(name string) tableSet {
+	ts := tableSet{name: name, layout: &l}
+	e2Min := 1 - l.bias - l.mantBits - 2
+	e2Max := 1<<uint(l.expBits) - 2 - l.bias - l.mantBits - 2
+	switch {
+	case l.mantBits+3 <= 32:
+		ts.pow5Bits, ts.pow5InvBits = 61, 59
+		if e2Min < 0 {
+			ts.posSize = -e2Min - log10Pow5(-e2Min) + 2
+		}
+		if e2Max >= 0 {
+			ts.negSize = log10Pow2(e2Max) + 1
+		}
+	default:
+		ts.pow5Bits, ts.pow5InvBits = 121, 122
+		if l.mantBits+3 > 64 {
+			ts.pow5Bits, ts.pow5InvBits = 249, 249
+		}
+		if e2Min < 0 {
+			q := log10Pow5(-e2Min)
+			if -e2Min > 1 {
+				q--
+			}
+			ts.posSize = -e2Min - q + 1
+		}
+		if e2Max >= 0 {
+			q := log10Pow2(e2Max)
+			if e2Max > 3 {
+				q--
+			}
+			ts.negSize = q + 1
+		}
+	}
+	// A table is empty if the format has no exponents of its sign; the
+	// conversion never reads it then.
+	return ts
+}
+
+// log10Pow2 returns floor(log_10(2^e)).
+func log10Pow2(e int) int {
+	return len(new(big.Int).Lsh(big.NewInt(1), uint(e)).String()) - 1
+}
+
+// log10Pow5 returns floor(log_10(5^e)).
+func log10Pow5(e int) int {
+	return len(pow5(e).String()) - 1
+}
+
+// writeFull writes every table entry.
+func writeFull(b *bytes.Buffer, ts tableSet) {
+	fmt.Fprintf(b, "const pow5NumBits%s = %d\n", ts.name, ts.pow5Bits)
+	fmt.Fprintf(b, "var pow5Split%s = [...]%s{\n", ts.name, entryType(ts.pow5Bits))
+	for i := 0; i < ts.posSize; i++ {
+		writeEntry(b, i, ts.pow5Bits, pow5Entry(i, ts.pow5Bits))
+	}
+	fmt.Fprintln(b, "\n}")
+
+	fmt.Fprintf(b, "const pow5InvNumBits%s = %d\n", ts.name, ts.pow5InvBits)
+	fmt.Fprintf(b, "var pow5InvSplit%s = [...]%s{\n", ts.name, entryType(ts.pow5InvBits))
+	for i := 0; i < ts.negSize; i++ {
+		writeEntry(b, i, ts.pow5InvBits, pow5InvEntry(i, ts.pow5InvBits))
+	}
+	fmt.Fprintln(b, "\n}")
+}
+
+// writePowersOf10 writes the 128-bit powers of 10 used by the Eisel–Lemire
+// algorithm. Each entry is 10^q scaled by a power of 2 to lie in
+// [2^127, 2^128) and rounded down.
+func writePowersOf10(b *bytes.Buffer, ts tableSet) {
+	fmt.Fprintf(b, "const (\npow10MinExp%[1]s = %[2]d\npow10MaxExp%[1]s = %[3]d\n)\n\n", ts.name, -ts.negSize, ts.posSize-1)
+	fmt.Fprintf(b, "var pow10Split%s = [...]uint128{\n", ts.name)
+	for q := -ts.negSize; q < ts.posSize; q++ {
+		writeEntry(b, q+ts.negSize, 128, pow10Entry(q))
+	}
+	fmt.Fprintln(b, "\n}")
+}
+
+// writeDragonbox writes the cached powers of 10 of the Dragonbox algorithm:
+// 10^k rounded up to 128 bits for k in [-292, 326], which covers the float64
+// exponents, and to 64 bits for k in [-31, 46], which covers the float32
+// ones.
+func writeDragonbox(b *bytes.Buffer, ts tableSet) {
+	for _, t := range []struct {
+		name       string
+		minK, maxK int
+		bits       int
+	}{
+		{"64", -292, 326, 128},
+		{"32", -31, 46, 64},
+	} {
+		fmt.Fprintf(b, "const (\ndragonboxMinK%[1]s = %[2]d\ndragonboxMaxK%[1]s = %[3]d\n)\n\n", t.name, t.minK, t.maxK)
+		fmt.Fprintf(b, "var dragonboxCache%s = [...]%s{\n", t.name, entryType(t.bits))
+		for k := t.minK; k <= t.maxK; k++ {
+			writeEntry(b, k-t.minK, t.bits, dragonboxEntry(k, t.bits))
+		}
+		fmt.Fprintln(b, "\n}")
+	}
+}
+
+// dragonboxEntry returns 10^k × 2^(bits-1-floor(log_2(10^k))), rounded up:
+// 10^k normalized to a bits-bit integer with its top bit set.
+func dragonboxEntry(k, bits int) *big.Int {
+	num, den := big.NewInt(1), big.NewInt(1)
+	if k >= 0 {
+		num = pow10(k)
+	} else {
+		den = pow10(-k)
+	}
+	// floor(log_2(num/den)) is num.BitLen()-den.BitLen(), or one less.
+	l := num.BitLen() - den.BitLen()
+	if l >= 0 && num.Cmp(new(big.Int).Lsh(den, uint(l))) < 0 ||
+		l < 0 && new(big.Int).Lsh(num, uint(-l)).Cmp(den) < 0 {
+		l--
+	}
+	if shift := bits - 1 - l; shift >= 0 {
+		num.Lsh(num, uint(shift))
+	} else {
+		den.Lsh(den, uint(-shift))
+	}
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+// fixedAdditionalBits is the number of bits beyond 2^(16 × idx) to which
+// the entries of the fixed-precision tables are scaled.
+const fixedAdditionalBits = 120
+
+// writeFixed writes the tables of upstream Ryu's d2fixed, which computes 9
+// decimal digits at a time as floor(m2 × 2^e2 / 10^(9i)) mod 10^9 (integer
+// part) or floor(m2 × 2^e2 × 10^(9(i+1))) mod 10^9 (fractional part) from a
+// 192-bit multiplier. The multipliers are grouped by idx, which covers 16
+// binary exponents; the offset tables give the start of each group.
+func writeFixed(b *bytes.Buffer, ts tableSet) {
+	const (
+		mantBits = 52
+		maxE2    = 1<<11 - 2 - 1023 - mantBits
+		minE2    = 1 - 1023 - mantBits
+	)
+	fmt.Fprintf(b, "const fixedAdditionalBits = %d\n\n", fixedAdditionalBits)
+
+	// For the integer part, idx = ceil(e2/16), and entry i holds
+	// ceil(2^(16 × idx + fixedAdditionalBits) / 10^(9i)).
+	var offsets []int
+	var entries []*big.Int
+	for idx := 0; idx <= (maxE2+15)/16; idx++ {
+		offsets = append(offsets, len(entries))
+		n := (log10Pow2(16*idx) + 1 + 16 + 8) / 9
+		for i := 0; i < n; i++ {
+			v := big.NewInt(1)
+			v.Lsh(v, uint(16*idx+fixedAdditionalBits))
+			entries = append(entries, fixedEntry(v, pow10(9*i), 16*idx == 0))
+		}
+	}
+	offsets = append(offsets, len(entries))
+	writeFixedTable(b, "pow10Offset"+ts.name, "pow10Split"+ts.name, offsets, entries)
+
+	// For the fractional part, idx = floor(-e2/16), and entry i holds
+	// ceil(10^(9(i+1)) × 2^(fixedAdditionalBits - 16 × idx)), starting
+	// from the first block that can be nonzero.
+	offsets, entries = nil, nil
+	var minBlocks []int
+	for idx := 0; idx <= -minE2/16; idx++ {
+		offsets = append(offsets, len(entries))
+		min := 0
+		if 16*idx > mantBits+1 {
+			min = log10Pow2(16*idx-mantBits-1) / 9
+		}
+		minBlocks = append(minBlocks, min)
+		for i := min; i < (16*idx+15+8)/9; i++ {
+			v := pow10(9 * (i + 1))
+			den := big.NewInt(1)
+			if shift := fixedAdditionalBits - 16*idx; shift >= 0 {
+				v.Lsh(v, uint(shift))
+			} else {
+				den.Lsh(den, uint(-shift))
+			}
+			entries = append(entries, fixedEntry(v, den, false))
+		}
+	}
+	offsets = append(offsets, len(entries))
+	fmt.Fprintf(b, "var minBlock2%s = [...]uint8{\n", ts.name)
+	for i, min := range minBlocks {
+		fmt.Fprintf(b, "%d,", min)
+		if i%16 == 15 {
 			fmt.Fprintln(b)
 		}
 	}
 	fmt.Fprintln(b, "\n}")
+	writeFixedTable(b, "pow10Offset2"+ts.name, "pow10Split2"+ts.name, offsets, entries)
+}
 
-	fmt.Fprintf(b, "const pow5InvNumBits32 = %d\n", pow5InvNumBits32)
-	fmt.Fprintln(b, "var pow5InvSplit32 = [...]uint64{")
-	for i := int64(0); i < negTableSize32; i++ {
-		pow5 := big.NewInt(5)
-		pow5.Exp(pow5, big.NewInt(i), nil)
-		shift := pow5.BitLen() - 1 + pow5InvNumBits32
-		inv := big.NewInt(1)
-		rsh(inv, -shift)
-		inv.Quo(inv, pow5)
-		inv.Add(inv, big.NewInt(1))
-		fmt.Fprintf(b, "%d,", inv.Uint64())
-		if i%4 == 3 {
+// fixedEntry returns ceil(num/den) reduced modulo 10^9 × 2^(j+8) for every
+// shift j+8 used with it, which leaves the digits computed from it unchanged
+// and makes it fit in 192 bits. The shifts are at most 180 for the integer
+// digits of idx 0 (e2 in [-52, 0]) and 143 otherwise.
+func fixedEntry(num, den *big.Int, idx0 bool) *big.Int {
+	v, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() != 0 {
+		v.Add(v, big.NewInt(1))
+	}
+	shift := uint(143)
+	if idx0 {
+		shift = 180
+	}
+	v.Mod(v, new(big.Int).Lsh(pow10(9), shift))
+	if v.BitLen() > 192 {
+		panic("fixed-precision table entry does not fit in 192 bits")
+	}
+	return v
+}
+
+func writeFixedTable(b *bytes.Buffer, offsetName, name string, offsets []int, entries []*big.Int) {
+	fmt.Fprintf(b, "var %s = [...]uint16{\n", offsetName)
+	for i, off := range offsets {
+		fmt.Fprintf(b, "%d,", off)
+		if i%16 == 15 {
 			fmt.Fprintln(b)
 		}
 	}
 	fmt.Fprintln(b, "\n}")
+	fmt.Fprintf(b, "var %s = [...][3]uint64{\n", name)
+	for i, v := range entries {
+		writeEntry(b, i, 192, v)
+	}
+	fmt.Fprintln(b, "\n}")
+}
 
-	mask64 := big.NewInt(1)
-	mask64.Lsh(mask64, 64)
-	mask64.Sub(mask64, big.NewInt(1))
-
-	fmt.Fprintf(b, "const pow5NumBits64 = %d\n", pow5NumBits64)
-	fmt.Fprintln(b, "var pow5Split64 = [...]uint128{")
-	for i := int64(0); i < posTableSize64; i++ {
-		pow5 := big.NewInt(5)
-		pow5.Exp(pow5, big.NewInt(i), nil)
-		shift := pow5.BitLen() - pow5NumBits64
-		rsh(pow5, shift)
-		lo := new(big.Int).And(pow5, mask64)
-		hi := new(big.Int).Rsh(pow5, 64)
-		fmt.Fprintf(b, "{%d, %d},\n", lo.Uint64(), hi.Uint64())
+// writeCompressed writes the size-optimized layout used by upstream Ryu's
+// RYU_OPTIMIZE_SIZE: every pow5TableSize-th entry of each table, the powers of
+// 5 that fit in a uint64, and 2-bit corrections (16 per uint32) that make the
+// computed entries match the full tables exactly.
+func writeCompressed(b *bytes.Buffer, ts tableSet) error {
+	if entryType(ts.pow5Bits) != "uint128" || entryType(ts.pow5InvBits) != "uint128" {
+		return fmt.Errorf("compressed layout requires 65-127 bit entries; table set %s has %d and %d",
+			ts.name, ts.pow5Bits, ts.pow5InvBits)
+	}
+	fmt.Fprintf(b, "const pow5TableSize%s = %d\n", ts.name, pow5TableSize)
+	fmt.Fprintf(b, "var pow5Table%s = [pow5TableSize%[1]s]uint64{\n", ts.name)
+	for i := 0; i < pow5TableSize; i++ {
+		writeEntry(b, i, 64, pow5(i))
 	}
 	fmt.Fprintln(b, "\n}")
 
-	fmt.Fprintf(b, "const pow5InvNumBits64 = %d\n", pow5InvNumBits64)
-	fmt.Fprintln(b, "var pow5InvSplit64 = [...]uint128{")
-	for i := int64(0); i < negTableSize64; i++ {
-		pow5 := big.NewInt(5)
-		pow5.Exp(pow5, big.NewInt(i), nil)
-		// We want floor(log_2 5^q) here, which is pow5.BitLen() - 1.
-		shift := pow5.BitLen() - 1 + pow5InvNumBits64
-		inv := big.NewInt(1)
-		rsh(inv, -shift)
-		inv.Quo(inv, pow5)
-		inv.Add(inv, big.NewInt(1))
-		lo := new(big.Int).And(inv, mask64)
-		hi := new(big.Int).Rsh(inv, 64)
-		fmt.Fprintf(b, "{%d, %d},\n", lo.Uint64(), hi.Uint64())
+	fmt.Fprintf(b, "const pow5NumBits%s = %d\n", ts.name, ts.pow5Bits)
+	fmt.Fprintf(b, "var pow5Split%sBase = [...]uint128{\n", ts.name)
+	for i := 0; i < ts.posSize; i += pow5TableSize {
+		writeEntry(b, i, ts.pow5Bits, pow5Entry(i, ts.pow5Bits))
 	}
 	fmt.Fprintln(b, "\n}")
+	offsets := make([]uint32, (ts.posSize+15)/16)
+	for i := 0; i < ts.posSize; i++ {
+		base2 := i / pow5TableSize * pow5TableSize
+		if i == base2 {
+			continue // stored exactly
+		}
+		v := new(big.Int).Mul(pow5(i-base2), pow5Entry(base2, ts.pow5Bits))
+		rsh(v, pow5BitLen(i)-pow5BitLen(base2))
+		corr, err := correction(pow5Entry(i, ts.pow5Bits), v)
+		if err != nil {
+			return fmt.Errorf("pow5Split%s[%d]: %s", ts.name, i, err)
+		}
+		offsets[i/16] |= corr << uint(i%16*2)
+	}
+	writeOffsets(b, "pow5Offsets"+ts.name, offsets)
 
-	text, err := format.Source(b.Bytes())
-	if err != nil {
-		log.Fatal(err)
+	fmt.Fprintf(b, "const pow5InvNumBits%s = %d\n", ts.name, ts.pow5InvBits)
+	fmt.Fprintf(b, "var pow5InvSplit%sBase = [...]uint128{\n", ts.name)
+	for i := 0; i < ts.negSize+pow5TableSize-1; i += pow5TableSize {
+		writeEntry(b, i, ts.pow5InvBits, pow5InvBase(i, ts.pow5InvBits))
+	}
+	fmt.Fprintln(b, "\n}")
+	offsets = make([]uint32, (ts.negSize+15)/16)
+	for i := 0; i < ts.negSize; i++ {
+		base2 := (i + pow5TableSize - 1) / pow5TableSize * pow5TableSize
+		v := new(big.Int).Mul(pow5(base2-i), pow5InvBase(base2, ts.pow5InvBits))
+		rsh(v, pow5BitLen(base2)-pow5BitLen(i))
+		corr, err := correction(pow5InvEntry(i, ts.pow5InvBits), v)
+		if err != nil {
+			return fmt.Errorf("pow5InvSplit%s[%d]: %s", ts.name, i, err)
+		}
+		offsets[i/16] |= corr << uint(i%16*2)
+	}
+	writeOffsets(b, "pow5InvOffsets"+ts.name, offsets)
+	return nil
+}
+
+// correction returns want - got, which must fit in 2 bits and must not carry
+// out of the low 64 bits of got.
+func correction(want, got *big.Int) (uint32, error) {
+	mask := new(big.Int).Lsh(big.NewInt(1), 128)
+	mask.Sub(mask, big.NewInt(1))
+	got = new(big.Int).And(got, mask)
+	d := new(big.Int).Sub(want, got)
+	if d.Sign() < 0 || d.Cmp(big.NewInt(3)) > 0 {
+		return 0, fmt.Errorf("correction %s out of range", d)
+	}
+	lo := new(big.Int).And(got, maskWords(1))
+	lo.Add(lo, d)
+	if lo.BitLen() > 64 {
+		return 0, fmt.Errorf("correction carries out of the low word")
+	}
+	return uint32(d.Uint64()), nil
+}
+
+func writeOffsets(b *bytes.Buffer, name string, offsets []uint32) {
+	fmt.Fprintf(b, "var %s = [...]uint32{\n", name)
+	for i, off := range offsets {
+		fmt.Fprintf(b, "%#08x,", off)
+		if i%4 == 3 {
+			fmt.Fprintln(b)
+		}
+	}
+	fmt.Fprintln(b, "\n}")
+}
+
+// pow5Entry returns 5^i truncated to its top bits bits.
+func pow5Entry(i, bits int) *big.Int {
+	v := pow5(i)
+	rsh(v, v.BitLen()-bits)
+	return v
+}
+
+// pow10Entry returns floor(10^q × 2^k) for the k that makes it 128 bits long.
+func pow10Entry(q int) *big.Int {
+	p := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(q))), nil)
+	if q >= 0 {
+		rsh(p, p.BitLen()-128)
+		return p
+	}
+	// 10^-q is strictly between 2^(L-1) and 2^L for L = p.BitLen(), so
+	// 2^(127+L) / 10^-q is strictly between 2^127 and 2^128.
+	v := big.NewInt(1)
+	rsh(v, -(127 + p.BitLen()))
+	return v.Quo(v, p)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// pow5InvEntry returns 2^(floor(log_2(5^i))+bits) / 5^i + 1.
+func pow5InvEntry(i, bits int) *big.Int {
+	p := pow5(i)
+	// We want floor(log_2 5^q) here, which is p.BitLen() - 1.
+	shift := p.BitLen() - 1 + bits
+	inv := big.NewInt(1)
+	rsh(inv, -shift)
+	inv.Quo(inv, p)
+	return inv.Add(inv, big.NewInt(1))
+}
+
+// pow5InvBase returns pow5InvEntry(i, bits) - 1. The compressed layout stores
+// these truncated values so that computed entries never exceed the full ones.
+func pow5InvBase(i, bits int) *big.Int {
+	v := pow5InvEntry(i, bits)
+	return v.Sub(v, big.NewInt(1))
+}
+
+func pow10(i int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(i)), nil)
+}
+
+func pow5(i int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(i)), nil)
+}
+
+// pow5BitLen returns ceil(log_2(5^i)), or else 1 if i==0.
+func pow5BitLen(i int) int {
+	return pow5(i).BitLen()
+}
+
+// entryType returns the Go type used for table entries of the given width.
+func entryType(bits int) string {
+	switch {
+	case bits <= 64:
+		return "uint64"
+	case bits <= 128:
+		return "uint128"
+	case bits <= 192:
+		return "[3]uint64"
+	default:
+		return "[4]uint64"
 	}
-	if err := ioutil.WriteFile("tables.go", text, 0644); err != nil {
-		log.Fatal(err)
+}
+
+// writeEntry writes the table entry v, which is at index i of a table of
+// entries of the given width. Multi-word entries are written low word first.
+func writeEntry(b *bytes.Buffer, i, bits int, v *big.Int) {
+	switch entryType(bits) {
+	case "uint64":
+		fmt.Fprintf(b, "%d,", v.Uint64())
+		if i%4 == 3 {
+			fmt.Fprintln(b)
+		}
+		return
+	case "uint128":
+		fmt.Fprintf(b, "{%d, %d},\n", word(v, 0), word(v, 1))
+	case "[3]uint64":
+		fmt.Fprintf(b, "{%d, %d, %d},\n", word(v, 0), word(v, 1), word(v, 2))
+	default:
+		fmt.Fprintf(b, "{%d, %d, %d, %d},\n", word(v, 0), word(v, 1), word(v, 2), word(v, 3))
 	}
 }
 
+// word returns the nth 64-bit word of v.
+func word(v *big.Int, n int) uint64 {
+	w := new(big.Int).Rsh(v, uint(64*n))
+	return w.And(w, maskWords(1)).Uint64()
+}
+
+func maskWords(n int) *big.Int {
+	m := new(big.Int).Lsh(big.NewInt(1), uint(64*n))
+	return m.Sub(m, big.NewInt(1))
+}
+
 func rsh(x *big.Int, n int) {
 	if n < 0 {
 		x.Lsh(x, uint(-n))
@@ -0,0 +1,166 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"strconv"
+)
+
+// A Notation determines how a Formatter lays out the shortest digits of a
+// number. The zero value, NotationScientific, is the layout of FormatFloat64.
+type Notation uint8
+
+const (
+	// NotationScientific always uses an exponent, as in "1.234e-06".
+	NotationScientific Notation = iota
+	// NotationPositional never uses an exponent, as in "0.000001234",
+	// like FormatFloat64NoExp.
+	NotationPositional
+	// NotationAuto chooses between the two like strconv's 'g' format with
+	// precision -1: it uses an exponent if it is less than -4 or at least
+	// 6, as in "1.234e-06", "0.0001234", and "1.234e+06", and positional
+	// notation otherwise.
+	NotationAuto
+	// NotationEngineering uses an exponent that is a multiple of 3, with 1
+	// to 3 digits before the decimal point, as in "12.5e+03" or
+	// "1.234e-06".
+	NotationEngineering
+)
+
+func (n Notation) String() string {
+	switch n {
+	case NotationScientific:
+		return "NotationScientific"
+	case NotationPositional:
+		return "NotationPositional"
+	case NotationAuto:
+		return "NotationAuto"
+	case NotationEngineering:
+		return "NotationEngineering"
+	}
+	return "Notation(" + strconv.Itoa(int(n)) + ")"
+}
+
+// A PrecisionKind determines what the Precision of a Formatter counts. The
+// zero value, PrecisionShortest, ignores it and prints the shortest digits
+// that identify the number.
+type PrecisionKind uint8
+
+const (
+	// PrecisionShortest prints the shortest digits that parse back to the
+	// same number.
+	PrecisionShortest PrecisionKind = iota
+	// PrecisionSignificant rounds to Precision significant digits, as in
+	// "1.23e+05" or "123000" for 3.
+	PrecisionSignificant
+	// PrecisionDecimals rounds to Precision digits after the decimal point,
+	// as in "123456.79" or "1.23e+05" for 2.
+	PrecisionDecimals
+)
+
+func (k PrecisionKind) String() string {
+	switch k {
+	case PrecisionShortest:
+		return "PrecisionShortest"
+	case PrecisionSignificant:
+		return "PrecisionSignificant"
+	case PrecisionDecimals:
+		return "PrecisionDecimals"
+	}
+	return "PrecisionKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// appendEngineeringDecimal appends the finite nonzero d in engineering
+// notation.
+func appendEngineeringDecimal(b []byte, d FloatDecimal) []byte {
+	if d.Neg {
+		b = append(b, '-')
+	}
+	start := len(b)
+	b = strconv.AppendUint(b, d.Digits, 10)
+	return engineering(b, start, int(d.Exp)+len(b)-start-1, -1)
+}
+
+// appendFloat64Engineering appends f in engineering notation, rounded
+// according to mode to prec significant digits if sig is set and to prec
+// digits after the decimal point otherwise.
+func appendFloat64Engineering(b []byte, f float64, prec int, sig bool, mode RoundingMode) []byte {
+	u := math.Float64bits(f)
+	neg := u>>(mantBits64+expBits64) != 0
+	mant := u & (uint64(1)<<mantBits64 - 1)
+	exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1)
+	if exp == uint64(1)<<expBits64-1 {
+		return appendSpecial(b, neg, false, mant == 0)
+	}
+	if neg {
+		b = append(b, '-')
+	}
+	if sig {
+		prec--
+	}
+	if prec < 0 {
+		prec = 0
+	}
+	start := len(b)
+	if exp == 0 && mant == 0 {
+		return engineering(append(b, '0'), start, 0, prec)
+	}
+	m2, e2 := unpack64(mant, exp)
+	if sig {
+		b, e10 := appendExpDigits(b, m2, e2, prec+1, neg, mode)
+		return engineering(b, start, e10, -1)
+	}
+	// The digits before the point depend on the exponent of f, which is
+	// that of its shortest form. If rounding carries into the next power
+	// of 10, engineering adjusts the digits to the new exponent.
+	d := Decimal64Bits(u)
+	e10 := int(d.Exp) + decimalLen64(d.Digits) - 1
+	b, e10 = appendExpDigits(b, m2, e2, e10-floor3(e10)+1+prec, neg, mode)
+	return engineering(b, start, e10, prec)
+}
+
+// engineering rewrites the significant digits in b[start:], the first of
+// which has the decimal exponent exp, in engineering notation with frac
+// digits after the decimal point, or with all the digits that follow those
+// before the point if frac is negative. Digits are padded with zeros or cut
+// off as needed; the ones cut off must be zeros.
+func engineering(b []byte, start, exp, frac int) []byte {
+	e3 := floor3(exp)
+	ip := exp - e3 + 1
+	nd := len(b) - start
+	if frac < 0 {
+		frac = 0
+		if nd > ip {
+			frac = nd - ip
+		}
+	}
+	if n := ip + frac; nd < n {
+		b = appendZeros(b, n-nd)
+	} else {
+		b = b[:start+n]
+	}
+	if frac > 0 {
+		b = insertByte(b, start+ip, '.')
+	}
+	return appendExponent(b, int32(e3))
+}
+
+// floor3 returns the greatest multiple of 3 that is at most e.
+func floor3(e int) int {
+	m := e % 3
+	if m < 0 {
+		m += 3
+	}
+	return e - m
+}
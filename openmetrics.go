@@ -0,0 +1,54 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"bytes"
+	"math"
+)
+
+// AppendOpenMetricsFloat64 appends f as a number of the OpenMetrics text
+// exposition format to b and returns the extended buffer, byte for byte as
+// the Prometheus Go client writes it: the shortest digits in the notation of
+// strconv's 'g' format, with ".0" added to numbers that have neither a
+// decimal point nor an exponent, as in "1.0", "0.25", "1e+06", and "0.0" for
+// both zeros, and "+Inf", "-Inf", and "NaN", as in the le label of the last
+// histogram bucket. It does not allocate unless b must grow.
+func AppendOpenMetricsFloat64(b []byte, f float64) []byte {
+	if f == 0 {
+		return append(b, "0.0"...)
+	}
+	start := len(b)
+	b = AppendPrometheusFloat64(b, f)
+	if math.IsInf(f, 0) || math.IsNaN(f) || bytes.IndexAny(b[start:], "e.") >= 0 {
+		return b
+	}
+	return append(b, ".0"...)
+}
+
+// AppendPrometheusFloat64 is like AppendOpenMetricsFloat64 for the older
+// Prometheus text format, version 0.0.4, which adds no ".0", as in "1",
+// "0.25", "1e+06", and "0" for both zeros.
+func AppendPrometheusFloat64(b []byte, f float64) []byte {
+	switch {
+	case f == 0:
+		return append(b, '0')
+	case math.IsNaN(f):
+		return append(b, "NaN"...)
+	case math.IsInf(f, 1):
+		return append(b, "+Inf"...)
+	case math.IsInf(f, -1):
+		return append(b, "-Inf"...)
+	}
+	return appendShortestG(b, Decimal64(f))
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestAppendOpenMetricsFloat64(t *testing.T) {
+	for _, tt := range []struct {
+		f           float64
+		openMetrics string
+		prometheus  string
+	}{
+		{0, "0.0", "0"},
+		{math.Copysign(0, -1), "0.0", "0"},
+		{1, "1.0", "1"},
+		{-1, "-1.0", "-1"},
+		{0.25, "0.25", "0.25"},
+		{123456, "123456.0", "123456"},
+		{1e6, "1e+06", "1e+06"},
+		{-1.5e-7, "-1.5e-07", "-1.5e-07"},
+		{math.Inf(1), "+Inf", "+Inf"},
+		{math.Inf(-1), "-Inf", "-Inf"},
+		{math.NaN(), "NaN", "NaN"},
+	} {
+		if got := AppendOpenMetricsFloat64(nil, tt.f); string(got) != tt.openMetrics {
+			t.Errorf("AppendOpenMetricsFloat64(%g): got %q; want %q", tt.f, got, tt.openMetrics)
+		}
+		if got := AppendPrometheusFloat64(nil, tt.f); string(got) != tt.prometheus {
+			t.Errorf("AppendPrometheusFloat64(%g): got %q; want %q", tt.f, got, tt.prometheus)
+		}
+	}
+	// The Prometheus Go client formats other values with strconv.
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		f := math.Float64frombits(r.Uint64())
+		want := strconv.AppendFloat(nil, f, 'g', -1, 64)
+		if got := AppendPrometheusFloat64(nil, f); !math.IsNaN(f) && !bytes.Equal(got, want) {
+			t.Fatalf("AppendPrometheusFloat64(%g): got %q; want %q", f, got, want)
+		}
+	}
+	buf := make([]byte, 0, 32)
+	if n := testing.AllocsPerRun(100, func() { AppendOpenMetricsFloat64(buf, 0.005) }); n != 0 {
+		t.Errorf("AppendOpenMetricsFloat64 allocates %.1f times per call; want 0", n)
+	}
+}
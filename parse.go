@@ -0,0 +1,589 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+// maxParseDigits is the number of significant decimal digits the Ryu parsing
+// algorithm supports. Longer numbers are converted by parseLong.
+const maxParseDigits = 17
+
+// ParseFloat64 converts the decimal number s to the nearest float64, rounding
+// half to even. It implements the string-to-double conversion (s2d) of the
+// reference Ryu implementation. s2d supports up to 17 significant digits;
+// longer numbers are converted correctly with arbitrary-precision
+// arithmetic, which is much slower.
+//
+// s has the form [sign] digits [. digits] [(e|E) [sign] digits], where the
+// mantissa has at least one digit. s may also be a hexadecimal floating-point
+// number as defined by the Go syntax: [sign] 0x hexdigits [. hexdigits]
+// (p|P) [sign] digits, with at least one mantissa digit, denoting the
+// mantissa times a power of 2. Hexadecimal numbers are rounded correctly
+// however many digits they have. Numbers too small to be represented become
+// ±0. ParseFloat64 accepts no spelling of infinity or NaN; a Parser with
+// Specials set does.
+//
+// The errors that ParseFloat64 returns have concrete type *strconv.NumError
+// and are those of strconv.ParseFloat, including its Func field,
+// "ParseFloat". If s is malformed, err.Err = strconv.ErrSyntax and the
+// result is 0. If s is too large to be represented, err.Err =
+// strconv.ErrRange and the result is ±Inf.
+func ParseFloat64(s string) (float64, error) {
+	u, err := parseStd(s, &float64info)
+	return math.Float64frombits(u), err
+}
+
+// ParseFloat32 converts the decimal number s to the nearest float32, rounding
+// half to even. The syntax and errors are those of ParseFloat64. The number
+// is rounded once, directly to float32, so ParseFloat32 avoids the double
+// rounding of float32 of a parsed float64.
+func ParseFloat32(s string) (float32, error) {
+	u, err := parseStd(s, &float32info)
+	return math.Float32frombits(uint32(u)), err
+}
+
+// ParseFloat64Bits is like ParseFloat64 but returns the IEEE 754 binary
+// representation of the result, as math.Float64bits would. It is the inverse
+// of FormatFloat64Bits.
+func ParseFloat64Bits(s string) (uint64, error) {
+	return parseStd(s, &float64info)
+}
+
+// ParseFloat32Bits is like ParseFloat32 but returns the IEEE 754 binary
+// representation of the result, as math.Float32bits would. It is the inverse
+// of FormatFloat32Bits.
+func ParseFloat32Bits(s string) (uint32, error) {
+	u, err := parseStd(s, &float32info)
+	return uint32(u), err
+}
+
+// ParseFloat64Prefix parses the longest prefix of b that is a decimal number
+// in the syntax accepted by ParseFloat64 and returns the nearest float64 and
+// the number of bytes consumed. An exponent marker not followed by exponent
+// digits is not consumed: for "1e+x", n is 1. If b does not start with a
+// number, n is 0 and err.Err is strconv.ErrSyntax. Otherwise the results and
+// errors are those of ParseFloat64 for b[:n].
+func ParseFloat64Prefix(b []byte) (f float64, n int, err error) {
+	u, n, err := stdParser.parseFloat(bytesString(b), &float64info, true, nil)
+	return math.Float64frombits(u), n, err
+}
+
+// ParseFloat32Prefix is like ParseFloat64Prefix but returns the nearest
+// float32.
+func ParseFloat32Prefix(b []byte) (f float32, n int, err error) {
+	u, n, err := stdParser.parseFloat(bytesString(b), &float32info, true, nil)
+	return math.Float32frombits(uint32(u)), n, err
+}
+
+// stdParser implements the top-level parsing functions.
+var stdParser Parser
+
+// parseStd is stdParser.parseFloat for all of s. It scans the common
+// numbers, decimal ones with at most maxParseDigits significant digits, in a
+// single pass, as ParseJSONNumber does, and leaves the others, hexadecimal,
+// long, and malformed numbers, to parseFloat.
+func parseStd(s string, info *floatInfo) (uint64, error) {
+	d, ok := scanStd(s)
+	if !ok {
+		u, _, err := stdParser.parseFloat(s, info, false, nil)
+		return u, err
+	}
+	u, _, err := stdParser.checkRange(s, d.convert(info, ToNearestEven), d.m10 != 0, info)
+	return u, err
+}
+
+// parseFloat parses a decimal number at the start of s and returns the bits
+// of the nearest number in the format described by info and the length of
+// the number. Unless prefix is set, all of s must be a number. If exact is
+// not nil, parseFloat sets *exact to whether the number was converted
+// without rounding.
+func (p *Parser) parseFloat(s string, info *floatInfo, prefix bool, exact *bool) (u uint64, n int, err error) {
+	if p.TrimSpace {
+		return p.parseTrimmed(s, info, prefix, exact)
+	}
+	if u, n := p.Specials.scan(s, info); n > 0 && (prefix || n == len(s)) {
+		if exact != nil {
+			*exact = true
+		}
+		return u, n, nil
+	}
+	if h, n := p.scanHex(s); n > 0 && (prefix || n == len(s)) {
+		if exact != nil {
+			*exact = h.exact(info)
+		}
+		return p.checkRange(s[:n], h.toBits(info, p.Rounding), h.mant != 0 || h.sticky, info)
+	}
+	d, n, tooLong := p.scanDecimal(s)
+	if n == 0 || (!prefix && n < len(s)) {
+		return 0, 0, numError(s, strconv.ErrSyntax)
+	}
+	if tooLong {
+		var ok bool
+		u, ok = p.parseLong(s[:n], info)
+		if exact != nil {
+			*exact = ok
+		}
+	} else {
+		u = d.convert(info, p.Rounding)
+		if exact != nil {
+			*exact = d.exact(info)
+		}
+	}
+	return p.checkRange(s[:n], u, d.m10 != 0 || tooLong, info)
+}
+
+// parseTrimmed is parseFloat for a Parser with TrimSpace set. It ignores
+// the whitespace around the number, or only before it if prefix is set, and
+// counts the leading whitespace in n.
+func (p *Parser) parseTrimmed(s string, info *floatInfo, prefix bool, exact *bool) (u uint64, n int, err error) {
+	t, i := trimSpace(s, prefix)
+	q := *p
+	q.TrimSpace = false
+	u, n, err = q.parseFloat(t, info, prefix, exact)
+	if n == 0 {
+		// Report the whole input, as the error would without TrimSpace.
+		return 0, 0, numError(s, strconv.ErrSyntax)
+	}
+	return u, i + n, err
+}
+
+// trimSpace returns s without leading whitespace, and without trailing
+// whitespace unless leadingOnly is set, along with the number of leading
+// whitespace bytes removed.
+func trimSpace(s string, leadingOnly bool) (t string, i int) {
+	j := len(s)
+	for i < j && isSpace(s[i]) {
+		i++
+	}
+	for !leadingOnly && j > i && isSpace(s[j-1]) {
+		j--
+	}
+	return s[i:j], i
+}
+
+// numError returns a *strconv.NumError for the input s, which it copies
+// because the parsing functions may be handed strings that alias a []byte.
+func numError(s string, err error) *strconv.NumError {
+	return &strconv.NumError{Func: "ParseFloat", Num: string([]byte(s)), Err: err}
+}
+
+// A parsedDecimal is the number m10 × 10^e10.
+type parsedDecimal struct {
+	neg bool
+	m10 uint64
+	e10 int32
+	// digits is the number of decimal digits of m10.
+	digits int32
+}
+
+// maxExp10 bounds the magnitude of parsedDecimal.e10. Larger exponents are
+// clamped; they are far beyond the range of any floating-point format, so
+// clamping does not change the result.
+const maxExp10 = 1 << 28
+
+// scanDecimal parses the longest prefix of s that is a decimal number in the
+// syntax accepted by p and returns the number and the length of the prefix.
+// n is 0 if s does not start with a number. If the number has too many
+// significant digits, tooLong is set and d is incomplete.
+func (p *Parser) scanDecimal(s string) (d parsedDecimal, n int, tooLong bool) {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		d.neg = s[i] == '-'
+		i++
+	}
+	dot, group := p.separators()
+	var (
+		sawDigits bool
+		sawDot    bool
+		fracLen   int // digits after the decimal separator
+		zeros     int // trailing zeros not yet included in m10
+	)
+	// Without separators, long runs of digits at the start of the integer
+	// and fractional parts are consumed 8 at a time.
+	swar := group == "" && !p.Underscores
+	if swar && len(s)-i >= 8 {
+		k := d.scanDigits8(s, i, &zeros)
+		sawDigits = k > 0
+		i += k
+	}
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c == dot {
+			if sawDot {
+				break
+			}
+			sawDot = true
+			if swar && len(s)-i > 8 {
+				k := d.scanDigits8(s, i+1, &zeros)
+				sawDigits = sawDigits || k > 0
+				fracLen += k
+				i += k
+			}
+			continue
+		}
+		if c == '_' && p.Underscores && underscoreOK(s, i) {
+			continue
+		}
+		if group != "" && !sawDot && groupOK(s, i, group) {
+			i += len(group) - 1
+			continue
+		}
+		if c < '0' || c > '9' {
+			break
+		}
+		sawDigits = true
+		if sawDot {
+			fracLen++
+		}
+		if !d.addDigit(c, &zeros) {
+			tooLong = true
+		}
+	}
+	if !sawDigits {
+		return d, 0, false
+	}
+	n = i
+	exp := 0
+	if i < len(s) && p.isExp(s[i]) {
+		i++
+		expNeg := false
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			expNeg = s[i] == '-'
+			i++
+		}
+		start := i
+		for ; i < len(s); i++ {
+			c := s[i]
+			if c == '_' && p.Underscores && underscoreOK(s, i) {
+				continue
+			}
+			if c < '0' || c > '9' {
+				break
+			}
+			if exp < maxExp10 {
+				exp = 10*exp + int(c-'0')
+			}
+		}
+		if i > start {
+			// The exponent is only part of the number if it has digits.
+			n = i
+			if expNeg {
+				exp = -exp
+			}
+		} else {
+			exp = 0
+		}
+	}
+	d.setExp(exp - fracLen + zeros)
+	return d, n, tooLong
+}
+
+// scanStd parses s as a decimal number in the syntax of ParseFloat64. It
+// reports false if s is anything else, such as a hexadecimal or malformed
+// number, or if the number has too many significant digits.
+func scanStd(s string) (d parsedDecimal, ok bool) {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		d.neg = s[i] == '-'
+		i++
+	}
+	var zeros, fracLen int
+	start := i
+	if len(s)-i >= 8 {
+		i += d.scanDigits8(s, i, &zeros)
+	}
+	for ; i < len(s) && isDigit(s[i]); i++ {
+		if !d.addDigit(s[i], &zeros) {
+			return d, false
+		}
+	}
+	sawDigits := i > start
+	if i < len(s) && s[i] == '.' {
+		i++
+		start := i
+		if len(s)-i >= 8 {
+			i += d.scanDigits8(s, i, &zeros)
+		}
+		for ; i < len(s) && isDigit(s[i]); i++ {
+			if !d.addDigit(s[i], &zeros) {
+				return d, false
+			}
+		}
+		fracLen = i - start
+		sawDigits = sawDigits || fracLen > 0
+	}
+	if !sawDigits {
+		return d, false
+	}
+	exp := 0
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		expNeg := false
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			expNeg = s[i] == '-'
+			i++
+		}
+		start := i
+		for ; i < len(s) && isDigit(s[i]); i++ {
+			if exp < maxExp10 {
+				exp = 10*exp + int(s[i]-'0')
+			}
+		}
+		if i == start {
+			return d, false
+		}
+		if expNeg {
+			exp = -exp
+		}
+	}
+	if i < len(s) {
+		return d, false
+	}
+	d.setExp(exp - fracLen + zeros)
+	return d, true
+}
+
+// addDigit appends the digit c to d, whose m10 is followed by *zeros pending
+// trailing zeros. Zeros are only included in m10 once a nonzero digit
+// follows them, so that they do not count against maxParseDigits. addDigit
+// reports false, dropping c, if d has no room for another significant digit.
+func (d *parsedDecimal) addDigit(c byte, zeros *int) bool {
+	if c == '0' {
+		if d.m10 != 0 {
+			*zeros++
+		}
+		return true
+	}
+	if int(d.digits)+*zeros >= maxParseDigits {
+		return false
+	}
+	for ; *zeros > 0; *zeros-- {
+		d.m10 *= 10
+		d.digits++
+	}
+	d.m10 = 10*d.m10 + uint64(c-'0')
+	d.digits++
+	return true
+}
+
+// setExp sets d.e10 to e, clamped to ±maxExp10.
+func (d *parsedDecimal) setExp(e int) {
+	switch {
+	case e > maxExp10:
+		e = maxExp10
+	case e < -maxExp10:
+		e = -maxExp10
+	}
+	d.e10 = int32(e)
+}
+
+// scanDigits8 consumes groups of 8 digits from s[i:] into d for as long as
+// they fit, as the byte-wise loop in scanDecimal would, and returns the
+// number of bytes consumed.
+func (d *parsedDecimal) scanDigits8(s string, i int, zeros *int) int {
+	start := i
+	for len(s)-i >= 8 {
+		x := load8(s, i)
+		if !is8Digits(x) || !d.addDigits8(x, zeros) {
+			break
+		}
+		i += 8
+	}
+	return i - start
+}
+
+// addDigits8 appends the 8 ASCII digits in x, as loaded by load8, to d,
+// whose m10 is followed by *zeros pending trailing zeros, as the byte-wise
+// loop in scanDecimal would. It reports false, leaving d unchanged, if the
+// digits would make d too long, so that the caller can fall back to the
+// byte-wise loop.
+func (d *parsedDecimal) addDigits8(x uint64, zeros *int) bool {
+	leading, trailing := zeroDigits(x)
+	if leading == 8 {
+		if d.m10 != 0 {
+			*zeros += 8
+		}
+		return true
+	}
+	n := 8 - trailing // digits up to the last nonzero one
+	if d.m10 == 0 {
+		n -= leading
+	} else {
+		n += *zeros
+	}
+	if int(d.digits)+n > maxParseDigits {
+		return false
+	}
+	v := uint64(parse8Digits(x)) / powersOf10[trailing]
+	d.m10 = d.m10*powersOf10[n] + v
+	d.digits += int32(n)
+	*zeros = trailing
+	return true
+}
+
+// underscoreOK reports whether the underscore at s[i] separates two digits.
+func underscoreOK(s string, i int) bool {
+	return i > 0 && isDigit(s[i-1]) && i+1 < len(s) && isDigit(s[i+1])
+}
+
+// separators returns the decimal separator of p and its grouping separator,
+// which is empty unless p.Grouping or p.GroupSeparator is set.
+func (p *Parser) separators() (dot byte, group string) {
+	dot, group = '.', ","
+	if p.DecimalComma {
+		dot, group = ',', "."
+	}
+	switch {
+	case p.GroupSeparator != 0:
+		group = string(p.GroupSeparator)
+	case !p.Grouping:
+		group = ""
+	}
+	return dot, group
+}
+
+// groupOK reports whether s[i:] starts with the grouping separator group and
+// the separator is between two digits.
+func groupOK(s string, i int, group string) bool {
+	j := i + len(group)
+	return i > 0 && isDigit(s[i-1]) && j < len(s) && isDigit(s[j]) && s[i:j] == group
+}
+
+// isExp reports whether c marks the exponent of a decimal number.
+func (p *Parser) isExp(c byte) bool {
+	return c == 'e' || c == 'E' || p.FortranExponent && (c == 'd' || c == 'D')
+}
+
+func isDigit(c byte) bool { return '0' <= c && c <= '9' }
+
+// A floatInfo describes a binary floating-point format for parsing.
+type floatInfo struct {
+	mantBits uint
+	expBits  uint
+	bias     int32
+	// Numbers less than 10^minExp10 round to zero and numbers of at least
+	// 10^maxExp10 overflow to infinity.
+	minExp10 int32
+	maxExp10 int32
+}
+
+var (
+	float32info = floatInfo{mantBits32, expBits32, bias32, -46, 40}
+	float64info = floatInfo{mantBits64, expBits64, bias64, -324, 310}
+)
+
+// toBits returns the bits of d rounded according to mode to the format
+// described by info. This is the s2d algorithm, generalized to any format
+// with at most 64 bits by computing with the float64 tables; upstream's s2f is
+// the same algorithm specialized to float32.
+func (d parsedDecimal) toBits(info *floatInfo, mode RoundingMode) uint64 {
+	sign := boolToUint64(d.neg) << (info.mantBits + info.expBits)
+	switch {
+	case d.m10 == 0:
+		return sign
+	case d.digits+d.e10 <= info.minExp10:
+		return info.underflow(d.neg, mode)
+	case d.digits+d.e10 >= info.maxExp10:
+		return info.overflow(d.neg, mode)
+	}
+	m10, e10 := d.m10, d.e10
+	mantBits := int32(info.mantBits)
+
+	// Convert to the binary number m2 × 2^e2, while retaining
+	// information about whether the conversion was exact (trailingZeros).
+	var (
+		e2            int32
+		m2            uint64
+		trailingZeros bool
+	)
+	if e10 >= 0 {
+		// The length of m10 × 10^e10 in bits is
+		//   log2(m10 × 10^e10) = log2(m10) + e10 + e10 × log2(5).
+		// We want to compute the mantBits64+1 topmost bits (+1 for the
+		// implicit leading one in IEEE format), so we choose a binary
+		// output exponent of
+		//   log2(m10 × 10^e10) - (mantBits64 + 1).
+		// We use floor(log2(5^e10)) so that we get at least this many
+		// bits; better to have an additional bit than not enough.
+		// Narrower formats use the same precision and round the extra
+		// bits away below.
+		e2 = int32(bits.Len64(m10)-1) + e10 + pow5Bits(e10) - 1 - (mantBits64 + 1)
+
+		// Compute [m10 × 10^e10 / 2^e2] = [m10 × 5^e10 / 2^(e2-e10)].
+		j := e2 - e10 - pow5Bits(e10) + pow5NumBits64
+		m2 = mulShift64(m10, pow5Entry64(uint32(e10)), j)
+
+		// The result is exact if 2^e2 divides m10 × 10^e10, which is
+		// always the case if e2 < e10 and otherwise requires that
+		// 2^(e2-e10) divides m10.
+		trailingZeros = e2 < e10 || (e2-e10 < 64 && multipleOfPowerOfTwo64(m10, uint32(e2-e10)))
+	} else {
+		e2 = int32(bits.Len64(m10)-1) + e10 - pow5Bits(-e10) - (mantBits64 + 1)
+		j := e2 - e10 + pow5Bits(-e10) - 1 + pow5InvNumBits64
+		m2 = mulShift64(m10, pow5InvEntry64(uint32(-e10)), j)
+		trailingZeros = multipleOfPowerOfFive64(m10, uint32(-e10))
+	}
+
+	// Compute the final IEEE exponent.
+	ieeeE2 := e2 + info.bias + int32(bits.Len64(m2)-1)
+	if ieeeE2 < 0 {
+		ieeeE2 = 0
+	}
+	if ieeeE2 >= 1<<info.expBits-1 {
+		// The final exponent is larger than the maximum representable.
+		return info.overflow(d.neg, mode)
+	}
+
+	// Compute how much m2 must be shifted, taking the final IEEE exponent
+	// into account: reverse the bias and special-case subnormals.
+	shift := ieeeE2 - e2 - info.bias - mantBits
+	if ieeeE2 == 0 {
+		shift++
+	}
+	assert(shift > 0 && shift < 64, "0 < shift < 64")
+
+	// Round up if the exact value is more than 0.5 above the value we
+	// computed: the last removed bit is 1 and either the removed bits were
+	// not just trailing zeros or the result would otherwise be odd (or, for
+	// ToNearestAway, in any case). trailingZeros must be updated now that
+	// the exact output exponent is known. The directed modes round up
+	// whenever any removed bit is 1.
+	trailingZeros = trailingZeros && m2&(uint64(1)<<uint(shift-1)-1) == 0
+	lastRemovedBit := (m2 >> uint(shift-1)) & 1
+	var roundUp bool
+	if mode.nearest() {
+		roundUp = lastRemovedBit != 0 &&
+			(mode == ToNearestAway || !trailingZeros || (m2>>uint(shift))&1 != 0)
+	} else {
+		roundUp = (lastRemovedBit != 0 || !trailingZeros) && mode.away(d.neg)
+	}
+
+	ieeeM2 := m2>>uint(shift) + boolToUint64(roundUp)
+	assert(ieeeM2 <= 1<<(info.mantBits+1), "ieeeM2 <= 1<<(mantBits+1)")
+	ieeeM2 &= uint64(1)<<info.mantBits - 1
+	if ieeeM2 == 0 && roundUp {
+		// Rounding up carried into the exponent. This may produce
+		// infinity, which is the correct result.
+		ieeeE2++
+	}
+	return sign | uint64(ieeeE2)<<info.mantBits | ieeeM2
+}
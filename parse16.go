@@ -0,0 +1,60 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+const (
+	mantBits16 = 10
+	expBits16  = 5
+	bias16     = 15
+
+	mantBitsBF16 = 7
+	expBitsBF16  = 8
+	biasBF16     = 127
+)
+
+var (
+	float16info  = floatInfo{mantBits16, expBits16, bias16, -8, 6}
+	bfloat16info = floatInfo{mantBitsBF16, expBitsBF16, biasBF16, -41, 40}
+)
+
+// ParseFloat16 converts s to the nearest IEEE 754 binary16 (half precision)
+// number and returns its bits. The syntax and errors are those of
+// ParseFloat64. The number is rounded once, directly to binary16, so the
+// result may differ from rounding the nearest float32 or float64.
+func ParseFloat16(s string) (uint16, error) {
+	return stdParser.ParseFloat16(s)
+}
+
+// ParseBFloat16 converts s to the nearest bfloat16 number and returns its
+// bits. bfloat16 has the 8-bit exponent of float32 but only 7 bits of
+// mantissa. As with ParseFloat16, the number is rounded once.
+func ParseBFloat16(s string) (uint16, error) {
+	return stdParser.ParseBFloat16(s)
+}
+
+// ParseFloat16 is like the top-level ParseFloat16.
+func (p *Parser) ParseFloat16(s string) (uint16, error) {
+	u, _, err := p.parseFloat(s, &float16info, false, nil)
+	return uint16(u), err
+}
+
+// ParseBFloat16 is like the top-level ParseBFloat16.
+func (p *Parser) ParseBFloat16(s string) (uint16, error) {
+	u, _, err := p.parseFloat(s, &bfloat16info, false, nil)
+	return uint16(u), err
+}
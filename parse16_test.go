@@ -0,0 +1,175 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestParseFloat16(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want uint16
+	}{
+		{"0", 0},
+		{"-0", 0x8000},
+		{"1", 0x3c00},
+		{"-2", 0xc000},
+		{"65504", 0x7bff},
+		{"65519.99", 0x7bff},
+		{"5.960464477539063e-8", 0x0001},
+		{"6.103515625e-5", 0x0400}, // smallest normal
+		{"0x1.ffcp15", 0x7bff},
+		{"0.1", 0x2e66},
+		// Just above halfway between 1 and 1+2^-10. Via float32, this
+		// would round to the tie 1+2^-11 and then to even, 1.
+		{"1.00048833", 0x3c01},
+	} {
+		got, err := ParseFloat16(tt.s)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseFloat16(%q): got (%#04x, %v); want %#04x", tt.s, got, err, tt.want)
+		}
+	}
+	for _, s := range []string{"65520", "1e5", "-1e300"} {
+		if u, err := ParseFloat16(s); u&0x7fff != 0x7c00 || numErr(err) != strconv.ErrRange {
+			t.Errorf("ParseFloat16(%q): got (%#04x, %v); want (±Inf, range error)", s, u, err)
+		}
+	}
+	p := Parser{Specials: StrconvSpecials}
+	if u, err := p.ParseFloat16("nan"); u != 0x7e00 || err != nil {
+		t.Errorf("ParseFloat16(nan): got (%#04x, %v); want 0x7e00", u, err)
+	}
+}
+
+func TestParseBFloat16(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want uint16
+	}{
+		{"1", 0x3f80},
+		{"-1.5", 0xbfc0},
+		{"3.3895313892515355e38", 0x7f7f},
+		{"9.183549615799121e-41", 0x0001},
+		{"0.1", 0x3dcd},
+		{"1.00390625", 0x3f80}, // halfway; rounds to even
+		{"1.0039062500000001", 0x3f81},
+	} {
+		got, err := ParseBFloat16(tt.s)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseBFloat16(%q): got (%#04x, %v); want %#04x", tt.s, got, err, tt.want)
+		}
+	}
+}
+
+func TestParse16Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, tt := range []struct {
+		name  string
+		parse func(string) (uint16, error)
+		info  floatInfo
+	}{
+		{"ParseFloat16", ParseFloat16, float16info},
+		{"ParseBFloat16", ParseBFloat16, bfloat16info},
+	} {
+		o := newOracle16(tt.info)
+		check := func(s string) {
+			t.Helper()
+			x, ok := new(big.Rat).SetString(s)
+			if !ok {
+				t.Fatalf("bad test input %q", s)
+			}
+			want := o.nearest(x)
+			if got, _ := tt.parse(s); got != want {
+				t.Fatalf("%s(%q): got %#04x; want %#04x", tt.name, s, got, want)
+			}
+		}
+		for i := 0; i < 1e4; i++ {
+			// Values near, and exactly at, the midpoints between
+			// neighbors.
+			j := 1 + r.Intn(len(o.values)-2)
+			mid := new(big.Rat).Add(o.values[j], o.values[j+1])
+			mid.Quo(mid, big.NewRat(2, 1))
+			check(mid.FloatString(60))
+			d, _ := mid.Float64()
+			check(strconv.FormatFloat(d, 'e', 5+r.Intn(15), 64))
+			if r.Intn(2) == 0 {
+				check("-" + mid.FloatString(60))
+			}
+		}
+	}
+}
+
+// An oracle16 rounds numbers to a 16-bit format by searching a sorted list of
+// all of its nonnegative finite values.
+type oracle16 struct {
+	values []*big.Rat
+	bits   []uint16
+}
+
+func newOracle16(info floatInfo) *oracle16 {
+	o := new(oracle16)
+	for u := 0; u < 1<<(info.mantBits+info.expBits); u++ {
+		exp := u >> info.mantBits
+		if exp == 1<<info.expBits-1 {
+			break
+		}
+		mant := u & (1<<info.mantBits - 1)
+		if exp > 0 {
+			mant |= 1 << info.mantBits
+		} else {
+			exp = 1
+		}
+		f := math.Ldexp(float64(mant), exp-int(info.bias)-int(info.mantBits))
+		o.values = append(o.values, new(big.Rat).SetFloat64(f))
+		o.bits = append(o.bits, uint16(u))
+	}
+	// Infinity behaves like the next power of 2 for rounding.
+	inf := math.Ldexp(1, int(info.bias)+1)
+	o.values = append(o.values, new(big.Rat).SetFloat64(inf))
+	o.bits = append(o.bits, uint16((1<<info.expBits-1)<<info.mantBits))
+	return o
+}
+
+func (o *oracle16) nearest(x *big.Rat) uint16 {
+	var sign uint16
+	if x.Sign() < 0 {
+		sign = 0x8000
+		x = new(big.Rat).Neg(x)
+	}
+	i := sort.Search(len(o.values), func(i int) bool { return o.values[i].Cmp(x) >= 0 })
+	if i == len(o.values) {
+		return sign | o.bits[len(o.bits)-1]
+	}
+	if i == 0 || o.values[i].Cmp(x) == 0 {
+		return sign | o.bits[i]
+	}
+	lo, hi := o.values[i-1], o.values[i]
+	// Compare x with the midpoint of lo and hi.
+	mid := new(big.Rat).Add(lo, hi)
+	mid.Quo(mid, big.NewRat(2, 1))
+	switch c := x.Cmp(mid); {
+	case c < 0, c == 0 && o.bits[i-1]&1 == 0:
+		return sign | o.bits[i-1]
+	}
+	return sign | o.bits[i]
+}
@@ -0,0 +1,420 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestParseFloat64(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want float64
+	}{
+		{"0", 0},
+		{"-0", math.Copysign(0, -1)},
+		{"+0.000", 0},
+		{"1", 1},
+		{"-1.5", -1.5},
+		{".5", 0.5},
+		{"5.", 5},
+		{"1e5", 1e5},
+		{"1E-5", 1e-5},
+		{"1e+05", 1e5},
+		{"1000000000000000000000000", 1e24},
+		{"0.000000000000000000000000001", 1e-27},
+		{"2.2250738585072014e-308", 2.2250738585072014e-308},
+		{"2.2250738585072011e-308", 2.225073858507201e-308},
+		{"4.9406564584124654e-324", 5e-324},
+		{"2.4703282292062328e-324", 5e-324},
+		{"2.4703282292062327e-324", 0},
+		{"1e-400", 0},
+		{"-1e-400", math.Copysign(0, -1)},
+		{"1.7976931348623157e308", math.MaxFloat64},
+		{"1.7976931348623158e308", math.MaxFloat64},
+		{"1.7976931348623159e308", math.Inf(1)},
+		{"1e309", math.Inf(1)},
+		{"-1e1000000000000", math.Inf(-1)},
+		{"1e-1000000000000", 0},
+		{"9007199254740993", 9007199254740992},
+		{"9007199254740995", 9007199254740996},
+	} {
+		got, err := ParseFloat64(tt.s)
+		if err != nil && !(math.IsInf(tt.want, 0) && numErr(err) == strconv.ErrRange) {
+			t.Errorf("ParseFloat64(%q): %s", tt.s, err)
+			continue
+		}
+		if math.Float64bits(got) != math.Float64bits(tt.want) {
+			t.Errorf("ParseFloat64(%q): got %g; want %g", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestParseFloat64Errors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"-",
+		".",
+		"e5",
+		"1e",
+		"1e+",
+		"1..5",
+		"1.5.",
+		"1x",
+		"1e5x",
+		"--1",
+		" 1",
+		"inf",
+	} {
+		if _, err := ParseFloat64(s); err == nil {
+			t.Errorf("ParseFloat64(%q): got nil error", s)
+		}
+	}
+}
+
+func TestParseFloat64Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	check := func(s string) {
+		t.Helper()
+		got, err := ParseFloat64(s)
+		want, wantErr := strconv.ParseFloat(s, 64)
+		if !sameError(err, wantErr) {
+			t.Fatalf("ParseFloat64(%q): got error %v; want %v", s, err, wantErr)
+		}
+		if math.Float64bits(got) != math.Float64bits(want) {
+			t.Fatalf("ParseFloat64(%q): got %g; want %g", s, got, want)
+		}
+	}
+	for i := 0; i < 1e4; i++ {
+		n := 1 + r.Intn(maxParseDigits)
+		m := r.Int63n(int64(math.Pow10(n)))
+		check(strconv.FormatInt(m, 10) + "e" + strconv.Itoa(r.Intn(700)-360))
+
+		x := math.Float64frombits(r.Uint64() &^ (1 << 63))
+		y := math.Nextafter(x, math.Inf(1))
+		if math.IsInf(y, 0) || math.IsNaN(x) {
+			continue
+		}
+		check(FormatFloat64(x))
+		// Numbers near the midpoint between two float64s.
+		mid := new(big.Float).SetPrec(2100).SetFloat64(x)
+		mid.Add(mid, new(big.Float).SetFloat64(y))
+		mid.Quo(mid, big.NewFloat(2))
+		check(mid.Text('e', maxParseDigits-1))
+		// Numbers just off the midpoint need more digits.
+		check(mid.Text('e', 40+r.Intn(400)))
+		mid.SetMantExp(mid, -r.Intn(3))
+		check(mid.Text('e', 20+r.Intn(40)))
+	}
+	for i := 0; i < 1e3; i++ {
+		check(randomDigits(r, 18+r.Intn(800)) + "e" + strconv.Itoa(r.Intn(1000)-700))
+	}
+}
+
+// randomDigits returns a random decimal number with n digits, some of which
+// may be leading or trailing zeros, and a decimal point.
+func randomDigits(r *rand.Rand, n int) string {
+	b := make([]byte, n+1)
+	for i := range b {
+		b[i] = "0123456789"[r.Intn(10)]
+	}
+	for i := r.Intn(n / 2); i > 0; i-- {
+		b[r.Intn(len(b))] = '0'
+	}
+	b[r.Intn(len(b))] = '.'
+	return string(b)
+}
+
+// TestParseStd checks that the single-pass scan of the top-level functions
+// agrees with the general Parser path.
+func TestParseStd(t *testing.T) {
+	for _, s := range []string{
+		"0", "-0", "+1", ".5", "5.", "-.5e1", "00012", "1.50000000000000000000",
+		"123456789012345678", "0.000000001234567890123", "12345678.12345678e-3",
+		"1e", "1e+", "1.e5", ".e5", ".", "+", "", "1e5x", "1..5", "0x1p-3",
+		"inf", "NaN", "1_000", "1,5", " 1", "1e400", "1e-400", "-1e999999999999",
+	} {
+		want, wantErr := stdParser.ParseFloat64(s)
+		if got, err := ParseFloat64(s); math.Float64bits(got) != math.Float64bits(want) || !sameError(err, wantErr) {
+			t.Errorf("ParseFloat64(%q): got (%g, %v); want (%g, %v)", s, got, err, want, wantErr)
+		}
+		want32, wantErr := stdParser.ParseFloat32(s)
+		if got, err := ParseFloat32(s); math.Float32bits(got) != math.Float32bits(want32) || !sameError(err, wantErr) {
+			t.Errorf("ParseFloat32(%q): got (%g, %v); want (%g, %v)", s, got, err, want32, wantErr)
+		}
+	}
+}
+
+func TestParseFloat32(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want float32
+	}{
+		{"0", 0},
+		{"-0", float32(math.Copysign(0, -1))},
+		{"1.5", 1.5},
+		{"3.4028235e38", math.MaxFloat32},
+		{"3.4028235677973366e38", math.MaxFloat32},
+		{"3.4028235677973367e38", float32(math.Inf(1))},
+		{"1e39", float32(math.Inf(1))},
+		{"1e-45", math.SmallestNonzeroFloat32},
+		{"7.006492321624085e-46", 0},
+		{"7.006492321624086e-46", math.SmallestNonzeroFloat32},
+		{"16777217", 16777216},
+		{"16777219", 16777220},
+		// This is just above the midpoint 1+2^-24 between two float32s,
+		// but rounding to float64 first gives the midpoint itself, which
+		// then rounds to even: 1.
+		{"1.0000000596046448", 1.0000001},
+	} {
+		got, err := ParseFloat32(tt.s)
+		if err != nil && !(math.IsInf(float64(tt.want), 0) && numErr(err) == strconv.ErrRange) {
+			t.Errorf("ParseFloat32(%q): %s", tt.s, err)
+			continue
+		}
+		if math.Float32bits(got) != math.Float32bits(tt.want) {
+			t.Errorf("ParseFloat32(%q): got %g; want %g", tt.s, got, tt.want)
+		}
+	}
+	if _, err := ParseFloat32("1.5x"); err == nil {
+		t.Error("ParseFloat32(\"1.5x\"): got nil error")
+	}
+}
+
+func TestParseFloat32Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	check := func(s string) {
+		t.Helper()
+		got, err := ParseFloat32(s)
+		want, wantErr := strconv.ParseFloat(s, 32)
+		if !sameError(err, wantErr) {
+			t.Fatalf("ParseFloat32(%q): got error %v; want %v", s, err, wantErr)
+		}
+		if math.Float32bits(got) != math.Float32bits(float32(want)) {
+			t.Fatalf("ParseFloat32(%q): got %g; want %g", s, got, want)
+		}
+	}
+	for i := 0; i < 1e4; i++ {
+		n := 1 + r.Intn(maxParseDigits)
+		m := r.Int63n(int64(math.Pow10(n)))
+		check(strconv.FormatInt(m, 10) + "e" + strconv.Itoa(r.Intn(110)-70))
+
+		x := math.Float32frombits(r.Uint32() &^ (1 << 31))
+		if x >= math.MaxFloat32 || x != x {
+			continue
+		}
+		check(FormatFloat32(x))
+		// Numbers near the midpoint between two float32s.
+		y := math.Nextafter32(x, float32(math.Inf(1)))
+		mid := new(big.Float).SetPrec(200).SetFloat64(float64(x))
+		mid.Add(mid, new(big.Float).SetFloat64(float64(y)))
+		mid.Quo(mid, big.NewFloat(2))
+		check(mid.Text('e', maxParseDigits-1))
+		check(mid.Text('e', 20+r.Intn(200)))
+	}
+	for i := 0; i < 1e3; i++ {
+		check(randomDigits(r, 18+r.Intn(200)) + "e" + strconv.Itoa(r.Intn(200)-120))
+	}
+}
+
+func TestParseFloatBits(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		u64 := r.Uint64()
+		if f := math.Float64frombits(u64); !math.IsInf(f, 0) && !math.IsNaN(f) {
+			s := FormatFloat64Bits(u64)
+			if got, err := ParseFloat64Bits(s); got != u64 || err != nil {
+				t.Fatalf("ParseFloat64Bits(%q): got (%#x, %v); want %#x", s, got, err, u64)
+			}
+		}
+		u32 := r.Uint32()
+		if f := math.Float32frombits(u32); f == f && !math.IsInf(float64(f), 0) {
+			s := FormatFloat32Bits(u32)
+			if got, err := ParseFloat32Bits(s); got != u32 || err != nil {
+				t.Fatalf("ParseFloat32Bits(%q): got (%#x, %v); want %#x", s, got, err, u32)
+			}
+		}
+	}
+	if u, err := ParseFloat64Bits("-1e400"); u != 0xfff0000000000000 || numErr(err) != strconv.ErrRange {
+		t.Errorf("ParseFloat64Bits(-1e400): got (%#x, %v); want (0xfff0000000000000, range error)", u, err)
+	}
+	if u, err := ParseFloat32Bits("-0"); u != 0x80000000 || err != nil {
+		t.Errorf("ParseFloat32Bits(-0): got (%#x, %v); want 0x80000000", u, err)
+	}
+}
+
+func TestParseFloat64Prefix(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want float64
+		n    int
+	}{
+		{"1.5", 1.5, 3},
+		{"1.5,2.5", 1.5, 3},
+		{"-2e3 ms", -2e3, 4},
+		{"1e", 1, 1},
+		{"1e+x", 1, 1},
+		{"1E-7;", 1e-7, 4},
+		{"1.5.3", 1.5, 3},
+		{"3.", 3, 2},
+		{".25x", 0.25, 3},
+		{"+7abc", 7, 2},
+	} {
+		f, n, err := ParseFloat64Prefix([]byte(tt.s))
+		if err != nil {
+			t.Errorf("ParseFloat64Prefix(%q): %s", tt.s, err)
+			continue
+		}
+		if f != tt.want || n != tt.n {
+			t.Errorf("ParseFloat64Prefix(%q): got (%g, %d); want (%g, %d)", tt.s, f, n, tt.want, tt.n)
+		}
+		f32, n, err := ParseFloat32Prefix([]byte(tt.s))
+		if err != nil || f32 != float32(tt.want) || n != tt.n {
+			t.Errorf("ParseFloat32Prefix(%q): got (%g, %d, %v); want (%g, %d, nil)", tt.s, f32, n, err, tt.want, tt.n)
+		}
+	}
+	for _, s := range []string{"", "x1", ".", "-.e5", "e5"} {
+		if _, n, err := ParseFloat64Prefix([]byte(s)); n != 0 || err == nil {
+			t.Errorf("ParseFloat64Prefix(%q): got (%d, %v); want (0, error)", s, n, err)
+		}
+		if _, n, err := ParseFloat32Prefix([]byte(s)); n != 0 || err == nil {
+			t.Errorf("ParseFloat32Prefix(%q): got (%d, %v); want (0, error)", s, n, err)
+		}
+	}
+	f, n, err := ParseFloat64Prefix([]byte("-1e400,"))
+	if !math.IsInf(f, -1) || n != 6 || err.Error() != `strconv.ParseFloat: parsing "-1e400": value out of range` {
+		t.Errorf("ParseFloat64Prefix(-1e400): got (%g, %d, %v); want (-Inf, 6, range error)", f, n, err)
+	}
+	f, n, err = ParseFloat64Prefix([]byte("123456789012345678901,"))
+	if f != 123456789012345678901 || n != 21 || err != nil {
+		t.Errorf("ParseFloat64Prefix(21 digits): got (%g, %d, %v); want (1.2345678901234568e+20, 21, nil)", f, n, err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	// The errors match strconv's exactly.
+	for _, s := range []string{
+		"",
+		"1x",
+		"1e",
+		"1..5",
+		"1e400",
+		"-1e400",
+		"1e-400",
+		"1.8e308",
+		"1.00000000000000000001e-400",
+		"1.00000000000000000001e400",
+	} {
+		_, err := ParseFloat64(s)
+		_, wantErr := strconv.ParseFloat(s, 64)
+		if !sameError(err, wantErr) {
+			t.Errorf("ParseFloat64(%q): got error %v; want %v", s, err, wantErr)
+		}
+		_, err = ParseFloat32(s)
+		_, wantErr = strconv.ParseFloat(s, 32)
+		if !sameError(err, wantErr) {
+			t.Errorf("ParseFloat32(%q): got error %v; want %v", s, err, wantErr)
+		}
+	}
+}
+
+// numErr returns the Err field of a *strconv.NumError.
+func numErr(err error) error {
+	if e, ok := err.(*strconv.NumError); ok {
+		return e.Err
+	}
+	return nil
+}
+
+// sameError reports whether err and want are both nil or are both
+// *strconv.NumErrors with the same message.
+func sameError(err, want error) bool {
+	if err == nil || want == nil {
+		return err == want
+	}
+	_, ok := err.(*strconv.NumError)
+	return ok && err.Error() == want.Error()
+}
+
+var parseBenchCases = []string{
+	"0",
+	"1",
+	"0.3",
+	"1e+06",
+	"-123.45",
+	"6.226662346353213e-309",
+	"1234567890123456",
+	"0.00000001234567890123",
+	"3.141592653589793",
+	"1.7976931348623157e308",
+	"4.9406564584124654e-300",
+}
+
+func BenchmarkParseFloat64(b *testing.B) {
+	for _, s := range parseBenchCases {
+		b.Run(s, func(b *testing.B) {
+			var f float64
+			for i := 0; i < b.N; i++ {
+				f, _ = ParseFloat64(s)
+			}
+			floatSink = f
+		})
+	}
+}
+
+func BenchmarkStrconvParseFloat64(b *testing.B) {
+	for _, s := range parseBenchCases {
+		b.Run(s, func(b *testing.B) {
+			var f float64
+			for i := 0; i < b.N; i++ {
+				f, _ = strconv.ParseFloat(s, 64)
+			}
+			floatSink = f
+		})
+	}
+}
+
+// BenchmarkParseFloat64VsStrconv compares ParseFloat64 with
+// strconv.ParseFloat on short numbers, the common case, side by side. The
+// top-level functions exist to be at least as fast.
+func BenchmarkParseFloat64VsStrconv(b *testing.B) {
+	for _, s := range []string{"0", "1", "0.3", "-123.45", "1e+06", "12.5e-3"} {
+		b.Run(s, func(b *testing.B) {
+			b.Run("ryu", func(b *testing.B) {
+				var f float64
+				for i := 0; i < b.N; i++ {
+					f, _ = ParseFloat64(s)
+				}
+				floatSink = f
+			})
+			b.Run("strconv", func(b *testing.B) {
+				var f float64
+				for i := 0; i < b.N; i++ {
+					f, _ = strconv.ParseFloat(s, 64)
+				}
+				floatSink = f
+			})
+		})
+	}
+}
+
+var floatSink float64
@@ -0,0 +1,74 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import "strconv"
+
+// maxDecimalDigits is the number of significant digits that ParseDecimal64
+// accepts, the most that always fit in a uint64.
+const maxDecimalDigits = 19
+
+// ParseDecimal64 parses s, a decimal number in the syntax accepted by
+// ParseFloat64, and returns it exactly as neg, the sign, and m × 10^e. Unlike
+// ParseFloat64, it does not round to a binary floating-point number, so
+// decimal-arithmetic packages may use it to read their input. m has no
+// trailing zeros; a zero is returned with m and e both zero and its sign in
+// neg. Hexadecimal numbers, infinities, and NaNs are syntax errors.
+//
+// If s has more than 19 significant digits or e would be 2^28 or more in
+// magnitude, ParseDecimal64 returns a *strconv.NumError with Err set to
+// strconv.ErrRange.
+func ParseDecimal64(s string) (m uint64, e int32, neg bool, err error) {
+	return stdParser.parseDecimal(s)
+}
+
+// ParseDecimal64 parses s like the top-level ParseDecimal64, accepting the
+// syntax configured by p. Rounding, Range, and Specials do not apply.
+func (p *Parser) ParseDecimal64(s string) (m uint64, e int32, neg bool, err error) {
+	return p.parseDecimal(s)
+}
+
+func (p *Parser) parseDecimal(s string) (m uint64, e int32, neg bool, err error) {
+	orig := s
+	if p.TrimSpace {
+		s, _ = trimSpace(s, false)
+	}
+	d, n, tooLong := p.scanDecimal(s)
+	if n == 0 || n < len(s) {
+		return 0, 0, false, numError(orig, strconv.ErrSyntax)
+	}
+	if tooLong {
+		neg, digits, e10 := p.longDigits(s)
+		if len(digits) > maxDecimalDigits {
+			return 0, 0, neg, numError(s, strconv.ErrRange)
+		}
+		d = parsedDecimal{neg: neg}
+		for _, c := range digits {
+			d.m10 = 10*d.m10 + uint64(c-'0')
+		}
+		d.setExp(e10)
+	}
+	switch {
+	case d.m10 == 0:
+		return 0, 0, d.neg, nil
+	case d.e10 >= maxExp10 || d.e10 <= -maxExp10:
+		// setExp clamped the exponent, so it is no longer exact.
+		return 0, 0, d.neg, numError(s, strconv.ErrRange)
+	}
+	return d.m10, d.e10, d.neg, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+import (
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestParseDecimal64(t *testing.T) {
+	for _, tt := range []struct {
+		s   string
+		m   uint64
+		e   int32
+		neg bool
+	}{
+		{"0", 0, 0, false},
+		{"-0.000e5", 0, 0, true},
+		{"1", 1, 0, false},
+		{"+1500", 15, 2, false},
+		{"-1.25", 125, -2, true},
+		{"0.1", 1, -1, false},
+		{"1e400", 1, 400, false},
+		{"12.5e-1000", 125, -1001, false},
+		{"9999999999999999999", 9999999999999999999, 0, false},
+		{"0.0000012345678901234567890000", 1234567890123456789, -24, false},
+		{"100000000000000000000000000000", 1, 29, false},
+		{"-0.3000000000000000004", 3000000000000000004, -19, true},
+	} {
+		m, e, neg, err := ParseDecimal64(tt.s)
+		if m != tt.m || e != tt.e || neg != tt.neg || err != nil {
+			t.Errorf("ParseDecimal64(%q): got (%d, %d, %t, %v); want (%d, %d, %t, nil)", tt.s, m, e, neg, err, tt.m, tt.e, tt.neg)
+		}
+	}
+	for _, tt := range []struct {
+		s       string
+		wantErr error
+	}{
+		{"", strconv.ErrSyntax},
+		{"1.5x", strconv.ErrSyntax},
+		{"0x1p3", strconv.ErrSyntax},
+		{"inf", strconv.ErrSyntax},
+		{"NaN", strconv.ErrSyntax},
+		{"1_0", strconv.ErrSyntax},
+		{"12345678901234567891", strconv.ErrRange},
+		{"0.30000000000000000004", strconv.ErrRange},
+		{"1.2345678901234567891e5", strconv.ErrRange},
+		{"1e999999999", strconv.ErrRange},
+		{"1e-999999999", strconv.ErrRange},
+	} {
+		if _, _, _, err := ParseDecimal64(tt.s); numErr(err) != tt.wantErr {
+			t.Errorf("ParseDecimal64(%q): got error %v; want %v", tt.s, err, tt.wantErr)
+		}
+	}
+	// A zero is exact whatever its exponent.
+	if m, e, _, err := ParseDecimal64("0e999999999"); m != 0 || e != 0 || err != nil {
+		t.Errorf("ParseDecimal64(0e999999999): got (%d, %d, %v); want (0, 0, nil)", m, e, err)
+	}
+}
+
+func TestParserParseDecimal64(t *testing.T) {
+	p := Parser{DecimalComma: true, Grouping: true, Underscores: true, FortranExponent: true}
+	for _, tt := range []struct {
+		s string
+		m uint64
+		e int32
+	}{
+		{"1.234.567,89", 123456789, -2},
+		{"1_000", 1, 3},
+		{"2,5D3", 25, 2},
+		{"1.234.567.890.123.456.789,0", 1234567890123456789, 0},
+	} {
+		m, e, _, err := p.ParseDecimal64(tt.s)
+		if m != tt.m || e != tt.e || err != nil {
+			t.Errorf("ParseDecimal64(%q): got (%d, %d, %v); want (%d, %d, nil)", tt.s, m, e, err, tt.m, tt.e)
+		}
+	}
+}
+
+// TestParseDecimal64Random checks that ParseDecimal64 returns the value of
+// its input exactly.
+func TestParseDecimal64Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1e4; i++ {
+		s := randomDigits(r, 2+r.Intn(18))
+		if r.Intn(2) == 0 {
+			s += "e" + strconv.Itoa(r.Intn(1000)-500)
+		}
+		m, e, _, err := ParseDecimal64(s)
+		if err != nil {
+			t.Fatalf("ParseDecimal64(%q): %v", s, err)
+		}
+		want, _ := new(big.Rat).SetString(s)
+		got := new(big.Rat).SetInt(new(big.Int).SetUint64(m))
+		p := new(big.Rat).SetInt(pow10Big(int(abs32(e))))
+		if e >= 0 {
+			got.Mul(got, p)
+		} else {
+			got.Quo(got, p)
+		}
+		if got.Cmp(want) != 0 || (m != 0 && m%10 == 0) {
+			t.Fatalf("ParseDecimal64(%q): got (%d, %d)", s, m, e)
+		}
+	}
+}
+
+func abs32(x int32) int32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,200 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "math/bits"
+
+// A parsedHex is the number mant × 2^exp, plus a fraction of 2^exp if sticky
+// is set. The fraction stands for nonzero digits that did not fit in mant.
+type parsedHex struct {
+	neg    bool
+	mant   uint64
+	exp    int32
+	sticky bool
+}
+
+// scanHex parses the longest prefix of s that is a hexadecimal floating-point
+// number in the syntax accepted by p and returns the number and the length of
+// the prefix. n is 0 if s does not start with such a number.
+func (p *Parser) scanHex(s string) (h parsedHex, n int) {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		h.neg = s[i] == '-'
+		i++
+	}
+	if i+1 >= len(s) || s[i] != '0' || (s[i+1] != 'x' && s[i+1] != 'X') {
+		return h, 0
+	}
+	i += 2
+	var (
+		sawDigits bool
+		sawDot    bool
+		exp       int
+	)
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c == '.' {
+			if sawDot {
+				break
+			}
+			sawDot = true
+			continue
+		}
+		if c == '_' && p.Underscores && hexUnderscoreOK(s, i) {
+			continue
+		}
+		v, ok := unhex(c)
+		if !ok {
+			break
+		}
+		sawDigits = true
+		switch {
+		case h.mant>>60 == 0:
+			h.mant = h.mant<<4 | uint64(v)
+			if sawDot {
+				exp -= 4
+			}
+		default:
+			// mant is full; keep track of the digits' presence
+			// and weight only.
+			h.sticky = h.sticky || v != 0
+			if !sawDot {
+				exp += 4
+			}
+		}
+	}
+	// The binary exponent is mandatory, as in Go.
+	if !sawDigits || i == len(s) || (s[i] != 'p' && s[i] != 'P') {
+		return h, 0
+	}
+	i++
+	expNeg := false
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		expNeg = s[i] == '-'
+		i++
+	}
+	start := i
+	pexp := 0
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c == '_' && p.Underscores && underscoreOK(s, i) {
+			continue
+		}
+		if !isDigit(c) {
+			break
+		}
+		if pexp < maxExp10 {
+			pexp = 10*pexp + int(c-'0')
+		}
+	}
+	if i == start {
+		return h, 0
+	}
+	if expNeg {
+		pexp = -pexp
+	}
+	exp += pexp
+	switch {
+	case exp > maxExp10:
+		exp = maxExp10
+	case exp < -maxExp10:
+		exp = -maxExp10
+	}
+	h.exp = int32(exp)
+	return h, i
+}
+
+// hexUnderscoreOK reports whether the underscore at s[i] separates two hex
+// digits or follows the 0x prefix and precedes a hex digit.
+func hexUnderscoreOK(s string, i int) bool {
+	if i+1 >= len(s) {
+		return false
+	}
+	if _, ok := unhex(s[i+1]); !ok {
+		return false
+	}
+	if _, ok := unhex(s[i-1]); ok {
+		return true
+	}
+	return s[i-1] == 'x' || s[i-1] == 'X'
+}
+
+func unhex(c byte) (v byte, ok bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// toBits returns the bits of h rounded according to mode to the format
+// described by info.
+func (h parsedHex) toBits(info *floatInfo, mode RoundingMode) uint64 {
+	sign := boolToUint64(h.neg) << (info.mantBits + info.expBits)
+	inf := (uint64(1)<<info.expBits - 1) << info.mantBits
+	if h.mant == 0 {
+		return sign
+	}
+	// Normalize mant to [2^63, 2^64). The number is then in
+	// [2^(exp+63), 2^(exp+64)).
+	lz := bits.LeadingZeros64(h.mant)
+	mant := h.mant << uint(lz)
+	e := int(h.exp) - lz + 63 + int(info.bias)
+	if e >= 1<<info.expBits-1 {
+		return info.overflow(h.neg, mode)
+	}
+
+	// Keep mantBits+1 bits, or fewer for subnormals.
+	shift := 63 - int(info.mantBits)
+	if e <= 0 {
+		shift += 1 - e
+	}
+	var kept, rem, half uint64
+	switch {
+	case shift < 64:
+		kept = mant >> uint(shift)
+		rem = mant & (uint64(1)<<uint(shift) - 1)
+		half = uint64(1) << uint(shift-1)
+	case shift == 64:
+		rem, half = mant, 1<<63
+	default:
+		// Less than half of the smallest subnormal.
+		return info.underflow(h.neg, mode)
+	}
+	if mode.nearest() {
+		if rem > half || (rem == half && (mode == ToNearestAway || h.sticky || kept&1 != 0)) {
+			kept++
+		}
+	} else if (rem != 0 || h.sticky) && mode.away(h.neg) {
+		kept++
+	}
+	// For normal numbers, kept includes the implicit leading bit, which
+	// adds one to the biased exponent e-1. A carry out of the mantissa
+	// likewise increments the exponent, possibly up to infinity. For
+	// subnormals, kept is the mantissa, and rounding up to the smallest
+	// normal number also works out.
+	var u uint64
+	if e > 0 {
+		u = uint64(e-1)<<info.mantBits + kept
+	} else {
+		u = kept
+	}
+	if u > inf {
+		u = inf
+	}
+	return sign | u
+}
@@ -0,0 +1,156 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestParseHex(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want float64
+	}{
+		{"0x1p0", 1},
+		{"0x1.8p3", 12},
+		{"-0X1.8P+3", -12},
+		{"0x.8p1", 1},
+		{"0x1.p1", 2},
+		{"0x0p0", 0},
+		{"-0x0.0p-99999999999", math.Copysign(0, -1)},
+		{"0x1p-1074", 5e-324},
+		{"0x1p-1075", 0},                          // halfway; rounds to even
+		{"0x1.0000000000000000001p-1075", 5e-324}, // just above halfway
+		{"0x3p-1076", 5e-324},
+		{"0x1.8p-1074", 1e-323},
+		{"0x0.fffffffffffff8p-1022", math.Ldexp(1, -1022)}, // rounds up to normal
+		{"0x1.fffffffffffffp1023", math.MaxFloat64},
+		{"0x1.fffffffffffff7ffffffffp1023", math.MaxFloat64},
+		{"0x1.fffffffffffff8p1023", math.Inf(1)},
+		{"0x10000000000000000000000000000001p-124", 1},
+		{"0x1000000000000080000000000000000p-120", 1},
+		{"0x1000000000000080000000000000001p-120", 1 + math.Ldexp(1, -52)},
+	} {
+		got, err := ParseFloat64(tt.s)
+		if err != nil && !(math.IsInf(tt.want, 0) && numErr(err) == strconv.ErrRange) {
+			t.Errorf("ParseFloat64(%q): %s", tt.s, err)
+			continue
+		}
+		if math.Float64bits(got) != math.Float64bits(tt.want) {
+			t.Errorf("ParseFloat64(%q): got %x; want %x", tt.s, got, tt.want)
+		}
+	}
+	for _, s := range []string{"0x", "0x1", "0x1.8", "0xp1", "0x.p1", "0x1p", "0x1p+", "0x1g", "0x1_0p0"} {
+		if _, err := ParseFloat64(s); numErr(err) != strconv.ErrSyntax {
+			t.Errorf("ParseFloat64(%q): got error %v; want syntax error", s, err)
+		}
+	}
+}
+
+func TestParseHexFloat32(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want float32
+	}{
+		{"0x1.8p3", 12},
+		{"0x1p-149", math.SmallestNonzeroFloat32},
+		{"0x1p-150", 0},
+		{"0x1.000001p-150", math.SmallestNonzeroFloat32},
+		{"0x1.fffffefffp127", math.MaxFloat32},
+		// Rounding to float64 first would give 0x1.000001p0 exactly, which
+		// would then round to even: 1.
+		{"0x1.000001000000001p0", float32(1 + math.Ldexp(1, -23))},
+	} {
+		got, err := ParseFloat32(tt.s)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseFloat32(%q): got (%x, %v); want %x", tt.s, got, err, tt.want)
+		}
+	}
+	if f, err := ParseFloat32("0x1.ffffffp127"); !math.IsInf(float64(f), 1) || numErr(err) != strconv.ErrRange {
+		t.Errorf("ParseFloat32(0x1.ffffffp127): got (%g, %v); want (+Inf, range error)", f, err)
+	}
+}
+
+func TestParseHexPrefix(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want float64
+		n    int
+	}{
+		{"0x1p4,", 16, 5},
+		{"0x1.8p1p2", 3, 7},
+		{"0x1.8", 0, 1}, // no exponent: only the 0 is a number
+		{"0xg", 0, 1},
+		{"-0x1p-1e", -0.5, 7},
+	} {
+		f, n, err := ParseFloat64Prefix([]byte(tt.s))
+		if err != nil || f != tt.want || n != tt.n {
+			t.Errorf("ParseFloat64Prefix(%q): got (%g, %d, %v); want (%g, %d, nil)", tt.s, f, n, err, tt.want, tt.n)
+		}
+	}
+}
+
+func TestParseHexUnderscores(t *testing.T) {
+	p := Parser{Underscores: true}
+	for _, tt := range []struct {
+		s    string
+		want float64
+	}{
+		{"0x_1p0", 1},
+		{"0x1_0.8p-1_0", 16.5 / 1024},
+		{"0xf_fp0", 255},
+	} {
+		got, err := p.ParseFloat64(tt.s)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseFloat64(%q): got (%g, %v); want %g", tt.s, got, err, tt.want)
+		}
+	}
+	for _, s := range []string{"0_x1p0", "0x__1p0", "0x1_p0", "0x1._8p0", "0x1p_1"} {
+		if _, err := p.ParseFloat64(s); numErr(err) != strconv.ErrSyntax {
+			t.Errorf("ParseFloat64(%q): got error %v; want syntax error", s, err)
+		}
+	}
+}
+
+func TestParseHexRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	const digits = "0123456789abcdefABCDEF"
+	for i := 0; i < 1e4; i++ {
+		b := []byte("0x")
+		n := 1 + r.Intn(30)
+		dot := r.Intn(n + 1)
+		for j := 0; j < n; j++ {
+			if j == dot {
+				b = append(b, '.')
+			}
+			b = append(b, digits[r.Intn(len(digits))])
+		}
+		b = append(b, 'p')
+		b = strconv.AppendInt(b, int64(r.Intn(2400)-1250), 10)
+		s := string(b)
+
+		got, err := ParseFloat64(s)
+		want, wantErr := strconv.ParseFloat(s, 64)
+		if math.Float64bits(got) != math.Float64bits(want) || !sameError(err, wantErr) {
+			t.Fatalf("ParseFloat64(%q): got (%x, %v); want (%x, %v)", s, got, err, want, wantErr)
+		}
+		got32, err := ParseFloat32(s)
+		want, wantErr = strconv.ParseFloat(s, 32)
+		if math.Float32bits(got32) != math.Float32bits(float32(want)) || !sameError(err, wantErr) {
+			t.Fatalf("ParseFloat32(%q): got (%x, %v); want (%x, %v)", s, got32, err, want, wantErr)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "math/big"
+
+// parseLong converts s, a decimal number accepted by p.scanDecimal that has
+// more significant digits than toBits supports, to the format described by
+// info. It computes the correctly rounded result with exact integer
+// arithmetic, which is much slower than toBits but works for any number of
+// digits. It also reports whether the conversion was exact.
+func (p *Parser) parseLong(s string, info *floatInfo) (u uint64, exact bool) {
+	neg, digits, e10 := p.longDigits(s)
+	sign := boolToUint64(neg) << (info.mantBits + info.expBits)
+	switch nd := len(digits); {
+	case nd == 0:
+		return sign, true
+	case nd+e10 <= int(info.minExp10):
+		return info.underflow(neg, p.Rounding), false
+	case nd+e10 >= int(info.maxExp10):
+		return info.overflow(neg, p.Rounding), false
+	}
+
+	// The number is num/den.
+	num, _ := new(big.Int).SetString(string(digits), 10)
+	den := big.NewInt(1)
+	if e10 >= 0 {
+		num.Mul(num, pow10Big(e10))
+	} else {
+		den = pow10Big(-e10)
+	}
+
+	// Find e such that 2^e <= num/den < 2^(e+1).
+	e := num.BitLen() - den.BitLen()
+	if cmpShifted(num, den, e) < 0 {
+		e--
+	}
+	bias := int(info.bias)
+	mantBits := int(info.mantBits)
+	if e > bias {
+		return info.overflow(neg, p.Rounding), false
+	}
+
+	// Divide by the unit in the last place, 2^ulp, leaving a quotient q
+	// with at most mantBits+1 bits.
+	ulp := e - mantBits
+	if min := 1 - bias - mantBits; ulp < min {
+		ulp = min
+	}
+	if ulp >= 0 {
+		den.Lsh(den, uint(ulp))
+	} else {
+		num.Lsh(num, uint(-ulp))
+	}
+	q, r := num.QuoRem(num, den, new(big.Int))
+	m := q.Uint64()
+	exact = r.Sign() == 0
+	if !exact {
+		if p.Rounding.nearest() {
+			c := r.Lsh(r, 1).Cmp(den)
+			if c > 0 || c == 0 && (p.Rounding == ToNearestAway || m&1 != 0) {
+				m++
+			}
+		} else if p.Rounding.away(neg) {
+			m++
+		}
+	}
+	// m is the significand including the implicit leading bit, which adds
+	// one to the biased exponent. A carry out of the significand likewise
+	// increments the exponent, possibly up to infinity.
+	return sign | (uint64(ulp+mantBits+bias-1)<<info.mantBits + m), exact
+}
+
+// longDigits splits s, a decimal number accepted by p.scanDecimal, into its
+// sign and significant digits, without leading or trailing zeros, and the
+// exponent e10 such that the magnitude of s is digits × 10^e10.
+func (p *Parser) longDigits(s string) (neg bool, digits []byte, e10 int) {
+	dot, _ := p.separators()
+	i := 0
+	if s[0] == '+' || s[0] == '-' {
+		neg = s[0] == '-'
+		i++
+	}
+	// Collect the significant digits. Since scanDecimal accepted s, any
+	// other bytes before the exponent are separators.
+	var (
+		fracLen int
+		sawDot  bool
+	)
+	for ; i < len(s) && !p.isExp(s[i]); i++ {
+		c := s[i]
+		switch {
+		case isDigit(c):
+			if len(digits) > 0 || c != '0' {
+				digits = append(digits, c)
+			}
+			if sawDot {
+				fracLen++
+			}
+		case c == dot:
+			sawDot = true
+		}
+	}
+	exp := 0
+	if i < len(s) {
+		i++
+		expNeg := false
+		if s[i] == '+' || s[i] == '-' {
+			expNeg = s[i] == '-'
+			i++
+		}
+		for ; i < len(s); i++ {
+			if c := s[i]; isDigit(c) && exp < maxExp10 {
+				exp = 10*exp + int(c-'0')
+			}
+		}
+		if expNeg {
+			exp = -exp
+		}
+	}
+	e10 = exp - fracLen
+	for len(digits) > 0 && digits[len(digits)-1] == '0' {
+		digits = digits[:len(digits)-1]
+		e10++
+	}
+	return neg, digits, e10
+}
+
+// cmpShifted compares x with y × 2^e.
+func cmpShifted(x, y *big.Int, e int) int {
+	if e >= 0 {
+		return x.Cmp(new(big.Int).Lsh(y, uint(e)))
+	}
+	return new(big.Int).Lsh(x, uint(-e)).Cmp(y)
+}
+
+// pow10Big returns 10^n as a new big.Int.
+func pow10Big(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,111 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "math"
+
+// A Parser converts strings to floating-point numbers according to its
+// configuration. The zero value is ready to use and parses like ParseFloat32
+// and ParseFloat64, whose documentation describes the syntax, rounding, and
+// errors. The options extend the accepted syntax.
+type Parser struct {
+	// Underscores accepts underscores between digits, as in the Go
+	// literals 1_000_000.5 and 1e1_0. As in Go, each underscore must
+	// separate two digits.
+	Underscores bool
+
+	// DecimalComma makes ',' the decimal separator of decimal numbers in
+	// place of '.', as in many European locales. Hexadecimal numbers
+	// still use '.'.
+	DecimalComma bool
+
+	// Grouping accepts a grouping separator between the digits of the
+	// integer part of a decimal number: GroupSeparator if it is set, or
+	// else ',' or, if DecimalComma is set, '.'. Any number of digits may be
+	// grouped, so "1,00,000" is 100000.
+	Grouping bool
+
+	// GroupSeparator is the grouping separator, such as '\'' or the thin
+	// space U+2009. Setting it implies Grouping. It must not be a digit or
+	// the decimal separator.
+	GroupSeparator rune
+
+	// FortranExponent accepts 'd' and 'D' as exponent markers of decimal
+	// numbers in addition to 'e' and 'E', as in the Fortran output
+	// "1.5D+10".
+	FortranExponent bool
+
+	// TrimSpace ignores ASCII whitespace (space, tab, newline, vertical
+	// tab, form feed, and carriage return) before and after the number, as
+	// in the fields of fixed-width columns such as "   +1.5  ". The
+	// Prefix methods skip only the leading whitespace and count it in n.
+	// A leading '+' is always accepted.
+	TrimSpace bool
+
+	// Rounding is the rounding mode for numbers that are not exactly
+	// representable. With a directed mode, a number too large to be
+	// represented becomes the largest finite number if it is rounded toward
+	// zero; only an infinite result is reported as strconv.ErrRange.
+	// Likewise, a tiny nonzero number becomes the smallest subnormal if it
+	// is rounded away from zero.
+	Rounding RoundingMode
+
+	// Range determines how numbers outside the range of the format are
+	// reported. The zero value reports overflow as strconv.ParseFloat does.
+	Range RangePolicy
+
+	// Specials declares the accepted spellings of infinity and NaN. The
+	// zero value accepts none; use StrconvSpecials to accept what
+	// strconv.ParseFloat does.
+	Specials Specials
+}
+
+// ParseFloat32 converts s to the nearest float32.
+func (p *Parser) ParseFloat32(s string) (float32, error) {
+	u, _, err := p.parseFloat(s, &float32info, false, nil)
+	return math.Float32frombits(uint32(u)), err
+}
+
+// ParseFloat64 converts s to the nearest float64.
+func (p *Parser) ParseFloat64(s string) (float64, error) {
+	u, _, err := p.parseFloat(s, &float64info, false, nil)
+	return math.Float64frombits(u), err
+}
+
+// ParseFloat32Bits is like ParseFloat32 but returns the bits of the result.
+func (p *Parser) ParseFloat32Bits(s string) (uint32, error) {
+	u, _, err := p.parseFloat(s, &float32info, false, nil)
+	return uint32(u), err
+}
+
+// ParseFloat64Bits is like ParseFloat64 but returns the bits of the result.
+func (p *Parser) ParseFloat64Bits(s string) (uint64, error) {
+	u, _, err := p.parseFloat(s, &float64info, false, nil)
+	return u, err
+}
+
+// ParseFloat32Prefix parses the longest prefix of b that is a number and
+// returns the nearest float32 and the number of bytes consumed, like the
+// top-level ParseFloat32Prefix.
+func (p *Parser) ParseFloat32Prefix(b []byte) (f float32, n int, err error) {
+	u, n, err := p.parseFloat(bytesString(b), &float32info, true, nil)
+	return math.Float32frombits(uint32(u)), n, err
+}
+
+// ParseFloat64Prefix parses the longest prefix of b that is a number and
+// returns the nearest float64 and the number of bytes consumed, like the
+// top-level ParseFloat64Prefix.
+func (p *Parser) ParseFloat64Prefix(b []byte) (f float64, n int, err error) {
+	u, n, err := p.parseFloat(bytesString(b), &float64info, true, nil)
+	return math.Float64frombits(u), n, err
+}
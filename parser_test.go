@@ -0,0 +1,255 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestParserZero(t *testing.T) {
+	var p Parser
+	for _, s := range []string{"1.5", "-1e-7", "1e400", "1_0", "x"} {
+		got, err := p.ParseFloat64(s)
+		want, wantErr := ParseFloat64(s)
+		if got != want || !sameError(err, wantErr) {
+			t.Errorf("ParseFloat64(%q): got (%g, %v); want (%g, %v)", s, got, err, want, wantErr)
+		}
+		got32, err := p.ParseFloat32(s)
+		want32, wantErr := ParseFloat32(s)
+		if got32 != want32 || !sameError(err, wantErr) {
+			t.Errorf("ParseFloat32(%q): got (%g, %v); want (%g, %v)", s, got32, err, want32, wantErr)
+		}
+	}
+}
+
+func TestParserUnderscores(t *testing.T) {
+	p := Parser{Underscores: true}
+	for _, tt := range []struct {
+		s    string
+		want float64
+	}{
+		{"1_000_000.5", 1000000.5},
+		{"-1_0", -10},
+		{"0.000_001", 1e-6},
+		{"1e1_0", 1e10},
+		{"1_2.3_4e-0_1", 1.234},
+	} {
+		got, err := p.ParseFloat64(tt.s)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseFloat64(%q): got (%g, %v); want %g", tt.s, got, err, tt.want)
+		}
+		got32, err := p.ParseFloat32(tt.s)
+		if err != nil || got32 != float32(tt.want) {
+			t.Errorf("ParseFloat32(%q): got (%g, %v); want %g", tt.s, got32, err, tt.want)
+		}
+	}
+	for _, s := range []string{
+		"_1",
+		"1_",
+		"1__0",
+		"1_.5",
+		"1._5",
+		"-_1",
+		"1e_1",
+		"1e1_",
+		"1_e1",
+	} {
+		if _, err := p.ParseFloat64(s); numErr(err) != strconv.ErrSyntax {
+			t.Errorf("ParseFloat64(%q): got error %v; want syntax error", s, err)
+		}
+	}
+
+	f, n, err := p.ParseFloat64Prefix([]byte("1_000_,"))
+	if f != 1000 || n != 5 || err != nil {
+		t.Errorf("ParseFloat64Prefix: got (%g, %d, %v); want (1000, 5, nil)", f, n, err)
+	}
+	f32, n, err := p.ParseFloat32Prefix([]byte("2_5e1_x"))
+	if f32 != 250 || n != 5 || err != nil {
+		t.Errorf("ParseFloat32Prefix: got (%g, %d, %v); want (250, 5, nil)", f32, n, err)
+	}
+}
+
+func TestParserDecimalComma(t *testing.T) {
+	for _, tt := range []struct {
+		p    Parser
+		s    string
+		want float64
+	}{
+		{Parser{DecimalComma: true}, "1,5", 1.5},
+		{Parser{DecimalComma: true}, "-0,001e3", -1},
+		{Parser{DecimalComma: true}, ",5", 0.5},
+		{Parser{DecimalComma: true}, "0x1.8p1", 3},
+		{Parser{DecimalComma: true, Grouping: true}, "1.234.567,89", 1234567.89},
+		{Parser{DecimalComma: true, Grouping: true}, "1.00.000", 100000},
+		{Parser{Grouping: true}, "1,234,567.89", 1234567.89},
+		{Parser{Grouping: true}, "-12,345e-2", -123.45},
+		{Parser{Grouping: true, Underscores: true}, "1_000,000", 1000000},
+	} {
+		got, err := tt.p.ParseFloat64(tt.s)
+		if err != nil || got != tt.want {
+			t.Errorf("%+v: ParseFloat64(%q): got (%g, %v); want %g", tt.p, tt.s, got, err, tt.want)
+		}
+		got32, err := tt.p.ParseFloat32(tt.s)
+		if err != nil || got32 != float32(tt.want) {
+			t.Errorf("%+v: ParseFloat32(%q): got (%g, %v); want %g", tt.p, tt.s, got32, err, tt.want)
+		}
+	}
+	for _, tt := range []struct {
+		p Parser
+		s string
+	}{
+		{Parser{DecimalComma: true}, "1.5"},
+		{Parser{DecimalComma: true}, "1,5,"},
+		{Parser{DecimalComma: true, Grouping: true}, "1,5.0"},
+		{Parser{DecimalComma: true, Grouping: true}, ".1"},
+		{Parser{Grouping: true}, ",1"},
+		{Parser{Grouping: true}, "1,"},
+		{Parser{Grouping: true}, "1,,000"},
+		{Parser{Grouping: true}, "1.000,5"},
+		{Parser{Grouping: true}, "1,e5"},
+	} {
+		if _, err := tt.p.ParseFloat64(tt.s); numErr(err) != strconv.ErrSyntax {
+			t.Errorf("%+v: ParseFloat64(%q): got error %v; want syntax error", tt.p, tt.s, err)
+		}
+	}
+
+	p := Parser{DecimalComma: true, Grouping: true}
+	f, n, err := p.ParseFloat64Prefix([]byte("1.000,5;2"))
+	if f != 1000.5 || n != 7 || err != nil {
+		t.Errorf("ParseFloat64Prefix: got (%g, %d, %v); want (1000.5, 7, nil)", f, n, err)
+	}
+	f, n, err = p.ParseFloat64Prefix([]byte("12. "))
+	if f != 12 || n != 2 || err != nil {
+		t.Errorf("ParseFloat64Prefix: got (%g, %d, %v); want (12, 2, nil)", f, n, err)
+	}
+}
+
+func TestParserGroupSeparator(t *testing.T) {
+	for _, tt := range []struct {
+		p    Parser
+		s    string
+		want float64
+	}{
+		{Parser{GroupSeparator: '\''}, "1'234'567.5", 1234567.5},
+		{Parser{GroupSeparator: '\u2009'}, "-1\u2009000\u2009000", -1e6},
+		{Parser{GroupSeparator: '\u202f', DecimalComma: true}, "12\u202f345,5", 12345.5},
+		{Parser{GroupSeparator: ' ', Grouping: true}, "1 000e3", 1e6},
+	} {
+		got, err := tt.p.ParseFloat64(tt.s)
+		if err != nil || got != tt.want {
+			t.Errorf("%+v: ParseFloat64(%q): got (%g, %v); want %g", tt.p, tt.s, got, err, tt.want)
+		}
+	}
+	for _, tt := range []struct {
+		p Parser
+		s string
+	}{
+		{Parser{GroupSeparator: '\''}, "1,000"},
+		{Parser{GroupSeparator: '\u2009'}, "1\u2009"},
+		{Parser{GroupSeparator: '\u2009'}, "\u20091"},
+		{Parser{GroupSeparator: '\u2009'}, "1\u2009\u2009000"},
+		{Parser{GroupSeparator: '\u2009'}, "1.000\u2009000"},
+		{Parser{GroupSeparator: '\u2009'}, "1\u202f000"},
+	} {
+		if _, err := tt.p.ParseFloat64(tt.s); numErr(err) != strconv.ErrSyntax {
+			t.Errorf("%+v: ParseFloat64(%q): got error %v; want syntax error", tt.p, tt.s, err)
+		}
+	}
+
+	p := Parser{GroupSeparator: '\u2009'}
+	f, n, err := p.ParseFloat64Prefix([]byte("1\u2009000\u2009x"))
+	if f != 1000 || n != 7 || err != nil {
+		t.Errorf("ParseFloat64Prefix: got (%g, %d, %v); want (1000, 7, nil)", f, n, err)
+	}
+}
+
+func TestParserFortranExponent(t *testing.T) {
+	p := Parser{FortranExponent: true}
+	for _, tt := range []struct {
+		s    string
+		want float64
+	}{
+		{"1.5D+10", 1.5e10},
+		{"-2.5d-3", -2.5e-3},
+		{"1E2", 100},
+		{"0.12345678901234567890123D2", 12.345678901234568},
+		{"0x1p4", 16},
+	} {
+		got, err := p.ParseFloat64(tt.s)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseFloat64(%q): got (%g, %v); want %g", tt.s, got, err, tt.want)
+		}
+		got32, err := p.ParseFloat32(tt.s)
+		if err != nil || got32 != float32(tt.want) {
+			t.Errorf("ParseFloat32(%q): got (%g, %v); want %g", tt.s, got32, err, tt.want)
+		}
+	}
+	if _, err := ParseFloat64("1.5D+10"); numErr(err) != strconv.ErrSyntax {
+		t.Errorf("ParseFloat64(1.5D+10) without FortranExponent: got error %v; want syntax error", err)
+	}
+	f, n, err := p.ParseFloat64Prefix([]byte("3dx"))
+	if f != 3 || n != 1 || err != nil {
+		t.Errorf("ParseFloat64Prefix(3dx): got (%g, %d, %v); want (3, 1, nil)", f, n, err)
+	}
+}
+
+func TestParserTrimSpace(t *testing.T) {
+	p := Parser{TrimSpace: true}
+	for _, tt := range []struct {
+		s    string
+		want float64
+	}{
+		{"   +1.5  ", 1.5},
+		{"\t-2e3\r\n", -2000},
+		{"7", 7},
+		{" 0x1p-2", 0.25},
+		{"\v\f12345678901234567890 ", 12345678901234567890},
+	} {
+		got, err := p.ParseFloat64(tt.s)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseFloat64(%q): got (%g, %v); want %g", tt.s, got, err, tt.want)
+		}
+		got32, err := p.ParseFloat32(tt.s)
+		if err != nil || got32 != float32(tt.want) {
+			t.Errorf("ParseFloat32(%q): got (%g, %v); want %g", tt.s, got32, err, tt.want)
+		}
+	}
+	for _, s := range []string{"", "   ", "1 2", "+ 1", "1 .5", " 1x ", "\u00a01"} {
+		_, err := p.ParseFloat64(s)
+		if e, ok := err.(*strconv.NumError); !ok || e.Err != strconv.ErrSyntax || e.Num != s {
+			t.Errorf("ParseFloat64(%q): got error %v; want syntax error for the whole input", s, err)
+		}
+	}
+	if _, err := p.ParseFloat64(" 1e400 "); numErr(err) != strconv.ErrRange {
+		t.Errorf("ParseFloat64(\" 1e400 \"): got error %v; want range error", err)
+	}
+
+	f, n, err := p.ParseFloat64Prefix([]byte("  42  ,"))
+	if f != 42 || n != 4 || err != nil {
+		t.Errorf("ParseFloat64Prefix: got (%g, %d, %v); want (42, 4, nil)", f, n, err)
+	}
+	if c, err := p.ParseComplex128(" (1+2i) "); c != 1+2i || err != nil {
+		t.Errorf("ParseComplex128: got (%v, %v); want (1+2i, nil)", c, err)
+	}
+	if _, err := p.ParseComplex128("1+ 2i"); numErr(err) != strconv.ErrSyntax {
+		t.Errorf("ParseComplex128(\"1+ 2i\"): got error %v; want syntax error", err)
+	}
+	if m, e, _, err := p.ParseDecimal64(" 1.25 "); m != 125 || e != -2 || err != nil {
+		t.Errorf("ParseDecimal64: got (%d, %d, %v); want (125, -2, nil)", m, e, err)
+	}
+	b := []byte("  -3.5  ")
+	if n := testing.AllocsPerRun(100, func() { p.ParseFloat64Bytes(b) }); n != 0 {
+		t.Errorf("ParseFloat64Bytes: %v allocations; want 0", n)
+	}
+}
@@ -0,0 +1,534 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A Pattern formats numbers according to an ICU-style decimal pattern such
+// as "#,##0.00", "0.###E+00", or "0.0%". Create one with CompilePattern.
+//
+// The supported pattern syntax is:
+//
+//	0	a digit, shown even if it is a leading or trailing zero
+//	#	a digit, omitted if it is a leading or trailing zero
+//	,	grouping separator; the digits after the last ',' give the group size,
+//		and those between the last two a different size for the remaining
+//		groups ("#,##,##0")
+//	.	decimal point
+//	E	starts the exponent, as in "0.0E0"; "E+" shows the sign of
+//		nonnegative exponents too, and the number of '0's after it is the
+//		minimum number of exponent digits. It ends the number part and
+//		must be quoted elsewhere
+//	%	in a prefix or suffix, multiplies the number by 100
+//	‰	in a prefix or suffix, multiplies the number by 1000
+//	;	separates the positive pattern from an optional negative pattern,
+//		of which only the prefix and suffix are used
+//	'	quotes literal text in a prefix or suffix; '' is a literal quote
+//
+// In scientific patterns, '#'s in the integer part select engineering
+// notation: the exponent is a multiple of the total number of integer
+// digits, so "##0.##E0" formats 12345 as "12.3E3". The number of
+// significant digits is at most the sum of the minimum integer digits and the
+// maximum fraction digits.
+//
+// Numbers are rounded half to even, working from their shortest decimal form
+// (see Decimal64). This matches ICU, which also starts from the shortest
+// form, so 0.015 is rounded to "0.02" rather than to the "0.01" given by its
+// exact binary value. Infinities are formatted as "∞" with the prefix and
+// suffix, and NaNs as "NaN".
+//
+// A Pattern is a Renderer, so it may be used as the Renderer of a Formatter.
+type Pattern struct {
+	pattern string
+
+	posPrefix, posSuffix string
+	negPrefix, negSuffix string
+
+	minInt, maxInt   int
+	minFrac, maxFrac int
+	showPoint        bool
+
+	// group1 is the size of the group before the decimal point and group2
+	// the size of the others; both are 0 if there is no grouping.
+	group1, group2 int
+
+	sci     bool
+	expPlus bool
+	minExp  int
+
+	// shift is the power of 10 the number is multiplied by.
+	shift int
+}
+
+// CompilePattern parses an ICU-style decimal pattern.
+func CompilePattern(pattern string) (*Pattern, error) {
+	p := &Pattern{pattern: pattern}
+	pos, neg := pattern, ""
+	hasNeg := false
+	if i := subpatternSeparator(pattern); i >= 0 {
+		pos, neg, hasNeg = pattern[:i], pattern[i+1:], true
+	}
+	var err error
+	var num string
+	if p.posPrefix, num, p.posSuffix, err = p.splitAffixes(pos); err != nil {
+		return nil, fmt.Errorf("ryu: invalid pattern %q: %s", pattern, err)
+	}
+	if err := p.parseNumber(num); err != nil {
+		return nil, fmt.Errorf("ryu: invalid pattern %q: %s", pattern, err)
+	}
+	if hasNeg {
+		// The negative pattern's number part is ignored, but its
+		// affixes must not change the multiplier.
+		q := &Pattern{}
+		if p.negPrefix, _, p.negSuffix, err = q.splitAffixes(neg); err != nil {
+			return nil, fmt.Errorf("ryu: invalid pattern %q: %s", pattern, err)
+		}
+		if q.shift != 0 && q.shift != p.shift {
+			return nil, fmt.Errorf("ryu: invalid pattern %q: negative pattern has a different multiplier", pattern)
+		}
+	} else {
+		p.negPrefix, p.negSuffix = "-"+p.posPrefix, p.posSuffix
+	}
+	return p, nil
+}
+
+// subpatternSeparator returns the index of the first ';' in pattern that is
+// not quoted, or -1 if there is none.
+func subpatternSeparator(pattern string) int {
+	quoted := false
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\'':
+			// A doubled quote toggles twice and so stays a literal quote.
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// MustCompilePattern is like CompilePattern but panics if the pattern cannot
+// be parsed. It simplifies initialization of global variables.
+func MustCompilePattern(pattern string) *Pattern {
+	p, err := CompilePattern(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the source text used to compile the pattern.
+func (p *Pattern) String() string { return p.pattern }
+
+// isPatternDigit reports whether c is part of the number portion of a pattern.
+func isPatternDigit(c byte) bool {
+	switch c {
+	case '#', '0', ',', '.':
+		return true
+	}
+	return false
+}
+
+// splitAffixes splits a subpattern into its unquoted prefix, number part,
+// and unquoted suffix. It records any multiplier in p.shift.
+func (p *Pattern) splitAffixes(s string) (prefix, num, suffix string, err error) {
+	prefix, i, err := p.parseAffix(s, 0)
+	if err != nil {
+		return "", "", "", err
+	}
+	j := i
+	for j < len(s) {
+		c := s[j]
+		switch {
+		case isPatternDigit(c):
+			j++
+		case c == 'E':
+			j++
+			if j < len(s) && s[j] == '+' {
+				j++
+			}
+			for j < len(s) && s[j] == '0' {
+				j++
+			}
+			// The exponent ends the number part.
+			goto done
+		default:
+			goto done
+		}
+	}
+done:
+	suffix, k, err := p.parseAffix(s, j)
+	if err != nil {
+		return "", "", "", err
+	}
+	if k != len(s) {
+		return "", "", "", fmt.Errorf("unexpected %q in suffix", s[k])
+	}
+	return prefix, s[i:j], suffix, nil
+}
+
+// parseAffix reads a prefix or suffix of s starting at i. It stops at the
+// first unquoted pattern digit.
+func (p *Pattern) parseAffix(s string, i int) (affix string, next int, err error) {
+	var b []byte
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case isPatternDigit(c):
+			return string(b), i, nil
+		case c == '\'':
+			if strings.HasPrefix(s[i:], "''") {
+				b = append(b, '\'')
+				i += 2
+				continue
+			}
+			for i++; ; i++ {
+				if i == len(s) {
+					return "", 0, fmt.Errorf("unterminated quote")
+				}
+				if s[i] != '\'' {
+					b = append(b, s[i])
+					continue
+				}
+				if !strings.HasPrefix(s[i:], "''") {
+					break
+				}
+				b = append(b, '\'')
+				i++
+			}
+			i++
+			continue
+		case c == '%':
+			if err := p.setShift(2); err != nil {
+				return "", 0, err
+			}
+		case strings.HasPrefix(s[i:], "‰"):
+			if err := p.setShift(3); err != nil {
+				return "", 0, err
+			}
+			b = append(b, "‰"...)
+			i += len("‰")
+			continue
+		case c == 'E':
+			return "", 0, fmt.Errorf("exponent outside the number part at %q", s[i:])
+		case strings.HasPrefix(s[i:], "¤"), c == '@', c == '*', '1' <= c && c <= '9':
+			return "", 0, fmt.Errorf("unsupported pattern character at %q", s[i:])
+		}
+		b = append(b, c)
+		i++
+	}
+	return string(b), i, nil
+}
+
+func (p *Pattern) setShift(shift int) error {
+	if p.shift != 0 {
+		return fmt.Errorf("multiple percent or per-mille signs")
+	}
+	p.shift = shift
+	return nil
+}
+
+// parseNumber parses the number part of a pattern, such as "#,##0.00".
+func (p *Pattern) parseNumber(s string) error {
+	if s == "" {
+		return fmt.Errorf("no digits")
+	}
+	var (
+		i          int
+		hashes     int
+		zeros      int
+		commas     int
+		sinceComma int
+	)
+	for ; i < len(s) && s[i] != '.' && s[i] != 'E'; i++ {
+		switch s[i] {
+		case '#':
+			if zeros > 0 {
+				return fmt.Errorf("'#' after '0' in integer part")
+			}
+			hashes++
+			sinceComma++
+		case '0':
+			zeros++
+			sinceComma++
+		case ',':
+			if commas > 0 {
+				p.group2 = sinceComma
+			}
+			commas++
+			sinceComma = 0
+		}
+	}
+	if commas > 0 {
+		if sinceComma == 0 || (commas > 1 && p.group2 == 0) {
+			return fmt.Errorf("misplaced grouping separator")
+		}
+		p.group1 = sinceComma
+		if commas == 1 {
+			p.group2 = p.group1
+		}
+	}
+	p.minInt, p.maxInt = zeros, zeros+hashes
+	if i < len(s) && s[i] == '.' {
+		hashes, zeros = 0, 0
+		for i++; i < len(s) && s[i] != 'E'; i++ {
+			switch s[i] {
+			case '0':
+				if hashes > 0 {
+					return fmt.Errorf("'0' after '#' in fraction part")
+				}
+				zeros++
+			case '#':
+				hashes++
+			default:
+				return fmt.Errorf("unexpected %q in fraction part", s[i])
+			}
+		}
+		p.minFrac, p.maxFrac = zeros, zeros+hashes
+		// A trailing '.', as in "#,##0.", is always shown.
+		p.showPoint = p.maxFrac == 0
+	}
+	if p.maxInt+p.maxFrac == 0 {
+		return fmt.Errorf("no digits")
+	}
+	if i < len(s) {
+		// s[i] == 'E'; splitAffixes only lets '+' and '0's follow it.
+		p.sci = true
+		i++
+		if i < len(s) && s[i] == '+' {
+			p.expPlus = true
+			i++
+		}
+		p.minExp = len(s) - i
+		if p.minExp == 0 {
+			return fmt.Errorf("no exponent digits")
+		}
+		p.group1, p.group2 = 0, 0
+		if p.minInt == 0 && p.maxInt <= 1 {
+			p.minInt, p.maxInt = 1, 1
+		}
+	}
+	return nil
+}
+
+// FormatFloat64 formats f according to the pattern.
+func (p *Pattern) FormatFloat64(f float64) string {
+	return string(p.AppendFloat64(nil, f))
+}
+
+// AppendFloat64 appends f, formatted according to the pattern, to b and
+// returns the extended buffer.
+func (p *Pattern) AppendFloat64(b []byte, f float64) []byte {
+	return p.AppendDecimal(b, Decimal64(f))
+}
+
+// FormatFloat32 formats f according to the pattern.
+func (p *Pattern) FormatFloat32(f float32) string {
+	return string(p.AppendFloat32(nil, f))
+}
+
+// AppendFloat32 appends f, formatted according to the pattern, to b and
+// returns the extended buffer.
+func (p *Pattern) AppendFloat32(b []byte, f float32) []byte {
+	return p.AppendDecimal(b, Decimal32(f))
+}
+
+// AppendDecimal appends d, formatted according to the pattern, to b and
+// returns the extended buffer.
+func (p *Pattern) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	if d.Class == ClassNaN {
+		return append(b, "NaN"...)
+	}
+	prefix, suffix := p.posPrefix, p.posSuffix
+	if d.Neg {
+		prefix, suffix = p.negPrefix, p.negSuffix
+	}
+	b = append(b, prefix...)
+	switch d.Class {
+	case ClassInf:
+		b = append(b, "∞"...)
+	case ClassZero:
+		b = p.appendDigits(b, nil, 0)
+	default:
+		var buf [20]byte
+		ds := strconv.AppendUint(buf[:0], d.Digits, 10)
+		b = p.appendDigits(b, ds, len(ds)+int(d.Exp)+p.shift)
+	}
+	return append(b, suffix...)
+}
+
+// appendDigits appends the number 0.ds × 10^dp according to the number part
+// of the pattern. ds holds decimal digits with no trailing zeros, and is
+// empty for zero.
+func (p *Pattern) appendDigits(b, ds []byte, dp int) []byte {
+	if p.sci {
+		return p.appendSci(b, ds, dp)
+	}
+	ds, dp = roundDigits(ds, dp, dp+p.maxFrac)
+	intLen := 0
+	if len(ds) > 0 && dp > 0 {
+		intLen = dp
+	}
+	fracLen := 0
+	if len(ds) > 0 && len(ds) > dp {
+		fracLen = len(ds) - dp
+	}
+	if fracLen < p.minFrac {
+		fracLen = p.minFrac
+	}
+	n := intLen
+	if n < p.minInt {
+		n = p.minInt
+	}
+	if n == 0 && fracLen == 0 {
+		n = 1
+	}
+	for k := 0; k < n; k++ {
+		if k > 0 && p.groupBefore(n-k) {
+			b = append(b, ',')
+		}
+		b = append(b, digitAt(ds, k-(n-intLen)))
+	}
+	if fracLen > 0 || p.showPoint {
+		b = append(b, '.')
+	}
+	for j := 0; j < fracLen; j++ {
+		b = append(b, digitAt(ds, dp+j))
+	}
+	return b
+}
+
+// groupBefore reports whether a grouping separator goes before the integer
+// digit with the given number of digits remaining, counting itself.
+func (p *Pattern) groupBefore(remaining int) bool {
+	return groupBefore(remaining, p.group1, p.group2)
+}
+
+// groupBefore reports whether a grouping separator goes before the integer
+// digit with the given number of digits remaining, counting itself, when the
+// group before the decimal point has group1 digits and the others group2.
+func groupBefore(remaining, group1, group2 int) bool {
+	switch {
+	case group1 == 0 || remaining < group1:
+		return false
+	case remaining == group1:
+		return true
+	default:
+		return (remaining-group1)%group2 == 0
+	}
+}
+
+func (p *Pattern) appendSci(b, ds []byte, dp int) []byte {
+	var intN, e int
+	if len(ds) > 0 {
+		ds, dp = roundDigits(ds, dp, p.minInt+p.maxFrac)
+		intN, e = p.sciExp(dp)
+	} else {
+		intN = p.minInt
+		if intN == 0 {
+			intN = 1
+		}
+	}
+	for k := 0; k < intN; k++ {
+		b = append(b, digitAt(ds, k))
+	}
+	fracLen := len(ds) - intN
+	if minFrac := p.minFrac + p.minInt - intN; fracLen < minFrac {
+		fracLen = minFrac
+	}
+	if fracLen > 0 || p.showPoint {
+		b = append(b, '.')
+	}
+	for j := 0; j < fracLen; j++ {
+		b = append(b, digitAt(ds, intN+j))
+	}
+	b = append(b, 'E')
+	if e < 0 {
+		b = append(b, '-')
+		e = -e
+	} else if p.expPlus {
+		b = append(b, '+')
+	}
+	var buf [8]byte
+	es := strconv.AppendInt(buf[:0], int64(e), 10)
+	for k := len(es); k < p.minExp; k++ {
+		b = append(b, '0')
+	}
+	return append(b, es...)
+}
+
+// sciExp returns the number of integer digits and the exponent used to
+// format a nonzero number with decimal point position dp.
+func (p *Pattern) sciExp(dp int) (intN, e int) {
+	if step := p.maxInt; step > p.minInt && step > 1 {
+		e = dp - 1
+		if e < 0 {
+			e -= step - 1
+		}
+		e = e / step * step
+		return dp - e, e
+	}
+	return p.minInt, dp - p.minInt
+}
+
+// digitAt returns ds[i], or '0' if i is out of range.
+func digitAt(ds []byte, i int) byte {
+	if i < 0 || i >= len(ds) {
+		return '0'
+	}
+	return ds[i]
+}
+
+// roundDigits rounds the number 0.ds × 10^dp, half to even, to the first
+// keep digits of ds. It returns the digits with trailing zeros removed and
+// the new decimal point position. ds is modified in place.
+func roundDigits(ds []byte, dp, keep int) ([]byte, int) {
+	if keep >= len(ds) {
+		return ds, dp
+	}
+	if keep < 0 {
+		return ds[:0], dp
+	}
+	var up bool
+	switch c := ds[keep]; {
+	case c > '5':
+		up = true
+	case c == '5':
+		// Exactly half if no (nonzero) digits follow;
+		// then round to even.
+		up = keep+1 < len(ds) || (keep > 0 && (ds[keep-1]-'0')%2 == 1)
+	}
+	ds = ds[:keep]
+	if up {
+		i := len(ds) - 1
+		for i >= 0 && ds[i] == '9' {
+			i--
+		}
+		if i < 0 {
+			return append(ds[:0], '1'), dp + 1
+		}
+		ds[i]++
+		ds = ds[:i+1]
+	}
+	for len(ds) > 0 && ds[len(ds)-1] == '0' {
+		ds = ds[:len(ds)-1]
+	}
+	return ds, dp
+}
@@ -0,0 +1,128 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPattern(t *testing.T) {
+	for _, tt := range []struct {
+		pattern string
+		x       float64
+		want    string
+	}{
+		{"#,##0.00", 1234.5, "1,234.50"},
+		{"#,##0.00", -1234567.891, "-1,234,567.89"},
+		{"#,##0.00", 0.005, "0.00"},
+		{"#,##0.00", 0.015, "0.02"},
+		{"#,##0.00", 0.025, "0.02"},
+		{"#,##0.00", 0.0251, "0.03"},
+		{"#,##0.00", 999.996, "1,000.00"},
+		{"#,##0.00", 0, "0.00"},
+		{"#,##0.00", math.Copysign(0, -1), "-0.00"},
+		{"#,##0.00", 1e20, "100,000,000,000,000,000,000.00"},
+		{"#,##0.00", math.Inf(1), "∞"},
+		{"#,##0.00", math.Inf(-1), "-∞"},
+		{"#,##0.00", math.NaN(), "NaN"},
+		{"#,##,##0", 12345678, "1,23,45,678"},
+		{"#,##,##0", 123, "123"},
+		{"#,##0", 2.5, "2"},
+		{"#,##0", 3.5, "4"},
+		{"#,##0.", 3, "3."},
+		{"00.00", 1.5, "01.50"},
+		{"#.##", 0.5, ".5"},
+		{"#.##", 0, "0"},
+		{"#.##", 0.001, "0"},
+		{"0.###", 1.0005, "1"},
+		{"0.###", 0.0006, "0.001"},
+		{"0.0%", 0.256, "25.6%"},
+		{"0.0%", 0.1234, "12.3%"},
+		{"#,##0‰", 1.2345, "1,234‰"},
+		{"#,##0‰", 1.2355, "1,236‰"},
+		{"0.###E+00", 12345, "1.234E+04"},
+		{"0.###E+00", 12355, "1.236E+04"},
+		{"0.###E+00", 0.00012, "1.2E-04"},
+		{"0.###E+00", 0, "0E+00"},
+		{"0.###E+00", 9.9996, "1E+01"},
+		{"0.000E0", 1, "1.000E0"},
+		{"0'E'", 5, "5E"},
+		{"00.0E0", 1234, "12.3E2"},
+		{"##0.##E0", 12345, "12.3E3"},
+		{"##0.##E0", 0.001234, "1.23E-3"},
+		{"##0.##E0", 123456, "123E3"},
+		{"##0.##E0", 999999, "1E6"},
+		{"#,##0.00;(#,##0.00)", -5, "(5.00)"},
+		{"#,##0.00;(#,##0.00)", 5, "5.00"},
+		{"'#'0", 7, "#7"},
+		{"0 'o''clock'", 7, "7 o'clock"},
+		{"'a;b'0.0", 1.5, "a;b1.5"},
+		{"0.0' x;y';'(;'0.0')'", -1.5, "(;1.5)"},
+		{"0.0' x;y';'(;'0.0')'", 1.5, "1.5 x;y"},
+		{"$#,##0.00 USD", 1e3, "$1,000.00 USD"},
+	} {
+		p, err := CompilePattern(tt.pattern)
+		if err != nil {
+			t.Errorf("CompilePattern(%q): %s", tt.pattern, err)
+			continue
+		}
+		if got := p.FormatFloat64(tt.x); got != tt.want {
+			t.Errorf("%q.FormatFloat64(%g): got %q; want %q", tt.pattern, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestPatternFloat32(t *testing.T) {
+	p := MustCompilePattern("0.00")
+	// float32(0.015) is slightly below 0.015,
+	// but its shortest form is 0.015.
+	if got, want := p.FormatFloat32(0.015), "0.02"; got != want {
+		t.Errorf("FormatFloat32: got %q; want %q", got, want)
+	}
+	f := Formatter{Renderer: p}
+	if got, want := f.FormatFloat64(1.005), "1.00"; got != want {
+		t.Errorf("Formatter: got %q; want %q", got, want)
+	}
+	if got, want := p.String(), "0.00"; got != want {
+		t.Errorf("String: got %q; want %q", got, want)
+	}
+}
+
+func TestPatternErrors(t *testing.T) {
+	for _, pattern := range []string{
+		"",
+		"abc",
+		"#0#",
+		"0.0#0",
+		"#,",
+		"#,,0",
+		"0E",
+		"0E+",
+		"0.0E0.0",
+		"0E00#",
+		"E0",
+		"0 E",
+		"¤0",
+		"@@",
+		"0.05",
+		"'abc",
+		"0%%",
+		"0 x 0",
+		"0%;0‰",
+	} {
+		if _, err := CompilePattern(pattern); err == nil {
+			t.Errorf("CompilePattern(%q): got nil error", pattern)
+		}
+	}
+}
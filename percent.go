@@ -0,0 +1,83 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "bytes"
+
+// FormatFloat64Percent converts the 64-bit floating point number f to a
+// percentage with prec digits after the decimal point, as in "12.5%" for
+// 0.125 and prec 1. The scaling by 100 is exact: f itself is rounded to
+// prec+2 digits after the decimal point, to nearest with ties to even, and
+// the decimal point is moved. A negative prec is treated as 0. Infinities
+// and NaNs are formatted as by FormatFloat64, with the '%' appended, as in
+// "+Inf%" and "NaN%".
+func FormatFloat64Percent(f float64, prec int) string {
+	b := make([]byte, 0, 24+prec)
+	return unsafeString(AppendFloat64Percent(b, f, prec))
+}
+
+// AppendFloat64Percent appends the percentage form of the 64-bit floating
+// point number f, as generated by FormatFloat64Percent, to b and returns the
+// extended buffer.
+func AppendFloat64Percent(b []byte, f float64, prec int) []byte {
+	return appendScaled(b, f, prec, 2, ToNearestEven, "%")
+}
+
+// FormatFloat64Permille is like FormatFloat64Percent but scales by 1000 and
+// appends '‰', as in "12.5‰" for 0.0125 and prec 1, or "-Inf‰" for negative
+// infinity.
+func FormatFloat64Permille(f float64, prec int) string {
+	b := make([]byte, 0, 24+prec)
+	return unsafeString(AppendFloat64Permille(b, f, prec))
+}
+
+// AppendFloat64Permille appends the per mille form of the 64-bit floating
+// point number f, as generated by FormatFloat64Permille, to b and returns the
+// extended buffer.
+func AppendFloat64Permille(b []byte, f float64, prec int) []byte {
+	return appendScaled(b, f, prec, 3, ToNearestEven, "‰")
+}
+
+// appendScaled appends f × 10^shift with prec digits after the decimal
+// point, rounded according to mode, followed by suffix. Infinities and NaNs
+// are printed as usual, with the suffix.
+func appendScaled(b []byte, f float64, prec, shift int, mode RoundingMode, suffix string) []byte {
+	if prec < 0 {
+		prec = 0
+	}
+	start := len(b)
+	b = appendFloat64Fixed(b, f, prec+shift, mode)
+	if i := bytes.IndexByte(b[start:], '.'); i >= 0 {
+		// Move the decimal point, which has at least shift digits after
+		// it, and drop it if no digits follow.
+		dot := start + i
+		copy(b[dot:], b[dot+1:dot+1+shift])
+		end := dot + shift
+		if prec == 0 {
+			b = b[:end]
+		} else {
+			b[end] = '.'
+		}
+		// Remove the leading zeros that the integer part gained.
+		s := start
+		if b[s] == '-' {
+			s++
+		}
+		j := s
+		for j < end-1 && b[j] == '0' {
+			j++
+		}
+		b = append(b[:s], b[j:]...)
+	}
+	return append(b, suffix...)
+}
@@ -0,0 +1,87 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestFormatFloat64Percent(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		prec int
+		want string
+	}{
+		{0.125, 1, "12.5%"},
+		{0.125, 0, "12%"},
+		{0.135, 0, "14%"},
+		{1, 0, "100%"},
+		{1.5, 2, "150.00%"},
+		{-0.0005, 1, "-0.1%"},
+		{0.00049, 1, "0.0%"},
+		{0.005, 0, "1%"},
+		{0.995, 0, "99%"}, // 0.99499999999999999555...
+		{0.9951, 0, "100%"},
+		{0.07, 3, "7.000%"},
+		{0, 1, "0.0%"},
+		{math.Copysign(0, -1), 0, "-0%"},
+		{0.1, -1, "10%"},
+		{1e20, 0, "10000000000000000000000%"},
+		{math.Inf(1), 1, "+Inf%"},
+		{math.NaN(), 0, "NaN%"},
+	} {
+		if got := FormatFloat64Percent(tt.f, tt.prec); got != tt.want {
+			t.Errorf("FormatFloat64Percent(%v, %d): got %q; want %q", tt.f, tt.prec, got, tt.want)
+		}
+	}
+	if got, want := FormatFloat64Permille(0.0125, 1), "12.5‰"; got != want {
+		t.Errorf("FormatFloat64Permille(0.0125, 1): got %q; want %q", got, want)
+	}
+	if got, want := FormatFloat64Permille(-0.0004, 0), "-0‰"; got != want {
+		t.Errorf("FormatFloat64Permille(-0.0004, 0): got %q; want %q", got, want)
+	}
+	if got, want := FormatFloat64Permille(math.Inf(-1), 1), "-Inf‰"; got != want {
+		t.Errorf("FormatFloat64Permille(-Inf, 1): got %q; want %q", got, want)
+	}
+	f := Formatter{Rounding: ToPositiveInf, TrimZeros: true}
+	if got, want := f.FormatFloat64Percent(0.12301, 2), "12.31%"; got != want {
+		t.Errorf("%+v: FormatFloat64Percent(0.12301, 2): got %q; want %q", f, got, want)
+	}
+	if got, want := f.FormatFloat64Permille(0.25, 2), "250‰"; got != want {
+		t.Errorf("%+v: FormatFloat64Permille(0.25, 2): got %q; want %q", f, got, want)
+	}
+}
+
+func TestFormatFloat64PercentRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		x := r.NormFloat64() * math.Pow(10, float64(r.Intn(12)-8))
+		if i%2 == 0 {
+			// A short binary number, which is often a tie.
+			x = math.Ldexp(float64(r.Intn(1<<20)), -r.Intn(30))
+		}
+		prec := r.Intn(10)
+		for _, mode := range roundingModes {
+			f := Formatter{Rounding: mode}
+			v := new(big.Rat).SetFloat64(math.Abs(x))
+			v.Mul(v, new(big.Rat).SetInt(pow10Int(prec+2)))
+			want := signed(x, insertDot(roundRat(v, x < 0, mode).String(), prec)) + "%"
+			if got := f.FormatFloat64Percent(x, prec); got != want {
+				t.Fatalf("%s: FormatFloat64Percent(%v, %d): got %q; want %q", mode, x, prec, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+//go:build ryu_compact
+// +build ryu_compact
+
+package ryu
+
+import "math/bits"
+
+// This file implements the size optimization of the C library
+// (RYU_OPTIMIZE_SIZE). Only every pow5TableSize64-th entry of the 64-bit
+// tables is stored; the others are computed by multiplying by a small power of
+// 5 and adding a stored 2-bit correction so that the result matches the full
+// table exactly.
+
+// pow5Entry64 returns 5^i truncated to its top pow5NumBits64 bits.
+func pow5Entry64(i uint32) uint128 {
+	base := i / pow5TableSize64
+	base2 := base * pow5TableSize64
+	mul := pow5Split64Base[base]
+	offset := i - base2
+	if offset == 0 {
+		return mul
+	}
+	delta := pow5Bits(int32(i)) - pow5Bits(int32(base2))
+	corr := uint64(pow5Offsets64[i/16]>>((i%16)<<1)) & 3
+	return mulShiftPow5(pow5Table64[offset], mul, delta, corr)
+}
+
+// pow5InvEntry64 returns 2^(floor(log_2(5^i))+pow5InvNumBits64) / 5^i + 1.
+func pow5InvEntry64(i uint32) uint128 {
+	base := (i + pow5TableSize64 - 1) / pow5TableSize64
+	base2 := base * pow5TableSize64
+	mul := pow5InvSplit64Base[base] // 1/5^base2, truncated
+	offset := base2 - i
+	delta := pow5Bits(int32(base2)) - pow5Bits(int32(i))
+	corr := uint64(pow5InvOffsets64[i/16]>>((i%16)<<1)) & 3
+	return mulShiftPow5(pow5Table64[offset], mul, delta, corr)
+}
+
+// mulShiftPow5 returns the low 128 bits of (m * mul) >> shift, plus corr.
+func mulShiftPow5(m uint64, mul uint128, shift int32, corr uint64) uint128 {
+	hi1, lo1 := bits.Mul64(m, mul.hi)
+	hi0, lo0 := bits.Mul64(m, mul.lo)
+	sum, carry := bits.Add64(hi0, lo1, 0)
+	hi1 += carry
+	// high1 | sum | low0
+	s := uint(shift)
+	return uint128{
+		lo: (lo0>>s | sum<<(64-s)) + corr,
+		hi: sum>>s | hi1<<(64-s),
+	}
+}
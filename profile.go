@@ -0,0 +1,345 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "strconv"
+
+// Versioned profiles are Formatters whose output is frozen: once a profile is
+// published, it produces the same bytes for every input in all later versions
+// of this package, even if the defaults of Formatter or of the top-level
+// functions change. Each call to a profile function returns a new Formatter,
+// so callers may modify it without affecting anyone else.
+
+// StableV1 returns a Formatter that formats numbers in the shortest-'e' form
+// produced by FormatFloat32 and FormatFloat64 at the time it was introduced,
+// such as "1.5e+00", "-1e-07", "NaN", and "+Inf".
+func StableV1() *Formatter {
+	return &Formatter{Backend: ryuBackend{}, Renderer: stableV1Renderer{}}
+}
+
+// stableV1Renderer currently shares its implementation with FormatFloat32 and
+// FormatFloat64. If their output ever changes, the StableV1 behavior must be
+// preserved here; TestStableV1 checks it against golden data.
+type stableV1Renderer struct{}
+
+func (stableV1Renderer) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	return appendShortestE(b, d)
+}
+
+// PythonRepr returns a Formatter that formats numbers like CPython's repr of a
+// float: the shortest digits, in positional notation with at least one digit
+// after the decimal point if the decimal exponent is in [-4, 16), and in
+// scientific notation otherwise, as in "1.0", "0.0001", "1e-05", "1.5e+16",
+// "-0.0", "inf", and "nan". Float32 values are printed with their own shortest
+// digits.
+func PythonRepr() *Formatter {
+	return &Formatter{Backend: ryuBackend{}, Renderer: pythonRenderer{}}
+}
+
+type pythonRenderer struct{}
+
+func (pythonRenderer) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	switch d.Class {
+	case ClassNaN:
+		return append(b, "nan"...)
+	case ClassInf:
+		if d.Neg {
+			b = append(b, '-')
+		}
+		return append(b, "inf"...)
+	case ClassZero:
+		if d.Neg {
+			b = append(b, '-')
+		}
+		return append(b, "0.0"...)
+	}
+	if exp := int(d.Exp) + decimalLen64(d.Digits) - 1; exp < -4 || exp >= 16 {
+		return appendShortestE(b, d)
+	}
+	b = appendShortestF(b, d)
+	if d.Exp >= 0 {
+		b = append(b, ".0"...)
+	}
+	return b
+}
+
+// UpstreamRyu returns a Formatter that formats numbers exactly like the d2s
+// and f2s functions of the reference C implementation of Ryu, for differential
+// testing against it: with a capital E and the exponent in its shortest form,
+// as in "1E0", "1.5E-7", "1E15", "-0E0", "NaN", and "-Infinity".
+func UpstreamRyu() *Formatter {
+	return &Formatter{Backend: ryuBackend{}, Renderer: upstreamRenderer{}}
+}
+
+type upstreamRenderer struct{}
+
+func (upstreamRenderer) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	if d.Class == ClassNaN {
+		return append(b, "NaN"...)
+	}
+	if d.Neg {
+		b = append(b, '-')
+	}
+	switch d.Class {
+	case ClassInf:
+		return append(b, "Infinity"...)
+	case ClassZero:
+		return append(b, "0E0"...)
+	}
+	return appendShortestCapitalE(b, d, false)
+}
+
+// appendShortestCapitalE appends the digits of the finite nonzero d, without
+// its sign, in scientific notation with a capital E and the exponent in its
+// shortest form, as in "1.5E-7", adding ".0" to a single digit if pointZero
+// is set.
+func appendShortestCapitalE(b []byte, d FloatDecimal, pointZero bool) []byte {
+	start := len(b)
+	b = strconv.AppendUint(b, d.Digits, 10)
+	nd := len(b) - start
+	if nd > 1 {
+		b = insertByte(b, start+1, '.')
+	} else if pointZero {
+		b = append(b, ".0"...)
+	}
+	b = append(b, 'E')
+	return strconv.AppendInt(b, int64(d.Exp)+int64(nd)-1, 10)
+}
+
+// DotNetRoundTrip returns a Formatter that formats numbers like the round-trip
+// ("R") format of .NET's Double.ToString, which since .NET Core 3.0 is also
+// its default: the shortest digits, in positional notation if the decimal
+// exponent is in [-4, 15), and in scientific notation otherwise, with a
+// capital E and an exponent of at least two digits, as in "1.5", "0.0001",
+// "1E-05", "1.5E+15", "-0", "NaN", and "-Infinity". Float32 values are printed
+// with their own shortest digits under the same rules, which are not those of
+// .NET's Single.
+func DotNetRoundTrip() *Formatter {
+	return &Formatter{Backend: ryuBackend{}, Renderer: dotNetRenderer{}}
+}
+
+type dotNetRenderer struct{}
+
+func (dotNetRenderer) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	if d.Class == ClassNaN {
+		return append(b, "NaN"...)
+	}
+	if d.Class == ClassInf {
+		if d.Neg {
+			b = append(b, '-')
+		}
+		return append(b, "Infinity"...)
+	}
+	if exp := int(d.Exp) + decimalLen64(d.Digits) - 1; d.Class != ClassZero && (exp < -4 || exp >= 15) {
+		start := len(b)
+		b = appendShortestE(b, d)
+		for i := len(b) - 1; i >= start; i-- {
+			if b[i] == 'e' {
+				b[i] = 'E'
+				break
+			}
+		}
+		return b
+	}
+	return appendShortestF(b, d)
+}
+
+// SQLLiteral returns a Formatter that formats numbers as numeric literals that
+// the major SQL dialects accept: the shortest digits, always with a decimal
+// point, in positional notation if the decimal exponent is in [-7, 21), which
+// keeps within the precision and scale of every dialect's DECIMAL, and
+// otherwise with a capital E and the exponent in its shortest form, as in
+// "1.5", "3.0", "0.0001", "1.5E-8", "1.0E21", and "-0.0". SQL has no literals
+// for infinities and NaNs, so they are formatted as "NULL"; callers that must
+// reject them should check for them first.
+func SQLLiteral() *Formatter {
+	return &Formatter{Backend: ryuBackend{}, Renderer: sqlRenderer{}}
+}
+
+type sqlRenderer struct{}
+
+func (sqlRenderer) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	switch d.Class {
+	case ClassInf, ClassNaN:
+		return append(b, "NULL"...)
+	case ClassZero:
+		if d.Neg {
+			b = append(b, '-')
+		}
+		return append(b, "0.0"...)
+	}
+	if exp := int(d.Exp) + decimalLen64(d.Digits) - 1; exp >= -7 && exp < 21 {
+		b = appendShortestF(b, d)
+		if d.Exp >= 0 {
+			b = append(b, ".0"...)
+		}
+		return b
+	}
+	if d.Neg {
+		b = append(b, '-')
+	}
+	return appendShortestCapitalE(b, d, true)
+}
+
+// YAML returns a Formatter that formats numbers as floats of the YAML 1.2 core
+// schema that a YAML 1.1 parser also reads as floats: like PythonRepr, but
+// with a decimal point in the mantissa of scientific notation too, and with
+// YAML's spellings of infinities and NaNs, as in "1.0", "0.0001", "1.0e-05",
+// "1.5e+16", "-0.0", ".inf", "-.inf", and ".nan". The output can be written as
+// a plain scalar.
+func YAML() *Formatter {
+	return &Formatter{Backend: ryuBackend{}, Renderer: yamlRenderer{}}
+}
+
+type yamlRenderer struct{}
+
+func (yamlRenderer) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	switch d.Class {
+	case ClassNaN:
+		return append(b, ".nan"...)
+	case ClassInf:
+		if d.Neg {
+			b = append(b, '-')
+		}
+		return append(b, ".inf"...)
+	}
+	start := len(b)
+	return pointZero(pythonRenderer{}.AppendDecimal(b, d), start)
+}
+
+// TOML returns a Formatter that formats numbers as TOML floats: the shortest
+// digits, in positional notation if the decimal exponent is in [-7, 21), and
+// in scientific notation otherwise, always with a decimal point, and with
+// TOML's spellings of infinities and NaNs, as in "1.0", "0.0000001",
+// "1.0e-08", "1.0e+21", "-0.0", "inf", "-inf", and "nan". Unlike the other
+// profiles, it is made of Formatter options, so setting, say, an IntegerGroup
+// of 3 and an IntegerSeparator of "_" gives TOML's underscores, as in
+// "1_000_000.0".
+func TOML() *Formatter {
+	return &Formatter{
+		Backend:   ryuBackend{},
+		Notation:  NotationAuto,
+		AutoRange: [2]int{-7, 21},
+		PointZero: true,
+		Inf:       "inf",
+		NaN:       "nan",
+	}
+}
+
+// XSDDouble returns a Formatter that formats numbers in the canonical
+// representation of the xs:double type of XML Schema, as canonicalization and
+// signature of XML documents require: the shortest digits in scientific
+// notation with a mantissa in [1, 10) that has at least one digit after the
+// decimal point, a capital E, and the exponent in its shortest form, as in
+// "1.0E0", "1.5E-7", "1.0E15", "0.0E0", "-0.0E0", "INF", "-INF", and "NaN".
+func XSDDouble() *Formatter {
+	return &Formatter{Backend: ryuBackend{}, Renderer: xsdRenderer{}}
+}
+
+type xsdRenderer struct{}
+
+func (xsdRenderer) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	if d.Class == ClassNaN {
+		return append(b, "NaN"...)
+	}
+	if d.Neg {
+		b = append(b, '-')
+	}
+	switch d.Class {
+	case ClassInf:
+		return append(b, "INF"...)
+	case ClassZero:
+		return append(b, "0.0E0"...)
+	}
+	return appendShortestCapitalE(b, d, true)
+}
+
+// ECMAScript returns a Formatter that formats numbers exactly like the
+// Number::toString operation of ECMAScript (ECMA-262), which JavaScript's
+// String(x) uses: the shortest digits, in positional notation if the decimal
+// exponent is in [-6, 21), and otherwise in scientific notation with the
+// exponent in its shortest form and always signed, as in "1", "1.5",
+// "0.000001", "1e-7", "1.5e+21", "0" for both zeros, "NaN", and "-Infinity".
+// It is the number format of RFC 8785, the JSON Canonicalization Scheme; see
+// AppendJCSFloat64.
+func ECMAScript() *Formatter {
+	return &Formatter{Backend: ryuBackend{}, Renderer: ecmaScriptRenderer{}}
+}
+
+type ecmaScriptRenderer struct{}
+
+func (ecmaScriptRenderer) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	switch d.Class {
+	case ClassNaN:
+		return append(b, "NaN"...)
+	case ClassZero:
+		return append(b, '0')
+	}
+	if d.Neg {
+		b = append(b, '-')
+	}
+	if d.Class == ClassInf {
+		return append(b, "Infinity"...)
+	}
+	start := len(b)
+	b = strconv.AppendUint(b, d.Digits, 10)
+	nd := len(b) - start
+	exp := int(d.Exp) + nd - 1
+	if exp >= -6 && exp < 21 {
+		return positional(b, start, exp)
+	}
+	if nd > 1 {
+		b = insertByte(b, start+1, '.')
+	}
+	b = append(b, 'e')
+	if exp > 0 {
+		b = append(b, '+')
+	}
+	return strconv.AppendInt(b, int64(exp), 10)
+}
+
+// PostgreSQL returns a Formatter that formats numbers like the text output of
+// PostgreSQL 12 and later for float8 (double precision) with the default
+// extra_float_digits: the shortest digits, in positional notation if the
+// decimal exponent is in [-4, 15), and in scientific notation otherwise, with
+// an exponent of at least two digits, as in "1", "0.0001", "1e-05", "1.5e+15",
+// "-0", "NaN", and "-Infinity". Use PostgreSQLFloat4 for float4 (real) values.
+func PostgreSQL() *Formatter {
+	return &Formatter{Backend: ryuBackend{}, Renderer: postgresRenderer{hi: 15}}
+}
+
+// PostgreSQLFloat4 returns a Formatter like PostgreSQL's for float4 (real),
+// which PostgreSQL prints in positional notation only if the decimal exponent
+// is in [-4, 6), as in "100000" and "1e+06". It is meant for FormatFloat32.
+func PostgreSQLFloat4() *Formatter {
+	return &Formatter{Backend: ryuBackend{}, Renderer: postgresRenderer{hi: 6}}
+}
+
+// postgresRenderer prints positionally the exponents in [-4, hi).
+type postgresRenderer struct{ hi int }
+
+func (r postgresRenderer) AppendDecimal(b []byte, d FloatDecimal) []byte {
+	switch d.Class {
+	case ClassNaN:
+		return append(b, "NaN"...)
+	case ClassInf:
+		if d.Neg {
+			b = append(b, '-')
+		}
+		return append(b, "Infinity"...)
+	}
+	if exp := int(d.Exp) + decimalLen64(d.Digits) - 1; d.Class != ClassZero && (exp < -4 || exp >= r.hi) {
+		return appendShortestE(b, d)
+	}
+	return appendShortestF(b, d)
+}
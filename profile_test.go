@@ -0,0 +1,403 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestStableV1(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{0, "0e+00"},
+		{math.Copysign(0, -1), "-0e+00"},
+		{1, "1e+00"},
+		{-1.5, "-1.5e+00"},
+		{0.3, "3e-01"},
+		{123456.7, "1.234567e+05"},
+		{1e23, "1e+23"},
+		{5e-324, "5e-324"},
+		{math.MaxFloat64, "1.7976931348623157e+308"},
+		{math.Inf(1), "+Inf"},
+		{math.Inf(-1), "-Inf"},
+		{math.NaN(), "NaN"},
+	} {
+		if got := StableV1().FormatFloat64(tt.f); got != tt.want {
+			t.Errorf("StableV1.FormatFloat64(%g): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+
+	// The output for a fixed stream of random values must never change.
+	const want = "b6ab5d641a5ce7efaa6c99e96b48b03bccf6188e6143ad3aba934ac3c38936af"
+	r := rand.New(rand.NewSource(1))
+	h := sha256.New()
+	f := StableV1()
+	var b []byte
+	for i := 0; i < 1e5; i++ {
+		b = f.AppendFloat64(b[:0], math.Float64frombits(r.Uint64()))
+		b = append(b, '\n')
+		b = f.AppendFloat32(b, math.Float32frombits(r.Uint32()))
+		b = append(b, '\n')
+		h.Write(b)
+	}
+	if got := fmt.Sprintf("%x", h.Sum(nil)); got != want {
+		t.Errorf("StableV1 output hash: got %s; want %s", got, want)
+	}
+}
+
+func TestProfileCopy(t *testing.T) {
+	f := StableV1()
+	f.Width = 12
+	if got, want := f.FormatFloat64(1.5), "     1.5e+00"; got != want {
+		t.Errorf("modified StableV1: FormatFloat64(1.5): got %q; want %q", got, want)
+	}
+	if got, want := StableV1().FormatFloat64(1.5), "1.5e+00"; got != want {
+		t.Errorf("StableV1.FormatFloat64(1.5) after modifying a copy: got %q; want %q", got, want)
+	}
+}
+
+func TestPythonRepr(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{0, "0.0"},
+		{math.Copysign(0, -1), "-0.0"},
+		{1, "1.0"},
+		{-1.5, "-1.5"},
+		{100, "100.0"},
+		{0.1, "0.1"},
+		{0.0001, "0.0001"},
+		{0.00001, "1e-05"},
+		{1.234e-5, "1.234e-05"},
+		{1e15, "1000000000000000.0"},
+		{1e16, "1e+16"},
+		{1.5e16, "1.5e+16"},
+		{123456789012345.6, "123456789012345.6"},
+		{5e-324, "5e-324"},
+		{math.MaxFloat64, "1.7976931348623157e+308"},
+		{math.Inf(1), "inf"},
+		{math.Inf(-1), "-inf"},
+		{math.NaN(), "nan"},
+	} {
+		if got := PythonRepr().FormatFloat64(tt.f); got != tt.want {
+			t.Errorf("PythonRepr.FormatFloat64(%g): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+	if got, want := PythonRepr().FormatFloat32(0.1), "0.1"; got != want {
+		t.Errorf("PythonRepr.FormatFloat32(0.1): got %q; want %q", got, want)
+	}
+}
+
+func TestUpstreamRyu(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{0, "0E0"},
+		{math.Copysign(0, -1), "-0E0"},
+		{1, "1E0"},
+		{-1.5, "-1.5E0"},
+		{1e15, "1E15"},
+		{-1.5e-7, "-1.5E-7"},
+		{123456.7, "1.234567E5"},
+		{math.MaxFloat64, "1.7976931348623157E308"},
+		{math.Inf(1), "Infinity"},
+		{math.Inf(-1), "-Infinity"},
+		{math.NaN(), "NaN"},
+		{-math.NaN(), "NaN"},
+	} {
+		if got := UpstreamRyu().FormatFloat64(tt.f); got != tt.want {
+			t.Errorf("UpstreamRyu.FormatFloat64(%g): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+	if got, want := UpstreamRyu().FormatFloat32(1.1), "1.1E0"; got != want {
+		t.Errorf("UpstreamRyu.FormatFloat32(1.1): got %q; want %q", got, want)
+	}
+}
+
+func TestDotNetRoundTrip(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{0, "0"},
+		{math.Copysign(0, -1), "-0"},
+		{1, "1"},
+		{-1.5, "-1.5"},
+		{0.1, "0.1"},
+		{0.0001, "0.0001"},
+		{0.00001, "1E-05"},
+		{1e14, "100000000000000"},
+		{123456789012345, "123456789012345"},
+		{1e15, "1E+15"},
+		{1234567890123456, "1.234567890123456E+15"},
+		{5e-324, "5E-324"},
+		{math.MaxFloat64, "1.7976931348623157E+308"},
+		{math.Inf(1), "Infinity"},
+		{math.Inf(-1), "-Infinity"},
+		{math.NaN(), "NaN"},
+	} {
+		if got := DotNetRoundTrip().FormatFloat64(tt.f); got != tt.want {
+			t.Errorf("DotNetRoundTrip.FormatFloat64(%g): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+}
+
+func TestSQLLiteral(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{0, "0.0"},
+		{math.Copysign(0, -1), "-0.0"},
+		{3, "3.0"},
+		{-1.5, "-1.5"},
+		{0.1, "0.1"},
+		{1e-7, "0.0000001"},
+		{1.5e-8, "1.5E-8"},
+		{1e20, "100000000000000000000.0"},
+		{1e21, "1.0E21"},
+		{-1.25e300, "-1.25E300"},
+		{5e-324, "5.0E-324"},
+		{math.MaxFloat64, "1.7976931348623157E308"},
+		{math.Inf(1), "NULL"},
+		{math.Inf(-1), "NULL"},
+		{math.NaN(), "NULL"},
+	} {
+		if got := SQLLiteral().FormatFloat64(tt.f); got != tt.want {
+			t.Errorf("SQLLiteral.FormatFloat64(%g): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+	// Every finite literal has a decimal point and parses back exactly.
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		f := math.Float64frombits(r.Uint64())
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			continue
+		}
+		s := SQLLiteral().FormatFloat64(f)
+		if !strings.Contains(s, ".") {
+			t.Fatalf("SQLLiteral.FormatFloat64(%g) = %q has no decimal point", f, s)
+		}
+		if g, err := strconv.ParseFloat(s, 64); err != nil || g != f {
+			t.Fatalf("SQLLiteral.FormatFloat64(%g) = %q parses as %g, %v", f, s, g, err)
+		}
+	}
+}
+
+func TestYAML(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{0, "0.0"},
+		{math.Copysign(0, -1), "-0.0"},
+		{3, "3.0"},
+		{-1.5, "-1.5"},
+		{0.0001, "0.0001"},
+		{0.00001, "1.0e-05"},
+		{1.25e-5, "1.25e-05"},
+		{1e15, "1000000000000000.0"},
+		{1e16, "1.0e+16"},
+		{1.5e16, "1.5e+16"},
+		{5e-324, "5.0e-324"},
+		{math.Inf(1), ".inf"},
+		{math.Inf(-1), "-.inf"},
+		{math.NaN(), ".nan"},
+	} {
+		if got := YAML().FormatFloat64(tt.f); got != tt.want {
+			t.Errorf("YAML.FormatFloat64(%g): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+	// The output matches the float pattern of the YAML 1.2 core schema and
+	// has a decimal point for YAML 1.1, and parses back exactly.
+	float := regexp.MustCompile(`^[-+]?(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?$`)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		f := math.Float64frombits(r.Uint64())
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			continue
+		}
+		s := YAML().FormatFloat64(f)
+		if !float.MatchString(s) || !strings.Contains(s, ".") {
+			t.Fatalf("YAML.FormatFloat64(%g) = %q is not a YAML float", f, s)
+		}
+		if g, err := strconv.ParseFloat(s, 64); err != nil || g != f {
+			t.Fatalf("YAML.FormatFloat64(%g) = %q parses as %g, %v", f, s, g, err)
+		}
+	}
+}
+
+func TestTOML(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{0, "0.0"},
+		{math.Copysign(0, -1), "-0.0"},
+		{3, "3.0"},
+		{-1.5, "-1.5"},
+		{1e-7, "0.0000001"},
+		{1e-8, "1.0e-08"},
+		{1e20, "100000000000000000000.0"},
+		{1e21, "1.0e+21"},
+		{6.02214076e23, "6.02214076e+23"},
+		{5e-324, "5.0e-324"},
+		{math.Inf(1), "inf"},
+		{math.Inf(-1), "-inf"},
+		{math.NaN(), "nan"},
+	} {
+		if got := TOML().FormatFloat64(tt.f); got != tt.want {
+			t.Errorf("TOML.FormatFloat64(%g): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+	grouped := TOML()
+	grouped.IntegerGroup = 3
+	grouped.IntegerSeparator = "_"
+	grouped.FractionGroup = 3
+	grouped.FractionSeparator = "_"
+	if got, want := grouped.FormatFloat64(-1234567.125), "-1_234_567.125"; got != want {
+		t.Errorf("with underscores: FormatFloat64(-1234567.125): got %q; want %q", got, want)
+	}
+	if got, want := grouped.FormatFloat64(3.14159), "3.141_59"; got != want {
+		t.Errorf("with underscores: FormatFloat64(3.14159): got %q; want %q", got, want)
+	}
+
+	// The output matches TOML's float grammar and parses back exactly.
+	const digits = `[0-9](_?[0-9])*`
+	float := regexp.MustCompile(`^[-+]?(0|[1-9](_?[0-9])*)((\.` + digits + `)([eE][-+]?` + digits + `)?|[eE][-+]?` + digits + `)$`)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		f := math.Float64frombits(r.Uint64())
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			continue
+		}
+		for _, s := range []string{TOML().FormatFloat64(f), grouped.FormatFloat64(f)} {
+			if !float.MatchString(s) {
+				t.Fatalf("FormatFloat64(%g) = %q is not a TOML float", f, s)
+			}
+			if g, err := strconv.ParseFloat(s, 64); err != nil || g != f {
+				t.Fatalf("FormatFloat64(%g) = %q parses as %g, %v", f, s, g, err)
+			}
+		}
+	}
+}
+
+func TestXSDDouble(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{0, "0.0E0"},
+		{math.Copysign(0, -1), "-0.0E0"},
+		{1, "1.0E0"},
+		{-1.5, "-1.5E0"},
+		{100, "1.0E2"},
+		{0.001, "1.0E-3"},
+		{1.5e-7, "1.5E-7"},
+		{123456789, "1.23456789E8"},
+		{5e-324, "5.0E-324"},
+		{math.MaxFloat64, "1.7976931348623157E308"},
+		{math.Inf(1), "INF"},
+		{math.Inf(-1), "-INF"},
+		{math.NaN(), "NaN"},
+	} {
+		if got := XSDDouble().FormatFloat64(tt.f); got != tt.want {
+			t.Errorf("XSDDouble.FormatFloat64(%g): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+	if got, want := XSDDouble().FormatFloat32(0.1), "1.0E-1"; got != want {
+		t.Errorf("XSDDouble.FormatFloat32(0.1): got %q; want %q", got, want)
+	}
+}
+
+func TestECMAScript(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{0, "0"},
+		{math.Copysign(0, -1), "0"},
+		{1, "1"},
+		{-1.5, "-1.5"},
+		{0.000001, "0.000001"},
+		{1.5e-7, "1.5e-7"},
+		{1e-7, "1e-7"},
+		{123e18, "123000000000000000000"},
+		{1e21, "1e+21"},
+		{-1.5e21, "-1.5e+21"},
+		{5e-324, "5e-324"},
+		{math.MaxFloat64, "1.7976931348623157e+308"},
+		{0.30000000000000004, "0.30000000000000004"},
+		{math.Inf(1), "Infinity"},
+		{math.Inf(-1), "-Infinity"},
+		{math.NaN(), "NaN"},
+	} {
+		if got := ECMAScript().FormatFloat64(tt.f); got != tt.want {
+			t.Errorf("ECMAScript.FormatFloat64(%g): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+}
+
+func TestPostgreSQL(t *testing.T) {
+	for _, tt := range []struct {
+		f    float64
+		want string
+	}{
+		{0, "0"},
+		{math.Copysign(0, -1), "-0"},
+		{1, "1"},
+		{-1.5, "-1.5"},
+		{0.0001, "0.0001"},
+		{0.00001, "1e-05"},
+		{123456789012345, "123456789012345"},
+		{1e15, "1e+15"},
+		{1.5e15, "1.5e+15"},
+		{0.1, "0.1"},
+		{5e-324, "5e-324"},
+		{math.MaxFloat64, "1.7976931348623157e+308"},
+		{math.Inf(1), "Infinity"},
+		{math.Inf(-1), "-Infinity"},
+		{math.NaN(), "NaN"},
+	} {
+		if got := PostgreSQL().FormatFloat64(tt.f); got != tt.want {
+			t.Errorf("PostgreSQL.FormatFloat64(%g): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+	for _, tt := range []struct {
+		f    float32
+		want string
+	}{
+		{0, "0"},
+		{0.1, "0.1"},
+		{100000, "100000"},
+		{1e6, "1e+06"},
+		{1.5e-5, "1.5e-05"},
+		{math.MaxFloat32, "3.4028235e+38"},
+		{float32(math.Inf(-1)), "-Infinity"},
+	} {
+		if got := PostgreSQLFloat4().FormatFloat32(tt.f); got != tt.want {
+			t.Errorf("PostgreSQLFloat4.FormatFloat32(%g): got %q; want %q", tt.f, got, tt.want)
+		}
+	}
+}
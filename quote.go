@@ -0,0 +1,58 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+// AppendQuotedFloat64 appends the shortest string form of the 64-bit
+// floating point number f in strconv's 'g' format, surrounded by double
+// quotes, to b and returns the extended buffer. For example, 1.5 is appended
+// as "1.5" (with the quotes), 1e21 as "1e+21", and NaN as "NaN".
+//
+// This is the form used by APIs that transport numbers as JSON strings to
+// avoid precision loss. The infinities are spelled "+Inf" and "-Inf". For
+// other spellings, such as the "Infinity" and "-Infinity" of proto3 JSON, use
+// the Formatter method with Inf and NegInf set:
+//
+//	f := Formatter{Notation: NotationAuto, Inf: "Infinity", NegInf: "-Infinity"}
+//	b = f.AppendQuotedFloat64(b, x)
+func AppendQuotedFloat64(b []byte, f float64) []byte {
+	b = append(b, '"')
+	b = appendShortestG(b, Decimal64(f))
+	return append(b, '"')
+}
+
+// AppendQuotedFloat32 is like AppendQuotedFloat64 for the 32-bit floating
+// point number f.
+func AppendQuotedFloat32(b []byte, f float32) []byte {
+	b = append(b, '"')
+	b = appendShortestG(b, Decimal32(f))
+	return append(b, '"')
+}
+
+// AppendQuotedFloat64 appends the string form of x, as generated by
+// f.FormatFloat64 and surrounded by double quotes, to b and returns the
+// extended buffer. The output is not escaped: f, and its Inf, NegInf, and NaN
+// spellings in particular, must not produce double quotes or backslashes.
+// NotationAuto gives the digits of the top-level AppendQuotedFloat64.
+func (f *Formatter) AppendQuotedFloat64(b []byte, x float64) []byte {
+	b = append(b, '"')
+	b = f.AppendFloat64(b, x)
+	return append(b, '"')
+}
+
+// AppendQuotedFloat32 is like AppendQuotedFloat64 for the 32-bit floating
+// point number x.
+func (f *Formatter) AppendQuotedFloat32(b []byte, x float32) []byte {
+	b = append(b, '"')
+	b = f.AppendFloat32(b, x)
+	return append(b, '"')
+}
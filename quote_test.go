@@ -0,0 +1,60 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAppendQuotedFloat(t *testing.T) {
+	for _, tt := range []struct {
+		x    float64
+		want string
+	}{
+		{1.5, `"1.5"`},
+		{-1e300, `"-1e+300"`},
+		{1e21, `"1e+21"`},
+		{123456, `"123456"`},
+		{1e-5, `"1e-05"`},
+		{0, `"0"`},
+		{math.Inf(1), `"+Inf"`},
+		{math.Inf(-1), `"-Inf"`},
+		{math.NaN(), `"NaN"`},
+	} {
+		if got := string(AppendQuotedFloat64(nil, tt.x)); got != tt.want {
+			t.Errorf("AppendQuotedFloat64(%g): got %s; want %s", tt.x, got, tt.want)
+		}
+		if got := string(AppendQuotedFloat32(nil, float32(tt.x))); tt.x != -1e300 && got != tt.want {
+			t.Errorf("AppendQuotedFloat32(%g): got %s; want %s", tt.x, got, tt.want)
+		}
+	}
+	proto := Formatter{Notation: NotationAuto, Inf: "Infinity", NegInf: "-Infinity"}
+	for _, x := range []float64{1.5, -1e300, 1e21, 123456, 1e-5, 0, 0.1} {
+		if got, want := string(proto.AppendQuotedFloat64(nil, x)), string(AppendQuotedFloat64(nil, x)); got != want {
+			t.Errorf("Formatter.AppendQuotedFloat64(%g) with NotationAuto: got %s; want %s", x, got, want)
+		}
+	}
+	for x, want := range map[float64]string{math.Inf(1): `"Infinity"`, math.Inf(-1): `"-Infinity"`, math.NaN(): `"NaN"`} {
+		if got := string(proto.AppendQuotedFloat64(nil, x)); got != want {
+			t.Errorf("Formatter.AppendQuotedFloat64(%g) with proto3 spellings: got %s; want %s", x, got, want)
+		}
+	}
+	f := Formatter{Renderer: fixedRenderer{}}
+	if got, want := string(f.AppendQuotedFloat64([]byte("x:"), 1.5)), `x:"1.5"`; got != want {
+		t.Errorf("Formatter.AppendQuotedFloat64: got %s; want %s", got, want)
+	}
+	if got, want := string(f.AppendQuotedFloat32(nil, 0.25)), `"0.25"`; got != want {
+		t.Errorf("Formatter.AppendQuotedFloat32: got %s; want %s", got, want)
+	}
+}
@@ -0,0 +1,20 @@
+//go:build race
+// +build race
+
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+// raceEnabled reports whether the race detector is on. It drops sync.Pool
+// items at random, which makes allocation counts unreliable.
+const raceEnabled = true
@@ -0,0 +1,67 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "strconv"
+
+// A RangePolicy determines how a Parser treats numbers outside the range of
+// the floating-point format: numbers that overflow to an infinity and nonzero
+// numbers that underflow to zero. The zero value, RangeInf, behaves like
+// strconv.ParseFloat.
+type RangePolicy uint8
+
+const (
+	// RangeInf returns the rounded result of a number that overflows,
+	// ±Inf, along with a *strconv.NumError whose Err is strconv.ErrRange,
+	// so that the caller gets both the value and the range flag. A number
+	// that underflows to ±0 is not an error.
+	RangeInf RangePolicy = iota
+	// RangeSaturate returns the rounded result of any number, ±Inf or ±0,
+	// without error.
+	RangeSaturate
+	// RangeError rejects numbers that overflow or underflow: the result is
+	// 0 and the error's Err is strconv.ErrRange.
+	RangeError
+)
+
+func (r RangePolicy) String() string {
+	switch r {
+	case RangeInf:
+		return "RangeInf"
+	case RangeSaturate:
+		return "RangeSaturate"
+	case RangeError:
+		return "RangeError"
+	}
+	return "RangePolicy(" + strconv.Itoa(int(r)) + ")"
+}
+
+// checkRange applies p.Range to u, the result of parsing s, and returns it
+// with the length of s. nonzero reports whether s is a nonzero number.
+// Spellings of infinity are handled before numbers are scanned, so an
+// infinite result here means the number overflowed.
+func (p *Parser) checkRange(s string, u uint64, nonzero bool, info *floatInfo) (uint64, int, error) {
+	if p.Range == RangeSaturate {
+		return u, len(s), nil
+	}
+	expMax := uint64(1)<<info.expBits - 1
+	overflow := (u>>info.mantBits)&expMax == expMax
+	underflow := nonzero && u&^(1<<(info.mantBits+info.expBits)) == 0
+	switch {
+	case p.Range == RangeError && (overflow || underflow):
+		return 0, len(s), numError(s, strconv.ErrRange)
+	case overflow:
+		return u, len(s), numError(s, strconv.ErrRange)
+	}
+	return u, len(s), nil
+}
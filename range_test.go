@@ -0,0 +1,88 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestParserRange(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	for _, tt := range []struct {
+		r       RangePolicy
+		s       string
+		want    float64
+		wantErr error
+	}{
+		{RangeInf, "1e400", math.Inf(1), strconv.ErrRange},
+		{RangeInf, "-1e-400", negZero, nil},
+		{RangeInf, "0x1p-2000", 0, nil},
+		{RangeSaturate, "1e400", math.Inf(1), nil},
+		{RangeSaturate, "-0x1p2000", math.Inf(-1), nil},
+		{RangeSaturate, "1e-400", 0, nil},
+		{RangeError, "1e400", 0, strconv.ErrRange},
+		{RangeError, "-1e-400", 0, strconv.ErrRange},
+		{RangeError, "0x1p-2000", 0, strconv.ErrRange},
+		{RangeError, "1234567890123456789e-400", 0, strconv.ErrRange},
+		{RangeError, "-0e-400", negZero, nil},
+		{RangeError, "5e-324", 5e-324, nil},
+		{RangeError, "1.7976931348623157e308", math.MaxFloat64, nil},
+	} {
+		p := Parser{Range: tt.r}
+		got, err := p.ParseFloat64(tt.s)
+		if math.Float64bits(got) != math.Float64bits(tt.want) || numErr(err) != tt.wantErr {
+			t.Errorf("%v: ParseFloat64(%q): got (%g, %v); want (%g, %v)", tt.r, tt.s, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	p := Parser{Range: RangeError}
+	if _, err := p.ParseFloat32("1e-46"); numErr(err) != strconv.ErrRange {
+		t.Errorf("ParseFloat32(1e-46): got error %v; want range error", err)
+	}
+	if f, err := p.ParseFloat32("1e-45"); f == 0 || err != nil {
+		t.Errorf("ParseFloat32(1e-45): got (%g, %v); want smallest subnormal", f, err)
+	}
+	// A directed rounding mode that keeps the result finite or nonzero is
+	// not out of range.
+	p.Rounding = ToZero
+	if f, err := p.ParseFloat64("1e400"); f != math.MaxFloat64 || err != nil {
+		t.Errorf("ToZero: ParseFloat64(1e400): got (%g, %v); want (MaxFloat64, nil)", f, err)
+	}
+	p.Rounding = ToPositiveInf
+	if f, err := p.ParseFloat64("1e-400"); f != 5e-324 || err != nil {
+		t.Errorf("ToPositiveInf: ParseFloat64(1e-400): got (%g, %v); want (5e-324, nil)", f, err)
+	}
+	// Infinities are not out of range.
+	p = Parser{Range: RangeError, Specials: StrconvSpecials}
+	if f, err := p.ParseFloat64("-inf"); !math.IsInf(f, -1) || err != nil {
+		t.Errorf("ParseFloat64(-inf): got (%g, %v); want (-Inf, nil)", f, err)
+	}
+}
+
+func TestRangePolicyString(t *testing.T) {
+	for _, tt := range []struct {
+		r    RangePolicy
+		want string
+	}{
+		{RangeInf, "RangeInf"},
+		{RangeSaturate, "RangeSaturate"},
+		{RangeError, "RangeError"},
+		{7, "RangePolicy(7)"},
+	} {
+		if got := tt.r.String(); got != tt.want {
+			t.Errorf("RangePolicy(%d).String() = %q; want %q", tt.r, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "strconv"
+
+// A RoundingMode determines how a number that is not exactly representable
+// is rounded. The zero value, ToNearestEven, is the IEEE 754 default.
+type RoundingMode uint8
+
+const (
+	// ToNearestEven rounds to the nearest representable number, choosing
+	// the one with an even last digit if the number is halfway between two.
+	ToNearestEven RoundingMode = iota
+	// ToZero rounds toward zero (truncation).
+	ToZero
+	// ToPositiveInf rounds toward +∞ (the ceiling).
+	ToPositiveInf
+	// ToNegativeInf rounds toward -∞ (the floor).
+	ToNegativeInf
+	// ToNearestAway rounds to the nearest representable number, choosing
+	// the one farther from zero if the number is halfway between two. This
+	// is the "round half up" of commercial arithmetic.
+	ToNearestAway
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case ToNearestEven:
+		return "ToNearestEven"
+	case ToZero:
+		return "ToZero"
+	case ToPositiveInf:
+		return "ToPositiveInf"
+	case ToNegativeInf:
+		return "ToNegativeInf"
+	case ToNearestAway:
+		return "ToNearestAway"
+	}
+	return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+// nearest reports whether m rounds to nearest rather than in a direction.
+func (m RoundingMode) nearest() bool {
+	return m == ToNearestEven || m == ToNearestAway
+}
+
+// away reports whether a directed mode m rounds an inexact number with the
+// sign neg away from zero, that is, up in magnitude.
+func (m RoundingMode) away(neg bool) bool {
+	return m == ToPositiveInf && !neg || m == ToNegativeInf && neg
+}
+
+// overflow returns the bits of a number with the sign neg that is too large
+// for the format described by info, rounded according to mode: ±Inf, or the
+// largest finite number when mode rounds toward zero.
+func (info *floatInfo) overflow(neg bool, mode RoundingMode) uint64 {
+	sign := boolToUint64(neg) << (info.mantBits + info.expBits)
+	inf := (uint64(1)<<info.expBits - 1) << info.mantBits
+	if !mode.nearest() && !mode.away(neg) {
+		return sign | (inf - 1)
+	}
+	return sign | inf
+}
+
+// underflow returns the bits of a nonzero number with the sign neg that is
+// less than half the smallest subnormal in the format described by info,
+// rounded according to mode: ±0, or the smallest subnormal when mode rounds
+// away from zero.
+func (info *floatInfo) underflow(neg bool, mode RoundingMode) uint64 {
+	sign := boolToUint64(neg) << (info.mantBits + info.expBits)
+	return sign | boolToUint64(mode.away(neg))
+}
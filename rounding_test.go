@@ -0,0 +1,350 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var roundingModes = []RoundingMode{ToNearestEven, ToZero, ToPositiveInf, ToNegativeInf, ToNearestAway}
+
+func TestParseRounding(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		mode RoundingMode
+		want float64
+	}{
+		{"0.1", ToNearestEven, 0.1},
+		{"0.1", ToZero, 0.09999999999999999},
+		{"0.1", ToNegativeInf, 0.09999999999999999},
+		{"0.1", ToPositiveInf, 0.1},
+		{"-0.1", ToZero, -0.09999999999999999},
+		{"-0.1", ToNegativeInf, -0.1},
+		{"-0.1", ToPositiveInf, -0.09999999999999999},
+		{"0.5", ToZero, 0.5},
+		{"0.5", ToPositiveInf, 0.5},
+		{"1e-400", ToPositiveInf, 5e-324},
+		{"1e-400", ToZero, 0},
+		{"-1e-400", ToNegativeInf, -5e-324},
+		{"-1e-400", ToPositiveInf, math.Copysign(0, -1)},
+		{"1e400", ToZero, math.MaxFloat64},
+		{"1e400", ToNegativeInf, math.MaxFloat64},
+		{"-1e400", ToPositiveInf, -math.MaxFloat64},
+		{"1.7976931348623158e308", ToZero, math.MaxFloat64},
+		{"1.7976931348623158e308", ToPositiveInf, math.Inf(1)},
+		{"0x1.00000000000008p0", ToZero, 1},
+		{"0x1.00000000000008p0", ToPositiveInf, 1.0000000000000002},
+		{"0x1p-1080", ToPositiveInf, 5e-324},
+		{"0x1p5000", ToZero, math.MaxFloat64},
+		{"9007199254740993", ToNearestEven, 9007199254740992},
+		{"9007199254740993", ToNearestAway, 9007199254740994},
+		{"-9007199254740993", ToNearestAway, -9007199254740994},
+		{"9007199254740993.0000000000000000001", ToNearestAway, 9007199254740994},
+		{"9007199254740992.9999999999999999999", ToNearestAway, 9007199254740992},
+		{"9007199254740995", ToNearestAway, 9007199254740996},
+		{"0x1.00000000000008p0", ToNearestAway, 1.0000000000000002},
+		{"0x1p-1075", ToNearestEven, 0},
+		{"0x1p-1075", ToNearestAway, 5e-324},
+		{"1.7976931348623158e308", ToNearestAway, math.MaxFloat64},
+		{"1e400", ToNearestAway, math.Inf(1)},
+	} {
+		p := Parser{Rounding: tt.mode}
+		got, err := p.ParseFloat64(tt.s)
+		if err != nil && !(math.IsInf(tt.want, 0) && numErr(err) == strconv.ErrRange) {
+			t.Errorf("%s: ParseFloat64(%q): %v", tt.mode, tt.s, err)
+			continue
+		}
+		if math.Float64bits(got) != math.Float64bits(tt.want) {
+			t.Errorf("%s: ParseFloat64(%q): got %g; want %g", tt.mode, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestParseRoundingRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1e4; i++ {
+		var s string
+		switch r.Intn(4) {
+		case 0:
+			s = strconv.FormatInt(r.Int63n(1e17), 10) + "e" + strconv.Itoa(r.Intn(700)-360)
+		case 1:
+			// Shortest representations are usually inexact.
+			s = strconv.FormatFloat(math.Float64frombits(r.Uint64()), 'e', -1, 64)
+			if s == "NaN" || s == "+Inf" || s == "-Inf" {
+				continue
+			}
+		case 2:
+			b := []byte("0x")
+			for n := 1 + r.Intn(30); n > 0; n-- {
+				b = append(b, "0123456789abcdef"[r.Intn(16)])
+			}
+			b = append(b, 'p')
+			s = string(strconv.AppendInt(b, int64(r.Intn(2400)-1250), 10))
+		case 3:
+			s = randomDigits(r, 18+r.Intn(100)) + "e" + strconv.Itoa(r.Intn(700)-400)
+		}
+		if s[0] != '-' && r.Intn(2) == 0 {
+			s = "-" + s
+		}
+		// big.Rat does not parse hexadecimal floats, but these are exact
+		// at 1000 bits.
+		x, ok := new(big.Rat).SetString(s)
+		if strings.Contains(s, "0x") {
+			xf, _, err := big.ParseFloat(s, 0, 1000, big.ToNearestEven)
+			if err != nil {
+				t.Fatalf("bad test input %q: %s", s, err)
+			}
+			x, _ = xf.Rat(nil)
+			ok = true
+		}
+		if !ok {
+			t.Fatalf("bad test input %q", s)
+		}
+		for _, mode := range roundingModes {
+			p := Parser{Rounding: mode}
+			got, _ := p.ParseFloat64(s)
+			if want := directed64(s, x, mode); math.Float64bits(got) != math.Float64bits(want) {
+				t.Fatalf("%s: ParseFloat64(%q): got %g; want %g", mode, s, got, want)
+			}
+			got32, _ := p.ParseFloat32(s)
+			if want := directed32(s, x, mode); math.Float32bits(got32) != math.Float32bits(want) {
+				t.Fatalf("%s: ParseFloat32(%q): got %g; want %g", mode, s, got32, want)
+			}
+		}
+	}
+}
+
+// directed64 rounds x, the value of s, to a float64 according to mode by
+// stepping from the nearest float64 toward x.
+func directed64(s string, x *big.Rat, mode RoundingMode) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	if mode == ToNearestAway && !math.IsInf(f, 0) {
+		// Move off a tie that strconv broke toward zero.
+		g := math.Nextafter(f, math.Copysign(math.Inf(1), f))
+		if math.Abs(g) > math.Abs(f) && isMidpoint(x, new(big.Rat).SetFloat64(f), new(big.Rat).SetFloat64(g)) {
+			return g
+		}
+	}
+	if mode.nearest() {
+		return f
+	}
+	if math.IsInf(f, 0) {
+		f = math.Copysign(math.MaxFloat64, f)
+	}
+	switch c := new(big.Rat).SetFloat64(f).Cmp(x); {
+	case c < 0 && (mode == ToPositiveInf || mode == ToZero && x.Sign() < 0):
+		f = math.Nextafter(f, math.Inf(1))
+	case c > 0 && (mode == ToNegativeInf || mode == ToZero && x.Sign() > 0):
+		f = math.Nextafter(f, math.Inf(-1))
+	}
+	return f
+}
+
+// directed32 is like directed64 for float32.
+func directed32(s string, x *big.Rat, mode RoundingMode) float32 {
+	f64, _ := strconv.ParseFloat(s, 32)
+	f := float32(f64)
+	if mode == ToNearestAway && !math.IsInf(f64, 0) {
+		g := math.Nextafter32(f, float32(math.Copysign(math.Inf(1), f64)))
+		if math.Abs(float64(g)) > math.Abs(float64(f)) && isMidpoint(x, new(big.Rat).SetFloat64(float64(f)), new(big.Rat).SetFloat64(float64(g))) {
+			return g
+		}
+	}
+	if mode.nearest() {
+		return f
+	}
+	if math.IsInf(f64, 0) {
+		f = float32(math.Copysign(math.MaxFloat32, f64))
+	}
+	switch c := new(big.Rat).SetFloat64(float64(f)).Cmp(x); {
+	case c < 0 && (mode == ToPositiveInf || mode == ToZero && x.Sign() < 0):
+		f = math.Nextafter32(f, float32(math.Inf(1)))
+	case c > 0 && (mode == ToNegativeInf || mode == ToZero && x.Sign() > 0):
+		f = math.Nextafter32(f, float32(math.Inf(-1)))
+	}
+	return f
+}
+
+// isMidpoint reports whether x is halfway between a and b.
+func isMidpoint(x, a, b *big.Rat) bool {
+	twice := new(big.Rat).Add(x, x)
+	return twice.Cmp(new(big.Rat).Add(a, b)) == 0
+}
+
+func TestFormatRounding(t *testing.T) {
+	for _, tt := range []struct {
+		fmt  byte
+		f    float64
+		prec int
+		want [5]string // in the order of roundingModes
+	}{
+		{'f', 2.5, 0, [5]string{"2", "2", "3", "2", "3"}},
+		{'f', -2.5, 0, [5]string{"-2", "-2", "-2", "-3", "-3"}},
+		{'f', 3.5, 0, [5]string{"4", "3", "4", "3", "4"}},
+		{'f', 0.125, 2, [5]string{"0.12", "0.12", "0.13", "0.12", "0.13"}},
+		{'f', 0.001, 2, [5]string{"0.00", "0.00", "0.01", "0.00", "0.00"}},
+		{'f', -0.001, 2, [5]string{"-0.00", "-0.00", "-0.00", "-0.01", "-0.00"}},
+		{'f', 1e-300, 3, [5]string{"0.000", "0.000", "0.001", "0.000", "0.000"}},
+		{'f', 9.999, 2, [5]string{"10.00", "9.99", "10.00", "9.99", "10.00"}},
+		{'f', 0.1, 20, [5]string{"0.10000000000000000555", "0.10000000000000000555", "0.10000000000000000556", "0.10000000000000000555", "0.10000000000000000555"}},
+		{'e', 8.5, 0, [5]string{"8e+00", "8e+00", "9e+00", "8e+00", "9e+00"}},
+		{'e', 9.5, 0, [5]string{"1e+01", "9e+00", "1e+01", "9e+00", "1e+01"}},
+		{'e', 1e23, 0, [5]string{"1e+23", "9e+22", "1e+23", "9e+22", "1e+23"}},
+		{'e', -1e23, 1, [5]string{"-1.0e+23", "-9.9e+22", "-9.9e+22", "-1.0e+23", "-1.0e+23"}},
+		{'g', 2.5, 1, [5]string{"2", "2", "3", "2", "3"}},
+		{'g', 0.15, 1, [5]string{"0.1", "0.1", "0.2", "0.1", "0.1"}},
+		{'g', 123456, 3, [5]string{"1.23e+05", "1.23e+05", "1.24e+05", "1.23e+05", "1.23e+05"}},
+	} {
+		for i, mode := range roundingModes {
+			f := Formatter{Rounding: mode}
+			var got string
+			switch tt.fmt {
+			case 'f':
+				got = f.FormatFloat64Fixed(tt.f, tt.prec)
+			case 'e':
+				got = f.FormatFloat64Exp(tt.f, tt.prec)
+			case 'g':
+				got = f.FormatFloat64General(tt.f, tt.prec)
+			}
+			if got != tt.want[i] {
+				t.Errorf("%s: %%.%d%c of %v: got %q; want %q", mode, tt.prec, tt.fmt, tt.f, got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestFormatRoundingRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1e4; i++ {
+		var x float64
+		switch r.Intn(3) {
+		case 0:
+			x = math.Float64frombits(r.Uint64())
+			if math.IsNaN(x) || math.IsInf(x, 0) {
+				continue
+			}
+		case 1:
+			// Short values make ties more likely.
+			x = float64(r.Intn(1e6)) / float64(int(1)<<uint(r.Intn(12)))
+		case 2:
+			x = r.NormFloat64() * math.Pow(10, float64(r.Intn(40)-20))
+		}
+		prec := r.Intn(25)
+		for _, mode := range roundingModes {
+			f := Formatter{Rounding: mode}
+			if got, want := f.FormatFloat64Fixed(x, prec), fixedRat(x, prec, mode); got != want {
+				t.Fatalf("%s: FormatFloat64Fixed(%v, %d): got %q; want %q", mode, x, prec, got, want)
+			}
+			if got, want := f.FormatFloat64Exp(x, prec), expRat(x, prec, mode); got != want {
+				t.Fatalf("%s: FormatFloat64Exp(%v, %d): got %q; want %q", mode, x, prec, got, want)
+			}
+		}
+	}
+}
+
+// fixedRat formats x like FormatFloat64Fixed using exact arithmetic.
+func fixedRat(x float64, prec int, mode RoundingMode) string {
+	v := new(big.Rat).SetFloat64(math.Abs(x))
+	v.Mul(v, new(big.Rat).SetInt(pow10Int(prec)))
+	return signed(x, insertDot(roundRat(v, x < 0, mode).String(), prec))
+}
+
+// expRat formats x like FormatFloat64Exp using exact arithmetic.
+func expRat(x float64, prec int, mode RoundingMode) string {
+	v := new(big.Rat).SetFloat64(math.Abs(x))
+	if v.Sign() == 0 {
+		return signed(x, insertDot(strings.Repeat("0", prec+1), prec)+"e+00")
+	}
+	// Find exp with 10^exp <= v < 10^(exp+1).
+	exp := int(math.Floor(math.Log10(math.Abs(x))))
+	for v.Cmp(pow10Rat(exp)) < 0 {
+		exp--
+	}
+	for v.Cmp(pow10Rat(exp+1)) >= 0 {
+		exp++
+	}
+	v.Mul(v, pow10Rat(prec-exp))
+	digits := roundRat(v, x < 0, mode).String()
+	if len(digits) > prec+1 {
+		digits = digits[:prec+1]
+		exp++
+	}
+	s := insertDot(digits, prec)
+	if exp < 0 {
+		s += "e-"
+		exp = -exp
+	} else {
+		s += "e+"
+	}
+	if exp < 10 {
+		s += "0"
+	}
+	return signed(x, s+strconv.Itoa(exp))
+}
+
+// roundRat rounds the nonnegative v, the magnitude of a number with the sign
+// neg, to an integer according to mode.
+func roundRat(v *big.Rat, neg bool, mode RoundingMode) *big.Int {
+	q, rem := new(big.Int).QuoRem(v.Num(), v.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return q
+	}
+	var up bool
+	switch c := new(big.Int).Lsh(rem, 1).Cmp(v.Denom()); mode {
+	case ToNearestEven:
+		up = c > 0 || c == 0 && q.Bit(0) == 1
+	case ToNearestAway:
+		up = c >= 0
+	default:
+		up = mode.away(neg)
+	}
+	if up {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+func pow10Int(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func pow10Rat(n int) *big.Rat {
+	if n < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), pow10Int(-n))
+	}
+	return new(big.Rat).SetInt(pow10Int(n))
+}
+
+// insertDot places a decimal point before the last prec digits of s, padding
+// it with leading zeros to at least one integer digit.
+func insertDot(s string, prec int) string {
+	if len(s) <= prec {
+		s = strings.Repeat("0", prec+1-len(s)) + s
+	}
+	if prec == 0 {
+		return s
+	}
+	return s[:len(s)-prec] + "." + s[len(s)-prec:]
+}
+
+func signed(x float64, s string) string {
+	if math.Signbit(x) {
+		return "-" + s
+	}
+	return s
+}
@@ -25,7 +25,10 @@ import (
 	"unsafe"
 )
 
-//go:generate go run maketables.go
+//go:generate go run maketables.go -variants
+//go:generate go run maketables.go -formats 16,bf16 -o tables16.go
+//go:generate go run maketables.go -formats 128 -o tables128.go
+//go:generate go run maketables.go -formats "" -spec E4M3:3:4:7,E5M2:2:5:15 -o tables8.go
 
 const (
 	mantBits32 = 23
@@ -41,22 +44,30 @@ const (
 // It behaves like strconv.FormatFloat(float64(f), 'e', -1, 32).
 func FormatFloat32(f float32) string {
 	b := make([]byte, 0, 15)
-	b = AppendFloat32(b, f)
-
-	// Convert the output to a string without copying.
-	var s string
-	sh := (*reflect.StringHeader)(unsafe.Pointer(&s))
-	sh.Data = uintptr(unsafe.Pointer(&b[0]))
-	sh.Len = len(b)
-	return s
+	return unsafeString(AppendFloat32(b, f))
 }
 
 // AppendFloat32 appends the string form of the 32-bit floating point number f,
 // as generated by FormatFloat32, to b and returns the extended buffer.
 func AppendFloat32(b []byte, f float32) []byte {
+	return AppendFloat32Bits(b, math.Float32bits(f))
+}
+
+// FormatFloat32Bits is like FormatFloat32 for the float32 with the IEEE 754
+// binary representation u. Unlike FormatFloat32(math.Float32frombits(u)), it
+// never passes the value through a floating-point register, which can quiet
+// signaling NaNs on some architectures.
+func FormatFloat32Bits(u uint32) string {
+	b := make([]byte, 0, 15)
+	return unsafeString(AppendFloat32Bits(b, u))
+}
+
+// AppendFloat32Bits appends the string form of the float32 with the IEEE 754
+// binary representation u, as generated by FormatFloat32Bits, to b and returns
+// the extended buffer.
+func AppendFloat32Bits(b []byte, u uint32) []byte {
 	// Step 1: Decode the floating-point number.
 	// Unify normalized and subnormal cases.
-	u := math.Float32bits(f)
 	neg := u>>(mantBits32+expBits32) != 0
 	mant := u & (uint32(1)<<mantBits32 - 1)
 	exp := (u >> mantBits32) & (uint32(1)<<expBits32 - 1)
@@ -77,22 +88,30 @@ func AppendFloat32(b []byte, f float32) []byte {
 // It behaves like strconv.FormatFloat(f, 'e', -1, 64).
 func FormatFloat64(f float64) string {
 	b := make([]byte, 0, 24)
-	b = AppendFloat64(b, f)
-
-	// Convert the output to a string without copying.
-	var s string
-	sh := (*reflect.StringHeader)(unsafe.Pointer(&s))
-	sh.Data = uintptr(unsafe.Pointer(&b[0]))
-	sh.Len = len(b)
-	return s
+	return unsafeString(AppendFloat64(b, f))
 }
 
 // AppendFloat64 appends the string form of the 64-bit floating point number f,
 // as generated by FormatFloat64, to b and returns the extended buffer.
 func AppendFloat64(b []byte, f float64) []byte {
+	return AppendFloat64Bits(b, math.Float64bits(f))
+}
+
+// FormatFloat64Bits is like FormatFloat64 for the float64 with the IEEE 754
+// binary representation u. Unlike FormatFloat64(math.Float64frombits(u)), it
+// never passes the value through a floating-point register, which can quiet
+// signaling NaNs on some architectures.
+func FormatFloat64Bits(u uint64) string {
+	b := make([]byte, 0, 24)
+	return unsafeString(AppendFloat64Bits(b, u))
+}
+
+// AppendFloat64Bits appends the string form of the float64 with the IEEE 754
+// binary representation u, as generated by FormatFloat64Bits, to b and returns
+// the extended buffer.
+func AppendFloat64Bits(b []byte, u uint64) []byte {
 	// Step 1: Decode the floating-point number.
 	// Unify normalized and subnormal cases.
-	u := math.Float64bits(f)
 	neg := u>>(mantBits64+expBits64) != 0
 	mant := u & (uint64(1)<<mantBits64 - 1)
 	exp := (u >> mantBits64) & (uint64(1)<<expBits64 - 1)
@@ -109,6 +128,26 @@ func AppendFloat64(b []byte, f float64) []byte {
 	return d.append(b, neg)
 }
 
+// unsafeString converts b to a string without copying.
+// The caller must not modify b afterwards.
+func unsafeString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	var s string
+	sh := (*reflect.StringHeader)(unsafe.Pointer(&s))
+	sh.Data = uintptr(unsafe.Pointer(&b[0]))
+	sh.Len = len(b)
+	return s
+}
+
+// bytesString converts b to a string without copying. The string must not
+// be retained beyond the lifetime of b, and b must not be modified while it
+// is in use.
+func bytesString(b []byte) string {
+	return unsafeString(b)
+}
+
 func appendSpecial(b []byte, neg, expZero, mantZero bool) []byte {
 	if !mantZero {
 		return append(b, "NaN"...)
@@ -91,27 +91,36 @@ func float32ToDecimalExactInt(mant, exp uint32) (d dec32, ok bool) {
 	return d, true
 }
 
-func float32ToDecimal(mant, exp uint32) dec32 {
-	var e2 int32
-	var m2 uint32
-	if exp == 0 {
-		// We subtract 2 so that the bounds computation has
-		// 2 additional bits.
-		e2 = 1 - bias32 - mantBits32 - 2
-		m2 = mant
-	} else {
-		e2 = int32(exp) - bias32 - mantBits32 - 2
-		m2 = uint32(1)<<mantBits32 | mant
-	}
+// pow5Tables32 are the pow5 tables of a format that uses the 32-bit
+// arithmetic, with entries of bits and invBits bits.
+type pow5Tables32 struct {
+	split, invSplit []uint64
+	bits, invBits   int32
+}
+
+func (t *pow5Tables32) mulPow5InvDivPow2(m, q uint32, j int32) uint32 {
+	return mulShift32(m, t.invSplit[q], j)
+}
+
+func (t *pow5Tables32) mulPow5DivPow2(m, i uint32, j int32) uint32 {
+	return mulShift32(m, t.split[i], j)
+}
+
+// toDecimal32 is the core of the 32-bit algorithm. m2 and e2 describe the
+// number as m2 * 2^e2, where e2 includes the 2 extra bits used for the bounds
+// computation, and mmShift is 1 unless the lower bound is closer than the
+// upper bound. Like toDecimal64, it only depends on the input format through
+// m2, e2, and the tables t, which must cover its exponents, so it serves
+// float32 and the smaller binary formats whose 4*m2 fits in a uint32.
+func toDecimal32(m2 uint32, e2 int32, mmShift uint32, t *pow5Tables32) dec32 {
 	even := m2&1 == 0
 	acceptBounds := even
 
 	// Step 2: Determine the interval of valid decimal representations.
 	var (
-		mv      = 4 * m2
-		mp      = 4*m2 + 2
-		mmShift = boolToUint32(mant != 0 || exp <= 1)
-		mm      = 4*m2 - 1 - mmShift
+		mv = 4 * m2
+		mp = 4*m2 + 2
+		mm = 4*m2 - 1 - mmShift
 	)
 
 	// Step 3: Convert to a decimal power base using 64-bit arithmetic.
@@ -125,19 +134,19 @@ func float32ToDecimal(mant, exp uint32) dec32 {
 	if e2 >= 0 {
 		q := log10Pow2(e2)
 		e10 = int32(q)
-		k := pow5InvNumBits32 + pow5Bits(int32(q)) - 1
+		k := t.invBits + pow5Bits(int32(q)) - 1
 		i := -e2 + int32(q) + k
-		vr = mulPow5InvDivPow2(mv, q, i)
-		vp = mulPow5InvDivPow2(mp, q, i)
-		vm = mulPow5InvDivPow2(mm, q, i)
+		vr = t.mulPow5InvDivPow2(mv, q, i)
+		vp = t.mulPow5InvDivPow2(mp, q, i)
+		vm = t.mulPow5InvDivPow2(mm, q, i)
 		if q != 0 && (vp-1)/10 <= vm/10 {
 			// We need to know one removed digit even if we are not
 			// going to loop below. We could use q = X - 1 above,
 			// except that would require 33 bits for the result, and
 			// we've found that 32-bit arithmetic is faster even on
 			// 64-bit machines.
-			l := pow5InvNumBits32 + pow5Bits(int32(q-1)) - 1
-			lastRemovedDigit = uint8(mulPow5InvDivPow2(mv, q-1, -e2+int32(q-1)+l) % 10)
+			l := t.invBits + pow5Bits(int32(q-1)) - 1
+			lastRemovedDigit = uint8(t.mulPow5InvDivPow2(mv, q-1, -e2+int32(q-1)+l) % 10)
 		}
 		if q <= 9 {
 			// The largest power of 5 that fits in 24 bits is 5^10,
@@ -155,14 +164,14 @@ func float32ToDecimal(mant, exp uint32) dec32 {
 		q := log10Pow5(-e2)
 		e10 = int32(q) + e2
 		i := -e2 - int32(q)
-		k := pow5Bits(i) - pow5NumBits32
+		k := pow5Bits(i) - t.bits
 		j := int32(q) - k
-		vr = mulPow5DivPow2(mv, uint32(i), j)
-		vp = mulPow5DivPow2(mp, uint32(i), j)
-		vm = mulPow5DivPow2(mm, uint32(i), j)
+		vr = t.mulPow5DivPow2(mv, uint32(i), j)
+		vp = t.mulPow5DivPow2(mp, uint32(i), j)
+		vm = t.mulPow5DivPow2(mm, uint32(i), j)
 		if q != 0 && (vp-1)/10 <= vm/10 {
-			j = int32(q) - 1 - (pow5Bits(i+1) - pow5NumBits32)
-			lastRemovedDigit = uint8(mulPow5DivPow2(mv, uint32(i+1), j) % 10)
+			j = int32(q) - 1 - (pow5Bits(i+1) - t.bits)
+			lastRemovedDigit = uint8(t.mulPow5DivPow2(mv, uint32(i+1), j) % 10)
 		}
 		if q <= 1 {
 			// {vr,vp,vm} is trailing zeros if {mv,mp,mm} has at
@@ -273,14 +282,6 @@ func mulShift32(m uint32, mul uint64, shift int32) uint32 {
 	return uint32(shiftedSum)
 }
 
-func mulPow5InvDivPow2(m, q uint32, j int32) uint32 {
-	return mulShift32(m, pow5InvSplit32[q], j)
-}
-
-func mulPow5DivPow2(m, i uint32, j int32) uint32 {
-	return mulShift32(m, pow5Split32[i], j)
-}
-
 func pow5Factor32(v uint32) uint32 {
 	for n := uint32(0); ; n++ {
 		q, r := v/5, v%5
@@ -0,0 +1,40 @@
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+//go:build ryu_64only
+// +build ryu_64only
+
+package ryu
+
+// float32ToDecimal converts a float32 using the 64-bit algorithm so that the
+// 32-bit tables need not be linked in.
+func float32ToDecimal(mant, exp uint32) dec32 {
+	var e2 int32
+	var m2 uint32
+	if exp == 0 {
+		// We subtract 2 so that the bounds computation has
+		// 2 additional bits.
+		e2 = 1 - bias32 - mantBits32 - 2
+		m2 = mant
+	} else {
+		e2 = int32(exp) - bias32 - mantBits32 - 2
+		m2 = uint32(1)<<mantBits32 | mant
+	}
+	mmShift := boolToUint64(mant != 0 || exp <= 1)
+	d := toDecimal64(uint64(m2), e2, mmShift)
+	return dec32{m: uint32(d.m), e: d.e}
+}
@@ -133,12 +133,22 @@ func float64ToDecimal(mant, exp uint64) dec64 {
 		e2 = int32(exp) - bias64 - mantBits64 - 2
 		m2 = uint64(1)<<mantBits64 | mant
 	}
+	mmShift := boolToUint64(mant != 0 || exp <= 1)
+	return toDecimal64(m2, e2, mmShift)
+}
+
+// toDecimal64 runs steps 2-4 of Ryu on the value m2 * 2^e2, where e2 already
+// includes the 2 extra bits used for the bounds computation and mmShift is 1
+// unless the lower bound is closer than the upper bound (that is, unless m2
+// is the smallest mantissa of a normal binade). It only depends on the input
+// format through m2 and e2, so it serves any binary format whose 4*m2 fits in
+// a uint64 and whose exponents are covered by the 64-bit tables.
+func toDecimal64(m2 uint64, e2 int32, mmShift uint64) dec64 {
 	even := m2&1 == 0
 	acceptBounds := even
 
 	// Step 2: Determine the interval of valid decimal representations.
 	mv := 4 * m2
-	mmShift := boolToUint64(mant != 0 || exp <= 1)
 	// We would compute mp and mm like this:
 	// mp := 4 * m2 + 2;
 	// mm := mv - 1 - mmShift;
@@ -156,7 +166,7 @@ func float64ToDecimal(mant, exp uint64) dec64 {
 		e10 = int32(q)
 		k := pow5InvNumBits64 + pow5Bits(int32(q)) - 1
 		i := -e2 + int32(q) + k
-		mul := pow5InvSplit64[q]
+		mul := pow5InvEntry64(q)
 		vr = mulShift64(4*m2, mul, i)
 		vp = mulShift64(4*m2+2, mul, i)
 		vm = mulShift64(4*m2-1-mmShift, mul, i)
@@ -183,7 +193,7 @@ func float64ToDecimal(mant, exp uint64) dec64 {
 		i := -e2 - int32(q)
 		k := pow5Bits(i) - pow5NumBits64
 		j := int32(q) - k
-		mul := pow5Split64[i]
+		mul := pow5Entry64(uint32(i))
 		vr = mulShift64(4*m2, mul, j)
 		vp = mulShift64(4*m2+2, mul, j)
 		vm = mulShift64(4*m2-1-mmShift, mul, j)
@@ -101,6 +101,18 @@ func TestFormatFloat64(t *testing.T) {
 	}
 }
 
+func TestFormatFloatBits(t *testing.T) {
+	for _, f := range append(genericTestCases, float64TestCases...) {
+		if got, want := FormatFloat64Bits(math.Float64bits(f)), FormatFloat64(f); got != want {
+			t.Errorf("FormatFloat64Bits(%#x): got %q; want %q", math.Float64bits(f), got, want)
+		}
+		u := math.Float32bits(float32(f))
+		if got, want := FormatFloat32Bits(u), FormatFloat32(float32(f)); got != want {
+			t.Errorf("FormatFloat32Bits(%#x): got %q; want %q", u, got, want)
+		}
+	}
+}
+
 func TestFormatFloatRandom(t *testing.T) {
 	t.Skip("disabled because of Go bug: https://github.com/golang/go/issues/29491")
 	for i := 0; i < 1e6; i++ {
@@ -143,6 +155,34 @@ func testDecimalLen(t *testing.T, n uint64) {
 	}
 }
 
+func TestPow5Tables(t *testing.T) {
+	// These checks hold for both the full and compact (ryu_compact) tables.
+	for i := uint32(0); i < 326; i++ {
+		pow5 := new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(i)), nil)
+		want := new(big.Int).Lsh(pow5, pow5NumBits64)
+		want.Rsh(want, uint(pow5.BitLen()))
+		if got := pow5Entry64(i); !equal128(got, want) {
+			t.Fatalf("pow5Entry64(%d): got %v; want %s", i, got, want)
+		}
+	}
+	for i := uint32(0); i < 342; i++ {
+		pow5 := new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(i)), nil)
+		want := new(big.Int).Lsh(big.NewInt(1), uint(pow5.BitLen()-1+pow5InvNumBits64))
+		want.Quo(want, pow5)
+		want.Add(want, big.NewInt(1))
+		if got := pow5InvEntry64(i); !equal128(got, want) {
+			t.Fatalf("pow5InvEntry64(%d): got %v; want %s", i, got, want)
+		}
+	}
+}
+
+func equal128(u uint128, x *big.Int) bool {
+	v := new(big.Int).SetUint64(u.hi)
+	v.Lsh(v, 64)
+	v.Or(v, new(big.Int).SetUint64(u.lo))
+	return v.Cmp(x) == 0
+}
+
 var sink string
 var sinkb []byte
 
@@ -0,0 +1,166 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+)
+
+// MaxScanNumberSize is the maximum length in bytes of a number read by a
+// Scanner.
+const MaxScanNumberSize = 64 * 1024
+
+// A Scanner reads a stream of numbers separated by white space (ASCII space,
+// tab, newline, carriage return, vertical tab, and form feed) and parses them
+// as float64s. It reads its input incrementally, so arbitrarily large inputs
+// may be processed in constant memory.
+//
+// Successive calls to Scan step through the numbers. Scanning stops at the
+// end of the input or at the first error, which is reported by Err with the
+// position of the offending number.
+type Scanner struct {
+	// Parser configures how numbers are parsed. It must not be changed
+	// after the first call to Scan.
+	Parser Parser
+
+	r   *bufio.Reader
+	tok []byte
+	f   float64
+	pos Position // of tok
+	cur Position // of the next byte
+	err error    // io.EOF at the end of the input
+}
+
+// A Position is a location in the input of a Scanner.
+type Position struct {
+	Offset int64 // byte offset, starting at 0
+	Line   int   // line number, starting at 1
+	Column int   // byte offset within the line, starting at 1
+}
+
+// String returns the position in the form "line:column".
+func (p Position) String() string {
+	return strconv.Itoa(p.Line) + ":" + strconv.Itoa(p.Column)
+}
+
+// A ScanError records a malformed or unparsable number found by a Scanner
+// and its position.
+type ScanError struct {
+	Pos Position
+	// Err is the *strconv.NumError from parsing the number or, if the
+	// number was longer than MaxScanNumberSize, bufio.ErrTooLong.
+	Err error
+}
+
+func (e *ScanError) Error() string {
+	return "ryu: " + e.Pos.String() + ": " + e.Err.Error()
+}
+
+// Unwrap returns e.Err.
+func (e *ScanError) Unwrap() error { return e.Err }
+
+// NewScanner returns a Scanner that reads from r. If r is a *bufio.Reader,
+// the Scanner reads from it directly rather than adding another buffer.
+func NewScanner(r io.Reader) *Scanner {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &Scanner{r: br, cur: Position{Line: 1, Column: 1}}
+}
+
+// Scan advances the Scanner to the next number, which is then available
+// through the Float64 method. It returns false when scanning stops, either at
+// the end of the input or because of an error.
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	for {
+		c, err := s.r.ReadByte()
+		if err != nil {
+			s.err = err
+			return false
+		}
+		if !isSpace(c) {
+			s.r.UnreadByte()
+			break
+		}
+		s.advance(c)
+	}
+	s.pos = s.cur
+	s.tok = s.tok[:0]
+	for {
+		c, err := s.r.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			s.err = err
+			return false
+		}
+		if isSpace(c) {
+			s.r.UnreadByte()
+			break
+		}
+		if len(s.tok) == MaxScanNumberSize {
+			s.err = &ScanError{Pos: s.pos, Err: bufio.ErrTooLong}
+			return false
+		}
+		s.tok = append(s.tok, c)
+		s.advance(c)
+	}
+	f, err := s.Parser.ParseFloat64(bytesString(s.tok))
+	if err != nil {
+		s.err = &ScanError{Pos: s.pos, Err: err}
+		return false
+	}
+	s.f = f
+	return true
+}
+
+func (s *Scanner) advance(c byte) {
+	s.cur.Offset++
+	s.cur.Column++
+	if c == '\n' {
+		s.cur.Line++
+		s.cur.Column = 1
+	}
+}
+
+// Float64 returns the number found by the most recent call to Scan.
+func (s *Scanner) Float64() float64 { return s.f }
+
+// Pos returns the position of the number found by the most recent call to
+// Scan.
+func (s *Scanner) Pos() Position { return s.pos }
+
+// Err returns the first error encountered by the Scanner, or nil if it
+// stopped at the end of the input. Malformed numbers are reported as a
+// *ScanError.
+func (s *Scanner) Err() error {
+	if s.err == io.EOF {
+		return nil
+	}
+	return s.err
+}
+
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\v', '\f':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"bufio"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestScanner(t *testing.T) {
+	const input = "1.5 -2e3\n\t0x1p-2\r\n\n  7\f1e-7 "
+	for _, r := range []func() *Scanner{
+		func() *Scanner { return NewScanner(strings.NewReader(input)) },
+		func() *Scanner { return NewScanner(iotest.OneByteReader(strings.NewReader(input))) },
+		func() *Scanner { return NewScanner(bufio.NewReaderSize(strings.NewReader(input), 16)) },
+	} {
+		s := r()
+		var got []float64
+		var pos []string
+		for s.Scan() {
+			got = append(got, s.Float64())
+			pos = append(pos, s.Pos().String())
+		}
+		if err := s.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if want := []float64{1.5, -2e3, 0.25, 7, 1e-7}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v; want %v", got, want)
+		}
+		if want := []string{"1:1", "1:5", "2:2", "4:3", "4:5"}; !reflect.DeepEqual(pos, want) {
+			t.Errorf("got positions %v; want %v", pos, want)
+		}
+		if s.Scan() {
+			t.Error("Scan after the end of the input returned true")
+		}
+	}
+}
+
+func TestScannerEmpty(t *testing.T) {
+	for _, input := range []string{"", " \n\t"} {
+		s := NewScanner(strings.NewReader(input))
+		if s.Scan() || s.Err() != nil {
+			t.Errorf("%q: got Scan = true or error %v", input, s.Err())
+		}
+	}
+}
+
+func TestScannerError(t *testing.T) {
+	s := NewScanner(strings.NewReader("1 2\n 3x 4"))
+	n := 0
+	for s.Scan() {
+		n++
+	}
+	if n != 2 {
+		t.Errorf("scanned %d numbers before the error; want 2", n)
+	}
+	err, ok := s.Err().(*ScanError)
+	if !ok {
+		t.Fatalf("got error %v; want *ScanError", s.Err())
+	}
+	if want := (Position{Offset: 5, Line: 2, Column: 2}); err.Pos != want {
+		t.Errorf("got position %+v; want %+v", err.Pos, want)
+	}
+	if numErr(err.Err) != strconv.ErrSyntax {
+		t.Errorf("got error %v; want syntax error", err.Err)
+	}
+	const want = `ryu: 2:2: strconv.ParseFloat: parsing "3x": invalid syntax`
+	if err.Error() != want {
+		t.Errorf("got message %q; want %q", err.Error(), want)
+	}
+	if s.Scan() {
+		t.Error("Scan after an error returned true")
+	}
+
+	s = NewScanner(strings.NewReader("1 " + strings.Repeat("1", MaxScanNumberSize+1)))
+	for s.Scan() {
+	}
+	if err, ok := s.Err().(*ScanError); !ok || err.Err != bufio.ErrTooLong || err.Pos.Column != 3 {
+		t.Errorf("got error %v; want too long error at column 3", s.Err())
+	}
+
+	// TimeoutReader fails its second read.
+	s = NewScanner(iotest.TimeoutReader(iotest.OneByteReader(strings.NewReader("12 3"))))
+	if s.Scan() || s.Err() != iotest.ErrTimeout {
+		t.Errorf("got error %v; want %v", s.Err(), iotest.ErrTimeout)
+	}
+}
+
+func TestScannerParser(t *testing.T) {
+	s := NewScanner(strings.NewReader("1_000 -inf"))
+	s.Parser = Parser{Underscores: true, Specials: StrconvSpecials}
+	var got []float64
+	for s.Scan() {
+		got = append(got, s.Float64())
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []float64{1000, math.Inf(-1)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// The sortable encoding is a fixed-width binary form of a float whose bytewise
+// (memcmp) order matches numeric order, which makes it suitable for keys in
+// ordered key-value stores. It is the big-endian IEEE bit pattern with the
+// sign bit flipped for non-negative numbers and all bits flipped for negative
+// numbers. This orders -Inf < negative numbers < -0 < +0 < positive numbers <
+// +Inf; NaNs sort before -Inf or after +Inf according to their sign bit.
+
+// AppendSortableFloat64 appends the 8-byte sortable encoding of f to b and
+// returns the extended buffer.
+func AppendSortableFloat64(b []byte, f float64) []byte {
+	u := math.Float64bits(f)
+	if u>>63 != 0 {
+		u = ^u
+	} else {
+		u |= 1 << 63
+	}
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], u)
+	return append(b, buf[:]...)
+}
+
+// DecodeSortableFloat64 decodes the sortable encoding produced by
+// AppendSortableFloat64. It returns an error if b is not exactly 8 bytes long.
+func DecodeSortableFloat64(b []byte) (float64, error) {
+	if len(b) != 8 {
+		return 0, fmt.Errorf("ryu: sortable float64 encoding has length %d; want 8", len(b))
+	}
+	u := binary.BigEndian.Uint64(b)
+	if u>>63 != 0 {
+		u &^= 1 << 63
+	} else {
+		u = ^u
+	}
+	return math.Float64frombits(u), nil
+}
+
+// AppendSortableFloat32 appends the 4-byte sortable encoding of f to b and
+// returns the extended buffer.
+func AppendSortableFloat32(b []byte, f float32) []byte {
+	u := math.Float32bits(f)
+	if u>>31 != 0 {
+		u = ^u
+	} else {
+		u |= 1 << 31
+	}
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], u)
+	return append(b, buf[:]...)
+}
+
+// DecodeSortableFloat32 decodes the sortable encoding produced by
+// AppendSortableFloat32. It returns an error if b is not exactly 4 bytes long.
+func DecodeSortableFloat32(b []byte) (float32, error) {
+	if len(b) != 4 {
+		return 0, fmt.Errorf("ryu: sortable float32 encoding has length %d; want 4", len(b))
+	}
+	u := binary.BigEndian.Uint32(b)
+	if u>>31 != 0 {
+		u &^= 1 << 31
+	} else {
+		u = ^u
+	}
+	return math.Float32frombits(u), nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestSortableRoundTrip(t *testing.T) {
+	vals := append(genericTestCases, float64TestCases...)
+	for i := 0; i < 1e4; i++ {
+		vals = append(vals, math.Float64frombits(rand.Uint64()))
+	}
+	for _, f := range vals {
+		b := AppendSortableFloat64(nil, f)
+		got, err := DecodeSortableFloat64(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Float64bits(got) != math.Float64bits(f) {
+			t.Errorf("DecodeSortableFloat64(AppendSortableFloat64(%g)): got %g", f, got)
+		}
+		f32 := float32(f)
+		got32, err := DecodeSortableFloat32(AppendSortableFloat32(nil, f32))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Float32bits(got32) != math.Float32bits(f32) {
+			t.Errorf("DecodeSortableFloat32(AppendSortableFloat32(%g)): got %g", f32, got32)
+		}
+	}
+}
+
+func TestSortableOrder(t *testing.T) {
+	var vals []float64
+	for i := 0; i < 1e4; i++ {
+		f := math.Float64frombits(rand.Uint64())
+		if !math.IsNaN(f) {
+			vals = append(vals, f)
+		}
+	}
+	vals = append(vals, math.Inf(1), math.Inf(-1), 0, math.Copysign(0, -1), 5e-324, -5e-324)
+	sort.Slice(vals, func(i, j int) bool {
+		if vals[i] == vals[j] {
+			return math.Signbit(vals[i]) && !math.Signbit(vals[j])
+		}
+		return vals[i] < vals[j]
+	})
+	for i := 1; i < len(vals); i++ {
+		a := AppendSortableFloat64(nil, vals[i-1])
+		b := AppendSortableFloat64(nil, vals[i])
+		if bytes.Compare(a, b) > 0 {
+			t.Errorf("encoding of %g sorts after encoding of %g", vals[i-1], vals[i])
+		}
+	}
+}
+
+func TestSortableMalformed(t *testing.T) {
+	for _, b := range [][]byte{nil, make([]byte, 7), make([]byte, 9)} {
+		if _, err := DecodeSortableFloat64(b); err == nil {
+			t.Errorf("DecodeSortableFloat64(%d bytes): got nil error", len(b))
+		}
+	}
+	if _, err := DecodeSortableFloat32(make([]byte, 8)); err == nil {
+		t.Error("DecodeSortableFloat32(8 bytes): got nil error")
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+// Specials declares the spellings of infinity and NaN that a Parser accepts.
+// Every other spelling is a syntax error. The zero value accepts none, which
+// is right for formats such as JSON that have no special values.
+//
+// An infinity may be preceded by a sign; a NaN may not. If several
+// spellings match the start of the input, the longest one is used, so
+// "infinity" is not cut short by "inf".
+type Specials struct {
+	// Inf lists the spellings of positive infinity.
+	Inf []string
+	// NaN lists the spellings of NaN. A parsed NaN is the canonical
+	// quiet NaN of the result format.
+	NaN []string
+	// IgnoreCase makes the spellings match without regard to ASCII case.
+	IgnoreCase bool
+}
+
+// Predefined sets of spellings for common formats.
+var (
+	// StrconvSpecials accepts the spellings of strconv.ParseFloat:
+	// "inf", "infinity", and "nan" in any case.
+	StrconvSpecials = Specials{
+		Inf:        []string{"inf", "infinity"},
+		NaN:        []string{"nan"},
+		IgnoreCase: true,
+	}
+	// YAMLSpecials accepts the spellings of the YAML 1.2 core schema:
+	// ".inf", ".Inf", ".INF", ".nan", ".NaN", and ".NAN".
+	YAMLSpecials = Specials{
+		Inf: []string{".inf", ".Inf", ".INF"},
+		NaN: []string{".nan", ".NaN", ".NAN"},
+	}
+	// JavaScriptSpecials accepts the spellings of JavaScript and JSON5:
+	// "Infinity" and "NaN".
+	JavaScriptSpecials = Specials{
+		Inf: []string{"Infinity"},
+		NaN: []string{"NaN"},
+	}
+)
+
+// scan matches the longest spelling of a special value at the start of s and
+// returns its bits in the format described by info and the length of the
+// match. If nothing matches, n is 0.
+func (sp *Specials) scan(s string, info *floatInfo) (u uint64, n int) {
+	expMax := uint64(1)<<info.expBits - 1
+	if n = sp.match(s, sp.NaN); n > 0 {
+		return expMax<<info.mantBits | 1<<(info.mantBits-1), n
+	}
+	i := 0
+	neg := false
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		neg = s[0] == '-'
+		i++
+	}
+	if n = sp.match(s[i:], sp.Inf); n > 0 {
+		u = expMax << info.mantBits
+		if neg {
+			u |= 1 << (info.mantBits + info.expBits)
+		}
+		return u, i + n
+	}
+	return 0, 0
+}
+
+// match returns the length of the longest spelling that is a prefix of s.
+func (sp *Specials) match(s string, spellings []string) int {
+	n := 0
+	for _, w := range spellings {
+		if len(w) > n && len(w) <= len(s) && sp.equal(s[:len(w)], w) {
+			n = len(w)
+		}
+	}
+	return n
+}
+
+func (sp *Specials) equal(s, t string) bool {
+	if !sp.IgnoreCase {
+		return s == t
+	}
+	for i := 0; i < len(s); i++ {
+		if lower(s[i]) != lower(t[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func lower(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
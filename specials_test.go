@@ -0,0 +1,112 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestSpecialsStrconv(t *testing.T) {
+	p := Parser{Specials: StrconvSpecials}
+	for _, s := range []string{
+		"inf", "+Inf", "-INF", "infinity", "-Infinity", "+iNfInItY",
+		"nan", "NaN", "NAN",
+		"+nan", "-nan", "infinit", "infx", "in", "1.5",
+	} {
+		got, err := p.ParseFloat64(s)
+		want, wantErr := strconv.ParseFloat(s, 64)
+		if !sameError(err, wantErr) {
+			t.Errorf("ParseFloat64(%q): got error %v; want %v", s, err, wantErr)
+		} else if got != want && !(math.IsNaN(got) && math.IsNaN(want)) {
+			t.Errorf("ParseFloat64(%q): got %g; want %g", s, got, want)
+		}
+		got32, err := p.ParseFloat32(s)
+		want, wantErr = strconv.ParseFloat(s, 32)
+		if !sameError(err, wantErr) {
+			t.Errorf("ParseFloat32(%q): got error %v; want %v", s, err, wantErr)
+		} else if got32 != float32(want) && !(got32 != got32 && math.IsNaN(want)) {
+			t.Errorf("ParseFloat32(%q): got %g; want %g", s, got32, want)
+		}
+	}
+	if f, err := p.ParseFloat64("nan"); err != nil || math.Float64bits(f) != 0x7ff8000000000000 {
+		t.Errorf("ParseFloat64(nan): got (%#x, %v); want 0x7ff8000000000000", math.Float64bits(f), err)
+	}
+	if f, err := p.ParseFloat32("nan"); err != nil || math.Float32bits(f) != 0x7fc00000 {
+		t.Errorf("ParseFloat32(nan): got (%#x, %v); want 0x7fc00000", math.Float32bits(f), err)
+	}
+}
+
+func TestSpecialsFormats(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		sp     Specials
+		accept []string
+		reject []string
+	}{
+		{
+			name:   "zero",
+			sp:     Specials{},
+			reject: []string{"inf", "Infinity", "NaN", ".inf"},
+		},
+		{
+			name:   "YAML",
+			sp:     YAMLSpecials,
+			accept: []string{".inf", "+.Inf", "-.INF", ".nan", ".NaN", ".NAN"},
+			reject: []string{"inf", ".iNf", ".Nan", "-.nan", "NaN"},
+		},
+		{
+			name:   "JavaScript",
+			sp:     JavaScriptSpecials,
+			accept: []string{"Infinity", "-Infinity", "NaN"},
+			reject: []string{"inf", "infinity", "nan", "-NaN"},
+		},
+	} {
+		p := Parser{Specials: tt.sp}
+		for _, s := range tt.accept {
+			if f, err := p.ParseFloat64(s); err != nil || !(math.IsInf(f, 0) || math.IsNaN(f)) {
+				t.Errorf("%s: ParseFloat64(%q): got (%g, %v); want special value", tt.name, s, f, err)
+			}
+		}
+		for _, s := range tt.reject {
+			if _, err := p.ParseFloat64(s); numErr(err) != strconv.ErrSyntax {
+				t.Errorf("%s: ParseFloat64(%q): got error %v; want syntax error", tt.name, s, err)
+			}
+		}
+	}
+}
+
+func TestSpecialsPrefix(t *testing.T) {
+	p := Parser{Specials: StrconvSpecials}
+	for _, tt := range []struct {
+		s       string
+		want    float64
+		n       int
+		wantErr error
+	}{
+		{"infinity]", math.Inf(1), 8, nil},
+		{"-infinit", math.Inf(-1), 4, nil},
+		{"Inf,1", math.Inf(1), 3, nil},
+		// Only an overflowing number is a range error.
+		{"1e500x", math.Inf(1), 5, strconv.ErrRange},
+	} {
+		f, n, err := p.ParseFloat64Prefix([]byte(tt.s))
+		if f != tt.want || n != tt.n || numErr(err) != tt.wantErr {
+			t.Errorf("ParseFloat64Prefix(%q): got (%g, %d, %v); want (%g, %d, %v)", tt.s, f, n, err, tt.want, tt.n, tt.wantErr)
+		}
+	}
+	if _, n, err := p.ParseFloat64Prefix([]byte("nanx")); n != 3 || err != nil {
+		t.Errorf("ParseFloat64Prefix(nanx): got (%d, %v); want (3, nil)", n, err)
+	}
+}
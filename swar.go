@@ -0,0 +1,57 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "math/bits"
+
+// The parser scans runs of digits 8 bytes at a time using SWAR (SIMD within
+// a register) techniques: the bytes are loaded into a uint64 in little-endian
+// order, so that the first character is the low byte, and are then checked
+// and converted with a few word-sized operations.
+
+// load8 returns s[i:i+8] as a little-endian uint64.
+func load8(s string, i int) uint64 {
+	_ = s[i+7]
+	return uint64(s[i]) | uint64(s[i+1])<<8 | uint64(s[i+2])<<16 | uint64(s[i+3])<<24 |
+		uint64(s[i+4])<<32 | uint64(s[i+5])<<40 | uint64(s[i+6])<<48 | uint64(s[i+7])<<56
+}
+
+// is8Digits reports whether all 8 bytes of x are ASCII digits.
+func is8Digits(x uint64) bool {
+	// Each byte must be 0x3_, and adding 6 must not carry it out of 0x3_.
+	return x&0xf0f0f0f0f0f0f0f0 == 0x3030303030303030 &&
+		(x+0x0606060606060606)&0xf0f0f0f0f0f0f0f0 == 0x3030303030303030
+}
+
+// parse8Digits returns the value of the 8 ASCII digits in x, where the first
+// digit is the low byte.
+func parse8Digits(x uint64) uint32 {
+	x -= 0x3030303030303030
+	// Combine pairs of digits into 2-digit numbers in the even bytes, then
+	// combine those four numbers with two multiplications whose sums
+	// accumulate in the high 32 bits.
+	x = (x * 10) + (x >> 8)
+	x = ((x&0x000000ff000000ff)*(100+1000000<<32) +
+		((x>>16)&0x000000ff000000ff)*(1+10000<<32)) >> 32
+	return uint32(x)
+}
+
+// zeroDigits returns the number of leading and trailing '0' characters among
+// the 8 ASCII digits in x.
+func zeroDigits(x uint64) (leading, trailing int) {
+	x -= 0x3030303030303030
+	if x == 0 {
+		return 8, 8
+	}
+	return bits.TrailingZeros64(x) / 8, bits.LeadingZeros64(x) / 8
+}
@@ -0,0 +1,86 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestSWARDigits(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1e5; i++ {
+		v := r.Intn(1e8)
+		s := fmt.Sprintf("%08d", v)
+		x := load8(s, 0)
+		if !is8Digits(x) {
+			t.Fatalf("is8Digits(%q) = false", s)
+		}
+		if got := parse8Digits(x); got != uint32(v) {
+			t.Fatalf("parse8Digits(%q): got %d", s, got)
+		}
+		for j := 0; j < 8; j++ {
+			for _, c := range []byte{'/', ':', 0, 0xb0, 'a', '.'} {
+				b := []byte(s)
+				b[j] = c
+				if is8Digits(load8(string(b), 0)) {
+					t.Fatalf("is8Digits(%q) = true", b)
+				}
+			}
+		}
+	}
+	for _, tt := range []struct {
+		s                 string
+		leading, trailing int
+	}{
+		{"00000000", 8, 8},
+		{"00100000", 2, 5},
+		{"12345678", 0, 0},
+		{"00000001", 7, 0},
+	} {
+		if l, tr := zeroDigits(load8(tt.s, 0)); l != tt.leading || tr != tt.trailing {
+			t.Errorf("zeroDigits(%q): got (%d, %d); want (%d, %d)", tt.s, l, tr, tt.leading, tt.trailing)
+		}
+	}
+}
+
+// TestScanDecimalSWAR checks that scanning 8 digits at a time gives the same
+// results as the byte-wise loop, which a Parser uses when underscores are
+// enabled.
+func TestScanDecimalSWAR(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	bytewise := Parser{Underscores: true}
+	const alphabet = "00000000123456789"
+	for i := 0; i < 1e5; i++ {
+		var b []byte
+		n := 1 + r.Intn(40)
+		dot := r.Intn(n + 5)
+		for j := 0; j < n; j++ {
+			if j == dot {
+				b = append(b, '.')
+			}
+			b = append(b, alphabet[r.Intn(len(alphabet))])
+		}
+		if r.Intn(2) == 0 {
+			b = strconv.AppendInt(append(b, 'e'), int64(r.Intn(40)-20), 10)
+		}
+		s := string(b)
+		d, n, tooLong := stdParser.scanDecimal(s)
+		wd, wn, wtooLong := bytewise.scanDecimal(s)
+		if d != wd || n != wn || tooLong != wtooLong {
+			t.Fatalf("scanDecimal(%q): got (%+v, %d, %t); want (%+v, %d, %t)", s, d, n, tooLong, wd, wn, wtooLong)
+		}
+	}
+}
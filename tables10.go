@@ -0,0 +1,702 @@
+//go:build !ryu_compact
+// +build !ryu_compact
+
+// Code generated by running "go generate". DO NOT EDIT.
+
+// Copyright 2020 The Go Authors. All rights reserved.
+// Modifications copyright 2019 Caleb Spare
+//
+// The table in this file is that of the Eisel–Lemire implementation of the
+// Go standard library's strconv package, which may be found at
+// https://go.dev/src/strconv/eisel_lemire.go. That source code is licensed
+// under the following BSD-style license and this code is derivative work
+// thereof:
+//
+//	Copyright 2009 The Go Authors.
+//
+//	Redistribution and use in source and binary forms, with or without
+//	modification, are permitted provided that the following conditions are
+//	met:
+//
+//	   * Redistributions of source code must retain the above copyright
+//	notice, this list of conditions and the following disclaimer.
+//	   * Redistributions in binary form must reproduce the above
+//	copyright notice, this list of conditions and the following disclaimer
+//	in the documentation and/or other materials provided with the
+//	distribution.
+//	   * Neither the name of Google LLC nor the names of its
+//	contributors may be used to endorse or promote products derived from
+//	this software without specific prior written permission.
+//
+//	THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+//	"AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+//	LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+//	A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+//	OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+//	SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+//	LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+//	DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+//	THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+//	(INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//	OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package ryu
+
+const (
+	pow10MinExp10 = -342
+	pow10MaxExp10 = 308
+)
+
+var pow10Split10 = [...]uint128{
+	{1242899115359157055, 17218479456385750618},
+	{5388497965526861063, 10761549660241094136},
+	{6735622456908576329, 13451937075301367670},
+	{17642900107990496220, 16814921344126709587},
+	{8720969558280366185, 10509325840079193492},
+	{10901211947850457732, 13136657300098991865},
+	{18238200953240460069, 16420821625123739831},
+	{18316404623416369399, 10263013515702337394},
+	{13672133742415685941, 12828766894627921743},
+	{12478481159592219522, 16035958618284902179},
+	{5493207715531443249, 10022474136428063862},
+	{16089881681269079869, 12528092670535079827},
+	{15500666083158961933, 15660115838168849784},
+	{9687916301974351208, 9787572398855531115},
+	{7498209359040551106, 12234465498569413894},
+	{149389661945913074, 15293081873211767368},
+	{93368538716195671, 9558176170757354605},
+	{4728396691822632493, 11947720213446693256},
+	{5910495864778290617, 14934650266808366570},
+	{8305745933913819539, 9334156416755229106},
+	{1158810380537498616, 11667695520944036383},
+	{15283571030954036982, 14584619401180045478},
+	{9881091751837770420, 18230774251475056848},
+	{6175682344898606512, 11394233907171910530},
+	{16942974967978033949, 14242792383964888162},
+	{11955346673117766628, 17803490479956110203},
+	{5166248661484910190, 11127181549972568877},
+	{11069496845283525642, 13908976937465711096},
+	{13836871056604407053, 17386221171832138870},
+	{4036358391950366504, 10866388232395086794},
+	{14268820026792733938, 13582985290493858492},
+	{17836025033490917422, 16978731613117323115},
+	{8841672636718129437, 10611707258198326947},
+	{6440404777470273892, 13264634072747908684},
+	{8050505971837842365, 16580792590934885855},
+	{11949095260039733334, 10362995369334303659},
+	{10324683056622278764, 12953744211667879574},
+	{3682481783923072647, 16192180264584849468},
+	{11524923151806696212, 10120112665365530917},
+	{571095884476206553, 12650140831706913647},
+	{14548927910877421904, 15812676039633642058},
+	{13704765962725776594, 9882922524771026286},
+	{7907585416552444934, 12353653155963782858},
+	{661109733835780360, 15442066444954728573},
+	{2719036592861056677, 9651291528096705358},
+	{12622167777931096654, 12064114410120881697},
+	{1942651667131707105, 15080143012651102122},
+	{5825843310384704845, 9425089382906938826},
+	{16505676174835656864, 11781361728633673532},
+	{2185351144835019464, 14726702160792091916},
+	{2731688931043774330, 18408377700990114895},
+	{8624834609543440812, 11505236063118821809},
+	{15392729280356688919, 14381545078898527261},
+	{5405853545163697437, 17976931348623159077},
+	{5684501474941004850, 11235582092889474423},
+	{2493940825248868159, 14044477616111843029},
+	{7729112049988473103, 17555597020139803786},
+	{9442381049670183593, 10972248137587377366},
+	{2579604275232953683, 13715310171984221708},
+	{3224505344041192104, 17144137714980277135},
+	{8932844867666826921, 10715086071862673209},
+	{15777742103010921555, 13393857589828341511},
+	{15110491610336264040, 16742321987285426889},
+	{2526528228819083169, 10463951242053391806},
+	{12381532322878629770, 13079939052566739757},
+	{1641857348316123500, 16349923815708424697},
+	{12555375888766046947, 10218702384817765435},
+	{11082533842530170780, 12773377981022206794},
+	{4629795266307937667, 15966722476277758493},
+	{5199465050656154994, 9979201547673599058},
+	{15722703350174969551, 12474001934591998822},
+	{10430007150863936130, 15592502418239998528},
+	{6518754469289960081, 9745314011399999080},
+	{8148443086612450102, 12181642514249998850},
+	{962181821410786819, 15227053142812498563},
+	{16742264702877599426, 9516908214257811601},
+	{7092772823314835570, 11896135267822264502},
+	{18089338065998320271, 14870169084777830627},
+	{8999993282035256217, 9293855677986144142},
+	{2026619565689294464, 11617319597482680178},
+	{11756646493966393888, 14521649496853350222},
+	{5472436080603216552, 18152061871066687778},
+	{8031958568804398249, 11345038669416679861},
+	{14651634229432885715, 14181298336770849826},
+	{9091170749936331336, 17726622920963562283},
+	{3376138709496513133, 11079139325602226427},
+	{18055231442152805128, 13848924157002783033},
+	{8733981247408842698, 17311155196253478792},
+	{5458738279630526686, 10819471997658424245},
+	{11435108867965546262, 13524339997073030306},
+	{5070514048102157020, 16905424996341287883},
+	{863228270850154185, 10565890622713304927},
+	{14914093393844856443, 13207363278391631158},
+	{9419244705451294746, 16509204097989538948},
+	{15110399977761835024, 10318252561243461842},
+	{9664627935347517973, 12897815701554327303},
+	{7469098900757009562, 16122269626942909129},
+	{16197401859041600736, 10076418516839318205},
+	{6411694268519837208, 12595523146049147757},
+	{12626303854077184414, 15744403932561434696},
+	{7891439908798240259, 9840252457850896685},
+	{14475985904425188227, 12300315572313620856},
+	{18094982380531485284, 15375394465392026070},
+	{6697677969404790399, 9609621540870016294},
+	{17595469498610763806, 12012026926087520367},
+	{17382650854836066854, 15015033657609400459},
+	{8558313775058847832, 9384396036005875287},
+	{6086206200396171886, 11730495045007344109},
+	{12219443768922602761, 14663118806259180136},
+	{15274304711153253452, 18328898507823975170},
+	{14158126462898171311, 11455561567389984481},
+	{3862600023340550427, 14319451959237480602},
+	{14051622066030463842, 17899314949046850752},
+	{8782263791269039901, 11187071843154281720},
+	{10977829739086299876, 13983839803942852150},
+	{4498915137003099037, 17479799754928565188},
+	{12035193997481712706, 10924874846830353242},
+	{5820620459997365075, 13656093558537941553},
+	{11887461593424094248, 17070116948172426941},
+	{9735506505103752857, 10668823092607766838},
+	{2946011094524915263, 13336028865759708548},
+	{3682513868156144079, 16670036082199635685},
+	{4607414176811284001, 10418772551374772303},
+	{1147581702586717097, 13023465689218465379},
+	{15269535183515560084, 16279332111523081723},
+	{7237616480483531100, 10174582569701926077},
+	{13658706619031801779, 12718228212127407596},
+	{17073383273789752224, 15897785265159259495},
+	{17588393573759676996, 9936115790724537184},
+	{3538747893490044629, 12420144738405671481},
+	{9035120885289943691, 15525180923007089351},
+	{12564479580947296663, 9703238076879430844},
+	{15705599476184120828, 12129047596099288555},
+	{15020313326802763131, 15161309495124110694},
+	{4776009810824339053, 9475818434452569184},
+	{5970012263530423816, 11844773043065711480},
+	{7462515329413029771, 14805966303832139350},
+	{52386062455755702, 9253728939895087094},
+	{9288854614924470436, 11567161174868858867},
+	{6999382250228200141, 14458951468586073584},
+	{8749227812785250177, 18073689335732591980},
+	{14691639419845557168, 11296055834832869987},
+	{13752863256379558556, 14120069793541087484},
+	{17191079070474448196, 17650087241926359355},
+	{8438581409832836170, 11031304526203974597},
+	{15159912780718433117, 13789130657754968246},
+	{9726518939043265588, 17236413322193710308},
+	{15302446373756816800, 10772758326371068942},
+	{9904685930341245193, 13465947907963836178},
+	{3157485376071780683, 16832434884954795223},
+	{8890957387685944783, 10520271803096747014},
+	{1890324697752655170, 13150339753870933768},
+	{2362905872190818963, 16437924692338667210},
+	{6088502188546649756, 10273702932711667006},
+	{16833999772538088003, 12842128665889583757},
+	{7207441660390446292, 16052660832361979697},
+	{16033866083812498692, 10032913020226237310},
+	{10818960567910847557, 12541141275282796638},
+	{4300328673033783639, 15676426594103495798},
+	{16522763475928278486, 9797766621314684873},
+	{6818396289628184396, 12247208276643356092},
+	{8522995362035230495, 15309010345804195115},
+	{3021029092058325107, 9568131466127621947},
+	{17611344420355070096, 11960164332659527433},
+	{8179122470161673908, 14950205415824409292},
+	{14335323580705822000, 9343878384890255807},
+	{13307468457454889596, 11679847981112819759},
+	{12022649553391224092, 14599809976391024699},
+	{10416625923311642211, 18249762470488780874},
+	{11122077220497164286, 11406101544055488046},
+	{4679224488766679549, 14257626930069360058},
+	{15072402647813125244, 17822033662586700072},
+	{9420251654883203278, 11138771039116687545},
+	{16387000587031392001, 13923463798895859431},
+	{15872064715361852097, 17404329748619824289},
+	{3002511419460075705, 10877706092887390181},
+	{8364825292752482535, 13597132616109237726},
+	{1232659579085827361, 16996415770136547158},
+	{14605470292210805812, 10622759856335341973},
+	{4421779809981343554, 13278449820419177467},
+	{915538744049291538, 16598062275523971834},
+	{5183897733458195115, 10373788922202482396},
+	{6479872166822743894, 12967236152753102995},
+	{3488154190101041964, 16209045190941378744},
+	{2180096368813151227, 10130653244338361715},
+	{16560178516298602746, 12663316555422952143},
+	{16088537126945865529, 15829145694278690179},
+	{7749492695127472003, 9893216058924181362},
+	{463493832054564196, 12366520073655226703},
+	{14414425345350368957, 15458150092069033378},
+	{13620701859271368502, 9661343807543145861},
+	{3190819268807046916, 12076679759428932327},
+	{17823582141290972357, 15095849699286165408},
+	{11139738838306857723, 9434906062053853380},
+	{13924673547883572154, 11793632577567316725},
+	{3570783879572301480, 14742040721959145907},
+	{18298537904747540562, 18427550902448932383},
+	{18354115218108294707, 11517219314030582739},
+	{18330958004207980480, 14396524142538228424},
+	{4466953431550423984, 17995655178172785531},
+	{486002885505321038, 11247284486357990957},
+	{5219189625309039202, 14059105607947488696},
+	{6523987031636299002, 17573882009934360870},
+	{17912549950054850588, 10983676256208975543},
+	{17779001419141175331, 13729595320261219429},
+	{8388693718644305452, 17161994150326524287},
+	{12160462601793772764, 10726246343954077679},
+	{10588892233814828051, 13407807929942597099},
+	{8624429273841147159, 16759759912428246374},
+	{778582277723329070, 10474849945267653984},
+	{973227847154161338, 13093562431584567480},
+	{1216534808942701673, 16366953039480709350},
+	{14595392310871352257, 10229345649675443343},
+	{13632554370161802418, 12786682062094304179},
+	{12429006944274865118, 15983352577617880224},
+	{7768129340171790699, 9989595361011175140},
+	{9710161675214738374, 12486994201263968925},
+	{16749388112445810871, 15608742751579961156},
+	{1244995533423855986, 9755464219737475723},
+	{15391302472061983695, 12194330274671844653},
+	{5404070034795315907, 15242912843339805817},
+	{14906758817815542202, 9526820527087378635},
+	{14021762503842039848, 11908525658859223294},
+	{8303831092947774002, 14885657073574029118},
+	{578208414664970847, 9303535670983768199},
+	{14557818573613377271, 11629419588729710248},
+	{18197273217016721589, 14536774485912137810},
+	{13523219484416126178, 18170968107390172263},
+	{15369541205401160717, 11356855067118857664},
+	{765182433041899281, 14196068833898572081},
+	{5568164059729762005, 17745086042373215101},
+	{5785945546544795205, 11090678776483259438},
+	{16455803970035769814, 13863348470604074297},
+	{6734696907262548556, 17329185588255092872},
+	{4209185567039092847, 10830740992659433045},
+	{9873167977226253963, 13538426240824291306},
+	{3118087934678041646, 16923032801030364133},
+	{4254647968387469981, 10576895500643977583},
+	{706623942056949572, 13221119375804971979},
+	{14718337982853350677, 16526399219756214973},
+	{11504804248497038125, 10328999512347634358},
+	{5157633273766521849, 12911249390434542948},
+	{6447041592208152311, 16139061738043178685},
+	{6335244004343789146, 10086913586276986678},
+	{17142427042284512241, 12608641982846233347},
+	{16816347784428252397, 15760802478557791684},
+	{1286845328412881940, 9850501549098619803},
+	{15443614715798266137, 12313126936373274753},
+	{5469460339465668959, 15391408670466593442},
+	{8030098730593431003, 9619630419041620901},
+	{14649309431669176658, 12024538023802026126},
+	{9088264752731695015, 15030672529752532658},
+	{10291851488884697288, 9394170331095332911},
+	{8253128342678483706, 11742712913869166139},
+	{5704724409920716729, 14678391142336457674},
+	{16354277549255671720, 18347988927920572092},
+	{998051431430019017, 11467493079950357558},
+	{10470936326142299579, 14334366349937946947},
+	{8476984389250486570, 17917957937422433684},
+	{14521487280136329914, 11198723710889021052},
+	{18151859100170412392, 13998404638611276315},
+	{18078137856785627587, 17498005798264095394},
+	{15910522178918405146, 10936253623915059621},
+	{6053094668365842720, 13670317029893824527},
+	{2954682317029915496, 17087896287367280659},
+	{17987577512639554849, 10679935179604550411},
+	{17872785872372055657, 13349918974505688014},
+	{13117610303610293764, 16687398718132110018},
+	{12810192458183821506, 10429624198832568761},
+	{2177682517447613171, 13037030248540710952},
+	{2722103146809516464, 16296287810675888690},
+	{6313000485183335694, 10185179881672430431},
+	{3279564588051781713, 12731474852090538039},
+	{17934513790346890853, 15914343565113172548},
+	{1985699082112030975, 9946464728195732843},
+	{16317181907922202431, 12433080910244666053},
+	{6561419329620589327, 15541351137805832567},
+	{11018416108653950185, 9713344461128645354},
+	{4549648098962661924, 12141680576410806693},
+	{10298746142130715309, 15177100720513508366},
+	{1825030320404309164, 9485687950320942729},
+	{6892973918932774359, 11857109937901178411},
+	{4004531380238580045, 14821387422376473014},
+	{16337890167931276240, 9263367138985295633},
+	{6587304654631931588, 11579208923731619542},
+	{17457502855144690293, 14474011154664524427},
+	{17210192550503474962, 18092513943330655534},
+	{6144684325637283947, 11307821214581659709},
+	{12292541425473992838, 14134776518227074636},
+	{15365676781842491048, 17668470647783843295},
+	{16521077016292638761, 11042794154864902059},
+	{16039660251938410547, 13803492693581127574},
+	{10826203278068237376, 17254365866976409468},
+	{15989749085647424168, 10783978666860255917},
+	{6152128301777116498, 13479973333575319897},
+	{12301846395648783526, 16849966666969149871},
+	{14606183024921571560, 10531229166855718669},
+	{4422670725869800738, 13164036458569648337},
+	{10140024425764638826, 16455045573212060421},
+	{8643358275316593218, 10284403483257537763},
+	{6192511825718353619, 12855504354071922204},
+	{7740639782147942024, 16069380442589902755},
+	{2532056854628769813, 10043362776618689222},
+	{12388443105140738074, 12554203470773361527},
+	{10873867862998534689, 15692754338466701909},
+	{9102010423587778132, 9807971461541688693},
+	{15989199047912110569, 12259964326927110866},
+	{10763126773035362404, 15324955408658888583},
+	{13644483260788183358, 9578097130411805364},
+	{17055604075985229198, 11972621413014756705},
+	{7484447039699372786, 14965776766268445882},
+	{9289465418239495895, 9353610478917778676},
+	{11611831772799369869, 11692013098647223345},
+	{679731660717048624, 14615016373309029182},
+	{10073036612751086588, 18268770466636286477},
+	{8601490892183123069, 11417981541647679048},
+	{10751863615228903837, 14272476927059598810},
+	{4216457482181353988, 17840596158824498513},
+	{14164500972431816002, 11150372599265311570},
+	{8482254178684994195, 13937965749081639463},
+	{5991131704928854840, 17422457186352049329},
+	{15273672361649004035, 10889035741470030830},
+	{9868718415206479236, 13611294676837538538},
+	{3112525982153323237, 17014118346046923173},
+	{4251171748059520975, 10633823966279326983},
+	{702278666647013314, 13292279957849158729},
+	{5489534351736154547, 16615349947311448411},
+	{1125115960621402640, 10384593717069655257},
+	{6018080969204141204, 12980742146337069071},
+	{2910915193077788601, 16225927682921336339},
+	{17960223060169475539, 10141204801825835211},
+	{17838592806784456520, 12676506002282294014},
+	{13074868971625794843, 15845632502852867518},
+	{3560107088838733872, 9903520314283042199},
+	{18285191916330581053, 12379400392853802748},
+	{4409745821703674700, 15474250491067253436},
+	{11979463175419572495, 9671406556917033397},
+	{1139270913992301907, 12089258196146291747},
+	{15259146697772541096, 15111572745182864683},
+	{7231123676894144233, 9444732965739290427},
+	{4427218577690292387, 11805916207174113034},
+	{14757395258967641292, 14757395258967641292},
+	{0, 9223372036854775808},
+	{0, 11529215046068469760},
+	{0, 14411518807585587200},
+	{0, 18014398509481984000},
+	{0, 11258999068426240000},
+	{0, 14073748835532800000},
+	{0, 17592186044416000000},
+	{0, 10995116277760000000},
+	{0, 13743895347200000000},
+	{0, 17179869184000000000},
+	{0, 10737418240000000000},
+	{0, 13421772800000000000},
+	{0, 16777216000000000000},
+	{0, 10485760000000000000},
+	{0, 13107200000000000000},
+	{0, 16384000000000000000},
+	{0, 10240000000000000000},
+	{0, 12800000000000000000},
+	{0, 16000000000000000000},
+	{0, 10000000000000000000},
+	{0, 12500000000000000000},
+	{0, 15625000000000000000},
+	{0, 9765625000000000000},
+	{0, 12207031250000000000},
+	{0, 15258789062500000000},
+	{0, 9536743164062500000},
+	{0, 11920928955078125000},
+	{0, 14901161193847656250},
+	{4611686018427387904, 9313225746154785156},
+	{5764607523034234880, 11641532182693481445},
+	{11817445422220181504, 14551915228366851806},
+	{5548434740920451072, 18189894035458564758},
+	{17302829768357445632, 11368683772161602973},
+	{7793479155164643328, 14210854715202003717},
+	{14353534962383192064, 17763568394002504646},
+	{4359273333062107136, 11102230246251565404},
+	{5449091666327633920, 13877787807814456755},
+	{2199678564482154496, 17347234759768070944},
+	{1374799102801346560, 10842021724855044340},
+	{1718498878501683200, 13552527156068805425},
+	{6759809616554491904, 16940658945086006781},
+	{6530724019560251392, 10587911840678754238},
+	{17386777061305090048, 13234889800848442797},
+	{7898413271349198848, 16543612251060553497},
+	{16465723340661719040, 10339757656912845935},
+	{15970468157399760896, 12924697071141057419},
+	{15351399178322313216, 16155871338926321774},
+	{4982938468024057856, 10097419586828951109},
+	{10840359103457460224, 12621774483536188886},
+	{4327076842467049472, 15777218104420236108},
+	{11927795063396681728, 9860761315262647567},
+	{10298057810818464256, 12325951644078309459},
+	{8260886245095692416, 15407439555097886824},
+	{5163053903184807760, 9629649721936179265},
+	{11065503397408397604, 12037062152420224081},
+	{18443565265187884909, 15046327690525280101},
+	{13833071299956122020, 9403954806578300063},
+	{12679653106517764621, 11754943508222875079},
+	{11237880364719817872, 14693679385278593849},
+	{212292400617608628, 18367099231598242312},
+	{132682750386005392, 11479437019748901445},
+	{4777539456409894645, 14349296274686126806},
+	{15195296357367144114, 17936620343357658507},
+	{7191217214140771119, 11210387714598536567},
+	{4377335499248575995, 14012984643248170709},
+	{10083355392488107898, 17516230804060213386},
+	{10913783138732455340, 10947644252537633366},
+	{4418856886560793367, 13684555315672041708},
+	{5523571108200991709, 17105694144590052135},
+	{10369760970266701674, 10691058840368782584},
+	{12962201212833377092, 13363823550460978230},
+	{6979379479186945558, 16704779438076222788},
+	{13585484211346616781, 10440487148797639242},
+	{7758483227328495169, 13050608935997049053},
+	{14309790052588006865, 16313261169996311316},
+	{18166990819722280098, 10195788231247694572},
+	{4261994450943298507, 12744735289059618216},
+	{5327493063679123134, 15930919111324522770},
+	{7941369183226839863, 9956824444577826731},
+	{5315025460606161924, 12446030555722283414},
+	{15867153862612478214, 15557538194652854267},
+	{7611128154919104931, 9723461371658033917},
+	{14125596212076269068, 12154326714572542396},
+	{17656995265095336336, 15192908393215677995},
+	{8729779031470891258, 9495567745759798747},
+	{6300537770911226168, 11869459682199748434},
+	{17099044250493808518, 14836824602749685542},
+	{6075216638131242420, 9273015376718553464},
+	{7594020797664053025, 11591269220898191830},
+	{269153960225290473, 14489086526122739788},
+	{336442450281613091, 18111358157653424735},
+	{7127805559067090038, 11319598848533390459},
+	{4298070930406474644, 14149498560666738074},
+	{14595960699862869113, 17686873200833422592},
+	{9122475437414293195, 11054295750520889120},
+	{11403094296767866494, 13817869688151111400},
+	{14253867870959833118, 17272337110188889250},
+	{13520353437777283602, 10795210693868055781},
+	{3065383741939440791, 13494013367335069727},
+	{17666787732706464701, 16867516709168837158},
+	{6430056314514152534, 10542197943230523224},
+	{8037570393142690668, 13177747429038154030},
+	{823590954573587527, 16472184286297692538},
+	{5126430365035880108, 10295115178936057836},
+	{6408037956294850135, 12868893973670072295},
+	{3398361426941174765, 16086117467087590369},
+	{13653190937906703988, 10053823416929743980},
+	{17066488672383379985, 12567279271162179975},
+	{16721424822051837077, 15709099088952724969},
+	{3533361486141316317, 9818186930595453106},
+	{13640073894531421205, 12272733663244316382},
+	{7826720331309500698, 15340917079055395478},
+	{280014188641050032, 9588073174409622174},
+	{9573389772656088348, 11985091468012027717},
+	{16578423234247498339, 14981364335015034646},
+	{5749828502977298558, 9363352709384396654},
+	{16410657665576399005, 11704190886730495817},
+	{6678264026688335045, 14630238608413119772},
+	{8347830033360418806, 18287798260516399715},
+	{2911550761636567802, 11429873912822749822},
+	{12862810488900485560, 14287342391028437277},
+	{2243455055843443238, 17859177988785546597},
+	{3708002419115845976, 11161986242990966623},
+	{23317005467419566, 13952482803738708279},
+	{13864204312116438170, 17440603504673385348},
+	{17888499731927549664, 10900377190420865842},
+	{13137252628054661272, 13625471488026082303},
+	{11809879766640938686, 17031839360032602879},
+	{14298703881791668535, 10644899600020376799},
+	{13261693833812197764, 13306124500025470999},
+	{11965431273837859301, 16632655625031838749},
+	{9784237555362356015, 10395409765644899218},
+	{3006924907348169211, 12994262207056124023},
+	{17593714189467375226, 16242827758820155028},
+	{1772699331562333708, 10151767349262596893},
+	{6827560182880305039, 12689709186578246116},
+	{8534450228600381299, 15862136483222807645},
+	{7639874402088932264, 9913835302014254778},
+	{326470965756389522, 12392294127517818473},
+	{5019774725622874806, 15490367659397273091},
+	{831516194300602802, 9681479787123295682},
+	{10262767279730529310, 12101849733904119602},
+	{3605087062808385830, 15127312167380149503},
+	{9170708441896323000, 9454570104612593439},
+	{6851699533943015846, 11818212630765741799},
+	{3952938399001381903, 14772765788457177249},
+	{13999801545444333449, 9232978617785735780},
+	{17499751931805416812, 11541223272232169725},
+	{8039631859474607303, 14426529090290212157},
+	{14661225842770647033, 18033161362862765196},
+	{18386638188586430203, 11270725851789228247},
+	{18371611717305649850, 14088407314736535309},
+	{9129456591349898601, 17610509143420669137},
+	{17235125415662156385, 11006568214637918210},
+	{12320534732722919674, 13758210268297397763},
+	{10788982397476261688, 17197762835371747204},
+	{15966486035277439363, 10748601772107342002},
+	{10734735507242023396, 13435752215134177503},
+	{8806733365625141341, 16794690268917721879},
+	{12421737381156795194, 10496681418073576174},
+	{6303799689591218185, 13120851772591970218},
+	{17103121648843798539, 16401064715739962772},
+	{1466078993672598279, 10250665447337476733},
+	{6444284760518135752, 12813331809171845916},
+	{8055355950647669691, 16016664761464807395},
+	{2728754459941099604, 10010415475915504622},
+	{12634315111781150314, 12513019344894380777},
+	{1957835834444274180, 15641274181117975972},
+	{10447019433382447170, 9775796363198734982},
+	{3835402254873283155, 12219745453998418728},
+	{4794252818591603944, 15274681817498023410},
+	{7608094030047140369, 9546676135936264631},
+	{4898431519131537557, 11933345169920330789},
+	{10734725417341809851, 14916681462400413486},
+	{2097517367411243253, 9322925914000258429},
+	{7233582727691441970, 11653657392500323036},
+	{9041978409614302462, 14567071740625403795},
+	{6690786993590490174, 18208839675781754744},
+	{4181741870994056359, 11380524797363596715},
+	{615491320315182544, 14225655996704495894},
+	{9992736187248753989, 17782069995880619867},
+	{3939617107816777291, 11113793747425387417},
+	{9536207403198359517, 13892242184281734271},
+	{7308573235570561493, 17365302730352167839},
+	{11485387299872682789, 10853314206470104899},
+	{9745048106413465582, 13566642758087631124},
+	{12181310133016831978, 16958303447609538905},
+	{695789805494438130, 10598939654755961816},
+	{869737256868047663, 13248674568444952270},
+	{10310543607939835386, 16560843210556190337},
+	{17973304801030866876, 10350527006597618960},
+	{4019886927579031980, 12938158758247023701},
+	{9636544677901177879, 16172698447808779626},
+	{10634526442115624078, 10107936529880487266},
+	{4069786015789754290, 12634920662350609083},
+	{475546501309804958, 15793650827938261354},
+	{4908902581746016003, 9871031767461413346},
+	{15359500264037295811, 12338789709326766682},
+	{9976003293191843956, 15423487136658458353},
+	{17764217104313372233, 9639679460411536470},
+	{12981899343536939483, 12049599325514420588},
+	{16227374179421174354, 15061999156893025735},
+	{17059637889779315827, 9413749473058141084},
+	{2877803288514593168, 11767186841322676356},
+	{3597254110643241460, 14708983551653345445},
+	{9108253656731439729, 18386229439566681806},
+	{1080972517029761926, 11491393399729176129},
+	{5962901664714590312, 14364241749661470161},
+	{12065313099320625794, 17955302187076837701},
+	{9846663696289085073, 11222063866923023563},
+	{7696643601933968437, 14027579833653779454},
+	{397432465562684739, 17534474792067224318},
+	{14083453346258841674, 10959046745042015198},
+	{8380944645968776284, 13698808431302518998},
+	{1252808770606194547, 17123510539128148748},
+	{10006377518483647400, 10702194086955092967},
+	{7896285879677171346, 13377742608693866209},
+	{14482043368023852087, 16722178260867332761},
+	{2133748077373825698, 10451361413042082976},
+	{2667185096717282123, 13064201766302603720},
+	{3333981370896602653, 16330252207878254650},
+	{6695424375237764562, 10206407629923909156},
+	{8369280469047205703, 12758009537404886445},
+	{15073286604736395033, 15947511921756108056},
+	{9420804127960246895, 9967194951097567535},
+	{7164319141522920715, 12458993688871959419},
+	{4343712908476262990, 15573742111089949274},
+	{7326506586225052273, 9733588819431218296},
+	{9158133232781315341, 12166986024289022870},
+	{2224294504121868368, 15208732530361278588},
+	{10613556101930943538, 9505457831475799117},
+	{17878631145841067327, 11881822289344748896},
+	{3901544858591782542, 14852277861680936121},
+	{13967680582688333849, 9282673663550585075},
+	{12847914709933029407, 11603342079438231344},
+	{16059893387416286759, 14504177599297789180},
+	{1628122660560806833, 18130221999122236476},
+	{10240948699705280078, 11331388749451397797},
+	{17412871893058988002, 14164235936814247246},
+	{12542717829468959195, 17705294921017809058},
+	{12450884661845487401, 11065809325636130661},
+	{1728547772024695539, 13832261657045163327},
+	{15995742770313033136, 17290327071306454158},
+	{5385653213018257806, 10806454419566533849},
+	{11343752534700210161, 13508068024458167311},
+	{9568004649947874797, 16885085030572709139},
+	{3674159897003727796, 10553178144107943212},
+	{4592699871254659745, 13191472680134929015},
+	{1129188820640936778, 16489340850168661269},
+	{3011586022114279438, 10305838031355413293},
+	{8376168546070237202, 12882297539194266616},
+	{10470210682587796502, 16102871923992833270},
+	{1932195658189984910, 10064294952495520794},
+	{11638616609592256945, 12580368690619400992},
+	{14548270761990321182, 15725460863274251240},
+	{9092669226243950738, 9828413039546407025},
+	{15977522551232326327, 12285516299433008781},
+	{6136845133758244197, 15356895374291260977},
+	{15364743254667372383, 9598059608932038110},
+	{9982557031479439671, 11997574511165047638},
+	{3254824252494523781, 14996968138956309548},
+	{11257637194663853171, 9373105086847693467},
+	{9460360474902428559, 11716381358559616834},
+	{2602078556773259891, 14645476698199521043},
+	{17087656251248738576, 18306845872749401303},
+	{17597314184671543466, 11441778670468375814},
+	{12773270693984653525, 14302223338085469768},
+	{15966588367480816906, 17877779172606837210},
+	{14590803748102898470, 11173611982879273256},
+	{18238504685128623088, 13967014978599091570},
+	{13574758819556003052, 17458768723248864463},
+	{15401753289863583763, 10911730452030540289},
+	{5417133557047315992, 13639663065038175362},
+	{15994788983163920798, 17049578831297719202},
+	{14608429132904838403, 10655986769561074501},
+	{4425478360848884291, 13319983461951343127},
+	{920161932633717460, 16649979327439178909},
+	{2880944217109767365, 10406237079649486818},
+	{12824552308241985014, 13007796349561858522},
+	{6807318348447705459, 16259745436952323153},
+	{15783789013848285672, 10162340898095201970},
+	{10506364230455581282, 12702926122619002463},
+	{8521269269642088699, 15878657653273753079},
+	{12243322321167387293, 9924161033296095674},
+	{6080780864604458308, 12405201291620119593},
+	{12212662099182960789, 15506501614525149491},
+	{5327070802775656541, 9691563509078218432},
+	{6658838503469570676, 12114454386347773040},
+	{8323548129336963345, 15143067982934716300},
+	{14425589617690377899, 9464417489334197687},
+	{13420301003685584469, 11830521861667747109},
+	{2940318199324816875, 14788152327084683887},
+	{8755227902219092403, 9242595204427927429},
+	{15555720896201253407, 11553244005534909286},
+	{10221279083396790951, 14441555006918636608},
+	{12776598854245988689, 18051943758648295760},
+	{7985374283903742931, 11282464849155184850},
+	{758345818024902856, 14103081061443981063},
+	{14782990327813292282, 17628851326804976328},
+	{9239368954883307676, 11018032079253110205},
+	{16160897212031522499, 13772540099066387756},
+	{1754377441329851508, 17215675123832984696},
+	{1096485900831157192, 10759796952395615435},
+	{15205665431321110202, 13449746190494519293},
+	{5172023733869224041, 16812182738118149117},
+	{5538357842881958977, 10507614211323843198},
+	{16146319340457224530, 13134517764154803997},
+	{6347841120289366950, 16418147205193504997},
+	{6273243709394548296, 10261342003245940623},
+}
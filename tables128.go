@@ -0,0 +1,9910 @@
+// Code generated by running "go generate". DO NOT EDIT.
+
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+const pow5NumBits128 = 249
+
+var pow5Split128 = [...][4]uint64{
+	{0, 0, 0, 72057594037927936},
+	{0, 0, 0, 90071992547409920},
+	{0, 0, 0, 112589990684262400},
+	{0, 0, 0, 140737488355328000},
+	{0, 0, 0, 87960930222080000},
+	{0, 0, 0, 109951162777600000},
+	{0, 0, 0, 137438953472000000},
+	{0, 0, 0, 85899345920000000},
+	{0, 0, 0, 107374182400000000},
+	{0, 0, 0, 134217728000000000},
+	{0, 0, 0, 83886080000000000},
+	{0, 0, 0, 104857600000000000},
+	{0, 0, 0, 131072000000000000},
+	{0, 0, 0, 81920000000000000},
+	{0, 0, 0, 102400000000000000},
+	{0, 0, 0, 128000000000000000},
+	{0, 0, 0, 80000000000000000},
+	{0, 0, 0, 100000000000000000},
+	{0, 0, 0, 125000000000000000},
+	{0, 0, 0, 78125000000000000},
+	{0, 0, 0, 97656250000000000},
+	{0, 0, 0, 122070312500000000},
+	{0, 0, 0, 76293945312500000},
+	{0, 0, 0, 95367431640625000},
+	{0, 0, 0, 119209289550781250},
+	{0, 0, 4611686018427387904, 74505805969238281},
+	{0, 0, 10376293541461622784, 93132257461547851},
+	{0, 0, 8358680908399640576, 116415321826934814},
+	{0, 0, 612489549322387456, 72759576141834259},
+	{0, 0, 14600669991935148032, 90949470177292823},
+	{0, 0, 13639151471491547136, 113686837721616029},
+	{0, 0, 3213881284082270208, 142108547152020037},
+	{0, 0, 4314518811765112832, 88817841970012523},
+	{0, 0, 781462496279003136, 111022302462515654},
+	{0, 0, 10200200157203529728, 138777878078144567},
+	{0, 0, 13292654125893287936, 86736173798840354},
+	{0, 0, 7392445620511834112, 108420217248550443},
+	{0, 0, 4628871007212404736, 135525271560688054},
+	{0, 0, 16728102434789916672, 84703294725430033},
+	{0, 0, 7075069988205232128, 105879118406787542},
+	{0, 0, 18067209522111315968, 132348898008484427},
+	{0, 0, 8986162942105878528, 82718061255302767},
+	{0, 0, 6621017659204960256, 103397576569128459},
+	{0, 0, 3664586055578812416, 129246970711410574},
+	{0, 0, 16125424340018921472, 80779356694631608},
+	{0, 0, 1710036351314100224, 100974195868289511},
+	{0, 0, 15972603494424788992, 126217744835361888},
+	{0, 0, 9982877184015493120, 78886090522101180},
+	{0, 0, 12478596480019366400, 98607613152626475},
+	{0, 0, 10986559581596820096, 123259516440783094},
+	{0, 0, 2254913720070624656, 77037197775489434},
+	{0, 0, 12042014186943056628, 96296497219361792},
+	{0, 0, 15052517733678820785, 120370621524202240},
+	{0, 11529215046068469760, 9407823583549262990, 75231638452626400},
+	{0, 5188146770730811392, 11759779479436578738, 94039548065783000},
+	{0, 15708555500268290048, 14699724349295723422, 117549435082228750},
+	{0, 5206161169240293376, 4575641699882439235, 73468396926392969},
+	{0, 1896015443122978816, 10331238143280436948, 91835496157991211},
+	{0, 2370019303903723520, 8302361660673158281, 114794370197489014},
+	{0, 7574210148307042304, 1154580038986672043, 143492962746861268},
+	{0, 2428038333478207488, 9944984561221445835, 89683101716788292},
+	{0, 16870105972129923072, 12431230701526807293, 112103877145985365},
+	{0, 7252574409880240128, 1703980321626345405, 140129846432481707},
+	{0, 6838702015388844032, 17205888765512323542, 87581154020301066},
+	{0, 17771749556090830848, 12283988920035628619, 109476442525376333},
+	{0, 17603000926686150656, 1519928094762372062, 136845553156720417},
+	{0, 6390189560751456256, 12479170105294952299, 85528470722950260},
+	{0, 3376050932511932416, 15598962631618690374, 106910588403687825},
+	{0, 13443435702494691328, 5663645234241199255, 133638235504609782},
+	{0, 15319676341700263936, 17374836326682913246, 83523897190381113},
+	{0, 9926223390270554112, 7883487353071477846, 104404871487976392},
+	{0, 3184407200983416832, 9854359191339347308, 130506089359970490},
+	{0, 11213626537469411328, 10770660513014479971, 81566305849981556},
+	{0, 9405347153409376256, 13463325641268099964, 101957882312476945},
+	{0, 11756683941761720320, 2994098996302961243, 127447352890596182},
+	{0, 5042084454387381248, 15706369927971514489, 79654595556622613},
+	{0, 10914291586411614464, 5797904354682229399, 99568244445778267},
+	{0, 9031178464587130176, 2635694424925398845, 124460305557222834},
+	{0, 7950329549580650312, 6258995034005762182, 77787690973264271},
+	{0, 714539900121037082, 3212057774079814824, 97234613716580339},
+	{9223372036854775808, 893174875151296352, 17850130272881932242, 121543267145725423},
+	{5764607523034234880, 5169920315396948124, 18073860448192289507, 75964541966078389},
+	{7205759403792793600, 1850714375818797251, 8757267504958198172, 94955677457597987},
+	{4395513236313604096, 2313392969773496564, 6334898362770359811, 118694596821997484},
+	{11970567809550778368, 17586771670604293016, 13182683513586250689, 74184123013748427},
+	{14963209761938472960, 8148406532973202558, 11866668373555425458, 92730153767185534},
+	{9480640165568315392, 962136129361727390, 5609963430089506015, 115912692208981918},
+	{1313714085052809216, 7518864108492161475, 17341285199088104971, 72445432630613698},
+	{15477200661598175232, 4786894117187813939, 12453234462005355406, 90556790788267123},
+	{14734814808570331136, 15206989683339543232, 10954857059079306353, 113195988485333904},
+	{18418518510712913920, 5173679048892265328, 13693571323849132942, 141494985606667380},
+	{11511574069195571200, 17068607460839829542, 17781854114260483896, 88434366004167112},
+	{5166095549639688192, 2889015252340235312, 3780573569116053255, 110542957505208891},
+	{6457619437049610240, 17446327120707457852, 114030942967678664, 138178696881511114},
+	{13259384185010782208, 10903954450442161157, 4682955357782187069, 86361685550944446},
+	{2739172175981314048, 18241629081480089351, 15077066234082509644, 107952106938680557},
+	{17259023275258806272, 4355292278140560072, 5011274737320973344, 134940133673350697},
+	{10786889547036753920, 2722057673837850045, 14661261756894078100, 84337583545844185},
+	{18095297952223330304, 3402572092297312556, 4491519140835433913, 105421979432305232},
+	{4172378366569611264, 8864901133799028600, 5614398926044292391, 131777474290381540},
+	{2607736479106007040, 12458092236265474731, 12732371365632458552, 82360921431488462},
+	{17094728654164672512, 15572615295331843413, 6692092170185797382, 102951151789360578},
+	{7533352762423676928, 10242397082310028459, 17588487249587022536, 128688939736700722},
+	{2402502467301104128, 6401498176443767787, 15604490549419276989, 80430587335437951},
+	{16838186139408543872, 12613558738982097637, 14893927168346708332, 100538234169297439},
+	{7212674618978516128, 15766948423727622047, 14005722942005997511, 125672792711621799},
+	{11425450664502654436, 16771871792470845635, 15671105866394830300, 78545495444763624},
+	{9670127312200930141, 2518095666879005428, 1142138259283986260, 98181869305954531},
+	{12087659140251162676, 3147619583598756785, 15262730879387146537, 122727336632443163},
+	{637257935015894816, 13496477285817692751, 7233363790403272633, 76704585395276977},
+	{14631630474052032232, 3035538551989952226, 13653390756431478696, 95880731744096221},
+	{9066166055710264483, 3794423189987440283, 3231680390257184658, 119850914680120277},
+	{3360510775605221349, 6983200512169538081, 4325643253124434363, 74906821675075173},
+	{8812324487933914591, 4117314621784534697, 10018740084832930858, 93633527093843966},
+	{15627091628344781143, 14370015314085444179, 3300053069186387764, 117041908867304958},
+	{7461089258501794262, 18204631608158178420, 15897591223523656064, 73151193042065598},
+	{9326361573127242828, 4309045436488171409, 10648616992549794273, 91438991302581998},
+	{16269637984836441439, 9997992814037602165, 4087399203832467033, 114298739128227498},
+	{6501989425763388086, 17109177035974390611, 14332621041645359599, 142873423910284372},
+	{1757900381888423602, 17610764675125075988, 18181260187883125557, 89295889943927732},
+	{2197375477360529502, 8178397788624181273, 4279831161144355331, 111619862429909666},
+	{7358405365128049782, 5611311217352838687, 14573160988285219972, 139524828037387082},
+	{11516532380846112970, 12730441547700299987, 13719911636105650386, 87203017523366926},
+	{9783979457630253308, 6689679897770599176, 7926517508277287175, 109003771904208658},
+	{12229974322037816635, 3750413853785861066, 684774848491833161, 136254714880260823},
+	{12255419969701023301, 13873223704684632926, 7345513307948477581, 85159196800163014},
+	{6095902925271503318, 3506471575573627446, 18405263671790372785, 106448996000203767},
+	{16843250693444154956, 8994775487894422211, 18394893571310578077, 133061245000254709},
+	{8221188674188902895, 7927577689147707834, 13802651491282805250, 83163278125159193},
+	{1053113805881352811, 686100074579858985, 3418256308821342851, 103954097656448992},
+	{5928078275779078918, 14692683148506987443, 4272820386026678563, 129942622070561240},
+	{1399205913148230372, 6877083958603173200, 2670512741266674102, 81214138794100775},
+	{1749007391435287965, 17819726985108742308, 17173198981865506339, 101517673492625968},
+	{2186259239294109956, 17662972712958539981, 3019754653622331308, 126897091865782461},
+	{3672255033772512674, 1815985908744311680, 4193189667727651020, 79310682416114038},
+	{4590318792215640843, 2269982385930389600, 14464859121514339583, 99138353020142547},
+	{5737898490269551054, 16672536037695150712, 13469387883465536574, 123922941275178184},
+	{3586186556418469409, 5808649005132081291, 8418367427165960359, 77451838296986365},
+	{18317791250805250473, 2649125237987713709, 15134645302384838353, 96814797871232956},
+	{9062181008224399379, 7923092565912030041, 471562554271496325, 121018497339041196},
+	{17193078176208719372, 7257775862908712727, 9518098633274461011, 75636560836900747},
+	{16879661701833511311, 4460533810208503005, 7285937273165688360, 94545701046125934},
+	{7264519072009725427, 5575667262760628757, 18330793628311886258, 118182126307657417},
+	{6846167429219772343, 8096478057652780877, 4539216990053847055, 73863828942285886},
+	{13169395304952103333, 5508911553638588192, 14897393274422084627, 92329786177857357},
+	{16461744131190129167, 2274453423620847336, 4786683537745442072, 115412232722321697},
+	{10288590081993830729, 1421533389763029585, 14520892257159371055, 72132645451451060},
+	{17472423620919676316, 15611974792485950693, 18151115321449213818, 90165806814313825},
+	{8005471470867431683, 10291596453752662559, 8853836096529353561, 112707258517892282},
+	{5395153320156901699, 17476181585618216103, 1843923083806916143, 140884073147365353},
+	{10289499852739145418, 17840142518652466920, 12681666973447792349, 88052545717103345},
+	{12861874815923931772, 8465120093033419938, 2017025661527576725, 110065682146379182},
+	{6853971483050138908, 15193086134719162827, 11744654113764246714, 137582102682973977},
+	{1977889167692642865, 14107364852626864671, 422879793461572340, 85988814176858736},
+	{16307419514897967294, 17634206065783580838, 528599741826965425, 107486017721073420},
+	{11160902356767683309, 8207699526947312336, 660749677283706782, 134357522151341775},
+	{6975563972979802068, 518126185914682306, 7330497575943398595, 83973451344588609},
+	{17942827003079528393, 14482715787675516594, 13774807988356636147, 104966814180735761},
+	{13205161716994634684, 13491708716167007839, 3383451930163631472, 131208517725919702},
+	{15170755100762728533, 8432317947604379899, 15949715511634433382, 82005323578699813},
+	{14351757857526022762, 1317025397650699066, 6102086334260878016, 102506654473374767},
+	{8716325285052752645, 1646281747063373833, 3015921899398709616, 128133318091718459},
+	{16976918349226440163, 1028926091914608645, 18025852251620051174, 80083323807324036},
+	{7386089881250886492, 10509529651748036615, 4085571240815512351, 100104154759155046},
+	{4620926333136220211, 8525226046257657865, 14330336087874166247, 125130193448943807},
+	{14417294004278607392, 12245795306552118021, 15873989082562435760, 78206370905589879},
+	{4186559450066095528, 15307244133190147527, 15230800334775656796, 97757963631987349},
+	{621513294155231506, 687311092778132793, 5203442363187407284, 122197454539984187},
+	{11917660854915489451, 9652941469841108803, 946308467778435600, 76373409087490117},
+	{10285390050216973910, 12066176837301386004, 5794571603150432404, 95466761359362646},
+	{12856737562771217387, 15082721046626732505, 16466586540792816313, 119333451699203307},
+	{1117931949090929011, 2509171626500625960, 7985773578781816244, 74583407312002067},
+	{1397414936363661264, 3136464533125782450, 5370530955049882401, 93229259140002584},
+	{10970140707309352388, 8532266684834615966, 6713163693812353001, 116536573925003230},
+	{2244651923640957338, 16861881724090104739, 18030785363914884337, 72835358703127018},
+	{16640872959833360385, 7242294099830467211, 13315109668038829614, 91044198378908773},
+	{16189405181364312577, 18276239661642859822, 2808829029766373305, 113805247973635967},
+	{11013384439850614914, 9010241521771411066, 17346094342490130344, 142256559967044958},
+	{11495051293334022225, 5631400951107131916, 6229622945628943561, 88910349979403099},
+	{14368814116667527781, 11650937207311302799, 3175342663608791547, 111137937474253874},
+	{13349331627407021823, 9951985490711740595, 13192550366365765242, 138922421842817342},
+	{6037489257915694687, 10831676950122225776, 3633657960551215372, 86826513651760839},
+	{7546861572394618359, 13539596187652782220, 18377130505971182927, 108533142064701048},
+	{9433576965493272949, 12312809216138589871, 4524669058754427043, 135666427580876311},
+	{12813514631074377449, 5389662750872924717, 9745447189362598758, 84791517238047694},
+	{2181835233560808099, 15960450475445931705, 2958436949848472639, 105989396547559618},
+	{7338980060378398028, 15338877075880026727, 12921418224165366607, 132486745684449522},
+	{11504391565377580623, 16504327200066098560, 12687572408530742033, 82804216052780951},
+	{14380489456721975779, 6795350944800459488, 11247779492236039638, 103505270065976189},
+	{17975611820902469724, 17717560717855350168, 224666310012885835, 129381587582470237},
+	{11234757388064043577, 8767632439445899903, 2446259452971747599, 80863492239043898},
+	{9431760716652666568, 6347854530879986975, 12281196353069460307, 101079365298804872},
+	{7178014877388445306, 3323132145172595815, 15351495441336825384, 126349206623506090},
+	{11403788326008860172, 2076957590732872384, 14206370669262903769, 78968254139691306},
+	{14254735407511075215, 7207883006843478384, 8534591299723853903, 98710317674614133},
+	{17818419259388844019, 4398167740126960076, 15279925143082205283, 123387897093267666},
+	{1913140000263251704, 443011828365656096, 14161639232853766206, 77117435683292291},
+	{2391425000329064630, 9777136822311845928, 13090363022639819853, 96396794604115364},
+	{2989281250411330787, 16833107046317195314, 16362953778299774816, 120495993255144205},
+	{6479986799934469646, 10520691903948247071, 12532689120651053212, 75309995784465128},
+	{3488297481490699153, 13150864879935308839, 15665861400813816515, 94137494730581410},
+	{18195429907145537654, 11826895081491748144, 10358954714162494836, 117671868413226763},
+	{11372143691965961034, 16615181462787118398, 4168503687137865320, 73544917758266727},
+	{4991807578102675484, 2322232754774346382, 598943590494943747, 91931147197833409},
+	{15463131509483120163, 16737848998750096689, 5360365506546067587, 114913933997291761},
+	{5493856331571736492, 16310625230010232958, 11312142901609972388, 143642417496614701},
+	{17268718262514499019, 970768731901619790, 9375932322719926695, 89776510935384188},
+	{12362525791288347966, 15048518970159188450, 11719915403399908368, 112220638669230235},
+	{6229785202255659150, 363904638989433947, 10038208235822497557, 140275798336537794},
+	{1587772742196093017, 2533283408582090169, 10885566165816448877, 87672373960336121},
+	{6596401946172504175, 7778290279155000615, 18218643725697949000, 109590467450420151},
+	{3633816414288242315, 9722862848943750769, 18161618638695048346, 136988084313025189},
+	{13800350304998621207, 10688475299017232134, 13656854658398099168, 85617552695640743},
+	{8027065844393500700, 13360594123771540168, 12459382304570236056, 107021940869550929},
+	{10033832305491875875, 16700742654714425210, 1739169825430631358, 133777426086938662},
+	{10882831209359810326, 5826278140769127852, 14922039196176308311, 83610891304336663},
+	{13603539011699762908, 2671161657534021911, 14040862976792997485, 104513614130420829},
+	{12392737746197315731, 7950638090344915293, 3716020665709083144, 130642017663026037},
+	{10051304100587016283, 4969148806465572058, 4628355925281870917, 81651261039391273},
+	{3340758088878994546, 10823122026509352977, 10397130925029726550, 102064076299239091},
+	{8787633629526131087, 4305530496281915413, 8384727637859770284, 127580095374048864},
+	{7798114027667525881, 11914328597030972941, 5240454773662356427, 79737559608780540},
+	{14359328553011795256, 10281224727861328272, 6550568467077945534, 99671949510975675},
+	{17949160691264744070, 3628158872971884532, 3576524565420044014, 124589936888719594},
+	{1994853395185689235, 16102657350889591545, 6847013871814915412, 77868710555449746},
+	{7105252762409499448, 1681577614902437815, 17782139376623420074, 97335888194312182},
+	{4269879934584486407, 11325344055482823077, 13004302183924499284, 121669860242890228},
+	{4974517968328997956, 16301712071531540231, 17351060901807587860, 76043662651806392},
+	{1606461441983859541, 1930396015704873673, 3242082053549933210, 95054578314757991},
+	{6619762820907212331, 11636367056485867899, 17887660622219580224, 118818222893447488},
+	{1831508753853313754, 7272729410303667437, 11179787888887237640, 74261389308404680},
+	{6901071960744030097, 9090911762879584296, 13974734861109047050, 92826736635505850},
+	{8626339950930037622, 2140267666744704562, 8245046539531533005, 116033420794382313},
+	{10003148487758661417, 3643510300929134303, 16682369133275677888, 72520887996488945},
+	{7892249591270938868, 4554387876161417879, 7017903361312433648, 90651109995611182},
+	{5253625970661285681, 5692984845201772349, 17995751238495317868, 113313887494513977},
+	{11178718481753995005, 7116231056502215436, 8659630992836983623, 141642359368142472},
+	{16210071087951022686, 11365173437954966503, 5412269370523114764, 88526474605089045},
+	{15650902841511390454, 14206466797443708129, 11377022731581281359, 110658093256361306},
+	{5728570496607074355, 13146397478377247258, 4997906377621825891, 138322616570451633},
+	{8192042578806809376, 5910655414772085584, 14652906532082110942, 86451635356532270},
+	{10240053223508511720, 16611691305319882788, 9092761128247862869, 108064544195665338},
+	{12800066529385639650, 6929556076367689773, 2142579373455052779, 135080680244581673},
+	{10305884590079718733, 2025129538516112156, 12868327154477877747, 84425425152863545},
+	{12882355737599648416, 16366469978427303907, 2250350887815183471, 105531781441079432},
+	{11491258653572172617, 15846401454606741980, 2812938609768979339, 131914726801349290},
+	{16405408695337383693, 7598157899915519785, 6369772649532999991, 82446704250843306},
+	{6671702813889565905, 4886011356467011828, 17185587848771025797, 103058380313554132},
+	{8339628517361957381, 10719200214011152689, 3035240737254230630, 128822975391942666},
+	{16741482869419693123, 2087814115329582526, 6508711479211282048, 80514359619964166},
+	{11703481549919840596, 2609767644161978158, 17359261385868878368, 100642949524955207},
+	{5405979900545024937, 3262209555202472698, 17087390713908710056, 125803686906194009},
+	{7990423456268028489, 2038880972001545436, 3762090168551861929, 78627304316371256},
+	{9988029320335035612, 7160287233429319699, 4702612710689827411, 98284130395464070},
+	{7873350631991406611, 4338673023359261720, 15101637925217060072, 122855162994330087},
+	{4920844144994629132, 2711670639599538575, 16356052730901744401, 76784476871456304},
+	{1539369162815898511, 8001274317926811123, 1998321839917628885, 95980596089320381},
+	{15759269508802036850, 14613278915835901807, 7109588318324424010, 119975745111650476},
+	{16767072470642354887, 13744985340824826533, 13666864735807540814, 74984840694781547},
+	{7123782533020779897, 7957859639176257359, 12471894901332038114, 93731050868476934},
+	{4293042147848586968, 723952512115545891, 6366496589810271835, 117163813585596168},
+	{377308333191672903, 16593371384568073846, 3979060368631419896, 73227383490997605},
+	{9695007453344366936, 2294970157000540691, 9585511479216662775, 91534229363747006},
+	{7507073298253070767, 16703770751532839576, 2758517312166052660, 114417786704683758},
+	{9383841622816338458, 2432969365706497854, 12671518677062341634, 143022233380854697},
+	{1253214995832823632, 6132291871993949063, 1002170145522881665, 89388895863034186},
+	{15401576800073193252, 12277050858419824232, 10476084718758377889, 111736119828792732},
+	{805226926381939950, 1511255517742616579, 13095105898447972362, 139670149785990915},
+	{16644167893484570132, 5556220717016523265, 5878598177316288774, 87293843616244322},
+	{6970151811573548954, 16168647933125429890, 16571619758500136775, 109117304520305402},
+	{17936061801321712000, 15599123897979399458, 11491152661270395161, 136396630650381753},
+	{15821724644253457904, 2831923408596042805, 264441385652915120, 85247894156488596},
+	{5942097750034658668, 3539904260745053507, 330551732066143900, 106559867695610745},
+	{2815936169115935431, 4424880325931316884, 5024875683510067779, 133199834619513431},
+	{10983332142552235452, 459707194493379100, 10058076329834874218, 83249896637195894},
+	{13729165178190294315, 9798006029971499683, 3349223375438816964, 104062370796494868},
+	{12549770454310479990, 12247507537464374604, 4186529219298521205, 130077963495618585},
+	{17066978570798825802, 9960535220128928079, 14145795808130045513, 81298727184761615},
+	{16722037195071144348, 17062355043588548003, 13070558741735168987, 101623408980952019},
+	{16290860475411542532, 16716257786058297100, 11726512408741573330, 127029261226190024},
+	{958415760277438274, 15059347134713823592, 7329070255463483331, 79393288266368765},
+	{1198019700346797843, 14212497899964891586, 13773023837756742068, 99241610332960956},
+	{10720896662288273112, 17765622374956114482, 17216279797195927585, 124052012916201195},
+	{11312246432357558599, 4185984956706489695, 8454331864033760789, 77532508072625747},
+	{9528622022019560344, 9844167214310500023, 5956228811614813082, 96915635090782184},
+	{7299091509097062526, 3081836981033349221, 7445286014518516353, 121144543863477730},
+	{6867775202399358031, 13455363159214313023, 9264989777501460624, 75715339914673581},
+	{3973032984571809635, 16819203949017891279, 16192923240304213684, 94644174893341976},
+	{354605212287374140, 2577260862562812483, 1794409976670715490, 118305218616677471},
+	{16362529322175466501, 6222474057529145705, 8039035263060279037, 73940761635423419},
+	{6618103597437169414, 12389778590338820036, 5437108060397960892, 92425952044279274},
+	{8272629496796461768, 15487223237923525045, 16019757112352226923, 115532440055349092},
+	{7476236444711482557, 7373671514488509201, 788976158365366019, 72207775034593183},
+	{13956981574316741100, 4605403374683248597, 14821278253238871236, 90259718793241478},
+	{3611168912613762663, 5756754218354060747, 9303225779693813237, 112824648491551848},
+	{18349019196049367041, 11807628791369963837, 11629032224617266546, 141030810614439810},
+	{13773980006744548353, 11991454013033615302, 11879831158813179495, 88144256634024881},
+	{7994102971575909633, 10377631497864631224, 1014730893234310657, 110180320792531102},
+	{9992628714469887041, 17583725390758176934, 10491785653397664129, 137725400990663877},
+	{1633706928116291497, 4072299341582778728, 8863209042587234033, 86078375619164923},
+	{2042133660145364371, 9702060195405861314, 6467325284806654637, 107597969523956154},
+	{11776039112036481272, 16739261262684714546, 17307528642863094104, 134497461904945192},
+	{11971710463450188699, 10462038289177946591, 10817205401789433815, 84060913690590745},
+	{10352952060885347969, 8465861843045045335, 18133192770664180173, 105076142113238431},
+	{8329504057679297058, 15194013322233694573, 18054804944902837312, 131345177641548039},
+	{7511783045263254613, 9496258326396059108, 18201782118205355176, 82090736025967524},
+	{9389728806579068266, 11870322907995073885, 4305483574047142354, 102613420032459406},
+	{16348847026651223237, 5614531598139066548, 14605226504413703751, 128266775040574257},
+	{994657354802238715, 10426611276477998449, 2210737537617482988, 80166734400358911},
+	{5855007711930186298, 13033264095597498061, 16598479977304017447, 100208418000448638},
+	{11930445658340120777, 11679894101069484672, 11524727934775246001, 125260522500560798},
+	{7456528536462575485, 382404785527346064, 2591268940807140847, 78287826562850499},
+	{9320660670578219357, 14313064037191346292, 17074144231291089770, 97859783203563123},
+	{11650825838222774196, 8667958009634407057, 16730994270686474309, 122324729004453904},
+	{364237121248152016, 7723316765235198363, 10456871419179046443, 76452955627783690},
+	{14290354456842353732, 5042459938116610049, 3847717237119032246, 95566194534729613},
+	{4027885015770778454, 15526446959500538370, 9421332564826178211, 119457743168412016},
+	{7129114153284124437, 7398186340474142529, 5888332853016361382, 74661089480257510},
+	{13523078710032543451, 24360888737902353, 16583788103125227536, 93326361850321887},
+	{3068790332258515602, 30451110922377942, 16118049110479146516, 116657952312902359},
+	{15753052012943735963, 9242403981181262021, 16991309721690548428, 72911220195563974},
+	{5856256960897506242, 11553004976476577527, 12015765115258409727, 91139025244454968},
+	{2708635182694494898, 9829570202168334005, 15019706394073012159, 113923781555568710},
+	{7997479996795506527, 7675276734283029602, 9551260955736489391, 142404726944460888},
+	{9610111016424579483, 11714576986567975357, 5969538097335305869, 89002954340288055},
+	{16624324788958112258, 808163177927805484, 2850236603241744433, 111253692925360069},
+	{2333661912488088707, 5621889990837144760, 8174481772479568445, 139067116156700086},
+	{1458538695305055441, 5819524253486909427, 497365089372342374, 86916947597937554},
+	{15658231424413483014, 16497777353713412591, 9845078398570203775, 108646184497421942},
+	{14961103262089465864, 16010535673714377835, 3082975961357978911, 135807730621777428},
+	{7044846529592222213, 16924113823712568003, 11150232012703512627, 84879831638610892},
+	{4194372143562889862, 16543456261213322100, 13937790015879390784, 106099789548263615},
+	{5242965179453612327, 2232576252807101009, 12810551501421850577, 132624736935329519},
+	{14806068283226977464, 12924575204072907890, 14924123716029738466, 82890460584580949},
+	{9284213317178946023, 6932346968236359055, 4820096589755009371, 103613075730726187},
+	{6993580628046294624, 4053747691868060915, 1413434718766373810, 129516344663407734},
+	{2065144883315240188, 7145278325844925976, 14718454754511147343, 80947715414629833},
+	{2581431104144050235, 4319911888878769566, 4563010387856770467, 101184644268287292},
+	{12450160917034838602, 788203842671074053, 5703762984820963084, 126480805335359115},
+	{10087193582360468078, 9715999438524197091, 1259008856299407975, 79050503334599447},
+	{7997305959523197194, 7533313279727858460, 15408819125656423681, 98813129168249308},
+	{9996632449403996492, 14028327618087210979, 814279833360977985, 123516411460311636},
+	{3942052271663803856, 1850175733663425006, 9732296932705387049, 77197757162694772},
+	{14150937376434530628, 6924405685506669161, 12165371165881733811, 96497196453368465},
+	{3853613665260999573, 4043821088455948548, 1371655902070003552, 120621495566710582},
+	{11631880577642900541, 2527388180284967842, 14692342994075915932, 75388434729194113},
+	{5316478685198849868, 3159235225356209803, 4530370687312731203, 94235543411492642},
+	{2033912338071174431, 17784102086977425966, 14886335395995689811, 117794429264365802},
+	{15106253266576647731, 8809220795147197276, 13915645640924694036, 73621518290228626},
+	{436072509511258048, 11011525993933996596, 8171185014301091737, 92026897862785783},
+	{545090636889072560, 18376093510844883649, 5602295249448976767, 115033622328482229},
+	{5293049314538728605, 18358430870128716657, 11614555080238608863, 143792027910602786},
+	{14837370867655175138, 18391548321471529766, 11870782943576518443, 89870017444126741},
+	{9323341547714193114, 18377749383412024304, 1003420624188484342, 112337521805158427},
+	{11654176934642741393, 13748814692410254572, 15089333835517769140, 140421902256448033},
+	{16507232621006489178, 17816381219611184915, 2513304619557523856, 87763688910280021},
+	{16022354757830723569, 3823732450804429528, 7753316792874292725, 109704611137850026},
+	{1581199373578852845, 9391351581932924815, 468273954238090098, 137130763922312533},
+	{7905778636127864884, 10481280757135465913, 2598514230612500263, 85706727451445333},
+	{14493909313587219009, 8489914927991944487, 7859828806693013233, 107133409314306666},
+	{13505700623556635858, 15224079678417318513, 601413971511490733, 133916761642883333},
+	{1523533862081815555, 11820892808224518023, 2681726741408375660, 83697976026802083},
+	{15739475382884433156, 14776116010280647528, 17187216482042633287, 104622470033502603},
+	{1227600154895989829, 13858458994423421507, 16872334584125903705, 130778087541878254},
+	{16908151161305851307, 1744007843873556585, 5933523096651301912, 81736304713673909},
+	{7300130896350150422, 2180009804841945732, 12028589889241515294, 102170380892092386},
+	{9125163620437688027, 11948384292907207973, 5812365324697118309, 127712976115115483},
+	{8009070271987248969, 9773583192280698935, 1326885318722004991, 79820610071947177},
+	{5399651821556673307, 7605292971923485765, 6270292666829894143, 99775762589933971},
+	{11361250795373229538, 4894930196476969302, 3226179815109979775, 124719703237417464},
+	{2489095728680880557, 9976860400439187670, 2016362384443737359, 77949814523385915},
+	{12334741697705876505, 7859389482121596683, 16355511035836835411, 97437268154232393},
+	{10806741103704957727, 5212550834224607950, 6609330739513880552, 121796585192790492},
+	{2142527171388210675, 3257844271390379969, 13354203749050951153, 76122865745494057},
+	{7289844982662651248, 8683991357665362865, 2857696631031525229, 95153582181867572},
+	{13723992246755701964, 15466675215509091485, 3572120788789406536, 118941977727334465},
+	{10883338163436007679, 9666672009693182178, 13761790539061848845, 74338736079584040},
+	{4380800667440233791, 16695026030543865627, 17202238173827311056, 92923420099480050},
+	{864314815872904335, 2422038464470280418, 12279425680429363013, 116154275124350063},
+	{5151882778347953113, 3819617049507619213, 14592170077909433739, 72596421952718789},
+	{11051539491362329296, 162835293457136112, 4405154542104628462, 90745527440898487},
+	{13814424364202911620, 9426916153676195948, 894757159203397673, 113431909301123109},
+	{17268030455253639525, 16395331210522632839, 5730132467431634995, 141789886626403886},
+	{17710048062174606559, 7941238997362951572, 17416390847426935584, 88618679141502428},
+	{3690816004008706583, 9926548746703689466, 3323744485574117864, 110773348926878036},
+	{13836892041865659037, 12408185933379611832, 4154680606967647330, 138466686158597545},
+	{8648057526166036898, 12366802226789645299, 14125890425423249341, 86541678849123465},
+	{6198385889280158218, 1623444728204892912, 3822304976496897965, 108177098561404332},
+	{7747982361600197773, 6640991928683504044, 4777881220621122456, 135221373201755415},
+	{14065861012854899416, 4150619955427190027, 9903704790529283391, 84513358251097134},
+	{12970640247641236366, 576588925856599630, 3156258951306828431, 105641697813871418},
+	{6989928272696769650, 14555794212602913250, 13168695725988311346, 132052122267339272},
+	{8980391188862868935, 13709057401304208685, 8230434828742694591, 82532576417087045},
+	{15837175004505974073, 12524635733202872952, 14899729554355756143, 103165720521358806},
+	{1349724681922915975, 11044108648076203287, 9401289906089919371, 128957150651698508},
+	{7761106953842904340, 4596724895833933102, 15099178228160975415, 80598219157311567},
+	{478011655448854617, 1134220101365028474, 14262286766773831365, 100747773946639459},
+	{9820886606165844080, 6029461145133673496, 13216172440039901302, 125934717433299324},
+	{6138054128853652550, 17603471270990709647, 17483479811879714121, 78709198395812077},
+	{3060881642639677783, 8169281033456223347, 8019291709567478940, 98386497994765097},
+	{17661160108581760941, 10211601291820279183, 14635800655386736579, 122983122493456371},
+	{17955754095504682444, 4076407798173980537, 6841532400403016410, 76864451558410232},
+	{8609634564098689343, 14318881784572251480, 8551915500503770512, 96080564448012790},
+	{10762043205123361679, 17898602230715314350, 1466522338774937332, 120100705560015988},
+	{2114590984774713145, 1963254357342295661, 10139948498589111641, 75062940975009992},
+	{7254924749395779336, 7065753965105257480, 12674935623236389551, 93828676218762490},
+	{9068655936744724170, 4220506437954183946, 6620297492190711131, 117285845273453113},
+	{10279595978892840510, 331973514507671014, 15666900978687664217, 73303653295908195},
+	{3626122936761274830, 5026652911561976672, 14971940204932192367, 91629566619885244},
+	{4532653670951593537, 1671630121025082936, 268181182455688843, 114536958274856556},
+	{5665817088689491921, 15924595706563517382, 335226478069611053, 143171197843570695},
+	{17376193735713096163, 12258715325815892315, 7127045576434588764, 89481998652231684},
+	{17108556151213982299, 15323394157269865394, 8908806970543235955, 111852498315289605},
+	{12162323152162702066, 14542556678159943839, 15747694731606432848, 139815622894112006},
+	{14518980997742770647, 9089097923849964899, 5230623188826632626, 87384764308820004},
+	{13537040228751075405, 2138000367957680316, 6538278986033290783, 109230955386025005},
+	{16921300285938844257, 16507558515229264107, 12784534750969001382, 136538694232531256},
+	{8269969669498083708, 5705538053590902163, 7990334219355625864, 85336683895332035},
+	{5725776068445216732, 7131922566988627704, 5376231755767144426, 106670854869165044},
+	{7157220085556520915, 18138275245590560438, 6720289694708930532, 133338568586456305},
+	{18308320608754989283, 2113049991639324465, 15729396105261551343, 83336605366535190},
+	{9050342705661572892, 16476370544831319294, 10438373094722163370, 104170756708168988},
+	{2089556345222190308, 11372091144184373310, 13047966368402704213, 130213445885211235},
+	{15141030771046032654, 9413399974328927270, 5849135971037996181, 81383403678257022},
+	{9702916426952765010, 16378435986338546992, 16534792000652271034, 101729254597821277},
+	{12128645533690956262, 11249672946068407932, 6833431945533175081, 127161568247276597},
+	{16803775495411623472, 113516563651673101, 6576737975171928378, 79475980154547873},
+	{7169661313982365628, 9365267741419367185, 12832608487392298376, 99344975193184841},
+	{13573762660905344939, 11706584676774208981, 2205702553958209258, 124181218991481052},
+	{10789444672279534539, 11928301441411268517, 10601936133078656594, 77613261869675657},
+	{18098491858776806077, 5687004764909309838, 17864106184775708647, 97016577337094571},
+	{13399742786616231789, 2497069937709249394, 17718446712542247905, 121270721671368214},
+	{12986525260062532772, 13089883757136750631, 6462343176911517036, 75794201044605134},
+	{11621470556650778061, 16362354696420938289, 17301301007994172103, 94742751305756417},
+	{691780140531308864, 15841257352098784958, 7791568204710551417, 118428439132195522},
+	{14267420643114231752, 2983256817420658742, 9481416146371482540, 74017774457622201},
+	{8610903767038013882, 3729071021775823428, 16463456201391741079, 92522218072027751},
+	{10763629708797517353, 49652758792391381, 15967634233312288445, 115652772590034689},
+	{9033111577212142297, 2336875983458938565, 3062242368179098422, 72282982868771681},
+	{15903075489942565776, 12144467016178449014, 8439488978651260931, 90353728585964601},
+	{10655472325573431412, 10568897751795673364, 15161047241741464068, 112942160732455751},
+	{13319340406966789265, 13211122189744591705, 14339623033749442181, 141177700915569689},
+	{1407058726713161434, 10562794377804063768, 2044735368452319507, 88236063072231056},
+	{1758823408391451793, 8591806953827691806, 2555919210565399384, 110295078840288820},
+	{11421901297344090549, 10739758692284614757, 3194899013206749230, 137868848550361025},
+	{9444531320053750545, 2100663164250496319, 13526026929322688029, 86168030343975640},
+	{7193978131639800278, 7237514973740508303, 16907533661653360036, 107710037929969550},
+	{4380786646122362443, 9046893717175635379, 11911045040211924237, 134637547412461938},
+	{432148644612782575, 7960151582448466064, 12056089168559840552, 84148467132788711},
+	{540185805765978219, 9950189478060582580, 10458425442272412786, 105185583915985889},
+	{675232257207472773, 3214364810720952417, 17684717821267903887, 131481979894982361},
+	{11951235206823140243, 8926507034341677116, 4135419610651358073, 82176237434363976},
+	{14939044008528925304, 15769819811354484299, 5169274513314197591, 102720296792954970},
+	{14062118992233768726, 15100588745765717470, 15684965178497522797, 128400370991193712},
+	{4177138351718717550, 11743710975317267371, 9803103236560951748, 80250231869496070},
+	{609736921221009033, 14679638719146584214, 3030507008846413877, 100312789836870088},
+	{9985543188381037100, 4514490343651066555, 3788133761058017347, 125390987296087610},
+	{3935121483524454235, 515713455568222645, 6979269619088648746, 78369367060054756},
+	{9530587872832955698, 9868013856315054114, 8724087023860810932, 97961708825068445},
+	{2689862804186418815, 12335017320393817643, 15516794798253401569, 122452136031335556},
+	{17822065317112369423, 791856797605054170, 474624712053600173, 76532585019584723},
+	{13054209609535685971, 5601507015433705617, 14428338945349163928, 95665731274480903},
+	{2482703956637443752, 7001883769292132022, 13423737663259067006, 119582164093101129},
+	{15386748028180566057, 18211235411089746225, 1472307011895835022, 74738852558188206},
+	{5398376979943543859, 13540672227007406974, 11063755801724569586, 93423565697735257},
+	{15971343261784205632, 7702468246904482909, 18441380770583099887, 116779457122169071},
+	{12287932547828822472, 11731571681956383674, 18443392009255519285, 72987160701355669},
+	{6136543647931252282, 829406547163315881, 9219181956287235395, 91233950876694587},
+	{12282365578341453256, 14871816239236308563, 6912291426931656339, 114042438595868234},
+	{10741270954499428666, 13978084280617997800, 17863736320519346232, 142553048244835292},
+	{6713294346562142916, 8736302675386248625, 1941463163469815587, 89095655153022058},
+	{13003303951630066550, 6308692325805422877, 11650200991192045292, 111369568941277572},
+	{2419071884255419475, 7885865407256778597, 14562751238990056615, 139211961176596965},
+	{3817762936873331124, 11846194907176568479, 11407562533582479336, 87007475735373103},
+	{160517652664276001, 14807743633970710599, 9647767148550711266, 108759344669216379},
+	{14035705121112508713, 9286307505608612440, 7448022917261001179, 135949180836520474},
+	{8772315700695317945, 3498099181791688823, 9266700341715513641, 84968238022825296},
+	{6353708607441759528, 8984309995666998933, 11583375427144392051, 106210297528531620},
+	{12553821777729587314, 6618701476156360762, 14479219283930490064, 132762871910664525},
+	{12457824629508379975, 4136688422597725476, 11355355061670250242, 82976794944165328},
+	{15572280786885474969, 14394232565101932653, 14194193827087812802, 103720993680206660},
+	{5630292928324679999, 8769418669522640009, 17742742283859766003, 129651242100258325},
+	{15048148126271394759, 3175043659237956053, 13395056936626047704, 81032026312661453},
+	{4975127102557079737, 3968804574047445067, 2908763115500395918, 101290032890826817},
+	{1607222859768961768, 14184377754414082142, 8247639912802882801, 126612541113533521},
+	{14839572342637764817, 1947707068867719482, 16683989991570271511, 79132838195958450},
+	{9326093391442430213, 16269691891366813065, 11631615452608063580, 98916047744948063},
+	{16269302757730425670, 1890370790498964715, 9927833297332691572, 123645059681185079},
+	{7862471214367822092, 10404853780916628755, 13122424838474014088, 77278162300740674},
+	{5216402999532389711, 13006067226145785944, 7179659011237741802, 96597702875925843},
+	{6520503749415487139, 7034211995827456622, 4362887745619789349, 120747128594907304},
+	{17910372898666843174, 6702225506605854340, 2726804841012368343, 75466955371817065},
+	{3941222049624002351, 3766095864829930022, 8020192069692848333, 94333694214771331},
+	{14149899598884778747, 9319305849464800431, 5413554068688672512, 117917117768464164},
+	{15761216276944068573, 5824566155915500269, 12606843329785196128, 73698198605290102},
+	{5866462290897922004, 7280707694894375337, 6535182125376719352, 92122748256612628},
+	{11944763882049790409, 9100884618617969171, 8168977656720899190, 115153435320765785},
+	{10319268834134850107, 2152733736417685656, 14822908089328511892, 143941794150957231},
+	{6449543021334281317, 10568830622115829343, 16181846583471401788, 89963621344348269},
+	{3450242758240463742, 13211038277644786679, 6392250174057088523, 112454526680435337},
+	{18147861503082743390, 11902111828628595444, 12601998735998748558, 140568158350544171},
+	{2119041402571938811, 2827133874465484249, 5570406200785523897, 87855098969090107},
+	{7260487771642311417, 8145603361509243215, 2351321732554516967, 109818873711362634},
+	{4463923696125501368, 5570318183459166115, 12162524202547922017, 137273592139203292},
+	{484109300864744403, 15010663910730448582, 16824949663447227068, 85795995087002057},
+	{9828508662935706312, 316585814703509111, 7196129024026870124, 107244993858752572},
+	{7673949810242244986, 395732268379386389, 8995161280033587655, 134056242323440715},
+	{7102061640615097068, 7164861695378198349, 3316132790807298332, 83785151452150447},
+	{13489263069196259239, 8956077119222747936, 17980224043791286627, 104731439315188058},
+	{16861578836495324049, 6583410380601047016, 13251908017884332476, 130914299143985073},
+	{10538486772809577530, 13338003524730430193, 1364913483536625941, 81821436964990671},
+	{17784794484439359817, 2837446350630874029, 15541199909702946139, 102276796206238338},
+	{8395935050267036059, 17381865993570756249, 10203127850273906865, 127845995257797923},
+	{16776674452485367297, 3946137218340640799, 4071111897207497839, 79903747036123702},
+	{16359157047179321217, 320985504498413095, 14312261908364148107, 99879683795154627},
+	{15837260290546763618, 14236289935905180081, 13278641367027797229, 124849604743943284},
+	{2980758653950645405, 11203524219154431503, 17522522891247149076, 78031002964964552},
+	{17561006372720470468, 14004405273943039378, 3456409540349384729, 97538753706205691},
+	{12727885929045812277, 3670448537146635511, 18155569980718894624, 121923442132757113},
+	{14872457733294714529, 2294030335716647194, 4429702210308227284, 76202151332973196},
+	{9367200129763617354, 2867537919645808993, 5537127762885284105, 95252689166216495},
+	{16320686180631909596, 8196108417984649145, 2309723685179217227, 119065861457770619},
+	{3282899835253861641, 2816724752026711764, 17584478367732868431, 74416163411106636},
+	{4103624794067327052, 17355963995315553417, 3533853885956533922, 93020204263883296},
+	{9741217011011546719, 12471582957289665963, 4417317357445667403, 116275255329854120},
+	{3782417622668522747, 5488896339092347275, 2760823348403542127, 72672034581158825},
+	{116336009908265530, 2249434405438046190, 8062715203931815563, 90840043226448531},
+	{9368792049240107721, 16646851062079721449, 5466707986487381549, 113550054033060664},
+	{16322676079977522555, 6973505772317488099, 6833384983109226937, 141937567541325830},
+	{7895829540772257645, 15887656153766899822, 18105923669725430547, 88710979713328643},
+	{646414889110546248, 15247884173781236874, 18020718568729400280, 110888724641660804},
+	{10031390648242958618, 613111143516994476, 4079154137202198735, 138610905802076006},
+	{15492991192006624944, 7300723492339203403, 16384529391033537921, 86631816126297503},
+	{14754552971580893276, 13737590383851392158, 15868975720364534497, 108289770157871879},
+	{9219819177621340787, 3336929924532076486, 15224533632028280218, 135362212697339849},
+	{1150700967585950088, 6697267221259935708, 2597804492376593280, 84601382935837406},
+	{1438376209482437610, 8371584026574919635, 12470627652325517408, 105751728669796757},
+	{15633028317135210725, 10464480033218649543, 1753226510124733048, 132189660837245947},
+	{16688171725850588559, 6540300020761655964, 17236667633323815819, 82618538023278716},
+	{2413470583603684083, 3563689007524682052, 3099090467945218158, 103273172529098396},
+	{3016838229504605103, 13677983296260628373, 3873863084931522697, 129091465661372995},
+	{4191366902654072141, 1631210532521810877, 115321418868507734, 80682166038358122},
+	{9850894646744978081, 11262385202507039404, 9367523810440410475, 100852707547947652},
+	{12313618308431222601, 9466295484706411351, 11709404763050513094, 126065884434934565},
+	{14613540470410595982, 1304748659514119190, 9624220986120264636, 78791177771834103},
+	{9043553551158469169, 1630935824392648988, 7418590214222942891, 98488972214792629},
+	{11304441938948086461, 15873727835772974947, 13884923786206066517, 123111215268490786},
+	{4759433202628860086, 12226922906571803294, 13289763384806179477, 76944509542806741},
+	{15172663540140850916, 1448595577932590405, 2777146175725560635, 96180636928508427},
+	{5130771369893899933, 15645802527697901719, 17306490774939114505, 120225796160635533},
+	{10124261133824769314, 2861097552170106718, 13122399743550640518, 75141122600397208},
+	{3431954380426185835, 12799743977067409206, 16402999679438300647, 93926403250496510},
+	{13513315012387508101, 11387993952906873603, 11280377562443100001, 117408004063120638},
+	{6139978873528498611, 199967192925714146, 2438549958099549597, 73380002539450399},
+	{16898345628765399072, 4861645009584530586, 16883245502906600708, 91725003174312998},
+	{11899559999101973032, 6077056261980663233, 11880684841778475077, 114656253967891248},
+	{1039391943595302578, 12208006345903216946, 14850856052223093846, 143320317459864060},
+	{5261305983174452015, 3018317947762122687, 58412995784657846, 89575198412415038},
+	{1964946460540677115, 12996269471557429167, 9296388281585598115, 111968998015518797},
+	{16291241130958010106, 11633650821019398554, 16232171370409385548, 139961247519398496},
+	{14793711725276144220, 16494403799991899904, 10145107106505865967, 87475779699624060},
+	{45395582885628659, 16006318731562486977, 12681383883132332459, 109344724624530075},
+	{4668430497034423728, 15396212396025720817, 11240043835488027670, 136680905780662594},
+	{14446984106714984590, 5010946729088687606, 11636713415607405198, 85425566112914121},
+	{8835358096538954930, 15487055448215635316, 710833714227092785, 106781957641142652},
+	{11044197620673693662, 5523761254987380433, 888542142783865982, 133477447051428315},
+	{18431838558989528299, 17287408839649276482, 16696239903735773902, 83423404407142696},
+	{13816426161882134565, 12385889012706819795, 2423555805960165762, 104279255508928371},
+	{12658846683925280303, 6258989229028748936, 16864502812732370915, 130349069386160463},
+	{7911779177453300189, 1606025258929274133, 17457843285598813678, 81468168366350289},
+	{14501409990244013141, 11230903610516368474, 7987246051716353385, 101835210457937862},
+	{8903390450950240618, 203571457863296881, 760685527790665924, 127294013072422328},
+	{17093834077912370146, 9350604198019336358, 475428454869166202, 79558758170263955},
+	{12143920560535686875, 2464883210669394640, 14429343623868621465, 99448447712829943},
+	{15179900700669608593, 7692790031764131204, 13424993511408388927, 124310559641037429},
+	{264065901063729563, 11725522797493663859, 10696463953843937031, 77694099775648393},
+	{14165140431611825665, 10045217478439691919, 17982265960732309193, 97117624719560491},
+	{13094739521087394178, 17168207866477002803, 17866146432487998587, 121397030899450614},
+	{5878369191465927409, 8424286907334432800, 6554655501877611213, 75873144312156634},
+	{7347961489332409261, 15142044652595428904, 17416691414201789824, 94841430390195792},
+	{9184951861665511577, 480811742034734514, 3324120194042685665, 118551787987744741},
+	{10352280931968332639, 11829722384840178831, 4383418130490372492, 74094867492340463},
+	{8328665146533027895, 14787152981050223539, 867586644685577711, 92618584365425579},
+	{5799145414738896965, 13872255207885391520, 14919541361139135851, 115773230456781973},
+	{3624465884211810603, 6364316495714675748, 11630556359925653859, 72358269035488733},
+	{4530582355264763254, 3343709601215956781, 703137394624903612, 90447836294360917},
+	{10274913962508341972, 4179637001519945976, 5490607761708517419, 113059795367951146},
+	{12843642453135427465, 612860233472544566, 16086631738990422582, 141324744209938932},
+	{3415590514782254261, 14218095701202504066, 830772800014238305, 88327965131211833},
+	{13492860180332593635, 3937561571220966370, 5650152018445185786, 110409956414014791},
+	{7642703188560966235, 14145324000880983771, 2451004004629094328, 138012445517518489},
+	{2470846483636909945, 8840827500550614857, 13061092548961653715, 86257778448449055},
+	{7700244122973525335, 6439348357260880667, 11714679667774679240, 107822223060561319},
+	{5013619135289518765, 8049185446576100834, 10031663566290961146, 134777778825701649},
+	{7745197977983337132, 9642426922537450925, 17799004775000320476, 84236111766063530},
+	{14293183490906559319, 12053033653171813656, 13025383931895624787, 105295139707579413},
+	{17866479363633199149, 10454606048037379166, 2446671859587367272, 131618924634474267},
+	{6554863583843361564, 6534128780023361979, 17670070976737962209, 82261827896546416},
+	{3581893461376814051, 12779346993456590378, 3640844647212901145, 102827284870683021},
+	{13700738863575793372, 2139125686538574260, 9162741827443514336, 128534106088353776},
+	{17786333826589646665, 1336953554086608912, 5726713642152196460, 80333816305221110},
+	{3786173209527506716, 1671191942608261141, 16381764089545021383, 100417270381526387},
+	{9344402530336771299, 15924047983542490138, 15865519093503888824, 125521587976907984},
+	{10451937599887869966, 9952529989714056336, 9915949433439930515, 78450992485567490},
+	{13064921999859837457, 7828976468715182516, 3171564754945137336, 98063740606959363},
+	{16331152499824796822, 9786220585893978145, 17799513998963585382, 122579675758699203},
+	{3289441284749416157, 1504701847756348437, 8818853240138546912, 76612297349187002},
+	{8723487624364158101, 1880877309695435546, 1800194513318407832, 95765371686483753},
+	{1680987493600421818, 2351096637119294433, 6861929160075397694, 119706714608104691},
+	{12579832229568733396, 15304493453481722732, 1982862715833429606, 74816696630065432},
+	{15724790286960916745, 9907244779997377607, 2478578394791787008, 93520870787581790},
+	{15044301840273758028, 12384055974996722009, 12321595030344509568, 116901088484477237},
+	{2485159622530016911, 7740034984372951256, 10006839903179012432, 73063180302798273},
+	{3106449528162521139, 9675043730466189070, 17120235897401153444, 91328975378497841},
+	{13106433947057927232, 12093804663082736337, 7565236816469278093, 114161219223122302},
+	{2547984378540245328, 1282197773571256710, 233173983731821809, 142701524028902878},
+	{15427548291869817042, 12330588654550505203, 13980791795114552342, 89188452518064298},
+	{14672749346409883398, 6189863781333355696, 8252617707038414620, 111485565647580373},
+	{18340936683012354248, 7737329726666694620, 14927458152225406179, 139356957059475466},
+	{2239713390027945597, 2529988069952990186, 13941347363568266766, 87098098162172166},
+	{12023013774389707804, 12385857124296013540, 8203312167605557649, 108872622702715208},
+	{15028767217987134756, 1647263350087853213, 10254140209506947062, 136090778378394010},
+	{11698822520455653174, 14864597649087071970, 11020523649369229817, 85056736486496256},
+	{5400156113714790660, 4745689006076676251, 13775654561711537272, 106320920608120320},
+	{2138509123716100421, 5932111257595845314, 17219568202139421590, 132901150760150400},
+	{5948254220749950667, 17542627591279567033, 10762230126337138493, 83063219225094000},
+	{12047003794364826238, 8093226433817295079, 13452787657921423117, 103829024031367500},
+	{10447068724528644893, 14728219060699006753, 16815984572401778896, 129786280039209375},
+	{18058632998898872818, 9205136912936879220, 17427519385392193666, 81116425024505859},
+	{4126547174914039407, 2283049104316323218, 17172713213312854179, 101395531280632324},
+	{14381556005497325066, 16688869435677567734, 3019147442931516107, 126744414100790406},
+	{4376786485008440262, 8124700388084785882, 15722025207114361279, 79215258812994003},
+	{14694355143115326136, 5544189466678594448, 15040845490465563695, 99019073516242504},
+	{18367943928894157670, 2318550814920855156, 354312789372403003, 123773841895303131},
+	{2256592918704072736, 17589995323821392137, 16362346557853609540, 77358651184564456},
+	{7432427166807478824, 3540750081067188555, 2006189123607460310, 96698313980705571},
+	{4678847940081960626, 13649309638188761502, 16342794459791489099, 120872892475881963},
+	{16759338017833389103, 6224975514654281986, 7908403528155986735, 75545557797426227},
+	{11725800485436960571, 3169533374890464579, 5273818391767595515, 94431947246782784},
+	{10045564588368812810, 17796974773895244436, 6592272989709494393, 118039934058478480},
+	{15501849904585283814, 4205580206043445916, 4120170618568433996, 73774958786549050},
+	{930568307022053151, 5256975257554307396, 14373585310065318303, 92218698483186312},
+	{1163210383777566439, 1959533053515496341, 17966981637581647879, 115273373103982890},
+	{6065698998149345953, 16284474372176534138, 13235355010122284040, 144091716379978613},
+	{8402747892270729125, 10177796482610333836, 10577939890540121477, 90057322737486633},
+	{10503434865338411406, 17333931621690305199, 17834110881602539750, 112571653421858291},
+	{8517607563245626353, 12444042490258105691, 17680952583575786784, 140714566777322864},
+	{3017661717814822519, 7777526556411316057, 11050595364734866740, 87946604235826790},
+	{8383763165695916053, 9721908195514145071, 4589872169063807617, 109933255294783488},
+	{5868017938692507162, 16764071262820069243, 5737340211329759521, 137416569118479360},
+	{1361668202469123024, 3560015511621461421, 3585837632081099701, 85885355699049600},
+	{6313771271513791684, 9061705407954214680, 4482297040101374626, 107356694623812000},
+	{7892214089392239605, 2103759723087992542, 5602871300126718283, 134195868279765000},
+	{320947787442761849, 17455750891425853003, 3501794562579198926, 83872417674853125},
+	{14236242789585616023, 12596316577427540445, 8988929221651386562, 104840522093566406},
+	{3960245431699856317, 6522023684929649749, 2012789490209457395, 131050652616958008},
+	{4780996404026104150, 1770421793867337141, 1257993431380910872, 81906657885598755},
+	{10587931523460018092, 2213027242334171426, 15407549844508302302, 102383322356998443},
+	{4011542367470246807, 11989656089772490091, 14647751287207989973, 127979152946248054},
+	{201370970455210302, 9799378065321500259, 4543158536077605829, 79986970591405034},
+	{14086771768351176590, 16860908600079263227, 14902320206951783094, 99983713239256292},
+	{12996778692011582833, 11852763713244303226, 181156184980177252, 124979641549070366},
+	{12734672700934627175, 16631349357632465324, 13948280670894774494, 78112275968168978},
+	{15918340876168283968, 11565814660185805847, 8211978801763692310, 97640344960211223},
+	{15286240076782967057, 5233896288377481501, 5653287483777227484, 122050431200264029},
+	{11859743057203048362, 12494557217090701746, 5839147686574461129, 76281519500165018},
+	{5601306784649034645, 1783138466081213471, 16522306645072852220, 95351899375206272},
+	{2389947462383905402, 2228923082601516839, 2206139232631513659, 119189874219007841},
+	{8411246191631022732, 17533977991121805688, 12908052066463165796, 74493671386879900},
+	{10514057739538778415, 3470728415192705494, 16135065083078957246, 93117089233599875},
+	{3919200137568697211, 13561782555845657676, 15557145335421308653, 116396361541999844},
+	{11672872122835211565, 10781957106617229999, 499843797783542100, 72747725963749903},
+	{9979404135116626552, 13477446383271537499, 14459862802511591337, 90934657454687378},
+	{7862569150468395287, 3011749923807258162, 8851456466284713364, 113668321818359223},
+	{604839401230718300, 3764687404759072703, 6452634564428503801, 142085402272949029},
+	{7295553653410280794, 13882144674042890199, 6338739611981508827, 88803376420593143},
+	{4507756048335463088, 12740994824126224845, 3311738496549498130, 111004220525741429},
+	{10246381078846716764, 6702871493303005248, 8751359139114260567, 138755275657176786},
+	{6403988174279197977, 11106823710955460136, 10081285480373800758, 86722047285735491},
+	{8004985217848997472, 4660157601839549362, 7989920832039863044, 108402559107169364},
+	{782859485456471032, 5825197002299436703, 9987401040049828805, 135503198883961705},
+	{7406816206051376251, 5946591135650841891, 17771340696099612763, 84689499302476065},
+	{4646834239136832410, 2821552901136164460, 8379117814842352242, 105861874128095082},
+	{5808542798921040512, 12750313163274981383, 1250525231698164494, 132327342660118853},
+	{10547868276966732176, 3357259708619475460, 3087421279025046761, 82704589162574283},
+	{13184835346208415220, 8808260654201732229, 17694334654063472163, 103380736453217853},
+	{2645986127478355313, 6398639799324777383, 8282860262297176492, 129225920566522317},
+	{8571270357315053927, 13222521911432761672, 7482630673149429259, 80766200354076448},
+	{10714087946643817408, 11916466370863564186, 9353288341436786574, 100957750442595560},
+	{4169237896449995952, 5672210926724679425, 11691610426795983218, 126197188053244450},
+	{14134988731349717230, 8156817847630312544, 11918942535174877415, 78873242533277781},
+	{17668735914187146538, 5584336291110502776, 1063620113686433057, 98591553166597227},
+	{3639175819024381556, 11592106382315516375, 15164583197390205033, 123239441458246533},
+	{9192013914531320329, 327537461306115878, 11783707507582572098, 77024650911404083},
+	{2266645356309374603, 9632793863487420656, 10117948366050827218, 96280813639255104},
+	{2833306695386718254, 2817620292504500012, 12647435457563534023, 120351017049068880},
+	{10994188721471474716, 8678541710456394363, 7904647160977208764, 75219385655668050},
+	{9131049883411955491, 10848177138070492954, 657436914366735147, 94024232069585063},
+	{2190440317410168556, 8948535404160728289, 14656854198240582646, 117530290086981328},
+	{12898240244449825108, 981148609173067276, 9160533873900364154, 73456431304363330},
+	{16122800305562281385, 10449807798321109903, 2227295305520679384, 91820539130454163},
+	{15541814363525463827, 13062259747901387379, 16619177187183012942, 114775673913067703},
+	{14815581935979441880, 7104452648021958416, 16162285465551378274, 143469592391334629},
+	{9259738709987151175, 9051968923441111914, 12407271425183305373, 89668495244584143},
+	{2351301350629163160, 15926647172728777797, 10897403263051743812, 112085619055730179},
+	{7550812706713841855, 1461564892201420630, 9010068060387291862, 140107023819662724},
+	{107571923268763255, 14748536112908051606, 14854664574596833221, 87566889887289202},
+	{9357836940940729877, 4600612085852900795, 9344958681391265719, 109458612359111503},
+	{7085610157748524442, 1139079088888738090, 7069512333311694245, 136823265448889379},
+	{9040192367020215680, 3017767439769155258, 2112602199106114951, 85514540905555862},
+	{2076868421920493792, 17607267354993607785, 11864124785737419496, 106893176131944827},
+	{7207771545828005145, 3562340120032458115, 10218469963744386467, 133616470164931034},
+	{2199014206928809263, 18367363639516143986, 10998229745767629445, 83510293853081896},
+	{11972139795515787387, 9124146494113016270, 13747787182209536807, 104387867316352370},
+	{5741802707539958426, 6793497099213882434, 7961361940907145201, 130484834145440463},
+	{8200312710639861920, 15775150733077146281, 11893380240708047606, 81553021340900289},
+	{14862076906727215304, 10495566379491657043, 1031667245602895796, 101941276676125362},
+	{13965910114981631226, 13119457974364571304, 10512956093858395553, 127426595845156702},
+	{8728693821863519516, 1282132206336775209, 1958911540234109317, 79641622403222939},
+	{15522553295756787300, 6214351276348356915, 16283697480574800358, 99552028004028673},
+	{14791505601268596221, 16991311132290221952, 6519563795436336735, 124440035005035842},
+	{9244691000792872638, 17537098485322470576, 8686413390575098363, 77775021878147401},
+	{11555863750991090797, 17309687088225700316, 15469702756646260858, 97218777347684251},
+	{14444829688738863497, 12413736823427349587, 14725442427380438169, 121523471684605314},
+	{6722175546248095733, 841056487001011636, 13815087535540161760, 75952169802878321},
+	{8402719432810119667, 1051320608751264545, 3433801364143038488, 94940212253597902},
+	{15115085309440037487, 1314150760939080681, 13515623742033573918, 118675265316997377},
+	{2529399290758941573, 14656402280869089138, 1529735811129901842, 74172040823123361},
+	{12385121150303452775, 9097130814231585614, 6523855782339765207, 92715051028904201},
+	{6258029401024540161, 6759727499362094114, 12766505746352094413, 115893813786130251},
+	{8522954394067725504, 6530672696315002773, 5673223082256365056, 72433633616331407},
+	{15265379011012044784, 8163340870393753466, 2479842834393068416, 90542042020414259},
+	{9858351726910280173, 10204176087992191833, 16934861598273499232, 113177552525517823},
+	{16934625677065238120, 12755220109990239791, 16556890979414486136, 141471940656897279},
+	{17501670075806855681, 7972012568743899869, 17265585889775135691, 88419962910560799},
+	{8042029539476405889, 5353329692502486933, 16970296343791531710, 110524953638200999},
+	{14664222942772895266, 15915034152482884474, 16601184411312026733, 138156192047751249},
+	{13776825357660447445, 12252739354515496748, 3458211229428934852, 86347620029844531},
+	{17221031697075559306, 15315924193144370935, 18157822092068332277, 107934525037305663},
+	{16914603602917061229, 5309847186148299957, 18085591596658027443, 134918156296632079},
+	{12877470261036857220, 1012811482128993521, 18221023775552349008, 84323847685395049},
+	{2261779771013907813, 1266014352661241902, 8941221664158272548, 105404809606743812},
+	{12050596750622160574, 1582517940826552377, 11176527080197840685, 131756012008429765},
+	{614093941497768503, 3294916722230289188, 9291172434337344380, 82347507505268603},
+	{767617426872210629, 4118645902787861485, 7002279524494292571, 102934384381585754},
+	{5571207802017651190, 536621360057438952, 17976221442472641522, 128667980476982192},
+	{3482004876261031993, 4947074368463287249, 11235138401545400951, 80417487798113870},
+	{8964192113753677896, 1572156942151721157, 4820550965076975381, 100521859747642338},
+	{15816926160619485274, 6576882196117039350, 15249060743200995034, 125652324684552922},
+	{5273892831959790392, 8722237391000537498, 14142348982928009800, 78532702927845576},
+	{15815738076804513798, 10902796738750671872, 17677936228660012250, 98165878659806970},
+	{1322928522296090632, 4405123886583564033, 12874048248970239505, 122707348324758713},
+	{12356045372503526405, 14282417475183197280, 1128751127965317834, 76692092702974196},
+	{15445056715629408006, 8629649807124220792, 1410938909956647293, 95865115878717745},
+	{859576820827208392, 15398748277332663895, 6375359655873197020, 119831394848397181},
+	{7454764540658087101, 400845636478139126, 6290442794134442090, 74894621780248238},
+	{95083638967833068, 9724429082452449716, 17086425529522828420, 93618277225310297},
+	{118854548709791335, 12155536353065562145, 7522973856621371813, 117022846531637872},
+	{11603499139012089344, 9903053229879670292, 4701858660388357383, 73139279082273670},
+	{14504373923765111680, 7767130518922199961, 15100695362340222537, 91424098852842087},
+	{4295409349424225889, 14320599167080137856, 14264183184497890267, 114280123566052609},
+	{5369261686780282361, 13289062940422784416, 3995170925340199122, 142850154457565762},
+	{3355788554237676475, 12917350356191628164, 7108667846765012355, 89281346535978601},
+	{4194735692797095594, 11535001926812147301, 13497520826883653348, 111601683169973251},
+	{9855105634423757397, 14418752408515184126, 12260215015177178781, 139502103962466564},
+	{1547755003087460469, 11317563264535684031, 16886006421340512546, 87188814976541602},
+	{15769751809141489298, 4923582043814829230, 11884135989820864875, 108986018720677003},
+	{10488817724572085815, 1542791536341148634, 10243483968848693190, 136232523400846254},
+	{11167197096284941538, 14799302765495381608, 1790491462103045339, 85145327125528909},
+	{13958996370356176923, 13887442438441839106, 6849800346056194578, 106431658906911136},
+	{8225373426090445346, 8135931011197523075, 8562250432570243223, 133039573633638920},
+	{2835015382092834389, 12002485909639533778, 5351406520356402014, 83149733521024325},
+	{12767141264470818794, 5779735350194641414, 11300944168872890422, 103937166901280406},
+	{6735554543733747685, 16448041224598077576, 4902808174236337219, 129921458626600508},
+	{4209721589833592303, 7974182756160104533, 12287627145752486570, 81200911641625317},
+	{9873838005719378283, 744356408345354858, 1524475876908444501, 101501139552031647},
+	{3118925470294447045, 5542131528859081477, 15740652901417719338, 126876424440039558},
+	{4255171428147723355, 8075518223964313827, 5226222044958686682, 79297765275024724},
+	{707278266757266290, 871025743100616476, 6532777556198358353, 99122206593780905},
+	{884097833446582863, 5700468197303158499, 12777657963675335845, 123902758242226131},
+	{16693462210399971953, 5868635632528168013, 5680193218083390951, 77439223901391332},
+	{7031769707717801229, 2724108522232822113, 7100241522604238689, 96799029876739165},
+	{13401398153074639441, 8016821671218415545, 13486987921682686265, 120998787345923956},
+	{1458344818030567794, 16539728590579979476, 17652739487906454723, 75624242091202472},
+	{1822931022538209743, 16062974719797586441, 3619180286173516788, 94530302614003091},
+	{6890349796600150083, 1631974326037431435, 18359033412999059698, 118162878267503863},
+	{2000625613661399850, 5631669972200782551, 18391924910765494167, 73851798917189914},
+	{16335840072358913524, 2427901446823590284, 13766534101602091901, 92314748646487393},
+	{1973056016739090289, 7646562826956875760, 3373109571720451164, 115393435808109242},
+	{1233160010461931431, 14002473803702823158, 6719879500752669881, 72120897380068276},
+	{10764822049932190097, 3668034199346365235, 8399849375940837352, 90151121725085345},
+	{8844341543987849717, 4585042749182956544, 15111497738353434594, 112688902156356681},
+	{11055426929984812146, 14954675473333471488, 5054314117659629530, 140861127695445852},
+	{6909641831240507591, 13958358189260807584, 12382318360392044264, 88038204809653657},
+	{8637052289050634489, 17447947736576009480, 1642839895207891618, 110047756012067072},
+	{10796315361313293111, 12586562633865236042, 2053549869009864523, 137559695015083840},
+	{11359383119248196098, 5560758636952078574, 1283468668131165327, 85974809384427400},
+	{4975856862205469315, 2339262277762710314, 1604335835163956659, 107468511730534250},
+	{15443193114611612452, 16759135902485551604, 11228791830809721631, 134335639663167812},
+	{428623659777481974, 17391988966694551609, 16241366931110851827, 83959774789479882},
+	{5147465593149240372, 17128300189940801607, 11078336627033788976, 104949718486849853},
+	{1822645973009162561, 2963631163716450393, 12862728510072509, 131187148108562317},
+	{12668368779199196361, 4158112486536475447, 2313882214532489270, 81991967567851448},
+	{11223774955571607547, 14421012645025370117, 2892352768165611587, 102489959459814310},
+	{194660639182345722, 13414579787854324743, 12838812997061790292, 128112449324767887},
+	{7039191927130047932, 17607484404263728772, 14941787150804700788, 80070280827979929},
+	{8798989908912559915, 3562611431620109349, 4842175883223712274, 100087851034974912},
+	{15610423404568087798, 13676636326379912494, 6052719854029640342, 125109813793718640},
+	{5144828609427666969, 3936211685560057405, 3782949908768525214, 78193633621074150},
+	{11042721780211971616, 14143636643804847564, 13952059422815432325, 97742042026342687},
+	{13803402225264964520, 3844487749473895743, 12828388260091902503, 122177552532928359},
+	{15544655418431684681, 9320333871062266695, 14935271690198520920, 76360970333080224},
+	{14819133254612217947, 11650417338827833369, 222345539038599534, 95451212916350281},
+	{4688858512983108722, 5339649636680015904, 4889617942225637322, 119314016145437851},
+	{2930536570614442951, 7948967041352397844, 750168204677329374, 74571260090898657},
+	{3663170713268053689, 712836764835721497, 5549396274274049622, 93214075113623321},
+	{9190649410012455015, 10114417992899427679, 11548431361269949931, 116517593892029151},
+	{12661684908898866240, 4015668236348448347, 14135298628434800563, 72823496182518219},
+	{11215420117696194897, 407899277008172530, 13057437267116112800, 91029370228147774},
+	{4795903110265467813, 509874096260215663, 7098424547040365192, 113786712785184718},
+	{1383192869404446862, 637342620325269579, 18096402720655232298, 142233390981480897},
+	{17005396607873636953, 5010025156130681390, 4392722672768438330, 88895869363425561},
+	{12033373722987270383, 15485903482018127546, 10102589359387935816, 111119836704281951},
+	{5818345116879312171, 910635278813107817, 8016550680807531867, 138899795880352439},
+	{15165680744118039867, 16710048113754050049, 11927873203145789272, 86812372425220274},
+	{5122042874865386122, 2440816068483010946, 5686469467077460783, 108515465531525343},
+	{15625925630436508460, 16886078140885927394, 2496400815419438074, 135644331914406679},
+	{14377889537450205691, 15165484856481092525, 8477779537278230652, 84777707446504174},
+	{4137303866530593402, 510111996891814041, 1373852384743012508, 105972134308130218},
+	{9783315851590629657, 637639996114767551, 10940687517783541443, 132465167885162772},
+	{13032101434885225391, 16539426062067587383, 16061301735469489209, 82790729928226732},
+	{11678440775179143835, 6839224522302320517, 1629883095627309896, 103488412410283416},
+	{762992913691766082, 8549030652877900647, 2037353869534137370, 129360515512854270},
+	{7394399598698435657, 9954830176476075808, 15108404223740999568, 80850322195533918},
+	{9242999498373044572, 12443537720595094760, 9662133242821473652, 101062902744417398},
+	{11553749372966305715, 15554422150743868450, 2854294516672066257, 126328628430521748},
+	{11832779376531328976, 2803984816573835925, 11007306109774817219, 78955392769076092},
+	{955916165381997508, 17340039075999458619, 13759132637218521523, 98694240961345115},
+	{15029953262009660597, 17063362826571935369, 12587229778095764000, 123367801201681394},
+	{2476191761114956017, 10664601766607459606, 12478704629737240404, 77104875751050871},
+	{12318611738248470829, 13330752208259324507, 10986694768744162601, 96381094688813589},
+	{10786578654383200632, 2828382205041991922, 18345054479357591156, 120476368361016986},
+	{11353297677416888299, 10991110915006020759, 16077345068025882376, 75297730225635616},
+	{9579936078343722470, 13738888643757525949, 1649937261322801354, 94122162782044521},
+	{16586606116357040992, 7950238767842131628, 6674107595080889597, 117652703477555651},
+	{1143256785868374812, 7274742239115026220, 1865474237711862046, 73532939673472282},
+	{1429070982335468515, 18316799835748558583, 11555214833994603365, 91916174591840352},
+	{15621396783201499355, 9060941739403534516, 14444018542493254207, 114895218239800440},
+	{1080001905292322578, 6714491155827030242, 18055023178116567759, 143619022799750550},
+	{5286687209235089515, 11114086000032975757, 6672703467895466945, 89761889249844094},
+	{11220045029971249798, 57549444759055984, 17564251371724109490, 112202361562305117},
+	{14025056287464062248, 9295308842803595788, 8120256159372973150, 140252951952881397},
+	{17989032216519814713, 1197882008324859463, 7381003108821802171, 87658094970550873},
+	{17874604252222380487, 15332410565688238041, 13837939904454640617, 109572618713188591},
+	{8508197259995811897, 5330455151828133840, 12685738862140912868, 136965773391485739},
+	{5317623287497382435, 12554906506747359458, 5622743779624376590, 85603608369678587},
+	{15870401146226503852, 6470261096579423514, 2416743706103082834, 107004510462098234},
+	{10614629395928354008, 17311198407579055201, 12244301669483629350, 133755638077622792},
+	{18163358418523691015, 6207812986309521596, 7652688543427268344, 83597273798514245},
+	{4257453949445062152, 7759766232886901996, 14177546697711473334, 104496592248142806},
+	{5321817436806327690, 476335754253851687, 8498561335284565860, 130620740310178508},
+	{10243664925645036662, 9521081883263433112, 14534972871407629470, 81637962693861567},
+	{12804581157056295828, 2677980317224515582, 13557030070832148934, 102047453367326959},
+	{6782354409465593977, 12570847433385420286, 12334601570112798263, 127559316709158699},
+	{18074029561198159948, 14774308673506969534, 5403282972106804962, 79724572943224187},
+	{13369164914642924127, 9244513805028936110, 2142417696706118299, 99655716179030234},
+	{7488084106448879350, 6943956237858782234, 11901394157737423682, 124569645223787792},
+	{9291738584957937498, 8951658667089126800, 7438371348585889801, 77856028264867370},
+	{11614673231197421872, 15801259352288796404, 74592148877586443, 97320035331084213},
+	{14518341538996777341, 15139888171933607601, 4704926204524370958, 121650044163855266},
+	{2156434434231903982, 4850744089031116847, 7552264896255119753, 76031277602409541},
+	{16530601098072043689, 10675116129716283962, 14052017138746287595, 95039097003011926},
+	{11439879335735278804, 8732209143717967049, 8341649386578083686, 118798871253764908},
+	{232395557193467396, 845944696396341502, 14436902903466078112, 74249294533603067},
+	{9513866483346610053, 1057430870495426877, 13434442610905209736, 92811618167003834},
+	{16504019122610650471, 1321788588119283596, 7569681226776736362, 116014522708754793},
+	{1091639914776880736, 5437803886001940152, 16260265812803929986, 72509076692971745},
+	{1364549893471100920, 16020626894357200998, 6490274210722748770, 90636345866214682},
+	{10929059403693651958, 10802411581091725439, 17336214800258211771, 113295432332768352},
+	{9049638236189677044, 8891328457937268895, 3223524426613213098, 141619290415960441},
+	{12573552925259630008, 10168766304638180963, 13543917812701727946, 88512056509975275},
+	{11105255138147149606, 3487585843942950396, 12318211247449772029, 110640070637469094},
+	{13881568922683937008, 8971168323356075899, 6174392022457439228, 138300088296836368},
+	{6370137567463766678, 14830352238952323245, 3858995014035899517, 86437555185522730},
+	{12574357977757096252, 4702882243408240344, 14047115804399650205, 108046943981903412},
+	{15717947472196370315, 10490288822687688334, 17558894755499562756, 135058679977379265},
+	{5212031151695343542, 15779802551034581017, 4056780194546144866, 84411674985862041},
+	{11126724958046567332, 10501381151938450463, 9682661261610068987, 105514593732327551},
+	{9296720179130821261, 8515040421495675175, 7491640558585198330, 131893242165409439},
+	{12727979139597845144, 9933586281862184888, 11599804376756830812, 82433276353380899},
+	{15909973924497306430, 12416982852327731110, 9888069452518650611, 103041595441726124},
+	{10664095368766857230, 10909542546982275984, 12360086815648313264, 128801994302157655},
+	{6665059605479285769, 6818464091863922490, 14642583287421277646, 80501246438848534},
+	{17554696543703883019, 17746452151684678920, 9079857072421821249, 100626558048560668},
+	{3496626605920302158, 8348007134323684939, 11349821340527276562, 125783197560700835},
+	{18326292693196046512, 9829190477379690990, 4787795328615853899, 78614498475438022},
+	{13684493829640282333, 7674802078297225834, 15208116197624593182, 98268123094297527},
+	{7882245250195577108, 370130561016756485, 14398459228603353574, 122835153867871909},
+	{7232246290585929644, 14066389655917636515, 11304880027090789935, 76771971167419943},
+	{4428621844805024151, 12971301051469657740, 9519414015436099515, 95964963959274929},
+	{5535777306006280189, 11602440295909684271, 16510953537722512298, 119956204949093661},
+	{10377389843895006974, 11863211203370940573, 12625188970290264138, 74972628093183538},
+	{17583423323296146622, 5605641967358899908, 6558114176008054365, 93715785116479423},
+	{3532535080410631661, 11618738477626012790, 3585956701582680052, 117144731395599279},
+	{16042892480538808500, 16485083585371033801, 9158751966130256888, 73215457122249549},
+	{6218557545391346913, 2159610408004240636, 16060125976090209015, 91519321402811936},
+	{7773196931739183642, 16534571065287464507, 1628413396403209652, 114399151753514921},
+	{5104810146246591648, 2221469757899779018, 6647202763931399970, 142998939691893651},
+	{7802192359831507684, 6000104617114749790, 1848658718243431029, 89374337307433532},
+	{529368412934608797, 12111816789820825142, 2310823397804288786, 111717921634291915},
+	{9885082553023036805, 5916398950421255619, 16723587302537524695, 139647402042864893},
+	{3872333586425704051, 10615278371654366618, 12758085073299646886, 87279626276790558},
+	{14063789019886905872, 4045725927713182464, 6724234304769782800, 109099532845988198},
+	{17579736274858632340, 5057157409641478080, 17628664917817004308, 136374416057485247},
+	{10987335171786645212, 12384095417880699608, 17935444601276709548, 85234010035928279},
+	{13734168964733306515, 15480119272350874510, 17807619733168499031, 106542512544910349},
+	{7944339169061857336, 14738463072011205234, 8424466611178460077, 133178140681137937},
+	{9576897999091048739, 11517382429220697223, 16794506678055007308, 83236337925711210},
+	{7359436480436423020, 14396728036525871529, 11769761310713983327, 104045422407139013},
+	{13810981618972916679, 13384224027229951507, 877143583110315447, 130056778008923767},
+	{6326020502644378972, 15282669044659801548, 7465743767085029010, 81285486255577354},
+	{7907525628305473715, 9879964268969976127, 108807672001510455, 101606857819471693},
+	{5272721016954454240, 7738269317785082255, 4747695608429275973, 127008572274339616},
+	{10212979663237615756, 7142261332829370361, 2967309755268297483, 79380357671462260},
+	{17377910597474407599, 4316140647609325047, 3709137194085371854, 99225447089327825},
+	{17110702228415621595, 14618547846366432117, 9248107511034102721, 124031808861659781},
+	{13000031901973457449, 2219063376337938217, 8085910203610008153, 77519880538537363},
+	{2414981822184658099, 7385515238849810676, 5495701736085122287, 96899850673171704},
+	{3018727277730822624, 4620208030134875441, 6869627170106402859, 121124813341464630},
+	{13415919594650233900, 581787009620603198, 18128575036598665499, 75703008338415393},
+	{7546527456458016567, 14562291817307917710, 8825660740466168161, 94628760423019242},
+	{209787283717744900, 4367806716352733426, 1808703888727934394, 118285950528774053},
+	{4742803070750978467, 7341565216147846295, 3436282939668652948, 73928719080483783},
+	{1316817820011335179, 9176956520184807869, 18130411729867979897, 92410898850604728},
+	{6257708293441556878, 16082881668658397740, 4216270588625423255, 115513623563255911},
+	{13134439720255748857, 16969330070552580443, 9552698145531971390, 72196014727034944},
+	{11806363631892298167, 11988290551335949746, 11940872681914964238, 90245018408793680},
+	{5534582503010596901, 5761991152315161375, 14926090852393705298, 112806273010992100},
+	{2306542110335858222, 16425860977248727527, 210869491782580006, 141007841263740126},
+	{8359117846600993245, 5654477092353066800, 13966851487646276216, 88129900789837578},
+	{10448897308251241556, 7068096365441333500, 8235192322703069462, 110162375987296973},
+	{13061121635314051945, 18058492493656442683, 14905676421806224731, 137702969984121216},
+	{5857358012857588514, 8980714799321582725, 9316047763628890457, 86064356240075760},
+	{11933383534499373546, 15837579517579366310, 11645059704536113071, 107580445300094700},
+	{5693357381269441125, 15185288378546819984, 14556324630670141339, 134475556625118375},
+	{3558348363293400703, 7184962227378068538, 16015231921809920193, 84047222890698984},
+	{13671307490971526687, 13592888802649973576, 1572295828552848625, 105059028613373731},
+	{17089134363714408358, 3156052948030303258, 15800427840973224494, 131323785766717163},
+	{15292394995748893128, 15807591147801103248, 7569424391394571356, 82077366104198227},
+	{668749670976564794, 1312744861041827445, 4850094470815826292, 102596707630247784},
+	{5447623107148093896, 1640931076302284306, 6062618088519782865, 128245884537809730},
+	{8016450460394946589, 12554796968757397451, 8400822323752252194, 80153677836131081},
+	{5408877057066295332, 6470124174091971006, 15112713923117703147, 100192097295163851},
+	{15984468358187644974, 3475969199187575853, 14279206385469741030, 125240121618954814},
+	{12296135733080972060, 16007538804774398620, 4312817972491200239, 78275076011846759},
+	{15370169666351215076, 15397737487540610371, 779336447186612395, 97843845014808449},
+	{14601026064511630941, 14635485840998375060, 5585856577410653398, 122304806268510561},
+	{18349013327174545146, 4535492632196596508, 15020375406950128134, 76440503917819100},
+	{4489522585258629816, 14892737827100521444, 328725184978108551, 95550629897273876},
+	{5611903231573287270, 14004236265448263901, 410906481222635689, 119438287371592345},
+	{5813282528946998496, 1835118638264083082, 11786031596832617066, 74648929607245215},
+	{16489975198038523928, 11517270334684879660, 10120853477613383428, 93311162009056519},
+	{2165724923838603294, 14396587918356099576, 8039380828589341381, 116638952511320649},
+	{1353578077399127059, 11303710458186256187, 16553828063936808123, 72899345319575405},
+	{15527030652031072535, 9517952054305432329, 6857227024638846442, 91124181649469257},
+	{5573730259756676957, 2674068031027014604, 13183219799225945957, 113905227061836571},
+	{6967162824695846197, 7954271057211156159, 11867338730605044542, 142381533827295714},
+	{11272005793075985729, 359733392329584695, 12028772725055540743, 88988458642059821},
+	{9478321222917594257, 14284724795694144581, 1200907851037262216, 111235573302574777},
+	{16459587547074380726, 17855905994617680726, 6112820832223965674, 139044466628218471},
+	{5675556198494100049, 15771627265063438358, 10738042047781060402, 86902791642636544},
+	{16317817284972400870, 10491162044474522139, 13422552559726325503, 108628489553295680},
+	{15785585587788113183, 8502266537165764770, 16778190699657906879, 135785611941619600},
+	{14477677010794958643, 12231445613369684837, 10486369187286191799, 84866007463512250},
+	{4262038208211534592, 10677620998284718143, 3884589447252963941, 106082509329390313},
+	{715861741837030337, 17958712266283285583, 9467422827493592830, 132603136661737891},
+	{7364942616289225816, 6612509147999665585, 3611296257969801567, 82876960413586182},
+	{13817864288788920174, 3653950416572194077, 13737492359317027767, 103596200516982727},
+	{3437272305703986506, 18402496075997406309, 12560179430718896804, 129495250646228409},
+	{4454138200278685518, 2278188010643603135, 932583116558228647, 80934531653892756},
+	{955986731920968994, 16682793068586667631, 1165728895697785808, 101168164567365945},
+	{15030041470183374954, 2406747262023782922, 6068847138049620165, 126460205709207431},
+	{14005461937291997250, 3810060047978558278, 10710558488922094459, 79037628568254644},
+	{8283455384760220755, 150889041545809944, 13388198111152618074, 98797035710318305},
+	{10354319230950275944, 9411983338787038238, 2900189583658608880, 123496294637897882},
+	{1859763500916534561, 5882489586741898899, 6424304508214018454, 77185184148686176},
+	{16159762431427831913, 16576484020282149431, 8030380635267523067, 96481480185857720},
+	{15588017020857401987, 16108919006925298885, 10037975794084403834, 120601850232322150},
+	{12048353647249570194, 14679760397755699707, 1662048852875364492, 75376156395201344},
+	{10448756040634574838, 18349700497194624634, 2077561066094205615, 94220195494001680},
+	{3837573013938442740, 18325439603065892889, 2596951332617757019, 117775244367502100},
+	{13927698179779996472, 9147556742702489103, 10846466619740873945, 73609527729688812},
+	{12797936706297607687, 16046131946805499283, 13558083274676092431, 92011909662111015},
+	{11385734864444621704, 15445978915079486200, 12335918074917727635, 115014887077638769},
+	{14232168580555777131, 14695787625421969846, 1584839538364995832, 143768608847048462},
+	{4283419344419972803, 9184867265888731154, 14825582766760286107, 89855380529405288},
+	{14577646217379741811, 6869398063933526038, 85234384740806018, 112319225661756611},
+	{8998685734869901456, 17810119616771683356, 13941601036208171234, 140399032077195763},
+	{14847550621148464218, 15743010778909690001, 6407657638416413069, 87749395048247352},
+	{4724380221153416561, 5843705418354948790, 8009572048020516337, 109686743810309190},
+	{15128847313296546509, 11916317791371073891, 788593023170869613, 137108429762886488},
+	{7149686561596647616, 9753541628820615134, 492870639481793508, 85692768601804055},
+	{18160480238850585328, 12191927036025768917, 14451146354634405597, 107115960752255068},
+	{8865542243281067948, 1404850739750047435, 18063932943293006997, 133894950940318835},
+	{3235120892836973515, 3183874721557473599, 8984115080344435421, 83684344337699272},
+	{17878959171328380606, 8591529420374229902, 11230143850430544276, 104605430422124090},
+	{13125326927305699950, 10739411775467787378, 4814307776183404537, 130756788027655113},
+	{12815015347993450373, 18241347405735836871, 14538157406183097595, 81722992517284445},
+	{11407083166564425062, 18189998238742408185, 4337638702446708282, 102153740646605557},
+	{423795902923367615, 13514125761573234424, 10033734396485773257, 127692175808256946},
+	{264872439327104759, 1528799573342189659, 10882770016230996190, 79807609880160591},
+	{14166148604441044661, 11134371503532512881, 8991776501861357333, 99759512350200739},
+	{3872627700269142115, 82906324133477390, 6628034608899308763, 124699390437750924},
+	{16255450367950377534, 16192717517079281032, 13365893667416843784, 77937119023594327},
+	{1872568886228420301, 1794152822639549675, 12095681065843666827, 97421398779492909},
+	{16175769163067689089, 16077749083581600805, 1284543277022419821, 121776748474366137},
+	{12415698736130999632, 12354436186452194455, 12332054594207482148, 76110467796478835},
+	{10907937401736361636, 15443045233065243069, 10803382224331964781, 95138084745598544},
+	{18246607770597839950, 5468748486049390124, 13504227780414955977, 118922605931998180},
+	{2180757819768874160, 14947182849849338588, 17663514399614123293, 74326628707498862},
+	{2725947274711092700, 4848920507029509523, 12856020962662878309, 92908285884373578},
+	{17242492148671029588, 10672836652214274807, 6846654166473822078, 116135357355466973},
+	{17694086620560475348, 2058836889206533850, 6585001863259832751, 72584598347166858},
+	{12894236238845818377, 16408604166790331025, 17454624365929566746, 90730747933958572},
+	{2282737243275109260, 11287383171633137974, 3371536383702406817, 113413434917448216},
+	{12076793590948662383, 274170909259258755, 4214420479628008522, 141766793646810270},
+	{5242152985129220037, 4783042836714424626, 16469070855049669038, 88604246029256418},
+	{15776063268266300854, 15202175582747806590, 11362966531957310489, 110755307536570523},
+	{10496707048478100260, 5167661423152594526, 9592022146519250208, 138444134420713154},
+	{1948755886871424758, 3229788389470371579, 10606699860001919284, 86527584012945721},
+	{16271002913871444660, 4037235486837964473, 17870060843429787009, 108159480016182151},
+	{6503695587057142113, 9658230376974843496, 17725890035859845857, 135199350020227689},
+	{4064809741910713821, 17565609031677746945, 4161152244771321804, 84499593762642306},
+	{9692698195815780180, 3510267215887632065, 14424812342818928064, 105624492203302882},
+	{16727558763197113129, 4387834019859540081, 8807643391668884272, 132030615254128603},
+	{3537195199357113849, 2742396262412212551, 3198934110579358718, 82519134533830377},
+	{18256552054478556024, 12651367364870041496, 8610353656651586301, 103148918167287971},
+	{4373945994388643414, 1979151150805388159, 6151256052387094973, 128936147709109964},
+	{9651245274133983990, 3542812478467061551, 13067907069596710166, 80585092318193727},
+	{7452370574240092083, 13651887634938602747, 11723197818568499803, 100731365397742159},
+	{4703777199372727200, 12453173525245865530, 10042311254783236850, 125914206747177699},
+	{7551546768035342404, 12394919471706053860, 3970601525025829079, 78696379216986062},
+	{9439433460044178005, 10881963321205179421, 14186623943137062157, 98370474021232577},
+	{16410977843482610410, 18214140169933862180, 3898221873639163984, 122963092526540722},
+	{1033489115321855698, 11383837606208663863, 7048074689451865394, 76851932829087951},
+	{15126919449434483335, 5006424970906054020, 4198407343387443839, 96064916036359939},
+	{461905238083552553, 1646345195205179622, 636323160806916895, 120081145045449924},
+	{14123748829084384057, 7946494774644319119, 9621074012359098867, 75050715653406202},
+	{13043000017928092168, 5321432449878010995, 2802970478594097776, 93813394566757753},
+	{11692064003982727306, 6651790562347513744, 8115399116670010124, 117266743208447191},
+	{7307540002489204566, 13380741138321971898, 11989653475559838183, 73291714505279494},
+	{18357797039966281516, 12114240404475076968, 5763694807595021921, 91614643131599368},
+	{4500502226248300279, 1307742450311682499, 7204618509493777402, 114518303914499210},
+	{1013941764382987444, 10858050099744378932, 18229145173721997560, 143147879893124012},
+	{9857085639594142961, 6786281312340236832, 2169843696721472667, 89467424933202508},
+	{12321357049492678701, 3871165621997908136, 2712304620901840834, 111834281166503135},
+	{15401696311865848376, 14062329064352160978, 17225438831409464754, 139792851458128918},
+	{14237746213343543139, 13400641683647488515, 6154213251203527567, 87370532161330574},
+	{13185496748252041020, 12139116086131972740, 16916138600859185267, 109213165201663217},
+	{16481870935315051275, 10562209089237578021, 7310115195791817872, 136516456502079022},
+	{12607012343785600999, 6601380680773486263, 18403880052652049882, 85322785313799388},
+	{11147079411304613344, 17475097887821633637, 4558105992105510736, 106653481642249236},
+	{98791208848602968, 3397128286067490431, 5697632490131888421, 133316852052811545},
+	{6979273533171458711, 4429048188005875471, 15090235352400900023, 83323032533007215},
+	{4112405898036935485, 924624216579956435, 14251108172073737125, 104153790666259019},
+	{528821354118781452, 5767466289152333448, 13202199196664783502, 130192238332823774},
+	{330513346324238408, 17439724486002372117, 3639688479488101784, 81370148958014859},
+	{5024827701332685914, 3352911533793413530, 18384668654642290943, 101712686197518573},
+	{15504406663520633200, 18026197472523930624, 9145777763020699966, 127140857746898217},
+	{9690254164700395750, 6654687401900068736, 17245326147956407239, 79463036091811385},
+	{12112817705875494687, 3706673233947698016, 7721599629663345337, 99328795114764232},
+	{15141022132344368359, 9245027560862010424, 9651999537079181671, 124160993893455290},
+	{9463138832715230224, 12695671253179838371, 10644185729101876448, 77600621183409556},
+	{7217237522466649877, 15869589066474797964, 13305232161377345560, 97000776479261945},
+	{9021546903083312346, 1390242259383945839, 2796482146439518239, 121250970599077432},
+	{12555995842068152072, 7786430439756048005, 1747801341524698899, 75781856624423395},
+	{1859936747303026378, 5121352031267672103, 16019809732188037336, 94727320780529243},
+	{16159978989410946685, 6401690039084590128, 15413076146807658766, 118409150975661554},
+	{10099986868381841678, 17836114329710032542, 14244858610182174632, 74005719359788471},
+	{3401611548622526289, 3848398838427989062, 13194387244300330387, 92507149199735589},
+	{13475386472632933670, 198812529607598423, 2657926000093249272, 115633936499669487},
+	{15339645573036665400, 124257831004749014, 8578732777699362651, 72271210312293429},
+	{9951184929441055942, 13990380344038099980, 15335101990551591217, 90339012890366786},
+	{12438981161801319927, 3652917374765461263, 9945505451334713214, 112923766112958483},
+	{10937040433824262005, 13789518755311602387, 7820195795741003613, 141154707641198104},
+	{4529807261926469801, 10924292231283445444, 4887622372338127258, 88221692275748815},
+	{5662259077408087251, 4431993252249530997, 1497841946995271169, 110277115344686019},
+	{11689509865187496968, 10151677583739301650, 15707360489026252673, 137846394180857523},
+	{11917629684169573509, 17874013535905533291, 7511257296427713968, 86153996363035952},
+	{10285351086784578982, 3895772846172364998, 9389071620534642461, 107692495453794940},
+	{3633316821625947920, 9481402076142844152, 11736339525668303076, 134615619317243675},
+	{2270823013516217450, 15149248334444053403, 5029369194328995470, 84134762073277297},
+	{16673586822177435524, 9713188381200290945, 10898397511338632242, 105168452591596621},
+	{7006925472439630694, 2918113439645587874, 18234682907600678207, 131460565739495776},
+	{8991014438702157087, 8741349927419574277, 11396676817250423879, 82162853587184860},
+	{15850454066805084263, 6315001390847079942, 14245846021563029849, 102703566983981075},
+	{10589695546651579521, 12505437756986237832, 13195621508526399407, 128379458729976344},
+	{6618559716657237201, 14733427625757480501, 8247263442828999629, 80237161706235215},
+	{12884885664248934405, 4581726476914686914, 5697393285108861633, 100296452132794019},
+	{6882735043456392198, 10338844114570746547, 2510055587958689137, 125370565165992524},
+	{1995866392946551172, 17990992617675186352, 10792156779328956518, 78356603228745327},
+	{2494832991183188965, 13265368735239207132, 8878509955733807744, 97945754035931659},
+	{3118541238978986206, 16581710919049008915, 6486451426239871776, 122432192544914574},
+	{18089989338857724043, 10363569324405630571, 17889090196682083572, 76520120340571608},
+	{18000800655144767149, 12954461655507038214, 3914618672143052849, 95650150425714511},
+	{13277628782076183129, 2358019014101634056, 281587321751428158, 119562688032143139},
+	{8298517988797614455, 15308819939095684997, 16316893140590500262, 74726680020089461},
+	{14984833504424405973, 9912652887014830438, 6561058370455961616, 93408350025111827},
+	{9507669843675731659, 12390816108768538048, 3589636944642564116, 116760437531389784},
+	{5942293652297332287, 16967632104835112088, 2243523090401602572, 72975273457118615},
+	{7427867065371665358, 2762796057334338494, 16639461918284166928, 91219091821398268},
+	{61461794859805890, 3453495071667923118, 2352583324145657044, 114023864776747836},
+	{9300199280429533171, 4316868839584903897, 2940729155182071305, 142529830970934795},
+	{17341839596336927991, 14227258070809034695, 17978856786484652229, 89081144356834246},
+	{17065613476993772085, 3949014533229129657, 13250198946251039479, 111351430446042808},
+	{7496958790960051395, 324582148109024168, 16562748682813799349, 139189288057553510},
+	{4685599244350032122, 2508706851781834057, 5740031908331236689, 86993305035970944},
+	{10468685073864928056, 7747569583154680475, 7175039885414045861, 108741631294963680},
+	{8474170323903772166, 14296147997370738498, 8968799856767557326, 135927039118704600},
+	{9908042470867245508, 4323406479929323657, 5605499910479723329, 84954399449190375},
+	{16996739107011444789, 10015944118339042475, 2395188869672266257, 106192999311487969},
+	{16634237865336918082, 17131616166351190998, 7605672105517720725, 132741249139359961},
+	{5784712647408185897, 13013103113183188326, 16282760112017045213, 82963280712099975},
+	{16454262846115008180, 2431320836196821695, 15741764121593918613, 103704100890124969},
+	{15956142539216372321, 7650837063673415023, 5842147096710234554, 129630126112656212},
+	{16890118114651314556, 9393459183223272293, 12874713972298672404, 81018828820410132},
+	{7277589588031979483, 11741823979029090367, 16093392465373340505, 101273536025512665},
+	{4485300966612586450, 842221918504199247, 6281682526434511920, 126591920031890832},
+	{9720842131773948387, 526388699065124529, 3926051579021569950, 79119950019931770},
+	{16762738683144823388, 9881357910686181469, 14130936510631738245, 98899937524914712},
+	{7118365298648865523, 16963383406785114741, 17663670638289672806, 123624921906143390},
+	{6754821320869234904, 5990428610813308809, 6428108130503657600, 77265576191339619},
+	{13055212669513931534, 7488035763516636011, 3423449144702184096, 96581970239174524},
+	{11707329818465026514, 9360044704395795014, 4279311430877730120, 120727462798968155},
+	{2705395118113253667, 5850027940247371884, 368726635084887373, 75454664249355097},
+	{3381743897641567084, 11924220943736602759, 5072594312283497120, 94318330311693871},
+	{18062237927334122567, 14905276179670753448, 1729056871926983496, 117897912889617339},
+	{11288898704583826604, 9315797612294220905, 17221561609450222349, 73686195556010836},
+	{276065325447619543, 16256433033795164036, 3080207938103226320, 92107744445013546},
+	{345081656809524429, 1873797218534403429, 13073631959483808709, 115134680556266932},
+	{5043038089439293440, 6953932541595392190, 16342039949354760886, 143918350695333665},
+	{16986956861181722112, 18181265893779283830, 3296245940705643697, 89948969184583541},
+	{12010324039622376832, 8891524311941941076, 8731993444309442526, 112436211480729426},
+	{15012905049527971040, 1891033353072650537, 1691619768532027350, 140545264350911783},
+	{2465536628313900044, 15016953900952570298, 7974791382973598949, 87840790219319864},
+	{12305292822247150863, 4936134320908549160, 9968489228716998687, 109800987774149830},
+	{15381616027808938579, 1558481882708298546, 3237239499041472551, 137251234717687288},
+	{14225196035807974516, 7891580204333768447, 2023274686900920344, 85782021698554555},
+	{13169809026332580241, 9864475255417210559, 16364151413908314142, 107227527123193193},
+	{11850575264488337397, 3107222032416737391, 6620131212103228966, 134034408903991492},
+	{14324138567946292729, 15777071825542624581, 13360954044419293911, 83771505564994682},
+	{4070115154650702199, 15109653763500892823, 7477820518669341581, 104714381956243353},
+	{475957924885989845, 5052009149093952317, 13958961666764064881, 130892977445304191},
+	{2603316712267437605, 14686720764252189958, 15641880069368622406, 81808110903315119},
+	{12477517927189072815, 9135028918460461639, 14940664068283390104, 102260138629143899},
+	{10985211390558953114, 11418786148075577049, 14064144066926849726, 127825173286429874},
+	{18394972165167815456, 2525055324119847751, 13401776060256668983, 79890733304018671},
+	{18382029188032381417, 16991377210431973401, 12140534056893448324, 99863416630023339},
+	{9142478429758313059, 2792477439330415136, 10563981552689422502, 124829270787529174},
+	{5714049018598945662, 15580356454863673172, 1990802452003501159, 78018294242205734},
+	{7142561273248682077, 14863759550152203561, 11711875101859152257, 97522867802757167},
+	{13539887609988240500, 4744641382408090739, 10028157858896552418, 121903584753446459},
+	{6156586747028956361, 7577086882432444616, 3961755652596651309, 76189740470904037},
+	{7695733433786195451, 14083044621467943674, 9563880584173202040, 95237175588630046},
+	{396294755377968506, 17603805776834929593, 2731478693361726742, 119046469485787558},
+	{11776899268179700076, 6390692592094443091, 15542232238633242926, 74404043428617223},
+	{10109438066797237191, 17211737776972829672, 14816104279864165753, 93005054285771529},
+	{12636797583496546489, 7679614165933873378, 4685072294548043480, 116256317857214412},
+	{12509684508112729459, 4799758853708670861, 12151542220947302983, 72660198660759007},
+	{1802047579858748112, 1388012548708450673, 10577741757756740825, 90825248325948759},
+	{6864245493250823044, 6346701704312951245, 8610491178768538127, 113531560407435949},
+	{13191992884990916710, 3321691111963801152, 15374799991888060563, 141914450509294936},
+	{8244995553119322943, 18216958009473233384, 9609249994930037851, 88696531568309335},
+	{10306244441399153679, 18159511493414153826, 7399876475235159410, 110870664460386669},
+	{3659433514894166291, 13476017329912916475, 13861531612471337167, 138588330575483336},
+	{18428047011304711596, 15340039858836654652, 8663457257794585729, 86617706609677085},
+	{4588314690421337879, 5339991768263654604, 15441007590670620066, 108272133262096356},
+	{5735393363026672349, 15898361747184344063, 854515414628723466, 135340166577620446},
+	{10502149879532752074, 14548162110417602943, 14369130189425115878, 84587604111012778},
+	{8516001330988552188, 8961830601167227871, 8738040699926619040, 105734505138765973},
+	{6033315645308302332, 11202288251459034839, 15534236893335661704, 132168131423457466},
+	{10688351305958770813, 7001430157161896774, 14320584076762176469, 82605082139660916},
+	{4137067095593687709, 13363473714879758872, 17900730095952720586, 103256352674576145},
+	{5171333869492109636, 7480970106744922782, 8540854564658737021, 129070440843220182},
+	{17067141723714732234, 6981449325929270690, 726348084484322734, 80669025527012614},
+	{12110555117788639485, 17950183694266364171, 10131307142460179225, 100836281908765767},
+	{10526507878808411452, 8602671562550791502, 8052447909647836128, 126045352385957209},
+	{1967381405827869253, 5376669726594244689, 16561994989598367340, 78778345241223255},
+	{7070912775712224471, 6720837158242805861, 16090807718570571271, 98472931551529069},
+	{13450326988067668493, 3789360429376119422, 6278451592931050377, 123091164439411337},
+	{3794768349114904904, 13897565314428544399, 15453247291650376245, 76931977774632085},
+	{131774417966243226, 3536898587753516787, 5481501059280806595, 96164972218290107},
+	{13999776077739967744, 18256181289974059695, 2240190305673620339, 120206215272862634},
+	{15667389076228561696, 9104270297020093357, 6011804959473400616, 75128884545539146},
+	{5749178290003538408, 11380337871275116697, 16738128236196526578, 93911105681923932},
+	{11798158880931810914, 5002050302239120063, 2475916221536106607, 117388882102404916},
+	{14291378328223463677, 10043810466540531895, 10770819675314842437, 73368051314003072},
+	{13252536891851941693, 17166449101603052773, 13463524594143553046, 91710064142503840},
+	{2730613059532763404, 12234689340149040159, 16829405742679441308, 114637580178129800},
+	{17248324379698117967, 15293361675186300198, 2590013104639750019, 143296975222662251},
+	{6168516718883935825, 7252508037777743672, 17759659254895701426, 89560609514163906},
+	{7710645898604919782, 18289007084076955398, 12976202031764850974, 111950761892704883},
+	{414935336401373919, 13637886818241418440, 11608566521278675814, 139938452365881104},
+	{259334585250858699, 3911993242973498621, 7255354075799172384, 87461532728675690},
+	{4935854249990961278, 4889991553716873276, 18292564631603741288, 109326915910844612},
+	{6169817812488701598, 6112489442146091595, 4418961715795124994, 136658644888555766},
+	{1550293123591744547, 8431991919768695151, 16596909127654116833, 85411653055347353},
+	{15772924459771844395, 15151675918138256842, 6911078354285482329, 106764566319184192},
+	{10492783537860029686, 5104536842390657341, 8638847942856852912, 133455707898980240},
+	{8863832720376212506, 3190335526494160838, 5399279964285533070, 83409817436862650},
+	{1856418863615489824, 13211291444972476856, 15972471992211692145, 104262271796078312},
+	{2320523579519362281, 2679056250933432358, 1518845916555063566, 130327839745097891},
+	{15285385292481765137, 15509468212115558935, 17090179762342772392, 81454899840686181},
+	{14495045597174818518, 940091191434897053, 7527666647646301779, 101818624800857727},
+	{4283748941186359435, 15010172044575785029, 4797897291130489319, 127273281001072159},
+	{4983186097455168599, 16298886555500947499, 9916214834597637680, 79545800625670099},
+	{1617296603391572845, 1926864120666632758, 7783582524819659197, 99432250782087624},
+	{11244992791094241864, 7020266169260678851, 9729478156024573996, 124290313477609530},
+	{4722277485220207213, 13611038392642700090, 10692609865942746651, 77681445923505956},
+	{15126218893380034824, 12402111972375987208, 13365762332428433314, 97101807404382445},
+	{461029543015491914, 6279267928615208203, 2872144860253377931, 121377259255478057},
+	{16429044528880540110, 1618699446170811174, 13324305583726830967, 75860787034673785},
+	{11312933624245899330, 15858432362995677680, 2820323924376374996, 94825983793342232},
+	{14141167030307374162, 1376296380035045484, 3525404905470468746, 118532479741677790},
+	{18061601430796884659, 5471871255949291331, 16038436121201206678, 74082799838548618},
+	{17965315770068717920, 16063211106791389972, 10824673114646732539, 92603499798185773},
+	{4009900638876345784, 15467327865061849562, 18142527411735803578, 115754374747732216},
+	{7117873917725104019, 14278765934091043880, 11339079632334877236, 72346484217332635},
+	{8897342397156380024, 17848457417613804850, 9562163521991208641, 90433105271665794},
+	{1898305959590699222, 8475513716735092351, 2729332365634234994, 113041381589582243},
+	{16207940504770537740, 1371020109064089630, 17246723512324957455, 141301726986977803},
+	{5518276797054198183, 7774416595806137875, 8473359185989404457, 88313579366861127},
+	{2286159977890359825, 14329706763185060248, 5980012964059367667, 110391974208576409},
+	{2857699972362949782, 13300447435553937406, 12086702223501597488, 137989967760720511},
+	{15621120538009007325, 8312779647221210878, 14471717917329580286, 86243729850450319},
+	{10303028635656483349, 1167602522171737790, 13477961378234587454, 107804662313062899},
+	{3655413757715828378, 10682875189569448046, 12235765704365846413, 134755827891328624},
+	{16119691653854556448, 8982640002694598980, 7647353565228654008, 84222392432080390},
+	{1702870493608643944, 11228300003368248726, 335819919681041702, 105277990540100488},
+	{11351960153865580738, 4812002967355535099, 419774899601302128, 131597488175125610},
+	{4789132086952294009, 3007501854597209437, 4874045330678201734, 82248430109453506},
+	{10598101127117755416, 12982749355101287604, 15315928700202527975, 102810537636816882},
+	{13247626408897194270, 11616750675449221601, 9921538838398384161, 128513172046021103},
+	{1362237477919664562, 342940144514681645, 13118490801640071957, 80320732528763189},
+	{6314482865826968607, 5040361199070739960, 2563055446767926234, 100400915660953987},
+	{7893103582283710759, 15523823535693200758, 17038877363742071504, 125501144576192483},
+	{321503720499931320, 9702389709808250474, 8343455343125100738, 78438215360120302},
+	{9625251687479689958, 2904615100405537284, 1205947142051600115, 98047769200150378},
+	{12031564609349612448, 17465826930789085317, 10730805964419275951, 122559711500187972},
+	{9825570890057201732, 17833670859384260179, 15930125764616823277, 76599819687617482},
+	{7670277594144114261, 8457030518948161512, 10689285168916253289, 95749774609521853},
+	{9587846992680142826, 15182974167112589794, 17973292479572704515, 119687218261902316},
+	{10604090388852477170, 7183515845231674669, 2009935762878164514, 74804511413688948},
+	{17866799004492984367, 18202766843394369144, 2512419703597705642, 93505639267111185},
+	{3886754681906678843, 13530086517388185623, 7752210647924519957, 116882049083888981},
+	{9346750703832756133, 10762147082581309966, 7150974664166518925, 73051280677430613},
+	{2460066342936169358, 18064369871654025362, 13550404348635536560, 91314100846788266},
+	{12298454965524987506, 4133718265857980086, 7714633398939644893, 114142626058485333},
+	{6149696670051458574, 9778833850749863012, 14254977767101944020, 142678282573106666},
+	{13066932455636937417, 15335143193573440190, 13521047122866102916, 89173926608191666},
+	{7110293532691395963, 722184918257248622, 7677936866727852838, 111467408260239583},
+	{18111238952719020762, 10126103184676336585, 4985735064982428143, 139334260325299479},
+	{4401995317808306120, 13246343518063792222, 10033613443255099445, 87083912703312174},
+	{14725866184115158458, 2722871342297576565, 3318644767214098499, 108854890879140218},
+	{4572274674861784361, 17238647233154134419, 13371677995872398931, 136068613598925272},
+	{551828662574921273, 8468311511507640060, 8357298747420249332, 85042883499328295},
+	{689785828218651592, 10585389389384550075, 5834937415847923761, 106303604374160369},
+	{14697290340555478202, 17843422755158075497, 11905357788237292605, 132879505467700461},
+	{2268277435206092020, 13457982231187491138, 9746691626862001830, 83049690917312788},
+	{12058718830862390833, 7599105752129588114, 12183364533577502288, 103812113646640985},
+	{5850026501723212733, 9498882190161985143, 1394147611689714148, 129765142058301232},
+	{10573795591218089814, 15160173405706016522, 871342257306071342, 81103213786438270},
+	{3993872452167836460, 9726844720277744845, 10312549858487364986, 101379017233047837},
+	{9604026583637183479, 2935183863492405248, 17502373341536594137, 126723771541309796},
+	{6002516614773239674, 13363704960751223040, 1715611301605595527, 79202357213318623},
+	{7503145768466549593, 12092945182511640896, 15979572182289158121, 99002946516648278},
+	{9378932210583186991, 1281123422857387408, 10751093191006671844, 123753683145810348},
+	{5861832631614491869, 10024074176140642938, 15942805281233945710, 77346051966131467},
+	{16550662826372890645, 3306720683321027864, 15316820583115044234, 96682564957664334},
+	{2241584459256561690, 13356772891006060639, 9922653692039029484, 120853206197080418},
+	{8318519314676432912, 17571355093733563707, 10813344575951781331, 75533253873175261},
+	{5786463124918153236, 17352507848739566730, 18128366738367114568, 94416567341469076},
+	{16456450943002467353, 3243890737214906796, 4213714349249341595, 118020709176836346},
+	{1061909802521766288, 18168332775255174412, 7245257486708226400, 73762943235522716},
+	{1327387253152207860, 4263671895359416399, 9056571858385283001, 92203679044403395},
+	{15494292121722423537, 9941275887626658402, 6709028804554215847, 115254598805504244},
+	{10144493115298253613, 7814908841105935099, 8386286005692769809, 144068248506880305},
+	{4034465187847714556, 16413533071759679197, 16770643799626450890, 90042655316800190},
+	{9654767503237031099, 11293544302844823188, 11739932712678287805, 112553319146000238},
+	{12068459379046288874, 281872323273865273, 5451543853993083949, 140691648932500298},
+	{625258084262848690, 2482013211259859748, 8018900927173065372, 87932280582812686},
+	{781572605328560863, 3102516514074824685, 800254122111555907, 109915350728515858},
+	{5588651775088088982, 17713203697875694568, 10223689689494220691, 137394188410644822},
+	{3492907359430055614, 8764909301958615153, 1778120037506500028, 85871367756653014},
+	{8977820217714957421, 10956136627448268941, 11446022083737900843, 107339209695816267},
+	{15833961290571084681, 9083484765882948272, 9695841586244988150, 134174012119770334},
+	{9896225806606927925, 1065491960249454766, 1448214972975729690, 83858757574856459},
+	{3146910221403884099, 10555236987166594266, 15645326771501825824, 104823446968570573},
+	{13157009813609630932, 13194046233958242832, 5721600409095118568, 131029308710713217},
+	{8223131133506019332, 8246278896223901770, 15105215301752918865, 81893317944195760},
+	{1055541880027748357, 14919534638707265117, 434775053481596965, 102366647430244701},
+	{5931113368462073351, 4814360243101917684, 5155154835279384111, 127958309287805876},
+	{12930317892143571652, 9926504179579780408, 12445343808904390877, 79973943304878672},
+	{16162897365179464565, 17019816242902113414, 15556679761130488596, 99967429131098340},
+	{10980249669619554899, 2828026229918090152, 999105627703559130, 124959286413872926},
+	{6862656043512221811, 6379202412126194249, 14459499072596888168, 78099554008670578},
+	{13190006072817665168, 7974003015157742811, 8851001803891334402, 97624442510838223},
+	{11875821572594693557, 744131732092402706, 6452066236436780099, 122030553138547779},
+	{12034074501299071377, 16605983397053609355, 1726698388559293609, 76269095711592362},
+	{10430907108196451317, 6922421191034847982, 11381745022553892820, 95336369639490452},
+	{3815261848390788338, 8653026488793559978, 14227181278192366025, 119170462049363065},
+	{6996224673671630615, 16937356601564444746, 1974459271229146909, 74481538780851916},
+	{17968652878944314077, 7336637696673392220, 2468074089036433637, 93101923476064895},
+	{4014072024970840981, 13782483139269128180, 16920150666577705758, 116377404345081118},
+	{11732167052461551421, 4002365943615817208, 5963408148183678195, 72735877715675699},
+	{14665208815576939276, 391271411092383606, 2842574166802209840, 90919847144594624},
+	{9108138982616398287, 489089263865479508, 3553217708502762300, 113649808930743280},
+	{11385173728270497859, 611361579831849385, 4441522135628452875, 142062261163429100},
+	{198204552527979306, 16523002051890763530, 11999323371622558854, 88788913227143187},
+	{9471127727514749940, 11430380528008678604, 10387468196100810664, 110986141533928984},
+	{11838909659393437425, 14287975660010848255, 12984335245126013330, 138732676917411230},
+	{14316847564761980247, 13541670805934168063, 3503523509776370427, 86707923073382019},
+	{13284373437525087405, 12315402488990322175, 18214462442502626746, 108384903841727523},
+	{11993780778478971352, 6170881074383126911, 18156392034700895529, 135481129802159404},
+	{14413642014190438951, 15386015717557924079, 2124372984833283897, 84675706126349628},
+	{13405366499310660784, 5397461591665241387, 2655466231041604872, 105844632657937035},
+	{12145022105710938077, 6746826989581551734, 17154390844084169802, 132305790822421293},
+	{2978952797641948394, 8828452886915857738, 13027337286766300078, 82691119264013308},
+	{12947063033907211300, 1812194071790046364, 16284171608457875098, 103363899080016635},
+	{16183828792384014126, 11488614626592333763, 15743528492144955968, 129204873850020794},
+	{7809049986026314876, 7180384141620208602, 14451391326017985384, 80753046156262996},
+	{537940445678117787, 8975480177025260753, 18064239157522481730, 100941307695328745},
+	{5284111575525035138, 1995978184426800133, 8745240891620938451, 126176634619160932},
+	{5608412743916840913, 17388387429762607747, 14689147594117862339, 78860396636975582},
+	{2398829911468663238, 17123798268775871780, 9138062455792552116, 98575495796219478},
+	{2998537389335829047, 2958003762260288109, 2199206032885914338, 123219369745274348},
+	{4179928877548587106, 6460438369840067972, 10597875807408472269, 77012106090796467},
+	{5224911096935733883, 12687233980727472869, 8635658740833202432, 96265132613495584},
+	{11142824889597055258, 15859042475909341086, 10794573426041503040, 120331415766869480},
+	{2352579537570771632, 9911901547443338179, 6746608391275939400, 75207134854293425},
+	{16775782477245628252, 12389876934304172723, 13044946507522312154, 94008918567866781},
+	{16358042078129647411, 6263974131025440096, 2471125079120726481, 117511148209833477},
+	{10223776298831029632, 15444198877959369820, 3850296183664148002, 73444467631145923},
+	{12779720373538787040, 10081876560594436467, 201184211152797099, 91805584538932404},
+	{11362964448496095896, 7990659682315657680, 251480263940996374, 114756980673665505},
+	{14203705560620119870, 764952566039796292, 4926036348353633372, 143446225842081881},
+	{18100688012242350727, 9701467390629648490, 14607987763789490617, 89653891151301175},
+	{13402487978448162601, 16738520256714448517, 13648298686309475367, 112067363939126469},
+	{2918051917778039539, 16311464302465672743, 3225315302604680497, 140084204923908087},
+	{8741311476252356568, 3277136161399963608, 8933351091769007167, 87552628077442554},
+	{10926639345315445710, 17931478257032118222, 1943316827856483150, 109440785096803193},
+	{4434927144789531329, 13190975784435371970, 7040832053247991842, 136800981371003991},
+	{7383515483920844985, 12856045883699495385, 11318049060921076757, 85500613356877494},
+	{13841080373328444135, 2234999299342205519, 4924189289296570139, 106875766696096868},
+	{12689664448233167265, 16628807179459920611, 6155236611620712673, 133594708370121085},
+	{5625197270932035588, 3475475459521368526, 6152865891476639373, 83496692731325678},
+	{16254868625519820293, 8956030342829098561, 16914454401200575024, 104370865914157097},
+	{6483527726617611655, 11195037928536373202, 7308009946218555068, 130463582392696372},
+	{8663890847563395188, 16220270742190009059, 13790878253241372725, 81539738995435232},
+	{6218177541026856081, 6440280372455347612, 17238597816551715907, 101924673744294040},
+	{7772721926283570102, 3438664447141796611, 3101503196980093268, 127405842180367551},
+	{2552108194713537361, 11372537316318398690, 8855968525753640148, 79628651362729719},
+	{12413507280246697510, 14215671645397998362, 6458274638764662281, 99535814203412149},
+	{6293512063453596079, 3934531501465334241, 12684529316883215756, 124419767754265186},
+	{15462660085726967309, 11682454225270609708, 12539516841479397751, 77762354846415741},
+	{881581033449157521, 9991381763160874232, 1839337996567083477, 97202943558019677},
+	{1101976291811446901, 17100913222378480694, 6910858514136242250, 121503679447524596},
+	{14523793237664318025, 15299756782413938337, 13542658608189927214, 75939799654702872},
+	{4319683491798233820, 9901323941162647114, 16928323260237409018, 94924749568378590},
+	{14622976401602568083, 3153282889598533084, 11937032038441985465, 118655936960473238},
+	{18362732287856380859, 13500016852067552937, 2848959005598853011, 74159960600295774},
+	{9118357304538312362, 12263335046657053268, 12784570793853342072, 92699950750369717},
+	{11397946630672890453, 15329168808321316585, 2145655437034513878, 115874938437962147},
+	{206187616529474677, 4969044486773434962, 17481935712642428838, 72421836523726341},
+	{9481106557516619154, 15434677645321569510, 8017361585520872335, 90527295654657927},
+	{2628011160040998135, 14681661038224573984, 5410015963473702515, 113159119568322409},
+	{3285013950051247669, 13740390279353329576, 11374205972769516048, 141448899460403011},
+	{2053133718782029793, 8587743924595830985, 4803035723767253578, 88405562162751882},
+	{7178103166904925145, 1511307868890012923, 15227166691563842781, 110506952703439852},
+	{4360942940203768528, 6500820854539904058, 587214290745251860, 138133690879299816},
+	{7337275356054743234, 13286385070942215844, 367008931715782412, 86333556799562385},
+	{9171594195068429042, 16607981338677769805, 5070447183072115919, 107916945999452981},
+	{16076178762262924207, 16148290654919824352, 10949744997267532803, 134896182499316226},
+	{10047611726414327629, 7786838650111196268, 11455276641719595906, 84310114062072641},
+	{12559514658017909537, 510176275784219527, 484037746867331171, 105387642577590802},
+	{11087707304094999017, 14472778400012438121, 9828419220438939771, 131734553221988502},
+	{12288037418292529, 6739643490794079874, 1531075994346949453, 82334095763742814},
+	{9238732083627641470, 13036240381919987746, 11137217029788462624, 102917619704678517},
+	{2325043067679776029, 16295300477399984683, 86463231953414568, 128647024630848147},
+	{17594052981795717682, 10184562798374990426, 16194940584466741769, 80404390394280091},
+	{12769194190389871295, 17342389516396125937, 15631989712156039307, 100505487992850114},
+	{2126434682705175407, 17066300877067769518, 10316615103340273326, 125631859991062643},
+	{15164079731972898341, 6054752029739968044, 4142041430373976877, 78519912494414152},
+	{508355591256571310, 12180126055602347960, 5177551787967471096, 98149890618017690},
+	{635444489070714138, 15225157569502934950, 15695311771814114678, 122687363272522112},
+	{14232210860951360048, 4904037462511946439, 9809569857383821674, 76679602045326320},
+	{13178577557761812156, 15353418864994708857, 12261962321729777092, 95849502556657900},
+	{2638163891920101483, 745029507533834456, 15327452902162221366, 119811878195822375},
+	{1648852432450063427, 14300701497490810247, 16497187091492470209, 74882423872388984},
+	{15896123595844742996, 4040818816581349096, 2174739790656036146, 93603029840486231},
+	{1423410421096377129, 14274395557581462179, 16553482793602208894, 117003787300607788},
+	{17030532577681093369, 4309811205061025957, 1122554709146604751, 73127367062879868},
+	{7453107666819203000, 775577987898894543, 1403193386433255939, 91409208828599835},
+	{4704698565096615846, 14804530540155781891, 15589049788323733635, 114261511035749793},
+	{1269187187943381904, 13893977156767339460, 5651254180122503332, 142826888794687242},
+	{10016614029319389498, 17907107759834362970, 8143719881003952486, 89266805496679526},
+	{3297395499794461064, 13160512662938177905, 956277814400164800, 111583506870849408},
+	{8733430393170464234, 16450640828672722381, 1195347268000206000, 139479383588561760},
+	{7764237004945234098, 10281650517920451488, 747092042500128750, 87174614742851100},
+	{9705296256181542623, 3628691110545788552, 933865053125160938, 108968268428563875},
+	{12131620320226928279, 13759235925037011498, 15002389371688614884, 136210335535704843},
+	{12193948718569218078, 17822894490002907994, 7070650348091690350, 85131459709815527},
+	{6019063861356746790, 13055246075648859185, 4226626916687225034, 106414324637269409},
+	{12135515845123321391, 7095685557706298173, 9894969664286419197, 133017905796586761},
+	{9890540412415769821, 6740646482780130310, 17713571086247481758, 83136191122866725},
+	{3139803478664936469, 17649180140329938696, 8306905802527188485, 103920238903583407},
+	{3924754348331170586, 8226417120130259658, 5771946234731597703, 129900298629479259},
+	{7064657486134369520, 12059039727722494142, 1301623387493554612, 81187686643424537},
+	{18054193894522737708, 15073799659653117677, 6238715252794331169, 101484608304280671},
+	{8732684312871258423, 5007191519284233385, 3186708047565526058, 126855760380350839},
+	{16987142741613006274, 7741180717980033769, 8909221557369535642, 79284850237719274},
+	{7398870371734094131, 453103860620266404, 1913154909857143745, 99106062797149093},
+	{9248587964667617664, 5178065844202720909, 7003129655748817585, 123882578496436366},
+	{8086210487130954992, 14765506198695170328, 18212014090125174702, 77426611560272728},
+	{10107763108913693740, 9233510711514187102, 4318273538946916762, 96783264450340911},
+	{3411331849287341367, 2318516352537958070, 786155905256258049, 120979080562926139},
+	{15967140461086752066, 12978287766404693553, 16632248505281018944, 75611925351828836},
+	{6123867521076276371, 16222859708005866942, 2343566557891722064, 94514906689786046},
+	{16878206438200121272, 1831830561297782061, 12152830234219428389, 118143633362232557},
+	{12854722033088769747, 3450737110024807740, 9901361905600836695, 73839770851395348},
+	{16068402541360962183, 18148479442813173387, 12376702382001045868, 92299713564244185},
+	{15473817158273814825, 4238855229806915118, 1635819922219143624, 115374641955305232},
+	{5059449705493746362, 2649284518629321949, 1022387451386964765, 72109151222065770},
+	{10935998150294570856, 7923291666714040340, 10501356351088481764, 90136439027582212},
+	{13669997687868213570, 9904114583392550425, 13126695438860602205, 112670548784477765},
+	{3252439054553103251, 16991829247668075936, 2573311243293589044, 140838185980597207},
+	{2032774409095689532, 1396521242937771652, 8525848554699575009, 88023866237873254},
+	{2540968011369611915, 6357337572099602469, 1433938656519692953, 110029832797341568},
+	{7787896032639402798, 12558357983551890990, 1792423320649616191, 137537290996676960},
+	{255749001972238844, 14766502767361013725, 1120264575406010119, 85960806872923100},
+	{4931372270892686460, 13846442440773879252, 1400330719257512649, 107451008591153875},
+	{6164215338615858075, 3472994995685185353, 15585471454354054524, 134313760738942343},
+	{15381849632703381056, 11393993909158016653, 16658448686612365933, 83946100461838964},
+	{5392253985597062609, 407434331165357105, 2376316784555905801, 104932625577298706},
+	{11352003500423716165, 5120978932384084285, 12193768017549658059, 131165781971623382},
+	{9400845196978516555, 894768823526358726, 3009418992541148383, 81978613732264614},
+	{2527684459368369886, 14953519084690112120, 12985145777531211286, 102473267165330767},
+	{3159605574210462357, 9468526819007864342, 11619746203486626204, 128091583956663459},
+	{15809811539163702685, 15141201298734691021, 4956498367965447425, 80057239972914662},
+	{5927206368672464645, 5091443568136200065, 15418994996811585090, 100071549966143327},
+	{12020693979267968710, 15587676497025025889, 14662057727587093458, 125089437457679159},
+	{595404709401398587, 14353983829068029085, 16081315107383015267, 78180898411049474},
+	{5355941905179136138, 13330793767907648452, 10878271847373993276, 97726123013811843},
+	{6694927381473920173, 16663492209884560565, 8986153790790103691, 122157653767264804},
+	{6490172622634894060, 8108839621964156401, 14839718156098590615, 76348533604540502},
+	{12724401796721005479, 5524363509027807597, 9326275658268462461, 95435667005675628},
+	{2070444190619093137, 11517140404712147401, 11657844572835578076, 119294583757094535},
+	{12823242665205402971, 16421584789799867933, 14203681885663318153, 74559114848184084},
+	{2193995276224590001, 6691922931967671205, 17754602357079147692, 93198893560230105},
+	{7354180113708125406, 8364903664959589006, 8358194891066770903, 116498616950287632},
+	{18431420626349742090, 12145593818240824984, 5223871806916731814, 72811635593929770},
+	{4592531709227625997, 5958620235946255423, 15753211795500690576, 91014544492412212},
+	{1128978618107144593, 7448275294932819279, 1244770670666311604, 113768180615515266},
+	{15246281327916094453, 9310344118666024098, 10779335375187665313, 142210225769394082},
+	{14140611848374946937, 17348180120234734821, 11348770627919678724, 88881391105871301},
+	{3840706755186519959, 3238481076583866911, 350905229617434694, 111101738882339127},
+	{189197425555762045, 13271473382584609447, 14273689592303957079, 138877173602923908},
+	{7035777418613433134, 15212199891756462760, 18144428032044748982, 86798233501827442},
+	{8794721773266791418, 9791877827840802642, 13457163003201160420, 108497791877284303},
+	{1770030179728713464, 12239847284801003303, 12209767735574062621, 135622239846605379},
+	{8023797889971527771, 9955747562214321016, 5325261825520095186, 84763899904128362},
+	{10029747362464409714, 3221312415913125462, 15879949318754894791, 105954874880160452},
+	{3313812166225736334, 17861698575173570540, 1403192574734066872, 132443593600200566},
+	{11294504640745861017, 11163561609483481587, 14712053414490955507, 82777246000125353},
+	{9506444782504938367, 9342765993426964080, 4555008712831530672, 103471557500156692},
+	{11883055978131172959, 11678457491783705100, 5693760891039413340, 129339446875195865},
+	{16650282023186758907, 16522407969219591495, 15087815602968103097, 80837154296997415},
+	{16201166510556060730, 6817951906242325657, 14248083485282740968, 101046442871246769},
+	{6416400082912912201, 8522439882802907072, 3975046301321262498, 126308053589058462},
+	{4010250051820570125, 9938210945179204824, 16319461993607952773, 78942533493161538},
+	{5012812564775712657, 17034449699901393934, 11175955455155165158, 98678166866451923},
+	{15489387742824416629, 12069690088021966609, 9358258300516568544, 123347708583064904},
+	{2763338311624178537, 7543556305013729131, 5848911437822855340, 77092317864415565},
+	{17289230944812386883, 9429445381267161413, 11922825315705957079, 96365397330519456},
+	{7776480625733319892, 7175120708156563863, 14903531644632446349, 120456746663149320},
+	{11777829418724406788, 6790293451811546366, 9314707277895278968, 75285466664468325},
+	{5498914736550732678, 8487866814764432958, 16255070115796486614, 94106833330585406},
+	{16097015457543191655, 1386461481600765389, 11095465607890832460, 117633541663231758},
+	{12366477670178188736, 10089910462855254176, 2322979986504382383, 73520963539519849},
+	{15458097087722735921, 8000702060141679816, 7515411001557865883, 91901204424399811},
+	{875877285943868285, 5389191556749711867, 4782577733519944450, 114876505530499764},
+	{14929904662711999068, 15959861482791915641, 5978222166899930562, 143595631913124705},
+	{2413661386553917561, 14586599445172335180, 15265603900380926361, 89747269945702940},
+	{3017076733192396952, 4398191251183255263, 635260801766606336, 112184087432128676},
+	{17606403971772659902, 5497739063979069078, 794076002208257920, 140230109290160845},
+	{6392316463930524535, 3436086914986918174, 2802140510593855152, 87643818306350528},
+	{17213767616767931476, 4295108643733647717, 3502675638242318940, 109554772882938160},
+	{7682151465677750633, 5368885804667059647, 4378344547802898675, 136943466103672700},
+	{11718873693689676002, 1049710618703218327, 11959837379231587480, 85589666314795437},
+	{10036906098684707098, 1312138273379022909, 1114738668757320638, 106987082893494297},
+	{17157818641783271777, 10863544878578554444, 6005109354374038701, 133733853616867871},
+	{1500264614259769052, 9095558558325290480, 10670722374124856044, 83583658510542419},
+	{1875330767824711316, 11369448197906613100, 8726716949228682151, 104479573138178024},
+	{2344163459780889145, 9600124228955878471, 10908396186535852689, 130599466422722530},
+	{8382631190004137571, 17529292689165893804, 11429433635012295834, 81624666514201581},
+	{10478288987505171964, 12688243824602591447, 451733988483206081, 102030833142751977},
+	{8486175215954077051, 2025246725471075597, 5176353504031395506, 127538541428439971},
+	{7609702519184992109, 5877465221846810152, 929377930805928239, 79711588392774982},
+	{9512128148981240136, 2735145508881124786, 10385094450362186107, 99639485490968727},
+	{2666788149371774362, 17253989941383569695, 8369682044525344729, 124549356863710909},
+	{8584271620998440832, 3866214685723649203, 7536894287042034408, 77843348039819318},
+	{6118653507820663137, 4832768357154561504, 197745821947767202, 97304185049774148},
+	{7648316884775828921, 15264332483297977688, 247182277434709002, 121630231312217685},
+	{4780198052984893075, 14151893820488623959, 2460331932610387078, 76018894570136053},
+	{1363561547803728440, 8466495238756004141, 7687100934190371752, 95023618212670066},
+	{6316137953182048454, 10583119048445005176, 385504130883188882, 118779522765837583},
+	{3947586220738780284, 11226135423705516139, 7158469109443074907, 74237201728648489},
+	{322796757496087451, 9420983261204507270, 13559772405231231538, 92796502160810611},
+	{9626867983724885122, 2552857039650858279, 12338029488111651519, 115995627701013264},
+	{12934321517469135057, 8513064677422868280, 7711268430069782199, 72497267313133290},
+	{16167901896836418821, 6029644828351197446, 415713500732451941, 90621584141416613},
+	{10986505334190747719, 12148742053866384712, 5131327894342952830, 113276980176770766},
+	{13733131667738434649, 5962555530478205082, 15637531904783466846, 141596225220963457},
+	{13194893310763909559, 17561655261831041888, 2855928412848584922, 88497640763102161},
+	{16493616638454886949, 12728697040434026552, 8181596534488119057, 110622050953877701},
+	{2170276724359057070, 2075813245260369479, 14838681686537536726, 138277563692347126},
+	{8273951980365492525, 15132441333569894636, 4662490035658572549, 86423477307716954},
+	{10342439975456865656, 5080493611680204583, 15051484581427991495, 108029346634646192},
+	{8316363950893694166, 1738930996172867825, 367611653075437753, 135036683293307741},
+	{16726942515377028614, 12616046918676512150, 2535600292385842547, 84397927058317338},
+	{11685306107366509959, 11158372629918252284, 12392872402337078992, 105497408822896672},
+	{14606632634208137449, 13947965787397815355, 15491090502921348740, 131871761028620840},
+	{6823302387166391954, 17940850653978410405, 9681931564325842962, 82419850642888025},
+	{13140814002385377846, 13202691280618237198, 16714100473834691607, 103024813303610031},
+	{7202645466126946500, 11891678082345408594, 16280939573865976605, 128781016629512539},
+	{9113339434756729466, 9738141810679574323, 7869744224452541426, 80488135393445337},
+	{6779988275018523929, 2949305226494692096, 14448866298993064687, 100610169241806671},
+	{8474985343773154911, 17521689588400528832, 13449396855313942954, 125762711552258339},
+	{5296865839858221819, 15562742011177718424, 6100030025357520394, 78601694720161462},
+	{6621082299822777274, 10230055477117372222, 16848409568551676301, 98252118400201827},
+	{17499724911633247401, 17399255364824103181, 16448825942262207472, 122815148000252284},
+	{13243171078984473577, 10874534603015064488, 1057144177059103862, 76759467500157678},
+	{16553963848730591972, 4369796216914054802, 10544802258178655636, 95949334375197097},
+	{11469082774058464157, 5462245271142568503, 17792688841150707449, 119936667968996371},
+	{14085705761427621954, 14943118340532575074, 8814587516505498203, 74960417480622732},
+	{8383760164929751635, 14067211907238330939, 11018234395631872754, 93700521850778415},
+	{5868014187734801639, 8360642847193137866, 9161106976112453039, 117125652313473019},
+	{8279194885761638928, 12142930807136793022, 3419848850856589197, 73203532695920637},
+	{1125621570347272853, 1343605453638827566, 8886497081998124401, 91504415869900796},
+	{10630398999788866874, 6291192835475922361, 11108121352497655501, 114380519837375995},
+	{17899684768163471497, 12475677062772290855, 9273465672194681472, 142975649796719994},
+	{18104832007743251541, 7797298164232681784, 10407602063549063824, 89359781122949996},
+	{4184295935969512811, 9746622705290852231, 13009502579436329780, 111699726403687495},
+	{618683901534503109, 12183278381613565289, 11650192205868024321, 139624658004609369},
+	{11915892484527534203, 697019960867396449, 363841101026433345, 87265411252880856},
+	{1059807550377254042, 5482960969511633466, 454801376283041681, 109081764066101070},
+	{10548131474826343361, 11465387230316929736, 9791873757208577909, 136352205082626337},
+	{6592582171766464600, 9471710028161775037, 17649136144323830953, 85220128176641460},
+	{12852413733135468654, 16451323553629606700, 3614676106695237075, 106525160220801826},
+	{16065517166419335818, 15952468423609620471, 13741717170223822152, 133156450276002282},
+	{16958477256653166742, 9970292764756012794, 13200259249817276749, 83222781422501426},
+	{11974724533961682620, 17074551974372403897, 7276952025416820128, 104028476778126783},
+	{1133347612169939563, 2896445894255953256, 4484504013343637257, 130035595972658479},
+	{708342257606212227, 13339493729978440545, 9720344035980855141, 81272247482911549},
+	{5497113840435153187, 2839309107190886969, 16762116063403456831, 101590309353639436},
+	{11483078318971329388, 17384194439270772423, 2505901005544769422, 126987886692049296},
+	{14094452976998162724, 6253435506116844860, 1566188128465480889, 79367429182530810},
+	{17618066221247703405, 12428480401073443979, 11181107197436626919, 99209286478163512},
+	{17410896758132241352, 10923914482914417070, 13976383996795783649, 124011608097704390},
+	{6270124455405262941, 18356661597889980429, 4123553979569976876, 77507255061065244},
+	{12449341587683966580, 4499082923652923920, 5154442474462471096, 96884068826331555},
+	{15561676984604958225, 5623853654566154900, 1831367074650700966, 121105086032914444},
+	{502676078523323083, 17349966589386010525, 10367976458511463911, 75690678770571527},
+	{5240031116581541757, 17075772218305125252, 8348284554711941985, 94613348463214409},
+	{6550038895726927197, 7509657217599242853, 15047041711817315386, 118266685579018011},
+	{6399617319043023450, 9305221779426914687, 7098558060672128164, 73916678486886257},
+	{3387835630376391408, 11631527224283643359, 13484883594267548109, 92395848108607821},
+	{18069852593252652973, 704350975072390486, 3021046437552271425, 115494810135759777},
+	{6681971852355520204, 11969434405488713814, 13417369069538639400, 72184256334849860},
+	{17575836852299176063, 14961793006860892267, 16771711336923299250, 90230320418562325},
+	{17358110046946582174, 9478869221721339526, 7129581115871960351, 112787900523202907},
+	{12474265521828451910, 7236900508724286504, 4300290376412562535, 140984875654003634},
+	{7796415951142782444, 11440591845593760921, 7299367503685239488, 88115547283752271},
+	{14357205957355865959, 14300739806992201151, 4512523361179161456, 110144434104690339},
+	{13334821428267444545, 17875924758740251439, 1028968183046563916, 137680542630862924},
+	{15251792420308234696, 1949080937357881341, 9866477151258878256, 86050339144289327},
+	{5229682470103129658, 2436351171697351677, 7721410420646209916, 107562923930361659},
+	{11148789106056299977, 3045438964621689596, 5040077007380374491, 134453654912952074},
+	{16191365228139963293, 18044300417384413661, 7761734148040121960, 84033534320595046},
+	{6404148479892790405, 4108631448020965461, 478795648195376643, 105041917900743808},
+	{12616871618293375910, 524103291598818922, 598494560244220804, 131302397375929760},
+	{12497230779860747848, 9550936594104037634, 374059100152638002, 82063998359956100},
+	{6398166437971159002, 2715298705775271235, 467573875190797503, 102579997949945125},
+	{3386022029036560848, 17229181437501252756, 5196153362415884782, 128224997437431406},
+	{11339635805002626338, 6156552380010895068, 17082653906792091701, 80140623398394628},
+	{14174544756253282923, 12307376493441006739, 2906573309780563010, 100175779247993286},
+	{13106494926889215750, 6160848579946482616, 12856588674080479571, 125219724059991607},
+	{8191559329305759843, 1544687353252857683, 14952896948941381588, 78262327537494754},
+	{5627763143204811900, 1930859191566072104, 9467749149321951177, 97827909421868443},
+	{7034703929006014875, 7025260007884978034, 7223000418225051067, 122284886777335554},
+	{9008375974056147201, 2084944495714417319, 9126061279818044821, 76428054235834721},
+	{6648783949142796097, 7217866638070409553, 16019262618199943930, 95535067794793401},
+	{12922665954855883026, 18245705334442787749, 6189020217467766200, 119418834743491752},
+	{10382509230998620843, 11403565834026742343, 3868137635917353875, 74636771714682345},
+	{8366450520320888150, 9642771274106040025, 9446858063324080248, 93295964643352931},
+	{15069749168828498091, 12053464092632550031, 7196886560727712406, 116619955804191164},
+	{16336122258158893163, 2921729039467955865, 13721426137309596062, 72887472377619477},
+	{6585094767416452742, 12875533336189720640, 3316724616354831365, 91109340472024347},
+	{8231368459270565927, 2259358614954987088, 17980963825725702919, 113886675590030433},
+	{10289210574088207409, 16659256323975897572, 8641146726874964936, 142358344487538042},
+	{15654128645659905439, 10412035202484935982, 10012402722724240989, 88973965304711276},
+	{10344288770220105990, 17626730021533557882, 12515503403405301236, 111217456630889095},
+	{3706988925920356680, 3586668453207395737, 11032693235829238642, 139021820788611369},
+	{13846083124768692685, 6853353801682010239, 18424648318461743911, 86888637992882105},
+	{12695917887533477952, 3955006233675124895, 9195752342795016177, 108610797491102632},
+	{11258211340989459536, 9555443810521294023, 11494690428493770221, 135763496863878290},
+	{13953911115759494066, 8277995390789502716, 11795867536235994292, 84852185539923931},
+	{17442388894699367583, 10347494238486878395, 10133148401867604961, 106065231924904914},
+	{17191300099946821574, 17546053816535985898, 3443063465479730393, 132581539906131143},
+	{15356248580894151388, 4048754607693909330, 9069443693565913352, 82863462441331964},
+	{9971938689262913427, 5060943259617386663, 11336804616957391690, 103579328051664955},
+	{7853237343151253880, 15549551111376509137, 9559319752769351708, 129474160064581194},
+	{16437488385538003435, 495097407755542402, 10586260863908232722, 80921350040363246},
+	{11323488445067728485, 9842243796549203811, 4009454043030515094, 101151687550454058},
+	{9542674537907272703, 3079432708831728956, 14235189590642919676, 126439609438067572},
+	{15187543623046821247, 11148017479874606405, 18120365531006600605, 79024755898792232},
+	{5149371473526362847, 99963794561094295, 4203712840048699141, 98780944873490291},
+	{1825028323480565655, 4736640761628755773, 642955031633486022, 123476181091862864},
+	{3446485711389047486, 16795458531300136070, 401846894770928763, 77172613182414290},
+	{13531479176091085166, 16382637145697782183, 9725680655318436762, 96465766478017862},
+	{12302662951686468553, 11254924395267451921, 2933728782293270145, 120582208097522328},
+	{771635317162960990, 116798719401075595, 1833580488933293841, 75363880060951455},
+	{14799602201735864949, 4757684417678732397, 16127033666448781013, 94204850076189318},
+	{4664444696887667475, 10558791540525803401, 10935420046206200458, 117756062595236648},
+	{14444492981623261931, 11210930731256015029, 6834637528878875286, 73597539122022905},
+	{4220558171746913702, 4790291377215242979, 13154982929525982012, 91996923902528631},
+	{664011696256254224, 5987864221519053724, 11832042643480089611, 114996154878160789},
+	{830014620320317780, 2873144258471429251, 954995249067948302, 143745193597700987},
+	{16659660202196056277, 15630773216826806993, 16737773095163325352, 89840745998563116},
+	{6989517197462906634, 1091722447323957126, 2475472295244605075, 112300932498203896},
+	{17960268533683409100, 15199711114437110119, 3094340369055756343, 140376165622754870},
+	{18142696861193212544, 16417348474164275680, 15769020785942011426, 87735103514221793},
+	{4231627002781964064, 11298313555850568793, 5876217927145350571, 109668879392777242},
+	{9901219771904842984, 9511205926385823087, 16568644445786464022, 137086099240971552},
+	{13105791385081608721, 1332817685563751525, 10355402778616540014, 85678812025607220},
+	{2547181176069847189, 10889394143809465215, 12944253473270675017, 107098515032009025},
+	{17019034525369472698, 18223428698189219422, 2345258786306180059, 133873143790011282},
+	{6025210559928532532, 9083799927154568187, 6077472759868750441, 83670714868757051},
+	{2919827181483277761, 15966435927370598138, 2985154931408550147, 104588393585946314},
+	{12873156013708873010, 15346358890785859768, 12954815701115463492, 130735491982432892},
+	{8045722508568045631, 368102269886386547, 17320131850051940491, 81709682489020557},
+	{5445467117282669135, 14295185892640146896, 7815106757282761901, 102137103111275697},
+	{6806833896603336419, 4033924310518019908, 14380569465030840281, 127671378889094621},
+	{13477643222231861069, 14050417740142232202, 11293698924857969127, 79794611805684138},
+	{7623681990935050529, 12951336156750402349, 4893751619217685601, 99743264757105173},
+	{14141288507096201065, 2354112140655839224, 10728875542449494906, 124679080946381466},
+	{8838305316935125666, 6083006106337287419, 11317233232458322220, 77924425591488416},
+	{6436195627741519178, 7603757632921609274, 14146541540572902775, 97405531989360520},
+	{17268616571531674781, 4893011022724623688, 17683176925716128469, 121756914986700650},
+	{10792885357207296738, 5363974898416583757, 15663671596999968197, 76098071866687906},
+	{18102792714936508826, 11316654641448117600, 10356217459395184438, 95122589833359883},
+	{4181746819961084417, 4922446264955371193, 8333585805816592644, 118903237291699854},
+	{14142806808544147521, 12299900952451882803, 596805110207982498, 74314523307312409},
+	{13066822492252796497, 6151504153710077696, 5357692406187366027, 92893154134140511},
+	{16333528115315995621, 3077694173710209216, 2085429489306819630, 116116442667675639},
+	{10208455072072497263, 15758616913851044472, 8220922458457844124, 72572776667297274},
+	{12760568840090621579, 1251527068604253974, 1052781036217529348, 90715970834121593},
+	{6727339013258501166, 1564408835755317468, 5927662313699299589, 113394963542651991},
+	{8409173766573126457, 6567197063121534739, 2797891873696736582, 141743704428314989},
+	{2949890594894510084, 17939556219733122924, 4054525430274154315, 88589815267696868},
+	{3687363243618137605, 17812759256239015751, 5068156787842692894, 110737269084621085},
+	{18444262109804835718, 13042577033443993880, 10946882003230754022, 138421586355776356},
+	{11527663818628022324, 3539924627475108271, 16065173288873997072, 86513491472360222},
+	{9797893754857640001, 4424905784343885339, 10858094574237720532, 108141864340450278},
+	{7635681175144662097, 5531132230429856674, 4349246180942374857, 135177330425562848},
+	{9383986752892801714, 14986172690087130181, 2718278863088984285, 84485831515976780},
+	{16341669459543390047, 4897657807326749014, 3397848578861230357, 105607289394970975},
+	{11203714787574461751, 10733758277585824172, 18082368778858701658, 132009111743713718},
+	{16225693779088814402, 11320284941918528011, 6689794468359300632, 82505694839821074},
+	{15670431205433630099, 14150356177398160014, 17585615122303901598, 103132118549776342},
+	{10364666969937261816, 8464573184892924210, 12758646866025101190, 128915148187220428},
+	{11089602874638176539, 678672222130689727, 17197526328120464052, 80571967617012767},
+	{9250317574870332769, 848340277663362159, 16885221891723192161, 100714959521265959},
+	{6951210950160528058, 5672111365506590603, 16494841346226602297, 125893699401582449},
+	{2038663834636636084, 15074284649510088887, 3391746813750544579, 78683562125989031},
+	{16383387848577958817, 14231169793460223204, 18074741572470344436, 98354452657486288},
+	{2032490737012896905, 17788962241825279006, 4146682891878378929, 122943065821857861},
+	{15105364765915224278, 4200572373499717522, 4897519816637680783, 76839416138661163},
+	{9658333920539254539, 639029448447258999, 1510213752369713075, 96049270173326454},
+	{7461231382246680270, 14633844865841237461, 11111139227316917151, 120061587716658067},
+	{6969112623117869121, 16063682068791855269, 4638619007859379267, 75038492322911292},
+	{13323076797324724305, 15467916567562431182, 5798273759824224084, 93798115403639115},
+	{7430473959801129573, 888151635743487362, 2636156181352892202, 117247644254548894},
+	{9255732243303093887, 5166780790767067505, 15482655668627721338, 73279777659093058},
+	{16181351322556255263, 15681848025313610189, 10129947548929875864, 91599722073866323},
+	{6391631097913155367, 1155565957932461121, 8050748417734956927, 114499652592332904},
+	{12601224890818832113, 15279515502697740113, 10063435522168696158, 143124565740416130},
+	{958236529120688214, 4938011170758699667, 10901333219782823003, 89452853587760081},
+	{15032853716683023980, 1560827945020986679, 18238352543155916658, 111816066984700101},
+	{14179381127426392071, 11174406968131009157, 8962882623662732110, 139770083730875127},
+	{11167956213855188996, 2372318336654492819, 12519330667430289425, 87356302331796954},
+	{9348259248891598341, 7577083939245503928, 6425791297433085973, 109195377914746193},
+	{11685324061114497927, 14083040942484267814, 12643925140218745370, 136494222393432741},
+	{2691641519769173300, 13413586607480055288, 10208296221850409808, 85308888995895463},
+	{3364551899711466625, 16766983259350069110, 8148684258885624356, 106636111244869329},
+	{13429061911494109090, 2511985000478034771, 14797541342034418350, 133295139056086661},
+	{6087320685470124229, 15405048680580935444, 11554306347985205420, 83309461910054163},
+	{7609150856837655286, 809566777016617689, 9831196916554118872, 104136827387567704},
+	{14123124589474457012, 1011958471270772111, 12288996145692648590, 130171034234459630},
+	{15744481896062617488, 14467532099826396281, 3068936572630517464, 81356896396537269},
+	{5845544314796108149, 18084415124782995352, 8447856734215534734, 101696120495671586},
+	{7306930393495135186, 13382146869123968382, 1336448880914642610, 127120150619589483},
+	{18401889551216623203, 12975527811629868142, 16976181615067509295, 79450094137243426},
+	{13778989902166003196, 11607723746109947274, 11996854981979610811, 99312617671554283},
+	{8000365340852728187, 9897968664210046189, 10384382709047125610, 124140772089442854},
+	{7306071347246649069, 10797916433558666772, 1878553174727065602, 77587982555901784},
+	{9132589184058311336, 4274023505093557657, 2348191468408832003, 96984978194877230},
+	{16027422498500277074, 730843362939559167, 12158611372365815812, 121231222743596537},
+	{16934668089203755027, 9680149138692000287, 681603080087553026, 75769514214747836},
+	{16556649093077305880, 2876814386510224551, 852003850109441283, 94711892768434795},
+	{16084125347919244446, 17431076038419944401, 14900062867918965315, 118389865960543493},
+	{3135049314808445923, 8588579514798771299, 11618382301663047274, 73993666225339683},
+	{17753869698792721115, 1512352356643688315, 9911291858651421189, 92492082781674604},
+	{17580651105063513490, 6502126464231998298, 12389114823314276486, 115615103477093255},
+	{15599592959092083835, 17898887095427162648, 14660725792212504659, 72259439673183284},
+	{1052747125155553178, 17761922850856565407, 18325907240265630824, 90324299591479105},
+	{15150991961726605185, 3755659489861155142, 9072325995049874819, 112905374489348882},
+	{9715367915303480673, 82888343899056024, 2117035456957567716, 141131718111686103},
+	{6072104947064675421, 9275177251791685823, 8240676188239561678, 88207323819803814},
+	{2978445165403456372, 2370599527884831471, 1077473198444676290, 110259154774754768},
+	{17558114512036484177, 12186621446710815146, 1346841498055845362, 137823943468443460},
+	{15585507588450190514, 12228324422621647370, 10065147973139679159, 86139964667777162},
+	{10258512448707962335, 10673719509849671309, 3358062929569823141, 107674955834721453},
+	{17434826579312340823, 17953835405739477040, 8809264680389666830, 134593694793401816},
+	{10896766612070213014, 6609461110159785246, 5505790425243541769, 84121059245876135},
+	{4397586228232990460, 12873512406127119462, 2270552013127039307, 105151324057345169},
+	{14720354822146013883, 11480204489231511423, 7449876034836187038, 131439155071681461},
+	{16117750791482340533, 2563441787342306735, 6962015530986310851, 82149471919800913},
+	{15535502470925537762, 17039360289460047131, 13314205432160276467, 102686839899751141},
+	{14807692070229534299, 16687514343397671010, 2807698734918181872, 128358549874688927},
+	{13866493562320846840, 10429696464623544381, 8672340736964945526, 80224093671680579},
+	{3498058897618894839, 3813748543924654669, 6228739902778794004, 100280117089600724},
+	{8984259640451006452, 4767185679905818336, 7785924878473492505, 125350146362000905},
+	{5615162275281879033, 14508706096009606220, 16395418095114402575, 78343841476250565},
+	{7018952844102348791, 13524196601584619871, 6659214563610839507, 97929801845313207},
+	{4162005036700548085, 12293559733553386935, 3712332186086161480, 122412252306641509},
+	{9518782175578924409, 7683474833470866834, 4626050625517544877, 76507657691650943},
+	{2675105682618879703, 14216029560265971447, 1170877263469543192, 95634572114563679},
+	{17178940158555763341, 17770036950332464308, 15298654634619092702, 119543215143204598},
+	{1513465562242576280, 6494587075530402289, 4949973128209545035, 74714509464502874},
+	{6503517971230608254, 3506547825985614957, 15410838447116707102, 93393136830628592},
+	{12741083482465648222, 13606556819336794504, 816803985186332261, 116741421038285741},
+	{7963177176541030138, 10809941021299190517, 2816345499955151615, 72963388148928588},
+	{14565657489103675577, 8900740258196600242, 3520431874943939519, 91204235186160735},
+	{8983699824524818663, 6514239304318362399, 18235597898962088111, 114005293982700918},
+	{6617938762228635425, 3531113111970565095, 13571125336847834331, 142506617478376148},
+	{11053740754033978997, 18347846759477460848, 17705325372384672264, 89066635923985092},
+	{13817175942542473746, 4488064375637274444, 3684912641771288715, 111333294904981366},
+	{17271469928178092183, 998394451119205151, 13829512839068886702, 139166618631226707},
+	{17712197732752389470, 14459054587231666931, 6337602515204360236, 86979136644516692},
+	{17528561147513098934, 18073818234039583664, 7922003144005450295, 108723920805645865},
+	{3463957360681822051, 17980586774122091677, 14514189948434200773, 135904901007057331},
+	{4470816359639832734, 13543709743040001250, 6765525708557681531, 84940563129410832},
+	{14811892486404566725, 12317951160372613658, 8456907135697101914, 106175703911763540},
+	{9291493571150932599, 6174066913610991265, 10571133919621377393, 132719629889704425},
+	{17336398528037802634, 15388006867075339300, 18136173745831830630, 82949768681065265},
+	{3223754086337701677, 10011636546989398318, 8835159127007624576, 103687210851331582},
+	{13253064644776902904, 12514545683736747897, 1820576871904754912, 129609013564164478},
+	{1365636375344482459, 7821591052335467436, 14972918600222635532, 81005633477602798},
+	{1707045469180603074, 9776988815419334295, 9492776213423518607, 101257041847003498},
+	{15968864891757917554, 7609550000846779964, 2642598229924622451, 126571302308754373},
+	{757168520493922663, 2450125741315543526, 3957466902916582984, 79107063942971483},
+	{10169832687472179137, 3062657176644429407, 335147610218340826, 98883829928714354},
+	{8100604840912836017, 13051693507660312567, 9642306549627701840, 123604787410892942},
+	{11980407053211604367, 8157308442287695354, 1414755575089925746, 77252992131808089},
+	{5752136779659729650, 973263516004843385, 6380130487289795087, 96566240164760111},
+	{11801856993002049967, 15051637450288217943, 3363477090684855954, 120707800205950139},
+	{14293689648267363085, 14018959424857524118, 18243074246173892635, 75442375128718836},
+	{8643740023479428049, 12912013262644517244, 4357098734007814178, 94302968910898546},
+	{10804675029349285061, 6916644541450870747, 14669745454364543531, 117878711138623182},
+	{4447078884129609211, 2017059829193100265, 4556904890550451803, 73674194461639489},
+	{10170534623589399418, 16356382841773539043, 10307817131615452657, 92092743077049361},
+	{8101482261059361368, 6610420496934760092, 17496457432946703726, 115115928846311701},
+	{10126852826324201711, 17486397658023225923, 8035513735901215945, 143894911057889627},
+	{4023440007238932117, 4011469508623434346, 2716353075724566014, 89934319411181017},
+	{14252672045903440954, 14237708922634068740, 8007127363083095421, 112417899263976271},
+	{17815840057379301193, 3962078098010422213, 5397223185426481373, 140522374079970339},
+	{13440743045075757198, 4782141820470207835, 1067421481677856906, 87826483799981462},
+	{12189242787917308593, 15201049312442535602, 10557648888952096940, 109783104749976827},
+	{6013181448041859933, 554567566843617887, 8585375092762733272, 137228880937471034},
+	{10675767432667244314, 346604729277261179, 9977545451404096199, 85768050585919396},
+	{8733023272406667489, 14268313966878740186, 12471931814255120248, 107210063232399245},
+	{1692907053653558553, 17835392458598425233, 1754856712536736598, 134012579040499057},
+	{12587281954601943856, 6535434268196627866, 12626000491403930134, 83757861900311910},
+	{6510730406397654012, 17392664872100560641, 6559128577400136859, 104697327375389888},
+	{12750099026424455419, 17129145071698312897, 8198910721750171074, 130871659219237360},
+	{1051282863874202780, 15317401688238833465, 5124319201093856921, 81794787012023350},
+	{5925789598270141380, 5311694055016378119, 15628771038222096960, 102243483765029187},
+	{2795550979410288821, 6639617568770472649, 14924277779350233296, 127804354706286484},
+	{13276434408199900273, 4149760980481545405, 104301575239120002, 79877721691429053},
+	{2760484954967711629, 14410573262456707565, 4742062987476287906, 99847152114286316},
+	{8062292212137027440, 8789844541216108648, 5927578734345359883, 124808940142857895},
+	{5038932632585642150, 3187809829046373953, 10622265736606931783, 78005587589286184},
+	{10910351809159440592, 17819820341590131153, 13277832170758664728, 97506984486607730},
+	{18249625779876688644, 3828031353278112325, 7373918176593555103, 121883730608259663},
+	{13711859121636624354, 9310048623439902059, 11526227888012053795, 76177331630162289},
+	{12528137883618392539, 7025874760872489670, 572726804732903532, 95221664537702862},
+	{6436800317668214866, 8782343451090612088, 9939280542770905223, 119027080672128577},
+	{4023000198542634291, 12406493684572714411, 17741265385300285524, 74391925420080360},
+	{417064229750904960, 15508117105715893014, 3729837657915805289, 92989906775100451},
+	{9744702324043407008, 5550088326862702555, 50611053967368708, 116237383468875564},
+	{3784595943313435428, 12692177241143964905, 9255003945584381250, 72648364668047227},
+	{9342430947569182189, 6641849514575180323, 6957068913553088659, 90810455835059034},
+	{7066352666034089832, 3690625874791587500, 17919708178796136632, 113513069793823792},
+	{8832940832542612290, 4613282343489484375, 3952891149785619174, 141891337242279741},
+	{12438117047980214537, 16718359519963091446, 4776399977829705935, 88682085776424838},
+	{6324274273120492364, 16286263381526476404, 15193872009141908227, 110852607220531047},
+	{7905342841400615455, 15746143208480707601, 14380653992999997380, 138565759025663809},
+	{16470054321943854419, 617967468445666442, 2070379717983916507, 86603599391039881},
+	{11364195865575042216, 14607517390839246765, 7199660665907283537, 108254499238799851},
+	{370186776686639058, 4424338683266894745, 4387889813956716518, 135318124048499814},
+	{11760581781497619171, 16600269732323972927, 16577489189005111535, 84573827530312383},
+	{10089041208444636060, 16138651146977578255, 16110175467829001515, 105717284412890479},
+	{7999615492128407171, 15561627915294584915, 15526033316358863990, 132146605516113099},
+	{2693916673366560530, 5114331428631727668, 7397927813510596042, 82591628447570687},
+	{3367395841708200662, 15616286322644435393, 4635723748460857148, 103239535559463359},
+	{8820930820562638732, 1073613829595992625, 1182968667148683532, 129049419449329199},
+	{17042296808920118967, 9894380680352271198, 7656884444609009063, 80655887155830749},
+	{12079498974295372901, 7756289832012951094, 14182791574188649233, 100819858944788436},
+	{5876001681014440319, 14307048308443576772, 17728489467735811541, 126024823680985545},
+	{12895873087488801007, 11247748201990929434, 4162776889693800357, 78765514800615966},
+	{6896469322506225451, 224627197206498081, 14426843148972026255, 98456893500769957},
+	{13232272671560169718, 14115842051790286313, 4198495880932869106, 123071116875962447},
+	{1352641392084024217, 13434087300796316850, 9541588953224125047, 76919448047476529},
+	{10914173776959806080, 12180923107568008158, 16538672209957544213, 96149310059345661},
+	{4419345184344981792, 1391095829177846486, 6838282207164766555, 120186637574182077},
+	{16597148795497777332, 17010335957732011717, 6579769388691673048, 75116648483863798},
+	{6911377939090057953, 2816175873455463031, 17448083772719367119, 93895810604829747},
+	{4027536405435184537, 17355277897101492501, 17198418697471820994, 117369763256037184},
+	{4823053262610684287, 15458734704115820717, 10749011685919888121, 73356102035023240},
+	{10640502596690743263, 5488360324862612184, 13436264607399860152, 91695127543779050},
+	{13300628245863429079, 6860450406078265230, 7571958722395049382, 114618909429723813},
+	{7402413270474510541, 17798935044452607346, 14076634421421199631, 143273636787154766},
+	{9238194312473956992, 18041863430423961447, 4186210494960861865, 89546022991971729},
+	{6936056872165058336, 8717271232747788097, 9844449137128465236, 111932528739964661},
+	{13281757108633710825, 10896589040934735121, 16917247439837969449, 139915660924955826},
+	{1383569165254987409, 18339583196652679211, 15184965668326118809, 87447288078097391},
+	{15564519511850897974, 9089420940533685301, 14369521066980260608, 109309110097621739},
+	{5620591334531458755, 11361776175667106627, 13350215315297937856, 136636387622027174},
+	{1207026574868467770, 7101110109791941642, 3732198553633823256, 85397742263766984},
+	{10732155255440360520, 8876387637239927052, 4665248192042279070, 106747177829708730},
+	{13415194069300450651, 1872112509695133007, 15054932276907624646, 133433972287135912},
+	{15302025320953863512, 15005128373841621841, 9409332673067265403, 83396232679459945},
+	{5292473595910165679, 14144724448874639398, 16373351859761469658, 104245290849324931},
+	{15838964031742482906, 8457533524238523439, 15855003806274449169, 130306613561656164},
+	{16816881547480133672, 16815173498717546909, 686005342066754922, 81441633476035103},
+	{7186043879068003379, 11795594836542157829, 14692564732865607365, 101802041845043878},
+	{13594240867262392127, 909435490395533574, 9142333879227233399, 127252552306304848},
+	{3884714523611607175, 7485926209138290340, 5713958674517020874, 79532845191440530},
+	{4855893154514508969, 134035724568087117, 16365820380001051901, 99416056489300662},
+	{10681552461570524116, 4779230674137496800, 11233903438146539068, 124270070611625828},
+	{6675970288481577572, 12210391208190711308, 16244561685696362725, 77668794132266142},
+	{8344962860601971965, 1427930954956225423, 11082330070265677599, 97085992665332678},
+	{5819517557325077053, 15619971748977445491, 4629540550977321190, 121357490831665848},
+	{1331355464114479206, 5150796324683515528, 2893462844360825744, 75848431769791155},
+	{1664194330143099007, 6438495405854394410, 17451886610733195892, 94810539712238943},
+	{11303614949533649567, 8048119257317993012, 17203172244989106961, 118513174640298679},
+	{16288131380313306787, 16559289581892215392, 17669511680759273706, 74070734150186674},
+	{1913420151682081868, 11475739940510493433, 12863517564094316325, 92588417687733343},
+	{7003461208029990240, 509616870355953079, 11467710936690507503, 115735522109666679},
+	{11294692282659825756, 7236039571613552530, 14084848363072649045, 72334701318541674},
+	{4894993316470006387, 13656735482944328567, 8382688416986035498, 90418376648177093},
+	{1507055627160120079, 7847547316825634901, 15090046539659932277, 113022970810221366},
+	{6495505552377538003, 14421120164459431530, 9639186137720139538, 141278713512776708},
+	{8671376988663349156, 13624886121214532610, 15247863372929863019, 88299195945485442},
+	{1615849198974410637, 12419421633090777859, 9836457179307552966, 110373994931856803},
+	{15854869554000177008, 6300905004508696515, 7683885455707053304, 137967493664821004},
+	{7603450462036416678, 3938065627817935322, 14025800446671684123, 86229683540513127},
+	{280941040690745040, 310896016345031249, 12920564539912217250, 107787104425641409},
+	{4962862319290819204, 9611992057286064869, 2315647619608107850, 134733880532051762},
+	{5407631958770455954, 10619181054231178447, 6058965780682455310, 84208675332532351},
+	{2147853930035682039, 4050604280934197251, 2962021207425681234, 105260844165665439},
+	{16519875467826766261, 14286627388022522371, 17537584564564265254, 131576055207081798},
+	{8019079158178034961, 4317456099086688578, 6349304334425277880, 82235034504426124},
+	{800476910867767893, 5396820123858360723, 7936630418031597350, 102793793130532655},
+	{14835654193866873578, 15969397191677726711, 5309102004112108783, 128492241413165819},
+	{16189812898807877842, 16898402272439661050, 1012345743356374037, 80307650883228637},
+	{11013894086655071495, 7287944785267412601, 5877118197622855451, 100384563604035796},
+	{18379053626746227273, 4498244963156877847, 7346397747028569314, 125480704505044745},
+	{18404437544357473901, 7423089120400436558, 16120713637961325581, 78425440315652965},
+	{13782174893592066569, 13890547418927933602, 6315833992169493264, 98031800394566207},
+	{8004346580135307403, 17363184273659917003, 3283106471784478676, 122539750493207759},
+	{2696873603370873175, 1628618134182672319, 8969470572506381029, 76587344058254849},
+	{17206150059495755181, 6647458686155728302, 15823524234060364190, 95734180072818561},
+	{12284315537514918168, 17532695394549436186, 5944347237293291525, 119667725091023202},
+	{12289383229374211759, 13263777630807091568, 8326903041735695107, 74792328181889501},
+	{15361729036717764699, 11968036020081476556, 15020314820597006788, 93490410227361876},
+	{755417222187654257, 14960045025101845696, 328649452036706869, 116863012784202346},
+	{472135763867283911, 11655871149902347512, 4817091925950329697, 73039382990126466},
+	{590169704834104888, 734780882095770678, 15244736944292687930, 91299228737658082},
+	{9961084167897406919, 10141848139474489155, 9832549143511084104, 114124035922072603},
+	{7839669191444370744, 12677310174343111444, 7679000410961467226, 142655044902590754},
+	{14123165281507507523, 12535004877391832556, 9411061275278304920, 89159403064119221},
+	{17653956601884384404, 15668756096739790695, 16375512612525269054, 111449253830149026},
+	{17455759733928092601, 10362573084069962561, 11246018728801810510, 139311567287686283},
+	{3992320806063976020, 1864922159116338697, 4722918696287437617, 87069729554803927},
+	{9602087026007357929, 6942838717322811275, 1291962351931909117, 108837161943504909},
+	{7390922764081809507, 13290234415080901998, 6226638958342274300, 136046452429381136},
+	{7640709123743038, 17529768546280339557, 3891649348963921437, 85029032768363210},
+	{4621236904832066701, 8077152627568260734, 14087933723059677605, 106286290960454012},
+	{14999918167894859184, 14708126802887713821, 17609917153824597006, 132857863700567515},
+	{11680791864147980942, 4580893233377433234, 8700355211926679177, 83036164812854697},
+	{5377617793330200370, 10337802560149179447, 15487130033335736875, 103795206016068371},
+	{2110336223235362558, 8310567181759086405, 14747226523242283190, 129744007520085464},
+	{3624803148735795551, 582418470172041099, 9217016577026426994, 81090004700053415},
+	{18366061991201908151, 9951395124569827181, 6909584702855645838, 101362505875066769},
+	{9122519433720221476, 3215871868857508169, 13248666896996945202, 126703132343833461},
+	{17230789692143608183, 6621605936463330509, 10586259819836784703, 79189457714895913},
+	{7703429059897346516, 3665321402151775233, 17844510793223368783, 98986822143619891},
+	{14240972343299071049, 18416709807971882753, 17693952473101823074, 123733527679524864},
+	{1983078686920837550, 16122129648409814625, 11058720295688639421, 77333454799703040},
+	{7090534377078434841, 6317604005230104569, 13823400369610799277, 96666818499628800},
+	{13474853989775431456, 12508691024965018615, 17279250462013499096, 120833523124536000},
+	{15339312771250726516, 7817931890603136634, 10799531538758436935, 75520951952835000},
+	{9950768927208632337, 5160728844826532889, 13499414423448046169, 94401189941043750},
+	{17050147177438178325, 11062597074460554015, 7650895992455281903, 118001487426304688},
+	{17573871013539943309, 13831652199178928115, 4781809995284551189, 73750929641440430},
+	{17355652748497541232, 3454507193691496432, 15200634530960464795, 92188662051800537},
+	{3247821861912374924, 18153192047396534253, 5165735108418417281, 115235827564750672},
+	{8671463345817856560, 8856432003963504104, 6457168885523021602, 144044784455938340},
+	{5419664591136160350, 10146956020904577969, 13259102590306664309, 90027990284961462},
+	{11386266757347588341, 17295381044558110365, 7350506201028554578, 112534987856201828},
+	{397775391402321715, 12395854268842862149, 9188132751285693223, 140668734820252285},
+	{2554452628840145023, 14664937945667870699, 8048425978767252216, 87917959262657678},
+	{17028123841332344991, 18331172432084838373, 837160436604289462, 109897449078322098},
+	{7450096746383267527, 13690593503251272159, 10269822582610137636, 137371811347902622},
+	{11573839494130624060, 17779992976386820907, 1806953095703948118, 85857382092439139},
+	{9855613349235892172, 13001619183628750326, 16093749424912098860, 107321727615548923},
+	{3096144649690089407, 16252023979535937908, 15505500762712735671, 134152159519436154},
+	{11158462442911081687, 17075044014851043048, 14302623995122847698, 83845099699647596},
+	{13948078053638852109, 12120432981709028002, 17878279993903559623, 104806374624559495},
+	{8211725530193789328, 10538855208708897099, 17736163973952061625, 131007968280699369},
+	{2826485447157424378, 18115999551511530447, 4167573456078956659, 81879980175437106},
+	{17368164864228944185, 18033313420962025154, 14432838856953471632, 102349975219296382},
+	{12486834043431404423, 4094897702492979827, 8817676534337063733, 127937469024120478},
+	{5498428267930933812, 4865154073271806344, 899361815533276929, 79960918140075299},
+	{6873035334913667265, 10693128610017145834, 14959260324698759873, 99951147675094123},
+	{17814666205496859890, 17978096780948820196, 14087389387446061937, 124938934593867654},
+	{1910794341580761623, 4318781460451930767, 4192932348726400807, 78086834121167284},
+	{16223550982258115741, 786790807137525554, 5241165435908001009, 97608542651459105},
+	{11056066690967868868, 5595174527349294847, 11163142813312389165, 122010678314323881},
+	{13827570709495999898, 5802827088807003231, 59435230679161372, 76256673946452426},
+	{12672777368442611969, 7253533861008754039, 9297666075203727523, 95320842433065532},
+	{11229285692125877057, 4455231307833554645, 11622082594004659404, 119151053041331915},
+	{9324146566792367113, 12007891604250747461, 4957958612039218175, 74469408150832447},
+	{16266869226917846795, 10398178486886046422, 1585762246621634815, 93086760188540559},
+	{11110214496792532686, 8386037090180170124, 15817260863559207231, 116358450235675698},
+	{16167256097350108736, 12158802209003688183, 14497474058151892423, 72724031397297311},
+	{15597384103260248017, 10586816742827222325, 13510156554262477625, 90905039246621639},
+	{5661672073793146309, 17845206946961415811, 12276009674400709127, 113631299058277049},
+	{2465404073814044982, 17694822665274381860, 1509954037718722697, 142039123822846312},
+	{10764249582988553922, 4141735138155406806, 943721273574201686, 88774452389278945},
+	{4231939941880916594, 14400540959549034316, 5791337610395140011, 110968065486598681},
+	{5289924927351145743, 13388990181008904991, 11850858031421312918, 138710081858248351},
+	{10223732107235547945, 3756432844703177715, 14324315297279402430, 86693801161405219},
+	{8167979115617047028, 13918913092733747952, 13293708103171865133, 108367251451756524},
+	{10209973894521308785, 3563583310635021228, 16617135128964831417, 135459064314695655},
+	{15604605720930593798, 13756454615215358027, 17303238483244101491, 84661915196684784},
+	{14894071132735854344, 12583882250591809630, 3182304030345575248, 105827393995855981},
+	{9394216879065042122, 15729852813239762038, 8589566056359356964, 132284242494819976},
+	{1259699530988263422, 607785971420075466, 5368478785224598103, 82677651559262485},
+	{10797996450590105086, 14594790519557258044, 11322284499958135532, 103347064449078106},
+	{13497495563237631357, 18243488149446572555, 4929483588092893607, 129183830561347633},
+	{6130091717809825646, 18319709121045189703, 14610142288626528264, 80739894100842270},
+	{3050928628834894154, 4452892327596935513, 9039305823928384523, 100924867626052838},
+	{8425346804471005596, 954429391068781487, 2075760243055704846, 126156084532566048},
+	{12183370780435460354, 14431576424700152141, 1297350151909815528, 78847552832853780},
+	{1394155420262161730, 18039470530875190177, 1621687689887269410, 98559441041067225},
+	{6354380293755090067, 13325966126739211913, 6638795630786474667, 123199301301334031},
+	{15500702729665401052, 6022885819998313493, 11066776296882628523, 76999563313333769},
+	{5540820356799587603, 2916921256570503963, 18445156389530673558, 96249454141667211},
+	{2314339427572096599, 12869523607567905762, 18444759468485954043, 120311817677084014},
+	{6058148160659948278, 5737609245516247149, 6916288649376333373, 75194886048177509},
+	{12184371219252323252, 11783697575322696840, 13257046830147804620, 93993607560221886},
+	{15230464024065404065, 14729621969153371050, 7347936500829979967, 117492009450277358},
+	{14130726033468265445, 16123542758361938762, 18427518368300901191, 73432505906423348},
+	{8440035504980555998, 15542742429525035549, 4587653886666574873, 91790632383029186},
+	{15161730399653082901, 5593369981624130724, 14957939395187994400, 114738290478786482},
+	{505418925856802011, 6991712477030163406, 9474052207130217192, 143422863098483103},
+	{14150944883942664969, 4369820298143852128, 12838811657097467601, 89639289436551939},
+	{17688681104928331211, 10073961391107203064, 11436828552944446597, 112049111795689924},
+	{3664107307450862398, 17204137757311391735, 14296035691180558246, 140061389744612405},
+	{9207596094797870854, 6140900079892231930, 11240865316201542856, 87538368590382753},
+	{2286123081642562760, 7676125099865289913, 216023589969764858, 109422960737978442},
+	{7469339870480591354, 371784337976836583, 9493401524316981881, 136778700922473052},
+	{11585866446691451452, 11761580257303992624, 15156747989552889483, 85486688076545657},
+	{14482333058364314315, 10090289303202602876, 5110876931658948142, 106858360095682072},
+	{18102916322955392894, 3389489592148477787, 6388596164573685178, 133572950119602590},
+	{9008479692633426607, 6730117013520186521, 17827930658140716948, 83483093824751618},
+	{15872285634219171163, 8412646266900233151, 13061541285821120377, 104353867280939523},
+	{15228671024346576049, 15127493852052679343, 11715240588849012567, 130442334101174404},
+	{16435448417857691887, 16372212685174006445, 16545397404885408662, 81526458813234002},
+	{6709252467039951147, 11241893819612732249, 11458374719251985020, 101908073516542503},
+	{12998251602227326837, 14052367274515915311, 9711282380637593371, 127385091895678129},
+	{15041436279033161129, 6476886537358753117, 17598766533966965617, 79615682434798830},
+	{4966737293509287700, 12707794190125829301, 12775086130603931213, 99519603043498538},
+	{10820107635313997529, 2049684682375122914, 6745485626400138209, 124399503804373173},
+	{11374253290498636359, 12810267972552921581, 6521771525713780332, 77749689877733233},
+	{382758557841131737, 16012834965691151977, 12763900425569613319, 97187112347166541},
+	{5090134215728802575, 15404357688686552067, 2119817476679852937, 121483890433958177},
+	{875490875616807657, 2710194527788013186, 12854100968993377846, 75927431521223860},
+	{10317735631375785380, 12611115196589792290, 16067626211241722307, 94909289401529825},
+	{3673797502364955917, 11152207977309852459, 6249474708769989172, 118636611751912282},
+	{18437024503473955112, 16193502022673433594, 8517607711408631136, 74147882344945176},
+	{13822908592487668082, 1795133454632240377, 10647009639260788921, 92684852931181470},
+	{3443577685327421391, 6855602836717688376, 4085390012221210343, 115856066163976838},
+	{2152236053329638369, 11202280800589637091, 16388426812920420176, 72410041352485523},
+	{16525353121944211673, 14002851000737046363, 15873847497723137316, 90512551690606904},
+	{16045005384002876688, 17503563750921307954, 1395565298444370029, 113140689613258631},
+	{10832884693148820052, 8044396633369471231, 15579514678337626249, 141425862016573288},
+	{13688081960859094388, 16556962941924389279, 9737196673961016405, 88391163760358305},
+	{12498416432646480081, 6861145622123322887, 16783181860878658411, 110488954700447881},
+	{11011334522380712198, 3964746009226765705, 7143919270816159302, 138111193375559852},
+	{18411299122556414884, 16313024311048892277, 13688321581114875371, 86319495859724907},
+	{9179065847913354893, 15779594370383727443, 12498715957966206310, 107899369824656134},
+	{6862146291464305712, 10501120926124883496, 6400022910602982080, 134874212280820168},
+	{4288841432165191070, 6563200578828052185, 4000014319126863800, 84296382675512605},
+	{9972737808633876741, 8204000723535065231, 9611703917335967654, 105370478344390756},
+	{7854236242364958023, 1031628867564055731, 12014629896669959568, 131713097930488445},
+	{2603054642264404812, 644768042227534832, 9814986694632418682, 82320686206555278},
+	{3253818302830506015, 10029332089639194348, 3045361331435747544, 102900857758194098},
+	{4067272878538132519, 12536665112048992935, 13030073701149460238, 128626072197742622},
+	{9459574576727414680, 3223729676603232680, 3532110044791024745, 80391295123589139},
+	{11824468220909268350, 8641348114181428754, 18250195611270944643, 100489118904486423},
+	{5557213239281809630, 6189999124299398039, 18201058495661292900, 125611398630608029},
+	{10390787302192212875, 13092121489541899582, 13681504569002002014, 78507124144130018},
+	{3765112090885490285, 7141779825072598670, 7878508674397726710, 98133905180162523},
+	{13929762150461638665, 18150596818195524145, 5236449824569770483, 122667381475203154},
+	{1788572316397442309, 9038280002158508639, 7884467158783494456, 76667113422001971},
+	{16070773450778966599, 11297850002698135798, 5243897930051980166, 95833891777502464},
+	{10865094776618932441, 4898940466517893940, 6554872412564975208, 119792364721878080},
+	{16014056272241608583, 3061837791573683712, 4096795257853109505, 74870227951173800},
+	{1570826266592459113, 8438983257894492545, 5120994072316386881, 93587784938967250},
+	{6575218851667961796, 15160415090795503585, 15624614627250259409, 116984731173709062},
+	{15638726828360945882, 2557730404106107884, 5153698123604024227, 73115456983568164},
+	{1101664461741630737, 17032221060414798568, 6442122654505030283, 91394321229460205},
+	{1377080577177038421, 16678590307091110306, 12664339336558675758, 114242901536825256},
+	{10944722758326073834, 11624865847009112074, 15830424170698344698, 142803626921031570},
+	{11452137742381184050, 11877227172808082950, 14505701125113853340, 89252266825644731},
+	{5091800141121704255, 14846533966010103688, 13520440387964928771, 111565333532055914},
+	{6364750176402130319, 13946481439085241706, 7677178448101385156, 139456666915069893},
+	{8589654878678719353, 17939922936283051874, 7104079539277059674, 87160416821918683},
+	{1513696561493623383, 13201531633499039035, 4268413405668936689, 108950521027398354},
+	{15727178757149192941, 2666856486591635081, 14558888793940946670, 136188151284247942},
+	{2911957695577163732, 15501843359401935638, 4487619477785703764, 85117594552654964},
+	{12863319156326230473, 930560125542867931, 5609524347232129706, 106396993190818705},
+	{11467462926980400188, 10386572193783360722, 11623591452467550036, 132996241488523381},
+	{11778850347790138021, 15714979657969376259, 9570587667005912724, 83122650930327113},
+	{10111876916310284623, 1196980498752168708, 16574920602184778810, 103903313662908891},
+	{12639846145387855778, 10719597660294986693, 16106964734303585608, 129879142078636114},
+	{10205746850081103813, 6699748537684366683, 14678538977367128909, 81174463799147571},
+	{8145497544173991863, 12986371690532846258, 13736487703281523232, 101468079748934464},
+	{958499893362714021, 16232964613166057823, 17170609629101904040, 126835099686168080},
+	{7516591460992778119, 10145602883228786139, 10731631018188690025, 79271937303855050},
+	{4784053307813584745, 17293689622463370578, 4191166735881086723, 99089921629818813},
+	{15203438671621756739, 17005426009651825318, 9850644438278746308, 123862402037273516},
+	{4890463151336210058, 1405019219177615016, 15380024810778992251, 77414001273295947},
+	{6113078939170262572, 15591332079254182482, 14613344995046352409, 96767501591619934},
+	{16864720710817604023, 5654107043785564390, 9043309206953164704, 120959376989524918},
+	{5928764425833614610, 3533816902365977744, 1040382235918340036, 75599610618453074},
+	{7410955532292018263, 4417271127957472180, 10523849831752700853, 94499513273066342},
+	{9263694415365022829, 10133274928374228129, 3931440252836100258, 118124391591332928},
+	{17319024055671609028, 10944982848661280484, 2457150158022562661, 73827744744583080},
+	{3202035995879959669, 18292914579253988510, 3071437697528203326, 92284680930728850},
+	{13225917031704725394, 13642771187212709829, 13062669158765029966, 115355851163411062},
+	{10572041154029147323, 3915045973580555739, 3552482205800755825, 72097406977131914},
+	{8603365424109046250, 9505493485403082578, 13663974794105720589, 90121758721414892},
+	{1530834743281532005, 16493552875181241127, 17079968492632150736, 112652198401768615},
+	{15748601484384078718, 2170197020266999792, 16738274597362800517, 140815248002210769},
+	{9842875927740049199, 3662216146880568822, 3543892595710668467, 88009530001381731},
+	{3080222872820285691, 18412828238882874740, 18264923799920499295, 110011912501727163},
+	{3850278591025357113, 18404349280176205521, 18219468731473236215, 137514890627158954},
+	{13935639165459317956, 18420247327751210306, 15998853975598160538, 85946806641974346},
+	{8196176919969371637, 13801937122834237075, 10775195432642924865, 107433508302467933},
+	{5633535131534326642, 3417363348260632632, 18080680309231043986, 134291885378084916},
+	{3520959457208954151, 6747538111090283299, 2077053156414626683, 83932428361303073},
+	{18236257376793356401, 3822736620435466219, 7208002463945671258, 104915535451628841},
+	{18183635702564307597, 14001792812399108582, 13621689098359476976, 131144419314536051},
+	{6753086295675304344, 8751120507749442864, 6207712677260979158, 81965262071585032},
+	{8441357869594130430, 1715528597832027772, 7759640846576223948, 102456577589481290},
+	{10551697336992663038, 2144410747290034715, 476179021365504127, 128070721986851613},
+	{4288967826406720447, 8257785744697353553, 2603454897567134031, 80044201241782258},
+	{9972895801435788462, 5710546162444304037, 12477690658813693347, 100055251552227822},
+	{17077805770222123482, 2526496684627992142, 6373741286662340876, 125069064440284778},
+	{6061942587961439272, 10802432464747270897, 8595274322591350951, 78168165275177986},
+	{12189114253379186994, 8891354562506700717, 1520720866384412881, 97710206593972483},
+	{1401334761441820031, 15725879221560763801, 15735959138262679813, 122137758242465603},
+	{12405049271969607279, 12134517522689171327, 7529131452200480931, 76336098901541002},
+	{10894625571534621195, 10556460884934076255, 188042278395825356, 95420123626926253},
+	{9006595945990888590, 13195576106167595319, 4846738866422169599, 119275154533657816},
+	{12546651493885387224, 15164764093995828930, 3029211791513855999, 74546971583536135},
+	{6459942330501958223, 14344269099067398259, 17621572794674483711, 93183714479420168},
+	{3463241894700059875, 13318650355406859920, 3580221919633553023, 116479643099275211},
+	{2164526184187537421, 15241685499770369306, 18378539764266828303, 72799776937047006},
+	{11929029767089197585, 14440420856285573728, 13749802668478759571, 90999721171308758},
+	{14911287208861496981, 13438840051929579256, 7963881298743673656, 113749651464135948},
+	{192364937367319611, 16798550064911974071, 9954851623429592070, 142187064330169935},
+	{7037757113495656612, 5887407772142595890, 13139311292284576900, 88866915206356209},
+	{18020568428724346574, 7359259715178244862, 2589081060073557413, 111083644007945262},
+	{13302338499050657409, 13810760662400193982, 12459723361946722574, 138854555009931577},
+	{3702275543479272977, 4020039395572733335, 869798073575619753, 86784096881207236},
+	{16158410921703317, 9636735262893304573, 1087247591969524691, 108480121101509045},
+	{4631884032079517050, 7434233060189242812, 5970745508389293768, 135600151376886306},
+	{12118299556904473964, 4646395662618276757, 8343401961170696509, 84750094610553941},
+	{1312816390848428743, 10419680596700233851, 15040938469890758540, 105937618263192426},
+	{15476078543842699641, 13024600745875292313, 9577801050508672367, 132422022828990533},
+	{2755020062260605420, 15057904493813139552, 8291968665781614181, 82763764268119083},
+	{3443775077825756775, 4987322561984260728, 5753274813799629823, 103454705335148854},
+	{4304718847282195968, 1622467184052938006, 16414965554104313087, 129318381668936067},
+	{16525507334833536192, 7931571017674168109, 7953510462101501727, 80823988543085042},
+	{6821826113259756528, 5302777753665322233, 718516040772101351, 101029985678856303},
+	{13138968660002083564, 2016786173654264887, 14733203106247290401, 126287482098570378},
+	{15129384440142384084, 12789706404602385314, 13819937959831944404, 78929676311606486},
+	{9688358513323204297, 15987133005752981643, 8051550412935154697, 98662095389508108},
+	{7498762123226617467, 6148858201909063342, 10064438016168943372, 123327619236885135},
+	{75040308589248013, 13066408413047940397, 13207802787746671463, 77079762023053209},
+	{4705486404163947920, 11721324497882537592, 2674695429401175617, 96349702528816512},
+	{5881858005204934900, 816597567071008278, 3343369286751469522, 120437128161020640},
+	{17511219308535248024, 5122059497846768077, 2089605804219668451, 75273205100637900},
+	{8053966080386896319, 1790888353881072193, 2612007255274585564, 94091506375797375},
+	{14679143618911008302, 2238610442351340241, 17100067124375395667, 117614382969746718},
+	{2256935734178298333, 17540032590965445315, 6075855934307234387, 73508989356091699},
+	{16656227723005036628, 17313354720279418739, 2983133899456655080, 91886236695114624},
+	{16208598635328907881, 3194949326639721808, 3728917374320818851, 114857795868893280},
+	{1814004220451583236, 17828744713581815973, 4661146717901023563, 143572244836116600},
+	{3439595646995933474, 8837122436774941031, 2913216698688139727, 89732653022572875},
+	{18134552614027080555, 6434717027541288384, 17476578928642338371, 112165816278216093},
+	{4221446693824299078, 3431710265999222577, 8010665605520759252, 140207270347770117},
+	{14167619229708656683, 11368190953104289918, 7312509012664168484, 87629543967356323},
+	{8486152000281045046, 14210238691380362398, 4528950247402822701, 109536929959195404},
+	{1384317963496530500, 3927740308943289286, 5661187809253528377, 136921162448994255},
+	{14700256782467495274, 13984052739158025563, 10455771408424537091, 85575726530621409},
+	{13763634959656981189, 12868379905520144050, 17681400278958059268, 106969658163276761},
+	{7981171662716450678, 16085474881900180063, 8266692293415410373, 133712072704095952},
+	{11905761316838863530, 12359264810401306491, 5166682683384631483, 83570045440059970},
+	{10270515627621191508, 10837394994574245210, 15681725391085565162, 104462556800074962},
+	{3614772497671713577, 4323371706363030705, 10378784702002180645, 130578196000093703},
+	{13788447857113290746, 5007950325690588142, 13404269466392444759, 81611372500058564},
+	{8012187784536837624, 1648251888685847274, 16755336832990555949, 102014215625073205},
+	{791862693816271223, 6672000879284696997, 7109112985956031224, 127517769531341507},
+	{2800757192848863466, 4170000549552935623, 2137352607008825563, 79698605957088442},
+	{17336004546343243044, 600814668513781624, 11895062795615807762, 99623257446360552},
+	{3223261609219502190, 9974390372497002839, 14868828494519759702, 124529071807950690},
+	{8932067533403270724, 1622307964383238870, 13904703827502237718, 77830669879969181},
+	{1941712379899312598, 11251256992333824396, 3545821729095633435, 97288337349961477},
+	{2427140474874140747, 9452385221989892591, 9043963179796929698, 121610421687451846},
+	{8434491824437419823, 10519426782171070773, 1040790968945693157, 76006513554657404},
+	{15154800798974162683, 17760969496141226370, 1300988711182116446, 95008141943321755},
+	{9720128961862927545, 12977839833321757155, 15461293944259809270, 118760177429152193},
+	{3769237591950635764, 3499463877398710318, 2745779687521298938, 74225110893220121},
+	{13934919026793070513, 13597701883603163705, 8043910627829011576, 92781388616525151},
+	{3583590728209174429, 16997127354503954632, 5443202266358876566, 115976735770656439},
+	{2239744205130734018, 6011518578137583741, 10319530444115379710, 72485459856660274},
+	{7411366274840805427, 16737770259526755484, 3676041018289448829, 90606824820825343},
+	{9264207843551006783, 7087154769126280643, 18430109328143974749, 113258531026031678},
+	{6968573786011370575, 13470629479835238708, 13814264623325192628, 141573163782539598},
+	{13578730653111882417, 17642515461751800000, 4022229371150857488, 88483227364087249},
+	{16973413316389853022, 3606400253480198384, 9639472732365959765, 110604034205109061},
+	{2770022571777764661, 9119686335277635885, 16661026933884837610, 138255042756386326},
+	{4037107116574796865, 10311489977975910332, 5801455815250635602, 86409401722741454},
+	{5046383895718496082, 3665990435615112107, 16475191805918070311, 108011752153426817},
+	{1696293851220732198, 18417546099801053846, 6758931702115424176, 135014690191783522},
+	{14895241712295121336, 11510966312375658653, 8836018332249528014, 84384181369864701},
+	{4783994085086737958, 5165335853614797509, 15656708933739297922, 105480226712330876},
+	{10591678624785810351, 15680041853873272694, 1124142093464570786, 131850283390413596},
+	{2008113122063743565, 14411712177098183338, 9925960845270132549, 82406427119008497},
+	{11733513439434455265, 4179582166090565460, 17019137075015053591, 103008033898760621},
+	{14666891799293069081, 612791689185818921, 7438863288486653277, 128760042373450777},
+	{2249278346917086320, 2688837814954830778, 16178504601372628058, 80475026483406735},
+	{12034969970501133708, 12584419305548314280, 15611444733288397168, 100593783104258419},
+	{15043712463126417135, 15730524131935392850, 14902619898183108556, 125742228880323024},
+	{14014006307881398613, 608205545604844723, 9314137436364442848, 78588893050201890},
+	{12905821866424360362, 760256932006055904, 2419299758600777752, 98236116312752363},
+	{16132277333030450453, 950321165007569880, 16859182753533135902, 122795145390940453},
+	{10082673333144031533, 14429008783411894887, 12842832230171903890, 76746965869337783},
+	{7991655648002651512, 8812888942410092801, 11441854269287491959, 95933707336672229},
+	{14601255578430702294, 6404425159585228097, 467259781327201237, 119917134170840287},
+	{2208255708878107078, 6308608733954461513, 7209566390970582629, 74948208856775179},
+	{7372005654525021751, 12497446935870464795, 4400271970285840382, 93685261070968974},
+	{4603321049728889285, 6398436632983305186, 14723711999712076286, 117106576338711217},
+	{7488761674507943707, 17834080950896729453, 2284790972178965822, 73191610211694511},
+	{13972638111562317538, 13069229151766136008, 16691046770505870990, 91489512764618138},
+	{17465797639452896923, 7113164402852894202, 11640436426277562930, 114361890955772673},
+	{12608875012461345346, 18114827540420893561, 715487477564789950, 142952363694715842},
+	{963017855147258985, 6710081194335670572, 5058865691905381623, 89345227309197401},
+	{1203772318934073731, 3775915474492200311, 10935268133309114933, 111681534136496751},
+	{15339773453949755876, 9331580361542638292, 9057399148209005762, 139601917670620939},
+	{363986371863821614, 10443923744391536837, 3355031458416934649, 87251198544138087},
+	{5066668983257164922, 17666590698916808950, 18028847378303332023, 109063998180172608},
+	{15556708265926231961, 17471552355218623283, 4089315149169613413, 136329997725215761},
+	{7417099656990201023, 13225563231225333504, 14085037014299478143, 85206248578259850},
+	{9271374571237751279, 11920268020604278976, 8382924231019571871, 106507810722824813},
+	{11589218214047189099, 10288649007327960816, 15090341307201852743, 133134763403531016},
+	{7243261383779493187, 13347934657221057366, 9431463317001157964, 83209227127206885},
+	{18277448766579142292, 16684918321526321707, 16401015164678835359, 104011533909008606},
+	{18235124939796539961, 16244461883480514230, 11277896918993768391, 130014417386260758},
+	{6785267068945449571, 17070317704816403250, 2436999555943717340, 81259010866412974},
+	{17704955873036587772, 2891153057310952446, 12269621481784422484, 101573763583016217},
+	{12907822804440958907, 3613941321638690558, 1501968796948364393, 126967204478770272},
+	{3455703234348211413, 13787928372092651359, 938730498092727745, 79354502799231420},
+	{18154687098217427978, 3399852409833650486, 1173413122615909682, 99193128499039275},
+	{13469986835917009165, 13473187549146838916, 15301824458552050814, 123991410623799093},
+	{17642113809302906536, 3809056199789386418, 11869483295808725711, 77494631639874433},
+	{12829270224773857362, 149634231309345119, 1001796064478743427, 96868289549843042},
+	{11424901762539933798, 14022100844418845111, 10475617117453205091, 121085361937303802},
+	{14058092629228540480, 6457970018548084242, 11158946716835641086, 75678351210814876},
+	{8349243749680899792, 17295834560039881111, 13948683396044551357, 94597939013518595},
+	{5824868668673736836, 7784735144767687677, 12824168226628301293, 118247423766898244},
+	{5946385927134779474, 7171302474693498750, 17238477178497464116, 73904639854311402},
+	{16656354445773250151, 8964128093366873437, 12324724436267054337, 92380799817889253},
+	{6985385001934398977, 15816846135135979701, 1570847490051654209, 115475999772361567},
+	{6671708635422693312, 2967999806818905457, 7899308708923365737, 72172499857725979},
+	{12951321812705754545, 8321685776951019725, 5262449867726819267, 90215624822157474},
+	{2354094210600029469, 5790421202761386753, 15801434371513299892, 112769531027696842},
+	{7554303781677424740, 7238026503451733441, 10528420927536849057, 140961913784621053},
+	{16250654909616860222, 16052981610725803160, 8886106088924224612, 88101196115388158},
+	{1866574563311523662, 1619482939697702335, 1884260574300504958, 110126495144235198},
+	{16168276259421568290, 11247725711476903726, 11578697754730407005, 137658118930293997},
+	{5493486643711092277, 9335671578886758781, 9542529105920198330, 86036324331433748},
+	{11478544323066253250, 2446217436753672668, 11928161382400247913, 107545405414292185},
+	{14348180403832816563, 7669457814369478739, 1075143672718146179, 134431756767865232},
+	{6661769743181816400, 2487568124767230260, 671964795448841362, 84019847979915770},
+	{8327212178977270500, 12332832192813813633, 10063328031165827510, 105024809974894712},
+	{15020701242148976029, 6192668204162491233, 12579160038957284388, 131281012468618390},
+	{2470409248702028162, 13093789664456332829, 3250289005920914838, 82050632792886494},
+	{7699697579304923106, 7143865043715640228, 13286233294255919356, 102563290991108117},
+	{9624621974131153883, 8929831304644550285, 2772733562537735483, 128204113738885147},
+	{8321231743045665129, 3275301556189149976, 17873859541081942341, 80127571086803216},
+	{10401539678807081411, 8705812963663825374, 3895580352642876310, 100159463858504021},
+	{3778552561654075956, 1658894167725005910, 9481161459230983292, 125199329823130026},
+	{16196653406315961184, 10260180891682904501, 10537411930446752461, 78249581139456266},
+	{6410758702612787769, 17436912133031018531, 3948392876203664768, 97811976424320333},
+	{3401762359838596807, 3349396092579221548, 9547177113681968865, 122264970530400416},
+	{11349473511753898812, 13622587603930483227, 5966985696051230540, 76415606581500260},
+	{9575155871264985611, 17028234504913104034, 7458732120064038175, 95519508226875325},
+	{2745572802226456206, 16673607112713992139, 13935101168507435623, 119399385283594156},
+	{17856884065887392793, 17338533473087326942, 17932810267171923072, 74624615802246347},
+	{13097733045504465183, 3226422767649607062, 17804326815537515937, 93280769752807934},
+	{7148794270025805671, 8644714477989396732, 13032036482567119113, 116600962191009918},
+	{13691368455620904352, 16932161594811842717, 3533336783177061541, 72875601369381199},
+	{3279152514243966728, 7330143938232639685, 18251729034253490639, 91094501711726498},
+	{8710626661232346314, 4550993904363411702, 13591289255962087491, 113868127139658123},
+	{1664911289685657085, 1077056362026876724, 12377425551525221460, 142335158924572654},
+	{10263941592908311486, 9896532263121573760, 3124204951275875508, 88959474327857909},
+	{12829926991135389358, 12370665328901967200, 8516942207522232289, 111199342909822386},
+	{16037408738919236697, 1628273605845295288, 1422805722548014554, 138999178637277983},
+	{10023380461824522936, 5629357022080697459, 7806782604233590952, 86874486648298739},
+	{7917539558853265766, 7036696277600871824, 5146792236864600786, 108593108310373424},
+	{9896924448566582207, 18019242383855865588, 6433490296080750982, 135741385387966780},
+	{15408949817208889687, 6650340471482528088, 13244303471905245172, 84838365867479237},
+	{814443197801560493, 8312925589353160111, 2720321284599392753, 106047957334349047},
+	{14853112052534114329, 15002843005118838042, 17235459661031404653, 132559946667936308},
+	{13894881051261209359, 11682619887412967728, 1548790251289852100, 82849966667460193},
+	{17368601314076511699, 14603274859266209660, 6547673832539703029, 103562458334325241},
+	{3264007568886088008, 4419035518800598364, 12796278309102016691, 129453072917906551},
+	{11263376767408580813, 456054190036680025, 14915202970829842288, 80908170573691594},
+	{244162903978562304, 570067737545850032, 9420631676682527052, 101135213217114493},
+	{305203629973202880, 712584671932312540, 16387475614280546719, 126419016521393116},
+	{9414124305588027608, 7362894447598777193, 1018800222070565891, 79011885325870698},
+	{16379341400412422414, 4591932041071083587, 10496872314442983172, 98764856657338372},
+	{15862490732088140114, 5739915051338854484, 13121090393053728965, 123456070821672965},
+	{690684670700311763, 5893289916300478005, 10506524504872274555, 77160044263545603},
+	{5475041856802777608, 2754926376948209602, 8521469612662955290, 96450055329432004},
+	{16067174357858247818, 12667030008040037810, 10651837015828694112, 120562569161790005},
+	{14653669992088792790, 7916893755025023631, 8963241144106627772, 75351605726118753},
+	{13705401471683603084, 9896117193781279539, 15815737448560672619, 94189507157648441},
+	{12520065821177115951, 7758460473799211520, 5934613755418677062, 117736883947060552},
+	{7825041138235697469, 237351777697119296, 3709133597136673164, 73585552466912845},
+	{9781301422794621836, 296689722121399120, 9248103014848229359, 91981940583641056},
+	{12226626778493277295, 14205920207933912612, 11560128768560286698, 114977425729551320},
+	{15283283473116596619, 8534028223062614957, 14450160960700358373, 143721782161939150},
+	{11857895179911566839, 7639610648627828300, 4419664582010336079, 89826113851211969},
+	{14822368974889458549, 4937827292357397471, 10136266745940308003, 112282642314014961},
+	{13916275200184435282, 1560598097019358935, 17282019450852772908, 140353302892518701},
+	{15615201027756353907, 10198745847491875142, 13107105165996677019, 87720814307824188},
+	{10295629247840666576, 8136746290937456024, 16383881457495846274, 109651017884780235},
+	{12869536559800833220, 947560826817044222, 15868165803442419939, 137063772355975294},
+	{3431774331448132858, 16733126581256510303, 5305917608724124557, 85664857722484559},
+	{18124775969592329785, 7081350171288474166, 2020710992477767793, 107081072153105699},
+	{13432597925135636424, 13463373732537980612, 16360946795879373453, 133851340191382123},
+	{17618745740064548573, 10720451592049931834, 7919748738210914456, 83657087619613827},
+	{12800060138225909908, 13400564490062414793, 5287999904336255166, 104571359524517284},
+	{2165017117500223673, 7527333575723242684, 6609999880420318958, 130714199405646605},
+	{10576507735292415603, 92897466399638773, 6437092934476393301, 81696374628529128},
+	{17832320687542907408, 4727807851426936370, 8046366168095491626, 102120468285661410},
+	{13067028822573858453, 15133131851138446271, 834585673264588724, 127650585357076763},
+	{15084422041749743389, 234835370106753111, 16662517110286225617, 79781615848172976},
+	{14243841533759791332, 4905230231060829293, 2381402314148230405, 99727019810216221},
+	{3969743861917575453, 10743223807253424521, 7588438911112675910, 124658774762770276},
+	{14010304959766954418, 2102828861106002421, 13966146356300198252, 77911734226731422},
+	{3677823144426529311, 2628536076382503027, 8234310908520472007, 97389667783414278},
+	{18432336985815325350, 17120728150760292495, 1069516598795814200, 121737084729267848},
+	{18437739643775660200, 10700455094225182809, 668447874247383875, 76085677955792405},
+	{9212116499437411538, 8763882849354090608, 5447245861236617748, 95107097444740506},
+	{11515145624296764423, 10954853561692613260, 16032429363400547993, 118883871805925632},
+	{16420338052040253572, 18375998522126353047, 10020268352125342495, 74302419878703520},
+	{15913736546622929061, 18358312134230553405, 12525335440156678119, 92878024848379400},
+	{6057112627996497614, 18336204149360803853, 15656669300195847649, 116097531060474250},
+	{6091538401711504961, 4542598565709420552, 14397104331049792685, 72560956912796406},
+	{7614423002139381201, 10289934225564163594, 8773008376957465048, 90701196140995508},
+	{294656715819450694, 12862417781955204493, 10966260471196831310, 113376495176244385},
+	{4980006913201701271, 6854650190589229808, 18319511607423427042, 141720618970305481},
+	{3112504320751063294, 8895842387545656534, 4532165726998560045, 88575386856440926},
+	{13114002437793604926, 15731489002859458571, 14888579195602975864, 110719233570551157},
+	{11780817028814618253, 1217617179864771598, 4775665939221556119, 138399041963188947},
+	{2751324624581748504, 7678539765056564105, 678948202799778622, 86499401226993092},
+	{8050841799154573534, 374802669465929323, 848685253499723278, 108124251533741365},
+	{5451866230515829014, 9691875373687187462, 5672542585302042001, 135155314417176706},
+	{17242474449354556846, 17586637154622961923, 8157025134241164154, 84472071510735441},
+	{16941407043265808153, 12759924406423926596, 14807967436228843097, 105590089388419301},
+	{2730014730372708576, 2114847452747744534, 4674901240003890160, 131987611735524127},
+	{15541317261765106572, 1321779657967340333, 9839342302643513206, 82492257334702579},
+	{5591588521924219503, 10875596609313951225, 7687491859877003603, 103115321668378224},
+	{11601171670832662282, 8982809743215051127, 9609364824846254504, 128894152085472780},
+	{14168261321911495782, 5614256089509406954, 15229225052383684873, 80558845053420487},
+	{8486954615534593920, 11629506130314146597, 14424845297052218187, 100698556316775609},
+	{15220379287845630304, 9925196644465295342, 4195998566033109022, 125873195395969512},
+	{4901051036476131036, 1591561884363421685, 2622499103770693139, 78670747122480945},
+	{10737999814022551699, 15824510410736440818, 7889809898140754327, 98338433903101181},
+	{4199127730673413816, 15168951994993163119, 14473948391103330813, 122923042378876476},
+	{9541983859311965491, 11786438006084420901, 18269589781294357566, 76826901486797797},
+	{16539165842567344768, 5509675470750750318, 9001929171335783246, 96033626858497247},
+	{11450585266354405152, 16110466375293213706, 6640725445742341153, 120042033573121559},
+	{11768301809898891124, 3151512456917176710, 11067982431230045077, 75026270983200974},
+	{5487005225518838097, 8551076589573858792, 4611606002182780538, 93782838729001218},
+	{6858756531898547621, 1465473700112547682, 14987879539583251481, 117228548411251522},
+	{8898408850863980167, 12445136108638812061, 13979110730666920079, 73267842757032201},
+	{15734697082007363113, 10944734117371127172, 3638830358051486387, 91584803446290252},
+	{1221627278799652275, 9069231628286521062, 4548537947564357984, 114481004307862815},
+	{10750406135354341152, 11336539535358151327, 1073986416028059576, 143101255384828519},
+	{13636532862237545076, 7085337209598844579, 7588770537658619091, 89438284615517824},
+	{12433980059369543441, 4244985493571167820, 9485963172073273864, 111797855769397280},
+	{15542475074211929301, 5306231866963959775, 11857453965091592330, 139747319711746600},
+	{16631575949023537669, 7928080935279862763, 7410908728182245206, 87342074819841625},
+	{16177783917852034182, 686729132245052646, 13875321928655194412, 109177593524802031},
+	{10998857860460266920, 858411415306315808, 12732466392391605111, 136471991906002539},
+	{6874286162787666825, 7454036162207529236, 5651948486031059242, 85294994941251587},
+	{8592857703484583531, 94173165904635737, 2453249589111436149, 106618743676564484},
+	{15352758147783117318, 4729402475808182575, 3066561986389295186, 133273429595705605},
+	{16513002870005530180, 7567562565807502013, 4222444250707003443, 83295893497316003},
+	{6806195532224749013, 4847767188831989613, 666369294956366400, 104119866871645004},
+	{13119430433708324170, 6059708986039987016, 832961618695458000, 130149833589556255},
+	{8199644021067702606, 3787318116274991885, 7438130039325743106, 81343645993472659},
+	{14861241044762016162, 13957519682198515664, 4685976530729790978, 101679557491840824},
+	{129807232242968586, 8223527565893368773, 5857470663412238723, 127099446864801030},
+	{2386972529365549318, 2833861719469661531, 17495977219914812914, 79437154290500643},
+	{16818773716989100360, 12765699186191852721, 17258285506466128238, 99296442863125804},
+	{7188409090954211738, 6733751945885040094, 3126112809373108682, 124120553578907256},
+	{18327813737128546048, 8820280984605537962, 1953820505858192926, 77575345986817035},
+	{13686395134555906752, 1801979193902146645, 16277333687604904870, 96969182483521293},
+	{3272935862912719728, 11475846029232459115, 6511609054223967375, 121211478104401617},
+	{18186485978816307494, 14089932795911368802, 15598970704958449369, 75757173815251010},
+	{13509735436665608560, 3777357939607047291, 10275341344343285904, 94696467269063763},
+	{12275483277404622796, 4721697424508809114, 8232490662001719476, 118370584086329704},
+	{12283863066805277151, 12174432927172781504, 5145306663751074672, 73981615053956065},
+	{15354828833506596439, 15218041158965976880, 11043319348116231244, 92477018817445081},
+	{746791968173693933, 575807374997919485, 18415835203572676960, 115596273521806351},
+	{2772587989322252660, 359879609373699678, 18427426029874004956, 72247670951128969},
+	{12689107023507591633, 449849511717124597, 9199224482060342483, 90309588688911212},
+	{2026325724102325829, 14397369944928569459, 11499030602575428103, 112886985861139015},
+	{16367965210410070999, 13385026412733323919, 9762102234791897225, 141108732326423769},
+	{17147507284147376230, 1448112480317245593, 17630528942813405526, 88192957704014855},
+	{7599326049902056576, 11033512637251332800, 17426475160089369003, 110241197130018569},
+	{9499157562377570720, 9180204778136778096, 7948035894829547542, 137801496412523212},
+	{5936973476485981700, 1125941967908098406, 14190894471123243022, 86125935257827007},
+	{16644588882462252933, 10630799496739898815, 13126932070476665873, 107657419072283759},
+	{16194050084650428262, 17900185389352261423, 11796979069668444437, 134571773840354699},
+	{17038810330547599520, 13493458877558857341, 5067268909329083821, 84107358650221687},
+	{7463454857902335688, 3031765541666407965, 1722400118233966873, 105134198312777109},
+	{13941004590805307514, 8401392945510397860, 6764686166219846495, 131417747890971386},
+	{17936499906108093004, 12168399618585080518, 8839614872314791963, 82136092431857116},
+	{13197252845780340447, 10598813504803962744, 11049518590393489954, 102670115539821395},
+	{16496566057225425559, 4025144844150177622, 9200212219564474539, 128337644424776744},
+	{5698667767338503070, 209872518380167062, 5750132637227796587, 80211027765485465},
+	{16346706746027904646, 14097398703257372539, 11799351814962133637, 100263784706856831},
+	{15821697414107492903, 3786690323789551962, 10137503750275279143, 125329730883571039},
+	{14500246902244570968, 9284210480009551832, 13253468871563131320, 78331081802231899},
+	{18125308627805713710, 11605263100011939790, 11955150071026526246, 97913852252789874},
+	{13433263747902366330, 5283206838160148930, 5720565551928382000, 122392315315987343},
+	{13007475860866366860, 3302004273850093081, 10492882497596320606, 76495197072492089},
+	{2424286770800794863, 13350877379167392160, 17727789140422788661, 95618996340615111},
+	{3030358463500993579, 2853538668677076488, 17548050407101097923, 119523745425768889},
+	{1893974039688120987, 17924362732419030469, 4050002476797104345, 74702340891105556},
+	{6979153568037539138, 8570395360241624374, 5062503095996380432, 93377926113881945},
+	{17947313996901699730, 10712994200302030467, 10939814888422863444, 116722407642352431},
+	{8911228238849868379, 15918993412043544850, 13754913332905371508, 72951504776470269},
+	{1915663261707559666, 1451997691344879447, 3358583610849550674, 91189380970587837},
+	{16229637132416613295, 11038369151035875116, 8809915531989326246, 113986726213234796},
+	{1840302341811215003, 4574589401940068088, 11012394414986657808, 142483407766543495},
+	{1150188963632009376, 2859118376212542555, 13800275537007742986, 89052129854089684},
+	{15272794259822175433, 12797270007120454001, 17250344421259678732, 111315162317612105},
+	{5255934769495555579, 15996587508900567502, 7727872471292434703, 139143952897015132},
+	{17120017286216885949, 16915396220703936544, 14053292331412547497, 86964970560634457},
+	{2953277534061555820, 7309187220597756969, 3731557358983520660, 108706213200793072},
+	{8303282936004332679, 9136484025747196211, 4664446698729400825, 135882766500991340},
+	{2883708825789013972, 17239517562160467392, 12138651223560651323, 84926729063119587},
+	{3604636032236267465, 16937710934273196336, 10561628011023426250, 106158411328899484},
+	{4505795040295334332, 11948766630986719612, 13202035013779282813, 132698014161124355},
+	{12039493937039359765, 9773822153580393709, 5945428874398357806, 82936258850702722},
+	{1214309366017035995, 2993905655120716329, 16655158129852723066, 103670323563378402},
+	{6129572725948682898, 12965754105755671219, 11595575625461128024, 129587904454223003},
+	{1525139944504232859, 8103596316097294512, 4941391756699511063, 80992440283889377},
+	{1906424930630291074, 5517809376694230236, 10788425714301776733, 101240550354861721},
+	{2383031163287863842, 11508947739295175699, 18097218161304608820, 126550687943577151},
+	{17630295541550772565, 16416464373914260619, 18228290378456462368, 79094179964735719},
+	{17426183408511077803, 2073836393683274158, 18173676954643190057, 98867724955919649},
+	{12559357223784071445, 7203981510531480602, 8882038138021823859, 123584656194899562},
+	{12461284283292432557, 2196645434868481424, 10162959854691027816, 77240410121812226},
+	{15576605354115540697, 2745806793585601780, 3480327781509008962, 96550512652265283},
+	{1024012618934874255, 12655630528836778034, 18185467782168424914, 120688140815331603},
+	{5251693905261684313, 12521455098950374175, 9060074354641571619, 75430088009582252},
+	{1952931363149717487, 11040132855260579815, 11325092943301964524, 94287610011977815},
+	{16276222259219310571, 13800166069075724768, 9544680160700067751, 117859512514972269},
+	{10172638912012069107, 15542632820813409836, 8271268109651236296, 73662195321857668},
+	{12715798640015086384, 981546952307210679, 10339085137064045371, 92077744152322085},
+	{11283062281591470076, 15061991745666177061, 17535542439757444617, 115097180190402606},
+	{268769796707173883, 4992431626800557615, 12696056012842029964, 143871475238003258},
+	{7085510150583065533, 12343641803605124317, 12546721026453656631, 89919672023752036},
+	{13468573706656219820, 10817866236079017492, 15683401283067070789, 112399590029690045},
+	{16835717133320274775, 18134018813526159769, 5769193548551674774, 140499487537112557},
+	{3604794180684089878, 6722075740026461952, 5911588977058490686, 87812179710695348},
+	{4505992725855112348, 17625966711887853248, 7389486221323113357, 109765224638369185},
+	{5632490907318890435, 8197400334577652848, 13848543795081279601, 137206530797961481},
+	{3520306817074306522, 16652590255179502790, 1737810844284717894, 85754081748725926},
+	{13623755558197658960, 11592365782119602679, 11395635592210673176, 107192602185907407},
+	{12418008429319685796, 14490457227649503349, 9632858471835953566, 133990752732384259},
+	{10067098277538497575, 4444849748853551689, 3714693535683777027, 83744220457740162},
+	{17195558865350509872, 944376167639551707, 13866738956459497092, 104680275572175202},
+	{16882762563260749437, 1180470209549439634, 8110051658719595557, 130850344465219003},
+	{15163412620465356302, 3043636890182093723, 2762939277486053271, 81781465290761877},
+	{14342579757154307473, 17639604168009780866, 8065360115284954492, 102226831613452346},
+	{8704852659588108534, 3602761136302674467, 858328107251417308, 127783539516815433},
+	{3134689903028873881, 11475097747043947350, 12065670113100605577, 79864712198009645},
+	{13141734415640868160, 508814128522770475, 1247029586093593260, 99830890247512057},
+	{11815482001123697296, 636017660653463094, 6170473001044379479, 124788612809390071},
+	{2772990232274922906, 7315040065549496290, 10774074653293819030, 77992883005868794},
+	{12689609827198429440, 18367172118791646170, 4244221279762497979, 97491103757335993},
+	{6638640247143260993, 18347279130062169809, 9916962618130510378, 121863879696669991},
+	{15678365200533007880, 16078735474716244034, 13115630663972650842, 76164924810418744},
+	{10374584463811484042, 10875047306540529235, 16394538329965813553, 95206156013023430},
+	{8356544561336967149, 18205495151603049448, 11269800875602491133, 119007695016279288},
+	{5222840350835604468, 13684277478965599857, 7043625547251556958, 74379809385174555},
+	{11140236456971893489, 7881974811852224013, 4192845915637058294, 92974761731468194},
+	{90237515932703149, 629096477960504209, 14464429431401098676, 116218452164335242},
+	{11585613493526409228, 9616557335580090938, 13651954413053074576, 72636532602709526},
+	{5258644830053235727, 12020696669475113673, 7841570979461567412, 90795665753386908},
+	{11184992055993932563, 15025870836843892091, 9801963724326959265, 113494582191733635},
+	{9369554051565027800, 4947280490772701402, 7640768636981311178, 141868227739667044},
+	{10467657300655530279, 7703736325160326280, 13998852434968095294, 88667642337291902},
+	{13084571625819412849, 406298369595632042, 8275193506855343310, 110834552921614878},
+	{7132342495419490253, 9731244998849315861, 1120619846714403329, 138543191152018598},
+	{6763557068850875360, 17611243170349292173, 14535445459478665792, 86589494470011623},
+	{13066132354490982104, 3567309889227063600, 13557620805920944337, 108236868087514529},
+	{16332665443113727630, 9070823379961217404, 3111967952119016709, 135296085109393162},
+	{984543865091303961, 7975107621689454830, 6556665988501773347, 84560053193370726},
+	{10454051868218905759, 5357198508684430633, 17419204522481992492, 105700066491713407},
+	{17679250853701020103, 6696498135855538291, 17162319634675102711, 132125083114641759},
+	{8743688774349443612, 11102840362550793288, 17643978799313021050, 82578176946651099},
+	{10929610967936804515, 4655178416333715802, 17443287480713888409, 103222721183313874},
+	{4438641673066229836, 10430659038844532657, 12580737314037584703, 129028401479142343},
+	{14303366091734863408, 13436690926918914766, 14780489848914572295, 80642750924463964},
+	{8655835577813803452, 12184177640221255554, 28868237433663753, 100803438655579956},
+	{1596422435412478507, 1395163994994405731, 36085296792079692, 126004298319474945},
+	{17138665086628656730, 10095349533726279389, 11551768356563519567, 78752686449671840},
+	{7588273303003657201, 8007500898730461333, 14439710445704399459, 98440858062089800},
+	{14097027647181959406, 5397690104985688762, 18049638057130499324, 123051072577612250},
+	{13422328297916112532, 12596928352470831284, 15892709804133949981, 76906920361007656},
+	{16777910372395140666, 1911102385306375393, 1419143181457885861, 96133650451259571},
+	{7137329910211762120, 7000564000060357146, 15608987032104521038, 120167063064074463},
+	{9072517212309739229, 18210410555319886928, 16673145922706407504, 75104414415046539},
+	{11340646515387174036, 4316269120440307044, 16229746384955621477, 93880518018808174},
+	{14175808144233967545, 10007022418977771709, 11063810944339751038, 117350647523510218},
+	{11165723099359923668, 1642702993433719414, 11526567858639732303, 73344154702193886},
+	{4733781837345128777, 15888436797074312980, 5184837786444889570, 91680193377742358},
+	{5917227296681410971, 10637173959488115417, 15704419269910887771, 114600241722177947},
+	{12008220139279151618, 8684781430932756367, 15018838068961221810, 143250302152722434},
+	{14422666614690551617, 10039674412760360633, 13998459811528151535, 89531438845451521},
+	{4193275213081025809, 7937906997523062888, 3663016709128025707, 111914298556814402},
+	{5241594016351282262, 5310697728476440706, 13802142923264807942, 139892873196018002},
+	{7887682278646939317, 17154244135579939153, 13238025345467892867, 87433045747511251},
+	{14471288866736062051, 16831119151047536037, 11935845663407478180, 109291307184389064},
+	{4254053028137913852, 2592154865099868431, 14919807079259347726, 136614133980486330},
+	{9576312170227278013, 15455154845969581481, 13936565442964480232, 85383833737803956},
+	{16582076231211485421, 872199483752425235, 17420706803705600291, 106729792172254945},
+	{16115909270586968872, 14925307409972695256, 7940825449349836651, 133412240215318682},
+	{10072443294116855545, 7022474122019240583, 9574701924271035811, 83382650134574176},
+	{7978868099218681527, 4166406634096662825, 11968377405338794764, 104228312668217720},
+	{14585271142450739813, 5208008292620828531, 14960471756673493455, 130285390835272150},
+	{6809951454818018431, 10172534210529099688, 4738608829493545505, 81428369272045094},
+	{8512439318522523039, 17327353781588762514, 15146633073721707689, 101785461590056367},
+	{1417177111298377991, 7824134171703789431, 14321605323724746708, 127231826987570459},
+	{7803264722202568100, 14113455894169644202, 6645160318114272740, 79519891867231537},
+	{530708865898434317, 17641819867712055253, 12918136416070228829, 99399864834039421},
+	{5275072100800430800, 8217216779357905354, 2312612464805622325, 124249831042549277},
+	{7908606081427657154, 7441603496312384798, 3751225799717207905, 77656144401593298},
+	{662385564929795635, 13913690388817868902, 13912404286501285689, 97070180501991622},
+	{10051353993017020351, 3557054930740172415, 8167133321271831304, 121337725627489528},
+	{13199625273276719575, 2223159331712607759, 5104458325794894565, 75836078517180955},
+	{11887845573168511565, 7390635183068147603, 1768886888816230302, 94795098146476194},
+	{10248120948033251553, 14921941980408696, 11434480647875063686, 118493872683095242},
+	{6405075592520782220, 13844384269019919147, 11758236423349302707, 74058670426934526},
+	{3394658472223589872, 12693794317847511030, 5474423492331852576, 92573338033668158},
+	{13466695127134263148, 15867242897309388787, 16066401402269591528, 115716672542085197},
+	{6110841445245220515, 9917026810818367992, 12347343885632188657, 72322920338803248},
+	{7638551806556525644, 17007969531950347894, 15434179857040235821, 90403650423504060},
+	{324817721340881247, 7424903859655771156, 845980747590743161, 113004563029380076},
+	{406022151676101559, 13892815842997101849, 1057475934488428951, 141255703786725095},
+	{11782978890866033234, 15600538929514270511, 7578451486696349950, 88284814866703184},
+	{10117037595155153639, 10277301625038062331, 9473064358370437438, 110356018583378980},
+	{8034610975516554145, 3623254994442802106, 11841330447963046798, 137945023229223725},
+	{9633317878125234244, 16099592426808915028, 9706674539190598200, 86215639518264828},
+	{12041647347656542805, 1677746459801592169, 12133343173988247751, 107769549397831035},
+	{1217001129288514795, 15932241130034153924, 10554992949057921784, 134711936747288794},
+	{9983997742660097555, 9957650706271346202, 11208556611588589019, 84194960467055496},
+	{3256625141470346135, 7835377364411794849, 14010695764485736274, 105243700583819370},
+	{8682467445265320573, 570849668659967753, 8289997668752394535, 131554625729774213},
+	{16955757199359295118, 7274310070553561701, 7487091552183940536, 82221641081108883},
+	{7359638443916955186, 9092887588191952127, 4747178421802537766, 102777051351386104},
+	{4587862036468806078, 2142737448385164351, 5933973027253172208, 128471314189232630},
+	{9784942800434085655, 1339210905240727719, 17543791197315396342, 80294571368270393},
+	{7619492482115219165, 10897385668405685457, 8094680941362081715, 100368214210337992},
+	{14136051621071411860, 9010046067079718917, 10118351176702602144, 125460267762922490},
+	{11140875272383326364, 5631278791924824323, 10935655503866514244, 78412667351826556},
+	{9314408072051770052, 7039098489906030404, 13669569379833142805, 98015834189783195},
+	{11643010090064712565, 13410559130809925909, 12475275706364040602, 122519792737228994},
+	{9582724315504139305, 12993285475183591597, 12408733334904913280, 76574870460768121},
+	{16590091412807562035, 16241606843979489496, 1675858613348977888, 95718588075960152},
+	{2290870192299900928, 1855264481264810255, 2094823266686222361, 119648235094950190},
+	{8349322897828519936, 12688755346858976169, 15144322596961052687, 74780146934343868},
+	{15048339640713037824, 11249258165146332307, 483659172491764243, 93475183667929836},
+	{14198738532463909376, 9449886688005527480, 604573965614705304, 116843979584912295},
+	{8874211582789943360, 5906179180003454675, 7295387756150272671, 73027487240570184},
+	{6481078460060041296, 2771037956576930440, 9119234695187840839, 91284359050712730},
+	{8101348075075051620, 17298855501003326762, 2175671332130025240, 114105448813390913},
+	{903313056989038717, 3176825302544606837, 7331275183589919455, 142631811016738641},
+	{2870413669831843150, 8903044841731461129, 16111262035812169419, 89144881885461650},
+	{8199703105717191841, 6517120033736938507, 10915705507910435966, 111431102356827063},
+	{5637942863719101898, 17369772079025948942, 9032945866460657053, 139288877946033829},
+	{17358772345106602398, 13161950558604912040, 7951434175751604610, 87055548716271143},
+	{3251721357673701382, 7229066161401364243, 5327606701262117859, 108819435895338929},
+	{17899709752374290439, 4424646683324317399, 11271194395005035228, 136024294869173661},
+	{18104847622875013380, 11988776213932474182, 9350339506091840969, 85015184293233538},
+	{13407687491738990917, 1150912212133429016, 2464552345760025404, 106268980366541923},
+	{16759609364673738647, 1438640265166786270, 16915748487482195467, 132836225458177403},
+	{5863069834493698750, 17040051230225099083, 8266499795462678214, 83022640911360877},
+	{2717151274689735534, 12076692000926598046, 14944810762755735672, 103778301139201096},
+	{12619811130216945225, 15095865001158247557, 234269379735117974, 129722876424001371},
+	{10193224965599284718, 4823229607296516819, 16287319426830306398, 81076797765000856},
+	{8129845188571717993, 15252409045975421832, 1912405209828331381, 101345997206251071},
+	{10162306485714647492, 5230453252187113578, 16225564567567577939, 126682496507813838},
+	{10963127571999042586, 963190273403252034, 5529291836302348308, 79176560317383649},
+	{4480537428144027425, 1203987841754065043, 11523300813805323289, 98970700396729561},
+	{988985766752646377, 6116670820619969208, 569067961974490399, 123713375495911952},
+	{618116104220403986, 10740448290528562611, 355667476234056499, 77320859684944970},
+	{14607703185557668694, 8813874344733315359, 9667956382147346432, 96651074606181212},
+	{13647942963519697964, 11017342930916644199, 12084945477684183040, 120813843257726515},
+	{15447493379840893083, 6885839331822902624, 5247247914338920448, 75508652036079072},
+	{862622651091564738, 8607299164778628281, 6559059892923650560, 94385815045098840},
+	{5689964332291843827, 10759123955973285351, 8198824866154563200, 117982268806373550},
+	{10473756735323484247, 6724452472483303344, 512579522919214096, 73738918003983469},
+	{13092195919154355309, 8405565590604129180, 5252410422076405524, 92173647504979336},
+	{16365244898942944137, 10506956988255161475, 6565513027595506905, 115217059381224170},
+	{15844870105251292267, 17745382253746339748, 17430263321349159439, 144021324226530212},
+	{679671778927281859, 18008392936232544199, 1670542538988448841, 90013327641581383},
+	{14684647778941266036, 8675433115008516536, 15923236229017724764, 112516659551976728},
+	{18355809723676582545, 10844291393760645670, 1457301212562604339, 140645824439970911},
+	{6860695058870476186, 4471839111886709592, 7828342285492709568, 87903640274981819},
+	{8575868823588095233, 5589798889858386990, 5173741838438499056, 109879550343727274},
+	{1496463992630343233, 6987248612322983738, 15690549334902899628, 137349437929659092},
+	{5546976013821352425, 13590402419556640644, 583221297459536459, 85843398706036933},
+	{6933720017276690531, 12376317006018412901, 5340712640251808478, 107304248382546166},
+	{13278836040023251068, 6247024220668240318, 15899262837169536406, 134130310478182707},
+	{3687586506587144013, 17739448193199813911, 7631196264017266301, 83831444048864192},
+	{18444541188516093729, 8339252186217603676, 9538995330021582877, 104789305061080240},
+	{4608932411935565545, 15035751251199392500, 11923744162526978596, 130986631326350300},
+	{12103954794314504273, 173972495144844504, 16675712138434137431, 81866644578968937},
+	{15129943492893130342, 14052523674213219342, 7009582117760508076, 102333305723711172},
+	{9689057329261637119, 17565654592766524178, 8761977647200635095, 127916632154638965},
+	{10667346849215911103, 17896063148120159467, 7782079038714090886, 79947895096649353},
+	{8722497543092500975, 13146706898295423526, 14339284816820001512, 99934868870811691},
+	{1679749892010850411, 16433383622869279408, 13312420002597613986, 124918586088514614},
+	{1049843682506781507, 14882550782720687534, 3708576483196120837, 78074116305321634},
+	{10535676639988252692, 4768130423118695705, 13859092640849926855, 97592645381652042},
+	{17781281818412703769, 1348477010470981727, 8100493764207632761, 121990806727065053},
+	{18030830164149021711, 12372013177612833339, 7368651611843464427, 76244254204415658},
+	{17926851686758889235, 10853330453588653770, 18434186551659106342, 95305317755519572},
+	{13185192571593835736, 4343291030131041405, 4595989115864331312, 119131647194399466},
+	{10546588366459841287, 2714556893831900878, 7484179215842594974, 74457279496499666},
+	{3959863421220025801, 12616568154144651906, 131851982948467909, 93071599370624583},
+	{14173201313379808059, 1935652137398651170, 13999873033967748599, 116339499213280728},
+	{13469936839289767941, 8127311613515238837, 8749920646229842874, 72712187008300455},
+	{3002362993830046214, 935767480039272739, 6325714789359915689, 90890233760375569},
+	{17588011797569721480, 5781395368476478827, 12518829505127282515, 113612792200469461},
+	{17373328728534763946, 2615058192168210630, 1813478826126939432, 142015990250586827},
+	{6246644436906839562, 1634411370105131644, 17274325330825194809, 88759993906616766},
+	{7808305546133549453, 6654700231058802459, 12369534626676717703, 110949992383270958},
+	{5148695914239548912, 3706689270396115170, 6238546246491121321, 138687490479088698},
+	{7829620964827105974, 13845895840066041741, 8510777422484338729, 86679681549430436},
+	{14398712224461270371, 3472311744800388464, 10638471778105423412, 108349601936788045},
+	{17998390280576587964, 4340389681000485580, 17909775741059167169, 135437002420985056},
+	{2025621888505591669, 14241958596693773248, 11193609838161979480, 84648126513115660},
+	{2532027360631989587, 17802448245867216560, 13992012297702474350, 105810158141394575},
+	{3165034200789986984, 13029688270479244892, 12878329353700705034, 132262697676743219},
+	{11201518412348517673, 12755241187476915961, 5743112836849246694, 82664186047964512},
+	{166839960153483379, 6720679447491369144, 7178891046061558368, 103330232559955640},
+	{208549950191854224, 8400849309364211430, 8973613807576947960, 129162790699944550},
+	{13965401774152072602, 5250530818352632143, 996822611308204571, 80726744187465344},
+	{12845066199262702848, 1951477504513402275, 1246028264135255714, 100908430234331680},
+	{11444646730650990656, 11662718917496528652, 1557535330169069642, 126135537792914600},
+	{16376276243511644968, 11900885341862718311, 973459581355668526, 78834711120571625},
+	{15858659285962168306, 5652734640473622081, 5828510495121973562, 98543388900714531},
+	{5988266052170546671, 16289290337446803410, 2673952100475079048, 123179236125893164},
+	{8354352301033979573, 10180806460904252131, 10894592099651700213, 76987022578683227},
+	{5831254357865086563, 17337694094557703068, 9006554106137237362, 96233778223354034},
+	{7289067947331358203, 12448745581342353027, 2034820595816770895, 120292222779192543},
+	{2249824457868404925, 14697995015980052498, 8189291900026563665, 75182639236995339},
+	{12035652609190281964, 4537435714692901910, 5624928856605816678, 93978299046244174},
+	{5821193724633076647, 14895166680220903196, 16254533107612046655, 117472873807805217},
+	{12861618114750448712, 16227008202779146353, 3241554164616447303, 73420546129878261},
+	{2241964588155897179, 15672074235046545038, 8663628724197947033, 91775682662347826},
+	{12025827772049647282, 5755034738526017585, 1606163868392657984, 114719603327934783},
+	{1197226659779895390, 7193793423157521982, 15842762890772986192, 143399504159918478},
+	{14583324717644598331, 4496120889473451238, 5290040788305728466, 89624690099949049},
+	{9005783860200972105, 14843523148696589856, 11224237003809548486, 112030862624936311},
+	{11257229825251215132, 9331031899015961512, 9418610236334547704, 140038578281170389},
+	{7035768640782009457, 5831894936884975945, 8192474406922786267, 87524111425731493},
+	{13406396819404899726, 2678182652678832027, 14852279027080870738, 109405139282164366},
+	{12146310005828736753, 12571100352703315842, 9341976746996312614, 136756424102705458},
+	{12203129772070348375, 3245251702012184497, 10450421485300083288, 85472765064190911},
+	{1418854159805771756, 4056564627515230622, 8451340838197716206, 106840956330238639},
+	{10996939736611990503, 14294077821248814085, 5952490029319757353, 133551195412798299},
+	{9178930344596188016, 2016269610639426947, 1414463259111154394, 83469497132998937},
+	{6861976912317847117, 11743709050154059492, 6379765092316330896, 104336871416248671},
+	{8577471140397308896, 14679636312692574365, 3363020346968025716, 130421089270310839},
+	{7666762471962012012, 18398144732287634786, 9019416744496097928, 81513180793944274},
+	{360081053097739207, 4550936841649991867, 2050898893765346603, 101891475992430343},
+	{14285159371654337721, 1076985033635101929, 16398681672488846966, 127364344990537928},
+	{2010695579642879219, 14508173701304102418, 10249176045305529353, 79602715619086205},
+	{11736741511408374832, 4300159071347964310, 17423156075059299596, 99503394523857756},
+	{5447554852405692732, 5375198839184955388, 3332201020114572879, 124379243154822196},
+	{12628093819608333766, 10277028302131678973, 11305997674426383857, 77737026971763872},
+	{1950059219228253495, 17457971396091986621, 14132497093032979821, 97171283714704840},
+	{7049260042462704773, 7987406189832819564, 17665621366291224777, 121464104643381050},
+	{13629159563393966291, 16521343914713981987, 15652699372359403389, 75915065402113156},
+	{12424763435815069960, 6816621838110313772, 1119130141739702621, 94893831752641446},
+	{15530954294768837450, 13132463316065280119, 10622284714029404084, 118617289690801807},
+	{16624375461871605262, 17431161609395575882, 13556456973909459408, 74135806056751129},
+	{11557097290484730770, 3342207938034918237, 3110513162104660549, 92669757570938912},
+	{611313557823749750, 8789445940971035701, 3888141452630825686, 115837196963673640},
+	{2687913982853537546, 881717694679509409, 2430088407894266054, 72398248102296025},
+	{7971578496994309836, 10325519155204162569, 7649296528295220471, 90497810127870031},
+	{14576159139670275199, 8295212925577815307, 4949934641941637685, 113122262659837539},
+	{13608512906160456095, 14980702175399657038, 1575732283999659202, 141402828324796924},
+	{3893634547922897155, 13974624878052173553, 10208204714354562809, 88376767702998077},
+	{9478729203331009348, 3633223042283053229, 17371941911370591416, 110470959628747596},
+	{16460097522591149590, 4541528802853816536, 3268183315503687654, 138088699535934496},
+	{10287560951619468493, 16673513557065799047, 2042614572189804783, 86305437209959060},
+	{8247765171096947713, 16230205927904860905, 2553268215237255979, 107881796512448825},
+	{14921392482298572545, 15676071391453688227, 7803271287473957878, 134852245640561031},
+	{7020027292222913888, 5185858601231167238, 11794573582312305530, 84282653525350644},
+	{17998406152133418169, 15705695288393734855, 14743216977890381912, 105353316906688305},
+	{17886321671739384807, 1185375036782616953, 4593963167080813679, 131691646133360382},
+	{4261422017196033648, 7658388425630217452, 16706285034707672261, 82307278833350238},
+	{5326777521495042060, 14184671550465159719, 11659484256529814518, 102884098541687798},
+	{2046785883441414672, 8507467401226673841, 5350983283807492340, 128605123177109748},
+	{12808456223219353930, 14540539162621446958, 12567736589234458520, 80378201985693592},
+	{6787198242169416604, 18175673953276808698, 15709670736543073150, 100472752482116990},
+	{17707369839566546563, 13496220404741235064, 10413716383824065630, 125590940602646238},
+	{11067106149729091602, 3823451734535884011, 1896886721462653115, 78494337876653899},
+	{9222196668733976598, 167628649742467110, 16206166457110480106, 98117922345817373},
+	{2304373799062694940, 9432907849032859696, 6422650016105936420, 122647402932271717},
+	{1440233624414184337, 15118939442500313118, 6319999269279904214, 76654626832669823},
+	{11023664067372506230, 9675302266270615589, 3288313068172492364, 95818283540837279},
+	{18391266102643020691, 12094127832838269486, 17945449390497779167, 119772854426046598},
+	{6882855295724500028, 14476358923165000285, 6604219850633724075, 74858034016279124},
+	{13215255138083012939, 13483762635528862452, 8255274813292155094, 93572542520348905},
+	{16519068922603766174, 7631331257556302257, 14930779535042581772, 116965678150436131},
+	{3406889048986272003, 13992954072827464719, 7025894200187919655, 73103548844022582},
+	{18093669366515003715, 12879506572606942994, 18005739787089675377, 91379436055028227},
+	{13393714671288978836, 2264325160476515031, 17895488715434706318, 114224295068785284},
+	{12130457320683835641, 12053778487450419597, 3922616820583831281, 142780368835981606},
+	{9887378834641091228, 616082527015430392, 16286693568147058263, 89237730522488503},
+	{12359223543301364035, 14605161214051451702, 15746680941756434924, 111547163153110629},
+	{6225657392271929236, 18256451517564314628, 5848293121913379943, 139433953941388287},
+	{13114407907024731580, 18327811226118778498, 10572712228836944320, 87146221213367679},
+	{7169637846926138667, 4463019958938921507, 8604204267618792497, 108932776516709599},
+	{4350361290230285430, 10190460967101039788, 6143569316096102717, 136165970645886999},
+	{11942347843248704202, 8674881113651843819, 10757259850201146054, 85103731653679374},
+	{10316248785633492348, 1620229355210028966, 4223202775896656760, 106379664567099218},
+	{3671938945187089627, 2025286694012536208, 14502375506725596758, 132974580708874022},
+	{2294961840741931017, 15100862239039998842, 4452298673276110069, 83109112943046264},
+	{12092074337782189579, 5041019743517834840, 5565373341595137587, 103886391178807830},
+	{15115092922227736974, 1689588660969905646, 16180088713848697792, 129857988973509787},
+	{4835247057964947705, 1055992913106191029, 7806712436941742168, 81161243108443617},
+	{10655744840883572535, 1319991141382738786, 14370076564604565614, 101451553885554521},
+	{4096309014249689861, 10873360963583199291, 4127537650473543305, 126814442356943152},
+	{254350124692362211, 18325065648307969317, 2579711031545964565, 79259026473089470},
+	{4929623674292840668, 9071274005102797934, 12448010826287231515, 99073783091361837},
+	{15385401629720826643, 6727406487951109513, 1724955477576875682, 123842228864202297},
+	{2698346990934434796, 8816315073396831350, 12607312219554017061, 77401393040126435},
+	{12596305775522819303, 15632079860173427091, 11147454256015133422, 96751741300158044},
+	{11133696200976136224, 10316727788362008056, 13934317820018916778, 120939676625197555},
+	{6958560125610085140, 11059640886153642939, 6403105628298129034, 75587297890748472},
+	{4086514138585218521, 4601179070837277866, 8003882035372661293, 94484122363435590},
+	{14331514710086298960, 10363159856973985236, 781480507361050808, 118105152954294488},
+	{18180568730658712658, 6476974910608740772, 488425317100656755, 73815720596434055},
+	{4278966839613839206, 3484532619833538062, 14445589701657984656, 92269650745542568},
+	{14572080586372074816, 4355665774791922577, 18056987127072480820, 115337063431928210},
+	{2190021338841464904, 11945663146099727419, 15897302972847688416, 72085664644955131},
+	{16572584728833994842, 14932078932624659273, 15259942697632222616, 90107080806193914},
+	{6880672855760329840, 218354592071272476, 9851556335185502463, 112633851007742393},
+	{8600841069700412300, 14108001295371254307, 16926131437409265982, 140792313759677991},
+	{3069682659349063735, 4205814791179646038, 17496361176021873095, 87995196099798744},
+	{13060475361041105477, 645582470547169643, 3423707396317789753, 109993995124748431},
+	{11713908182873993943, 5418664106611349958, 18114692300679400903, 137492493905935538},
+	{2709506595868858310, 10304194094273175580, 15933368706352013468, 85932808691209711},
+	{3386883244836072888, 12880242617841469475, 15305024864512628931, 107416010864012139},
+	{18068662111327254822, 11488617253874448939, 14519595062213398260, 134270013580015174},
+	{8987070810365840312, 16403757820526306395, 4463060895455986008, 83918758487509484},
+	{6622152494529912486, 2057953201948331378, 5578826119319982511, 104898448109386855},
+	{17501062655017166415, 16407499557717577934, 2361846630722590234, 131123060136733569},
+	{6326478140958341105, 14866373242000874113, 13005369190270088656, 81951912585458480},
+	{12519783694625314286, 136222478791541025, 16256711487837610821, 102439890731823100},
+	{1814671562999479145, 4781964116916814186, 1874145286087461910, 128049863414778876},
+	{5745855745302062370, 16823785628355172578, 10394712840659439501, 80031164634236797},
+	{16405691718482353770, 7194673980161802010, 17605077069251687281, 100038955792795996},
+	{11283742611248166405, 13605028493629640417, 3559602262855057485, 125048694740994996},
+	{134810104389022147, 10808985817732219213, 11448123451139186736, 78155434213121872},
+	{4780198648913665588, 13511232272165274016, 14310154313923983420, 97694292766402340},
+	{5975248311142081985, 16889040340206592520, 17887692892404979275, 122117865958002925},
+	{3734530194463801240, 8249807203415426373, 13485651066966805999, 76323666223751828},
+	{9279848761507139454, 5700572985841895062, 16857063833708507499, 95404582779689785},
+	{2376438915029148510, 2514030213874980924, 7236271736853470662, 119255728474612232},
+	{10708646358747993627, 15406326938954026789, 4522669835533419163, 74534830296632645},
+	{17997493966862379937, 14646222655265145582, 10265023312844161858, 93168537870790806},
+	{13273495421723199114, 9084406282226656170, 3607907104200426515, 116460672338488508},
+	{12907620657004387350, 3371910917177966154, 11478313976980042380, 72787920211555317},
+	{6911153784400708380, 4214888646472457693, 512834415942889263, 90984900264444147},
+	{13250628248928273379, 656924789663184212, 14476101075210775291, 113731125330555183},
+	{16563285311160341723, 14656214042361143977, 13483440325586081209, 142163906663193979},
+	{3434524291834131721, 2242604748834633130, 6121307194277606804, 88852441664496237},
+	{13516527401647440459, 2803255936043291412, 12263320011274396409, 111065552080620296},
+	{16895659252059300574, 8115755938481502169, 15329150014092995511, 138831940100775370},
+	{3642258004895981003, 11989876489192020712, 14192404777235510098, 86769962562984606},
+	{4552822506119976253, 5763973574635250082, 8517133934689611815, 108462453203730758},
+	{14914400169504746125, 2593280949866674698, 1423045381507238961, 135578066504663448},
+	{13933186124367854232, 13150015639735141446, 889403363442024350, 84736291565414655},
+	{8193110618605041982, 7214147512814151000, 14946812259584694150, 105920364456768318},
+	{10241388273256302478, 18241056427872464558, 9460143287626091879, 132400455570960398},
+	{1789181652357801144, 18318189295061372205, 1300903536338919520, 82750284731850249},
+	{6848163083874639335, 4450992545117163640, 6237815438851037305, 103437855914812811},
+	{8560203854843299168, 10175426699823842454, 3185583280136408727, 129297319893516014},
+	{738441390849674076, 13277170715030983390, 15826047605367419166, 80810824933447508},
+	{10146423775416868403, 7373091356933953429, 1335815432999722342, 101013531166809386},
+	{17294715737698473408, 18439736233022217594, 10893141328104428735, 126266913958511732},
+	{15420883354488933784, 18442364173279967852, 16031585366920043767, 78916821224069832},
+	{829360119401615614, 18441269198172571912, 1592737634940503093, 98646026530087291},
+	{1036700149252019518, 9216528442433551178, 15825980098957792579, 123307533162609113},
+	{5259623611709900102, 3454487267307275534, 2973708534207538506, 77067208226630696},
+	{15797901551492150936, 13541481120988870225, 3717135667759423132, 96334010283288370},
+	{5912318884083024958, 16926851401236087782, 13869791621554054723, 120417512854110462},
+	{17530257357834054311, 8273439116558860911, 4056933745043896298, 75260945533819039},
+	{17301135678865179984, 1118426858843800331, 459481162877482469, 94076181917273799},
+	{17014733580154087077, 6009719591982138318, 14409409508879016798, 117595227396592248},
+	{6022522469168916519, 17591132800271000161, 9005880943049385498, 73497017122870155},
+	{12139839104888533553, 12765543963483974393, 6645665160384343969, 91871271403587694},
+	{1339740825828503229, 2121871899072804280, 17530453487335205770, 114839089254484617},
+	{1674676032285629036, 11875711910695781158, 8078008803886843500, 143548861568105772},
+	{14881730575460681859, 16645691981039639031, 14272127539284052995, 89718038480066107},
+	{13990477200898464420, 16195428957872160885, 13228473405677678340, 112147548100082634},
+	{3653038445840916814, 1797542123630649491, 7312219720242322118, 140184435125103293},
+	{18424050093146430672, 14958521882551319643, 6875980334365145275, 87615271953189558},
+	{18418376598005650436, 14086466334761761650, 17818347454811207402, 109519089941486947},
+	{13799598710652287238, 8384710881597426255, 17661248300086621349, 136898862426858684},
+	{15542278221798761379, 7546287310212085361, 1814908150699362535, 85561789016786678},
+	{5592789721966288012, 4821173119337718798, 11492007225228978977, 106952236270983347},
+	{16214359189312635824, 10638152417599536401, 9753323013108835817, 133690295338729184},
+	{3216445465679315534, 18178060307068180011, 6095826883193022385, 83556434586705740},
+	{17855614887381308129, 8887517328553061301, 7619783603991277982, 104445543233382175},
+	{8484460553944471449, 1886024623836550819, 4913043486561709574, 130556929041727719},
+	{2996944837001600704, 15013823445180007974, 9988181206742150339, 81598080651079824},
+	{12969553083106776688, 14155593288047622063, 12485226508427687924, 101997600813849780},
+	{11600255335456082956, 17694491610059527579, 15606533135534609905, 127497001017312225},
+	{4944316575446357895, 4141528228646122881, 2836554182068049335, 79685625635820141},
+	{10792081737735335273, 565224267380265697, 8157378746012449573, 99607032044775176},
+	{18101788190596556996, 5318216352652720025, 10196723432515561966, 124508790055968970},
+	{4396088591481766266, 17158943275690113728, 10984638163749614132, 77817993784980606},
+	{5495110739352207833, 3001935020903090544, 4507425667832241858, 97272492231225758},
+	{6868888424190259791, 12975790812983638988, 14857654121645078130, 121590615289032197},
+	{13516427301973688177, 12721555276542162271, 11591876835241867783, 75994134555645123},
+	{12283848109039722318, 11290258077250314935, 9878160025624946825, 94992668194556404},
+	{10743124117872264993, 277764541280729957, 12347700032031183532, 118740835243195505},
+	{9020295582883859572, 9396974875155232031, 799783492378407851, 74213022026997191},
+	{6663683460177436562, 7134532575516652135, 14834787420755173526, 92766277533746488},
+	{3717918306794407798, 18141537756250590977, 96740202234415291, 115957846917183111},
+	{13852913987814974634, 9032618088442925408, 6977991654037591413, 72473654323239444},
+	{17316142484768718292, 15902458628981044664, 8722489567546989266, 90592067904049305},
+	{3198434032251346250, 10654701249371530023, 15514797977861124487, 113240084880061631},
+	{17833100595596346524, 8706690543287024624, 14781811453899017705, 141550106100077039},
+	{11145687872247716577, 16970896635622860150, 16156161186327967921, 88468816312548149},
+	{4708737803454869914, 7378562739246411476, 6360143427627796190, 110586020390685187},
+	{5885922254318587392, 18446575460912790153, 3338493266107357333, 138232525488356484},
+	{15207916455017586880, 13834952672284187797, 11309930328171874141, 86395328430222802},
+	{5174837513489819888, 3458632785073071035, 4914040873360066869, 107994160537778503},
+	{1856860873434886956, 8934976999768726698, 1530865073272695682, 134992700672223129},
+	{5772224064324192252, 10196046643282842090, 12486005716863904561, 84370437920139455},
+	{16438652117260016123, 17356744322530940516, 10995821127652492797, 105463047400174319},
+	{2101571072865468537, 7860872347881511934, 9133090391138228093, 131828809250217899},
+	{15148539975823081548, 7218888226639638910, 3402338485247698606, 82393005781386187},
+	{9712302932924076127, 18246982320154324446, 18087981161841786969, 102991257226732733},
+	{2917006629300319350, 8973669844910741846, 8774918397020070000, 128739071533415917},
+	{15658187198594863306, 5608543653069213653, 7790167007351237702, 80461919708384948},
+	{5737675942961415420, 16234051603191292875, 9737708759189047127, 100577399635481185},
+	{2560408910274381372, 15680878485561728190, 16783821967413696813, 125721749544351481},
+	{15435313624203652069, 12106392062689774070, 3572359701992478652, 78576093465219676},
+	{10070769993399789278, 15132990078362217588, 4465449627490598315, 98220116831524595},
+	{12588462491749736598, 14304551579525384081, 970126015935859990, 122775146039405744},
+	{950260029702503518, 4328658718775977147, 606328759959912494, 76734466274628590},
+	{15022883092410293109, 14634195435324747241, 9981282986804666425, 95918082843285737},
+	{4943545810230702675, 4457686238873770340, 17088289751933220936, 119897603554107171},
+	{12313088168248964979, 2786053899296106462, 8374338085744569133, 74936002221316982},
+	{6167988173456430416, 8094253392547520982, 1244550570325935608, 93670002776646228},
+	{16933357253675313829, 10117816740684401227, 1555688212907419510, 117087503470807785},
+	{8277505274333377191, 1711949444500362863, 12501520179135606954, 73179689669254865},
+	{5735195574489333585, 11363308842480229387, 1791842168637344980, 91474612086568582},
+	{2557308449684279077, 14204136053100286734, 11463174747651457033, 114343265108210727},
+	{12420007598960124654, 3920112011093194705, 9717282416136933388, 142929081385263409},
+	{844975721708996053, 11673442043788022499, 17602516556154053127, 89330675865789630},
+	{14891277707418408778, 9980116536307640219, 12779773658337790601, 111663344832237038},
+	{14002411115845623069, 17086831688811938178, 6751345036067462443, 139579181040296298},
+	{13363192965830902322, 8373426796293767409, 8831276665969551931, 87236988150185186},
+	{2868933152006464190, 5855097476939821358, 1815723795607164106, 109046235187731483},
+	{12809538476862856046, 16542243883029552505, 16104712799791118844, 136307793984664353},
+	{1088432520398203173, 1115530390038694508, 3147916472228367422, 85192371240415221},
+	{1360540650497753966, 10617785024403143943, 8546581608712847181, 106490464050519026},
+	{15535733868404356169, 17883917298931317832, 1459854974036283168, 133113080063148783},
+	{9709833667752722606, 11177448311832073645, 7829938386413758836, 83195675039467989},
+	{16748978103118291161, 13971810389790092056, 14399109001444586449, 103994593799334986},
+	{2489478555188312336, 3629704931955451359, 8775514214950957254, 129993242249168733},
+	{8473453124633777066, 16103623637754320811, 7790539393558042235, 81245776405730458},
+	{5980130387364833428, 15517843528765513110, 514802205092776986, 101557220507163073},
+	{16698535021060817593, 10173932374102115579, 5255188774793359137, 126946525633953841},
+	{8130741378949317044, 17887922779882291997, 14813708030314319220, 79341578521221150},
+	{14775112742114034209, 3913159401143313380, 9293763001038123218, 99176973151526438},
+	{22146853932991145, 14114821288283917534, 2393831714442878214, 123971216439408048},
+	{13848899838990283177, 4210077286750060554, 1496144821526798884, 77482010274630030},
+	{8087752761883078164, 5262596608437575693, 11093553063763274413, 96852512843287537},
+	{14721376970781235609, 11189931778974357520, 31883274421929304, 121065641054109422},
+	{9200860606738272255, 6993707361858973450, 13854985101795869527, 75666025658818388},
+	{2277703721568064511, 4130448183896328909, 17318731377244836909, 94582532073522985},
+	{7458815670387468543, 9774746248297799040, 7813356166273882424, 118228165091903732},
+	{4661759793992167839, 6109216405186124400, 14106719640775952323, 73892603182439832},
+	{5827199742490209799, 3024834488055267596, 17633399550969940404, 92365753978049790},
+	{7283999678112762249, 3781043110069084495, 12818377401857649697, 115457192472562238},
+	{11470028826461558262, 13892366989861647569, 3399799857733643156, 72160745295351399},
+	{502477977794784115, 17365458737327059462, 18084807877449217657, 90200931619189248},
+	{9851469509098255952, 7871765366376660615, 4159265773101970456, 112751164523986561},
+	{7702650867945432036, 9839706707970825769, 9810768234804850974, 140938955654983201},
+	{16343371838534364782, 1538130674054378201, 17660945192821501619, 88086847284364500},
+	{6594156742885792266, 15757721397850136464, 3629437417317325407, 110108559105455626},
+	{8242695928607240333, 15085465728885282676, 13760168808501432567, 137635698881819532},
+	{14375056992234301016, 16345945108194383528, 17823477542168171162, 86022311801137207},
+	{17968821240292876270, 11209059348388203602, 17667660909282826049, 107527889751421509},
+	{13237654513511319530, 176266130203090791, 8249518081321368850, 134409862189276887},
+	{15191063098585656562, 4721852349804319648, 12073477828466937387, 84006163868298054},
+	{542084799522519086, 1290629418828011657, 5868475248728895926, 105007704835372568},
+	{5289292017830536762, 10836658810389790379, 7335594060911119907, 131259631044215710},
+	{999964501930391524, 4467068747279925035, 18419804343351613654, 82037269402634818},
+	{15085013682695153117, 14807207970954682101, 13801383392334741259, 102546586753293523},
+	{5021209048086777685, 13897323945265964723, 12640043221991038670, 128183233441616904},
+	{832412645840542101, 4074141447363840048, 7900027013744399169, 80114520901010565},
+	{1040515807300677626, 9704362827632187964, 14486719785607886865, 100143151126263206},
+	{1300644759125847033, 16742139552967622859, 8885027695155082773, 125178938907829008},
+	{16953804038949512059, 12769680229818458238, 5553142309471926733, 78236836817393130},
+	{11968883011832114266, 2127042231990909086, 16164799923694684225, 97796046021741412},
+	{5737731727935367025, 7270488808416024262, 1759255830908803665, 122245057527176766},
+	{17421140385241768102, 16073270551328484923, 14934592949600166002, 76403160954485478},
+	{17164739463124822224, 10868216152305830346, 9444869150145431695, 95503951193106848},
+	{12232552292051251972, 8973584171954900029, 11806086437681789619, 119379938991383560},
+	{9951188191745726434, 3302647098258118566, 7378804023551118512, 74612461869614725},
+	{3215613202827382235, 4128308872822648208, 13835191047866286044, 93265577337018406},
+	{4019516503534227794, 5160386091028310260, 8070616772978081747, 116581971671273008},
+	{11735569851563668179, 919398297678999960, 5044135483111301092, 72863732294545630},
+	{14669462314454585224, 1149247872098749950, 15528541390743902173, 91079665368182037},
+	{9113455856213455722, 6048245858550825342, 5575618683147714004, 113849581710227547},
+	{2168447783412043845, 7560307323188531678, 2357837335507254601, 142311977137784434},
+	{15190337919914691115, 16254407123061302058, 6085334353119422029, 88944985711115271},
+	{9764550363038588086, 6482950848544463861, 2994981922971889633, 111181232138894089},
+	{16817373972225623011, 12715374579107967730, 8355413422142249945, 138976540173617611},
+	{15122544751068402286, 1029580084301397975, 2916290379625212264, 86860337608511007},
+	{14291494920408114953, 1286975105376747469, 17480421029813679042, 108575422010638758},
+	{4029310595227979980, 10832090918575710145, 12627154250412322994, 135719277513298448},
+	{14047534168085957247, 11381742842537206744, 7891971406507701871, 84824548445811530},
+	{17559417710107446559, 9615492534744120526, 641592221279851531, 106030685557264413},
+	{12725900100779532391, 7407679650002762754, 5413676295027202318, 132538356946580516},
+	{12565373581414595648, 18113762824338817, 12606919721246777257, 82836473091612822},
+	{1871658921486080848, 4634328221957811426, 6535277614703695763, 103545591364516028},
+	{11562945688712376869, 1181224259019876378, 8169097018379619704, 129431989205645035},
+	{11838527073872623447, 738265161887422736, 2799842627273568363, 80894993253528147},
+	{14798158842340779309, 14757889507641442132, 17334861339374124165, 101118741566910183},
+	{50954479216422520, 4612303829269638954, 17056890655790267303, 126398426958637729},
+	{4643532567937651979, 9800218920934606202, 3743027632227835208, 78999016849148581},
+	{15027787746776840781, 12250273651168257752, 9290470558712181914, 98748771061435726},
+	{337990609761499361, 6089470027105546383, 2389716161535451585, 123435963826794658},
+	{7128773158742018956, 15335133813009436249, 6105258619387045144, 77147477391746661},
+	{13522652466854911600, 722173192552243695, 12243259292661194335, 96434346739683326},
+	{12291629565141251596, 14737774545972468331, 6080702078971717110, 120542933424604158},
+	{5376425468999588295, 4599423072805404803, 17635496854639486906, 75339333390377598},
+	{2108845817822097465, 14972650877861531812, 12820999031444582824, 94174166737971998},
+	{2636057272277621831, 269069523617363149, 6802876752450952723, 117717708422464998},
+	{3953378804387207596, 16309069516756709632, 18086856025564009163, 73573567764040623},
+	{4941723505484009496, 15774650877518499136, 17996884013527623550, 91966959705050779},
+	{6177154381855011870, 10494941560043348112, 17884418998482141534, 114958699631313474},
+	{7721442977318764837, 3895304913199409332, 13132151711247901110, 143698374539141843},
+	{14049273897679003831, 16269623626031794544, 5901751810316244241, 89811484086963652},
+	{17561592372098754789, 6501971477257579468, 7377189762895305302, 112264355108704565},
+	{3505246391413891870, 17350836383426750144, 13833173222046519531, 140330443885880706},
+	{2190778994633682419, 8538429730428024888, 13257419282206462611, 87706527428675441},
+	{2738473743292103024, 6061351144607643206, 2736716047475914552, 109633159285844302},
+	{12646464215969904588, 7576688930759554007, 12644267096199668998, 137041449107305377},
+	{14821569162622272223, 123744563297333350, 985137907483711268, 85650905692065861},
+	{9303589416423064471, 154680704121666688, 5843108402782026989, 107063632115082326},
+	{11629486770528830589, 4805036898579471264, 16527257540332309544, 133829540143852907},
+	{7268429231580519118, 3003148061612169540, 8023692953493999513, 83643462589908067},
+	{9085536539475648897, 8365621095442599829, 5417930173440111487, 104554328237385084},
+	{15968606692771949026, 5845340350875861882, 6772412716800139359, 130692910296731355},
+	{14592065201409856045, 10570866746938495532, 1926914938786393147, 81683068935457097},
+	{18240081501762320056, 8601897415245731511, 7020329691910379338, 102103836169321371},
+	{18188415858775512167, 1528999732202388581, 4163726096460586269, 127629795211651714},
+	{13673602920948389056, 3261467841840186815, 7214014828715254322, 79768622007282321},
+	{12480317632758098416, 13300206839155009327, 13629204554321455806, 99710777509102901},
+	{10988711022520235116, 7401886512088985851, 3201447637619656046, 124638471886378627},
+	{4562101379861452995, 14493051628228253, 18141805838008142693, 77899044928986641},
+	{10314312743254204148, 4629802332962673220, 8842199242228014654, 97373806161233302},
+	{12892890929067755186, 15010624953058117333, 1829377015930242509, 121717257701541628},
+	{10363899839881040943, 11687483604875017285, 10366732671811177376, 76073286063463517},
+	{17566560818278689083, 14609354506093771606, 17570101858191359624, 95091607579329396},
+	{12734828985993585545, 18261693132617214508, 3515883249029647914, 118864509474161746},
+	{17182640153100766774, 16025244226313146971, 6809113049070917850, 74290318421351091},
+	{16866614172948570563, 10808183246036657906, 3899705292911259409, 92862898026688864},
+	{11859895679330937396, 18121915075973210287, 4874631616139074261, 116078622533361080},
+	{14329963827222917728, 13632039931696950381, 3046644760086921413, 72549139083350675},
+	{4077396728746483449, 3204991859339024265, 17643364005390815479, 90686423854188343},
+	{9708431929360492215, 17841297879455944043, 17442518988311131444, 113358029817735429},
+	{7523853893273227365, 3854878275610378438, 7968090680106750594, 141697537272169287},
+	{90722664868379199, 7020984940683874428, 11897585702707800977, 88560960795105804},
+	{113403331085473999, 13387917194282230939, 14871982128384751221, 110701200993882255},
+	{13976812219139006211, 2899838437570624961, 13978291642053551123, 138376501242352819},
+	{1817978609320797025, 17953300087977498265, 6430589267069775499, 86485313276470512},
+	{6884159280078384186, 17829939091544484927, 8038236583837219374, 108106641595588140},
+	{3993513081670592328, 13064051827575830351, 10047795729796524218, 135133301994485175},
+	{9413474703685202061, 12776718410662281873, 13197401358763909492, 84458313746553234},
+	{16378529398033890480, 15970898013327852341, 7273379661600111057, 105572892183191543},
+	{6638103692260199389, 6128564461377651715, 4480038558572750918, 131966115228989429},
+	{1842971798448930666, 17665410843643196034, 5105867108321663275, 82478822018118393},
+	{11527086784915939140, 17470077536126607138, 10994019903829466998, 103098527522647991},
+	{5185486444290148118, 12614224883303483115, 9130838861359445844, 128873159403309989},
+	{935086018467648621, 17107262588919452755, 8012617297563347604, 80545724627068743},
+	{15003915578366724489, 2937334162439764327, 5404085603526796602, 100682155783835929},
+	{14143208454531017707, 12895039739904481217, 11366793022835883656, 125852694729794911},
+	{1921976256440804211, 8059399837440300761, 14021774666913509141, 78657934206121819},
+	{7014156338978393168, 14685935815227763855, 12915532315214498522, 98322417757652274},
+	{4156009405295603556, 9134047732179929011, 6921043357163347345, 122903022197065343},
+	{291662869096058270, 17237994878680925392, 11243181125868173946, 76814388873165839},
+	{364578586370072838, 12324121561496380932, 9442290388907829529, 96017986091457299},
+	{455723232962591047, 1570093896588312453, 7191176967707399008, 120022482614321624},
+	{2590670029815313356, 981308685367695283, 4494485604817124380, 75014051633951015},
+	{17073395592551305407, 1226635856709619103, 1006420987594017571, 93767564542438769},
+	{16730058472261743855, 15368352876169187591, 5869712252919909867, 117209455678048461},
+	{17373815572804671765, 7299377538392048292, 5974413167288637619, 73255909798780288},
+	{3270525392296288091, 4512535904562672462, 7468016459110797024, 91569887248475360},
+	{13311528777225135922, 5640669880703340577, 9335020573888496280, 114462359060594200},
+	{2804352916249256190, 7050837350879175722, 11668775717360620350, 143077948825742750},
+	{6364406591083173023, 18241831399581648538, 2681298804922999814, 89423718016089219},
+	{17178880275708742087, 13578917212622284864, 17186681561435913480, 111779647520111523},
+	{3026856270926375992, 16973646515777856081, 16871665933367503946, 139724559400139404},
+	{13421000215397454755, 15220215090788547954, 1321419171499914158, 87327849625087128},
+	{7552878232392042636, 9801896826630909135, 1651773964374892698, 109159812031358910},
+	{4829411772062665391, 3028998996433860611, 11288089492323391681, 136449765039198637},
+	{712539348325471917, 13422339418839632642, 9360898941915813752, 85281103149499148},
+	{10114046222261615705, 16777924273549540802, 11701123677394767190, 106601378936873935},
+	{3419185740972243823, 11749033305082150195, 10014718578316071084, 133251723671092419},
+	{18277892152603510053, 16566517852531119679, 3953356102233850475, 83282327294432762},
+	{18235679172326999663, 16096461297236511695, 14165067164647088902, 104102909118040952},
+	{18182912946981361674, 10897204584690863811, 17706333955808861128, 130128636397551190},
+	{9058477582649657094, 6810752865431789882, 6454772703953150301, 81330397748469494},
+	{2099724941457295560, 13125127100217125257, 17291837916796213684, 101662997185586867},
+	{7236342195249007354, 16406408875271406571, 17003111377567879201, 127078746481983584},
+	{2216870862816935644, 3336476519403547251, 10626944610979924501, 79424216551239740},
+	{16606146633803333267, 8782281667681821967, 13283680763724905626, 99280270689049675},
+	{16145997273826778680, 1754480047747501651, 11992914936228744129, 124100338361312094},
+	{7785405286928042723, 12625765075910658292, 2883885816715577176, 77562711475820059},
+	{9731756608660053404, 15782206344888322865, 17439915326176635182, 96953389344775073},
+	{16776381779252454659, 10504385894255627773, 7964836102438630266, 121191736680968842},
+	{12791081621246478114, 11176927202337155262, 9589708582451531820, 75744835425605526},
+	{6765479989703321834, 13971159002921444078, 2763763691209638967, 94681044282006908},
+	{17680222023983928101, 12852262735224417193, 3454704614012048709, 118351305352508635},
+	{4132609737348873207, 10338507218728954698, 18300091448253388107, 73969565845317896},
+	{14389134208540867317, 8311448004983805468, 4428370236607183518, 92461957306647371},
+	{17986417760676084146, 1165937969374981027, 923776777331591494, 115577446633309214},
+	{8935668091208858639, 14563769286141526854, 14412418541114408395, 72235904145818258},
+	{1946213077156297491, 13593025589249520664, 8792151139538234686, 90294880182272823},
+	{2432766346445371864, 7767909949707125022, 6378502905995405454, 112868600227841029},
+	{12264329969911490638, 486515400279130469, 12584814650921644722, 141085750284801286},
+	{9971049240408375600, 4915758143601844447, 3253823138398640047, 88178593928000804},
+	{7852125532083081597, 1533011661074917655, 4067278922998300059, 110223242410001005},
+	{5203470896676464092, 15751322631625810781, 9695784672175262977, 137779053012501256},
+	{5558012319636484009, 2927047617125049882, 6059865420109539361, 86111908132813285},
+	{16170887436400380820, 8270495539833700256, 12186517793564312105, 107639885166016606},
+	{1766865221790924409, 14949805443219513225, 6009775205100614323, 134549856457520758},
+	{12633505809687797515, 7037785392798501813, 17591167558470047664, 84093660285950473},
+	{1956824206827583182, 8797231740998127267, 8153901392805395868, 105117075357438092},
+	{16281088313816642690, 10996539676247659083, 10192376741006744835, 131396344196797615},
+	{7869837186921707729, 4566994288441092975, 13287764490770297378, 82122715122998509},
+	{5225610465224746757, 14932114897406142027, 2774647558180708010, 102653393903748137},
+	{1920327063103545543, 9441771584902901726, 8079995466153272917, 128316742379685171},
+	{15035262469721879676, 8206950249778007530, 2744154157132101621, 80197963987303232},
+	{9570706050297573787, 14870373830649897317, 3430192696415127026, 100247454984129040},
+	{16575068581299355138, 9364595251457595838, 4287740870518908783, 125309318730161300},
+	{5747731844884709057, 12770401059802079255, 11903210080929093797, 78318324206350812},
+	{2572978787678498418, 2127943269470435357, 14879012601161367247, 97897905257938515},
+	{7827909503025510926, 16494987142120207908, 13987079733024321154, 122372381572423144},
+	{14115815476245720137, 14921052982252517846, 8741924833140200721, 76482738482764465},
+	{8421397308452374363, 4816258172533483596, 15539092059852638806, 95603423103455581},
+	{10526746635565467954, 15243694752521630303, 5588807019533634795, 119504278879319477},
+	{13496745674869499327, 7221466211112324987, 5798847396422215699, 74690174299574673},
+	{12259246075159486255, 4415146745463018330, 11860245263955157528, 93362717874468341},
+	{6100685557094582011, 5518933431828772913, 990248524661783198, 116703397343085427},
+	{15342143519252583517, 17284391450175146782, 16759806392409472162, 72939623339428391},
+	{9954307362210953588, 12382117275864157670, 16338071972084452299, 91174529174285489},
+	{3219512165908916177, 10865960576402809184, 6587531909823401662, 113968161467856862},
+	{4024390207386145221, 4359078683648735672, 17457786924134027886, 142460201834821077},
+	{2515243879616340763, 16559482232562623507, 13216959836797461380, 89037626146763173},
+	{16979112904802589666, 2252608716993727767, 2686141740714663014, 111297032683453967},
+	{16612205112575849179, 12039132933096935517, 17192735231175492479, 139121290854317458},
+	{12688471204573599688, 14441987110826666554, 15357145537912070703, 86950806783948411},
+	{6637216968862223803, 13440797870105945289, 14584745903962700475, 108688508479935514},
+	{12908207229505167657, 12189311319205043707, 9007560343098599786, 135860635599919393},
+	{5761786509227035834, 12230005592930540221, 17158940260505094626, 84912897249949620},
+	{11813919154961182696, 6064134954308399468, 3001931251921816667, 106141121562437026},
+	{14767398943701478370, 2968482674458111431, 12975786101757046642, 132676401953046282},
+	{16147153367454505837, 6466987689963707548, 12721552332025542055, 82922751220653926},
+	{1737197635608580681, 3472048594027246532, 6678568378177151761, 103653439025817408},
+	{2171497044510725851, 8951746760961446069, 8348210472721439701, 129566798782271760},
+	{3663028662032897609, 7900684734814597745, 5217631545450899813, 80979249238919850},
+	{9190471845968509915, 14487541936945635085, 15745411468668400574, 101224061548649812},
+	{16099775825888025298, 8886055384327268048, 1235020262125949102, 126530076935812266},
+	{10062359891180015811, 942098596777154626, 5383573682256106093, 79081298084882666},
+	{3354577827120243956, 5789309264398831187, 15952839139674908424, 98851622606103332},
+	{18028280339182468657, 7236636580498538983, 1494304850884083914, 123564528257629166},
+	{18185204239630124766, 9134583881238974768, 14768998587084716158, 77227830161018228},
+	{4284761225828104342, 2194857814693942653, 14504160146343582, 96534787701272786},
+	{9967637550712518332, 11966944305222204124, 9241502237037705285, 120668484626590982},
+	{15453145506050099765, 9785183199977571529, 1164252879721177899, 75417802891619364},
+	{5481373827280460995, 7619792981544576508, 1455316099651472374, 94272253614524205},
+	{6851717284100576243, 301369190075944827, 6430831142991728372, 117840317018155256},
+	{1976480293349166200, 9411727780652241325, 4019269464369830232, 73650198136347035},
+	{7082286385113845654, 11764659725815301656, 412400812034899886, 92062747670433794},
+	{8852857981392307068, 5482452620414351262, 9738873051898400666, 115078434588042242},
+	{1842700439885608027, 16076437812372714886, 2950219278018225024, 143848043235052803},
+	{14986745830210668729, 10047773632732946803, 17984788113257248304, 89905027021908001},
+	{14121746269335948007, 12559717040916183504, 8645927086289396668, 112381283777385002},
+	{17652182836669935009, 15699646301145229380, 1584036821006970027, 140476604721731253},
+	{1809242236063933572, 7506435929002074411, 3295866022343050219, 87797877951082033},
+	{16096610850362080677, 4771358892825205109, 8731518546356200678, 109747347438852541},
+	{6285705507670437135, 15187570652886282195, 15526084201372638751, 137184184298565676},
+	{1622722933080329257, 16409760685695008228, 480430589003123411, 85740115186603548},
+	{2028403666350411571, 15900514838691372381, 600538236253904264, 107175143983254435},
+	{7147190601365402368, 1428899474654663860, 14585730850599544043, 133968929979068043},
+	{13690366162708152288, 17033963236155022576, 6810238772411021074, 83730581236917527},
+	{17112957703385190360, 12069082008339002412, 3901112447086388439, 104663226546146909},
+	{2944453055521936334, 10474666491996365112, 9488076577285373453, 130829033182683636},
+	{1840283159701210209, 8852509566711422147, 15153419897658134216, 81768145739177272},
+	{16135412004908676473, 11065636958389277683, 495030798363116154, 102210182173971591},
+	{15557578987708457688, 4608674161131821296, 14453846553236058905, 127762727717464488},
+	{9723486867317786055, 14409636396775858070, 9033654095772536815, 79851704823415305},
+	{2930986547292456760, 13400359477542434684, 15903753638143058923, 99814631029269131},
+	{3663733184115570951, 12138763328500655451, 15268006029251435750, 124768288786586414},
+	{18430734304568089508, 2975041061885521752, 4930817749854759440, 77980180491616509},
+	{4591673807000560269, 3718801327356902191, 10775208205745837204, 97475225614520636},
+	{1127906240323312432, 4648501659196127739, 13469010257182296505, 121844032018150795},
+	{16845842464697927934, 14434528583066049596, 6112288401525241363, 76152520011344247},
+	{2610559007162858302, 13431474710405174092, 3028674483479163800, 95190650014180309},
+	{3263198758953572877, 16789343388006467615, 8397529122776342654, 118988312517725386},
+	{8957028251987064904, 5881653599076654355, 9860141720162602063, 74367695323578366},
+	{6584599296556443226, 2740380980418430040, 3101805113348476771, 92959619154472958},
+	{8230749120695554033, 17260534280805201262, 13100628428540371771, 116199523943091197},
+	{532532182007333366, 8481990916289556837, 10493735777051426309, 72624702464431998},
+	{5277351245936554612, 15214174663789333950, 3893797684459507078, 90780878080539998},
+	{15820061094275469073, 9794346292881891629, 14090619142429159656, 113476097600674997},
+	{5940018312562172630, 12242932866102364537, 3778215872754285858, 141845122000843747},
+	{15241726491419827653, 12263519059741365739, 55541911257734709, 88653201250527342},
+	{14440472095847396663, 1494340769394543462, 9292799425926944195, 110816501563159177},
+	{8827218082954470021, 15702984017025343040, 16227685300836068147, 138520626953948971},
+	{5517011301846543763, 7508522001427145448, 7836460303808848640, 86575391846218107},
+	{6896264127308179704, 9385652501783931810, 5183889361333672896, 108219239807772634},
+	{17843702195990000438, 11732065627229914762, 15703233738521866928, 135274049759715792},
+	{15763999890921138177, 7332541017018696726, 9814521086576166830, 84546281099822370},
+	{10481627826796646914, 18389048308128146716, 3044779321365432729, 105682851374777963},
+	{13102034783495808642, 9151252329878019683, 17641032206988954624, 132103564218472453},
+	{5882928730471186449, 5719532706173762302, 13331488138581790592, 82564727636545283},
+	{16577032949943758870, 7149415882717202877, 12052674154799850336, 103205909545681604},
+	{6886233132147534875, 8936769853396503597, 15065842693499812920, 129007386932102005},
+	{6609738716805903249, 5585481158372814748, 11721994692651077027, 80629616832563753},
+	{8262173396007379061, 2370165429538630531, 817435310531682572, 100787021040704692},
+	{5716030726581835923, 2962706786923288164, 1021794138164603215, 125983776300880865},
+	{12795891240968423259, 8769220769468136958, 12167836382421346769, 78739860188050540},
+	{6771492014355753266, 15573211980262559102, 15209795478026683461, 98424825235063175},
+	{17687737054799467391, 5631456920046035165, 14400558329105966423, 123031031543828969},
+	{13360678668463361071, 10437189602669853834, 2082819928050147158, 76894394714893106},
+	{7477476298724425531, 3823114966482541485, 11826896946917459756, 96117993393616382},
+	{13958531391832919818, 4778893708103176856, 5560249146792048887, 120147491742020478},
+	{8724082119895574886, 9904337595205567391, 17310213772027194266, 75092182338762798},
+	{6293416631442080704, 3157049957152183431, 12414395178179217025, 93865227923453498},
+	{3255084770875212976, 8557998464867617193, 6294621935869245473, 117331534904316873},
+	{13563643027865477870, 16877964086610730505, 15463353755986748180, 73332209315198045},
+	{3119495729549683625, 2650711034553861516, 5494134139701271514, 91665261643997557},
+	{3899369661937104532, 12536760830047102703, 11479353693053977296, 114581577054996946},
+	{262526058993992761, 15670951037558878379, 5125820079462695812, 143226971318746183},
+	{16304979851367103139, 570972361619523178, 10121166577305266739, 89516857074216364},
+	{11157852777354103116, 14548773507306567685, 12651458221631583423, 111896071342770455},
+	{112257916410465183, 13574280865705821703, 11202636758612091375, 139870089178463069},
+	{6987690225397622595, 15401454568707220420, 9307490983346251061, 87418805736539418},
+	{8734612781747028244, 5416760155601861813, 2410991692328038019, 109273507170674273},
+	{15529951995611173210, 2159264176074939362, 7625425633837435428, 136591883963342841},
+	{14317906015684371160, 10572912146901612909, 16295106067216866902, 85369927477089275},
+	{4062324464323300238, 3992768146772240329, 15757196565593695724, 106712409346361594},
+	{9689591598831513202, 4990960183465300411, 10473123670137343847, 133390511682951993},
+	{3750151740056001799, 10036879142306894613, 18074917339904309664, 83369069801844995},
+	{9299375693497390153, 12546098927883618266, 17981960656452999176, 104211337252306244},
+	{2400847580016961883, 15682623659854522833, 4030706746856697354, 130264171565382806},
+	{13029744783579070937, 14413325805836464674, 16354249772067599558, 81415107228364253},
+	{7063808942619062863, 8793285220440805035, 6607754159802335736, 101768884035455317},
+	{4218075159846440675, 10991606525551006294, 12871378718180307574, 127211105044319146},
+	{16471355030186189134, 2258068060041991029, 12656297717290080138, 79506940652699466},
+	{6754135732450572705, 12045957111907264595, 6597000109757824364, 99383675815874333},
+	{3830983647135827977, 15057446389884080744, 12857936155624668359, 124229594769842916},
+	{2394364779459892486, 16328433021318632321, 17259582134120193532, 77643496731151822},
+	{7604641992752253511, 1963797202938738785, 12351105630795466108, 97054370913939778},
+	{14117488509367704793, 2454746503673423481, 6215510001639556827, 121317963642424723},
+	{1905901290713733640, 17675117629291747340, 1578850741811029064, 75823727276515452},
+	{2382376613392167050, 3647152962905132559, 1973563427263786331, 94779659095644315},
+	{16813028822022372524, 18393999258913579410, 16302012339361896625, 118474573869555393},
+	{15119829032191370731, 4578720509179905275, 3271228684460103535, 74046608668472121},
+	{14288100271811825510, 1111714618047493690, 8700721874002517323, 92558260835590151},
+	{8636753302910006080, 15224701327841530825, 6264216324075758749, 115697826044487689},
+	{16927185860387223560, 11821281339114650717, 15444350248615818978, 72311141277804805},
+	{7323924270201865738, 5553229637038537589, 5470379755487610011, 90388926597256007},
+	{13766591356179720077, 2329851027870784082, 2226288675932124610, 112986158246570009},
+	{7984867158369874288, 12135685821693255911, 7394546863342543666, 141232697808212511},
+	{11908071001622253286, 12196489656985672848, 11539120817230171647, 88270436130132819},
+	{14885088752027816607, 10633926052804703156, 9812215003110326655, 110338045162666024},
+	{159616866325219143, 8680721547578491042, 12265268753887908319, 137922556453332530},
+	{4711446559880649868, 12342979994877638757, 12277478989607330603, 86201597783332831},
+	{10500994218278200240, 10817038975169660542, 10735162718581775350, 107751997229166039},
+	{3902870735992974492, 4297926682107299870, 8807267379799831284, 134689996536457549},
+	{16274352265277772769, 11909576213171838226, 7810385121588588504, 84181247835285968},
+	{11119568294742440153, 14886970266464797783, 9762981401985735630, 105226559794107460},
+	{9287774350000662288, 9385340796226221421, 12203726752482169538, 131533199742634325},
+	{8110701977964107882, 10477524016068776292, 9933172229515049913, 82208249839146453},
+	{10138377472455134852, 17708591038513358269, 17028151305321200295, 102760312298933066},
+	{17284657858996306469, 17524052779714309932, 12061817094796724561, 128450390373666333},
+	{1579539125017915735, 4035003959680361852, 9844478693461646803, 80281493983541458},
+	{1974423906272394669, 432068931173064411, 3082226329972282696, 100351867479426823},
+	{16303087938122657049, 540086163966330513, 17687840967747517082, 125439834349283528},
+	{3271900933685578799, 4949239870906344475, 11054900604842198176, 78399896468302205},
+	{17924934222389137211, 6186549838632930593, 18430311774480135624, 97999870585377756},
+	{8571109722704257802, 7733187298291163242, 4591145644390617914, 122499838231722196},
+	{9968629595117549030, 9444928079859364930, 12092838064598912004, 76562398894826372},
+	{3237414957042160480, 11806160099824206163, 15116047580748640005, 95702998618532965},
+	{17881826751584864312, 922642069498093991, 5060001420653636295, 119628748273166207},
+	{18093670747381622051, 7494180321077390600, 10080029915549604540, 74767967670728879},
+	{4170344360517475947, 9367725401346738251, 7988351376009617771, 93459959588411099},
+	{601244432219457030, 7097970733256034910, 5373753201584634310, 116824949485513874},
+	{14210835825419324356, 18271289763567185530, 7970281769417784347, 73015593428446171},
+	{8540172744919379637, 18227426186031594009, 5351166193344842530, 91269491785557714},
+	{15286901949576612450, 13560910695684716703, 15912329778535828971, 114086864731947142},
+	{14496941418543377659, 12339452351178507975, 10667040186315010406, 142608580914933928},
+	{15978117414230692893, 3100471701059179580, 6666900116446881504, 89130363071833705},
+	{1525902694078814500, 3875589626323974476, 12945311163985989784, 111412953839792131},
+	{1907378367598518125, 4844487032904968095, 11569952936555099326, 139266192299740164},
+	{8109640507390155684, 16862862450847768771, 16454592622201712886, 87041370187337602},
+	{5525364615810306701, 11855206026704935156, 11344868740897365300, 108801712734172003},
+	{6906705769762883377, 14819007533381168945, 9569399907694318721, 136002140917715004},
+	{15845906152170271870, 2344350680722148734, 15204246979163725009, 85001338073571877},
+	{10584010653358064030, 7542124369330073822, 5170250668672492549, 106251672591964847},
+	{4006641279842804229, 14039341480089980182, 1851127317413227782, 132814590739956059},
+	{16339208855183916355, 4162902406628849709, 17297855637879125028, 83009119212472536},
+	{6588953013697731732, 5203628008286062137, 3175575473639354669, 103761399015590671},
+	{12847877285549552569, 11116221028784965575, 17804527397331357048, 129701748769488338},
+	{14947452331109552212, 6947638142990603484, 15739515641759486059, 81063592980930211},
+	{237571340177388649, 4072861660310866452, 15062708533771969670, 101329491226162764},
+	{296964175221735811, 14314449112243358873, 381641593505410471, 126661864032703456},
+	{11714817655582054642, 15864059722793181151, 238525995940881544, 79163665020439660},
+	{10031836051050180398, 1383330579781924823, 298157494926101931, 98954581275549575},
+	{7928109045385337594, 15564221280009569741, 14207754923939791125, 123693226594436968},
+	{7260911162579529948, 12033481309219675040, 8879846827462369453, 77308266621523105},
+	{9076138953224412435, 1206793581242430088, 15711494552755349721, 96635333276903881},
+	{11345173691530515544, 6120177994980425514, 5804310135662023439, 120794166596129852},
+	{11702419575633960119, 10742640274503847802, 12851065871643540457, 75496354122581157},
+	{5404652432687674341, 18039986361557197657, 2228774284272261859, 94370442653226447},
+	{11367501559286980830, 17938296933519109167, 16621025910622491036, 117963053316533058},
+	{14022217502195444874, 1988063546594667421, 14999827212566444802, 73726908322833161},
+	{3692713822462142381, 11708451470098110085, 4914725960425892290, 92158635403541452},
+	{9227578296505065881, 5412192300767861798, 6143407450532365363, 115198294254426815},
+	{2311100833776556543, 2153554357532439344, 3067573294738068800, 143997867818033519},
+	{1444438021110347839, 1345971473457774590, 8834762336852374856, 89998667386270949},
+	{11028919563242710607, 1682464341822218237, 15655138939492856474, 112498334232838686},
+	{18397835472480776163, 11326452464132548604, 10345551637511294784, 140622917791048358},
+	{2275275133445709294, 7079032790082842878, 1854283755017171336, 87889323619405224},
+	{12067465953661912425, 8848790987603553597, 2317854693771464170, 109861654524256530},
+	{1249274386795226820, 1837616697649666189, 12120690404069106021, 137327068155320662},
+	{3086639500960710714, 3454353445244735320, 2963745484115803359, 85829417597075414},
+	{3858299376200888393, 18152999861838082862, 12928053891999530006, 107286771996344267},
+	{14046246257105886299, 13467877790442827769, 11548381346572024604, 134108464995430334},
+	{1861374883050097081, 17640795655881543164, 2606052323180127473, 83817790622143959},
+	{2326718603812621351, 8215936514569765243, 17092623459257323054, 104772238277679948},
+	{16743456310047940401, 1046548606357430745, 2919035250362102202, 130965297847099936},
+	{3547131166138880894, 5265778897400782120, 1824397031476313876, 81853311154437460},
+	{4433913957673601118, 6582223621750977650, 2280496289345392345, 102316638943046825},
+	{14765764483946777206, 12839465545616109966, 7462306380109128335, 127895798678808531},
+	{4616916784039347849, 14942194993651150585, 2358098478354511257, 79934874174255332},
+	{10382831998476572716, 4842685686781774519, 2947623097943139072, 99918592717819165},
+	{8366853979668327991, 6053357108477218149, 8296214890856311744, 124898240897273956},
+	{7535126746506398946, 3783348192798261343, 14408506343639970648, 78061400560796222},
+	{4807222414705610779, 4729185240997826679, 8787260892695187502, 97576750700995278},
+	{1397341999954625570, 15134853588102059157, 1760704079014208569, 121970938376244098},
+	{3179181759185334933, 2541754464922705117, 5712126067811268260, 76231836485152561},
+	{8585663217409056570, 3177193081153381396, 11751843603191473229, 95289795606440701},
+	{10732079021761320713, 8583177369869114649, 854746448707177824, 119112244508050877},
+	{18236764434669295205, 5364485856168196655, 2840059539655680092, 74445152817531798},
+	{18184269524909231103, 6705607320210245819, 12773446461424375923, 93056441021914747},
+	{18118650887709150975, 3770323131835419370, 11355122058353082000, 116320551277393434},
+	{15935842823245607263, 2356451957397137106, 11708637304898064154, 72700344548370896},
+	{10696431492202233271, 12168936983601197191, 14635796631122580192, 90875430685463620},
+	{8758853346825403685, 15211171229501496489, 18294745788903225240, 113594288356829525},
+	{15560252701959142510, 567219963167318995, 9033374180846867839, 141992860446036907},
+	{7419314929510770117, 7272041504620656228, 3340015853815598447, 88745537778773067},
+	{9274143661888462646, 4478365862348432381, 18010077872551661771, 110931922223466333},
+	{16204365595787966211, 986271309508152572, 8677539285407413502, 138664902779332917},
+	{904356460512703074, 14451477623724759070, 7729305062593327390, 86665564237083073},
+	{10353817612495654651, 8840974992801173029, 14273317346669047142, 108331955296353841},
+	{17553958034046956217, 1827846704146690478, 4006588628054145216, 135414944120442302},
+	{6359537752851959732, 1142404190091681549, 16339175947816004472, 84634340075276438},
+	{12561108209492337569, 1428005237614601936, 11200597897915229782, 105792925094095548},
+	{15701385261865421961, 11008378583873028228, 14000747372394037227, 132241156367619435},
+	{589993751811112917, 4574393605706948691, 6444624098532579315, 82650722729762147},
+	{14572550245046054859, 1106305988706297959, 3444094104738336240, 103313403412202684},
+	{13604001787880180670, 1382882485882872449, 4305117630922920300, 129141754265253355},
+	{1584972089784031062, 10087673590531571089, 384855510113131235, 80713596415783347},
+	{6592901130657426732, 7997905969737075957, 14316127442923577756, 100891995519729183},
+	{12852812431749171319, 9997382462171344946, 13283473285227084291, 126114994399661479},
+	{12644693788270619978, 3942521029643396639, 15219699830908009538, 78821871499788424},
+	{11194181216910887069, 14151523323909021607, 577880714925460306, 98527339374735531},
+	{9381040502711220932, 8466032118031501201, 14557408948938989095, 123159174218419413},
+	{17392365360262982843, 12208799101410770106, 11404223602300562136, 76974483886512133},
+	{12517084663473952745, 15260998876763462633, 420221447593538958, 96218104858140167},
+	{1811297774060277220, 9852876559099552484, 14360334864774087410, 120272631072675208},
+	{10355433145642449070, 10769733867864608206, 8975209290483804631, 75170394420422005},
+	{3720919395198285530, 8850481316403372354, 15830697631532143693, 93962993025527506},
+	{13874521280852632720, 15674787663931603346, 10565000002560403808, 117453741281909383},
+	{13283261818960283354, 9796742289957252091, 13520654029241334236, 73408588301193364},
+	{11992391255272966289, 12245927862446565114, 16900817536551667795, 91760735376491705},
+	{5767117032236432053, 10695723809630818489, 7290963865407421032, 114700919220614632},
+	{11820582308722927970, 13369654762038523111, 9113704831759276290, 143376149025768290},
+	{14305392970592911837, 12967720244701464848, 10307751538276935585, 89610093141105181},
+	{17881741213241139797, 2374592250594667348, 17496375441273557386, 112012616426381476},
+	{3905432442841873130, 12191612350098109994, 3423725227882395116, 140015770532976846},
+	{7052581295203558610, 16843129755666094554, 15974886322708660659, 87509856583110528},
+	{18039098655859224071, 16442226176155230288, 1521863829676274208, 109387320728888161},
+	{4102129246114478473, 2106038646484486245, 6514015805522730665, 136734150911110201},
+	{4869673788035242997, 12845489200121273663, 15600474924520176425, 85458844319443875},
+	{1475406216616665843, 2221803444869428367, 14888907637222832628, 106823555399304844},
+	{15679315826052996015, 2777254306086785458, 164390472818989169, 133529444249131056},
+	{14411258409710510413, 13264998987372710671, 102744045511868230, 83455902655706910},
+	{13402386993710750113, 7357876697361112531, 9351802093744611096, 104319878319633637},
+	{12141297723711049737, 9197345871701390664, 16301438635608151774, 130399847899542046},
+	{7588311077319406086, 1136655151385981261, 5576713128827706955, 81499904937213779},
+	{14097074865076645511, 15255876994514640288, 2359205392607245789, 101874881171517224},
+	{17621343581345806889, 5234788187861136648, 2949006740759057237, 127343601464396530},
+	{11013339738341129305, 5577585626626904357, 6454815231401798677, 79589750915247831},
+	{18378360691353799536, 11583668051711018350, 3456833020824860442, 99487188644059789},
+	{13749578827337473612, 5256213027783997130, 8932727294458463457, 124358985805074736},
+	{13205172785513308911, 14814348188433467966, 5582954559036539660, 77724366128171710},
+	{7283093945036860331, 71191161832283342, 16202065235650450384, 97155457660214637},
+	{18327239468150851222, 88988952290354177, 6417523489280899268, 121444322075268297},
+	{4536995639953200158, 9278990132036247169, 15540167226869031802, 75902701297042685},
+	{10282930568368888101, 2375365628190533153, 5590150978304126041, 94878376621303357},
+	{17465349228888498031, 7580893053665554345, 11599374741307545455, 118597970776629196},
+	{3998314240414229413, 11655587186182053322, 16472981250171991717, 74123731735393247},
+	{14221264837372562574, 734425927445402940, 15979540544287601743, 92654664669241559},
+	{17776581046715703218, 14753090464588917387, 15362739661932114274, 115818330836551949},
+	{8804520144983620559, 13832367558795461271, 11907555297921265373, 72386456772844968},
+	{6393964162802137795, 3455401393212162877, 14884444122401581717, 90483070966056210},
+	{12604141221930060148, 8930937759942591500, 9382183116147201338, 113103838707570263},
+	{15755176527412575185, 1940300163073463567, 7116042876756613769, 141379798384462829},
+	{16764514357273941346, 12741902647989384489, 6753369807186577557, 88362373990289268},
+	{7120584891310262971, 2092320254704566900, 8441712258983221947, 110452967487861585},
+	{8900731114137828714, 16450458373662872337, 15163826342156415337, 138066209359826981},
+	{17092171992404612706, 3364007455898213354, 11783234473061453538, 86291380849891863},
+	{12141842953650990075, 13428381356727542501, 10117357072899429018, 107864226062364829},
+	{1342245636781573882, 7562104659054652319, 17258382359551674177, 134830282577956036},
+	{7756432550629565532, 16255530457977627459, 1563116937865020552, 84268926611222523},
+	{5083854669859569011, 1872668998762482708, 15788954227613439403, 105336158264028153},
+	{6354818337324461264, 16175894303735267097, 5901134729234635541, 131670197830035192},
+	{15500976506896258050, 12415776949048235887, 3688209205771647213, 82293873643771995},
+	{14764534615192934658, 1684663131028131147, 18445319562496722729, 102867342054714993},
+	{13843982250563780419, 6717514932212551838, 9221591397838739699, 128584177568393742},
+	{4040802888174974858, 1892603823419150947, 1151808605221824408, 80365110980246089},
+	{439317591791330668, 2365754779273938684, 6051446774954668414, 100456388725307611},
+	{549146989739163335, 12180565510947199163, 2952622450265947613, 125570485906634514},
+	{16484117933082834748, 9918696453555693428, 6457075049843605162, 78481553691646571},
+	{2158403342643991820, 3174998530089840978, 3459657793877118549, 98101942114558214},
+	{11921376215159765583, 8580434181039689126, 13547944279201173994, 122627427643197767},
+	{2839174116047465585, 9974457381577193608, 15384994202141815602, 76642142276998604},
+	{3548967645059331981, 3244699690116716202, 784498678967717887, 95802677846248256},
+	{13659581593178940785, 17890932667928058964, 980623348709647358, 119753347307810320},
+	{17760610532591613798, 6570146899027648948, 612889592943529599, 74845842067381450},
+	{3754019092029965632, 3600997605357173282, 9989484028034187807, 93557302584226812},
+	{13915895901892232848, 18336305061978630314, 12486855035042734758, 116946628230283515},
+	{13309120957110033434, 6848504645309256042, 5498441387688015272, 73091642643927197},
+	{7413029159532765985, 8560630806636570053, 11484737753037406994, 91364553304908996},
+	{13877972467843345385, 1477416471440936758, 14355922191296758743, 114205691631136245},
+	{8124093547949405923, 15681828644583334660, 4109844683838784716, 142757114538920307},
+	{14300930504323154510, 577770866009808354, 262809918185546496, 89223196586825192},
+	{8652791093549167329, 722213582512260443, 328512397731933120, 111528995733531490},
+	{6204302848509071258, 902766978140325554, 9634012534019692208, 139411244666914362},
+	{8489375298745557440, 564229361337703471, 10632943852189695534, 87132027916821476},
+	{6000033105004558896, 9928658738526905147, 13291179815237119417, 108915034896026845},
+	{2888355362828310716, 17022509441586019338, 2778916713764235559, 136143793620033557},
+	{6416908120195082101, 17556597428632343942, 4042665955316341176, 85089871012520973},
+	{17244507187098628435, 3499002712080878311, 9665018462572814375, 106362338765651216},
+	{16943947965445897640, 18208811445383261601, 12081273078216017968, 132952923457064020},
+	{3672438450762604169, 11380507153364538501, 16774167710739787038, 83095577160665012},
+	{9202234081880643115, 5002261904850897318, 2520965564715182182, 103869471450831266},
+	{2279420565496028086, 15476199417918397456, 12374578992748753535, 129836839313539082},
+	{1424637853435017553, 16590153663840080266, 12345797888895358863, 81148024570961926},
+	{11004169353648547750, 16126006061372712428, 6208875324264422771, 101435030713702408},
+	{13755211692060684687, 15545821558288502631, 7761094155330528464, 126793788392128010},
+	{15514536335179009785, 9716138473930314144, 9462369865508968194, 79246117745080006},
+	{946426345264210616, 2921801055558116873, 2604590295031434435, 99057647181350008},
+	{5794718950007651174, 17487309374729809803, 3255737868789293043, 123822058976687510},
+	{1315856334541088032, 8623725349992437175, 15869894223275471864, 77388786860429693},
+	{15479878473458523752, 10779656687490546468, 6002309723812176118, 96735983575537117},
+	{903104018113603074, 4251198822508407278, 12114573173192608052, 120919979469421396},
+	{14399498066603165633, 11880371300922530356, 16794980270100155840, 75574987168388372},
+	{17999372583253957041, 14850464126153162945, 2546981263915643184, 94468733960485466},
+	{8664157673785282590, 116336083981902066, 12407098616749329789, 118085917450606832},
+	{10026784564543189522, 2378553061702382743, 7754436635468331118, 73803698406629270},
+	{7921794687251598999, 12196563363982754237, 469673757480638089, 92254623008286588},
+	{14513929377491886653, 1410646149696279084, 587092196850797612, 115318278760358235},
+	{18294577897787204966, 10105025880414950235, 16507833687527606171, 72073924225223896},
+	{18256536353806618304, 8019596332091299890, 2188048035699956098, 90092405281529871},
+	{13597298405403497072, 801123378259349055, 16570118099907108835, 112615506601912338},
+	{12384936988326983436, 14836462278106350031, 11489275588029110235, 140769383252390423},
+	{14658114645345446503, 6966945914602774817, 14098326270159275753, 87980864532744014},
+	{4487585251399644417, 13320368411680856426, 8399535800844318883, 109976080665930018},
+	{14832853601104331329, 12038774496173682628, 1276047714200622796, 137470100832412523},
+	{47161463835431273, 16747606096963327451, 16938430885871246911, 85918813020257826},
+	{13894009885076452803, 16322821602776771409, 11949666570484282831, 107398516275322283},
+	{3532454301063402292, 15791840985043576358, 10325397194677965635, 134248145344152854},
+	{16042841993446790144, 7564057606438541271, 1841687228246340618, 83905090840095534},
+	{15441866473381099776, 231699971193400781, 11525481072162701581, 104881363550119417},
+	{5467275036444211008, 4901310982419138881, 571793284921213264, 131101704437649272},
+	{14946261943846101640, 3063319364011961800, 357370803075758290, 81938565273530795},
+	{236083356098075434, 13052521241869728059, 14281771559126861574, 102423206591913493},
+	{14130162250404758005, 7092279515482384265, 4017156393626413256, 128029008239891867},
+	{1913822378861891897, 4432674697176490166, 204879736802814333, 80018130149932417},
+	{11615650010432140679, 10152529389898000611, 4867785689430905820, 100022662687415521},
+	{9907876494612787945, 12690661737372500764, 10696418130216020179, 125028328359269401},
+	{15415794845987768274, 5625820576644119025, 18214476377453482372, 78142705224543375},
+	{5434685502202546630, 7032275720805148782, 18156409453389465061, 97678381530679219},
+	{16016728914607959096, 13402030669433823881, 18083825798309443422, 122097976913349024},
+	{3092926543988892579, 3764583149968752022, 11302391123943402139, 76311235570843140},
+	{13089530216840891531, 94042919033552123, 14127988904929252674, 95389044463553925},
+	{11750226752623726510, 9340925685646715962, 3824928075879402130, 119236305579442407},
+	{11955577738817216973, 10449764571956585380, 9308109075065708187, 74522690987151504},
+	{14944472173521521216, 8450519696518343821, 11635136343832135234, 93153363733939380},
+	{4845532161619737808, 1339777583793153969, 14543920429790169043, 116441704667424225},
+	{14557672647080805890, 16978262054366578894, 2172421240977773795, 72776065417140141},
+	{8973718771996231555, 16611141549530835714, 7327212569649605148, 90970081771425176},
+	{1993776428140513635, 2317182863203993027, 9159015712062006436, 113712602214281470},
+	{16327278590457805756, 2896478579004991283, 2225397603222732237, 142140752767851838},
+	{7898706109822434646, 4116142121091813504, 15225931557296371360, 88837970479907398},
+	{9873382637278043307, 5145177651364766880, 9809042409765688392, 111047463099884248},
+	{12341728296597554134, 6431472064205958600, 12261303012207110490, 138809328874855310},
+	{7713580185373471334, 8631356058556112029, 3051628364202056152, 86755830546784569},
+	{14253661250144227071, 10789195073195140036, 8426221473679958094, 108444788183480711},
+	{17817076562680283839, 4263121804639149237, 5921090823672559714, 135555985229350889},
+	{13441515860888871351, 7276137146326856177, 15229896810863819581, 84722490768344305},
+	{2966836770828925477, 13706857451335958126, 5202312958297610764, 105903113460430382},
+	{12931918000390932654, 17133571814169947657, 15726263234726789263, 132378891825537977},
+	{1164919722603251053, 17626011411497299142, 2911385494063161433, 82736807390961236},
+	{10679521690108839624, 8197456209089460215, 3639231867578951792, 103421009238701545},
+	{8737716094208661626, 10246820261361825269, 9160725852901077644, 129276261548376931},
+	{7766915568094107468, 15627634700205916601, 3419610648849479575, 80797663467735582},
+	{14320330478545022239, 14922857356830007847, 13497885347916625277, 100997079334669477},
+	{13288727079753889895, 4818513640755346097, 3037298629613617885, 126246349168336847},
+	{1387925397205099328, 5317414034685785263, 8815840671149593034, 78903968230210529},
+	{15569964801788537873, 15870139580212007386, 15631486857364379196, 98629960287763161},
+	{10239083965380896533, 1390930401555457617, 5704300516423310284, 123287450359703952},
+	{17928642524431530093, 10092703537826936818, 3565187822764568927, 77054656474814970},
+	{13187431118684636808, 8004193403856283119, 13679856815310486967, 96318320593518712},
+	{11872602879928408107, 5393555736392965995, 17099821019138108709, 120397900741898390},
+	{5114533790741561114, 5676815344459297699, 6075702118533930039, 75248687963686494},
+	{1781481219999563489, 2484333162146734220, 16817999685022188357, 94060859954608117},
+	{2226851524999454362, 7717102471110805679, 7187441550995571734, 117576074943260147},
+	{8309311230765740832, 211503026016865645, 2186307960158538382, 73485046839537592},
+	{14998325056884563944, 9487750819375857864, 2732884950198172977, 91856308549421990},
+	{301162247396153314, 16471374542647210235, 12639478224602492029, 114820385686777487},
+	{14211510864527355355, 6754160123026849081, 11187661762325727133, 143525482108471859},
+	{1964665262688515240, 6527193086105474628, 4686445592239885506, 89703426317794912},
+	{2455831578360644051, 17382363394486619093, 5858056990299856882, 112129282897243640},
+	{7681475491378192967, 12504582206253498058, 7322571237874821103, 140161603621554550},
+	{9412608200538758508, 14732892906549518142, 18411665078953926901, 87601002263471593},
+	{2542388213818672328, 4581058077904733966, 9179523293410244915, 109501252829339492},
+	{12401357304128116218, 1114636578953529553, 11474404116762806144, 136876566036674365},
+	{833319287438990780, 696647861845955971, 9477345582190447792, 85547853772921478},
+	{14876707164580902187, 870809827307444963, 2623309940883283932, 106934817216151848},
+	{13984197937298739830, 1088512284134306204, 3279137426104104915, 133668521520189810},
+	{17963495747666488201, 16821221242079799041, 6661146909742453475, 83542825950118631},
+	{8619311629300946540, 16414840534172360898, 3714747618750678940, 104428532437648289},
+	{1550767499771407367, 2071806594005899507, 9255120541865736580, 130535665547060361},
+	{17110130751852987268, 10518251158108462999, 17313665384734555122, 81584790966912725},
+	{16775977421388846181, 3924441910780802941, 7807023675636030191, 101980988708640907},
+	{7134913721453894015, 293866370048615773, 5147093576117649835, 127476235885801134},
+	{6765164085122377711, 16324567545776242522, 17051991540355694858, 79672647428625708},
+	{17679827143257747947, 11182337395365527344, 2868245351735066957, 99590809285782136},
+	{3653039855362633318, 142863688924745469, 3585306689668833697, 124488511607227670},
+	{4588992918815339775, 11618504851646435678, 16075874736325184772, 77805319754517293},
+	{14959613185373950527, 14523131064558044597, 6259785365124317253, 97256649693146617},
+	{4864458426435274447, 4318855775415392035, 12436417724832784471, 121570812116433271},
+	{734443507308352577, 9616813887275701878, 14690290105661572150, 75981757572770794},
+	{10141426420990216530, 2797645322239851539, 9139490595222189380, 94977196965963493},
+	{8065097007810382759, 3497056652799814424, 16036049262455124629, 118721496207454366},
+	{5040685629881489224, 4491503417213577967, 5410844770607064989, 74200935129658979},
+	{1689171018924473626, 10226065289944360363, 2151869944831443332, 92751168912073724},
+	{15946521828937755745, 12782581612430450453, 2689837431039304165, 115938961140092155},
+	{12272419152299791292, 10294956516982725485, 17822049458895422767, 72461850712557596},
+	{1505465885092575403, 8257009627801018953, 3830817749909726843, 90577313390696996},
+	{6493518374793107158, 5709576016323885787, 4788522187387158554, 113221641738371245},
+	{3505211950063996044, 16360342057259633042, 10597338752661336096, 141527052172964056},
+	{6802443487217385431, 10225213785787270651, 6623336720413335060, 88454407608102535},
+	{3891368340594343885, 12781517232234088314, 3667484882089280921, 110568009510128169},
+	{14087582462597705665, 2141838485010446680, 9196042121038989056, 138210011887660211},
+	{8804739039123566040, 1338649053131529175, 3441683316435674208, 86381257429787632},
+	{6394237780477069647, 1673311316414411469, 4302104145544592760, 107976571787234540},
+	{12604483244023724962, 2091639145518014336, 5377630181930740950, 134970714734043175},
+	{7877802027514828101, 15142332521230922672, 10278547891347794949, 84356696708776984},
+	{9847252534393535127, 5092857596256489628, 12848184864184743687, 105445870885971230},
+	{12309065667991918909, 1754385976893224131, 6836859043376153801, 131807338607464038},
+	{5387323033281255366, 12625706281626734842, 18108094957392259837, 82379586629665023},
+	{15957525828456345015, 1947074796751254840, 18023432678312936893, 102974483287081279},
+	{1500163211860879653, 7045529514366456455, 17917604829463783212, 128718104108851599},
+	{7855131035054131639, 13626827983333811092, 18116032046055946363, 80448815068032249},
+	{9818913793817664549, 12421848960739875961, 8809982002287769242, 100561018835040312},
+	{16885328260699468590, 6303939164070069143, 11012477502859711553, 125701273543800390},
+	{17470859190578249725, 15469177023612262974, 2271112420859931816, 78563295964875244},
+	{12615201951368036348, 889727205805777102, 2838890526074914771, 98204119956094055},
+	{6545630402355269627, 14947217062539385090, 17383671212875807175, 122755149945117568},
+	{8702705019899431421, 16259539691728197537, 10864794508047379484, 76721968715698480},
+	{15490067293301677180, 1877680540950695305, 13580993135059224356, 95902460894623100},
+	{5527526061344932763, 2347100676188369132, 16976241418824030445, 119878076118278875},
+	{12678075825195358785, 3772780931831424659, 8304307877551325076, 74923797573924297},
+	{11235908763066810577, 4715976164789280824, 14992070865366544249, 93654746967405371},
+	{14044885953833513222, 10506656224413988934, 14128402563280792407, 117068433709256714},
+	{4166367702718557859, 13484189167899824940, 13441937620477883158, 73167771068285446},
+	{5207959628398197324, 7631864423020005367, 7579049988742578140, 91459713835356808},
+	{1898263517070358751, 9539830528775006709, 9473812485928222675, 114324642294196010},
+	{6984515414765336343, 7313102142541370482, 2618893570555502536, 142905802867745013},
+	{8977008152655723118, 4570688839088356551, 3942651490810883037, 89316126792340633},
+	{6609574172392265994, 10325047067287833593, 9540000381940991700, 111645158490425791},
+	{12873653733917720397, 12906308834109791991, 7313314458998851721, 139556448113032239},
+	{14963562611339657104, 1148913993677538138, 11488350564515364182, 87222780070645149},
+	{9481081227319795572, 10659514528951698481, 525380150362041515, 109028475088306437},
+	{16463037552577132369, 8712707142762235197, 5268411206379939798, 136285593860383046},
+	{12595241479574401682, 833755945799009094, 17127815059269626086, 85178496162739403},
+	{6520679812613226295, 10265566969103537176, 16798082805659644703, 106473120203424254},
+	{8150849765766532869, 8220272692952033566, 11774231470219780071, 133091400254280318},
+	{482595085176695139, 12055199460736102835, 2747208650459974640, 83182125158925199},
+	{14438301911753032636, 15068999325920128543, 17269068868357132012, 103977656448656498},
+	{13436191371263902891, 389505083690609063, 12362964048591639208, 129972070560820623},
+	{15315148634681021163, 243440677306630664, 14644381558010856361, 81232544100512889},
+	{697191719641724837, 4915986865060676235, 4470418892231406739, 101540680125641112},
+	{14706547704834319759, 1533297562898457389, 5588023615289258424, 126925850157051390},
+	{11497435324735143801, 958310976811535868, 17327572814837950227, 79328656348157118},
+	{14371794155918929752, 15032946776296583547, 12436093981692661975, 99160820435196398},
+	{13353056676471274286, 14179497451943341530, 6321745440261051661, 123951025543995498},
+	{12957346441221934332, 11168028916678282408, 8562776918590545192, 77469390964997186},
+	{16196683051527417916, 13960036145847853010, 1480099111383405682, 96836738706246483},
+	{11022481777554496587, 8226673145455040455, 15685181944511420815, 121045923382808103},
+	{13806580138612642222, 12059199743550482140, 16720767742960719865, 75653702114255064},
+	{17258225173265802778, 1238941624155938963, 2454215604991348216, 94567127642818831},
+	{16961095448154865569, 1548677030194923704, 16902827561521348982, 118208909553523538},
+	{10600684655096790980, 14802981199153991027, 15175953244378231017, 73880568470952211},
+	{8639169800443600821, 4668668443660325072, 14358255537045400868, 92350710588690264},
+	{10798962250554501027, 5835835554575406340, 17947819421306751085, 115438388235862830},
+	{15972723443451338950, 5953240230823322914, 6605701119889331524, 72148992647414269},
+	{10742532267459397879, 7441550288529153643, 12868812418289052309, 90186240809267836},
+	{8816479315896859445, 13913623879088829958, 16086015522861315386, 112732801011584795},
+	{1797227108016298499, 8168657812006261640, 15495833385149256329, 140916001264480994},
+	{1123266942510186561, 16634626178572383285, 14296581884145673109, 88072500790300621},
+	{6015769696565121106, 6958224667933315394, 4035669299899927675, 110090625987875777},
+	{16743084157561177190, 4086094816489256338, 9656272643302297498, 137613282484844721},
+	{15076113616903123648, 7165495278733173115, 17564385448132405696, 86008301553027950},
+	{14233456002701516656, 8956869098416466394, 12732109773310731312, 107510376941284938},
+	{8568447966522120012, 11196086373020582993, 6691765179783638332, 134387971176606173},
+	{16884495025144794767, 16220926019992640178, 6488196246578467909, 83992481985378858},
+	{11882246744576217651, 6441099469708636511, 17333617345077860695, 104990602481723572},
+	{10241122412292884160, 3439688318708407735, 3220277607637774253, 131238253102154466},
+	{13318230535324134456, 4455648208406448786, 6624359523200996812, 82023908188846541},
+	{7424416132300392262, 5569560260508060983, 12892135422428633919, 102529885236058176},
+	{4668834146948102424, 2350264307207688325, 16115169278035792399, 128162356545072720},
+	{5223864351056257967, 8386444219645887059, 10071980798772370249, 80101472840670450},
+	{1918144420392934554, 15094741292984746728, 3366603961610687003, 100126841050838063},
+	{2397680525491168193, 14256740597803545506, 18043313007295522466, 125158551313547578},
+	{6110236346859368025, 13522148892054603845, 15888756647987089445, 78224094570967236},
+	{12249481452001597935, 3067628059786091094, 1414201736274310191, 97780118213709046},
+	{6088479778147221611, 17669593130014777580, 10991124207197663546, 122225147767136307},
+	{13028671898196789314, 15655181724686623891, 4563609620284845764, 76390717354460192},
+	{11674153854318598739, 1122233082148728248, 5704512025356057206, 95488396693075240},
+	{14592692317898248424, 10626163389540686118, 7130640031695071507, 119360495866344050},
+	{4508746680259017361, 4335509109249234872, 9068336038236807596, 74600309916465031},
+	{5635933350323771701, 5419386386561543590, 6723734029368621591, 93250387395581289},
+	{16268288724759490435, 2162546964774541583, 13016353555138164893, 116562984244476611},
+	{17085209480615763377, 3657434862197782441, 5829377962747659106, 72851865152797882},
+	{7521453795487540510, 13795165614602003860, 16510094490289349690, 91064831440997352},
+	{9401817244359425638, 8020584981397729017, 2190874039152135497, 113831039301246691},
+	{16363957573876669951, 14637417245174549175, 16573650604222333083, 142288799126558363},
+	{17145002511314000575, 6842542769020399282, 8052688618425264225, 88930499454098977},
+	{12207881102287724911, 13164864479702887007, 14677546791458968185, 111163124317623721},
+	{10648165359432268235, 2621022544346445047, 4511875434041546520, 138953905397029652},
+	{13572632377286249503, 1638139090216528154, 12043294183130742383, 86846190873143532},
+	{7742418434753036071, 15882731918052823905, 15054117728913427978, 108557738591429415},
+	{14289709061868682992, 10630042860711254073, 14205961142714397069, 135697173239286769},
+	{2013539136026845014, 8949619797158227748, 1961196686555416312, 84810733274554231},
+	{2516923920033556268, 11187024746447784685, 16286553913476434102, 106013416593192788},
+	{7757840918469333239, 4760408896204955048, 1911448318135991012, 132516770741490986},
+	{4848650574043333274, 12198627596982872713, 5806341217262382286, 82822981713431866},
+	{10672499235981554497, 6024912459373815083, 16481298558432753666, 103528727141789832},
+	{8728938026549555217, 16754512611072044662, 2154879124331390466, 129410908927237291},
+	{843900248166084106, 15083256400347415818, 17487700517202976705, 80881818079523306},
+	{10278247347062380941, 5019012445152106060, 12636253609648945074, 101102272599404133},
+	{12847809183827976177, 15497137593294908383, 1960258956779017630, 126377840749255167},
+	{14947409767533566966, 5074024977381929835, 8142690875627967875, 78986150468284479},
+	{14072576190989570804, 1730845203300024390, 5566677576107571940, 98732688085355599},
+	{8367348201882187697, 2163556504125030488, 2346660951707077021, 123415860106694499},
+	{5229592626176367310, 3658065824291838007, 17607564159312780802, 77134912566684061},
+	{1925304764293071234, 13795954317219573317, 8174397143858812290, 96418640708355077},
+	{7018316973793726947, 8021570859669690838, 14829682448250903267, 120523300885443846},
+	{18221506163903243054, 2707638778079862821, 4656865511729426638, 75327063053402404},
+	{8941824649596890105, 12607920509454604335, 5821081889661783297, 94158828816753005},
+	{6565594793568724727, 1924842581536091707, 11888038380504617026, 117698536020941256},
+	{1797653736766759002, 5814712631887445221, 7430023987815385641, 73561585013088285},
+	{6858753189385836657, 11880076808286694430, 13899216003196619955, 91951981266360356},
+	{17796813523587071630, 10238409991930980133, 17374020003995774944, 114939976582950445},
+	{8410958849201675825, 12798012489913725167, 7882466949712554968, 143674970728688057},
+	{12174378308392129247, 7998757806196078229, 16455756889638816615, 89796856705430035},
+	{1382914830207997846, 5386761239317709883, 15958010093621132865, 112246070881787544},
+	{15563701593042161020, 11345137567574525257, 1500768543316864465, 140307588602234431},
+	{2809784468010268781, 173181952092996430, 7855509367214122147, 87692242876396519},
+	{12735602621867611785, 14051535495398409249, 5207700690590264779, 109615303595495649},
+	{2084445222052351019, 12952733350820623658, 11121311881665218878, 137019129494369561},
+	{5914464282210107291, 3483772325835501882, 33290898399679943, 85636955933980976},
+	{16616452389617409922, 18189773462576541064, 41613622999599928, 107046194917476220},
+	{2323821413312210786, 4290472754511124715, 52017028749499911, 133807743646845275},
+	{17593289447815989405, 9599074499210534802, 16173411707464295108, 83629839779278296},
+	{12768239772915210948, 11998843124013168503, 1770020560620817269, 104537299724097871},
+	{11348613697716625782, 1163495849734296917, 16047583756058185299, 130671624655122338},
+	{9398726570286585065, 16868085970579793237, 14641425865963753715, 81669765409451461},
+	{16360094231285619236, 16473421444797353642, 4466724277172528432, 102087206761814327},
+	{11226745752252248237, 2145032732287140437, 971719328038272637, 127609008452267909},
+	{9322559104371349100, 3646488466893156725, 2913167589237614350, 79755630282667443},
+	{16264884898891574279, 13781482620471221714, 17476517541829181649, 99694537853334303},
+	{11107734086759692041, 3391795220306863431, 17233960908859089158, 124618172316667879},
+	{13859862831865889381, 15954930067973953356, 17688754595678012579, 77886357697917424},
+	{17324828539832361727, 15331976566540053791, 3664199170887964108, 97357947122396781},
+	{17044349656363064255, 718226634465515623, 9191934982037343040, 121697433902995976},
+	{17570247562867997015, 448891646540947264, 5744959363773339400, 76060896189372485},
+	{3516065379875444653, 561114558176184081, 11792885223144062154, 95076120236715606},
+	{9006767743271693720, 9924765234575005909, 5517734492075301884, 118845150295894508},
+	{7935072848758502527, 15426350308464154501, 12671956094401839485, 74278218934934067},
+	{14530527079375516063, 5447879830298029414, 11228259099574911453, 92847773668667584},
+	{8939786812364619271, 11421535806299924672, 14035323874468639316, 116059717085834480},
+	{5587366757727887044, 16361831915792228728, 8772077421542899572, 72537323178646550},
+	{6984208447159858805, 2005545821030734294, 1741724740073848658, 90671653973308188},
+	{17953632595804599314, 11730304313143193675, 2177155925092310822, 113339567466635235},
+	{17830354726328361239, 5439508354574216286, 16556502961647552240, 141674459333294043},
+	{6532285685527837870, 3399692721608885179, 8041971341816026198, 88546537083308777},
+	{3553671088482409434, 13472987938865882282, 14664150195697420651, 110683171354135971},
+	{13665460897457787601, 12229548905154964948, 13718501726194387910, 138353964192669964},
+	{17764285097765893058, 3031782047294465188, 17797435615726268252, 86471227620418727},
+	{3758612298497814707, 3789727559118081486, 17635108501230447411, 108089034525523409},
+	{13921637409977044192, 125473430470213953, 8208827571255895552, 135111293156904262},
+	{1783494353594570764, 78420894043883721, 518831213607546816, 84444558223065164},
+	{6841053960420601359, 98026117554854651, 648539017009433520, 105555697778831455},
+	{3939631432098363794, 122532646943568314, 14645731826543955612, 131944622223539318},
+	{7073955663488865275, 9299954941194506004, 4541896373162584353, 82465388889712074},
+	{8842444579361081594, 16236629694920520409, 14900742503308006249, 103081736112140092},
+	{15664741742628739897, 6460729063368486799, 179184055425456196, 128852170140175116},
+	{16707992616784044291, 13261327701460080057, 9335362071495685930, 80532606337609447},
+	{7049932715697891652, 7353287589970324264, 7057516570942219509, 100665757922011809},
+	{8812415894622364566, 13803295505890293234, 13433581732105162290, 125832197402514761},
+	{10119445952566365757, 13238745709608821175, 1478459554924644575, 78645123376571726},
+	{8037621422280569293, 11936746118583638565, 11071446480510581527, 98306404220714657},
+	{14658712796278099520, 10309246629802160302, 4250045356063197, 122883005275893322},
+	{4550009479246424296, 8749122152840044141, 4614342296774927402, 76801878297433326},
+	{10299197867485418274, 1713030654195279368, 14991299907823435061, 96002347871791657},
+	{12873997334356772843, 6752974336171487114, 4904066829497130114, 120002934839739572},
+	{12657934352400370930, 8832294978534567350, 12288413805290482129, 75001834274837232},
+	{6599045903645687855, 15652054741595597092, 15360517256613102661, 93752292843546540},
+	{8248807379557109819, 5730010371712332653, 753902497056826711, 117190366054433176},
+	{7461347621436887589, 10498785509961289764, 471189060660516694, 73243978784020735},
+	{9326684526796109486, 3900109850596836397, 14424044381107809580, 91554973480025918},
+	{16270041676922524762, 4875137313246045496, 8806683439529986167, 114443716850032398},
+	{1890808022443604336, 1482235623130168967, 1784982262557706901, 143054646062540498},
+	{8099284041668334566, 3232240273670049556, 5727299932525954717, 89409153789087811},
+	{10124105052085418208, 8651986360514949849, 2547438897230055492, 111761442236359764},
+	{17266817333534160664, 10814982950643687311, 3184298621537569365, 139701802795449705},
+	{17709289861099932271, 9065207353365998521, 13519401684529450613, 87313626747156065},
+	{8301554271092751626, 15943195210134886056, 3064194050379649554, 109142033433945082},
+	{10376942838865939533, 10705621975813831762, 13053614599829337751, 136427541792431352},
+	{11097275292718600112, 13608542762524726707, 8158509124893336094, 85267213620269595},
+	{9259908097470862236, 7787306416301132576, 5586450387689282214, 106584017025336994},
+	{11574885121838577795, 510760983521639912, 16206435021466378576, 133230021281671242},
+	{7234303201149111122, 319225614701024945, 14740707906843874514, 83268763301044526},
+	{13654565019863776806, 9622404055231056989, 9202512846700067334, 104085954126305658},
+	{3233148219547557296, 2804633032184045429, 2279769021520308360, 130107442657882073},
+	{4326560646430917262, 1752895645115028393, 12954070684518662485, 81317151661176295},
+	{10019886826466034482, 6802805574821173395, 11580902337220940202, 101646439576470369},
+	{7913172514655155198, 17726879005381242552, 641069866244011540, 127058049470587962},
+	{4945732821659471999, 1855927341508500787, 5012354684829895117, 79411280919117476},
+	{1570480008646952094, 6931595195313013888, 6265443356037368896, 99264101148896845},
+	{1963100010808690118, 8664493994141267360, 12443490213474099024, 124080126436121056},
+	{1226937506755431324, 5415308746338292100, 7777181383421311890, 77550079022575660},
+	{1533671883444289155, 15992507969777640933, 9721476729276639862, 96937598778219575},
+	{6528775872732749347, 10767262925367275358, 7540159893168411924, 121171998472774469},
+	{17915542975740132054, 15952911365209322906, 7018442942443951404, 75732499045484043},
+	{13171056682820389260, 1494395132802102017, 4161367659627551352, 94665623806855054},
+	{2628762798243322863, 1867993916002627522, 14425081611389214998, 118332029758568817},
+	{6254662767329464693, 15002554252783805913, 2098146979477177517, 73957518599105511},
+	{12430014477589218770, 4918134760697593679, 16457741779628635609, 92446898248881888},
+	{10925832078559135559, 10759354469299380003, 2125433150826242895, 115558622811102361},
+	{4522802039885765772, 13642125570953194358, 12857610765334871569, 72224139256938975},
+	{14876874586711983023, 3217598908409329235, 11460327438241201558, 90280174071173719},
+	{13984407214962590875, 13245370672366437352, 9713723279374114043, 112850217588967149},
+	{17480509018703238594, 11945027322030658786, 16753840117645030458, 141062771986208936},
+	{15537004155116912025, 12077328094696549645, 10471150073528144036, 88164232491380585},
+	{5586197138613976320, 15096660118370687057, 17700623610337567949, 110205290614225731},
+	{11594432441694858304, 5035767092681195109, 17514093494494572033, 137756613267782164},
+	{9552363285272980392, 14676569478994216703, 1722936397204331712, 86097883292363853},
+	{7328768088163837586, 18345711848742770879, 6765356514932802544, 107622354115454816},
+	{4549274091777409078, 4485395737218911983, 8456695643666003181, 134527942644318520},
+	{9760825335001962530, 5109215344975513941, 5285434777291251988, 84079964152699075},
+	{16812717687179841066, 6386519181219392426, 1995107453186677081, 105099955190873844},
+	{11792525072120025525, 12594834994951628437, 2493884316483346351, 131374943988592305},
+	{9676171179288709905, 14789300899485849629, 13087892743870561229, 82109339992870190},
+	{16706899992538275285, 4651568069075148324, 7136493892983425729, 102636674991087738},
+	{2436880916963292491, 10426146104771323310, 18143989403084057969, 128295843738859672},
+	{15358108628384221518, 18045556361550546828, 11339993376927536230, 80184902336787295},
+	{750891711770725282, 13333573415083407728, 9563305702732032384, 100231127920984119},
+	{938614639713406603, 16666966768854259660, 7342446109987652576, 125288909901230149},
+	{9810006186675654935, 10416854230533912287, 6894871827955976812, 78305568688268843},
+	{7650821714917180764, 13021067788167390359, 4006903766517583111, 97881960860336054},
+	{4951841125219088052, 11664648716781850045, 14232001745001754697, 122352451075420067},
+	{5400743712475623984, 372876420347574422, 6589158081412402734, 76470281922137542},
+	{15974301677449305788, 9689467562289243835, 17459819638620279225, 95587852402671927},
+	{15356191078384244331, 16723520471288942698, 17213088529847961127, 119484815503339909},
+	{14209305442417540611, 17369729322196671042, 13064023340368669656, 74678009689587443},
+	{8538259766167149956, 3265417579036287187, 11718343157033449167, 93347512111984304},
+	{6061138689281549541, 17916830029077522696, 14647928946291811458, 116684390139980380},
+	{3788211680800968463, 15809704786600839589, 18378327628287157969, 72927743837487737},
+	{9346950619428598483, 5927072927968885774, 9137851480076783750, 91159679796859672},
+	{2460316237430972295, 16632213196815883026, 11422314350095979687, 113949599746074590},
+	{12298767333643491177, 16178580477592465878, 5054520900765198801, 142436999682593238},
+	{3075043565099794082, 3194083770854209318, 16994133618260412963, 89023124801620773},
+	{13067176493229518410, 17827662768849925359, 7407608967543352491, 111278906002025967},
+	{11722284598109510109, 17672892442635018795, 4647825191001802710, 139098632502532459},
+	{5020584864604749866, 6433871758219498843, 599047735162432742, 86936645314082787},
+	{1664045062328549429, 17265711734629149362, 14583867724235204639, 108670806642603483},
+	{11303428364765462594, 16970453649859048798, 13618148636866617895, 135838508303254354},
+	{2452956709551026217, 17524062558802987355, 13123028916469024088, 84899067689533971},
+	{16901253942220946483, 3458334124794182577, 11792100127158892207, 106123834611917464},
+	{7291509372494019392, 18157975711274891934, 14740125158948615258, 132654793264896830},
+	{18392251413090925832, 15960420837974195362, 4600892205915496632, 82909245790560519},
+	{13766942229508881482, 1503781973758192587, 1139429238966982887, 103636557238200649},
+	{12596991768458713949, 15714785522479904446, 6035972567136116512, 129545696547750811},
+	{3261433836859308314, 9821740951549940279, 1466639845246378868, 80966060342344257},
+	{17911850351356299104, 12277176189437425348, 6444985824985361489, 101207575427930321},
+	{3943068865485822265, 1511412181514617974, 12667918299659089766, 126509469284912901},
+	{16299476096210802627, 14779690668728799945, 10223291946500625055, 79068418303070563},
+	{6539287064981339572, 13862927317483612028, 8167428914698393415, 98835522878838204},
+	{8174108831226674465, 12716973128427127131, 10209286143372991769, 123544403598547755},
+	{2802975010302977589, 1030579177625872601, 4074960830394425904, 77215252249092347},
+	{8115404781306109890, 1288223972032340751, 482015019565644476, 96519065311365434},
+	{5532569958205249458, 1610279965040425939, 9825890811311831403, 120648831639206792},
+	{1152013214664586959, 17147326042646123876, 6141181757069894626, 75405519774504245},
+	{1440016518330733699, 12210785516452879037, 12288163214764756187, 94256899718130306},
+	{6411706666340805028, 10651795877138710892, 6136831981601169426, 117821124647662883},
+	{13230688703317778950, 11269058441639082211, 1529676979287036939, 73638202904789302},
+	{11926674860719835784, 9474637033621464860, 11135468260963571982, 92047753630986627},
+	{14908343575899794730, 2619924255172055267, 9307649307777077074, 115059692038733284},
+	{14023743451447355509, 12498277355819844892, 11634561634721346342, 143824615048416605},
+	{17988211694009373001, 3199737328960015153, 9577444030914535416, 89890384405260378},
+	{8650206562229552539, 3999671661200018942, 2748433001788393462, 112362980506575473},
+	{1589386165932164866, 14222961613354799486, 8047227270662879731, 140453725633219341},
+	{14828424408989766753, 6583507999133055726, 7335360053377993784, 87783578520762088},
+	{9312158474382432634, 8229384998916319658, 9169200066722492230, 109729473150952610},
+	{2416826056123264984, 1063359211790623765, 2238128046548339480, 137161841438690763},
+	{3816359294290734567, 664599507369139853, 17539731093588569839, 85726150899181726},
+	{9382135136290806113, 14665807439493588528, 12701291830130936490, 107157688623977158},
+	{11727668920363507641, 9108887262512209852, 6653242750808894805, 133947110779971448},
+	{16553165112081968084, 7998897548283825109, 4158276719255559253, 83716944237482155},
+	{6856398334820296393, 14610307953782169291, 586159880642061162, 104646180296852694},
+	{3958811900097982587, 9039512905372935806, 9956071887657352261, 130807725371065867},
+	{16309315492843402829, 7955538575071778830, 3916701920572151211, 81754828356916167},
+	{11163272329199477728, 5332737200412335634, 284191382287801110, 102193535446145209},
+	{4730718374644571352, 15889293537370195351, 4966925246287139291, 127741919307681511},
+	{9874228011793938951, 7624965451642678142, 10021857306570543913, 79838699567300944},
+	{3119412977887647881, 14142892832980735582, 12527321633213179891, 99798374459126180},
+	{13122638259214335659, 13066930022798531573, 15659152041516474864, 124747968073907725},
+	{10507491921222653739, 8166831264249082233, 12092813035161490742, 77967480046192328},
+	{17746050919955705077, 985167043456576983, 15116016293951863428, 97459350057740410},
+	{17570877631517243443, 1231458804320721229, 9671648330585053477, 121824187572175513},
+	{13287641528911971104, 3075504761914144720, 17573995252684128183, 76140117232609695},
+	{16609551911139963880, 17679439007674844612, 17355808047427772324, 95175146540762119},
+	{2315195815215403234, 3652554685884004150, 17083074040857327502, 118968933175952649},
+	{15282055439791790733, 16117904733959666305, 3759392247894747832, 74355583234970406},
+	{5267511244457574704, 1700636843740031266, 13922612346723210599, 92944479043713007},
+	{15807761092426744188, 15960854109957202794, 12791579414976625344, 116180598804641259},
+	{14491536701194103022, 9975533818723251746, 5688894125146696888, 72612874252900787},
+	{8891048839637852969, 12469417273404064683, 2499431638005983206, 90766092816125984},
+	{6502125031119928307, 6363399554900305046, 3124289547507479008, 113457616020157480},
+	{17351028325754686192, 7954249443625381307, 3905361934384348760, 141822020025196850},
+	{8538549694382984918, 4971405902265863317, 7052537227417605879, 88638762515748031},
+	{15284873136406119052, 1602571359404941242, 4203985515844619445, 110798453144685039},
+	{9882719383652873007, 6614900217683564457, 643295876378386402, 138498066430856299},
+	{17705914660851515389, 8745998654479615689, 16542960987232349165, 86561291519285186},
+	{8297335270782230524, 15544184336526907516, 11455329197185660648, 108201614399106483},
+	{10371669088477788156, 983486346949082779, 9707475478054687907, 135252017998883104},
+	{4176450171084923645, 16755580031339034401, 6067172173784179941, 84532511249301940},
+	{9832248732283542460, 7109416983891629289, 7583965217230224927, 105665639061627425},
+	{16901996933781815980, 4275085211437148707, 14091642539965169063, 132082048827034281},
+	{8257905074399941035, 9589457284789299798, 1889747559837148808, 82551280516896426},
+	{1099009306145150486, 11986821605986624748, 11585556486651211818, 103189100646120532},
+	{1373761632681438108, 5760154970628505127, 14481945608314014773, 128986375807650665},
+	{7776130048066980673, 5905939865856509656, 2133686977555177377, 80616484879781666},
+	{9720162560083725842, 11994110850748024974, 11890480758798747529, 100770606099727082},
+	{2926831163249881494, 1157580508152867506, 5639728911643658604, 125963257624658853},
+	{6440955495458563838, 9946859854450317999, 5830673578990980579, 78727036015411783},
+	{3439508350895816893, 7821888799635509595, 2676655955311337820, 98408795019264729},
+	{18134443493901934828, 9777360999544386993, 7957505962566560179, 123010993774080911},
+	{4416498156047627412, 3805007615501547919, 11890970254245181968, 76881871108800569},
+	{908936676632146361, 4756259519376934899, 1028654762524313748, 96102338886000712},
+	{14971228901072346663, 5945324399221168623, 1285818453155392185, 120127923607500890},
+	{16274547090811298520, 15245042795581700149, 5415322551649508019, 75079952254688056},
+	{6508125808231959438, 14444617476049737283, 6769153189561885024, 93849940318360070},
+	{3523471241862561394, 18055771845062171604, 17684813523807132088, 117312425397950087},
+	{11425541563018876679, 11284857403163857252, 17970537480020539411, 73320265873718804},
+	{14281926953773595849, 9494385735527433661, 4016427776316122648, 91650332342148506},
+	{4017350636934831099, 11867982169409292077, 14243906757249929118, 114562915427685632},
+	{9633374314595926778, 5611605674906839288, 17804883446562411398, 143203644284607040},
+	{6020858946622454236, 17342311602098938267, 11128052154101507123, 89502277677879400},
+	{2914387664850679891, 17066203484196284930, 13910065192626883904, 111877847097349250},
+	{12866356617918125672, 2886010281535804546, 8164209453928829073, 139847308871686563},
+	{12653158904626216449, 13332971472028347601, 2796787899491824218, 87404568044804102},
+	{1981390575500606849, 7442842303180658694, 12719356911219556081, 109255710056005127},
+	{11700110256230534370, 13915238897403211271, 11287510120597057197, 136569637570006409},
+	{14230097937785165837, 11002867320090700996, 137164797732078892, 85356023481254006},
+	{17787622422231457296, 13753584150113376245, 9394828034019874423, 106695029351567507},
+	{8399469972507157909, 12580294169214332403, 7131849024097455125, 133368786689459384},
+	{2943825723603279741, 10168526864972651704, 4457405640060909453, 83355491680912115},
+	{3679782154504099676, 17322344599643202534, 960071031648748912, 104194364601140144},
+	{13823099729984900403, 3206186675844451551, 1200088789560936141, 130242955751425180},
+	{15556966358881644608, 4309709681616476171, 9973427530330360896, 81401847344640737},
+	{14834521930174667856, 5387137102020595214, 17078470431340339024, 101752309180800921},
+	{9319780375863559012, 6733921377525744018, 7513029983893260068, 127190386476001152},
+	{10436548753342112286, 13432072897808365819, 4695643739933287542, 79493991547500720},
+	{8433999923250252454, 7566719085405681466, 5869554674916609428, 99367489434375900},
+	{1319127867208039760, 9458398856757101833, 7336943343645761785, 124209361792969875},
+	{12353669963073494610, 17440714331541658405, 2279746580564907163, 77630851120606172},
+	{1607029398559704550, 17189206895999685103, 2849683225706133954, 97038563900757715},
+	{15843844803481794400, 12263136583144830570, 17397162087414831155, 121298204875947143},
+	{14514089020603509404, 5358617355251825154, 17790755332275351328, 75811378047466964},
+	{8919239238899610947, 6698271694064781443, 3791700091634637544, 94764222559333706},
+	{6537363030197125779, 8372839617580976804, 13962997151398072738, 118455278199167132},
+	{13309223930727979420, 9844710779415498406, 17950245256478571269, 74034548874479457},
+	{7413157876555198467, 16917574492696760912, 8602748515316050374, 92543186093099322},
+	{9266447345693998084, 11923596079016175332, 1530063607290287160, 115678982616374153},
+	{15014901627913524610, 7452247549385109582, 12485504800624899235, 72299364135233845},
+	{9545254998037129955, 4703623418303999074, 1771822945498960332, 90374205169042307},
+	{2708196710691636636, 5879529272879998843, 16049836737155864127, 112967756461302883},
+	{17220303943646709507, 2737725572672610649, 15450609903017442255, 141209695576628604},
+	{3845160937138111586, 8628607510561463512, 433259152531125601, 88256059735392878},
+	{4806451171422639482, 15397445406629217294, 9764945977518682809, 110320074669241097},
+	{15231436001133075161, 5411748703004357905, 16817868490325741416, 137900093336551371},
+	{2602118473067090119, 3382342939377723691, 8205324797239894433, 86187558335344607},
+	{17087706146616026361, 8839614692649542517, 5644969978122480137, 107734447919180759},
+	{7524574627987869240, 15661204384239316051, 2444526454225712267, 134668059898975949},
+	{2397016133278724323, 7482409730935878580, 3833672043104764119, 84167537436859968},
+	{2996270166598405403, 4741326145242460321, 4792090053880955149, 105209421796074960},
+	{8357023726675394658, 10538343699980463305, 5990112567351193936, 131511777245093700},
+	{16752354875240591421, 6586464812487789565, 12967192391449272018, 82194860778183562},
+	{7105385538768575565, 17456453052464512765, 6985618452456814214, 102743575972729453},
+	{13493417941888107360, 12597194278725865148, 13343709083998405672, 128429469965911816},
+	{17656758250534842908, 7873246424203665717, 8339818177499003545, 80268418728694885},
+	{8235889757886389923, 14453244048681970051, 15036458740301142335, 100335523410868606},
+	{5683176178930599500, 13454869042425074660, 9572201388521652111, 125419404263585758},
+	{12775357148686400495, 15326822179156753518, 1370939849398644665, 78387127664741099},
+	{6745824399003224811, 5323469668663778186, 15548732867030469544, 97983909580926373},
+	{17655652535608806822, 6654337085829722732, 5600858028505923218, 122479886976157967},
+	{1811410797900728456, 8770646697070964612, 10418065295457283867, 76549929360098729},
+	{2264263497375910570, 6351622352911317861, 17634267637748992738, 95687411700123411},
+	{7442015390147276116, 17162899977993923134, 17431148528758853018, 119609264625154264},
+	{39573600414659668, 15338498504673589863, 10894467830474283136, 74755790390721415},
+	{13884525055800488298, 726379057132435712, 9006398769665466017, 93444737988401769},
+	{17355656319750610372, 5519659839842932544, 15869684480509220425, 116805922485502211},
+	{10847285199844131482, 14979002445970302600, 7612709791104568813, 73003701553438882},
+	{13559106499805164353, 4888695002180714538, 292515202025935209, 91254626941798603},
+	{7725511087901679634, 10722554771153281077, 14200702057814582723, 114068283677248253},
+	{14268574878304487446, 8791507445514213442, 3915819516986064692, 142585354596560317},
+	{13529545317367692558, 14718064190301159209, 4753230207329984384, 89115846622850198},
+	{3076873591427451985, 18397580237876449012, 15164909796017256288, 111394808278562747},
+	{3846091989284314982, 4550231223636009649, 14344451226594182457, 139243510348203434},
+	{13933022539371166623, 14373109560840975790, 13576968035048751939, 87027193967627146},
+	{8192906137359182471, 13354700932623831834, 7747838006956164116, 108783992459533933},
+	{1017760634844202281, 16693376165779789793, 14296483527122593049, 135979990574417416},
+	{12165315442846096186, 3515831075971286764, 8935302204451620656, 84987494109010885},
+	{15206644303557620232, 4394788844964108455, 15780813773991913724, 106234367636263606},
+	{14396619361019637386, 5493486056205135569, 10502645180635116347, 132792959545329508},
+	{2080358072996191510, 1127585775914515779, 15787525274751723525, 82995599715830942},
+	{16435505646527403100, 6021168238320532627, 10511034556584878598, 103744499644788678},
+	{15932696039731865971, 16749832334755441592, 3915421158876322439, 129680624555985848},
+	{9957935024832416232, 17386174236863232851, 2447138224297701524, 81050390347491155},
+	{7835732762613132386, 3285973722369489448, 16893980835654290618, 101312987934363943},
+	{9794665953266415482, 13330839189816637618, 16505790026140475368, 126641234917954929},
+	{10733352239218897580, 8331774493635398511, 3398589738696715249, 79150771823721831},
+	{8805004280596234071, 15026404135471636043, 18083295228653057773, 98938464779652288},
+	{6394569332317904685, 4947947114057381342, 4157374962106770601, 123673080974565361},
+	{17831663887980854140, 14621681992354333098, 14127574397385201385, 77295675609103350},
+	{13066207823121291867, 4442044435160752661, 8436095959876725924, 96619594511379188},
+	{2497701723619451122, 5552555543950940827, 10545119949845907405, 120774493139223985},
+	{17701964641758014615, 5776190224183031968, 18119915014722161888, 75484058212014990},
+	{3680711728487966653, 7220237780228789961, 13426521731547926552, 94355072765018738},
+	{9212575679037346221, 9025297225285987451, 7559780127580132382, 117943840956273423},
+	{3452016790184647436, 1029124747376354253, 11642391607378664595, 73714900597670889},
+	{8926707006158197199, 15121463989502606528, 717931453941167031, 92143625747088612},
+	{11158383757697746499, 14290143968450870256, 897414317426458789, 115179532183860765},
+	{13947979697122183123, 4027621905281424108, 5733453915210461391, 143974415229825956},
+	{17940859347556140260, 9434792718441971923, 12806780733861314177, 89984009518641222},
+	{17814388166017787421, 16405176916479852808, 6785103880471866913, 112480011898301528},
+	{3821241133812682660, 6671413090317652299, 8481379850589833642, 140600014872876910},
+	{82432699419232711, 8781319199875920591, 689176388191258122, 87875009295548069},
+	{13938098929556204600, 1753276962990124930, 5473156503666460557, 109843761619435086},
+	{8199251625090479942, 6803282222165044067, 16064817666437851504, 137304702024293857},
+	{2818689256467856012, 4252051388853152542, 3122982013882575334, 85815438765183661},
+	{12746733607439595823, 14538436272921216485, 8515413535780607071, 107269298456479576},
+	{2098358954017331067, 13561359322724132703, 10644266919725758839, 134086623070599470},
+	{8229003373901913773, 15393378604343664795, 2040980806401211370, 83804139419124669},
+	{5674568198950004312, 10018351218574805186, 7162912026428902117, 104755174273905836},
+	{16316582285542281198, 17134625041645894386, 8953640033036127646, 130943967842382295},
+	{14809549946891313653, 6097454632601296087, 12513554048288661635, 81839979901488934},
+	{13900251415186754162, 3010132272324232205, 6418570523506051236, 102299974876861168},
+	{3540256213701278990, 3762665340405290257, 8023213154382564045, 127874968596076460},
+	{13741875179631769129, 4657508846967000362, 14237880258343878336, 79921855372547787},
+	{7953971937684935603, 5821886058708750453, 13185664304502460016, 99902319215684734},
+	{14554150940533557408, 7277357573385938066, 7258708343773299212, 124877899019605918},
+	{13708030356260861284, 13771720520220987099, 18371750770140475719, 78048686887253698},
+	{12523351926898688701, 12602964631848845970, 13741316425820818841, 97560858609067123},
+	{6430817871768585068, 1918647734528893751, 12564959513848635648, 121951073261333904},
+	{10936790197496447523, 1199154834080558594, 7853099696155397280, 76219420788333690},
+	{4447615710015783596, 1498943542600698243, 593002583339470792, 95274275985417113},
+	{947833619092341592, 1873679428250872804, 5352939247601726394, 119092844981771391},
+	{9815768048787489303, 5782735661084183406, 10263116057392160852, 74433028113607119},
+	{3046338024129585820, 7228419576355229258, 8217209053312813161, 93041285142008899},
+	{13031294567016758084, 13647210488871424476, 5659825298213628547, 116301606427511124},
+	{17367931141240249610, 6223663546330946345, 12760762848238293650, 72688504017194452},
+	{7874855871268148301, 17002951469768458740, 15950953560297867062, 90860630021493065},
+	{9843569839085185376, 12030317300355797617, 6103633895090170116, 113575787526866332},
+	{16916148317283869624, 15037896625444747021, 7629542368862712645, 141969734408582915},
+	{12878435707516112467, 11704528400116660840, 2462620971325501451, 88731084005364322},
+	{16098044634395140584, 10018974481718438146, 12301648251011652622, 110913855006705402},
+	{10899183756139149922, 3300346065293271875, 6153688276909789970, 138642318758381753},
+	{4506146838373274749, 6674402309235682826, 15375270219137088491, 86651449223988595},
+	{14856055584821369244, 3731316868117215628, 14607401755493972710, 108314311529985744},
+	{123325407317159940, 13887518122001295344, 18259252194367465887, 135392889412482180},
+	{77078379573224962, 15597227853891891446, 2188660584624890371, 84620555882801363},
+	{9319720011321307011, 14884848798937476403, 16570883786063276676, 105775694853501703},
+	{7037963995724245859, 159316924962293888, 16101918714151707942, 132219618566877129},
+	{4398727497327653662, 13934631133383597392, 3146170168703735607, 82637261604298206},
+	{5498409371659567078, 12806602898302108836, 13156084747734445317, 103296577005372757},
+	{6873011714574458847, 2173195567595472333, 2610047879385892935, 129120721256715947},
+	{6601475330822730731, 8275776257388252064, 17772180989112040748, 80700450785447466},
+	{8251844163528413414, 10344720321735315080, 12991854199535275127, 100875563481809333},
+	{10314805204410516768, 8319214383741755946, 2404759694136930197, 126094454352261667},
+	{11058439271183960884, 7505351999052291418, 17643875873331439037, 78809033970163541},
+	{4599677052125175297, 13993376017242752177, 8219786786382135084, 98511292462704427},
+	{10361282333583857025, 17491720021553440221, 5663047464550280951, 123139115578380534},
+	{8781644467703604592, 17849854041111981994, 17374462720626089306, 76961947236487833},
+	{1753683547774729933, 13088945514535201685, 7883020345500447921, 96202434045609792},
+	{6803790453145800320, 2526123837886838394, 9853775431875559902, 120253042557012240},
+	{8864055051643513104, 15413885453961437708, 6158609644922224938, 75158151598132650},
+	{11080068814554391380, 10043984780597021327, 16921634093007556981, 93947689497665812},
+	{9238399999765601321, 17166666994173664563, 2705298542549894610, 117434611872082266},
+	{3468156990639806873, 15340852889785928256, 6302497607521072035, 73396632420051416},
+	{4335196238299758592, 14564380093805022416, 7878122009401340044, 91745790525064270},
+	{5418995297874698240, 18205475117256278020, 624280474896899247, 114682238156330338},
+	{6773744122343372800, 18145157878142959621, 10003722630475899867, 143352797695412922},
+	{6539433085678301952, 9034880664625655811, 10864012662474825321, 89595498559633076},
+	{3562605338670489536, 15905286849209457668, 13580015828093531651, 111994373199541345},
+	{4453256673338111920, 15269922543084434181, 3139961729834750852, 139992966499426682},
+	{5089128430050013902, 320329552572995555, 6574162099574107187, 87495604062141676},
+	{1749724519135129474, 14235469995998408156, 8217702624467633983, 109369505077677095},
+	{2187155648918911842, 13182651476570622291, 5660442262157154575, 136711881347096369},
+	{17507873345070177565, 15156686200497720787, 15066991459916691369, 85444925841935230},
+	{17273155662910334052, 5110799695339987272, 9610367288041088404, 106806157302419038},
+	{3144700504928365950, 6388499619174984091, 2789587073196584697, 133507696628023798},
+	{18106338880076086382, 15522027308052834816, 15578549976030029147, 83442310392514873},
+	{4186179526385556362, 14790848116638655617, 5638129414755372722, 104302887990643592},
+	{9844410426409333357, 9265188108943543713, 7047661768444215903, 130378609988304490},
+	{17681971562574303108, 12708271595730796676, 9016474623705022843, 81486631242690306},
+	{3655720379508327269, 11273653476236107942, 2047221242776502746, 101858289053362883},
+	{13793022511240184894, 4868694808440359119, 16394084608752792145, 127322861316703603},
+	{15538168097166197415, 14572149301343694209, 7940459871256801138, 79576788322939752},
+	{5587652066175583057, 8991814589824841954, 9925574839071001423, 99470985403674690},
+	{16207937119574254629, 6628082218853664538, 3183596511983975971, 124338731754593363},
+	{14741646718161297047, 1836708377569846384, 18130648884485842646, 77711707346620851},
+	{18427058397701621309, 11519257508817083788, 18051625087179915403, 97139634183276064},
+	{4587078923417475020, 9787385867593966832, 4117787285265342638, 121424542729095081},
+	{2866924327135921887, 1505430148818841366, 14102832099359308909, 75890339205684425},
+	{12807027445774678167, 6493473704450939611, 3793482068916972424, 94862924007105532},
+	{11397098288790959805, 8116842130563674514, 4741852586146215530, 118578655008881915},
+	{11734872448921737782, 9684712350029684475, 657814857127690754, 74111659380551197},
+	{10056904542724784324, 2882518400682329786, 5433954589837001347, 92639574225688996},
+	{3347758641551204597, 17438206056135075945, 6792443237296251683, 115799467782111245},
+	{13621564197037972633, 8593035775870728513, 6551120032523851254, 72374667363819528},
+	{3191897191015302079, 1517922682983634834, 8188900040654814068, 90468334204774410},
+	{13213243525623903407, 1897403353729543542, 1012753013963741777, 113085417755968013},
+	{7293182370175103451, 6983440210589317332, 5877627285882065125, 141356772194960016},
+	{13781611018214215465, 6670493140832017284, 3673517053676290703, 88347982621850010},
+	{17227013772767769331, 3726430407612633701, 13815268353950139187, 110434978277312512},
+	{7698709160677547952, 46351991088404223, 17269085442437673984, 138043722846640640},
+	{11729222253064549326, 28969994430252639, 10793178401523546240, 86277326779150400},
+	{10049841797903298753, 36212493037815799, 13491473001904432800, 107846658473938000},
+	{7950616228951735537, 45265616297269749, 16864341252380541000, 134808323092422500},
+	{7274978152308528663, 28291010185793593, 1316841245883062317, 84255201932764063},
+	{13705408708813048733, 4647049781159629895, 15481109612635991608, 105319002415955078},
+	{12520074867588923012, 5808812226449537369, 10128014978940213702, 131648753019943848},
+	{907517764601995026, 17465565696813124568, 6330009361837633563, 82280470637464905},
+	{1134397205752493783, 17220271102589017806, 12524197720724429858, 102850588296831131},
+	{10641368544045393037, 12301966841381496449, 11043561132478149419, 128563235371038914},
+	{18180070386096840408, 5382886266649741328, 11513911726226231291, 80352022106899321},
+	{4278343908911498894, 2116921814884788757, 557331602500625402, 100440027633624152},
+	{9959615904566761521, 11869524305460761754, 696664503125781752, 125550034542030190},
+	{10836445958781613855, 7418452690912976096, 14270473369735777307, 78468771588768868},
+	{13545557448477017318, 4661379845213832216, 17838091712169721634, 98085964485961085},
+	{16931946810596271648, 15050096843372066078, 8462556584929988330, 122607455607451357},
+	{5970780738195281876, 14017996545534929203, 7594940874794936658, 76629659754657098},
+	{2851789904316714441, 8299123645063885696, 270304056638895015, 95787074693321373},
+	{3564737380395893051, 5762218537902469216, 4949566089226006673, 119733843366651716},
+	{2227960862747433157, 15130601632257513020, 12316850842621029978, 74833652104157322},
+	{2784951078434291446, 9689880003467115467, 6172691516421511665, 93542065130196653},
+	{17316246903325028020, 16724036022761282237, 12327550413954277485, 116927581412745816},
+	{13128497323791836464, 12758365523439495350, 7704719008721423428, 73079738382966135},
+	{7187249617885019773, 15947956904299369188, 5019212742474391381, 91349672978707669},
+	{8984062022356274716, 6099888075092047773, 10885701946520377131, 114187091223384586},
+	{15841763546372731299, 3013174075437671812, 4383755396295695606, 142733864029230733},
+	{677730179628181254, 15718291852430708595, 5045690131898503705, 89208665018269208},
+	{14682220779817390279, 5812806760256222031, 6307112664873129632, 111510831272836510},
+	{13741089956344349945, 7266008450320277539, 17107262867946187848, 139388539091045637},
+	{6282338213501524763, 4541255281450173462, 12997882301680061357, 87117836931903523},
+	{17076294803731681762, 10288255120240104731, 11635666858672688792, 108897296164879404},
+	{16733682486237214299, 12860318900300130914, 14544583573340860990, 136121620206099255},
+	{15070237572325646841, 3426013294260193917, 16007893760979119975, 85076012628812034},
+	{5002738910124894839, 18117574673107406109, 10786495164369124160, 106345015786015043},
+	{10865109656083506453, 4200224267674706020, 8871432937034017297, 132931269732518804},
+	{16014065571906967341, 14154355213365161022, 14768017622501036618, 83082043582824252},
+	{10794209928028933368, 8469571979851675470, 13277954416744157, 103852554478530316},
+	{4269390373181390903, 15198650993241982242, 16597443020930196, 129815693098162895},
+	{7280055001665757218, 275784833921463093, 6927902429529163229, 81134808186351809},
+	{13711754770509584427, 4956417060829216770, 13271564055338841940, 101418510232939761},
+	{7916321426282204725, 6195521326036520963, 2754397013891388713, 126773137791174702},
+	{2641857882212684001, 15401415874841295362, 15556556188964281657, 79233211119484188},
+	{12525694389620630810, 5416711788269455490, 998951162495800456, 99041513899355236},
+	{6433745950171012704, 6770889735336819363, 1248688953119750570, 123801892374194045},
+	{1715248209643188988, 8843492103012900006, 3086273604913538058, 77376182733871278},
+	{11367432298908762043, 1830993091911349199, 13081214042996698381, 96720228417339097},
+	{9597604355208564650, 6900427383316574403, 2516459498463709264, 120900285521673872},
+	{3692659712791658954, 4312767114572859002, 1572787186539818290, 75562678451046170},
+	{13839196677844349501, 14614330930070849560, 11189356020029548670, 94453348063807712},
+	{17298995847305436876, 9044541625733786142, 13986695025036935838, 118066685079759640},
+	{6200186386138510143, 1041152497656228435, 8741684390648084899, 73791678174849775},
+	{3138546964245749775, 15136498677352449256, 6315419469882718219, 92239597718562219},
+	{3923183705307187219, 14308937328263173666, 3282588318926009870, 115299497148202774},
+	{7063675834244379916, 4331399811737095637, 15886675754610919881, 72062185717626733},
+	{13441280811232862799, 10025935783098757450, 6023286637981486139, 90077732147033417},
+	{7578228977186302691, 7920733710446058909, 12140794315904245578, 112597165183791771},
+	{14084472239910266267, 677545101202797828, 10564306876452919069, 140746456479739714},
+	{18026167186798692225, 2729308697465442594, 11214377816210462322, 87966535299837321},
+	{13309336946643589473, 12635007908686579051, 182914214980914190, 109958169124796652},
+	{12024985164877098938, 6570387849003448006, 228642768726142738, 137447711405995815},
+	{2903929709620798932, 8718178424054542908, 7060430758094921067, 85904819628747384},
+	{3629912137025998665, 6286037011640790731, 8825538447618651334, 107381024535934230},
+	{18372448226564662043, 17080918301405764221, 1808551022668538359, 134226280669917788},
+	{13788623150816607729, 17593102966019684494, 10353716426022612282, 83891425418698617},
+	{8012406901665983853, 12768006670669829810, 17553831550955653257, 104864281773373271},
+	{792136590227704009, 2124950283055123551, 17330603420267178668, 131080352216716589},
+	{7412614396533396861, 10551465963764228027, 13137470146880680619, 81925220135447868},
+	{4654081977239358173, 8577646436277897130, 16421837683600850774, 102406525169309835},
+	{15040974508403973524, 1498686008492595604, 15915611086073675564, 128008156461637294},
+	{177237030897707644, 10160050792162648061, 5335570910368659323, 80005097788523309},
+	{4833232307049522459, 8088377471775922172, 11281149656388212058, 100006372235654136},
+	{6041540383811903074, 887099802865126907, 14101437070485265073, 125007965294567670},
+	{1470119730668745469, 12083652422859174077, 4201712150625902766, 78129978309104794},
+	{6449335681763319741, 5881193491719191788, 14475512225137154266, 97662472886380992},
+	{8061669602204149676, 16574863901503765543, 18094390281421442832, 122078091107976240},
+	{11956072529018675403, 10359289938439853464, 11308993925888401770, 76298806942485150},
+	{14945090661273344254, 3725740386195041022, 4912870370505726405, 95373508678106438},
+	{9457991289736904510, 9268861501171189182, 15364459999986933814, 119216885847633047},
+	{1299558537658177415, 1181352419804605335, 16520316527632915490, 74510553654770654},
+	{15459506227354885480, 10700062561610532476, 11427023622686368554, 93138192068463318},
+	{877638710484055235, 4151706165158389788, 5060407491503184885, 116422740085579148},
+	{9771896230907310329, 4900659362437687569, 12386126719044266361, 72764212553486967},
+	{16826556307061525816, 10737510221474497365, 10870972380377945047, 90955265691858709},
+	{7198137328544743558, 8810201758415733803, 18200401493899819213, 113694082114823386},
+	{4385985642253541544, 15624438216447055158, 13527129830519998208, 142117602643529233},
+	{16576299081690627177, 9765273885279409473, 1536927116433917024, 88823501652205771},
+	{6885315796831120259, 12206592356599261842, 15756216950824559992, 111029377065257213},
+	{17830016782893676132, 15258240445749077302, 5860213133248536278, 138786721331571517},
+	{6532074470881159678, 4924714260165785410, 5968476217494029126, 86741700832232198},
+	{17388465125456225406, 15379264862062007570, 16683967308722312215, 108427126040290247},
+	{12512209369965505949, 14612395059150121559, 16243273117475502365, 135533907550362809},
+	{14737659883869523074, 11438589921182519926, 3234516670781107122, 84708692218976756},
+	{9198702817982128035, 5074865364623374100, 4043145838476383903, 105885865273720945},
+	{11498378522477660044, 1731895687351829721, 9665618316522867783, 132357331592151181},
+	{268957548907455671, 7999963832235975432, 8346854457040486316, 82723332245094488},
+	{336196936134319589, 9999954790294969290, 10433568071300607895, 103404165306368110},
+	{9643618207022675294, 7888257469441323708, 3818588052270984061, 129255206632960138},
+	{15250633416243947867, 7236003927614521269, 6998303551096752942, 80784504145600086},
+	{5228233715022771122, 18268376946372927395, 17971251475725716985, 100980630182000107},
+	{1923606125351075998, 9000413127683995532, 17852378326229758328, 126225787727500134},
+	{10425625865199198307, 5625258204802497207, 6546050435466211051, 78891117329687584},
+	{8420346313071609979, 2419886737575733605, 8182563044332763814, 98613896662109480},
+	{15137118909766900378, 12248230458824442814, 10228203805415954767, 123267370827636850},
+	{4849013300176924832, 14572673064406358615, 11004313396812359633, 77042106767273031},
+	{1449580606793768137, 4380783275225784557, 9143705727588061638, 96302633459091289},
+	{6423661776919598075, 14699351130887006504, 16041318177912464951, 120378291823864111},
+	{4014788610574748797, 16104623484445460921, 16943352888836372450, 75236432389915069},
+	{9630171781645823900, 10907407318702050343, 7344133055763301851, 94045540487393837},
+	{7426028708629891971, 9022573129950175025, 13791852338131515218, 117556925609242296},
+	{16170482988962152242, 10250794224646247294, 8619907711332197011, 73473078505776435},
+	{10989731699347914494, 8201806762380421214, 6163198620737858360, 91841348132220544},
+	{4513792587330117310, 10252258452975526518, 7703998275922322950, 114801685165275680},
+	{14865612771017422445, 3591951029364632339, 9629997844902903688, 143502106456594600},
+	{6985164972672195076, 2244969393352895212, 6018748653064314805, 89688816535371625},
+	{8731456215840243845, 7417897760118506919, 12135121834757781410, 112111020669214531},
+	{6302634251372916903, 49000163293357841, 10557216275019838859, 140138775836518164},
+	{15468361453176542824, 16171526166554206314, 15821632208742175094, 87586734897823852},
+	{10112079779615902722, 10991035671337982085, 1330296187218167252, 109483418622279816},
+	{17251785742947266307, 13738794589172477606, 1662870234022709065, 136854273277849770},
+	{6170680070914653538, 1669217590591716648, 5650979914691581070, 85533920798656106},
+	{7713350088643316922, 11309894025094421618, 16287096930219252145, 106917400998320132},
+	{418315573949370345, 302309476085863311, 1912127089064513566, 133646751247900166},
+	{7178976261359438321, 14024001477835828281, 15030137485947484690, 83529219529937603},
+	{13585406345126685806, 8306629810440009543, 14175985839006967959, 104411524412422004},
+	{12370071912980969353, 5771601244622624025, 17719982298758709949, 130514405515527505},
+	{813765917972023990, 5913093787102833968, 4157459909083111862, 81571503447204691},
+	{1017207397465029987, 16614739270733318268, 585138867926501923, 101964379309005864},
+	{1271509246831287484, 16156738069989259931, 731423584908127404, 127455474136257330},
+	{16935594343765412341, 874589256888511648, 5068825758994967532, 79659671335160831},
+	{2722748855997213811, 1093236571110639561, 1724346180316321511, 99574589168951039},
+	{8015122088423905168, 15201603769170463163, 15990490780677565600, 124468236461188798},
+	{2703608296051246778, 9501002355731539477, 5382370719496090596, 77792647788242999},
+	{7991196388491446376, 11876252944664424346, 2116277380942725341, 97240809735303749},
+	{765623448759532162, 1010258125548366721, 7257032744605794581, 121551012169129686},
+	{12007729701543177361, 2937254337681423152, 18370703520660785325, 75969382605706053},
+	{15009662126928971702, 8283253940529166844, 9128321345543817944, 94961728257132567},
+	{315333584951663011, 10354067425661458556, 6798715663502384526, 118702160321415709},
+	{9420455527449565190, 1859606122611023693, 6555040298902684281, 74188850200884818},
+	{16387255427739344391, 6936193671691167520, 17417172410483131159, 92736062751106022},
+	{2037325210964628873, 4058556071186571497, 12548093476249138141, 115920078438882528},
+	{12802543302921362806, 4842440553705301137, 7842558422655711338, 72450049024301580},
+	{2168121073369539795, 15276422728986402230, 9803198028319639172, 90562561280376975},
+	{11933523378566700552, 648784337523451171, 7642311516972161062, 113203201600471219},
+	{10305218204780987786, 10034352458759089772, 4941203377787813423, 141504002000589024},
+	{15664133414842893174, 13188999314365512963, 3088252111117383389, 88440001250368140},
+	{14968480750126228564, 2651191087674727492, 3860315138896729237, 110550001562960175},
+	{263856863948234089, 7925674878020797270, 213707905193523642, 138187501953700219},
+	{13999968595249810018, 9565232817190386197, 16274468505241809940, 86367188721062636},
+	{3664902688780098810, 11956541021487982747, 1896341557842710809, 107958985901328296},
+	{18416186416257287225, 1110618221577814721, 2370426947303388512, 134948732376660370},
+	{4592587482519722659, 694136388486134201, 6093202860492005724, 84342957735412731},
+	{10352420371577041228, 867670485607667751, 3004817557187619251, 105428697169265914},
+	{8328839446043913631, 14919646162291748401, 12979393983339299871, 131785871461582392},
+	{16734739699845915779, 16242307879073424606, 8112121239587062419, 82366169663488995},
+	{11695052587952618916, 15691198830414392854, 5528465531056440120, 102957712079361244},
+	{5395443698085997838, 1167254464308439452, 6910581913820550151, 128697140099201555},
+	{12595524348158524456, 7647063067833856513, 2013270686924149892, 80435712562000972},
+	{1909347379915991858, 9558828834792320642, 2516588358655187365, 100544640702501215},
+	{11610056261749765631, 16560222061917788706, 16980793503601147918, 125680800878126518},
+	{11867971182020991423, 5738452770271230037, 6001309921323329545, 78550500548829074},
+	{999905922244075567, 11784751981266425451, 16725009438508937739, 98188125686036342},
+	{15084940458087258171, 10119253958155643909, 11682889761281396366, 122735157107545428},
+	{11733930795518230309, 1712847705419889539, 16525178137655648537, 76709473192215892},
+	{10055727475970399982, 6752745650202249828, 2209728598360009055, 95886841490269866},
+	{12569659344962999978, 3829246044325424381, 11985532784804787127, 119858551862837332},
+	{10161880099815568938, 9310807805344472094, 16714330027357767762, 74911594914273332},
+	{3478978087914685365, 2415137719825814310, 2446168460487658087, 93639493642841666},
+	{13572094646748132514, 16853980205064431599, 12281082612464348416, 117049367053552082},
+	{15400088181858664677, 10533737628165269749, 12287362651217605664, 73155854408470051},
+	{5415052172041167134, 13167172035206587187, 10747517295594619176, 91444818010587564},
+	{2157129196624071014, 16458965044008233984, 13434396619493273970, 114306022513234455},
+	{2696411495780088768, 11350334268155516672, 12181309755939204559, 142882528141543069},
+	{1685257184862555480, 14011487945238279776, 9919161606675696801, 89301580088464418},
+	{2106571481078194350, 3679301876265686008, 3175579971489845194, 111626975110580523},
+	{2633214351347742937, 13822499382186883318, 17804533019644470204, 139533718888225653},
+	{15480817024874503048, 17862434150721577881, 13433676146491487829, 87208574305141033},
+	{5515963225810965098, 8492984633119808640, 2957037127832196075, 109010717881426292},
+	{6894954032263706372, 6004544772972372896, 3696296409790245094, 136263397351782865},
+	{4309346270164816482, 17587898538389896772, 13839400302187372943, 85164623344864290},
+	{5386682837706020603, 17373187154559983061, 8075878340879440371, 106455779181080363},
+	{11345039565559913658, 17104797924772590922, 5483161907671912560, 133069723976350454},
+	{11702335746902333940, 10690498702982869326, 17262034247577109062, 83168577485219033},
+	{5404547646773141617, 4139751341873810850, 7742484754189222616, 103960721856523792},
+	{15979056595321202830, 5174689177342263562, 9678105942736528270, 129950902320654740},
+	{14598596390503139672, 17069238791121078438, 15272188251065105976, 81219313950409212},
+	{9024873451274148783, 2889804415191796432, 643491240121830855, 101524142438011516},
+	{11281091814092685978, 17447313574271909252, 804364050152288568, 126905178047514395},
+	{16274054420662704544, 10904570983919943282, 16643628595841038019, 79315736279696496},
+	{11119195988973604872, 9019027711472541199, 2357791671091745908, 99144670349620621},
+	{9287308967789618187, 11273784639340676499, 7558925607292070289, 123930837937025776},
+	{3498725095654817414, 128586371946840956, 4724328504557543931, 77456773710641110},
+	{4373406369568521768, 13995791020215714907, 15128782667551705721, 96820967138301387},
+	{855071943533264306, 3659680719987479922, 14299292316012244248, 121026208922876734},
+	{5146105983135678095, 2287300449992174951, 4325371679080264751, 75641380576797959},
+	{1820946460492209715, 16694183617772382401, 795028580422943034, 94551725720997449},
+	{6887869094042650048, 11644357485360702193, 5605471743956066697, 118189657151246811},
+	{15834133229845126040, 360194400709357014, 1197576830758847734, 73868535719529257},
+	{10569294500451631742, 9673615037741472076, 6108657056875947571, 92335669649411571},
+	{13211618125564539678, 7480332778749452191, 3024135302667546560, 115419587061764464},
+	{15174790356118919154, 4675207986718407619, 1890084564167216600, 72137241913602790},
+	{14356801926721261039, 5844009983398009524, 11585977742063796558, 90171552392003487},
+	{17946002408401576299, 16528384516102287713, 9870786159152357793, 112714440490004359},
+	{8597444955219806662, 6825422589845695930, 7726796680513059338, 140893050612505449},
+	{9985089115439767067, 8877575137080947860, 16358462971389131846, 88058156632815905},
+	{12481361394299708834, 1873596884496409017, 6613020658954251096, 110072695791019882},
+	{1766643687592472331, 2341996105620511272, 17489647860547589678, 137590869738774852},
+	{1104152304745295207, 15298805621294983257, 1707657875987467740, 85994293586734283},
+	{5991876399359006913, 676762952909177455, 15969630400266498388, 107492866983417853},
+	{2878159480771370737, 845953691136471819, 6126979945050959273, 134366083729272317},
+	{17939750739977964374, 12057936103028764646, 6135205474870543497, 83978802330795198},
+	{13201316388117679660, 1237362073503792096, 16892378880442955180, 104973502913493997},
+	{16501645485147099575, 1546702591879740120, 7280415545271530263, 131216878641867497},
+	{10313528428216937234, 7884218147565919431, 16079474761863176174, 82010549151167185},
+	{8280224516843783639, 631900647602623481, 6264285397046806506, 102513186438958982},
+	{14961966664482117453, 10013247846358055159, 17053728783163283940, 128141483048698727},
+	{16268758192942405264, 15481651940828560282, 17576109517118134318, 80088426905436704},
+	{11112575704323230772, 10128692889180924545, 3523392822688116282, 100110533631795881},
+	{55661575121874753, 3437494074621379874, 9015927046787533257, 125138167039744851},
+	{4646474502878559625, 13677648842706832181, 3329111395028514333, 78211354399840532},
+	{10419779147025587435, 3262002998101376514, 4161389243785642917, 97764192999800665},
+	{3801351896927208486, 8689189766054108547, 9813422573159441550, 122205241249750831},
+	{70001926365811351, 819057585356429938, 13050918135865732825, 76378275781094269},
+	{9310874444812039997, 5635508000122925326, 2478589614550002319, 95472844726367837},
+	{2415221019160274189, 2432698981726268754, 7709923036614890803, 119341055907959796},
+	{6121199155402559272, 17661337928074775635, 14042073934739082559, 74588159942474872},
+	{3039812925825811186, 17464986391666081640, 17552592418423853199, 93235199928093590},
+	{3799766157282263982, 17219546971155214146, 12717368486175040691, 116543999910116988},
+	{6986539866728802893, 8456373847758314889, 17171727340714176240, 72839999943823117},
+	{13344860851838391520, 10570467309697893611, 7629601120610556588, 91049999929778897},
+	{12069390046370601496, 13213084137122367014, 14148687419190583639, 113812499912223621},
+	{5863365521108476062, 11904669152975570864, 3850801218706065837, 142265624890279527},
+	{3664603450692797539, 9746261229823425742, 9324279789332373004, 88916015556424704},
+	{13804126350220772732, 12182826537279282177, 11655349736665466255, 111145019445530880},
+	{3420099882493802203, 10616847153171714818, 14569187170831832819, 138931274306913600},
+	{6749248444986014281, 4329686461518627809, 9105741981769895512, 86832046441821000},
+	{13048246574659905755, 5412108076898284761, 11382177477212369390, 108540058052276250},
+	{2475250163042718482, 15988507132977631760, 5004349809660685929, 135675072565345313},
+	{1547031351901699051, 3075287930469937994, 14656933677106398466, 84796920353340820},
+	{11157161226731899622, 13067481949942198300, 18321167096382998082, 105996150441676025},
+	{13946451533414874527, 7110980400572972067, 9066400815196583891, 132495188052095032},
+	{6410689199170602627, 2138519741144413590, 5666500509497864932, 82809492532559395},
+	{17236733535818029092, 2673149676430516987, 2471439618444943261, 103511865665699244},
+	{16934230901345148461, 7953123113965534138, 3089299523056179076, 129389832082124055},
+	{15195580331768105692, 14194073983083234644, 8848341229551193778, 80868645051327534},
+	{547731341000580499, 8519220441999267498, 1837054500084216415, 101085806314159418},
+	{9908036213105501432, 6037339534071696468, 11519690161960046327, 126357257892699272},
+	{15415894670045714203, 10690866236435892148, 7199806351225028954, 78973286182937045},
+	{823124263847591138, 4140210758690089378, 13611443957458674097, 98716607728671306},
+	{10252277366664264731, 9786949466789999626, 7790932909968566813, 123395759660839133},
+	{11019359372592553360, 8422686425957443718, 7175176077944048210, 77122349788024458},
+	{4550827178885915893, 1304985995592028840, 18192342134284836071, 96402937235030572},
+	{5688533973607394866, 15466290549772199762, 4293683594146493472, 120503671543788216},
+	{8167019751932009695, 9666431593607624851, 2683552246341558420, 75314794714867635},
+	{5597088671487624215, 12083039492009531064, 17189498363209111737, 94143493393584543},
+	{6996360839359530269, 1268741309729750118, 16875186935584001768, 117679366741980679},
+	{18207783579881870130, 792963318581093823, 17464520862381082961, 73549604213737924},
+	{18148043456424949758, 5602890166653755183, 3383907004266802085, 91937005267172406},
+	{18073368302103799294, 11615298726744581883, 13453255792188278414, 114921256583965507},
+	{17980024359202361214, 5295751371575951546, 12204883721807960114, 143651570729956884},
+	{15849201242928863662, 7921530625662357620, 16851424362984750879, 89782231706223052},
+	{1364757479951527962, 5290227263650559122, 2617536380021386983, 112227789632778816},
+	{10929318886794185761, 2001098061135810998, 3271920475026733729, 140284737040973520},
+	{2219138285818978196, 12779901334278351634, 2044950296891708580, 87677960650608450},
+	{11997294894128498553, 15974876667847939542, 11779559907969411533, 109597450813260562},
+	{5773246580805847384, 6133537779527760716, 5501077848106988609, 136996813516575703},
+	{12831651149858430423, 15362676158273320207, 10355702682707949736, 85623008447859814},
+	{11427877918895650125, 756601124132098643, 3721256316530161363, 107028760559824768},
+	{9673161380192174752, 14780809460447287016, 4651570395662701703, 133785950699780960},
+	{6045725862620109220, 16155534940420636241, 2907231497289188564, 83616219187363100},
+	{12168843346702524429, 1747674601816243685, 3634039371611485706, 104520273984203875},
+	{1375996128095991824, 11407965289125080415, 18377607269796520844, 130650342480254843},
+	{7777526607701076746, 16353350342557951067, 9180161534409131575, 81656464050159277},
+	{5110222241198958028, 15830001909770050930, 16086887936438802373, 102070580062699096},
+	{15611149838353473344, 5952444331930399950, 1661865846838951351, 127588225078373871},
+	{5145282630543532936, 10637806735097581825, 7956195181915426450, 79742640673983669},
+	{11043289306606804074, 4073886382017201473, 14556929995821670967, 99678300842479586},
+	{18415797651685892996, 480671959094113937, 8972790457922312901, 124597876053099483},
+	{4592344504662601266, 2606262983647515163, 3302151026987751611, 77873672533187177},
+	{1128744612400863679, 17092886784841557666, 8739374802162077417, 97342090666483971},
+	{10634302802355855407, 7531050425769783370, 6312532484275208868, 121677613333104964},
+	{11258125269899797533, 13930278552960890414, 13168704839526781350, 76048508333190602},
+	{4849284550519971109, 8189476154346337210, 7237509012553700880, 95060635416488253},
+	{15284977725004739694, 10236845192932921512, 13658572284119514004, 118825794270610316},
+	{9553111078127962309, 15621400282437851753, 17759979714429472060, 74266121419131447},
+	{16553074866087340790, 1080006279337763075, 17588288624609452172, 92832651773914309},
+	{16079657564181788083, 1350007849172203844, 8150302725479651503, 116040814717392887},
+	{826413940758841744, 7761283933373709259, 12011468231065864045, 72525509198370554},
+	{14868075481230715892, 14313290935144524477, 5790963251977554248, 90656886497963193},
+	{4750036296256231153, 17891613668930655597, 11850390083399330714, 113321108122453991},
+	{10549231388747676846, 13141145049308543688, 10201301585821775489, 141651385153067489},
+	{6593269617967298028, 1295686628176757949, 17905028537207079441, 88532115720667180},
+	{12853273040886510440, 6231294303648335340, 3934541597799297685, 110665144650833976},
+	{16066591301108138050, 12400803897987807079, 4918176997249122106, 138331430813542470},
+	{16959148590833668137, 12362188454669767328, 16908918678562865028, 86457144258464043},
+	{2752191664832533555, 15452735568337209161, 16524462329776193381, 108071430323080054},
+	{8051925599468054848, 5480861405139347739, 11432205875365465919, 135089287903850068},
+	{2726610490453840328, 10343067405853174193, 16368500708958192007, 84430804939906292},
+	{8019949131494688314, 8317148238889079837, 2013881812488188393, 105538506174882866},
+	{14636622432795748296, 15008121317038737700, 11740724302465011299, 131923132718603582},
+	{18371261057352118493, 7074232813935517110, 2726266670613244158, 82451957949127239},
+	{13740704284835372309, 18066163054274172196, 17242891393548718909, 103064947436409048},
+	{17175880356044215386, 8747645762560551533, 3106870168226347021, 128831184295511311},
+	{13040768231741328568, 7773121610814038660, 8859322882782548744, 80519490184694569},
+	{16300960289676660710, 9716402013517548325, 15685839621905573834, 100649362730868211},
+	{6541142306813662176, 2922130480042159599, 14995613508954579389, 125811703413585264},
+	{11005742969399620716, 4132174559240043701, 9372258443096612118, 78632314633490790},
+	{18368864730176913799, 14388590235904830434, 2491951017015989339, 98290393291863488},
+	{13737708875866366440, 13374051776453650139, 3114938771269986674, 122862991614829360},
+	{6280225038202785073, 12970468378710919241, 1946836732043741671, 76789369759268350},
+	{12461967316180869245, 11601399454961261147, 11656917951909452897, 95986712199085437},
+	{10965773126798698653, 666691263419412722, 736089384604652410, 119983390248856797},
+	{11465294222676574562, 5028368058064520855, 2765898874591601708, 74989618905535498},
+	{9719931759918330298, 6285460072580651069, 12680745630094277943, 93737023631919372},
+	{16761600718325300777, 3245139072298425932, 15850932037617847429, 117171279539899215},
+	{1252628412098537178, 4334054929400210160, 16824361551152236499, 73232049712437009},
+	{1565785515123171472, 805882643322874796, 7195393883658131912, 91540062140546262},
+	{1957231893903964340, 1007353304153593495, 18217614391427440698, 114425077675682827},
+	{16281597922662119137, 10482563667046767676, 18160331970856912968, 143031347094603534},
+	{952626664809048653, 6551602291904229798, 6738521463358182701, 89394591934127209},
+	{10414155367866086624, 12801188883307675151, 13034837847625116280, 111743239917659011},
+	{8406008191405220376, 16001486104134593939, 11681861291104007446, 139679049897073764},
+	{2947912110414568783, 5389242796656733308, 16524535343794780462, 87299406185671102},
+	{3684890138018210979, 15959925532675692443, 11432297142888699769, 109124257732088878},
+	{18441170727804927436, 6114848860562451841, 5066999391756098904, 136405322165111098},
+	{4608202677236997791, 3821780537851532401, 7778560638274949719, 85253326353194436},
+	{10371939364973635143, 165539653887027597, 9723200797843687149, 106566657941493045},
+	{17576610224644431833, 4818610585786172400, 16765687015731996840, 133208322426866306},
+	{10985381390402769895, 3011631616116357750, 15090240403259885929, 83255201516791441},
+	{4508354701148686561, 8376225538572835092, 5027742448792693699, 104069001895989302},
+	{5635443376435858202, 5858595904788655961, 15508050097845642932, 130086252369986627},
+	{15051367156340881136, 12884994477347685783, 7386688301939832880, 81303907731241642},
+	{14202522926998713516, 16106243096684607229, 9988340570015292, 101629884664052053},
+	{3918095603466228183, 1686059797146207421, 4624171444139907020, 127037355830065066},
+	{4754652761380086566, 10277159410071155446, 7501793171014829791, 79398347393790666},
+	{15166687988579884016, 8234763244161556403, 153869426913761431, 99247934242238333},
+	{14346673967297467116, 5681768036774557600, 4804022802069589693, 124059917802797916},
+	{8966671229560916947, 5856948032197792452, 12225886288148269366, 77537448626748697},
+	{11208339036951146184, 16544557077102016373, 1447299804903172995, 96921810783435872},
+	{175365740906769018, 16069010327950132563, 1809124756128966244, 121152263479294840},
+	{16250504652562588300, 819759418114057043, 1130702972580603903, 75720164674559275},
+	{15701444797275847472, 14859757327924735016, 15248436771007918590, 94650205843199093},
+	{1180061922885257724, 9351324623051142963, 5225487908477734526, 118312757303998867},
+	{16878439766299143741, 1232891870979576447, 960086933584890127, 73945473314999292},
+	{16486363689446541772, 15376172894006634271, 1200108666981112658, 92431841643749115},
+	{15996268593380789312, 9996844080653517031, 15335193889008554535, 115539802054686393},
+	{16915196898504075176, 13165556578049530000, 2666967152989264728, 72212376284178996},
+	{2697252049420542354, 16456945722561912501, 3333708941236580910, 90265470355223745},
+	{7983251080203065846, 11347810116347614818, 8778822194973114042, 112831837944029681},
+	{755691813399056500, 4961390608579742715, 15585213762143780457, 141039797430037101},
+	{16613208447870267976, 14630084176430808956, 12046601610553556737, 88149873393773188},
+	{2319766486128283354, 4452547165256347484, 15058252013191945922, 110187341742216485},
+	{2899708107660354193, 14789055993425210163, 4987756961207768690, 137734177177770607},
+	{17953218631783579035, 13854846014318144255, 10034877128395937287, 86083860736106629},
+	{17829837271302085889, 12706871499470292415, 17155282428922309513, 107604825920133286},
+	{17675610570700219458, 2048531319055701807, 12220730999298111084, 134506032400166608},
+	{17964785634328719017, 10503704111264589437, 7637956874561319427, 84066270250104130},
+	{8620923987628735059, 8517944120653348893, 324074056346873476, 105082837812630163},
+	{15387841002963306728, 10647430150816686116, 14240150625715755557, 131353547265787703},
+	{394028589997290897, 8960486853474122775, 15817623168713429079, 82095967041117314},
+	{14327593792778777333, 6588922548415265564, 10548656924037010541, 102619958801396643},
+	{17909492240973471667, 12847839203946469859, 8574135136618875272, 128274948501745804},
+	{8887589641394725840, 8029899502466543662, 14582206497241572853, 80171842813591127},
+	{1886115014888631492, 14649060396510567482, 13616072103124578162, 100214803516988909},
+	{11581015805465565173, 9087953458783433544, 3185032073623558991, 125268504396236137},
+	{7238134878415978233, 12597499939380727821, 13519860092083194129, 78292815247647585},
+	{13659354616447360695, 1911816868943746064, 3064767059821828950, 97866019059559482},
+	{17074193270559200869, 11613143123034458388, 13054330861632061995, 122332523824449352},
+	{1447998757244724735, 4952371442682842541, 8158956788520038747, 76457827390280845},
+	{6421684464983293823, 1578778284926165272, 14810382004077436338, 95572284237851056},
+	{8027105581229117278, 11196844893012482398, 66233431387243806, 119465355297313821},
+	{405254969840810395, 2386342039705413595, 2347238903830721331, 74665847060821138},
+	{14341626767583176706, 16817985604913930705, 12157420666643177471, 93332308826026422},
+	{4091975404196807170, 16410795987715025478, 5973403796449196031, 116665386032533028},
+	{16392542682905168193, 17174276519962972779, 12956749409635523327, 72915866270333142},
+	{15878992335204072338, 16856159631526328070, 6972564725189628351, 91144832837916428},
+	{10625368382150314614, 16458513520980522184, 8715705906487035439, 113931041047395535},
+	{13281710477687893268, 15961455882798264826, 6282946364681406395, 142413801309244419},
+	{12912755066982321196, 7670066917535221564, 1620998468712185045, 89008625818277762},
+	{16140943833727901495, 14199269665346414859, 11249620122745007114, 111260782272847202},
+	{15564493773732488965, 8525715044828242766, 4838653116576483085, 139075977841059003},
+	{5116122590155417699, 7634414912231345681, 718315188646607976, 86922486150661877},
+	{11006839256121660028, 9543018640289182101, 5509580004235647874, 108653107688327346},
+	{18370235088579462939, 2705401263506701818, 16110347042149335651, 135816384610409182},
+	{16093082948789552241, 17831776854187546300, 5457280882915946877, 84885240381505739},
+	{1669609612277388685, 8454663012452269164, 2209915085217545693, 106106550476882174},
+	{2087012015346735857, 15180014783992724359, 11985765893376707924, 132633188096102717},
+	{8221911537232791766, 264137203140676916, 9796946692574136405, 82895742560064198},
+	{10277389421540989708, 4941857522353234049, 3022811328862894698, 103619678200080248},
+	{17458422795353625039, 15400693939796318369, 3778514161078618372, 129524597750100310},
+	{3993985219454933793, 402061675517923173, 16196629405956300195, 80952873593812693},
+	{9604167542746055146, 14337635149679567678, 6410728702163211531, 101191091992265867},
+	{2781837391577793124, 13310357918672071694, 3401724859276626510, 126488864990332334},
+	{15573706425018284414, 3707287680742656904, 15961136092330055281, 79055540618957708},
+	{1020388957563303902, 9245795619355709035, 1504676041703017485, 98819425773697136},
+	{15110544252236293590, 16168930542622024197, 1880845052128771856, 123524282217121420},
+	{11749933166861377445, 10105581589138765123, 10398900194435258218, 77202676385700887},
+	{10075730440149333903, 3408604949568680596, 8386939224616684869, 96503345482126109},
+	{12594663050186667379, 8872442205388238649, 15095360049198243990, 120629181852657636},
+	{954135378725585255, 933590359940261252, 211227993894126686, 75393238657911023},
+	{1192669223406981569, 10390359986780102373, 14099093047649822069, 94241548322388778},
+	{6102522547686114866, 17599636001902515870, 8400494272707501778, 117801935402985973},
+	{17649134647585985503, 15611458519616460322, 7556151929655882563, 73626209626866233},
+	{12838046272627706071, 14902637131093187499, 14056875930497241108, 92032762033582791},
+	{11435871822357244685, 181552340156932758, 12959408894694163482, 115040952541978489},
+	{5071467741091780048, 9450312462050941756, 2364203063085540640, 143801190677473112},
+	{12393039375037138338, 5906445288781838597, 1477626914428462900, 89875744173420695},
+	{1656241163514259210, 7383056610977298247, 15682091698317742337, 112344680216775868},
+	{15905359509674987725, 13840506782149010712, 1155870549187626305, 140430850270969836},
+	{9940849693546867328, 1732787711202049839, 9945791130097042249, 87769281419356147},
+	{7814376098506196256, 6777670657429950203, 7820552894193914907, 109711601774195184},
+	{5156284104705357416, 3860402303360049850, 9775691117742393634, 137139502217743980},
+	{7834363583868236289, 7024437458027419060, 15333178985443771829, 85712188886089987},
+	{9792954479835295361, 13392232840961661729, 14554787713377326882, 107140236107612484},
+	{16852879118221507106, 7516919014347301353, 18193484641721658603, 133925295134515605},
+	{3615520421247360085, 2392231374753369394, 13676770910289730579, 83703309459072253},
+	{13742772563413975914, 16825347273723875454, 3260905582579999511, 104629136823840317},
+	{7955093667412694085, 16419998073727456414, 8687817996652387293, 130786421029800396},
+	{360247523705545899, 12568341805293354211, 14653258284762517866, 81741513143625247},
+	{14285367459914096086, 6487055219761916955, 13704886837525759429, 102176891429531559},
+	{13245023306465232203, 12720505043129784098, 12519422528479811382, 127721114286914449},
+	{12889825584968158031, 3338629633528727157, 907110052658800258, 79825696429321531},
+	{2277223925928033827, 13396659078765684755, 14968945621105664034, 99782120536651913},
+	{16681587962692205996, 7522451811602330135, 4876123971099916331, 124727650670814892},
+	{17343521504323710603, 2395689373037762382, 12270949518792223515, 77954781669259307},
+	{12456029843549862446, 16829669771579366690, 10727000880062891489, 97443477086574134},
+	{6346665267582552250, 7202029159192044651, 4185379063223838554, 121804346358217668},
+	{1660822783025401204, 9112954242922415811, 11839233951369674904, 76127716473886042},
+	{15911086534063915217, 11391192803653019763, 5575670402357317822, 95159645592357553},
+	{15277172149152506117, 5015618967711498896, 11581274021374035182, 118949556990446941},
+	{9548232593220316323, 16969819910101850522, 9544139272572465940, 74343473119029338},
+	{2711918704670619596, 2765530813917761537, 2706802053860806618, 92929341398786673},
+	{8001584399265662399, 12680285554251977729, 7995188585753396176, 116161676748483341},
+	{16530205295609508759, 7925178471407486080, 7302835875309566562, 72601047967802088},
+	{2216012545802334333, 683101052404581793, 9128544844136958203, 90751309959752610},
+	{7381701700680305821, 14688934370787890953, 2187309018316421945, 113439137449690763},
+	{13838813144277770180, 4526109908202699979, 16569194328177691144, 141798921812113453},
+	{6343415205959912410, 2828818692626687487, 12661589464324750917, 88624326132570908},
+	{3317582989022502609, 8147709384210747263, 15826986830405938646, 110780407665713635},
+	{17982036791560291973, 961264693408658270, 15172047519580035404, 138475509582142044},
+	{6627086976297794579, 9824162470235187227, 259157662882746319, 86547193488838778},
+	{3672172701944855320, 7668517069366596130, 9547319115458208707, 108183991861048472},
+	{13813587914285844958, 4973960318280857258, 11934148894322760884, 135229989826310590},
+	{13245178464856041003, 12332097235780311594, 2847157040524337648, 84518743641444119},
+	{7333101044215275445, 15415121544725389493, 17394004355937585772, 105648429551805148},
+	{13778062323696482211, 822157857197185250, 3295761371212430600, 132060536939756436},
+	{13222974970737689286, 513848660748240781, 11283222893862544933, 82537835587347772},
+	{2693660658139947895, 5253996844362688881, 14104028617328181166, 103172294484184715},
+	{7978761841102322773, 15790868092308136909, 13018349753232838553, 128965368105230894},
+	{7292569159902645685, 2951763530051503712, 3524782577343136192, 80603355065769309},
+	{9115711449878307106, 3689704412564379640, 9017664240106308144, 100754193832211636},
+	{11394639312347883883, 4612130515705474550, 11272080300132885180, 125942742290264545},
+	{2509963551790039523, 12105953609170697402, 127521159941971381, 78714213931415341},
+	{12360826476592325212, 1297383956181208040, 4771087468354852131, 98392767414269176},
+	{15451033095740406515, 15456788000508673762, 5963859335443565163, 122990959267836470},
+	{14268581703265141975, 7354649491104227149, 17562470139934391939, 76869349542397793},
+	{4000669073799263757, 4581625845452896033, 8118029619635826212, 96086686927997242},
+	{9612522360676467601, 5727032306816120041, 924164987690006957, 120108358659996553},
+	{17537041521491262010, 5885238200973768977, 12106818163374724108, 75067724162497845},
+	{8086243846581913801, 7356547751217211222, 1298464648936241423, 93834655203122307},
+	{884432771372616444, 4583998670594126124, 15458138866452465491, 117293319003902883},
+	{9776142518962661085, 559156159907634875, 7355493782319096980, 73308324377439302},
+	{7608492130275938452, 698945199884543594, 18417739264753647033, 91635405471799127},
+	{287243125990147258, 5485367518283067397, 18410488062514670887, 114544256839748909},
+	{4970739925915071976, 2245023379426446342, 9178052022861174897, 143180321049686137},
+	{16941770508979083697, 12932354658209998723, 17265497560356704070, 89487700656053835},
+	{16565527117796466717, 6942071285907722596, 16970185932018492184, 111859625820067294},
+	{2260164823536031781, 8677589107384653246, 11989360378168339422, 139824532275084118},
+	{15247661069992183575, 811807173688020374, 2881664217927824235, 87390332671927574},
+	{9836204300635453661, 14849817022392189180, 12825452309264556101, 109237915839909467},
+	{12295255375794317076, 4727213222708072763, 11420129368153307223, 136547394799886834},
+	{5378691600657754220, 9872037291833627333, 11749266873523204918, 85342121749929271},
+	{11335050519249580679, 3116674577937258358, 10074897573476618244, 106677652187411589},
+	{4945441112207200041, 3895843222421572948, 17205307985273160709, 133347065234264486},
+	{12314272731984275834, 4740745023227177044, 6141631472368337539, 83341915771415304},
+	{15392840914980344792, 1314245260606583401, 7677039340460421924, 104177394714269130},
+	{5405993088443267278, 1642806575758229252, 372927138720751597, 130221743392836413},
+	{12602117717131817857, 3332597119062587234, 2538922470914163700, 81388589620522758},
+	{6529275109559996513, 4165746398828234043, 12397025125497480433, 101735737025653447},
+	{3549907868522607738, 9818869016962680458, 10884595388444462637, 127169671282066809},
+	{6830378436254017740, 8442636144815369238, 18332087163846258908, 79481044551291755},
+	{17761345082172297983, 10553295181019211547, 18303422936380435731, 99351305689114694},
+	{17589995334287984575, 8579932957846626530, 13655906633620768856, 124189132111393368},
+	{15605433102357378263, 5362458098654141581, 8534941646012980535, 77618207569620855},
+	{5671733322664559117, 2091386604890289073, 6056991039088837765, 97022759462026069},
+	{11701352671758086800, 7225919274540249245, 12182924817288435110, 121278449327532586},
+	{9619188429062498202, 18351257601869819490, 12226014029232659847, 75799030829707866},
+	{2800613499473346945, 18327385983909886459, 6059145499686049001, 94748788537134833},
+	{17335824929623847393, 9074174424605194361, 12185617893034949156, 118435985671418541},
+	{3917361553373822764, 14894731052233022284, 9921854192360537174, 74022491044636588},
+	{4896701941717278455, 9395041778436502047, 12402317740450671468, 92528113805795735},
+	{1509191408719210165, 11743802223045627559, 10891211157135951431, 115660142257244669},
+	{7860773658090588209, 14257405417044599080, 9112849982423663596, 72287588910777918},
+	{9825967072613235262, 17821756771305748850, 2167690441174803687, 90359486138472398},
+	{3059086803911768269, 17665509945704798159, 11932985088323280417, 112949357673090497},
+	{17658916560171874049, 8246829376848833986, 1081173305121936810, 141186697091363122},
+	{15648508868534809184, 9765954378957909145, 5287419334128598410, 88241685682101951},
+	{5725578030386347768, 2984070936842610624, 1997588149233360109, 110302107102627439},
+	{7156972537982934711, 8341774689480651184, 16332043241823863848, 137877633878284298},
+	{4473107836239334194, 5213609180925406990, 14819213044567302809, 86173521173927686},
+	{14814756832153943551, 11128697494584146641, 9300644268854352703, 107716901467409608},
+	{4683387984910265726, 9299185849802795398, 11625805336067940879, 134646126834262010},
+	{16762175545851079791, 12729520183767828979, 11877814353469850953, 84153829271413756},
+	{16341033413886461835, 2076842174427622512, 14847267941837313692, 105192286589267195},
+	{1979547693648525677, 2596052718034528141, 13947398908869254211, 131490358236583994},
+	{3543060317744022500, 17763434013267437752, 13328810336470671785, 82181473897864996},
+	{4428825397180028125, 8369234461302133478, 16661012920588339732, 102726842372331245},
+	{14759403783329810965, 10461543076627666847, 6991208095453260953, 128408552965414057},
+	{16142156392222213709, 18067679468960761539, 15898720105726757855, 80255345603383785},
+	{15566009471850379232, 17972913317773564020, 6038342076876283607, 100319182004229732},
+	{1010767766103422424, 17854455628789567122, 7547927596095354509, 125398977505287165},
+	{5243415872242026919, 13464877777207173403, 7023297756773290520, 78374360940804478},
+	{1942583821875145745, 16831097221508966754, 18002494232821388958, 97967951176005597},
+	{11651601814198707989, 11815499490031432634, 8668059735744572486, 122459938970006997},
+	{11893937152301580397, 2773001162842257492, 7723380344054051756, 76537461856254373},
+	{14867421440376975496, 3466251453552821865, 14265911448494952599, 95671827320317966},
+	{4749218745189055659, 18167872372223191044, 8609017273763914940, 119589784150397458},
+	{12191633752597935595, 2131548195784718594, 9992321814529834742, 74743615093998411},
+	{6016170153892643685, 11887807281585674051, 7878716249734905523, 93429518867498014},
+	{2908526673938416703, 10248073083554704660, 625023275313856096, 116786898584372518},
+	{11041201208066286247, 6405045677221690412, 14225697602353323772, 72991811615232823},
+	{13801501510082857809, 8006307096527113015, 13170435984514266811, 91239764519041029},
+	{12640190869176184357, 5396197852231503365, 2627986925360669802, 114049705648801287},
+	{1965180531188066735, 15968619352144155015, 17120041711983000964, 142562132061001608},
+	{8145766859633623565, 757015058235321076, 10700026069989375603, 89101332538126005},
+	{10182208574542029456, 14781326878076315057, 17986718605914107407, 111376665672657506},
+	{17339446736604924725, 13864972579168005917, 13260026220537858451, 139220832090821883},
+	{13142997219591771905, 6359764852766309746, 5981673378622467580, 87013020056763677},
+	{7205374487634939073, 7949706065957887183, 12088777741705472379, 108766275070954596},
+	{4395032091116285937, 5325446564019971075, 15110972177131840474, 135957843838693245},
+	{441052047733984759, 7940090120939869826, 11750200619921094248, 84973652399183278},
+	{9774687096522256756, 9925112651174837282, 5464378738046592002, 106217065498979098},
+	{2994986833798045138, 3183018777113770795, 16053845459413015811, 132771331873723872},
+	{18012767835619635875, 18130287800191964410, 10033653412133134881, 82982082421077420},
+	{13292587757669769036, 8827801694957791801, 12542066765166418602, 103727603026346775},
+	{2780676641805047583, 1811380081842463944, 11065897438030635349, 129659503782933469},
+	{1737922901128154739, 3437955560365233917, 9222028907982841045, 81037189864333418},
+	{6784089644837581328, 8909130468883930300, 2304164098123775498, 101296487330416773},
+	{8480112056046976660, 1913041049250137067, 7491891141082107277, 126620609163020966},
+	{2994227025815666460, 3501493664995029619, 70745944748929144, 79137880726888104},
+	{17577841837551746787, 4376867081243787023, 88432430936161430, 98922350908610130},
+	{17360616278512295580, 14694455888409509587, 9333912575524977595, 123652938635762662},
+	{8544542164856490786, 6878191921042249540, 1222009341275723093, 77283086647351664},
+	{10680677706070613482, 13209425919730199829, 1527511676594653866, 96603858309189580},
+	{17962533151015654757, 7288410362807973978, 1909389595743317333, 120754822886486975},
+	{15838269237812172127, 6861099485968677688, 8110897524980655189, 75471764304054359},
+	{1351092473555663543, 13188060375888235015, 5526935887798431082, 94339705380067949},
+	{15523923647226743140, 7261703433005517960, 11520355878175426757, 117924631725084936},
+	{9702452279516714463, 6844407654842142677, 7200222423859641723, 73702894828178085},
+	{16739751367823280982, 3943823550125290442, 13611964048251940058, 92128618535222606},
+	{11701317172924325420, 14153151474511388861, 7791583023460149264, 115160773169028258},
+	{791588410873243063, 17691439343139236077, 516106742470410772, 143950966461285323},
+	{2800585766009470866, 1833777552607246740, 16463467778539864397, 89969354038303326},
+	{3500732207511838583, 6903907959186446329, 11355962686320054688, 112461692547879158},
+	{8987601277817186133, 8629884948983057911, 4971581321045292552, 140577115684848948},
+	{12534779826276823189, 5393678093114411194, 12330610362508083653, 87860697303030592},
+	{6445102745991253178, 11353783634820401897, 15413262953135104566, 109825871628788240},
+	{12668064450916454377, 4968857506670726563, 819834617709329092, 137282339535985301},
+	{5611697272609090033, 12328907978523979910, 2818239645282024634, 85801462209990813},
+	{16237993627616138350, 6187762936300199079, 8134485575029918697, 107251827762488516},
+	{15685806016092785033, 12346389688802636753, 10168106968787398371, 134064784703110645},
+	{2886099732416908790, 5410650546287954019, 8660909864705817934, 83790490439444153},
+	{17442682720803299699, 15986685219714718331, 15437823349309660321, 104738113049305191},
+	{17191667382576736720, 6148298469361234202, 14685593168209687498, 130922641311631489},
+	{15356478132537848354, 8454372561778159280, 2260966702489972830, 81826650819769681},
+	{748853591962758827, 1344593665367923293, 7437894396539853942, 102283313524712101},
+	{5547753008380836437, 10904114118564679924, 13909054014102205331, 127854141905890126},
+	{12690717667092798581, 4509228314889231000, 4081472740386490428, 79908838691181329},
+	{15863397083865998227, 5636535393611538750, 9713526943910500939, 99886048363976661},
+	{10605874317977721975, 2433983223587035534, 16753594698315514078, 124857560454970826},
+	{2016985430308688330, 15356297570024060921, 15082682704874584202, 78035975284356766},
+	{7132917806313248317, 9971999925675300343, 9629981344238454445, 97544969105445958},
+	{4304461239464172492, 17076685925521513333, 2814104643443292248, 121931211381807448},
+	{4996131283878801760, 10672928703450945833, 1758815402152057655, 76207007113629655},
+	{10856850123275890104, 8729474860886294387, 16033577307972235781, 95258758892037068},
+	{8959376635667474726, 15523529594535255888, 1595227561255743110, 119073448615046336},
+	{5599610397292171703, 5090519978157147026, 997017225784839444, 74420905384403960},
+	{16222885033469990437, 6363149972696433782, 1246271532231049305, 93026131730504950},
+	{11055234254982712239, 12565623484297930132, 10781211452143587439, 116282664663131187},
+	{16132893446218970957, 14771043705327288188, 4432414148376048197, 72676665414456992},
+	{1719372734064162080, 4628746576376946524, 5540517685470060247, 90845831768071240},
+	{2149215917580202601, 1174247202043795251, 6925647106837575309, 113557289710089050},
+	{16521577952257416963, 6079495020982131967, 17880430920401744944, 141946612137611312},
+	{17243515247801967458, 3799684388113832479, 11175269325251090590, 88716632586007070},
+	{16942708041325071418, 13972977521997066407, 4745714619709087429, 110895790732508838},
+	{16566699033228951369, 3631163847214169297, 15155515311491135095, 138619738415636047},
+	{3436657868127012749, 9187006432149937667, 16389726097323041290, 86637336509772529},
+	{18130880390440929649, 2260386003332646275, 6652099566371637901, 108296670637215662},
+	{18051914469623774157, 7437168522593195748, 17538496494819323184, 135370838296519577},
+	{2059074506660083040, 4648230326620747343, 4044031281620995134, 84606773935324736},
+	{16408901188607267512, 15033659945130709986, 5055039102026243917, 105758467419155920},
+	{11287754448904308582, 4957016876131223771, 6318798877532804897, 132198084273944900},
+	{4749003521351498912, 14627350593650484617, 13172621335312778868, 82623802671215562},
+	{10547940420116761544, 18284188242063105771, 7242404632286197777, 103279753339019453},
+	{8573239506718564026, 9020177247296718502, 13664691808785135126, 129099691673774316},
+	{746588673271714612, 1025924761133061160, 17763804417345485262, 80687307296108947},
+	{933235841589643265, 10505777988271102258, 17593069503254468673, 100859134120136184},
+	{10389916838841829890, 17743908503766265726, 3544592805358534225, 126073917650170231},
+	{1882012005848755777, 4172413787212834223, 9132899530990165747, 78796198531356394},
+	{16187573062593108433, 603831215588654874, 2192752376882931376, 98495248164195493},
+	{11011094291386609734, 754789019485818593, 7352626489531052124, 123119060205244366},
+	{18411148978185100843, 9695115174033412428, 18430449611239071289, 76949412628277728},
+	{4567192149021824438, 16730579985969153440, 4591317940339287495, 96186765785347161},
+	{5708990186277280548, 16301538964034053896, 10350833443851497273, 120233457231683951},
+	{3568118866423300342, 3270932824880201829, 13386799930048267652, 75145910769802469},
+	{9071834601456513332, 4088666031100252286, 2898441857278170853, 93932388462253087},
+	{2116421214965865857, 9722518557302703262, 17458110376879877278, 117415485577816358},
+	{15157821314635829872, 1464888079886801634, 6299632967122535395, 73384678486135224},
+	{9723904606440011533, 15666168155140665755, 7874541208903169243, 91730848107669030},
+	{7543194739622626512, 14971024175498444290, 619804474274185746, 114663560134586288},
+	{205621387673507332, 9490408182518279555, 774755592842732183, 143329450168232860},
+	{16269414431791799746, 12849034141715006577, 9707594282381483422, 89580906355145537},
+	{6501709984457585971, 6837920640288982414, 16746178871404242182, 111976132943931921},
+	{17350509517426758272, 17770772837216003825, 7097665533973139015, 139970166179914902},
+	{3926539420750642064, 18024262050901084247, 18271099014015375596, 87481353862446813},
+	{296488257510914676, 4083583489916803693, 9003815712237055784, 109351692328058517},
+	{4982296340316031249, 5104479362396004616, 15866455658723707634, 136689615410073146},
+	{3113935212697519530, 7801985619924890789, 14528220805129705175, 85431009631295716},
+	{8504105034299287317, 5140796006478725582, 18160276006412131469, 106788762039119645},
+	{1406759256019333339, 11037681026525794882, 8865286952733000624, 133485952548899557},
+	{5490910553439471240, 6898550641578621801, 7846647354671819342, 83428720343062223},
+	{11475324210226726955, 17846560338828053059, 5196623174912386273, 104285900428827779},
+	{9732469244356020789, 8473142368252902612, 1884092950213094938, 130357375536034724},
+	{15306165314577288801, 9907399998585452036, 10400930130737960144, 81473359710021702},
+	{685962569512059386, 12384249998231815046, 3777790626567674372, 101841699637527128},
+	{10080825248744850040, 15480312497789768807, 4722238283209592965, 127302124546908910},
+	{13218044808106613131, 11981038320332299456, 16786456982288159315, 79563827841818068},
+	{16522556010133266414, 10364611881987986416, 2536327154150647528, 99454784802272586},
+	{2206450938957031401, 12955764852484983021, 12393780979543085218, 124318481002840732},
+	{3684874846061838578, 12709039051230502292, 16969485149069204069, 77699050626775457},
+	{4606093557577298222, 2051240758755964153, 7376798381054341375, 97123813283469322},
+	{10369302965399010682, 16399109003727118903, 18444370013172702526, 121404766604336652},
+	{13398343381015463532, 5637757108902061410, 2304359221378163271, 75877979127710408},
+	{7524557189414553607, 2435510367700188859, 2880449026722704089, 94847473909638010},
+	{4794010468340804105, 7656073978052623978, 12823933320258155919, 118559342387047512},
+	{7607942561140390469, 11702575263923971842, 8014958325161347449, 74099588991904695},
+	{286556164570712279, 793161024622801091, 5407011888024296408, 92624486239880869},
+	{14193253260995554061, 991451280778501363, 11370450878457758414, 115780607799851086},
+	{6564940278908527336, 14454715105768727064, 2494845780608711104, 72362879874906929},
+	{8206175348635659170, 18068393882210908830, 7730243244188276784, 90453599843633661},
+	{1034347148939798154, 4138748279054084422, 14274490073662733885, 113066999804542076},
+	{10516305973029523501, 9785121367244993431, 17843112592078417356, 141333749755677595},
+	{13490220260784534044, 15339072891382896702, 8846102360835316895, 88333593597298497},
+	{7639403289125891747, 14562155095801232974, 15669313969471534023, 110416991996623121},
+	{325882074552588876, 13591007851324153314, 5751584406557253817, 138021239995778902},
+	{4815362315022755951, 1576850879436513965, 17429798309380447348, 86263274997361813},
+	{10630888912205832843, 1971063599295642456, 7952189831443395473, 107829093746702267},
+	{13288611140257291054, 7075515517546940974, 5328551270876856437, 134786367183377834},
+	{3693695944233419005, 6728040207680532061, 7942030562725423177, 84241479489611146},
+	{9228805948719161660, 13021736278028052980, 704166166552003163, 105301849362013933},
+	{11536007435898952075, 11665484329107678321, 5491893726617391858, 131627311702517416},
+	{292475619795763191, 11902613724119686855, 3432433579135869911, 82267069814073385},
+	{14200652580026867701, 10266581136722220664, 8902227992347225293, 102833837267591731},
+	{17750815725033584626, 17444912439330163734, 6516098972006643712, 128542296584489664},
+	{6482573809718602487, 10903070274581352334, 4072561857504152320, 80338935365306040},
+	{17326589299003028917, 13628837843226690417, 5090702321880190400, 100423669206632550},
+	{7823178568471622434, 17036047304033363022, 15586749939205013808, 125529586508290687},
+	{277800586867376117, 10647529565020851889, 16659247739644215486, 78455991567681679},
+	{4958936752011608051, 4086039919421289053, 16212373656127881454, 98069989459602099},
+	{10810356958441897967, 14330921936131387124, 15653781051732463913, 122587486824502624},
+	{15979845135880962037, 2039297182441035096, 9783613157332789946, 76617179265314140},
+	{1528062346141650931, 11772493514906069679, 12229516446665987432, 95771474081642675},
+	{15745135987959227376, 14715616893632587098, 10675209539905096386, 119714342602053344},
+	{14452396010901905014, 13808946576947754840, 6672005962440685241, 74821464126283340},
+	{18065495013627381267, 3426125165902529838, 8340007453050856552, 93526830157854175},
+	{13358496730179450776, 4282656457378162298, 5813323297886182786, 116908537697317719},
+	{12960746474789544639, 7288346304288739340, 10550856088819946097, 73067836060823574},
+	{16200933093486930799, 13722118898788312079, 3965198074170156813, 91334795076029468},
+	{15639480348431275595, 3317590568203226387, 4956497592712696017, 114168493845036835},
+	{14937664417111706589, 8758674228681420888, 1583935972463482117, 142710617306296044},
+	{9336040260694816618, 7780014402139582007, 10213332019644452131, 89194135816435027},
+	{7058364307441132869, 5113331984247089605, 8154979006128177260, 111492669770543784},
+	{13434641402728803990, 6391664980308862006, 10193723757660221575, 139365837213179730},
+	{3784964858278114590, 10912319640334120610, 10982763366965026388, 87103648258237331},
+	{13954578109702419045, 13640399550417650762, 9116768190278895081, 108879560322796664},
+	{8219850600273247999, 3215441382739899741, 11395960237848618852, 136099450403495830},
+	{7443249634384473951, 11233022901067213146, 2510789130227998878, 85062156502184894},
+	{80690006125816631, 4817906589479240625, 12361858449639774406, 106327695627731117},
+	{4712548526084658693, 15245755273703826589, 1617265006767554295, 132909619534663897},
+	{5251185838016605635, 16446126073705973474, 12540005675298191194, 83068512209164935},
+	{15787354334375532852, 11334285555277691034, 11063321075695351089, 103835640261456169},
+	{10510820881114640257, 332798888814950081, 18440837363046576766, 129794550326820211},
+	{18098478096765119920, 14043057360791507512, 9219680342690416526, 81121593954262632},
+	{4176353547246848284, 8330449664134608583, 11524600428363020658, 101401992442828290},
+	{608755915631172452, 1189690043313484921, 5182378498599000015, 126752490553535363},
+	{11909687493337952542, 7661085304712009931, 933143552410681057, 79220306595959602},
+	{10275423348245052774, 14188042649317400318, 10389801477368127129, 99025383244949502},
+	{3620907148451540159, 3899995256364586686, 3763879809855383104, 123781729056186878},
+	{16098125023064376311, 2437497035227866678, 16187482936441778152, 77363580660116798},
+	{10899284241975694581, 3046871294034833348, 11010981633697446882, 96704475825145998},
+	{13624105302469618227, 13031961154398317493, 4540355005267032794, 120880594781432498},
+	{10820908823257205344, 12756661739926336337, 7449407896719283400, 75550371738395311},
+	{18137822047498894584, 15945827174907920421, 4700073852471716346, 94437964672994139},
+	{8837219504091454518, 10708911931780124719, 1263406297162257529, 118047455841242674},
+	{12440791217698240929, 18222285003431047709, 5401314954153798859, 73779659900776671},
+	{1715930966840637450, 18166170235861421733, 2139957674264860670, 92224574875970839},
+	{6756599726978184716, 13484340757972001358, 16510005148113239550, 115280718594963548},
+	{17669121695577506703, 7632053910610225889, 2190762361431997822, 144100898243704436},
+	{4125672032094859833, 158347675704003277, 10592598512749774447, 90063061402315272},
+	{9768776058545962696, 14032992649912167808, 13240748140937218058, 112578826752894090},
+	{12210970073182453370, 8317868775535433952, 7327563139316746765, 140723533441117613},
+	{7631856295739033356, 7504510993923340172, 6885569971286660680, 87952208400698508},
+	{9539820369673791695, 9380638742404175215, 8606962464108325850, 109940260500873135},
+	{7313089443664851715, 2502426391150443211, 6147017061708019409, 137425325626091419},
+	{2264837893076838370, 13093231540537496767, 1536042654353818178, 85890828516307137},
+	{16666105421628211674, 7143167388817095150, 6531739336369660627, 107363535645383921},
+	{11609259740180488785, 4317273217593981034, 12776360188889463688, 134204419556729901},
+	{11867473356040193395, 2698295760996238146, 10291068127269608757, 83877762222956188},
+	{5610969658195465935, 7984555719672685587, 12863835159087010946, 104847202778695235},
+	{2402026054316944515, 757322612736081176, 11468107930431375779, 131059003473369044},
+	{1501266283948090322, 16614227697455908399, 16390939493374385669, 81911877170855652},
+	{15711640910217276614, 6932726566537721786, 2041930293008430471, 102389846463569566},
+	{10416179100916819960, 4054222189744764329, 11775784903115313897, 127987308079461957},
+	{18039326984141482235, 14063103914658947465, 9665708573660765137, 79992067549663723},
+	{8714100674894689082, 3743821838041520620, 7470449698648568518, 99990084437079654},
+	{10892625843618361352, 13903149334406676583, 114690086455934839, 124987605546349568},
+	{13725420179902557701, 15606997361645254720, 71681304034959274, 78117253466468480},
+	{17156775224878197126, 10285374665201792592, 89601630043699093, 97646566833085600},
+	{2999224957388194792, 17468404349929628645, 112002037554623866, 122058208541357000},
+	{4180358607581315697, 15529438737133405807, 70001273471639916, 76286380338348125},
+	{613762241049256717, 965054347707205643, 4699187610266937800, 95357975422935156},
+	{14602260856593734609, 1206317934634007053, 5873984512833672250, 119197469278668945},
+	{11432256044584778082, 5365634727573642312, 15200455366589514916, 74498418299168090},
+	{14290320055730972603, 6707043409467052890, 9777197171382117837, 93123022873960113},
+	{8639528032808939946, 12995490280261204017, 16833182482655035200, 116403778592450141},
+	{16928920066574057226, 8122181425163252510, 12826582060873090952, 72752361620281338},
+	{11937778046362795724, 10152726781454065638, 6809855539236587882, 90940452025351673},
+	{5698850521098718848, 3467536439962806240, 13124005442473122757, 113675565031689591},
+	{7123563151373398560, 8946106568380895704, 11793320784664015542, 142094456289611989},
+	{4452226969608374100, 979630586810671911, 9676668499628703666, 88809035181007493},
+	{953597693583079721, 10447910270368115697, 16707521642963267486, 111011293976259366},
+	{5803683135406237555, 3836515801105368813, 11661030016849308550, 138764117470324208},
+	{5933144968842592424, 16232880430973019220, 7288143760530817843, 86727573418952630},
+	{7416431211053240530, 15679414520288886121, 18333551737518298112, 108409466773690787},
+	{13882225032243938566, 1152524076651556035, 18305253653470484737, 135511833467113484},
+	{6370547635938767652, 12249542593975692282, 2217411496564277152, 84694895916945928},
+	{17186556581778235373, 15311928242469615352, 2771764370705346440, 105868619896182410},
+	{3036451653513242600, 693166229377467575, 12688077500236458859, 132335774870228012},
+	{8815311311086858481, 16574129957856774898, 17153420474502562594, 82709859293892507},
+	{1795767102003797293, 11494290410466192815, 16830089574700815339, 103387324117365634},
+	{16079766932786910329, 9756176994655353114, 11814239931521243366, 129234155146707043},
+	{14661540351419206859, 1485924603232207792, 5078056947987083152, 80771346966691902},
+	{18326925439274008574, 1857405754040259740, 15570943221838629748, 100964183708364877},
+	{4461912725382959102, 2321757192550324676, 5628620972016123473, 126205229635456097},
+	{12012067490219125246, 12980313291412422682, 15047103153578546930, 78878268522160060},
+	{5791712325919130750, 7002019577410752545, 362134868263632047, 98597835652700076},
+	{11851326425826301342, 4140838453336052777, 452668585329540059, 123247294565875095},
+	{489549988500356483, 282181024121339034, 7200446893472044393, 77029559103671934},
+	{9835309522480221411, 4964412298579061696, 18223930653694831299, 96286948879589917},
+	{12294136903100276764, 1593829354796439216, 8944855261836375412, 120358686099487397},
+	{7683835564437672977, 10219515383602550318, 7896377547861428584, 75224178812179623},
+	{381422418692315414, 12774394229503187898, 5258785916399397826, 94030223515224529},
+	{9700150060220170076, 6744620750024209064, 11185168413926635187, 117537779394030661},
+	{6062593787637606297, 1909544959551436713, 9296573267917840944, 73461112121269163},
+	{12189928252974395775, 2386931199439295891, 7009030566469913276, 91826390151586454},
+	{10625724297790606815, 2983663999299119864, 17984660244942167403, 114782987689483067},
+	{13282155372238258519, 17564638054406063542, 17869139287750321349, 143478734611853834},
+	{3689661089221523670, 13283741793217483666, 15779898073271338747, 89674209132408646},
+	{13835448398381680396, 11992991223094466678, 10501500554734397626, 112092761415510808},
+	{8070938461122324687, 5767866992013307540, 13126875693417997033, 140115951769388510},
+	{14267708575056228737, 15134131916076786972, 3592611289958860241, 87572469855867819},
+	{17834635718820285922, 5082606839813820003, 18325822167730739014, 109465587319834773},
+	{17681608630097969498, 15576630586622050812, 9072219654381260055, 136831984149793467},
+	{1827633356956455128, 16652923144279863614, 3364294274774593582, 85519990093620917},
+	{11507913733050344719, 11592781893495053709, 8817053861895629882, 106899987617026146},
+	{549834111030767186, 5267605330014041329, 1797945290514761545, 133624984521282683},
+	{11872861365462699251, 14821468377327245590, 17264616871067583629, 83515615325801676},
+	{5617704669973598256, 4691777416376893276, 3134027015124927921, 104394519157252096},
+	{7022130837466997820, 10476407788898504499, 3917533768906159901, 130493148946565120},
+	{2082988764203179686, 8853597877275259264, 2448458605566349938, 81558218091603200},
+	{2603735955253974607, 1843625309739298272, 3060573256957937423, 101947772614504000},
+	{3254669944067468259, 16139589692456286552, 3825716571197421778, 127434715768130000},
+	{2034168715042167662, 14698929576212566999, 2391072856998388611, 79646697355081250},
+	{16377768949084873289, 13761975951838320844, 12212213108102761572, 99558371693851562},
+	{2025467112646539996, 17202469939797901056, 6041894348273676157, 124447964617314453},
+	{1265916945404087497, 13057386721587382112, 6082026976884741550, 77779977885821533},
+	{1582396181755109371, 7098361365129451832, 12214219739533314842, 97224972357276916},
+	{1977995227193886714, 18096323743266590598, 15267774674416643552, 121531215446596145},
+	{15071305072278342908, 11310202339541619123, 2624830143869320364, 75957009654122591},
+	{14227445321920540732, 14137752924427023904, 17116095735118814167, 94946262067653238},
+	{17784306652400675915, 13060505137106391976, 12171747632043741901, 118682827584566548},
+	{11115191657750422446, 10468658719905188937, 16830714306882114496, 74176767240354092},
+	{58931516905864346, 13085823399881486172, 2591648809893091504, 92720959050442616},
+	{73664396132330433, 16357279249851857715, 3239561012366364380, 115901198813053270},
+	{16186941312078564184, 999927494302635263, 15859783688011141450, 72438249258158293},
+	{15621990621670817327, 10473281404733069887, 5989671554731763100, 90547811572697867},
+	{14915802258661133754, 13091601755916337359, 2875403424987315971, 113184764465872334},
+	{14033066804899029289, 11752816176468033795, 12817626318088920772, 141480955582340417},
+	{6464823743848199353, 16568882147147296930, 1093487421164493626, 88425597238962761},
+	{17304401716665025000, 11487730647079345354, 5978545294883004937, 110531996548703451},
+	{12407130108976505442, 524605253567017981, 2861495600176368268, 138164995685879314},
+	{10060299327324009853, 9551250320334162046, 6400120768537618071, 86353122303674571},
+	{3352002122300236508, 7327376881990314654, 3388464942244634685, 107941402879593214},
+	{13413374689730071444, 13770907120915281221, 13458953214660569164, 134926753599491517},
+	{10689202190294988604, 17830188987426826571, 10717688768376549679, 84329220999682198},
+	{8749816719441347851, 17676050215856145310, 4173738923615911291, 105411526249602748},
+	{1713898862446909006, 17483376751392793734, 5217173654519889114, 131764407812003435},
+	{14906244844311481841, 15538796488047883987, 954890524861236744, 82352754882502147},
+	{14021120036961964397, 976751536350303368, 15028671211358709643, 102940943603127683},
+	{17526400046202455496, 15055997475720042922, 14174152995770999149, 128676179503909604},
+	{15565686047303922589, 11715841431538720778, 18082217659211650276, 80422612189943502},
+	{10233735522275127428, 14644801789423400973, 13379400037159787037, 100528265237429378},
+	{17403855421271297190, 4470944181497087504, 7500878009594957989, 125660331546786723},
+	{10877409638294560743, 5100183122649373642, 2382205746783154791, 78537707216741702},
+	{4373390011013425121, 1763542884884329149, 12201129220333719297, 98172134020927127},
+	{10078423532194169306, 6816114624532799340, 10639725506989761217, 122715167526158909},
+	{15522386744476131624, 15789286686401469347, 8955671451082294712, 76696979703849318},
+	{14791297412167776626, 1289864284292285068, 1971217276998092583, 95871224629811648},
+	{42377691500169167, 15447388410647520048, 2464021596247615728, 119839030787264560},
+	{26486057187605729, 9654617756654700030, 1540013497654759830, 74899394242040350},
+	{9256479608339282969, 2844900158963599229, 11148388908923225596, 93624242802550437},
+	{16182285528851491616, 3556125198704499036, 100428080871868283, 117030303503188047},
+	{890556418677406452, 18363479313686169562, 6980296578185999532, 73143939689492529},
+	{10336567560201533873, 4507605068398160336, 13337056741159887320, 91429924611865661},
+	{12920709450251917341, 5634506335497700420, 2836262871167695438, 114287405764832077},
+	{16150886812814896676, 16266504956226901333, 8157014607387007201, 142859257206040096},
+	{12400147267223004375, 3249036570000731477, 5098134129616879501, 89287035753775060},
+	{1665126028746591756, 8672981730928302251, 6372667662021099376, 111608794692218825},
+	{15916465591215403408, 10841227163660377813, 12577520595953762124, 139510993365273531},
+	{12253634003723321082, 15999139014142511941, 5555107363257407375, 87194370853295957},
+	{1481984449371987640, 15387237749250752023, 11555570222499147123, 108992963566619946},
+	{15687538616997148262, 14622361168136052124, 5221090741269158096, 136241204458274933},
+	{581339598768441856, 9138975730085032578, 5569024722506917762, 85150752786421833},
+	{9950046535315328128, 2200347625751514914, 11572966921561035107, 106438440983027291},
+	{3214186132289384352, 16585492587471557355, 9854522633523905979, 133048051228784114},
+	{18149767397176722884, 8060089857956029394, 10770762664379829141, 83155032017990071},
+	{13463837209616127797, 14686798340872424647, 8851767312047398522, 103943790022487589},
+	{12218110493592771842, 9135125889235755001, 15676395158486636057, 129929737528109486},
+	{718790030854400545, 17238668726840816636, 5186060955626759631, 81206085955068429},
+	{898487538568000681, 16936649890123632891, 11094262212960837443, 101507607443835536},
+	{14958167478492164564, 16559126344227153209, 13867827766201046804, 126884509304794420},
+	{2431325646416520996, 1126081928287194948, 17890764390730430061, 79302818315496512},
+	{3039157058020651245, 6019288428786381589, 3916711414703485960, 99128522894370641},
+	{8410632340953201961, 7524110535982976986, 9507575286806745354, 123910653617963301},
+	{9868331231523139129, 9314255103416748520, 8248077563467909798, 77444158511227063},
+	{12335414039403923912, 2419446842416159842, 5698410935907499344, 96805198139033829},
+	{6195895512400129082, 3024308553020199803, 11734699688311762084, 121006497673792286},
+	{1566591686036386724, 11113564882492400685, 2722501286767463398, 75629061046120179},
+	{6569925625972871309, 4668584066260725048, 17238184663741492960, 94536326307650223},
+	{8212407032466089137, 5835730082825906310, 16936044811249478296, 118170407884562779},
+	{521068376863917806, 3647331301766191444, 8279184997817229983, 73856504927851737},
+	{651335471079897258, 18394222182489903017, 14960667265698925382, 92320631159814671},
+	{5425855357277259476, 13769405691257602963, 14089148063696268824, 115400788949768339},
+	{1085316589084593221, 8605878557036001852, 6499874530596474063, 72125493093605212},
+	{1356645736355741526, 6145662177867614411, 8124843163245592579, 90156866367006515},
+	{15530865225726840619, 3070391703907130109, 5544367935629602820, 112696082958758144},
+	{5578523476876387062, 3837989629883912637, 6930459919537003525, 140870103698447680},
+	{5792420182261435866, 4704586527891139350, 4331537449710627203, 88043814811529800},
+	{16463897264681570640, 1269047141436536283, 5414421812138284004, 110054768514412250},
+	{15968185562424575397, 1586308926795670354, 15991399302027630813, 137568460643015312},
+	{14591801994942747527, 3297286088460987923, 9994624563767269258, 85980287901884570},
+	{13628066475251046505, 13344979647431010712, 3269908667854310764, 107475359877355713},
+	{17035083094063808131, 16681224559288763390, 8699071853245276359, 134344199846694641},
+	{6035240915362492178, 17343294377196558975, 16966134954346767484, 83965124904184150},
+	{2932365125775727318, 3232373897786147103, 11984296656078683548, 104956406130230188},
+	{17500514462501822860, 4040467372232683878, 14980370820098354435, 131195507662787735},
+	{6326135520636251383, 219449098431733472, 16280260790202553378, 81997192289242334},
+	{7907669400795314229, 9497683409894442648, 11126953950898415914, 102496490361552918},
+	{9884586750994142786, 2648732225513277502, 4685320401768244085, 128120612951941148},
+	{1566180700943951337, 3961300650159492391, 12151697287959928361, 80075383094963217},
+	{15792783931462102884, 9563311831126753392, 1354563554667746739, 100094228868704022},
+	{1294235840618076989, 7342453770481053837, 10916576480189459232, 125117786085880027},
+	{3114740409599992070, 4589033606550658648, 4517017290904718068, 78198616303675017},
+	{3893425511999990087, 5736292008188323310, 10257957632058285489, 97748270379593771},
+	{14090153926854763417, 11782051028662792041, 8210761021645468957, 122185337974492214},
+	{1888817176643145280, 9669624902127938978, 520039620101030194, 76365836234057634},
+	{11584393507658707408, 2863659090805147914, 9873421561981063551, 95457295292572042},
+	{5257119847718608452, 17414631918788598605, 3118404915621553630, 119321619115715053},
+	{5591542914037824234, 6272458930815486224, 4254846081477164971, 74576011947321908},
+	{6989428642547280293, 3228887645091969876, 5318557601846456214, 93220014934152385},
+	{8736785803184100366, 13259481593219738153, 11259883020735458171, 116525018667690481},
+	{16989706173058532489, 5981332986548642393, 119897860318579501, 72828136667306551},
+	{7402074661041001899, 12088352251613190896, 13984930380680388088, 91035170834133188},
+	{9252593326301252374, 15110440314516488620, 17481162975850485110, 113793963542666485},
+	{11565741657876565467, 9664678356290834967, 8016395664530942676, 142242454428333107},
+	{14146117563813935273, 15263796009536547662, 2704404281118145220, 88901534017708192},
+	{8459274917912643283, 633000938211132962, 3380505351397681526, 111126917522135240},
+	{1350721610536028296, 10014623209618692011, 4225631689247101907, 138908646902669050},
+	{16985102071080875349, 3953296496797988554, 7252705824206826596, 86817904314168156},
+	{12008005551996318378, 4941620620997485693, 9065882280258533245, 108522380392710195},
+	{1174948884713234261, 10788711794674245021, 6720666831895778652, 135652975490887744},
+	{3040186062159465365, 15966316908526178946, 4200416769934861657, 84783109681804840},
+	{13023604614554107514, 6122838080375559970, 5250520962418577072, 105978887102256050},
+	{7056133731337858585, 7653547600469449963, 15786523239877997148, 132473608877820062},
+	{2104240572872467664, 14006839287148182035, 5254891006496360313, 82796005548637539},
+	{16465358771372748292, 3673491053653063831, 1956927739693062488, 103495006935796924},
+	{15970012445788547461, 4591863817066329789, 2446159674616328110, 129368758669746155},
+	{12287100787831536115, 16704972940948619830, 17669750861131062732, 80855474168591346},
+	{6135503947934644335, 2434472102476223172, 12863816539559052608, 101069342710739183},
+	{7669379934918305419, 3043090128095278965, 11468084656021427856, 126336678388423979},
+	{7099205468537634839, 1901931330059549353, 4861709900799698458, 78960423992764987},
+	{13485692854099431453, 11600786199429212499, 1465451357572235168, 98700529990956234},
+	{12245430049196901412, 14500982749286515624, 11055186233820069768, 123375662488695292},
+	{7653393780748063382, 9063114218304072265, 16132863432992319413, 77109789055434557},
+	{14178428244362467132, 15940578791307478235, 6331021235958235554, 96387236319293197},
+	{13111349287025696011, 10702351452279571986, 12525462563375182347, 120484045399116496},
+	{12806279322818447911, 4383126648461038539, 7828414102109488967, 75302528374447810},
+	{11396163135095671985, 867222292148910270, 562145590782085401, 94128160468059763},
+	{5021831882014814173, 5695713883613525742, 14537740043759770463, 117660200585074703},
+	{16973702981541422570, 10477350204899535444, 16003616554990938395, 73537625365671689},
+	{2770384653217226597, 8485001737697031402, 6169462638456509282, 91922031707089612},
+	{12686352853376309054, 1382880135266513444, 7711828298070636603, 114902539633862015},
+	{15857941066720386317, 15563658224365305517, 5028099354160907849, 143628174542327519},
+	{12217056175913935400, 2809757362587234092, 10060091123991649262, 89767609088954699},
+	{15271320219892419250, 12735568740088818423, 7963427886562173673, 112209511361193374},
+	{14477464256438136159, 2084402869828859317, 730912821347941284, 140261889201491718},
+	{11354258169487529051, 10526123830497812881, 14291878568624627014, 87663680750932323},
+	{357764656577247602, 3934282751267490294, 13253162192353395864, 109579600938665404},
+	{9670577857576335311, 4917853439084362867, 16566452740441744830, 136974501173331755},
+	{3738268151771515617, 16908716454709890504, 8048189953562396566, 85609063233332347},
+	{4672835189714394521, 11912523531532587322, 5448551423525607804, 107011329041665434},
+	{15064416023997768960, 14890654414415734152, 16034061316261785563, 133764161302081792},
+	{9415260014998605600, 7000815999796139893, 10021288322663615977, 83602600813801120},
+	{16380761037175644904, 13362706018172562770, 12526610403329519971, 104503251017251400},
+	{11252579259614780322, 12091696504288315559, 15658263004161899964, 130629063771564250},
+	{13950391064900319557, 16780682352034973032, 14398100396028575381, 81643164857227656},
+	{17437988831125399446, 7140794884761552578, 17997625495035719227, 102053956071534570},
+	{12574114002051973500, 4314307587524552819, 13273659831939873226, 127567445089418213},
+	{5552978242068789485, 7308128260630233416, 10601880404176114718, 79729653180886383},
+	{6941222802585986857, 18358532362642567578, 8640664486792755493, 99662066476107979},
+	{17899900540087259379, 9113107398021045760, 6189144590063556463, 124577583095134974},
+	{11187437837554537112, 12613221151404235456, 17703273424071886501, 77860989434459358},
+	{13984297296943171390, 1931468383973130608, 12905719743235082319, 97326236793074198},
+	{17480371621178964237, 16249393535248576972, 6908777642189077090, 121657795991342748},
+	{1701860226382076840, 14767556977957748512, 13541358063222948989, 76036122494589217},
+	{2127325282977596050, 4624388167165021928, 3091639523746522525, 95045153118236522},
+	{2659156603721995063, 10392171227383665314, 13087921441537928964, 118806441397795652},
+	{6273658895753634818, 15718479053969566629, 17403322937815981410, 74254025873622282},
+	{12453759638119431427, 10424726780607182478, 12530781635415200955, 92817532342027853},
+	{6343827510794513476, 8419222457331590194, 1828418988986837482, 116021915427534817},
+	{8576578212673958826, 9873700054259631775, 12671976914185243186, 72513697142209260},
+	{6109036747415060629, 3118753030969763911, 15839971142731553983, 90642121427761575},
+	{3024609915841437882, 17733499343994368601, 15188277909987054574, 113302651784701969},
+	{8392448413229185257, 12943502143138184943, 5150289332201654506, 141628314730877462},
+	{12162809285909322641, 12701374857888753493, 17053988887908197778, 88517696706798413},
+	{1368453552104489590, 6653346535506166059, 7482428054603083511, 110647120883498017},
+	{15545624995412775699, 3704997150955319669, 13964721086681242293, 138308901104372521},
+	{12021858631346678764, 4621466228560768745, 1810421651534694577, 86443063190232826},
+	{1192265233901184743, 10388518804128348836, 11486399101273144029, 108053828987791032},
+	{1490331542376480929, 17597334523587823949, 14357998876591430036, 135067286234738790},
+	{3237300223198994532, 1774962040387614160, 4362063279442255869, 84417053896711744},
+	{4046625278998743166, 6830388568911905604, 5452579099302819836, 105521317370889680},
+	{5058281598748428957, 8537985711139882005, 6815723874128524795, 131901646713612100},
+	{5467269008431462050, 3030398060248732301, 13483199458185103805, 82438529196007562},
+	{11445772278966715467, 8399683593738303280, 7630627285876603948, 103048161495009453},
+	{14307215348708394334, 10499604492172879100, 14149970125773142839, 128810201868761816},
+	{18165381629797522266, 13479781835249131293, 8843731328608214274, 80506376167976135},
+	{8871668981964739121, 7626355257206638309, 6442978142332879939, 100632970209970169},
+	{15701272245883311805, 4921258053080909982, 12665408696343487828, 125791212762462711},
+	{5201609135249681974, 12299158320030344547, 14833409462855761748, 78619507976539194},
+	{1890325400634714564, 15373947900037930684, 9318389791714926377, 98274384970673993},
+	{2362906750793393205, 5382376819765249643, 16259673258071045876, 122842981213342491},
+	{17617717783741728417, 12587357549208056834, 7856452777080709720, 76776863258339057},
+	{12798775192822384713, 15734196936510071043, 14432251989778275054, 95971079072923821},
+	{11386782972600592988, 10444374133782812996, 4205256931940680106, 119963848841154777},
+	{16340111394730146425, 11139419852041646026, 14157500628531394826, 74977405525721735},
+	{11201767206557907223, 4700902778197281725, 13085189767236855629, 93721756907152169},
+	{167150952915220317, 10487814491173990061, 2521429153763905824, 117152196133940212},
+	{2410312354785706650, 6554884056983743788, 10799265257957216948, 73220122583712632},
+	{3012890443482133313, 8193605071229679735, 13499081572446521185, 91525153229640790},
+	{17601171109634830353, 14853692357464487572, 7650479928703375673, 114406441537050988},
+	{3554719813333986326, 4732057391548445754, 9563099910879219592, 143008051921313735},
+	{6833385901761129357, 2957535869717778596, 12894466471940594101, 89380032450821084},
+	{8541732377201411697, 8308605855574611149, 16118083089925742626, 111725040563526355},
+	{15288851489929152525, 1162385282613488128, 15535917843979790379, 139656300704407944},
+	{9555532181205720328, 16867391866129287744, 9709948652487368986, 87285187940254965},
+	{11944415226507150410, 11860867795806833872, 16749121834036599137, 109106484925318706},
+	{14930519033133938013, 991026689476378628, 11713030255690973114, 136383106156648383},
+	{108202358853935450, 5231077699350124547, 14238172937447940052, 85239441347905239},
+	{13970311003849583024, 6538847124187655683, 13186030153382537161, 106549301684881549},
+	{12851202736384590877, 12785244923661957508, 2647479636446007739, 133186627106101937},
+	{17255373747095145106, 5684935068075029490, 13183889818847224597, 83241641941313710},
+	{12345845147014155574, 11717854853521174767, 7256490236704254938, 104052052426642138},
+	{10820620415340306564, 5423946530046692651, 18293984832735094481, 130065065533302672},
+	{4457044750373997650, 14919181627347652667, 11433740520459434050, 81290665958314170},
+	{959619919540109159, 9425604997329790026, 5068803613719516755, 101613332447892713},
+	{10422896936279912257, 7170320228234849628, 10947690535576783848, 127016665559865891},
+	{15737682622029720968, 4481450142646781017, 4536463575521795953, 79385415974916182},
+	{5837045222254987499, 10213498696735864176, 14893951506257020749, 99231769968645227},
+	{7296306527818734373, 17378559389347218124, 14005753364393888032, 124039712460806534},
+	{13783563616741484791, 10861599618342011327, 4141909834318792116, 77524820288004084},
+	{12617768502499468085, 13576999522927514159, 5177387292898490145, 96906025360005105},
+	{11160524609696947202, 3136191348377228987, 11083420134550500586, 121132531700006381},
+	{4669484871846898049, 6571805611163156021, 9232980593307756818, 75707832312503988},
+	{10448542108236010466, 17438129050808720834, 11541225741634696022, 94634790390629985},
+	{3837305598440237274, 12574289276656125235, 591474121761206316, 118293487988287482},
+	{92472989811454344, 17082302834764854080, 4981357344528141851, 73933429992679676},
+	{115591237264317931, 16741192525028679696, 6226696680660177314, 92416787490849595},
+	{144489046580397413, 11703118619431073812, 3171684832397833739, 115520984363561994},
+	{9313677690967524191, 5008606127930727180, 6593989038676033991, 72200615227226246},
+	{11642097113709405239, 1649071641486021071, 17465858335199818297, 90250769034032807},
+	{9940935373709368645, 6673025570284914243, 17220636900572384967, 112813461292541009},
+	{7814483198709322902, 3729595944428754900, 7690738070433317497, 141016826615676262},
+	{14107424036048102622, 13860212511336441572, 195025275593435531, 88135516634797664},
+	{17634280045060128277, 12713579620743164061, 243781594491794414, 110169395793497080},
+	{8207792001042996635, 6668602489074179269, 304726993114743018, 137711744741871350},
+	{7435713009865566848, 8779562574098749947, 14025512425978878098, 86069840463669593},
+	{4682955243904570657, 1751081180768661626, 3696832477191433911, 107587300579586992},
+	{15077066091735489129, 16023909531242990744, 4621040596489292388, 134484125724483740},
+	{9423166307334680705, 791571420172093407, 12111522409660583551, 84052578577802337},
+	{7167271865740962978, 14824522330497280471, 1304344956793565726, 105065723222252922},
+	{4347403813748815819, 9307280876266824781, 10853803232846732966, 131332154027816152},
+	{5022970392806703838, 1205364529239377584, 6783627020529208104, 82082596267385095},
+	{6278712991008379798, 1506705661549221980, 3867847757234122226, 102603245334231369},
+	{7848391238760474748, 11106754113791303283, 9446495714970040686, 128254056667789211},
+	{2599401515011602765, 2330035302692176648, 3598216812642581477, 80158785417368257},
+	{3249251893764503457, 7524230146792608714, 9109457034230614750, 100198481771710321},
+	{13284936904060405129, 181915646635985084, 15998507311215656342, 125248102214637901},
+	{17526457601892529013, 13948755334429654389, 12304910078723479165, 78280063884148688},
+	{8073013947083497555, 3600886112754904275, 15381137598404348957, 97850079855185860},
+	{5479581415426984040, 9112793659371018248, 779677924295884580, 122312599818982326},
+	{3424738384641865025, 14918868073961662213, 14322356757967091574, 76445374886863953},
+	{8892608999229719185, 9425213055597301958, 4067887892176700756, 95556718608579942},
+	{1892389212182373173, 11781516319496627448, 14308231902075651753, 119445898260724927},
+	{1182743257613983233, 445918672044310299, 15860173966438364202, 74653686412953079},
+	{15313487127299642753, 9780770376910163681, 15213531439620567348, 93317108016191349},
+	{5306800853842389730, 12225962971137704602, 5181856244243545473, 116646385020239187},
+	{7928436552078881485, 723697829319983520, 932817143438521969, 72903990637649492},
+}
+
+const pow5InvNumBits128 = 249
+
+var pow5InvSplit128 = [...][4]uint64{
+	{1, 0, 0, 144115188075855872},
+	{11068046444225730970, 11068046444225730969, 11068046444225730969, 115292150460684697},
+	{8854437155380584776, 5165088340638674452, 1475739525896764129, 92233720368547758},
+	{10772898539046378144, 15200117116736670531, 8559289250201231949, 73786976294838206},
+	{9857940032990384384, 5873443313069121234, 6316165170838150473, 118059162071741130},
+	{507654396908486861, 12077452279939117634, 5052932136670520378, 94447329657392904},
+	{15163518776494430782, 2283264194467473460, 7731694524078326626, 75557863725914323},
+	{13193583598165358281, 11031920340631778183, 8681362423783412278, 120892581961462917},
+	{17933564508016107271, 8825536272505422546, 18013136383252460792, 96714065569170333},
+	{10657502791670975494, 7060429018004338037, 3342462662376237664, 77371252455336267},
+	{13362655651931650467, 3917988799323120213, 9037289074543890586, 123794003928538027},
+	{7000775706803410050, 10513088668942316817, 18297877703860843438, 99035203142830421},
+	{1911271750700817717, 12099819749895763777, 10948953348346764427, 79228162514264337},
+	{14126081245347039317, 8291665155607491073, 2760930098387181791, 126765060022822940},
+	{14990213811019541777, 2943983309744082535, 2208744078709745433, 101412048018258352},
+	{11992171048815633421, 2355186647795266028, 12835041707193527316, 81129638414606681},
+	{740729604395461858, 7457647451214335969, 13157369102025823059, 129807421463370690},
+	{7971281313000190133, 9655466775713379098, 10525895281620658447, 103845937170696552},
+	{17445071494625883076, 11413722235312613601, 1042018595812706111, 83076749736557242},
+	{16844067947175681952, 14572606761758271439, 5356578568042240101, 132922799578491587},
+	{6096556728256724915, 590038965180886182, 15353309298659523051, 106338239662793269},
+	{15945291826831110902, 472031172144708945, 15971996253669528764, 85070591730234615},
+	{14444420478704046473, 8133947504915354959, 7108449932161694406, 136112946768375385},
+	{11555536382963237178, 2817809189190373644, 5686759945729355525, 108890357414700308},
+	{1865731476886769096, 9632944980836119562, 11928105586067305066, 87112285931760246},
+	{10363867992502651200, 655316710370150006, 11706271308223867460, 139379657490816394},
+	{8291094394002120960, 4213602183038030328, 13054365861321004291, 111503725992653115},
+	{10322224329943607092, 18128277005398065555, 10443492689056803432, 89202980794122492},
+	{5447512483684040377, 17937196764411173919, 1952193043523244199, 142724769270595988},
+	{668661172205321978, 6971059782045118489, 8940452064302416006, 114179815416476790},
+	{534928937764257583, 1887499010894184468, 7152361651441932805, 91343852333181432},
+	{427943150211406066, 12578045652941078544, 16789935765379277213, 73075081866545145},
+	{4374057855080160029, 16435524229963815347, 8417153150897291925, 116920130986472233},
+	{3499246284064128023, 2080372939745321308, 14112420150201654187, 93536104789177786},
+	{17556792286218943711, 9042995981280077692, 7600587305419413026, 74828883831342229},
+	{13333472398982668645, 14468793570048124308, 1092893244445329872, 119726214130147567},
+	{3288080289702314270, 15264383670780409770, 11942361039781994867, 95780971304118053},
+	{2630464231761851416, 12211506936624327816, 16932586461309416540, 76624777043294442},
+	{519393956077051942, 4781015839631283213, 12334743079127425172, 122599643269271108},
+	{7794212794345462200, 3824812671705026570, 17246492092785760784, 98079714615416886},
+	{6235370235476369760, 3059850137364021256, 10107844859486698304, 78463771692333509},
+	{17355290006246012262, 1206411405040523686, 5104505330952986317, 125542034707733615},
+	{2816185560771078840, 12033175568258149919, 4083604264762389053, 100433627766186892},
+	{5942297263358773396, 9626540454606519935, 14334929856035642212, 80346902212949513},
+	{9507675621374037433, 15402464727370431896, 799794881205565600, 128555043540719222},
+	{14984838126583050593, 4943274152412524870, 11707882349190183450, 102844034832575377},
+	{4609172871782619828, 15022665766155750866, 1987608249868326113, 82275227866060302},
+	{18442723039077922694, 5589521152139649769, 6869522014531232105, 131640364585696483},
+	{7375480801778517509, 11850314551195540462, 12874315241108806330, 105312291668557186},
+	{13279082270906634654, 5790902826214522046, 6610103378145134741, 84249833334845749},
+	{13867834003966794799, 9265444521943235274, 17954863034516036232, 134799733335753198},
+	{14783616017915346162, 7412355617554588219, 3295843983387098016, 107839786668602559},
+	{15516241629074187253, 5929884494043670575, 6326024001451588736, 86271829334882047},
+	{2689893718067237666, 5798466375727962598, 13810987217064452301, 138034926935811275},
+	{5841263789195700456, 949424285840459755, 11048789773651561841, 110427941548649020},
+	{983662216614650042, 15516934687640009097, 8839031818921249472, 88342353238919216},
+	{1573859546583440066, 2691002611772552616, 6763753280790178510, 141347765182270746},
+	{12327134081492483023, 16910197348385683385, 1721653809890232484, 113078212145816597},
+	{6172358450452076095, 9838809063966636385, 12445369492137916957, 90462569716653277},
+	{8627235575103571199, 11560396065915219431, 2577597964226512919, 72370055773322622},
+	{17492925734907624242, 11117936075980530443, 7813505557504330994, 115792089237316195},
+	{6615642958442278747, 12583697675526334678, 6250804446003464795, 92633671389852956},
+	{8981863181495733321, 6377609325679157419, 1311294742060861513, 74106937111882365},
+	{18060329905135083637, 6514826106344741547, 2098071587297378421, 118571099379011784},
+	{7069566294624246263, 5211860885075793238, 5367806084579813060, 94856879503209427},
+	{5655653035699397010, 15237535152286365560, 15362291311889581417, 75885503602567541},
+	{5359696042377124893, 2243963355206722957, 17200968469539509622, 121416805764108066},
+	{4287756833901699915, 9173868313649199012, 10071425960889697374, 97133444611286453},
+	{7119554281863270255, 18407141095145090179, 15435838398195578545, 77706755689029162},
+	{4012589221497411761, 14694030493264502994, 9939946178145284380, 124330809102446660},
+	{6899420191939839732, 11755224394611602395, 7951956942516227504, 99464647281957328},
+	{16587582597777602756, 2025481886205461269, 13740263183496802650, 79571717825565862},
+	{714690453250792146, 6930119832670648355, 7227025834627242947, 127314748520905380},
+	{11639798806826364687, 16612142310362249653, 5781620667701794357, 101851798816724304},
+	{13001187860203002073, 9600365033547889399, 8314645348903345809, 81481439053379443},
+	{13423202946840982670, 603188794708981746, 9614083743503442972, 130370302485407109},
+	{3359864727988965489, 15239946294734826690, 11380615809544664700, 104296241988325687},
+	{13755938226616903361, 4813259406304040705, 1725795018151911114, 83436993590660550},
+	{10941454718361314408, 15079912679570285775, 2761272029043057782, 133499189745056880},
+	{1374466145205230880, 4685232514172407974, 2209017623234446226, 106799351796045504},
+	{4788921730906095027, 3748186011337926379, 5456562913329467304, 85439481436836403},
+	{283577139965931397, 9686446432882592530, 5041151846585237363, 136703170298938245},
+	{7605559341456565764, 15127854775789894670, 4032921477268189890, 109362536239150596},
+	{2395098658423342288, 8412935005890005413, 17983732440782193205, 87490028991320476},
+	{11210855482961168307, 6081998379940188014, 2948530202058136866, 139984046386112763},
+	{16347382015852755292, 8554947518694060734, 9737521791130330139, 111987237108890210},
+	{2009859168456473264, 10533306829697158911, 7790017432904264111, 89589789687112168},
+	{3215774669530357223, 5785244483289723288, 8774679077904912255, 143343663499379469},
+	{13640666179850016748, 8317544401373688953, 10709092077065840127, 114674930799503575},
+	{10912532943880013398, 17722081965324682132, 8567273661652672101, 91739944639602860},
+	{16108723984587831365, 10488316757517835382, 6853818929322137681, 73391955711682288},
+	{18395260745856709538, 5713260367802805642, 7276761472173509967, 117427129138691661},
+	{14716208596685367630, 11949305923726065160, 2132060362996897650, 93941703310953329},
+	{15462315692090204428, 13248793553722762451, 5394997105139428443, 75153362648762663},
+	{9982309848376685791, 13819372056472599276, 4942646553481175186, 120245380238020261},
+	{15364545508185169279, 3676800015694258774, 264768428043029826, 96196304190416209},
+	{15980985221290045747, 2941440012555407019, 3901163557176334184, 76957043352332967},
+	{7122832280354521578, 15774350464314382201, 9931210506224045017, 123131269363732747},
+	{5698265824283617263, 16308829186193416084, 566270775495415367, 98505015490986198},
+	{8247961474168804134, 13047063348954732867, 7831714249880152940, 78804012392788958},
+	{13196738358670086613, 17185952543585662264, 8841393985066334381, 126086419828462333},
+	{17936088316419889937, 17438110849610440134, 14451812817536888151, 100869135862769866},
+	{10659521838394001627, 6571791050204531461, 7872101439287600198, 80695308690215893},
+	{2297839682462761310, 3136168050843429692, 8906013488118249994, 129112493904345429},
+	{1838271745970209048, 9887632070158564400, 10814159605236510318, 103289995123476343},
+	{16228012655743808531, 4220756841384941196, 16030025313673028901, 82631996098781074},
+	{139378545996721387, 6753210946215905915, 14579994057651115272, 132211193758049719},
+	{14868898095765018403, 1713219942230814408, 15353344060862802541, 105768955006439775},
+	{15584467291353925045, 16127971212752292819, 12282675248690242032, 84615164005151820},
+	{17556450036682459426, 11047358681436027218, 1205536324194835636, 135384262408242913},
+	{6666462399862146894, 12527235759890732098, 8343126688839689155, 108307409926594330},
+	{12711867549373538162, 10021788607912585678, 6674501351071751324, 86645927941275464},
+	{12960290449513840413, 12345512957918226762, 18057899791198622765, 138633484706040742},
+	{14057581174352982653, 2497712736850760763, 7067622203475077566, 110906787764832594},
+	{178018495256655153, 1998170189480608611, 9343446577521972376, 88725430211866075},
+	{3974178407152558568, 14265118747394704747, 14949514524035155801, 141960688338985720},
+	{10558040355205867501, 7722746183173853474, 11959611619228124641, 113568550671188576},
+	{4757083469422783678, 17246243390764813749, 5878340480640589389, 90854840536950861},
+	{7495015590280137265, 13796994712611850999, 1013323569770561188, 72683872429560689},
+	{4613327314964398978, 7317796281211320306, 9000015341116718548, 116294195887297102},
+	{1313037229608859, 5854237024969056245, 18268058717119105808, 93035356709837681},
+	{3690399244525597411, 8372738434717155319, 10925098158953374323, 74428285367870145},
+	{9593987605982866180, 9707032680805538187, 17480157054325398917, 119085256588592232},
+	{3985841270044382621, 11454974959386340873, 6605428013976498487, 95268205270873786},
+	{17946068275003147390, 16542677596992893344, 1594993596439288466, 76214564216699029},
+	{17645662795779304853, 8021540081479077735, 9930687383786682193, 121943302746718446},
+	{17805879051365354206, 10106580879925172511, 4255201092287435431, 97554642197374757},
+	{17934052055834193688, 15463962333423958655, 14472207318055679314, 78043713757899805},
+	{17626436845108978931, 13674293289252602879, 4708787635179535287, 124869942012639689},
+	{14101149476087183145, 7250085816660171980, 7456378922885538553, 99895953610111751},
+	{14970268395611656839, 9489417468070047907, 2275754323566520519, 79916762888089401},
+	{9195034174011009650, 15183067948912076652, 14709253361932163800, 127866820620943041},
+	{14734724968692628366, 8457105544387750998, 8078053874803820717, 102293456496754433},
+	{719733530728371724, 6765684435510200799, 13841140729326877220, 81834765197403546},
+	{1151573649165394757, 3446397467332500632, 14767127537439182906, 130935624315845674},
+	{11989305363558046776, 2757117973866000505, 15503050844693256648, 104748499452676539},
+	{2212746661362616774, 13273740823318531374, 16091789490496515641, 83798799562141231},
+	{14608441102405917808, 6480590058342008905, 18368165555310604380, 134078079299425970},
+	{11686752881924734247, 5184472046673607124, 14694532444248483504, 107262463439540776},
+	{13038751120281697721, 4147577637338885699, 8066277140656876480, 85809970751632621},
+	{2415257718741164737, 17704170663967948089, 5527345795567181721, 137295953202612194},
+	{5621554989734842113, 14163336531174358471, 8111225451195655700, 109836762562089755},
+	{807895177045963367, 11330669224939486777, 6488980360956524560, 87869410049671804},
+	{12360678727499272356, 7061024315677447873, 17761066207014259943, 140591056079474886},
+	{2509845352515597239, 9338168267283868622, 10519504150869497631, 112472844863579909},
+	{13075922726238208761, 7470534613827094897, 12104952135437508428, 89978275890863927},
+	{6164081103013492724, 11952855382123351836, 4610528157732372192, 143965241425382284},
+	{15999311326636525149, 5872935490956771145, 7377771340927808077, 115172193140305827},
+	{16488797876051130442, 8387697207507327239, 16970263516967977431, 92137754512244661},
+	{9501689486098994031, 17778204210231592761, 9886861998832471621, 73710203609795729},
+	{7824005548274569803, 9998382662660996802, 4750932753906223625, 117936325775673167},
+	{9948553253361566165, 620008500644976795, 14868792647350709870, 94349060620538533},
+	{15337540232173073579, 7874704429999802082, 826987673654836926, 75479248496430827},
+	{6093320297767366110, 8910178273257773009, 5012529092589649405, 120766797594289323},
+	{15942702682439623858, 14506840248090039053, 11388720903555540170, 96613438075431458},
+	{9064813331209788763, 537425754246300273, 16489674352328252783, 77290750460345166},
+	{18193050144677572344, 8238578836277901083, 558037260531832190, 123665200736552267},
+	{14554440115742057875, 17658909513248051836, 11514476252651196721, 98932160589241813},
+	{11643552092593646300, 17816476425340351792, 16590278631604778023, 79145728471393450},
+	{182939274440282464, 6370269392093100929, 8097701736858093222, 126633165554229521},
+	{11214397863777956941, 12474913143158301389, 2788812574744564254, 101306532443383617},
+	{8971518291022365553, 6290581699784730788, 13299096504021382373, 81045225954706893},
+	{3286382821410053915, 2686233090171748615, 17589205591692301474, 129672361527531029},
+	{10007803886611863778, 9527684101621219538, 17760713288095751502, 103737889222024823},
+	{15384940738773311669, 7622147281296975630, 3140524186250870232, 82990311377619859},
+	{17237207552553478024, 4816738020591340362, 12403536327485213018, 132784498204191774},
+	{17479114856784692742, 14921436860698803259, 13612177876730080737, 106227598563353419},
+	{13983291885427754194, 8247800673817132284, 14579091116125974913, 84982078850682735},
+	{7615871757716765417, 9507132263365501332, 4879801712092008245, 135971326161092377},
+	{9782046220915322657, 227008181208580419, 14971887813899337566, 108777060928873901},
+	{4136288161990347803, 11249652989192595305, 8288161436377559729, 87021648743099121},
+	{6618061059184556484, 17999444782708152488, 5882360668720274920, 139234637988958594},
+	{16362495291573376157, 18088904640908432313, 8395237349718130259, 111387710391166875},
+	{5711298603774880279, 18160472527468656174, 6716189879774504207, 89110168312933500},
+	{1759380136555987800, 14299360784982208586, 10745903807639206732, 142576269300693600},
+	{8786201738728610886, 4060790998501946222, 8596723046111365386, 114061015440554880},
+	{14407659020466709356, 18006028057769198270, 6877378436889092308, 91248812352443904},
+	{4147429586889546838, 7026124816731537970, 9191251564253184170, 72999049881955123},
+	{2946538524281364618, 7552450892028550429, 11016653688063184349, 116798479811128197},
+	{2357230819425091694, 2352611898880930020, 1434625320966726833, 93438783848902558},
+	{16643179914507714648, 16639484778072385308, 8526397886257202112, 74751027079122046},
+	{803646160018971174, 4487082756464354555, 6263538988527702734, 119601643326595274},
+	{8021614557498997586, 10968363834655304290, 8700180005564072510, 95681314661276219},
+	{10106640460741108392, 12464039882466153755, 10649492819193168331, 76545051729020975},
+	{8791927107701952781, 12563766182462025362, 17039188510709069330, 122472082766433560},
+	{18101588130387293194, 10051012945969620289, 13631350808567255464, 97977666213146848},
+	{10791921689567924232, 662112727291875585, 18283778276337625018, 78382132970517478},
+	{9888377073824858125, 12127426807892731906, 7117952353688738089, 125411412752827966},
+	{4221352844317976177, 9701941446314185525, 2005013068209080148, 100329130202262373},
+	{18134477534422022234, 4072204342309438096, 8982708084051084765, 80263304161809898},
+	{17947117610849504605, 2826178132953190631, 10682984119739825301, 128421286658895837},
+	{18047042903421514007, 9639640135846373151, 1167689666308039594, 102737029327116670},
+	{14437634322737211206, 11401060923419008844, 934151733046431675, 82189623461693336},
+	{4653470842669986313, 10862999847986593505, 12562689217100021650, 131503397538709337},
+	{14790823118361720020, 1311702248905454157, 2671453744196196674, 105202718030967470},
+	{8143309679947465693, 4738710613866273649, 2137162995356957339, 84162174424773976},
+	{1961249043690214139, 7581936982186037839, 14487507236796862712, 134659479079638361},
+	{12637045679177902281, 13444247215232650917, 7900656974695579846, 107727583263710689},
+	{2730938913858501179, 10755397772186120734, 10009874394498374200, 86182066610968551},
+	{4369502262173601885, 9829938806013972528, 8637101401713578074, 137891306577549682},
+	{7184950624480791832, 4174602230069267699, 17977727565596593429, 110313045262039745},
+	{13126658129068454112, 7029030598797324482, 14382182052477274743, 88250436209631796},
+	{13623955377025705932, 178402513849988202, 15632793654479818943, 141200697935410874},
+	{14588513116362475069, 11210768455305721531, 16195583738325765477, 112960558348328699},
+	{4292112863606159409, 5279265949502666902, 16645815805402522705, 90368446678662959},
+	{18191085549852568820, 7912761574344043844, 17006001459063928487, 72294757342930367},
+	{6969643991312648173, 1592372074724739182, 12452207075534644287, 115671611748688588},
+	{16643761637275849508, 1273897659779791345, 17340463289911536076, 92537289398950870},
+	{9625660495078769283, 15776513386791474369, 13872370631929228860, 74029831519160696},
+	{15401056792126030853, 17863723789382538344, 14817095381602945530, 118447730430657114},
+	{1252798989475093713, 17980327846247940999, 15543025120024266747, 94758184344525691},
+	{12070285635805805940, 3316215832772621829, 8745071281277503075, 75806547475620553},
+	{4555061758321648211, 1616596517694284604, 10302765235302094597, 121290475960992885},
+	{18401444665624959861, 12361323658381158652, 8242212188241675677, 97032380768794308},
+	{7342458103016147243, 9889058926704926922, 13972467380077161188, 77625904615035446},
+	{4369235335342014942, 4754447838502152106, 14977250178639637255, 124201447384056714},
+	{3495388268273611954, 7492907085543632008, 15671148957653620127, 99361157907245371},
+	{17553705873586530856, 13373023297918726252, 8847570351380985778, 79488926325796297},
+	{13328534138770808076, 2950093202960410388, 17845461376951487569, 127182282121274075},
+	{3284129681532825815, 6049423377110238634, 14276369101561190055, 101745825697019260},
+	{6316652559968170975, 4839538701688190907, 11421095281248952044, 81396660557615408},
+	{17485341725432894206, 11432610737443015774, 14584403635256412947, 130234656892184653},
+	{17677622195088225688, 9146088589954412619, 599476463979399388, 104187725513747723},
+	{14142097756070580551, 3627522057221619772, 7858278800667340157, 83350180410998178},
+	{7869961150745287588, 5804035291554591636, 8883897266325833928, 133360288657597085},
+	{9985317735338140394, 12021925862727493955, 7107117813060667142, 106688230926077668},
+	{7988254188270512315, 9617540690181995164, 13064391879932354360, 85350584740862134},
+	{9091857886490909380, 4320018660065461293, 9834980563666036007, 136560935585379415},
+	{7273486309192727504, 14524061372278100004, 7867984450932828805, 109248748468303532},
+	{2129440232612271680, 4240551468338659357, 17362434004971994014, 87398998774642825},
+	{14475150816405365658, 14163579978825675617, 9333150334245638806, 139838398039428521},
+	{15269469467866202850, 3952166353576719847, 3777171452654600722, 111870718431542817},
+	{8526226759551051957, 6851081897603286201, 14089783606349411547, 89496574745234253},
+	{6263265185797862484, 10961731036165257922, 407560881707596536, 143194519592374806},
+	{8699960963380200311, 1390687199448385691, 15083443964333718522, 114555615673899844},
+	{18028015214929891218, 15869945018526349845, 15756103986208885140, 91644492539119875},
+	{14422412171943912975, 12695956014821079876, 12604883188967108112, 73315594031295900},
+	{939766586658798820, 5556134364746086510, 1721069028637821364, 117304950450073441},
+	{751813269327039056, 4444907491796869208, 16134250481877898384, 93843960360058752},
+	{4290799430203541568, 18313321252405136659, 5528702756018498060, 75075168288047002},
+	{17933325532551397478, 14543918744880577361, 12535273224371507220, 120120269260875203},
+	{18036009240783028306, 567088551678730919, 17406916208981026423, 96096215408700162},
+	{18118156207368332968, 453670841342984735, 6546835337701000492, 76876972326960130},
+	{14231654672821691456, 4415222160890685900, 10474936540321600787, 123003155723136208},
+	{11385323738257353165, 3532177728712548720, 15758646861741101276, 98402524578508966},
+	{1729561361122061885, 13893788627195769946, 8917568674650970697, 78722019662807173},
+	{13835344622021029986, 3783317729803680297, 10578761064699642793, 125955231460491477},
+	{3689578068133003342, 3026654183842944238, 1084311222275893588, 100764185168393182},
+	{10330360083990223320, 2421323347074355390, 11935495422046445840, 80611348134714545},
+	{16528576134384357312, 3874117355318968624, 650048601564761728, 128978157015543273},
+	{13222860907507485850, 17856689143222816192, 7898736510735630028, 103182525612434618},
+	{17956986355489809327, 10596002499836342630, 13697686838072324669, 82546020489947694},
+	{6595085280332232983, 13264255184996237886, 10848252496689988501, 132073632783916311},
+	{12654765853749607033, 3232706518513169662, 4989253182610080478, 105658906227133049},
+	{13813161497741595950, 13654211659036266699, 7680751360829974705, 84527124981706439},
+	{18411709581644643196, 10778692210232295749, 1221155733102228559, 135243399970730303},
+	{11040018850573804234, 1244256138702015953, 8355622215965603494, 108194719976584242},
+	{12521363895200953710, 15752800169929254055, 17752544216998213764, 86555775981267393},
+	{5276786973353884643, 10447085012919165196, 6267977858745680084, 138489241570027830},
+	{4221429578683107715, 12047016825077242480, 5014382286996544067, 110791393256022264},
+	{18134538921914127465, 5948264645319883660, 7700854644339145577, 88633114604817811},
+	{6879169386611142004, 5827874617769903534, 4942669801458812277, 141812983367708498},
+	{9192684324030823926, 4662299694215922827, 11332833470650870468, 113450386694166798},
+	{14732845088708479788, 40490940630827938, 16444964406004517021, 90760309355333438},
+	{4407578441482963184, 3721741567246572674, 2087925080577882647, 72608247484266751},
+	{10741474321114651417, 2265437692852605955, 14408726573150343205, 116173195974826801},
+	{4903830642149810811, 16569745413249726057, 7837632443778364240, 92938556779861441},
+	{11301762143203669295, 9566447515857870522, 2580757140280781069, 74350845423889153},
+	{7014772984900139902, 548920766404951543, 439862609707339388, 118961352678222645},
+	{1922469573178201598, 7817834242607781881, 351890087765871510, 95169082142578116},
+	{12606022102768292248, 2564918579344315181, 15038907329180338501, 76135265714062492},
+	{9101588920203536628, 414520912208993967, 9304856467720900309, 121816425142499988},
+	{10970619950904739625, 11399663173992926143, 14822582803660540893, 97453140113999990},
+	{5087147145981881377, 16498428168678161561, 11858066242928432714, 77962512091199992},
+	{11828784248312920526, 572043366691686235, 4215510729717851051, 124740019345919988},
+	{13152376213392246744, 4146983508095259311, 10751106213258101487, 99792015476735990},
+	{17900598600197618042, 14385633250701938418, 8600884970606481189, 79833612381388792},
+	{17572911316090457897, 15638315571639280823, 17450764767712280226, 127733779810222067},
+	{10368980238130455995, 1442606013085693689, 6581914184686003535, 102187023848177654},
+	{15673881819988185442, 4843433625210465274, 8954880162490713151, 81749619078542123},
+	{2942118023529634768, 15128191429820565086, 10638459445243230718, 130799390525667397},
+	{17111089677791349108, 12102553143856452068, 1132069926710763928, 104639512420533918},
+	{17378220556974989609, 5992693700343251331, 8284353570852431789, 83711609936427134},
+	{16737106446934252405, 5898961105807291807, 2186919269138159893, 133938575898283415},
+	{13389685157547401924, 12097866514129654092, 1749535415310527914, 107150860718626732},
+	{18090445755521742186, 5988944396561812950, 12467674776474153301, 85720688574901385},
+	{3119271505641415235, 2203613405015080075, 1501535568649093666, 137153101719842217},
+	{9874114833996952834, 9141588353495884706, 12269274899145005902, 109722481375873773},
+	{4209943052455651944, 7313270682796707765, 17194117548799825368, 87777985100699018},
+	{17803955328154774080, 4322535462990911777, 5374495189628258650, 140444776161118430},
+	{6864466633039998618, 3458028370392729422, 4299596151702606920, 112355820928894744},
+	{1802224491690088571, 6455771511056093861, 7129025736103995859, 89884656743115795},
+	{2883559186704141713, 17707932047173570824, 11406441177766393374, 143815450788985272},
+	{2306847349363313371, 10476996822996946336, 1746455312729294053, 115052360631188218},
+	{12913524323716381666, 4692248643655646745, 8775861879667255889, 92041888504950574},
+	{17709517088456925980, 11132496544408338042, 10710038318475715034, 73633510803960459},
+	{9888483267821529951, 14122645656311430545, 6068014865335413085, 117813617286336735},
+	{7910786614257223961, 11298116525049144436, 4854411892268330468, 94250893829069388},
+	{17396675735631510138, 5349144405297405225, 11262227143298485021, 75400715063255510},
+	{2009239473817043959, 1179933418992027715, 18019563429277576034, 120641144101208816},
+	{1607391579053635167, 943946735193622172, 10726301928680150504, 96512915280967053},
+	{4975262077984818457, 11823203832380628707, 15959739172427941049, 77210332224773642},
+	{4271070510033799208, 11538428502325185285, 10778187416917064386, 123536531559637828},
+	{7106205222768949690, 12920091616602058551, 16001247563017472155, 98829225247710262},
+	{13063661807698980398, 2957375663797826194, 5422300420930157078, 79063380198168210},
+	{6144463633350727344, 1042452247334611588, 8675680673488251325, 126501408317069136},
+	{8604919721422492198, 15591357056835330563, 3251195724048690736, 101201126653655309},
+	{14262633406621814405, 12473085645468264450, 6290305393980862912, 80960901322924247},
+	{11752167006369172078, 8888890588523492151, 13753837445111290983, 129537442116678795},
+	{13091082419837247986, 14489810100302614367, 11003069956089032786, 103629953693343036},
+	{14162214750611708712, 4213150450758270847, 5113107150129315906, 82903962954674429},
+	{7902148342011092646, 6741040721213233356, 15559669069690726096, 132646340727479086},
+	{13700416303092694764, 16460879021196317654, 8758386441010670553, 106117072581983269},
+	{7270984227732245488, 5790005587473233477, 10696057967550446766, 84893658065586615},
+	{7944225949629682457, 1885311310473352917, 17113692748080714826, 135829852904938584},
+	{17423427203929476935, 1508249048378682333, 17380303013206482184, 108663882323950867},
+	{17628090577885491872, 15963994497670587159, 6525544781081365100, 86931105859160694},
+	{6068852036165325055, 14474344752047208486, 17819569279214004807, 139089769374657110},
+	{12233779258416080690, 4200778172153946142, 14255655423371203846, 111271815499725688},
+	{6097674591990954229, 7049971352465067237, 336477894471232107, 89017452399780551},
+	{9756279347185526766, 7590605349202197256, 11606411075379702341, 142427923839648881},
+	{15183721107232242060, 17140530723587488774, 5595780045561851549, 113942339071719105},
+	{1078930441560062678, 17401773393611901343, 4476624036449481239, 91153871257375284},
+	{15620539612215691435, 2853372270663790104, 7270648043901495315, 72923097005900227},
+	{17614165750061285650, 8254744447803974490, 15322385684984302827, 116676955209440363},
+	{14091332600049028520, 6603795558243179592, 1189862103761711292, 93341564167552291},
+	{11273066080039222816, 12661734076078364320, 15709284941977010326, 74673251334041832},
+	{14347556913320846182, 16569425706983472589, 10377460648195575229, 119477202134466932},
+	{11478045530656676946, 9566191750844867748, 923270889072639537, 95581761707573546},
+	{5493087609783431234, 15031651030159714845, 15496011970225752922, 76465409366058836},
+	{5099591360911579651, 1914548759804081813, 17414921522877384030, 122344654985694138},
+	{390324273987353397, 8910336637327086097, 2863890774076176254, 97875723988555311},
+	{11380305863415613688, 7128269309861668877, 17048507878228582296, 78300579190844248},
+	{10829791751981161253, 337184451552939234, 5141519716714269735, 125280926705350798},
+	{1285135772101108356, 7648445190726172034, 11491913402855236434, 100224741364280638},
+	{15785503876648527978, 17186802596806668596, 16572228351768009793, 80179793091424510},
+	{14188759758411913795, 5362791266439207815, 8068821289119264054, 128287668946279217},
+	{7661658991987620713, 600884198409455929, 17523103475521142213, 102630135157023373},
+	{6129327193590096570, 15238102617695206036, 2950436336191182800, 82104108125618699},
+	{9806923509744154512, 13312917744086598688, 12099395767389713127, 131366573000989918},
+	{15224236437279144256, 10650334195269278950, 17058214243395591148, 105093258400791934},
+	{4800691520339494759, 1141569726731602514, 17335920209458383242, 84074606720633547},
+	{3991757617801281291, 9205209192254384669, 12980077076165771894, 134519370753013676},
+	{6882754908982935356, 7364167353803507735, 6694712846190707192, 107615496602410941},
+	{12884901556670168931, 13270031512526626834, 1666421462210655430, 86092397281928753},
+	{5858447231704628997, 17542701605300692612, 17423669598504689981, 137747835651086004},
+	{15754804229589434167, 14034161284240554089, 17628284493545662308, 110198268520868803},
+	{12603843383671547334, 7537980212650532948, 3034581150610798877, 88158614816695043},
+	{16476800599132565410, 12060768340240852717, 1165981026235367880, 141053783706712069},
+	{9492091664564142005, 13337963486934592497, 4622133635730204627, 112843026965369655},
+	{11283022146393223928, 3291673160063853351, 3697706908584163702, 90274421572295724},
+	{1647720087630758496, 17390733787018723974, 6647514341609241284, 72219537257836579},
+	{6325700954951123916, 5689081170778496419, 18014720576058606702, 115551259612538526},
+	{16128607208186630103, 11929962566106617781, 10722427646104975038, 92441007690030821},
+	{12902885766549304082, 13233318867627204548, 4888593302142069707, 73952806152024657},
+	{5887221967511245238, 10105263743977796308, 11511098098169221855, 118324489843239451},
+	{8399126388750906514, 4394862180440326723, 5519529663793467161, 94659591874591561},
+	{6719301111000725211, 14583936188577992348, 726274916292863405, 75727673499673249},
+	{14440230592343070661, 12266251457499056787, 8540737495552402095, 121164277599477198},
+	{11552184473874456529, 17191698795483066076, 14211287625925742322, 96931422079581758},
+	{5552398764357654900, 13753359036386452861, 300983656514862888, 77545137663665407},
+	{1505140393488427193, 3558630384508772962, 4170922665165690945, 124072220261864651},
+	{8582809944274562401, 17604299566574659662, 18094133391100194048, 99257776209491720},
+	{6866247955419649921, 3015393209033996760, 14475306712880155239, 79406220967593376},
+	{10985996728671439873, 4824629134454394816, 15781793111124427736, 127049953548149402},
+	{12478146197679062222, 11238400937047336499, 5246736859415721542, 101639962838519522},
+	{17361214587627070424, 12680069564379779522, 15265435931758308203, 81311970270815617},
+	{13020548081235671385, 1841367229298095620, 9667302231845651833, 130099152433304988},
+	{6727089650246626785, 16230489042406117789, 15112539414960342112, 104079321946643990},
+	{1692322905455391105, 5605693604441073585, 12090031531968273690, 83263457557315192},
+	{6397065463470536091, 12658458581847628059, 4586655192181596611, 133221532091704308},
+	{12496350000260249519, 13816115680220012770, 11048021783229097935, 106577225673363446},
+	{6307731185466289292, 7363543729434099893, 5149068611841368025, 85261780538690757},
+	{10092369896746062867, 15471018781836470152, 11927858593688099163, 136418848861905211},
+	{4384547102654939971, 16066163840211086445, 5852938060208569007, 109135079089524169},
+	{18265032941091593270, 9163582257426958832, 8371699262908765529, 87308063271619335},
+	{3398611002553176969, 3593685167657403163, 13394718820654024847, 139692901234590936},
+	{17476284061010182868, 10253645763609743176, 7026426241781309554, 111754320987672749},
+	{17670376063550056618, 15581614240371615187, 9310489808166957966, 89403456790138199},
+	{13515206442712449295, 6483838710885032684, 3828737248841401777, 143045530864221119},
+	{10812165154169959436, 1497722153966115824, 6752338613815031745, 114436424691376895},
+	{12339080938077877872, 1198177723172892659, 5401870891052025396, 91549139753101516},
+	{6181915935720391975, 12026588622764045097, 632147898099709993, 73239311802481213},
+	{6201716682410716836, 11863844166938651509, 15768831895927177282, 117182898883969940},
+	{1272024531186663146, 2112377704067100561, 12615065516741741826, 93746319107175952},
+	{4706968439691240840, 9068599792737501095, 2713354783909572814, 74997055285740762},
+	{152451874022164697, 7131062038896181106, 8030716468997226826, 119995288457185219},
+	{14879356758185373050, 5704849631116944884, 10113921989939691784, 95996230765748175},
+	{835438962322567471, 8253228519635466231, 8091137591951753427, 76796984612598540},
+	{16094097598683749246, 16894514446158656292, 12945820147122805483, 122875175380157664},
+	{16564626893688909720, 6136913927443104387, 14046004932440154710, 98300140304126131},
+	{2183655070725396807, 1220182327212573187, 7547455131210213445, 78640112243300905},
+	{7183196927902545213, 1952291723540117099, 12075928209936341512, 125824179589281448},
+	{9435906357063946494, 1561833378832093679, 17039440197432893856, 100659343671425158},
+	{14927422715134977842, 4938815517807585266, 2563505713720584115, 80527474937140127},
+	{1747783455764502607, 11591453643234046750, 7790957956694844907, 128843959899424203},
+	{1398226764611602086, 9273162914587237400, 13611463994839696572, 103075167919539362},
+	{4807930226431191992, 11107879146411700243, 3510473566387936611, 82460134335631490},
+	{15071385991773727833, 10393909004774899742, 5616757706220698578, 131936214937010384},
+	{15746457608160892590, 936429574336099147, 8182754979718469186, 105548971949608307},
+	{12597166086528714072, 8127841288952699964, 17614250428000506318, 84439177559686645},
+	{5398070479478301222, 9315197247582409620, 9736056611091258493, 135102684095498633},
+	{629107568840730655, 3762808983324017373, 15167542918356827441, 108082147276398906},
+	{503286055072584524, 14078293630884944868, 8444685519943551629, 86465717821119125},
+	{4494606502858045561, 11457223365190180819, 13511496831909682607, 138345148513790600},
+	{18353080461254077742, 1787081062668324008, 10809197465527746086, 110676118811032480},
+	{18371813183745172517, 16187060109102300499, 8647357972422196868, 88540895048825984},
+	{3569459390798903764, 11141900915596039507, 2767726311649784020, 141665432078121575},
+	{13923613956864853981, 8913520732476831605, 2214181049319827216, 113332345662497260},
+	{7449542350749972862, 3441467771239554961, 1771344839455861773, 90665876529997808},
+	{17027680324825709259, 17510569475959285261, 8795773501048510064, 72532701223998246},
+	{1418846816527762552, 9570167087825304803, 6694539972193795457, 116052321958397194},
+	{4824426267964120365, 3966784855518333519, 9044980792496946689, 92841857566717755},
+	{11238238643855116938, 6862776699156577138, 7235984633997557351, 74273486053374204},
+	{14291833015426276778, 10980442718650523421, 509528970170360792, 118837577685398727},
+	{11433466412341021422, 12473702989662329060, 11475669620362019603, 95070062148318981},
+	{12836121944614727461, 13668311206471773571, 5491186881547705359, 76056049718655185},
+	{2091051037674012321, 10801251486129106745, 8785899010476328575, 121689679549848296},
+	{16430236089106851150, 4951652374161375072, 3339370393639152537, 97351743639878637},
+	{9454840056543570597, 7650670714071010381, 13739542759137052999, 77881394911902909},
+	{4059697646243981985, 8551724327771706287, 10915221970393553829, 124610231859044655},
+	{18005153375962826881, 10530728276959275352, 8732177576314843063, 99688185487235724},
+	{18093471515512171828, 1045884992083599635, 10675090875793784774, 79750548389788579},
+	{6813461536368012985, 16430811246301400710, 6012098957044324668, 127600877423661727},
+	{5450769229094410388, 13144648997041120568, 15877725609861190704, 102080701938929381},
+	{11739313012759348957, 10515719197632896454, 9012831673147042240, 81664561551143505},
+	{7714854376189227362, 16825150716212634327, 14420530677035267584, 130663298481829608},
+	{9861232315693292213, 6081422943486286815, 468378097402483098, 104530638785463687},
+	{7888985852554633770, 4865138354789029452, 11442748922147717448, 83624511028370949},
+	{1554330919861683062, 11473570182404357447, 7240351831210616947, 133799217645393519},
+	{8622162365373167096, 5489507331181575634, 9481630279710403881, 107039374116314815},
+	{6897729892298533677, 702257050203350184, 7585304223768323105, 85631499293051852},
+	{3657670198193833237, 4812960095067270618, 15825835572771227291, 137010398868882963},
+	{13994182602780797559, 7539716890795726817, 1592622013991250863, 109608319095106371},
+	{7505997267482727724, 9721122327378491777, 16031492870160641983, 87686655276085096},
+	{4630897998488543712, 4485749279579855874, 18271690962773206527, 140298648441736154},
+	{3704718398790834970, 18345994682631525992, 18306701584960475544, 112238918753388923},
+	{6653123533774578299, 7298098116621400147, 3577314823742649466, 89791135002711139},
+	{14334346468781235602, 11676956986594240235, 13102401347472059792, 143665816004337822},
+	{15156825989766898805, 13030914404017302511, 3103223448493827187, 114932652803470258},
+	{8436111977071608721, 10424731523213842009, 9861276388278882396, 91946122242776206},
+	{3059540766915376653, 961087589087252961, 4199672295881195594, 73556897794220965},
+	{4895265227064602645, 8916437772023425384, 6719475673409912950, 117691036470753544},
+	{11294909811135502763, 10822499032360650630, 9064929353469840683, 94152829176602835},
+	{16414625478392222857, 16036696855372341150, 7251943482775872546, 75322263341282268},
+	{15195354321201825601, 14590668524370014871, 7913760757699485751, 120515621346051629},
+	{8466934642219550157, 11672534819496011897, 10020357420901498924, 96412497076841303},
+	{10462896528517550449, 1959330226112988871, 15394983566205019786, 77129997661473042},
+	{5672588001402349749, 17892323620748423487, 9874578446960390364, 123407996258356868},
+	{8227419215863790122, 6935161267114918143, 15278360387052132938, 98726397006685494},
+	{6581935372691032098, 16616175457917665484, 15912037124383616673, 78981117605348395},
+	{14220445411047561680, 4449787844216802835, 7012515325304235062, 126369788168557433},
+	{11376356328838049344, 3559830275373442268, 12988709889727208696, 101095830534845946},
+	{9101085063070439475, 13915910664524484784, 6701619097039856633, 80876664427876757},
+	{3493689656686972190, 3818712989529624039, 14411939370005680937, 129402663084602811},
+	{13862998169575308722, 10433668021107519877, 7840202681262634426, 103522130467682249},
+	{3711700906176426331, 8346934416886015902, 9961510959752017864, 82817704374145799},
+	{5938721449882282130, 9665746252275715120, 4870371091377497613, 132508326998633279},
+	{15819023604131556673, 353899372336751449, 7585645687843908414, 106006661598906623},
+	{8965870068563335016, 11351165942095132129, 13447214179758947377, 84805329279125298},
+	{3277345665475605055, 18161865507352211407, 17826193872872405480, 135688526846600477},
+	{6311225347122394367, 7150794776397948479, 6882257468814103738, 108550821477280382},
+	{8738329092439825817, 5720635821118358783, 16573852419277013960, 86840657181824305},
+	{10291977733161810984, 9153017313789374053, 8071419797133670720, 138945051490918889},
+	{854884557045628141, 11011762665773409566, 10146484652448846899, 111156041192735111},
+	{15441302904604143806, 8809410132618727652, 4427838907217167196, 88924832954188089},
+	{9948689388398988796, 14095056212189964244, 14463239881031288160, 142279732726700942},
+	{4269602695977280714, 3897347340268150749, 4191894275341209882, 113823786181360754},
+	{3415682156781824571, 17875273131182161892, 7042864235014878228, 91059028945088603},
+	{10111243354909280303, 10610869690203819190, 13012989017495723229, 72847223156070882},
+	{8799291738371027839, 9598693874842290058, 6063387169025515874, 116555557049713412},
+	{10728782205438732594, 3989606285131921723, 15918756179446143669, 93244445639770729},
+	{4893676949609075752, 10570382657589358025, 16424353758298825258, 74595556511816583},
+	{451185489890700557, 9533914622659152194, 4142873124826658474, 119352890418906534},
+	{11428994836138291415, 15005829327611142401, 7003647314603237102, 95482312335125227},
+	{9143195868910633132, 15694012276830824244, 16670964295908320651, 76385849868100181},
+	{7250415760773192365, 10353024383961677498, 848101170259940780, 122217359788960291},
+	{9489681423360464215, 4593070692427431675, 15435876195175593917, 97773887831168232},
+	{11281093953430281696, 7363805368683855663, 4970003326656654487, 78219110264934586},
+	{10671052696004630066, 8092739775152258738, 573307693166826533, 125150576423895338},
+	{12226190971545614376, 2784843005379896667, 7837343784017281873, 100120461139116270},
+	{9780952777236491501, 9606572033787737980, 6269875027213825498, 80096368911293016},
+	{4581477999352655432, 4302468809834649799, 2653102414058300151, 128154190258068826},
+	{18422577658449765638, 14510021492093450808, 16879877190214281413, 102523352206455060},
+	{11048713312017902188, 539970749449029677, 13503901752171425131, 82018681765164048},
+	{10299243669744822853, 8242650828602268130, 17916893988732369886, 131229890824262477},
+	{15618092565279678929, 13972818292365635150, 6954817561502075262, 104983912659409982},
+	{16183822866965653467, 14867603448634418443, 16631900493427391179, 83987130127527985},
+	{11136721328177404254, 12720119073589338540, 8164296715774274271, 134379408204044777},
+	{16288074692025744049, 17554792888355291478, 17599483816845150386, 107503526563235821},
+	{13030459753620595240, 6665136681200412536, 10390238238734209986, 86002821250588657},
+	{9780689161567221414, 6974869875178749735, 1866985923007094685, 137604514000941852},
+	{445853699769956485, 16647942344368730758, 12561635182631406717, 110083611200753481},
+	{15114078218783606481, 2250307431269253636, 6359959331363215051, 88066888960602785},
+	{9425129891086129076, 14668538334256536788, 10175934930181144081, 140907022336964456},
+	{7540103912868903261, 4356133037921408784, 4451399129403004942, 112725617869571565},
+	{2342734315553212285, 14552952874562857997, 3561119303522403953, 90180494295657252},
+	{12942233896668300798, 11642362299650286397, 13916941887043654132, 72144395436525801},
+	{2260830160959729661, 14938430864698547913, 14888409389786025965, 115431032698441282},
+	{1808664128767783729, 4572047062275017684, 4532029882345000126, 92344826158753026},
+	{16204326561981868276, 14725684094045745116, 18383019164843641393, 73875860927002420},
+	{14858876054945258271, 1425001662021730247, 10966086590040274614, 118201377483203873},
+	{15576449658698116940, 12208047773843115167, 16151566901516040337, 94561101986563098},
+	{5082462097474672906, 9766438219074492134, 1853207076987101300, 75648881589250479},
+	{4442590541217566326, 4558254706293456445, 10343828952663182727, 121038210542800766},
+	{10932770062457873707, 11025301394518585802, 4585714347388635858, 96830568434240613},
+	{16124913679450119612, 5130892300872958318, 11047269107394729333, 77464454747392490},
+	{18421164257636370733, 4520078866654822986, 17675630571831566933, 123943127595827984},
+	{3668884961883365617, 14684109537549589359, 17829853272207163869, 99154502076662387},
+	{2935107969506692494, 8057938815297761164, 6885184988281910449, 79323601661329910},
+	{1006823936468797666, 1824655660250686893, 11016295981251056719, 126917762658127856},
+	{8184156778658858780, 1459724528200549514, 5123687970258935052, 101534210126502285},
+	{2857976608185176701, 12235826066786170581, 4098950376207148041, 81227368101201828},
+	{4572762573096282721, 8509275262632141960, 2868971787189526543, 129963788961922925},
+	{11036907687960846823, 14186117839589534214, 2295177429751621234, 103971031169538340},
+	{16208223779852498105, 15038243086413537694, 1836141943801296987, 83176824935630672},
+	{3797065159312535028, 12993142494035929342, 6627175924823985503, 133082919897009075},
+	{3037652127450028023, 17773211624712564120, 5301740739859188402, 106466335917607260},
+	{13498168146185753388, 6839871670286230649, 4241392591887350722, 85173068734085808},
+	{10529022589671474451, 10943794672457969039, 3096879332277850832, 136276909974537293},
+	{12112566886479089884, 8755035737966375231, 9856201095306101312, 109021527979629834},
+	{2311355879699451261, 3314679775631189862, 11574309690986791373, 87217222383703867},
+	{7387518222261032340, 5303487641009903779, 3761500246611224904, 139547555813926188},
+	{2220665763066915549, 15310836557033653993, 10387897826772800569, 111638044651140950},
+	{12844579054679263409, 15938018060368833517, 8310318261418240455, 89310435720912760},
+	{5793931228519180161, 7054084822880582012, 13296509218269184729, 142896697153460416},
+	{15703191427041075099, 5643267858304465609, 6947858559873437460, 114317357722768333},
+	{5183855512149039433, 11893311916127393134, 12936984477382570614, 91453886178214666},
+	{7836433224461141869, 9514649532901914507, 6660238767164146168, 73163108942571733},
+	{8848944344395916668, 7844741623159242565, 6967033212720723546, 117060974308114773},
+	{10768504290258643657, 9965142113269304375, 12952324199660399483, 93648779446491818},
+	{4925454617465004603, 4282764875873533177, 17740556989212140233, 74919023557193454},
+	{15259425017427828011, 10541772616139563406, 17316844738513693403, 119870437691509527},
+	{8518191199200352085, 8433418092911650725, 6474778161327134076, 95896350153207622},
+	{14193250588844102315, 14125432103813141226, 16247868973287438230, 76717080122566097},
+	{573108053699101764, 7843296107133384670, 11239195098292259876, 122747328196105756},
+	{11526532887185012381, 17342683329932438705, 5302007263891897577, 98197862556884605},
+	{1842528680264189259, 6495449034462130318, 4241605811113518062, 78558290045507684},
+	{10326743517906523460, 3014020825655587862, 14165266927265449546, 125693264072812294},
+	{882697184841398122, 2411216660524470290, 15021562356554269960, 100554611258249835},
+	{706157747873118497, 1928973328419576232, 12017249885243415968, 80443689006599868},
+	{15887247655564630888, 14154403769697052940, 15538251001647555225, 128709902410559789},
+	{16399146939193615034, 15012871830499552675, 16119949616059954503, 102967921928447831},
+	{16808666366096802350, 15699646279141552463, 9206610878106053279, 82374337542758265},
+	{12136470926787242468, 14051387602400752972, 14730577404969685247, 131798940068413224},
+	{17087874370913614621, 7551761267178692054, 15473810738717658521, 105439152054730579},
+	{17359648311472802020, 6041409013742953643, 16068397405716037140, 84351321643784463},
+	{1949995595163110969, 5976905607246815507, 3573342960694197485, 134962114630055142},
+	{5249345290872399098, 15849570930023183375, 13926720812781088957, 107969691704044113},
+	{4199476232697919279, 12679656744018546700, 73330205999140196, 86375753363235291},
+	{10408510787058581169, 5530055531462033427, 11185374773824355284, 138201205381176465},
+	{4637459814904954612, 8113393239911537065, 8948299819059484227, 110560964304941172},
+	{7399316666665874013, 10180063406671139975, 18226686299473318351, 88448771443952937},
+	{8149557851923488097, 12598752635931913637, 14405302820189668069, 141518034310324700},
+	{2830297466796880155, 13768350923487441233, 11524242256151734455, 113214427448259760},
+	{9642935602921324770, 11014680738789952986, 9219393804921387564, 90571541958607808},
+	{15093046111820880463, 1433046961548141742, 14754212673420930698, 72457233566886246},
+	{16770176149429588094, 9671572767960847434, 16228042647989668470, 115931573707017994},
+	{2348094475317939505, 11426607029110588271, 16671782933133645099, 92745258965614395},
+	{1878475580254351604, 12830634438030380940, 13337426346506916079, 74196207172491516},
+	{3005560928406962567, 2082271027139057888, 13961184524927245081, 118713931475986426},
+	{2404448742725570054, 12733863265936977280, 7479598805199885741, 94971145180789141},
+	{12991605438406187013, 2808392983265761177, 2294330229417998270, 75976916144631313},
+	{2339824627740347604, 804079958483307561, 18428323626036438525, 121563065831410100},
+	{16629254961159919376, 643263966786646048, 14742658900829150820, 97250452665128080},
+	{2235357524702204531, 514611173429316839, 11794127120663320656, 77800362132102464},
+	{10955269669007347896, 823377877486906942, 7802556948835582080, 124480579411363943},
+	{8764215735205878317, 8037399931473346200, 13620743188552286310, 99584463529091154},
+	{10700721402906612977, 10119268759920587283, 14585943365583739371, 79667570823272923},
+	{2363758985682939470, 5122783571647208684, 1201416496482521055, 127468113317236678},
+	{12959053632772082546, 11476924486801587593, 8339830826669837490, 101974490653789342},
+	{10367242906217666037, 1802841959957449428, 17739911105561600962, 81579592523031473},
+	{12898239835206355335, 2884547135931919085, 6247764880447099600, 130527348036850358},
+	{17697289497648904914, 9686335338229355914, 12376909533841500326, 104421878429480286},
+	{10468482783377213608, 370370641099664085, 6212178812331289938, 83537502743584229},
+	{1992177194435900480, 4281941840501372860, 17318183729213884547, 133660004389734766},
+	{8972439385032541031, 10804251101884918934, 10165198168629197314, 106928003511787813},
+	{3488602693284122502, 1264703252024114501, 15510856164387178498, 85542402809430250},
+	{12960461938738416649, 16780920462206224494, 6370625789309933980, 136867844495088401},
+	{10368369550990733319, 9735387555023069272, 1407151816706036861, 109494275596070721},
+	{11984044455534496979, 409612414534634771, 15883116712332470782, 87595420476856576},
+	{8106424684629464196, 15412775122223056927, 18034289110248132604, 140152672762970522},
+	{13863837377187392003, 8640871283036535218, 7048733658714685437, 112122138210376418},
+	{23023457524182633, 6912697026429228175, 13017684556455568996, 89697710568301134},
+	{36837532038692212, 11060315242286765080, 9760248846103179424, 143516336909281815},
+	{3718818840372864093, 12537601008571322387, 7808199076882543539, 114813069527425452},
+	{10353752701782111921, 6340731992115147586, 17314605705731765801, 91850455621940361},
+	{15661699790909510183, 16140632037917849038, 10162335749843502317, 73480364497552289},
+	{13990673221229485324, 18446313631184737815, 5191690755523872738, 117568583196083663},
+	{7503189762241677936, 11067702090205879929, 11532050233902918837, 94054866556866930},
+	{2313202995051432025, 1475464042680883297, 9225640187122335070, 75243893245493544},
+	{14769171236308022210, 9739440097773233921, 3692977855170005142, 120390229192789671},
+	{15504685803788328091, 15170249707702407783, 17711777543103645406, 96312183354231736},
+	{12403748643030662473, 4757502136678105580, 10480073219741006002, 77049746683385389},
+	{12467300199365239310, 233305789201148282, 5700070707359878634, 123279594693416623},
+	{13663188974234101772, 11254691075586649595, 11938754195371723553, 98623675754733298},
+	{7241202364645371094, 5314404045727409353, 16929700985781199489, 78898940603786638},
+	{11585923783432593750, 12192395287905765288, 4951428688798457243, 126238304966058622},
+	{16647436656229895647, 9753916230324612230, 15029189395264496764, 100990643972846897},
+	{9628600510242006194, 4113784169517779461, 4644653886727776765, 80792515178277518},
+	{4337714372161478941, 2892705856486536815, 3742097404022532501, 129268024285244029},
+	{3470171497729183153, 2314164685189229452, 6683026737959936324, 103414419428195223},
+	{6465486012925256846, 12919378192377114531, 12725119019851769705, 82731535542556178},
+	{17723475250164231599, 16981656293061472926, 16670841617020921205, 132370456868089885},
+	{10489431385389474956, 13585325034449178341, 13336673293616736964, 105896365494471908},
+	{15770242737795400611, 3489562398075522026, 18048036264377210218, 84717092395577526},
+	{6785644306763089362, 12961997466404655889, 3051416319810164086, 135547347832924043},
+	{12807213074894292136, 14058946787865635034, 9819830685331951915, 108437878266339234},
+	{17624468089399254355, 14936506245034418350, 11545213363007471855, 86750302613071387},
+	{13441753684071165676, 9141014733087428068, 3714946121844313676, 138800484180914220},
+	{14442751761998842864, 3623462971728032131, 2971956897475450941, 111040387344731376},
+	{486154965373343322, 13966816821608156675, 17134960776948002045, 88832309875785100},
+	{777847944597349314, 3900162840863499064, 8969193169407251657, 142131695801256161},
+	{11690324799903610421, 10498827902174619897, 3486005720783891002, 113705356641004929},
+	{16730957469406708983, 4709713506997785594, 6478153391369023125, 90964285312803943},
+	{6006068346041546540, 11146468435082049122, 12561220342579039146, 72771428250243154},
+	{13299058168408384787, 17834349496131278595, 9029906103900731664, 116434285200389047},
+	{6949897719984797507, 10578130782163112553, 18291971327346316301, 93147428160311237},
+	{1870569361245927682, 15841202255214310689, 7254879432393232394, 74517942528248990},
+	{17750306236961125584, 14277877164117166132, 11607807091829171831, 119228708045198384},
+	{6821547360085079821, 11422301731293732906, 12975594488205247788, 95382966436158707},
+	{1767889073326153534, 9137841385034986325, 3001777961080377584, 76306373148926966},
+	{2828622517321845654, 14620546216055978120, 15870891181954335104, 122090197038283145},
+	{5952246828599386846, 15385785787586692819, 12696712945563468083, 97672157630626516},
+	{12140495092363330124, 15997977444811264578, 6468021541708864143, 78137726104501213},
+	{8356745703555597228, 18218066282214202679, 6659485651992272306, 125020361767201941},
+	{2996047748102567459, 7195755396287541497, 1638239706851907522, 100016289413761553},
+	{13464884642707784937, 5756604317030033197, 8689289394965346664, 80013031531009242},
+	{17854466613590545576, 1831869277764232469, 17592211846686464986, 128020850449614787},
+	{6904875661388615814, 8844193051695206622, 6695071847865351342, 102416680359691830},
+	{9213249343852802975, 18143400885581896267, 5356057478292281073, 81933344287753464},
+	{3673152505938753790, 14272046157963392735, 15948389594751470364, 131093350860405542},
+	{17695917263718644325, 7728288111628803864, 5380014046317355645, 104874680688324434},
+	{3088687366749184490, 9871979304044953415, 7993360051795794839, 83899744550659547},
+	{16009946231024426154, 8416469256988104817, 16478724897615182066, 134239591281055275},
+	{1739910540593809953, 3043826590848573531, 13182979918092145653, 107391673024844220},
+	{5081277247216958286, 9813758902162679471, 10546383934473716522, 85913338419875376},
+	{15508741225030953904, 12012665428718376830, 9495516665674125789, 137461341471800602},
+	{8717644165282852800, 5920783528232791141, 217715703055479985, 109969073177440482},
+	{14352812961710102886, 15804673266811963882, 11242219006670114957, 87975258541952385},
+	{11896454294510433648, 10530081967931500919, 17987550410672183932, 140760413667123816},
+	{2138465806124526272, 15802763203829021382, 10700691513795836822, 112608330933699053},
+	{12778819089125351988, 12642210563063217105, 15939250840520490104, 90086664746959242},
+	{6533706456558371267, 6424419635708663361, 5372703042932571437, 72069331797567394},
+	{14143279145235304350, 2900373787650040731, 15975022498175934946, 115310930876107830},
+	{3935925686704422834, 17077694289087673878, 12780017998540747956, 92248744700886264},
+	{10527438178847358914, 13662155431270139102, 13913363213574508688, 73798995760709011},
+	{9465203456671953615, 10791402245806491594, 14882683512235393255, 118078393217134418},
+	{193465135853742246, 16011819426129013922, 838100365562583634, 94462714573707535},
+	{14912167367650635089, 16498804355645121460, 670480292450066907, 75570171658966028},
+	{5412723714531464527, 7951342895322642721, 15830163726887748345, 120912274654345644},
+	{4330178971625171622, 10050423131000024500, 16353479796252108999, 96729819723476515},
+	{7153491992042047621, 11729687319541929923, 13082783837001687199, 77383855778781212},
+	{377540743041545223, 11388802081783267231, 6175058880235058226, 123814169246049940},
+	{11370079038658967148, 5421692850684703461, 4940047104188046581, 99051335396839952},
+	{12785412045669084042, 11716051910031583415, 15020084127576168234, 79241068317471961},
+	{2009915199360982850, 298938982340981849, 16653436974638048529, 126785709307955138},
+	{16365327418456427573, 11307197630098516448, 2254703135484707853, 101428567446364111},
+	{5713564305281321412, 12735106918820723482, 16561157767355407575, 81142853957091288},
+	{9141702888450114259, 16686822255371247248, 4361759539317190181, 129828566331346062},
+	{3624013496018181084, 2281411360071266829, 14557454075679483115, 103862853065076849},
+	{10277908426298365514, 5514477902798923786, 15335312075285496815, 83090282452061479},
+	{16444653482077384822, 16201862273962098704, 13468452876231063934, 132944451923298367},
+	{13155722785661907858, 9272141004427768640, 3396064671501030501, 106355561538638694},
+	{10524578228529526286, 7417712803542214912, 6406200551942734724, 85084449230910955},
+	{9460627536163421412, 800294041441812890, 10249920883108375559, 136135118769457528},
+	{189804399446916483, 11708281677379181282, 15578634335970521093, 108908095015566022},
+	{11219889963783264156, 9366625341903345025, 5084209839292596228, 87126476012452818},
+	{10573126312569402003, 7607902917561531394, 4445386928126243642, 139402361619924509},
+	{8458501050055521602, 2396973519307314792, 7245658357242905237, 111521889295939607},
+	{3077452025302506959, 5606927630187762157, 16864573130020055159, 89217511436751685},
+	{15991969684709742103, 16349781837784240097, 8536572934322536638, 142748018298802697},
+	{5414878118283973036, 13079825470227392078, 17897304791683760280, 114198414639042157},
+	{4331902494627178429, 3085162746698093016, 6939146203863187578, 91358731711233726},
+	{3465521995701742744, 6157479012100384736, 1861968148348639739, 73086985368986981},
+	{16612881637348519359, 9851966419360615577, 14047195481583554552, 116939176590379169},
+	{2222258865653084518, 4192224320746582139, 14927105200008753965, 93551341272303335},
+	{5467155907264377937, 3353779456597265711, 11941684160007003172, 74841073017842668},
+	{1368751822139184053, 5366047130555625138, 15417345841269294752, 119745716828548269},
+	{4784350272453257566, 603488889702589787, 16023225487757346125, 95796573462838615},
+	{14895526662188337022, 482791111762071829, 12818580390205876900, 76637258770270892},
+	{16454145030017518589, 4461814593561225250, 5752333365361761747, 122619614032433428},
+	{13163316024014014871, 3569451674848980200, 11980564321773230044, 98095691225946742},
+	{6841304004469301574, 17612956598846825453, 2205753827934763388, 78476552980757394},
+	{10946086407150882518, 13423335299187279432, 10907903754179442068, 125562484769211830},
+	{8756869125720706015, 18117365868833644192, 8726323003343553654, 100449987815369464},
+	{7005495300576564812, 3425846250841184384, 10670407217416753247, 80359990252295571},
+	{14898141295664414022, 1792005186603984691, 9693953918382984549, 128575984403672914},
+	{15607861851273441541, 8812301778767008399, 11444511949448297962, 102860787522938331},
+	{5107591851534932586, 14428539052497427366, 5466260744816728046, 82288630018350665},
+	{11861495777197802461, 15706964854512063139, 8746017191706764874, 131661808029361064},
+	{2110498992274421322, 1497525439383919542, 10686162568107322223, 105329446423488851},
+	{16445794452787178351, 4887369166249045956, 4859581239743947455, 84263557138791081},
+	{11555875865491844068, 441093036514652884, 396632354106495282, 134821691422065730},
+	{5555351877651564931, 11420920873437453277, 317305883285196225, 107857353137652584},
+	{754932687379341622, 12826085513491872945, 3943193521370067303, 86285882510122067},
+	{1207892299806946595, 2074992747877445096, 9998458448934018009, 138057412016195307},
+	{12034360284071288246, 16417389457269597369, 620069129663393760, 110445929612956246},
+	{9627488227257030597, 9444562751073767572, 15253450562698356301, 88356743690364996},
+	{4335934719385517985, 353905142750386823, 17026823270833549436, 141370789904583994},
+	{10847445404992235034, 283124114200309458, 17310807431408749872, 113096631923667195},
+	{8677956323993788028, 11294545735585978536, 13848645945126999897, 90477305538933756},
+	{10631713873936940745, 16414334217952603475, 7389567941359689594, 72381844431147005},
+	{5942695754073374223, 15194888304498434591, 11823308706175503351, 115810951089835208},
+	{4754156603258699378, 15845259458340657996, 16837344594424223327, 92648760871868166},
+	{7492674097348869826, 1608161122446795427, 9780526860797468339, 74119008697494533},
+	{920232111532460752, 6262406610656783007, 11959494162534039019, 118590413915991253},
+	{4425534503967878925, 16077971732751157375, 16946292959511051861, 94872331132793002},
+	{10919125232658123786, 1794330941975194930, 6178336738125020843, 75897864906234402},
+	{17470600372252998057, 2870929507160311888, 13574687595741943672, 121436583849975043},
+	{2908433853576667476, 2296743605728249511, 18238447706077375584, 97149267079980034},
+	{6016095897603244304, 9216092514066420255, 18280106979603810790, 77719413663984027},
+	{13315102250907101210, 18435096837248182731, 14490775908398455971, 124351061862374444},
+	{6962732985983770645, 14748077469798546185, 15281969541460675100, 99480849489899555},
+	{5570186388787016516, 11798461975838836948, 12225575633168540080, 79584679591919644},
+	{12601647036801136749, 7809492717116408147, 8492874568843933159, 127335487347071431},
+	{2702619999957088753, 6247594173693126518, 3104950840333236204, 101868389877657145},
+	{13230142444191401972, 8687424153696411537, 2483960672266588963, 81494711902125716},
+	{13789530281222422508, 2831832201688527490, 15042383519852273311, 130391539043401145},
+	{7342275410236027683, 17022861020318463285, 12033906815881818648, 104313231234720916},
+	{9563169142930732470, 17307637630996680951, 5937776637963544595, 83450584987776733},
+	{4233024184463440982, 5556127321143227583, 5811093805999761030, 133520935980442773},
+	{18143814606538394079, 11823599486398402712, 12027572674283629470, 106816748784354218},
+	{14515051685230715263, 16837577218602542816, 17000755768910724222, 85453399027483374},
+	{8466687437401503128, 1114681846570696244, 16133162786031427787, 136725438443973399},
+	{6773349949921202502, 15649140736224198288, 16595879043567052552, 109380350755178719},
+	{9108028774678872325, 8829963774237448307, 16966052049595552365, 87504280604142975},
+	{18262194854228106043, 14127942038779917291, 8698939205643332168, 140006848966628761},
+	{14609755883382484835, 14991702445765844156, 3269802549772755411, 112005479173303009},
+	{7998455891964077545, 11993361956612675325, 6305190854560114652, 89604383338642407},
+	{1729482982916793101, 8121332686354549551, 13777654182038093767, 143367013341827851},
+	{5072935201075344804, 13875763778567460287, 7332774530888564690, 114693610673462281},
+	{11437045790344096490, 7411262208112057906, 2176870809968941429, 91754888538769825},
+	{9149636632275277192, 9618358581231556648, 1741496647975153143, 73403910831015860},
+	{7260720982156622861, 11700024915228580314, 2786394636760245029, 117446257329625376},
+	{9497925600467208612, 9360019932182864251, 16986510968375837316, 93957005863700300},
+	{219642850889946243, 3798667131004381078, 13589208774700669853, 75165604690960240},
+	{11419475005649644958, 2388518594865099401, 3295989965811520149, 120264967505536385},
+	{9135580004519715967, 5600163690633989844, 2636791972649216119, 96211974004429108},
+	{3619115188873862450, 15548177396732922845, 9488131207603193541, 76969579203543286},
+	{9479933116940090243, 10119688575805035259, 7802312302681289020, 123151326725669258},
+	{205248864068251548, 15474448490127848854, 13620547471628851862, 98521061380535406},
+	{11232245535480332208, 1311512347876548113, 7207089162561171167, 78816849104428325},
+	{17971592856768531533, 5787768571344387304, 11531342660097873867, 126106958567085320},
+	{10687925470672914903, 15698261301301240813, 9225074128078299093, 100885566853668256},
+	{1171642747054511276, 16247957855782902974, 3690710487720728951, 80708453482934605},
+	{1874628395287218042, 171290866059272496, 5905136780353166323, 129133525572695368},
+	{12567749160455505403, 14894427951815059289, 12102807053766353704, 103306820458156294},
+	{2675501698880583676, 847495917226316462, 13371594457754993287, 82645456366525035},
+	{11659500347692754528, 5045342282304016662, 2947807058698437643, 132232730186440057},
+	{1948902648670382976, 4036273825843213330, 13426292091184481084, 105786184149152045},
+	{5248470933678216704, 6918367875416480987, 10741033672947584867, 84628947319321636},
+	{8397553493885146727, 7380039785924459256, 9806956247232315141, 135406315710914618},
+	{6718042795108117381, 9593380643481477728, 15224262627269672759, 108325052568731694},
+	{1685085421344583582, 15053402144269002829, 15868758916557648530, 86660042054985355},
+	{10074834303635154377, 5638699357120852910, 6943270192782686033, 138656067287976569},
+	{681169813424302856, 15579005929922413298, 9243964968968059149, 110924853830381255},
+	{11612982294965173254, 16152553558679840961, 7395171975174447319, 88739883064305004},
+	{7512725227718546237, 3707992805436283599, 764228716053384742, 141983812902888007},
+	{13388877811658657636, 6655743059090937202, 11679429417068438763, 113587050322310405},
+	{10711102249326926109, 12703292076756570408, 9343543533654751010, 90869640257848324},
+	{1190184169977720241, 13851982476147166650, 11164183641665711131, 72695712206278659},
+	{1904294671964352385, 3716427888125915024, 6794647382439406841, 116313139530045855},
+	{1523435737571481908, 17730537569468373312, 5435717905951525472, 93050511624036684},
+	{8597446219541006173, 10495081240832788326, 8037923139503130701, 74440409299229347},
+	{2687867507039878907, 13102781170590550999, 16550025837946919445, 119104654878766955},
+	{5839642820373813449, 10482224936472440799, 13240020670357535556, 95283723903013564},
+	{8361063071040961082, 8385779949177952639, 14281365351027938768, 76226979122410851},
+	{9688352098923627408, 2349201474458993253, 15471486932160881383, 121963166595857362},
+	{15129379308622722573, 1879361179567194602, 4998491916244884460, 97570533276685890},
+	{4724805817414357412, 1503488943653755682, 3998793532995907568, 78056426621348712},
+	{11249038122604882182, 2405582309846009091, 10087418467535362432, 124890282594157939},
+	{1620532868600085100, 16681861106844448566, 11759283588770200268, 99912226075326351},
+	{1296426294880068080, 17034837700217469176, 5718078056274249891, 79929780860261081},
+	{16831677330775750220, 12498345061380309388, 1770227260554979180, 127887649376417730},
+	{2397295420394869207, 9998676049104247511, 1416181808443983344, 102310119501134184},
+	{5607185151057805689, 15377638468767218655, 4822294261497096998, 81848095600907347},
+	{8971496241692489101, 6157477476317998232, 11405019633137265521, 130956952961451755},
+	{14555894622837811928, 1236633166312488262, 9124015706509812417, 104765562369161404},
+	{576669254044518573, 15746701792017631903, 10988561379949760256, 83812449895329123},
+	{4612019621213140039, 14126676423002480075, 13892349393177706087, 134099919832526597},
+	{7378964511712422355, 14990689953143894383, 3735181885058344223, 107279935866021278},
+	{9592520424111848207, 8303203147773205183, 10366843137530496025, 85823948692817022},
+	{15348032678578957131, 16974473851179038616, 1829553761081152347, 137318317908507236},
+	{15967774957605076028, 2511532636717499923, 16221038267832563171, 109854654326805788},
+	{5395522336600240176, 5698574924115910262, 1908784170040319567, 87883723461444631},
+	{8632835738560384281, 5428371063843546096, 14122101116290242277, 140613957538311409},
+	{17974315035074038395, 653348036332926553, 14987029707774104145, 112491166030649127},
+	{14379452028059230716, 11590724873292072212, 4610926136735462669, 89992932824519302},
+	{871030356443307206, 11166462167783494894, 11066830633518650594, 143988692519230883},
+	{15454219544122287058, 1554472104742975268, 16232162136298741122, 115190954015384706},
+	{8674026820555919323, 8622275313278200861, 9296380894297082574, 92152763212307765},
+	{6939221456444735459, 10587169065364471012, 7437104715437666059, 73722210569846212},
+	{7413405515569666410, 9560772875099332973, 15588716359442176018, 117955536911753939},
+	{5930724412455733128, 269920670595645732, 16160321902295651138, 94364429529403151},
+	{1055230715222676180, 3905285351218426909, 9238908707094610587, 75491543623522521},
+	{5377717959098192210, 2559107747207572731, 7403556301867556293, 120786469797636034},
+	{11680871996762374415, 13115332641991789154, 9612193856235955357, 96629175838108827},
+	{16723395226893720178, 14181614928335341646, 311057455504943639, 77303340670487062},
+	{12000037104062310992, 15311886255852725988, 4187040743549820146, 123685345072779299},
+	{16978727312733669440, 12249509004682180790, 7038981409581766440, 98948276058223439},
+	{17272330664928845875, 13488956018487654955, 9320533942407323475, 79158620846578751},
+	{1810287360692781138, 14203632000096427283, 7534156678367896914, 126653793354526002},
+	{5137578703296135234, 7673556785335231503, 17095371786920048501, 101323034683620801},
+	{4110062962636908187, 17206891872493916172, 9986948614794128477, 81058427746896641},
+	{6576100740219053099, 5394934107538803936, 8600420154186784918, 129693484395034626},
+	{5260880592175242479, 8005296100772953472, 3190987308607517611, 103754787516027701},
+	{7898053288482104307, 17472283324844093747, 17310185105853655381, 83003830012822160},
+	{8947536446829456567, 2130211616557177733, 9249552095656296995, 132806128020515457},
+	{7158029157463565254, 12772215737471473156, 20944047041216949, 106244902416412366},
+	{2037074511228941880, 10217772589977178525, 14774150496600614852, 84995921933129892},
+	{10638016847450127654, 5280389699737754670, 8881245535593342471, 135993475093007828},
+	{12199762292702012447, 7913660574532114059, 14483694057958494623, 108794780074406262},
+	{13449158648903520281, 6330928459625691247, 4208257616882975052, 87035824059525010},
+	{10450607394019901479, 13818834350143016319, 6733212187012760083, 139257318495240016},
+	{15739183544699741830, 14744416294856323378, 1697220934868297743, 111405854796192013},
+	{16280695650501703787, 8106184221143148379, 8736474377378458841, 89124683836953610},
+	{7602368967093174443, 5591197124345216761, 13978359003805534146, 142599494139125776},
+	{13460592803158360201, 15541004143701904378, 7493338388302516993, 114079595311300621},
+	{3389776613042867514, 16122152129703433826, 2305321895900103271, 91263676249040497},
+	{6401170105176204335, 1829675259537016091, 12912303960945813587, 73010940999232397},
+	{10241872168281926935, 10306178044743046392, 5902291078545660446, 116817505598771836},
+	{11882846549367451872, 866244806310616467, 1032484048094618034, 93454004479017469},
+	{9506277239493961497, 8071693474532313820, 4515336053217604750, 74763203583213975},
+	{15210043583190338396, 12914709559251702112, 7224537685148167600, 119621125733142360},
+	{4789337237068450070, 10331767647401361690, 5779630148118534080, 95696900586513888},
+	{11210167419138580703, 15644111747404909998, 12002401747978647910, 76557520469211110},
+	{14246919055879818801, 6583834722138304381, 757098723056285041, 122492032750737777},
+	{15086884059445765364, 12645765407194464151, 11673725422670759002, 97993626200590221},
+	{15758856062298522614, 17495309955239391967, 5649631523394696878, 78394900960472177},
+	{10456774440709994890, 9545751854673475532, 12728759252173425329, 125431841536755483},
+	{12054768367309906235, 257903854254959779, 17561705031222560910, 100345473229404386},
+	{9643814693847924988, 14963718342371609116, 10360015210236138404, 80276378583523509},
+	{4362057065930949011, 1805856459343112647, 5507977892152090478, 128442205733637615},
+	{3489645652744759209, 8823382796958310764, 4406382313721672382, 102753764586910092},
+	{10170414151679628014, 10748055052308558934, 14593152295203068875, 82203011669528073},
+	{1515267383719763529, 13507539268951783972, 1212950783873448261, 131524818671244918},
+	{15969609165943452116, 14495380229903337500, 8349058256582579255, 105219854936995934},
+	{16465036147496672016, 15285652998664580323, 10368595420007973727, 84175883949596747},
+	{11586662577027033933, 13388998353637597548, 1832357413045116671, 134681414319354796},
+	{9269330061621627146, 3332501053426257392, 16223281189403734630, 107745131455483836},
+	{14794161678781122364, 17423396101708647206, 9289276136781077380, 86196105164387069},
+	{5223914612340244165, 16809387318508104561, 3794795374623992839, 137913768263019311},
+	{489782875130285009, 13447509854806483649, 17793231558666835564, 110331014610415448},
+	{15149221559071869300, 3379310254361366272, 3166538802707737482, 88264811688332359},
+	{2102661606063528941, 16474942851203917006, 12445159713816200617, 141223698701331774},
+	{12750175729076554122, 9490605466221223281, 13645476585794870817, 112978958961065419},
+	{2821442953777422652, 3903135558235068302, 14605730083377806977, 90383167168852335},
+	{5946503177763848445, 14190554890813785611, 11684584066702245581, 72306533735081868},
+	{9514405084422157511, 11636841381076326008, 15005985691981682607, 115690453976130989},
+	{11300872882279636332, 5620124290119150483, 15694137368327256409, 92552363180904791},
+	{16419395935307529712, 4496099432095320386, 8865961079919894804, 74041890544723833},
+	{4134940608040585600, 10883107906094422942, 10496188913129921363, 118467024871558133},
+	{10686650115916289127, 5017137510133628030, 15775648759987757737, 94773619897246506},
+	{15928017722216851948, 11392407637590723070, 8931170193248295866, 75818895917797205},
+	{18106130726063142470, 10849154590661336266, 14289872309197273386, 121310233468475528},
+	{14484904580850513976, 12368672487270979336, 363851403132087739, 97048186774780423},
+	{519877220454680211, 2516240360332962823, 7669778751989490838, 77638549419824338},
+	{8210501182211308984, 15094031020758471486, 8582297188441275017, 124221679071718941},
+	{10257749760510957511, 1007178372381046219, 3176488936011109691, 99377343257375153},
+	{15584897437892586655, 4495091512646747298, 9919888778292708399, 79501874605900122},
+	{13867789456402407678, 18260192864460526647, 1114426786300692145, 127202999369440196},
+	{26185120896195173, 10918805476826510995, 15648936688008195009, 101762399495552156},
+	{20948096716956138, 8735044381461208796, 8829800535664645684, 81409919596441725},
+	{33516954747129821, 2908024566112203104, 14127680857063433095, 130255871354306760},
+	{3716162378539614180, 2326419652889762483, 11302144685650746476, 104204697083445408},
+	{14040976347057422314, 5550484537053720309, 16420413378004417827, 83363757666756326},
+	{7708166896324234409, 5191426444544042172, 447219701613696261, 133382012266810123},
+	{2477184702317477204, 7842489970377144061, 7736473390774777655, 106705609813448098},
+	{5671096576595892087, 13652689605785535895, 13567876342103642770, 85364487850758478},
+	{5384405707811517015, 18154954554514947109, 18019253332623918109, 136583180561213565},
+	{11686222195733034259, 18213312458353868010, 14415402666099134487, 109266544448970852},
+	{13038326571328337730, 18259998781425004731, 4153624503395486943, 87413235559176682},
+	{13482624884641519722, 10769253976570455954, 10335148020174689433, 139861176894682691},
+	{18164797537197036424, 12304751995998275086, 4578769601397841223, 111888941515746153},
+	{7153140400273808493, 6154452782056709746, 11041713310602093625, 89511153212596922},
+	{7755675825696183265, 13536473266032645917, 2909346037995708507, 143217845140155076},
+	{6204540660556946612, 18207876242309937380, 17084872089364208098, 114574276112124060},
+	{12342330157929377936, 3498254549622218934, 13667897671491366479, 91659420889699248},
+	{6184515311601592026, 13866650083923506117, 18313015766676913829, 73327536711759398},
+	{17273922128046367887, 7429244875309968494, 7164732338231600188, 117324058738815038},
+	{13819137702437094310, 2254047085506064472, 13110483500069100797, 93859246991052030},
+	{14744658976691585771, 12871284112630582547, 10488386800055280637, 75087397592841624},
+	{1455361474255075295, 13215356950725111430, 5713372435862718050, 120139836148546599},
+	{4853637994145970559, 14261634375321999467, 8260046763432084763, 96111868918837279},
+	{7572259210058686770, 4030609870773778927, 10297386225487578134, 76889495135069823},
+	{1047568291868167863, 10138324607979956607, 12786469146038214691, 123023192216111717},
+	{838054633494534290, 15489357315867785932, 2850477687346751106, 98418553772889374},
+	{11738490151021358402, 12391485852694228745, 5969730964619311208, 78734843018311499},
+	{4024188982666532150, 5068982105343124700, 16930267172874718580, 125975748829298398},
+	{10598048815617046367, 11433883313758320406, 2476167294074043894, 100780599063438719},
+	{12167787867235547417, 16525804280490476971, 5670282650001145438, 80624479250750975},
+	{8400414143351144897, 4305193960333301215, 9072452240001832702, 128999166801201560},
+	{17788377758906646887, 14512201612492371941, 7257961792001466161, 103199333440961248},
+	{14230702207125317510, 15299110104735807876, 13185067063084993575, 82559466752768998},
+	{11701077087174777046, 2342483279125830663, 17406758486194079398, 132095146804430397},
+	{16739559299223642283, 1873986623300664530, 6546709159471442872, 105676117443544318},
+	{13391647439378913826, 1499189298640531624, 12616064957060974944, 84540893954835454},
+	{6669240644038620829, 17156098136792491892, 9117657487071828940, 135265430327736727},
+	{9024741329972806987, 6346180879950172867, 18362172433883194122, 108212344262189381},
+	{7219793063978245589, 12455642333443958940, 11000389132364644974, 86569875409751505},
+	{483622458139461973, 8860981289284603335, 17600622611783431959, 138511800655602408},
+	{11454944410737300548, 18156831475653413637, 3012451645201014597, 110809440524481927},
+	{5474606713847930116, 18214813995264641233, 13478007760386542647, 88647552419585541},
+	{1380673112672867538, 7007609503971964034, 14186114787134647590, 141836083871336866},
+	{1104538490138294031, 1916738788435660904, 7659543014965807749, 113468867097069493},
+	{15641026051078276517, 12601437474974259692, 13506332041456466845, 90775093677655594},
+	{8823472026120710891, 13770498794721318077, 14494414447907083799, 72620074942124475},
+	{6738857612309316779, 10964751627328377954, 4744319042941782463, 116192119907399161},
+	{12769783719331274069, 12461150116604612686, 106106419611515647, 92953695925919329},
+	{2837129345981198609, 6279571278541779826, 3774233950431122841, 74362956740735463},
+	{4539406953569917774, 17426011675150668368, 2349425505947886222, 118980730785176741},
+	{18388920821823575512, 2872762895894803724, 16636935663725950271, 95184584628141392},
+	{7332439027975039764, 9676907946199663626, 5930850901496939570, 76147667702513114},
+	{11731902444760063622, 4415006269693730832, 16868059071878923959, 121836268324020982},
+	{16764219585291871544, 18289400274722625958, 6115749628019318520, 97469014659216786},
+	{17100724482975407558, 10942171405036190443, 1203250887673544493, 77975211727373429},
+	{8914415099051100477, 2750078989090263417, 9303899049761491836, 124760338763797486},
+	{10820880893982790705, 13268109635497941703, 3753770425067283145, 99808271011037989},
+	{1278007085702411918, 14303836523140263686, 6692365154795736839, 79846616808830391},
+	{13112857781349590037, 4439394363314870281, 3329086618189358297, 127754586894128626},
+	{14179635039821582353, 10930213120135716871, 17420664553519127930, 102203669515302900},
+	{7654359217115355560, 8744170496108573497, 13936531642815302344, 81762935612242320},
+	{4868277117900748249, 2922626349547986626, 3851706554794932135, 130820696979587713},
+	{7583970509062508922, 9716798709122209947, 10460062873319766354, 104656557583670170},
+	{2377827592508096815, 11462787782039678281, 8368050298655813083, 83725246066936136},
+	{3804524148012954903, 7272414007037754280, 6010182848365480287, 133960393707097818},
+	{3043619318410363923, 5817931205630203424, 12186843908176204876, 107168314965678254},
+	{6124244269470201461, 4654344964504162739, 13438823941282874224, 85734651972542603},
+	{2420093201668501691, 11136300757948570706, 17812769491310688435, 137175443156068165},
+	{16693469820302442646, 8909040606358856564, 14250215593048550748, 109740354524854532},
+	{17044124670983864440, 7127232485087085251, 4021474844955019952, 87792283619883626},
+	{16202553029348452134, 7714223161397426079, 17502406196153762893, 140467653791813801},
+	{1893995979253030738, 9860727343859851187, 10312576142181099991, 112374123033451041},
+	{5204545598144334914, 509884245604060303, 4560712099002969670, 89899298426760833},
+	{948575327547115215, 15573210051934137778, 3607790543662841148, 143838877482817333},
+	{4448209076779602495, 8769219226805399899, 10264930064414093565, 115071101986253866},
+	{3558567261423681996, 3326026566702409596, 4522595236789364529, 92056881589003093},
+	{10225551438622766244, 13728867697587658646, 10996773818915312269, 73645505271202474},
+	{5292835857570695020, 18276839501398343511, 6526791666038768661, 117832808433923959},
+	{544919871314645693, 14621471601118674809, 8910782147572925252, 94266246747139167},
+	{7814633526535537201, 15386526095636850170, 18196672162284071171, 75412997397711333},
+	{1435367198231128551, 13550395308793229303, 6978582571203051935, 120660795836338134},
+	{12216340202810633811, 14529665061776493765, 9272214871704351871, 96528636669070507},
+	{17151769791732327695, 555685605195464042, 39074267879660851, 77222909335256406},
+	{16374785222545993342, 4578445783054652791, 11130565272833188331, 123556654936410249},
+	{9410479363294884351, 3662756626443722233, 12593801033008460988, 98845323949128199},
+	{7528383490635907481, 13998251745380708756, 13764389641148679113, 79076259159302559},
+	{8356064770275541646, 7639807533641492717, 10954976981612155612, 126522014654884095},
+	{10374200630962343640, 17179892471138925143, 8763981585289724489, 101217611723907276},
+	{15678058134253695558, 13743913976911140114, 3321836453489869268, 80974089379125821},
+	{2948800126354450954, 10922215918832093214, 16382984769809521799, 129558543006601313},
+	{17116435360051202056, 1359075105581853924, 2038341371621886470, 103646834405281051},
+	{13693148288040961645, 15844655343433124432, 16388068356265150468, 82917467524224840},
+	{3462293187155987015, 3215355661041537153, 7774165296314689134, 132667948038759745},
+	{13837880993950520582, 6261633343575140045, 6219332237051751307, 106134358431007796},
+	{2258350934685496, 12388004304343932683, 1286116974899490722, 84907486744806237},
+	{3692962176237407117, 8752760442724561323, 5747135974581095479, 135851978791689979},
+	{17711764999957566986, 14380905983663469704, 8287057594406786706, 108681583033351983},
+	{3101365555740322620, 15194073601672686087, 14008343705009250011, 86945266426681586},
+	{12340882518668336837, 9553122503708656446, 15034652298530979372, 139112426282690538},
+	{6183357200192759147, 7642498002966925157, 959675394599052528, 111289941026152431},
+	{1257336945412296994, 9803347217115450449, 15525135574646883315, 89031952820921944},
+	{16769134371627316483, 4617309103158989748, 13772170475209282335, 142451124513475111},
+	{17104656312043763510, 4498467785281475, 7328387565425515545, 113960899610780089},
+	{17373073864376921131, 3692947588970135503, 9552058867082322759, 91168719688624071},
+	{2830412647275805936, 2954358071176108403, 3952298278923947884, 72934975750899257},
+	{11907357865125110143, 15795019358107504414, 10013026061020226937, 116695961201438811},
+	{2147188662616267468, 1567969042260272562, 4321072034074271227, 93356768961151049},
+	{9096448559576834621, 16011770492775859342, 7146206442001327304, 74685415168920839},
+	{14554317695322935393, 3482739899989913008, 365883862976392718, 119496664270273343},
+	{15332802971000258638, 17543587178959571699, 7671404719864934820, 95597331416218674},
+	{1198195932574475941, 17724218557909567683, 9826472590633858179, 76477865132974939},
+	{12985159936344892475, 6222656804203846353, 4654309700788442118, 122364584212759903},
+	{14077476763817824303, 1288776628621166759, 11102145390114574341, 97891667370207922},
+	{3883283781570438796, 8409718932380754054, 1503018682607838826, 78313333896166338},
+	{2523905235770791750, 2387503847583475517, 17162225151140183415, 125301334233866140},
+	{13087170632842364370, 1910003078066780413, 13729780120912146732, 100241067387092912},
+	{3091038876790070850, 5217351277195334654, 3605126467245896739, 80192853909674330},
+	{1256313388122203036, 15726459672996356093, 5768202347593434782, 128308566255478928},
+	{8383748339981583075, 12581167738397084874, 11993259507558568472, 102646853004383142},
+	{14085696301469087107, 13754283005459578222, 2215909976563034131, 82117482403506514},
+	{7779718823382898078, 3560108735025773540, 10924153591984675257, 131387971845610422},
+	{9913123873448228785, 6537435802762529155, 1360625244103919559, 105110377476488338},
+	{551801469274762382, 16297995086435754294, 8467197824766956293, 84088301981190670},
+	{4572231165581530134, 3940699249845744931, 13547516519627130070, 134541283169905072},
+	{11036482561949044754, 14220605844102326914, 3459315586217883409, 107633026535924058},
+	{5139837234817325480, 3997787045798040885, 10146150098458127374, 86106421228739246},
+	{8223739575707720768, 6396459273276865416, 8855142528049183152, 137770273965982794},
+	{17647038104791907584, 1427818603879582009, 10773462837181256845, 110216219172786235},
+	{17806979298575436390, 1142254883103665607, 8618770269745005476, 88172975338228988},
+	{2665725174527325962, 9206305442449685619, 10100683616850098438, 141076760541166381},
+	{9511277769105681416, 11054393168701658818, 4391198078738168427, 112861408432933105},
+	{7609022215284545133, 1464816905477506408, 3512958462990534742, 90289126746346484},
+	{9776566586969546430, 15929248783349646419, 6499715585134338116, 72231301397077187},
+	{885111280183632994, 3350705164907972332, 14088893750956851310, 115570082235323499},
+	{15465484283114547688, 6369912946668288188, 14960463815507391371, 92456065788258799},
+	{1304340982265907181, 5095930357334630551, 15657719867147823420, 73964852630607039},
+	{2086945571625451490, 15532186201219229528, 13984305343210786502, 118343764208971263},
+	{9048254086784181838, 12425748960975383622, 119397830342898232, 94675011367177011},
+	{7238603269427345471, 17319296798264127544, 14852913523241959878, 75740009093741608},
+	{11581765231083752753, 1885433174029231808, 1628568748735673867, 121184014549986574},
+	{12954760999608912525, 16265741798191026739, 4992203813730449416, 96947211639989259},
+	{14053157614429040344, 13012593438552821391, 7683111865726269856, 77557769311991407},
+	{11417005738860733580, 17130800686942603903, 15982327799903942093, 124092430899186251},
+	{1754906961604766218, 17393989364295993446, 9096513425181243351, 99273944719349001},
+	{8782623198767633621, 6536493861952974110, 3587861925403084358, 79419155775479201},
+	{2984150673802482823, 17837087808608579223, 16808625524870665942, 127070649240766721},
+	{17144715798009627551, 3201623802661132408, 9757551605154622431, 101656519392613377},
+	{10026423823665791718, 9939996671612726573, 427343654639877298, 81325215514090702},
+	{12352929303123356425, 15903994674580362517, 4373098662165714000, 130120344822545123},
+	{9882343442498685140, 1655149295438559044, 10877176559216391847, 104096275858036098},
+	{11595223568740858436, 1324119436350847235, 16080438876856934124, 83277020686428878},
+	{3794962451017732204, 5807939912903265900, 3592609314519632659, 133243233098286206},
+	{3035969960814185763, 4646351930322612720, 17631482710583347420, 106594586478628964},
+	{6118124783393258934, 7406430359000000499, 17794534983208588259, 85275669182903171},
+	{17167697282913034940, 11850288574400000798, 2645814269940368952, 136441070692645075},
+	{13734157826330427952, 2101533230036179992, 2116651415952295162, 109152856554116060},
+	{14676675075806252685, 12749273028254674963, 1693321132761836129, 87322285243292848},
+	{5035936047580452680, 5641441586239838649, 17466709071386579100, 139715656389268556},
+	{4028748838064362144, 823804454249960596, 10284018442367352957, 111772525111414845},
+	{10601696699935310362, 11727090007625699446, 8227214753893882365, 89418020089131876},
+	{2205319460928855286, 11384646382717298468, 5784845976746391138, 143068832142611002},
+	{9142953198226904875, 9107717106173838774, 15695923225622843880, 114455065714088801},
+	{7314362558581523900, 3596824870197160696, 8867389765756364781, 91564052571271041},
+	{13230187676349039767, 13945506340383459526, 3404562997863181501, 73251242057016833},
+	{2721556208448912010, 11244763700387804273, 1757951981839180079, 117201987291226933},
+	{2177244966759129608, 1617113330826422772, 8785059214955164710, 93761589832981546},
+	{9120493602891124333, 16051085923628779510, 3338698557222221444, 75009271866385237},
+	{7214092135141978286, 18303039848322226570, 9031266506297464634, 120014834986216379},
+	{13149971337597403276, 3574385434432050286, 10914362019779882031, 96011867988973103},
+	{10519977070077922621, 6548857162287550552, 16110187245307726271, 76809494391178482},
+	{16831963312124676193, 6788822644918170560, 11018904333524720741, 122895191025885572},
+	{2397524205474009985, 12809755745418357095, 1436425837335955946, 98316152820708458},
+	{5607368179121118311, 13937153411076595999, 8527838299352585403, 78652922256566766},
+	{5282440271851878974, 11231399013496822629, 6265843649480315999, 125844675610506826},
+	{7915301032223413502, 8985119210797458103, 1323326104842342476, 100675740488405461},
+	{6332240825778730802, 18256141812863697452, 15816056142841515273, 80540592390724368},
+	{6442236506504058960, 3384385197388543661, 3169596940094962499, 128864947825158990},
+	{5153789205203247168, 6396856972652745252, 2535677552075969999, 103091958260127192},
+	{11501728993646418381, 1428136763380285878, 13096588485886506969, 82473566608101753},
+	{18402766389834269409, 5974367636150367728, 17265192762676500827, 131957706572962805},
+	{14722213111867415527, 15847540553146025152, 13812154210141200661, 105566165258370244},
+	{4399072860010111776, 12678032442516820122, 14739072182854870852, 84452932206696195},
+	{14417214205499999487, 5527456649059270902, 5135771418858241748, 135124691530713913},
+	{465724920174268620, 732616504505506399, 11487314764570414045, 108099753224571130},
+	{4061928750881325219, 586093203604405119, 9189851811656331236, 86479802579656904},
+	{13877783630893940997, 937749125767048190, 3635716454424399008, 138367684127451047},
+	{11102226904715152798, 750199300613638552, 13976619607765250176, 110694147301960837},
+	{8881781523772122238, 7978857069974731488, 3802598056728379494, 88555317841568670},
+	{17900199252777305904, 1698124867733839411, 6084156890765407191, 141688508546509872},
+	{18009508216963755047, 8737197523670892175, 15935371956838056722, 113350806837207897},
+	{18096955388312914361, 10679106833678624063, 5369599935986624731, 90680645469766318},
+	{7098866681166510842, 12232634281684809574, 11674377578273120431, 72544516375813054},
+	{290140245640686378, 1125470776986143703, 7610957681011261721, 116071226201300887},
+	{14989507455480190395, 8279074251072735608, 17156812589034740346, 92856980961040709},
+	{923559520158421347, 6623259400858188487, 17414798885969702600, 74285584768832567},
+	{8856392861737294801, 14286563856115011902, 13106282958583882867, 118856935630132108},
+	{18153160733615566810, 11429251084892009521, 17863723996350926940, 95085548504105686},
+	{7143830957408632802, 5454052053171697294, 10601630382338831229, 76068438803284549},
+	{15119478346595722806, 5037134470332805347, 5894562167516398997, 121709502085255279},
+	{1027536233050847275, 340358761524333955, 8404998548755029521, 97367601668204223},
+	{4511377801182588143, 3961635823961377487, 14102696468487844263, 77894081334563378},
+	{3528855667150230706, 17406663762563934949, 428221461129088881, 124630530135301406},
+	{17580479792687825858, 6546633380567327312, 15099972427870912398, 99704424108241124},
+	{17753732648892171009, 16305353148679592819, 15769326757038640241, 79763539286592899},
+	{17337925794001742645, 7641820964177796895, 14162876367036093417, 127621662858548639},
+	{10180991820459483793, 2424107956600327193, 15019649908370785057, 102097330286838911},
+	{4455444641625676711, 9317983994764082401, 8326371111954717722, 81677864229471129},
+	{10818060241342993061, 7530076762138711195, 2254147334901817386, 130684582767153807},
+	{16033145822558215095, 13402759039194789602, 12871364312147184878, 104547666213723045},
+	{12826516658046572076, 18100904860839652328, 10297091449717747902, 83638132970978436},
+	{13143729023390694676, 6825354888891981786, 9096648690064575998, 133821012753565498},
+	{3136285589228735094, 1770935096371675106, 14656016581535481445, 107056810202852398},
+	{6198377286124898399, 8795445706581160731, 656766821002654186, 85645448162281919},
+	{2538706028316016791, 14072713130529857170, 8429524543088067344, 137032717059651070},
+	{5720313637394723756, 14947519319165796059, 6743619634470453875, 109626173647720856},
+	{4576250909915779005, 8268666640590726524, 1705546892834452777, 87700938918176685},
+	{18390047900090977377, 16919215439687072761, 2728875028535124443, 140321502269082696},
+	{14712038320072781902, 17224721166491568532, 16940495281795740847, 112257201815266156},
+	{11769630656058225522, 2711730488967523856, 9863047410694682355, 89805761452212925},
+	{11452711420209340188, 4338768782348038170, 15780875857111491768, 143689218323540680},
+	{16540866765651292797, 10849712655362251182, 12624700685689193414, 114951374658832544},
+	{13232693412521034238, 16058467753773621592, 13789109363293265054, 91961099727066035},
+	{6896805915274917067, 16536123017760807597, 11031287490634612043, 73568879781652828},
+	{7345540649697956984, 632355125223919893, 13960711170273468947, 117710207650644525},
+	{5876432519758365587, 11573930544404866884, 11168568936218775157, 94168166120515620},
+	{1011797201064782147, 1880446806040072861, 8934855148975020126, 75334532896412496},
+	{16376270780671292727, 6698063704406026900, 6917070608876211555, 120535252634259994},
+	{16790365439278944505, 9047799778266731843, 9223005301842879567, 96428202107407995},
+	{13432292351423155604, 18306286266839116444, 7378404241474303653, 77142561685926396},
+	{17802318947535138643, 18222011582716855341, 4426749156875065199, 123428098697482234},
+	{17931203972770021238, 3509562821947753303, 7230748140241962483, 98742478957985787},
+	{3276916733990286021, 2807650257558202643, 16852644956419300956, 78993983166388629},
+	{1553717959642547309, 4492240412093124229, 15896185486045150560, 126390373066221807},
+	{16000369626681679140, 14661838773900230352, 5338250759352299801, 101112298452977446},
+	{16489644516087253636, 4350773389636363635, 581251792739929518, 80889838762381957},
+	{7936687152030054201, 6961237423418181817, 4619351683125797552, 129423742019811131},
+	{6349349721624043361, 12947687568218366100, 6132531758727718, 103538993615848905},
+	{12458177406783055335, 17736847684058513526, 4906025406982174, 82831194892679124},
+	{8865037406627157566, 6242863406042159703, 7386547270134992126, 132529911828286598},
+	{18160076369527457023, 8683639539575638085, 13287935445591814347, 106023929462629278},
+	{14528061095621965618, 6946911631660510468, 18009045985957272124, 84819143570103422},
+	{12176851308769414019, 3736360981172996103, 14057078318563994106, 135710629712165476},
+	{9741481047015531216, 14057135229164127852, 7556313840109284961, 108568503769732381},
+	{11482533652354335296, 3867010553847481635, 2355702257345517646, 86854803015785905},
+	{10993356214283115826, 17255263330381701586, 3769123611752828233, 138967684825257448},
+	{1415987341942672015, 10114861849563450946, 10393996518886083233, 111174147860205958},
+	{12200836317779868582, 4402540664908850433, 15693894844592687233, 88939318288164766},
+	{4763942849480148437, 14422762693337981340, 17731534121864478926, 142302909261063626},
+	{14879200723809849720, 4159512525186564425, 10495878482749672818, 113842327408850901},
+	{15592709393789790099, 7016958834891161863, 4707353971457827931, 91073861927080721},
+	{12474167515031832079, 16681613512138660460, 76534362424352021, 72859089541664577},
+	{16269319209309021003, 4554488730970394797, 3811803794620873558, 116574543266663323},
+	{1947408923221485833, 18400986243743957131, 10428140665180519492, 93259634613330658},
+	{16315322397544829959, 14720788994995165704, 15721210161628236240, 74607707690664526},
+	{7657771762362176319, 16174564762508444481, 17775238629121357338, 119372332305063242},
+	{2436868595147830732, 12939651810006755585, 6841493273813265224, 95497865844050594},
+	{9328192505602085232, 17730419077489225114, 9162543433792522502, 76398292675240475},
+	{7546410379479515725, 13611275265015118890, 14660069494068036004, 122237268280384760},
+	{9726477118325522903, 14578369026754005435, 11728055595254428803, 97789814624307808},
+	{4091832879918507999, 7973346406661294025, 16761142105687363689, 78231851699446246},
+	{6546932607869612799, 12757354250658070440, 992385665906409640, 125170962719113995},
+	{5237546086295690239, 10205883400526456352, 793908532725127712, 100136770175291196},
+	{4190036869036552191, 15543404349904985728, 15392522085147743462, 80109416140232956},
+	{17772105434684214475, 2733354071396515225, 17249337706752568894, 128175065824372730},
+	{17907033162489281904, 5876032071859122503, 13799470165402055115, 102540052659498184},
+	{6946928900507604877, 8390174472229208326, 14728924947063554415, 82032042127598547},
+	{7425737426070257479, 17113627970308643645, 8808884656334045771, 131251267404157676},
+	{17008636385081936953, 6312204746763094269, 3357758910325326294, 105001013923326141},
+	{17296257922807459886, 5049763797410475415, 17443602387227902328, 84000811138660912},
+	{9227268602782384201, 8079622075856760665, 13152368560597002432, 134401297821857460},
+	{3117252742086714, 17531744104911139502, 10521894848477601945, 107521038257485968},
+	{2493802193669372, 2957348839703180632, 15796213508265902203, 86016830605988774},
+	{7382687712993691641, 8421106958266999334, 14205895168999712555, 137626928969582039},
+	{13284847799878773959, 10426234381355509790, 15054064949941680367, 110101543175665631},
+	{18006575869386839814, 15719685134568228478, 8353903145211433970, 88081234540532505},
+	{6674428502567481762, 6704752141599613950, 13366245032338294353, 140929975264852008},
+	{1650193987312075087, 1674452898537780837, 18071693655354456129, 112743980211881606},
+	{12388201634075391039, 1339562318830224669, 10768006109541654580, 90195184169505285},
+	{13599910122002223155, 1071649855064179735, 8614404887633323664, 72156147335604228},
+	{7002460936235915754, 5403988582844597900, 10093699005471407539, 115449835736966765},
+	{9291317563730642927, 8012539681017588643, 8074959204377126031, 92359868589573412},
+	{3743705236242604018, 13788729374297891561, 17528013807727431794, 73887894871658729},
+	{13368626007471987075, 18372618184134716174, 16976775648138159901, 118220631794653967},
+	{3316203176493769014, 3630048103082041970, 6202722889026707275, 94576505435723174},
+	{6342311355936925534, 6593387297207543899, 8651527125963276143, 75661204348578539},
+	{2769000540015260208, 14238768490273980562, 2774396957315510859, 121057926957725663},
+	{5904549246754118490, 4012317162735363803, 9598215195336229334, 96846341566180530},
+	{15791685841629025762, 6899202544930201365, 7678572156268983467, 77477073252944424},
+	{17887999717122620572, 3660026442404501538, 1217669005804642578, 123963317204711079},
+	{14310399773698096458, 13996067598149332200, 4663484019385624385, 99170653763768863},
+	{380273374732746197, 128807634293734791, 11109484844992320155, 79336523011015090},
+	{11676483843798124884, 206092214869975665, 17775175751987712248, 126938436817624144},
+	{1962489445554679261, 11232920216121711502, 17909489416332080121, 101550749454099315},
+	{8948689185927564055, 5296987358155458878, 14327591533065664097, 81240599563279452},
+	{18007251512226012811, 15853877402532554851, 8166751193937421262, 129984959301247124},
+	{7027103580296989603, 8993753107284133558, 10222749769891847333, 103987967440997699},
+	{5621682864237591682, 18263048930053037816, 11867548630655388189, 83190373952798159},
+	{16373390212263967338, 7084785399633398566, 7920031364822890134, 133104598324477055},
+	{13098712169811173870, 9357177134448629176, 6336025091858312107, 106483678659581644},
+	{3100272106365118450, 3796392892816993018, 8758168888228560009, 85186942927665315},
+	{8649784184926099843, 13452926257991009475, 14013070221165696014, 136299108684264504},
+	{14298524977424700521, 18141038635876628226, 14899804991674467134, 109039286947411603},
+	{370773537714029447, 7134133279217481935, 851797549113842738, 87231429557929283},
+	{11661284104568178085, 4035915617264150449, 16120271337549789674, 139570287292686852},
+	{9329027283654542468, 17986127752778961652, 5517519440556011092, 111656229834149482},
+	{3773873012181723651, 18078251016965079645, 15482061996670539843, 89324983867319585},
+	{2348848004748847518, 6789108738692665493, 6324555120963312134, 142919974187711337},
+	{5568427218540988338, 1741938176212222071, 16127690540996380677, 114335979350169069},
+	{15522788219058521640, 16150945799937418949, 16591501247539014864, 91468783480135255},
+	{1350184131021086343, 16610105454691845483, 13273200998031211891, 73175026784108204},
+	{16917689868601379441, 8129424653797401156, 10169075152624208057, 117080042854573127},
+	{13534151894881103553, 10192888537779831248, 756562492615545799, 93664034283658502},
+	{14516670330646793165, 4464962015481954675, 11673296438318167609, 74931227426926801},
+	{4779928455325317448, 7143939224771127481, 11298576671825247528, 119889963883082882},
+	{134593949518343636, 5715151379816901985, 1660163707976377376, 95911971106466306},
+	{11175721603840405878, 15640167548079252557, 16085526225348743193, 76729576885173044},
+	{6813108121918918435, 10266872817959162799, 14668795516332258140, 122767323016276871},
+	{5450486497535134748, 4524149439625419916, 8045687598323896189, 98213858413021497},
+	{15428435642253838768, 18376714810667977225, 17504596522884847920, 78571086730417197},
+	{9928101768638500736, 14645348438101122268, 13249959177648115380, 125713738768667516},
+	{11631830229652710912, 8026929935738987491, 6910618527376581981, 100570991014934013},
+	{9305464183722168730, 10110892763333100316, 12907192451385086231, 80456792811947210},
+	{131347434987828675, 8798730791849139860, 2204763848506586354, 128730868499115537},
+	{14862473206957904233, 3349635818737401564, 12831857523031000053, 102984694799292429},
+	{8200629750824413063, 13747755099215652221, 13954834833166710365, 82387755839433943},
+	{2052961157093329931, 18307059344003133231, 191642844615274645, 131820409343094310},
+	{16399764184642305238, 14645647475202506584, 153314275692219716, 105456327474475448},
+	{2051764903488113221, 15405866794903915591, 7501349050037596419, 84365061979580358},
+	{18040219104548622445, 9891991612878623652, 8312809665318243948, 134984099167328573},
+	{3364128839413166987, 4224244475560988599, 14028945361738415805, 107987279333862858},
+	{13759349515756264559, 10758093209932611525, 155109845165001674, 86389823467090287},
+	{14636261595726202648, 9834251506408357794, 3937524567005913002, 138223717547344459},
+	{11709009276580962118, 4178052390384775912, 6839368468346640725, 110578974037875567},
+	{13056556236006680018, 14410488356533551699, 16539541218903043549, 88463179230300453},
+	{13511792348126867382, 8299386111486041426, 4327173061793407740, 141541086768480726},
+	{3430736249017673259, 2950160074446922818, 18219133708402367485, 113232869414784580},
+	{10123286628697959254, 2360128059557538254, 14575306966721893988, 90586295531827664},
+	{4409280488216457080, 12956148891871761573, 15349594388119425513, 72469036425462131},
+	{10744197595888241651, 9661791782769087547, 17180653391507260175, 115950458280739410},
+	{1216660447226772675, 7729433426215270038, 13744522713205808140, 92760366624591528},
+	{15730723616749059433, 13562244370456036676, 18374315800048467158, 74208293299673222},
+	{17790460157314674445, 3252846919020107066, 14641510021109906161, 118733269279477156},
+	{3164321681626008587, 13670323979441816623, 8023859202146014605, 94986615423581725},
+	{9910154974784627516, 10936259183553453298, 6419087361716811684, 75989292338865380},
+	{1098852700687762733, 6429968249459794308, 10270539778746898695, 121582867742184608},
+	{15636477419517851479, 12522672229051656092, 15595129452481339602, 97266294193747686},
+	{12509181935614281183, 17396835412725145520, 8786754747243161358, 77813035354998149},
+	{5257295838015208600, 13077541401392591540, 2990761151363327204, 124500856567997039},
+	{11584534299895987527, 17840730750597893878, 6081957735832572086, 99600685254397631},
+	{9267627439916790021, 6893886970994494456, 1176217373924147346, 79680548203518105},
+	{70808644899222741, 3651521524107370484, 1881947798278635754, 127488877125628968},
+	{14814042174887019486, 13989263663511627356, 8884255868106729249, 101991101700503174},
+	{15540582554651525912, 123364486583570915, 10796753509227293723, 81592881360402539},
+	{10107536828474800166, 3886731993275623788, 6206759170537938987, 130548610176644063},
+	{15464727092263660779, 3109385594620499030, 12344104965914171836, 104438888141315250},
+	{8682432859069018300, 17244903734664040517, 9875283972731337468, 83551110513052200},
+	{13891892574510429280, 5455753087011002888, 15800454356370139950, 133681776820883520},
+	{45467615382612455, 4364602469608802311, 12640363485096111960, 106945421456706816},
+	{11104420536531820933, 18249077234654683141, 6422941973334979244, 85556337165365453},
+	{14077724043709003170, 14441128316479851733, 6587358342594056468, 136890139464584725},
+	{7572830420225292213, 484856208958150417, 5269886674075245175, 109512111571667780},
+	{17126310780405964740, 387884967166520333, 4215909339260196140, 87609689257334224},
+	{12644701989681902291, 7999313576950253180, 14124152572300134470, 140175502811734758},
+	{17494459221229342479, 13778148491044023190, 231275613614376606, 112140402249387807},
+	{2927520932757743014, 18401216422319039199, 11253066935117232254, 89712321799510245},
+	{994684677670478499, 18373899831484731749, 18004907096187571607, 143539714879216392},
+	{8174445371620203445, 18388468679929695722, 7025228047466236639, 114831771903373114},
+	{6539556297296162756, 3642728499718025608, 9309531252714899635, 91865417522698491},
+	{8920993852578840528, 17671578058742061779, 3758276187430009384, 73492334018158793},
+	{6894892534642324199, 13517129635019657554, 2323893085146104692, 117587734429054069},
+	{5515914027713859359, 7124354893273815720, 5548463282858794077, 94070187543243255},
+	{15480777666396818457, 16767530358844783545, 4438770626287035261, 75256150034594604},
+	{6322500192525357915, 8381304500442102057, 14480730631543077065, 120409840055351366},
+	{12436697783504106978, 3015694785611771322, 7895235690492551329, 96327872044281093},
+	{13638707041545195906, 13480602272715148027, 13694886181877861709, 77062297635424874},
+	{3375187192762761833, 17879614821602326521, 10843771446778847765, 123299676216679799},
+	{2700149754210209467, 17993040672023771540, 12364365972164988535, 98639740973343839},
+	{5849468618110077897, 18083781352360927555, 13580841592473901151, 78911792778675071},
+	{13048498603718034958, 14176654904809842795, 14350648918474421196, 126258868445880114},
+	{10438798882974427966, 11341323923847874236, 15169867949521447280, 101007094756704091},
+	{972341476895721727, 5383710324336389066, 8446545544875247501, 80805675805363273},
+	{1555746363033154762, 15992634148422043152, 9825124057058485678, 129289081288581237},
+	{12312643534652254780, 12794107318737634521, 481401616162967896, 103431265030864990},
+	{2471417198237983177, 6545937040248197294, 385121292930374317, 82745012024691992},
+	{264918702438862760, 17852196893880936317, 4305542883430509230, 132392019239507187},
+	{3901283776693000532, 6903059885620928407, 14512480750970138354, 105913615391605749},
+	{3121027021354400425, 12901145537980563372, 15299333415518021006, 84730892313284599},
+	{8682992048908951004, 2195088787059349779, 13410887020603102641, 135569427701255359},
+	{10635742453869071126, 1756071029647479823, 14418058431224392436, 108455542161004287},
+	{4819245148353346578, 8783554453201804505, 4155749115495693302, 86764433728803430},
+	{11400141052107264847, 17743035939864797531, 6649198584793109283, 138823093966085488},
+	{1741415212201991232, 10505079937149927702, 12698056497318308073, 111058475172868390},
+	{1393132169761592985, 15782761579203762808, 10158445197854646458, 88846780138294712},
+	{16986406730586190069, 6805674453016468876, 1496117057599793041, 142154848221271540},
+	{6210427754985131409, 1755190747671264778, 1196893646079834433, 113723878577017232},
+	{12347039833471925774, 1404152598137011822, 12025561361089598516, 90979102861613785},
+	{17256329496261361265, 15880717337477250750, 9620449088871678812, 72783282289291028},
+	{9163383120308626408, 6962403666254049585, 11703369727452775777, 116453251662865645},
+	{18398752940472632096, 16637969377228970637, 9362695781962220621, 93162601330292516},
+	{18408351167120016000, 5931677872299355863, 3800807810827866174, 74530081064234013},
+	{11006617793682473984, 13180033410420879705, 2391943682582675555, 119248129702774421},
+	{5115945420204068864, 6854677913594793441, 16670950205033781737, 95398503762219536},
+	{7782105150905165415, 12862439960359655399, 9647411349285115066, 76318803009775629},
+	{1383321797222533694, 2133159862865897023, 4367811714630453137, 122110084815641007},
+	{12174703882003757925, 5395876705034627941, 14562295815930093479, 97688067852512805},
+	{9739763105603006340, 8006050178769612676, 11649836652744074783, 78150454282010244},
+	{11894272154222899820, 16499029100773290605, 7571692200164788683, 125040726851216391},
+	{13204766538120230180, 16888572095360542807, 2368004945389920623, 100032581480973113},
+	{17942510859980004790, 9821508861546523922, 9273101585795757145, 80026065184778490},
+	{13950622117000366371, 15714414178474438276, 14836962537273211432, 128041704295645584},
+	{3781800064116472451, 8882182528037640298, 15558918844560479469, 102433363436516467},
+	{6714788866035088284, 3416397207688201915, 5068437446164562929, 81946690749213174},
+	{7054313370914230931, 1776886717559212741, 15488197543347121333, 131114705198741078},
+	{16711497140957115714, 16178904633015011485, 1322511590451966096, 104891764158992863},
+	{17058546527507602895, 16632472521153919511, 8436706901845393523, 83913411327194290},
+	{16225627999786433662, 4475863145394809279, 13498731042952629638, 134261458123510864},
+	{9291153585087236606, 14648736960541578393, 14488333649104014033, 107409166498808691},
+	{54225238585968639, 15408338383175173038, 7901318104541300903, 85927333199046953},
+	{7465458011221370468, 17274643783596456214, 8952760152524171122, 137483733118475125},
+	{2283017594235186051, 6441017397393344325, 7162208122019336898, 109986986494780100},
+	{9205111704871969487, 12531511547398496106, 5729766497615469518, 87989589195824080},
+	{3660132283569420210, 16361069661095683447, 9167626396184751229, 140783342713318528},
+	{6617454641597446491, 5710158099392726111, 14712798746431621630, 112626674170654822},
+	{12672661342761777840, 15636172923739911858, 4391541367661476657, 90101339336523858},
+	{17516826703693242918, 12508938338991929486, 10891930723613001972, 72081071469219086},
+	{16958876281683457699, 16324952527645176855, 10048391528296982509, 115329714350750538},
+	{6188403395862945513, 5681264392632320838, 15417410852121406654, 92263771480600430},
+	{16018769160916087380, 8234360328847766993, 12333928681697125323, 73811017184480344},
+	{7183286583756188192, 16864325340898337513, 8666239446489669547, 118097627495168551},
+	{2057280452263040230, 2423413828492939041, 3243642742449825315, 94478101996134841},
+	{12713870806036163154, 16696126321761992525, 17352309452927501544, 75582481596907872},
+	{12963495660174040400, 888360411625815778, 13006299865716361179, 120931970555052596},
+	{17749494157623052966, 710688329300652622, 6715691077831178620, 96745576444042077},
+	{17888944140840352696, 11636597107666253067, 16440599306490673865, 77396461155233661},
+	{6486217736893102375, 11239857742782184262, 479517187191705922, 123834337848373859},
+	{12567671818998302546, 5302537379483837086, 4072962564495275061, 99067470278699087},
+	{13743486269940552360, 11620727533070890315, 14326416495821951018, 79253976222959269},
+	{7232182772937242483, 3835768793945783212, 11854219949089390660, 126806361956734831},
+	{13164443847833614633, 17826010294124267862, 5794027144529602204, 101445089565387865},
+	{6842206263524981383, 17950157050041324613, 4635221715623681763, 81156071652310292},
+	{7258181206898059890, 10273507206356567765, 11105703559739801145, 129849714643696467},
+	{16874591409744178882, 840108135601433565, 1505865218308020270, 103879771714957174},
+	{17189021942537253429, 4361435323223057175, 4894040989388326539, 83103817371965739},
+	{5366342219608143546, 3288947702414981158, 15209163212505143109, 132966107795145182},
+	{7982422590428425160, 17388553420899626219, 4788632940520293840, 106372886236116146},
+	{6385938072342740128, 10221493921977790652, 141557537674324749, 85098308988892917},
+	{6528152101006473882, 8975692645680644397, 3915840875020829922, 136157294382228667},
+	{1533172866063268782, 10869902931286425841, 14200719144242394907, 108925835505782933},
+	{15983933551818256319, 8695922345029140672, 292528871168184956, 87140668404626347},
+	{7127549609199658494, 10224126937304714753, 4157395008611006253, 139425069447402155},
+	{2012690872617816472, 15557999179327592449, 3325916006888805002, 111540055557921724},
+	{1610152698094253178, 8757050528720163636, 6350081620252954325, 89232044446337379},
+	{2576244316950805084, 2943234401726530848, 17538828221888547567, 142771271114139806},
+	{16818390712528285360, 9733285150865045324, 10341713762768927730, 114217016891311845},
+	{17144061384764538611, 7786628120692036259, 8273371010215142184, 91373613513049476},
+	{17404597922553541212, 6229302496553629007, 2929347993430203424, 73098890810439581},
+	{13089961417118024647, 9966883994485806412, 15755003233714056448, 116958225296703329},
+	{14161317948436330041, 594809566104824483, 16293351401713155482, 93566580237362663},
+	{261007914523333063, 475847652883859587, 1966634677144793416, 74853264189890131},
+	{7796310292721153547, 4450705059356085662, 14214661927657400435, 119765222703824209},
+	{2547699419435012515, 7249912862226778853, 15061078356867830671, 95812178163059367},
+	{16795554794515651305, 13178627919265243728, 4670165056010443890, 76649742530447494},
+	{12115492412257400794, 10017758226598658996, 14850961719100530871, 122639588048715990},
+	{2313696300322099989, 4324857766537016874, 11880769375280424697, 98111670438972792},
+	{9229654669741500638, 7149235027971523822, 2125917870740519111, 78489336351178234},
+	{10052212618759727, 11438776044754438116, 10780166222668651224, 125582938161885174},
+	{3697390584836918105, 16529718465287371139, 12313481792876831302, 100466350529508139},
+	{2957912467869534484, 9534425957487986588, 13540134249043375365, 80373080423606511},
+	{12111357578075075821, 7876383902496957894, 14285517168985579938, 128596928677770418},
+	{9689086062460060657, 2611758307255655992, 360367290962732981, 102877542942216335},
+	{15129966479451869172, 16846801904772166086, 288293832770186384, 82302034353773068},
+	{2071853478671528735, 12197487788667824446, 15218665391399939508, 131683254966036908},
+	{12725529227162953958, 6068641416192349233, 1106885868894220637, 105346603972829527},
+	{2801725752246542520, 8544261947695789710, 11953555139341107479, 84277283178263621},
+	{4482761203594468032, 13670819116313263536, 11746990593461951320, 134843653085221794},
+	{3586208962875574426, 14626004107792521152, 13086941289511471379, 107874922468177435},
+	{17626362429268100833, 15390152100975927244, 10469553031609177103, 86299937974541948},
+	{9755435813119409717, 17245545732077662945, 13061936035832773042, 138079900759267117},
+	{11493697465237438097, 17485785400404040679, 3070851199182397787, 110463920607413694},
+	{9194957972189950478, 10299279505581322220, 6146029774087828553, 88371136485930955},
+	{11022583940762010441, 12789498394188205229, 9833647638540525685, 141393818377489528},
+	{1439369523125787706, 17610296344834384830, 15245615740316241194, 113115054701991622},
+	{15908890877468271458, 10398888261125597540, 4817794962769172309, 90492043761593298},
+	{5348415072490796520, 940412979416657386, 11232933599699158494, 72393635009274638},
+	{4868115301243364109, 5194009581808562141, 14283344944776743267, 115829816014839421},
+	{7583841055736601610, 11533905294930670359, 7737327141079484290, 92663852811871537},
+	{2377724029847370965, 1848426606460715641, 17257908157089318402, 74131082249497229},
+	{7493707262497703867, 14025529014562875995, 16544606607117178473, 118609731599195567},
+	{5994965809998163094, 11220423211650300796, 5856987656209922132, 94887785279356454},
+	{15864019092224261445, 5286989754578330313, 8374938939709848029, 75910228223485163},
+	{6935686473849266695, 12148532422067238825, 9710553488793846523, 121456365157576261},
+	{9237897993821323679, 13408174752395701383, 4079093976293166895, 97165092126061009},
+	{11620765573238297, 14415888616658471430, 6952623995776443839, 77732073700848807},
+	{11086639669142912245, 15686724157169733641, 14813547207984220466, 124371317921358091},
+	{16248009364798150442, 5170681696251966266, 8161488951645466050, 99497054337086473},
+	{16687756306580430677, 11515242986485393659, 13907888790800193486, 79597643469669178},
+	{4564317202077227144, 7356342334150898886, 116529176828847639, 127356229551470686},
+	{18408849020629423008, 5885073867320719108, 14850618600430719404, 101884983641176548},
+	{14727079216503538407, 15776105538082306256, 812448436118844553, 81507986912941239},
+	{1427233857954199511, 10484373601964048718, 8678615127273971932, 130412779060705982},
+	{12209833530589090579, 12076847696313149297, 18010938546044908515, 104330223248564785},
+	{2389169194987451817, 9661478157050519438, 14408750836835926812, 83464178598851828},
+	{133321897238012583, 15458365051280831101, 917908450486020960, 133542685758162926},
+	{11174703962016141036, 8677343226282754557, 15491722019356458061, 106834148606530340},
+	{16318460799096733475, 3252525766284293322, 12393377615485166449, 85467318885224272},
+	{3973444390103311621, 16272087670280600286, 5072008925808625025, 136747710216358836},
+	{14246801956308380267, 9328321321482569905, 368258325904989697, 109398168173087069},
+	{7708092750304793890, 3773308242444145601, 3983955475465902081, 87518534538469655},
+	{16022297215229580547, 17105339632136363931, 6374328760745443329, 140029655261551448},
+	{1749791327957933468, 6305574076225270499, 12478160638080175310, 112023724209241158},
+	{12467879506592077744, 12423156890464037045, 17361226139947960894, 89618979367392926},
+	{1501863136837772775, 1430306951032907657, 1952520120723365169, 143390366987828683},
+	{4890839324212128543, 12212292005052057095, 8940713726062512781, 114712293590262946},
+	{14980717903595433804, 2391135974557825029, 3463222166108099902, 91769834872210357},
+	{916527878650616074, 5602257594388170347, 13838624177112210891, 73415867897768285},
+	{16223839864808627011, 1584914521537251908, 3695054609669985810, 117465388636429257},
+	{12979071891846901609, 12335978061455532496, 14024090131961719617, 93972310909143405},
+	{17761955142961341933, 2490084819680605350, 11219272105569375694, 75177848727314724},
+	{6283035340286685154, 294786896747058238, 6882788924685270141, 120284557963703559},
+	{12405125901713168770, 235829517397646590, 9195579954490126436, 96227646370962847},
+	{17302798350854355662, 7567361243401937918, 18424510407817832118, 76982117096770277},
+	{5548384472915507120, 12107777989443100670, 14721821393540890096, 123171387354832444},
+	{4438707578332405696, 9686222391554480536, 15466805929574622400, 98537109883865955},
+	{18308361321633565850, 7748977913243584428, 12373444743659697920, 78829687907092764},
+	{14535982855646064067, 1330318216964004116, 8729465145629785703, 126127500651348423},
+	{4250088655033030607, 15821649832538844586, 14362269745987649208, 100902000521078738},
+	{14468117368252155455, 8967971051289165345, 421769352564388397, 80721600416862991},
+	{1012894900751986789, 10659404867320754230, 11742877408328752405, 129154560666980785},
+	{810315920601589431, 8527523893856603384, 9394301926663001924, 103323648533584628},
+	{15405647995448912838, 17890065559311013676, 14894139170814222185, 82658918826867702},
+	{2512943904266798601, 13866709635929980590, 9073227414335114204, 132254270122988324},
+	{9389052752897259527, 25321264518253502, 10947930746210001687, 105803416098390659},
+	{14889939831801628268, 14777652270582244094, 12447693411709911672, 84642732878712527},
+	{1687810842431143290, 12576197188705859582, 5158914199768217383, 135428372605940044},
+	{5039597488686824955, 2682260121480867019, 7816480174556484230, 108342698084752035},
+	{7721026805691370287, 2145808097184693615, 6253184139645187384, 86674158467801628},
+	{16042991703848102783, 7122641770237420107, 6315745808690389491, 138678653548482605},
+	{1766346918852751257, 2008764601448025763, 5052596646952311593, 110942922838786084},
+	{1413077535082201006, 12675058125384151580, 7731426132303759597, 88754338271028867},
+	{9639621685615342255, 9212046556388911558, 16059630626427925679, 142006941233646187},
+	{11401046163234184127, 3680288430369218923, 5469006871658519897, 113605552986916950},
+	{9120836930587347302, 14012277188521106108, 4375205497326815917, 90884442389533560},
+	{7296669544469877842, 3831124121333064240, 3500164397861452734, 72707553911626848},
+	{15364020085893714870, 9819147408874813107, 1910914221836414051, 116332086258602957},
+	{12291216068714971896, 15234015556583671132, 12596777821694862210, 93065669006882365},
+	{2454275225488156870, 12187212445266936906, 10077422257355889768, 74452535205505892},
+	{14994886805006781962, 1052795838717547433, 1366480352801782337, 119124056328809428},
+	{927862999779694600, 842236670974037947, 8471881911725246516, 95299245063047542},
+	{742290399823755680, 8052486966263051004, 17845551973605928182, 76239396050438033},
+	{8566362269201829734, 12883979146020881606, 6416790269318023152, 121983033680700854},
+	{17921136259587194757, 6617834502074794961, 8822781030196328845, 97586426944560683},
+	{18026257822411666129, 12672965231143656615, 14436922453640883722, 78069141555648546},
+	{6705919627407203867, 16587395555087940262, 15720378296341593309, 124910626489037674},
+	{5364735701925763093, 2201869999844621240, 16265651451815184971, 99928501191230139},
+	{4291788561540610475, 1761495999875696992, 16701869976194058300, 79942800952984111},
+	{3177512883723066436, 13886440044026846157, 897550258717121017, 127908481524774579},
+	{9920707936462273796, 7419803220479566602, 4407389021715607137, 102326785219819663},
+	{557868719685998390, 5935842576383653282, 10904608846856306356, 81861428175855730},
+	{15649985210465238717, 2118650492730024604, 17447374154970090170, 130978285081369168},
+	{5141290538888370327, 9073618023667840330, 2889852879750341166, 104782628065095335},
+	{423683616368785939, 3569545604192361941, 2311882303800272933, 83826102452076268},
+	{4367242600931967825, 16779319410933510075, 9662871338526369, 134121763923322029},
+	{10872491710229394906, 2355409084521077090, 3697079111812731419, 107297411138657623},
+	{1319295738699695279, 12952373711842592642, 10336360918934005781, 85837928910926098},
+	{13178919626145243415, 9655751494722417257, 12848828655552498927, 137340686257481757},
+	{6853786886174284409, 11413950010519844129, 2900365294958178495, 109872549005985406},
+	{5483029508939427527, 5441811193673964980, 17077687494934184089, 87898039204788324},
+	{5083498399561173720, 5017549095136433645, 16256253547668963573, 140636862727661319},
+	{15134845163874669946, 15082085720334877885, 16694351652877081181, 112509490182129055},
+	{8418527316357825634, 8376319761525991985, 13355481322301664945, 90007592145703244},
+	{2401597261946790044, 2334065174215856207, 10300723671456932943, 144012147433125191},
+	{16678673068525073328, 5556600954114595288, 4551230122423636031, 115209717946500153},
+	{5964240825336238016, 8134629578033586554, 11019681727422729471, 92167774357200122},
+	{15839439104494721383, 13886401291910689889, 1437047752454362930, 73734219485760098},
+	{10585707308223912919, 82149178605641884, 17056671662894621982, 117974751177216156},
+	{1089868217095309689, 7444416972368334154, 9955988515573787262, 94379800941772925},
+	{15629289832643889044, 17023580022120398292, 7964790812459029809, 75503840753418340},
+	{17628166102746401824, 16169681591166906298, 12743665299934447695, 120806145205469344},
+	{6723835252713300813, 16625094087675435362, 13884281054689468479, 96644916164375475},
+	{1689719387428730327, 16989424084882258613, 11107424843751574783, 77315932931500380},
+	{13771597464111699493, 5046985647360151841, 17771879750002519654, 123705492690400608},
+	{18395975600773180241, 15105634962113852442, 3149457355776284753, 98964394152320487},
+	{7338082851134723546, 12084507969691081954, 13587612328846758772, 79171515321856389},
+	{11740932561815557674, 11956515122021910480, 10672133281929083066, 126674424514970223},
+	{13082094864194356462, 13254560912359438707, 15916404255027087099, 101339539611976178},
+	{14155024706097395493, 3224951100403730319, 1665076959795938710, 81071631689580943},
+	{7890644270788191496, 1470572945904058188, 17421518394641143229, 129714610703329508},
+	{6312515416630553197, 12244504800948977520, 2869168271487183613, 103771688562663607},
+	{5050012333304442557, 9795603840759182016, 13363381061415477860, 83017350850130885},
+	{701322103803287445, 15672966145214691226, 2934665624555212960, 132827761360209417},
+	{7939755312526450603, 5159675286687932334, 13415778943869901338, 106262209088167533},
+	{6351804250021160482, 438391414608435544, 18111320784579741717, 85009767270534026},
+	{13852235614775767094, 15458821522341138163, 3152671552134214484, 136015627632854443},
+	{11081788491820613676, 4988359588389089884, 9900834871191192234, 108812502106283554},
+	{1486733163972670294, 11369385300195092554, 11610016711694864110, 87050001685026843},
+	{6068121877098182794, 14501667665570237763, 14886677923969872253, 139280002696042949},
+	{4854497501678546235, 4222636502972369564, 15598691153917808126, 111424002156834359},
+	{7572946816084747311, 3378109202377895651, 16168301737876156824, 89139201725467487},
+	{15806063720477506021, 16473021168030364011, 11111887521634209625, 142622722760747980},
+	{8955502161640094494, 13178416934424291209, 8889510017307367700, 114098178208598384},
+	{14543099358795896241, 14232082362281343290, 10800956828587804483, 91278542566878707},
+	{11634479487036716993, 11385665889825074632, 1262067833386422940, 73022834053502966},
+	{14925818364516836866, 10838367794236298765, 13087354977644007674, 116836534485604745},
+	{15630003506355379816, 12360043050130949335, 10469883982115206139, 93469227588483796},
+	{12504002805084303853, 9888034440104759468, 4686558370950254588, 74775382070787037},
+	{16317055673392975841, 15820855104167615149, 11187842208262317664, 119640611313259259},
+	{5674946909230560027, 1588637639108361150, 12639622581351764455, 95712489050607407},
+	{15608003971610178991, 12338956555512419889, 2733000435597590917, 76569991240485926},
+	{10215411095608645092, 16052981674077961500, 15440847141181876437, 122511985984777481},
+	{15551026505970736720, 1774338895036638230, 8663328898203590827, 98009588787821985},
+	{5062123575292768730, 12487517560255041554, 6930663118562872661, 78407671030257588},
+	{4410048905726519645, 8911981652182335517, 7399712174958685935, 125452273648412141},
+	{10906736754065036362, 3440236507003958090, 2230420925225038425, 100361818918729713},
+	{16104087032735849736, 10130886835086987118, 9163034369663851386, 80289455134983770},
+	{14698492808151628608, 8830721306655358743, 14660854991462162218, 128463128215974032},
+	{690747802295571917, 7064577045324286995, 4349986363685909128, 102770502572779226},
+	{4241947056578367857, 9341010451001339919, 18237384349916368595, 82216402058223380},
+	{14165812920009209217, 14945616721602143870, 10733070886156638136, 131546243293157409},
+	{11332650336007367374, 11956493377281715096, 12275805523667220832, 105236994634525927},
+	{9066120268805893899, 13254543516567282400, 2441946789449956019, 84189595707620742},
+	{7127094800605609592, 13828571997023831194, 7596463677861839954, 134703353132193187},
+	{5701675840484487674, 7373508782877154632, 17145217386515202933, 107762682505754549},
+	{8250689487129500462, 16966853470527454675, 17405522723954072669, 86210146004603639},
+	{9511754364665290416, 5010872664392465541, 16780789914100785302, 137936233607365823},
+	{3920054676990322010, 4008698131513972433, 2356585487054897272, 110348986885892659},
+	{6825392556334167931, 17964353764178819239, 5574617204385828140, 88279189508714127},
+	{3541930460650848043, 13985570763718469490, 12608736341759235348, 141246703213942603},
+	{17590939627488319727, 7499107796232865268, 17465686702891208925, 112997362571154082},
+	{14072751701990655782, 17067332681212023184, 6593851732829146493, 90397890056923266},
+	{190154917366793656, 17343214959711528871, 1585732571521406871, 72318312045538613},
+	{304247867786869849, 16681097491312715224, 17294567373401892287, 115709299272861780},
+	{15000793553197137172, 5966180363566351532, 13835653898721513830, 92567439418289424},
+	{8311286027815799415, 8462293105594991549, 14757871933719121387, 74053951534631539},
+	{13298057644505279063, 6160971339468165832, 12544548649724863250, 118486322455410463},
+	{10638446115604223251, 12307474701058353312, 17414336549263711246, 94789057964328370},
+	{15889454521967199247, 6156630946104772326, 13931469239410968997, 75831246371462696},
+	{14355080790921787825, 6161260699025725399, 14911653153573729749, 121329994194340314},
+	{4105367003253609614, 12307706188704400966, 15618671337600894122, 97063995355472251},
+	{6973642417344798015, 17224862580447341419, 8805588255338804974, 77651196284377801},
+	{89781423525945853, 9113036055006194655, 6710243579058267313, 124241914055004482},
+	{71825138820756683, 7290428844004955724, 16436241307472344820, 99393531244003585},
+	{3746808925798515670, 5832343075203964579, 13148993045977875856, 79514824995202868},
+	{2305545466535714748, 16710446549810163973, 17349040058822691046, 127223719992324589},
+	{9223134002712392445, 13368357239848131178, 17568580861800063160, 101778975993859671},
+	{11067856016911824279, 7005336977136594619, 10365515874698140205, 81423180795087737},
+	{10329871997575098200, 14897887978160461714, 1827430140549383035, 130277089272140380},
+	{11953246412801988883, 11918310382528369371, 1461944112439506428, 104221671417712304},
+	{16941294759725411753, 2155950676538874850, 4858904104693515466, 83377337134169843},
+	{16038025171334927835, 10828218711946020407, 4084897752767714422, 133403739414671749},
+	{1762373692842211298, 4973226154814906003, 6957267016956081861, 106722991531737399},
+	{8788596583757589685, 3978580923851924802, 9255162428306775812, 85378393225389919},
+	{10372405719270233173, 17433775922388810653, 3740213441065110329, 136605429160623871},
+	{15676622204900007185, 13947020737911048522, 17749566011819729556, 109284343328499096},
+	{16230646578661916071, 3778918960845018171, 10510303994713873322, 87427474662799277},
+	{7522290452149514098, 13424967966835849721, 2059091132574556022, 139883959460478844},
+	{17085878805945342248, 7050625558726769453, 5336621720801555141, 111907167568383075},
+	{17358051859498184122, 1951151632239505239, 4269297376641244113, 89525734054706460},
+	{13015487716229453301, 17879237870550849676, 6830875802625990580, 143241174487530336},
+	{3033692543499741995, 10614041481698769418, 1775351827358882141, 114592939590024269},
+	{9805651664283614242, 8491233185359015534, 5109630276629016036, 91674351672019415},
+	{7844521331426891394, 3103637733545302104, 4087704221303212829, 73339481337615532},
+	{12551234130283026230, 16033866817898214336, 10229675568827050849, 117343170140184851},
+	{6351638489484510661, 12827093454318571469, 4494391640319730356, 93874536112147881},
+	{1391961976845698206, 2882977133971036529, 18352908571223425578, 75099628889718304},
+	{13295185607178848098, 8302112229095568769, 18296607269731749955, 120159406223549287},
+	{6946799671001168156, 17709736227502185985, 7258588186301579317, 96127524978839430},
+	{16625486181026665494, 6789091352517928141, 5806870549041263454, 76902019983071544},
+	{15532731445416933821, 7173197349286774703, 16669690507949842173, 123043231972914470},
+	{8736836341591636733, 13117255508913240409, 13335752406359873738, 98434585578331576},
+	{14368166702757130033, 14183153221872502650, 6979253110345988667, 78747668462665261},
+	{852973835959946114, 556952266544542302, 3788107347069761222, 125996269540264418},
+	{11750425512993687861, 445561813235633841, 10409183507139629624, 100797015632211534},
+	{13089689225136860612, 7735147080072327719, 12016695620453614022, 80637612505769227},
+	{17254153945477066655, 1308188883889993381, 4469317733758141143, 129020180009230764},
+	{2735276712155922355, 12114597551337725675, 7264803001748423237, 103216144007384611},
+	{9566918999208558530, 17070375670554001186, 2122493586656828266, 82572915205907689},
+	{7928372769249873002, 8865856999176850282, 10774687368134745873, 132116664329452302},
+	{17410744659625629371, 7092685599341480225, 1241052265023976052, 105693331463561842},
+	{17617944542442413820, 9363497294215094503, 12060888256244911811, 84554665170849473},
+	{13431316008940220819, 3913549226518420236, 15608072395249948575, 135287464273359157},
+	{18123750436635997302, 14198885825440467158, 5107760286716138213, 108229971418687326},
+	{7120302719824977195, 15048457475094284050, 396859414631000247, 86583977134949861},
+	{7703135536978053189, 1941439071699392541, 11703021507635331366, 138534363415919777},
+	{9851857244324352875, 8931848886843334679, 1983719576624444446, 110827490732735822},
+	{502788165975661653, 14524176738958488390, 12655022105525286526, 88661992586188657},
+	{15561856324528699938, 1102589893882119484, 5490640109872817150, 141859188137901852},
+	{8760136244881049627, 11950118359331426557, 15460558532123984689, 113487350510321481},
+	{18076155440130570671, 9560094687465141245, 8679098010957277428, 90789880408257185},
+	{3392877907878725568, 15026773379455933643, 6943278408765821942, 72631904326605748},
+	{1739255837864050585, 5596093333419942213, 7419896639283404785, 116211046922569197},
+	{1391404670291240468, 15544921110961684740, 17003963755652454797, 92968837538055357},
+	{4802472550974902698, 16125285703511258115, 6224473375038143191, 74375070030444286},
+	{11373304896301754639, 11043061866650371691, 2580459770577208460, 119000112048710858},
+	{12787992731783314035, 16213147122804117999, 9443065445945587414, 95200089638968686},
+	{13919743000168561551, 12970517698243294399, 3865103542014559608, 76160071711174949},
+	{14892891170785877835, 5995433058221629746, 13562863296707116020, 121856114737879918},
+	{15603661751370612591, 12175044076061124443, 18228988266849513462, 97484891790303934},
+	{16172278215838400396, 6050686446106989231, 18272539428221521093, 77987913432243147},
+	{50203442148068371, 9681098313771182771, 14478667826186792456, 124780661491589036},
+	{7418860383202275343, 366181021533125570, 7893585446207523642, 99824529193271229},
+	{2245739491819909952, 15050340076194141749, 10004217171707929236, 79859623354616983},
+	{18350578445879497215, 13012497677684895828, 12317398659990776455, 127775397367387173},
+	{10991113941961687449, 17788695771631737309, 17232616557476441810, 102220317893909738},
+	{1414193524085529313, 3162910173079658878, 2718046801755422479, 81776254315127791},
+	{17020104897504488193, 5060656276927454204, 15416921327034406936, 130842006904204465},
+	{13616083918003590555, 359176206800053040, 12333537061627525549, 104673605523363572},
+	{7203518319660962121, 15044736224407683725, 2488132019818199792, 83738884418690858},
+	{11525629311457539393, 5624833885342742344, 291662416967209345, 133982215069905373},
+	{1841805819682210868, 11878564737758014522, 7612027563057588122, 107185772055924298},
+	{12541491099971499664, 9502851790206411617, 13468319679929891144, 85748617644739438},
+	{8998339315728668492, 447167605362617295, 17859962673145915508, 137197788231583101},
+	{10888020267324845117, 4047082899032004159, 10598621323774822083, 109758230585266481},
+	{16089113843343696740, 6927015133967513650, 4789548244277947343, 87806584468213185},
+	{3606489260898452845, 7393875399606111518, 7663277190844715749, 140490535149141096},
+	{10263889038202582923, 5915100319684889214, 2441272937933862276, 112392428119312877},
+	{832413601078245692, 12110777885231732018, 13021064794572820790, 89913942495450301},
+	{8710559391209013753, 11998546986886950582, 13455006041832692618, 143862307992720482},
+	{18036493957192941972, 9598837589509560465, 3385307203982333448, 115089846394176386},
+	{18118543980496263901, 11368418886349558695, 17465641022153508051, 92071877115341108},
+	{18184183999138921444, 12784083923821557279, 2904466373497075471, 73657501692272887},
+	{14337299139654633017, 16765185463372581324, 8336495012337231077, 117852002707636619},
+	{11469839311723706414, 9722799555956154736, 10358544824611695185, 94281602166109295},
+	{5486522634637054808, 7778239644764923789, 8286835859689356148, 75425281732887436},
+	{5089087400677377369, 1377136987398147093, 5880239746019149191, 120680450772619898},
+	{15139316364767632865, 4791058404660427997, 12082889426299139999, 96544360618095918},
+	{15800801906556016615, 14900893167954073367, 17045009170523132645, 77235488494476734},
+	{17902585421005805938, 12773382624500786418, 16203968228611281263, 123576781591162775},
+	{10632719522062734427, 17597403729084449781, 12963174582889025010, 98861425272930220},
+	{4816826802908277219, 14077922983267559825, 10370539666311220008, 79089140218344176},
+	{15085620514137064196, 11456630329002364750, 9214165836614131367, 126542624349350682},
+	{15757845226051561680, 12854653077943802123, 18439379113517036063, 101234099479480545},
+	{8916927366099339021, 17662420091838862345, 14751503290813628850, 80987279583584436},
+	{6888386156275121787, 2434430443748807490, 16223707635817985515, 129579647333735098},
+	{12889406554503918076, 9326241984482866638, 1910919664428657442, 103663717866988079},
+	{14000874058345044784, 3771644772844382987, 5218084546284836277, 82930974293590463},
+	{7644003234384430362, 6034631636551012780, 4659586459313827720, 132689558869744741},
+	{2425853772765633966, 1138356494498899901, 38320352709151853, 106151647095795793},
+	{13008729462438238143, 15668080454566761213, 7409353911651142128, 84921317676636634},
+	{2367223066191629412, 10311533468339176649, 786919814416096436, 135874108282618615},
+	{1893778452953303530, 4559877959929430996, 629535851532877149, 108699286626094892},
+	{12583069206588373793, 18405297626911186089, 11571675125452032688, 86959429300875913},
+	{1686166656831846453, 3623034499864525481, 14825331385981341979, 139135086881401461},
+	{16106328584433118455, 2898427599891620384, 8170916294043163260, 111308069505121169},
+	{1817016423320763795, 6008090894655206631, 10226081849976440931, 89046455604096935},
+	{6596575092055132395, 2234247801964509963, 16361730959962305490, 142474328966555096},
+	{8966608888386016239, 16544793500539249263, 9400035953227934068, 113979463173244077},
+	{14551984740192633638, 13235834800431399410, 141331133098526608, 91183570538595262},
+	{11641587792154106910, 10588667840345119528, 11181111350704552256, 72946856430876209},
+	{14937191652704660733, 16941868544552191245, 6821731716901552640, 116714970289401935},
+	{11949753322163728586, 13553494835641752996, 5457385373521242112, 93371976231521548},
+	{5870453842989072546, 10842795868513402397, 11744605928300814336, 74697580985217238},
+	{2014028519298695427, 6280426945395712866, 15102020670539392615, 119516129576347581},
+	{12679269259664687311, 1334992741574659969, 8392267721689603769, 95612903661078065},
+	{17522113037215570496, 4757343008001638298, 6713814177351683015, 76490322928862452},
+	{9588636785835361177, 11301097627544531601, 14431451498504603147, 122384516686179923},
+	{3981560613926378618, 9040878102035625281, 477114754577951548, 97907613348943939},
+	{10563946120624923541, 18300748925854231194, 4071040618404271561, 78326090679155151},
+	{9523616163516057019, 14523803022399128618, 17581711433672565468, 125321745086648241},
+	{240195301329024969, 15308391232661213218, 10376020332196142051, 100257396069318593},
+	{11260202685288950945, 15936061800870880897, 15679513895240734287, 80205916855454874},
+	{14326975481720411188, 18119001251909588789, 14019175788159443890, 128329466968727799},
+	{393533941150597981, 18184549816269581355, 14904689445269465435, 102663573574982239},
+	{4004175967662388708, 18236988667757575407, 15613100370957482671, 82130858859985791},
+	{13785379177743642579, 14421786609444479358, 17602262964048151628, 131409374175977266},
+	{3649605712711093417, 15226778102297493810, 10392461556496610979, 105127499340781813},
+	{13987731014394605703, 4802724852354174401, 15692666874681109430, 84101999472625450},
+	{15001671993547548479, 7684359763766679042, 6661522925780223472, 134563199156200721},
+	{12001337594838038783, 13526185440497163880, 1639869525882268454, 107650559324960577},
+	{5911721261128520703, 7131599537655820781, 12379942064931545733, 86120447459968461},
+	{9458754017805633125, 342512816023582280, 12429209674406652527, 137792715935949538},
+	{7567003214244506500, 274010252818865824, 17322065369009142668, 110234172748759630},
+	{17121649015621336170, 7597905831738913305, 13857652295207314134, 88187338199007704},
+	{5258545536542675932, 8467300516040350966, 11104197228105971645, 141099741118412327},
+	{11585534058717961392, 17841886857058011742, 1504660153000956669, 112879792894729862},
+	{16647124876458189760, 10584160670904499070, 12271774566626496305, 90303834315783889},
+	{17007048715908462131, 12156677351465509579, 13506768468043107367, 72243067452627111},
+	{12453882686485898117, 15761334947602905004, 14232131919385151141, 115588907924203378},
+	{17341803778672539140, 16298416772824234326, 317659091282389943, 92471126339362703},
+	{6494745393454210666, 9349384603517477138, 7632824902509732601, 73976901071490162},
+	{3012895000042916419, 11269666550886053098, 15901868658757482485, 118363041714384259},
+	{13478362444260064105, 12705082055450752801, 16410843741747896311, 94690433371507407},
+	{14472038770149961607, 17542763273844422887, 5749977363914496402, 75752346697205926},
+	{4708517958530386955, 5932328349699614681, 1821266152779373598, 121203754715529482},
+	{77465552082399241, 4745862679759691745, 12525059366449229848, 96963003772423585},
+	{7440670071149740039, 11175387773291574042, 10020047493159383878, 77570403017938868},
+	{8215723299097673739, 10501922807782697821, 12342727174313103882, 124112644828702189},
+	{17640625083503869961, 4712189431484247933, 13563530554192393429, 99290115862961751},
+	{3044453622577364999, 3769751545187398347, 7161475628612004420, 79432092690369401},
+	{1181776981381873676, 17099648916525568325, 4079663376295386425, 127091348304591042},
+	{12013468029331229910, 6301021503736634013, 14331777145262040110, 101673078643672833},
+	{5921425608723073605, 12419514832473127857, 397375271983901118, 81338462914938267},
+	{13163629788698828091, 1424479658247452955, 4325149249916152113, 130141540663901227},
+	{10530903830959062473, 1139583726597962364, 14528165844158652660, 104113232531120981},
+	{8424723064767249978, 15669062240246011184, 7933183860585011804, 83290586024896785},
+	{9790208088885689642, 14002453140167886925, 12693094176936018887, 133264937639834856},
+	{15210864100592372360, 133916067908578570, 6465126526806904787, 106611950111867885},
+	{4789993650990077242, 11175179298552593826, 5172101221445523829, 85289560089494308},
+	{3974641026842213263, 3122891618716508829, 4586013139570927804, 136463296143190893},
+	{17937108080441411903, 13566359739198938032, 11047508141140562889, 109170636914552714},
+	{14349686464353129523, 18231785420842971072, 12527355327654360634, 87336509531642171},
+	{8202103083997365944, 10724112599639202100, 12665070894763156369, 139738415250627474},
+	{13940380096681713401, 15957987709195182326, 13821405530552435418, 111790732200501979},
+	{84257633119639752, 5387692537872325215, 14746473239183858658, 89432585760401583},
+	{11202858657217154572, 1241610431111899697, 1458264294242711914, 143092137216642534},
+	{8962286925773723658, 8371985974373340404, 4855960250136079854, 114473709773314027},
+	{7169829540618978926, 3008239964756762000, 14952814644334594853, 91578967818651221},
+	{9425212447237093464, 6095940786547319923, 8272902900725765559, 73263174254920977},
+	{4012293471353618573, 2374807628991891231, 16925993455903135218, 117221078807873563},
+	{14277881221308625828, 16657241362161154277, 2472748320496777204, 93776863046298851},
+	{4043607347563080016, 13325793089728923422, 16735593915365063056, 75021490437039080},
+	{2780422941359017702, 13942571314082456829, 8330206190874549274, 120034384699262529},
+	{13292384797312945131, 86010607040234493, 10353513767441549743, 96027507759410023},
+	{14323256652592266428, 14826203744599828887, 15661508643437060440, 76822006207528018},
+	{4470466570438074669, 1585833102908264281, 2922320941047834766, 122915209932044830},
+	{14644419700576190705, 16026061741294252717, 2337856752838267812, 98332167945635864},
+	{647489316235221595, 9131500578293491851, 5559634217012524573, 78665734356508691},
+	{1035982905976354551, 3542354481043855992, 1516717117736218671, 125865174970413906},
+	{4518135139522993964, 13901930029060815763, 15970768953156616229, 100692139976331124},
+	{18371903370586036464, 53497579022921640, 16465963977267203307, 80553711981064899},
+	{3569603689744286080, 11153642570662405595, 15277495919401794321, 128885939169703839},
+	{2855682951795428864, 8922914056529924476, 15911345550263345780, 103108751335763071},
+	{13352592805662074061, 3448982430482029257, 9039727625468766301, 82487001068610457},
+	{2917404415349766881, 1829023074029336489, 18152913015491936405, 131979201709776731},
+	{2333923532279813505, 16220613718191110484, 10832981597651638800, 105583361367821385},
+	{5556487640565761127, 12976490974552888387, 8666385278121311040, 84466689094257108},
+	{8890380224905217804, 17073036744542711096, 10176867630252187341, 135146702550811373},
+	{7112304179924174243, 17347778210376079200, 15520191733685570519, 108117362040649098},
+	{16757889788165070364, 6499524938817042713, 1348106942722725446, 86493889632519279},
+	{4676530772612650643, 10399239902107268342, 9535668737840181360, 138390223412030846},
+	{11119922247573941161, 4630043106943904350, 3939186175530234765, 110712178729624677},
+	{1517240168575332282, 14772080929780854450, 14219395384649918781, 88569742983699741},
+	{6116933084462441975, 8877934228681725827, 15372334985956049404, 141711588773919586},
+	{15961592911795684550, 7102347382945380661, 8608519174022929200, 113369271019135669},
+	{12769274329436547640, 9371226721098214852, 10576164153960253683, 90695416815308535},
+	{10215419463549238112, 14875679006362392528, 8460931323168202946, 72556333452246828},
+	{1587275882711139686, 12733039965954097076, 9848141302327214391, 116090133523594925},
+	{12337867150394642718, 6497083158021367337, 7878513041861771513, 92872106818875940},
+	{9870293720315714175, 12576364155900914516, 6302810433489417210, 74297685455100752},
+	{12103121137763232356, 5364787390473821933, 13773845508324977860, 118876296728161203},
+	{5993148095468675562, 11670527541862878193, 18397774036143802934, 95101037382528962},
+	{8483867291116850773, 5647073218748392231, 7339521599431221701, 76080829906023170},
+	{17263536480528871559, 1656619520513606923, 11743234559089954722, 121729327849637072},
+	{6432131554939276601, 5014644431152795862, 2015890017788143131, 97383462279709658},
+	{1456356429209510958, 7701064359664147013, 8991409643714335151, 77906769823767726},
+	{2330170286735217532, 16011051790204545544, 7007557800459115595, 124650831718028362},
+	{16621531488355815319, 5430143802679815788, 16674092684593023446, 99720665374422689},
+	{2229178746458921285, 4344115042143852631, 17028622962416329080, 79776532299538151},
+	{7256034809076184380, 18018630511655895179, 1420355036672754265, 127642451679261043},
+	{16872874291486678473, 3346857965098985173, 8514981658822024059, 102113961343408834},
+	{9808950618447432456, 10056184001563008785, 10501334141799529570, 81691169074727067},
+	{936925730548250636, 1332499143533172764, 2044739367911606020, 130705870519563308},
+	{11817587028664331478, 8444696944310358857, 9014489123813105462, 104564696415650646},
+	{9454069622931465183, 14134455184932107732, 3522242484308574046, 83651757132520517},
+	{15126511396690344292, 479035407439910432, 9324936789635628798, 133842811412032827},
+	{4722511487868454788, 383228325951928346, 81251802224682392, 107074249129626262},
+	{3778009190294763830, 3995931475503453000, 11133047886005476883, 85659399303701009},
+	{9734163519213532451, 10082839175547435123, 6744830173383032043, 137055038885921615},
+	{4097982000628915638, 15444968969921768745, 5395864138706425634, 109644031108737292},
+	{18035780859470773803, 8666626361195504672, 15384737755190871477, 87715224886989833},
+	{14099854116185596792, 13866602177912807476, 2479487519853932424, 140344359819183734},
+	{14969232107690387757, 25235298104515011, 5672938830625056263, 112275487855346987},
+	{8286036871410399882, 20188238483612009, 15606397508725775980, 89820390284277589},
+	{9568310179514729488, 7410998811057599861, 13902189569735510598, 143712624454844143},
+	{275950514127962944, 2239450234104169566, 53705211562677509, 114970099563875315},
+	{220760411302370356, 5480909002025245976, 42964169250142007, 91976079651100252},
+	{176608329041896285, 8074076016362107104, 11102417779625844575, 73580863720880201},
+	{282573326467034055, 5539823996695550720, 10385170817917530674, 117729381953408322},
+	{14983453920141268537, 742510382614530252, 929439024850203893, 94183505562726658},
+	{918716691887283860, 15351403565059265495, 8122248849363983760, 75346804450181326},
+	{12537993151245385145, 17183548074611004145, 5616900529498553370, 120554887120290122},
+	{2651696891512487470, 6368140830204982670, 15561566867824573666, 96443909696232097},
+	{9500055142693810622, 12473210293647806782, 5070555864775838286, 77155127756985678},
+	{7821390598826276349, 8889090025610759882, 4423540568899430935, 123448204411177085},
+	{17325158923286752049, 7111272020488607905, 3538832455119544748, 98758563528941668},
+	{10170778323887491316, 1999668801648976001, 10209763593579456445, 79006850823153334},
+	{16273245318219986106, 10578167712122182248, 5267575305501399342, 126410961317045335},
+	{13018596254575988885, 1083836540213925152, 4214060244401119474, 101128769053636268},
+	{14104225818402701431, 11935115676396871091, 10749945825004716225, 80903015242909014},
+	{4120017235734770673, 8028138638009262777, 6131866875781814991, 129444824388654423},
+	{18053409047555457831, 10111859725149320544, 12284191130109272639, 103555859510923538},
+	{10753378423302455942, 710790150635635789, 17206050533571238758, 82844687608738830},
+	{2448010218316288214, 15894659499984658556, 9082936780004430396, 132551500173982129},
+	{16715803433620671864, 12715727599987726844, 10955698238745454640, 106041200139185703},
+	{5993945117412716845, 17551279709474002122, 16143256220480184358, 84832960111348562},
+	{13279661002602257275, 9635303461448851779, 11071814693800653681, 135732736178157700},
+	{10623728802081805820, 4018893954417171100, 8857451755040522945, 108586188942526160},
+	{8498983041665444656, 3215115163533736880, 7085961404032418356, 86868951154020928},
+	{2530326422438980480, 12522881891137799655, 7648189431709959046, 138990321846433485},
+	{16781656396918825677, 6328956698168329400, 6118551545367967237, 111192257477146788},
+	{13425325117535060542, 5063165358534663520, 12273538865778194436, 88953805981717430},
+	{14101822558572276220, 722366944171640986, 1190918111535559482, 142326089570747889},
+	{3902760417374000330, 15335288814304954082, 4642083303970357908, 113860871656598311},
+	{17879603592866841557, 15957579866185873588, 24317828434376003, 91088697325278649},
+	{14303682874293473245, 5387366263464878224, 3708803077489411126, 72870957860222919},
+	{11817846154643826223, 8619786021543805159, 13312782553466878448, 116593532576356670},
+	{2075579294231240332, 14274526446718864774, 10650226042773502758, 93274826061085336},
+	{9039161064868812912, 15108969972117002142, 4830832019476891883, 74619860848868269},
+	{3394611259564369689, 9416956696419562135, 15108028860646847660, 119391777358189230},
+	{2715689007651495752, 7533565357135649708, 12086423088517478128, 95513421886551384},
+	{2172551206121196601, 17094898729934250736, 13358487285555892825, 76410737509241107},
+	{18233477188761555855, 12594442708927159884, 6616184397921787228, 122257180014785772},
+	{18276130565751155007, 10075554167141727907, 16360993962563160752, 97805744011828617},
+	{10931555637859013683, 11749792148455292649, 5710097540566707955, 78244595209462894},
+	{13801140205832511569, 7731620993302737269, 16514853694390553375, 125191352335140630},
+	{14730260979407919578, 6185296794642189815, 13211882955512442700, 100153081868112504},
+	{15473557598268245986, 8637586250455662175, 14258855179151864483, 80122465494490003},
+	{17378994527745372931, 6441440371245238834, 678075398191521234, 128195944791184006},
+	{17592544436938208668, 5153152296996191067, 15299855577520858280, 102556755832947204},
+	{10384686734808656611, 7811870652338863177, 15929233276758596947, 82045404666357763},
+	{1858103516726209285, 12498993043742181084, 3350680354362293176, 131272647466172422},
+	{12554529257606698397, 17377892064477565513, 13748590727715565510, 105018117972937937},
+	{10043623406085358718, 6523616022098231764, 3620174952688631762, 84014494378350350},
+	{8691099820252753302, 14127134450099081146, 5792279924301810819, 134423191005360560},
+	{6952879856202202642, 14991056374821175240, 4633823939441448655, 107538552804288448},
+	{12941001514445582760, 924798655631209222, 11085756781036979571, 86030842243430758},
+	{13326904793629111769, 8858375478493755402, 14047862034917256990, 137649347589489213},
+	{10661523834903289416, 14465398012278824968, 170243183708074622, 110119478071591371},
+	{4839870253180721209, 504271965597329005, 14893589805934100991, 88095582457273096},
+	{11433141219831064258, 4496183959697636731, 16451046060010740939, 140952931931636954},
+	{12835861790606761730, 10975644797241930031, 16850185662750503074, 112762345545309563},
+	{17647387061969230030, 1401818208309723378, 2412102085974671490, 90209876436247651},
+	{17807258464317294347, 15878849825615419995, 16687076927747378484, 72167901148998120},
+	{17423567098681939986, 14338113276758941023, 8252579010686253959, 115468641838396993},
+	{13938853678945551989, 4091792991923332172, 13980760838032823814, 92374913470717594},
+	{11151082943156441591, 10652132023022486384, 14873957485168169374, 73899930776574075},
+	{14152383894308396222, 5975364792610247245, 5351587902559519383, 118239889242518521},
+	{15011255930188627301, 8469640648830108119, 591921507305705183, 94591911394014817},
+	{15698353558892812164, 6775712519064086495, 11541583650070295116, 75673529115211853},
+	{14049319250002768493, 18219837659986359039, 14777185025370561862, 121077646584338965},
+	{7550106585260304471, 7197172498505266585, 11821748020296449490, 96862117267471172},
+	{17108131712433974547, 16825784443029944237, 2078700786753338945, 77489693813976938},
+	{8926266666184807658, 4785162220396448841, 18083316517772983606, 123983510102363100},
+	{18209059777173577096, 138780961575248749, 14466653214218386885, 99186808081890480},
+	{18256596636480772000, 111024769260198999, 11573322571374709508, 79349446465512384},
+	{3385112915175862938, 3866988445558228723, 7449269669973804243, 126959114344819815},
+	{17465485591108331643, 10472288385930403624, 5959415735979043394, 101567291475855852},
+	{13972388472886665315, 4688481894002412576, 15835579033008965685, 81253833180684681},
+	{7598426297651023211, 122873400920039476, 17958228823330524450, 130006133089095490},
+	{2389392223378908245, 98298720736031581, 14366583058664419560, 104004906471276392},
+	{9290211408186947243, 11146685420814556234, 4114568817447715001, 83203925177021114},
+	{3796291808873384618, 17834696673303289975, 13962007737400164648, 133126280283233782},
+	{3037033447098707695, 14267757338642631980, 3790908560436311072, 106501024226587026},
+	{9808324387162786802, 346159426688374614, 17790122107316690151, 85200819381269620},
+	{15693319019460458884, 11621901526927130352, 10017451297997152625, 136321311010031393},
+	{12554655215568367107, 16676218851025524928, 15392658667881542746, 109057048808025114},
+	{17422421801938514332, 13340975080820419942, 16003475749047144520, 87245639046420091},
+	{5739781994650160992, 13966862499828851262, 18226863568991610586, 139593022474272146},
+	{8281174410462039117, 3794792370379260363, 10892142040451378146, 111674417979417717},
+	{2935590713627720970, 10414531525787228937, 1335016002877281870, 89339534383534174},
+	{15764991586030084522, 5595203997033835329, 9514723234087471639, 142943255013654678},
+	{8922644454082157294, 15544209641852799233, 14990476216753797957, 114354604010923742},
+	{18206162007491456805, 16124716528224149709, 4613683343919217719, 91483683208738994},
+	{7186231976509344798, 1831726778353588798, 7380295489877284499, 73186946566991195},
+	{15187319977156861999, 10309460474849562723, 11808472783803655198, 117099114507185912},
+	{1081809537499758630, 8247568379879650179, 2068080597559103512, 93679291605748730},
+	{15622842888967448197, 17666101148129451112, 1654464478047282809, 74943433284598984},
+	{6549804548638365499, 6129668948555659841, 10025840794359473142, 119909493255358374},
+	{16307890083136423369, 1214386344102617549, 11710021450229488837, 95927594604286699},
+	{13046312066509138695, 15728904334249735332, 13057365974925501392, 76742075683429359},
+	{17184750491672711589, 17787549305315755885, 9823739115655071258, 122787321093486975},
+	{2679753949112438301, 3161993000026873739, 7858991292524057007, 98229856874789580},
+	{16901198418257591934, 13597640844247229960, 6287193034019245605, 78583885499831664},
+	{15973871024986416125, 10688178906569836967, 17438206483914613615, 125734216799730662},
+	{16468445634731043223, 1171845495772048927, 6571867557647870246, 100587373439784530},
+	{2106710063559103609, 15694871655585280435, 5257494046118296196, 80469898751827624},
+	{3370736101694565774, 6665050575226897080, 15790688103273094561, 128751838002924198},
+	{6385937696097562942, 9021389274923427987, 1564504038392744679, 103001470402339359},
+	{5108750156878050354, 14595809049422563036, 4940952045456106066, 82401176321871487},
+	{15552697880488701212, 1217201590624638918, 11594872087471680030, 131841882114994379},
+	{5063460674907140324, 4663110087241621458, 12965246484719254347, 105473505691995503},
+	{11429466169409532905, 3730488069793297166, 17750894817259224124, 84378804553596402},
+	{3529750612087611356, 17036827355895006436, 13644036448647117305, 135006087285754244},
+	{2823800489670089085, 17318810699457915472, 14604577973659604167, 108004869828603395},
+	{5948389206477981591, 6476350930082511731, 11683662378927683334, 86403895862882716},
+	{2138725100880949899, 10362161488132018770, 11315162176800472688, 138246233380612346},
+	{5400328895446670242, 11979078005247525339, 5362780926698467827, 110596986704489877},
+	{11698960745841156840, 13272611218939930594, 15358271185584505231, 88477589363591901},
+	{11339639563862030298, 6478782691336247658, 17194536267451387724, 141564142981747042},
+	{12761060465831534562, 1493677338327087803, 6376931384477289533, 113251314385397634},
+	{10208848372665227649, 12262988314887401212, 8790893922323741949, 90601051508318107},
+	{15545776327616002766, 6121041837168010646, 18100761582084724529, 72480841206654485},
+	{6426498050476052809, 17172364568952637681, 10514474457626007630, 115969345930647177},
+	{12519896069864662894, 6359194025678289498, 1032881936616985458, 92775476744517742},
+	{17394614485375550962, 5087355220542631598, 11894351993519319336, 74220381395614193},
+	{13073987917633240246, 15518465982352031204, 15341614374889000614, 118752610232982709},
+	{3080492704622771550, 1346726341655893994, 15962640314653110815, 95002088186386167},
+	{17221789422665858533, 12145427517550446164, 5391414622238668005, 76001670549108934},
+	{5418770187813911713, 8364637583854982894, 16004961025065689455, 121602672878574294},
+	{11713713779734950017, 10381058881825896638, 16493317634794461887, 97282138302859435},
+	{9370971023787960014, 926149475976896664, 13194654107835569510, 77825710642287548},
+	{236158379093094729, 16239234420530675956, 17422097757795000892, 124521137027660077},
+	{188926703274475783, 16680736351166451088, 6558980576752180067, 99616909622128062},
+	{11219187806845311596, 17033937895675071193, 16315230905627475023, 79693527697702449},
+	{17950700490952498554, 12496905374112472616, 15036323004778229068, 127509644316323919},
+	{3292513948536267874, 2618826669806157447, 15718407218564493578, 102007715453059135},
+	{2634011158829014299, 9473758965328746604, 12574725774851594862, 81606172362447308},
+	{11593115483610243525, 15158014344525994566, 16430212425020641456, 130569875779915693},
+	{9274492386888194820, 15815760290362705976, 2076123495790782195, 104455900623932555},
+	{3730245094768645533, 12652608232290164781, 1660898796632625756, 83564720499146044},
+	{17036438595855563822, 1797429097954712033, 10036135704096021857, 133703552798633670},
+	{13629150876684451057, 12505989722589500596, 8028908563276817485, 106962842238906936},
+	{3524623071863740200, 6315442963329690154, 2733778035879543665, 85570273791125549},
+	{1950048100240073996, 17483406370811324893, 11752742486891090510, 136912438065800878},
+	{16317433739159700490, 2918678652423328944, 16780891618996693055, 109529950452640702},
+	{9364598176585850069, 13402989366164394125, 6046015665713533797, 87623960362112562},
+	{3915310638311629140, 10376736541637299631, 13362973879883564399, 140198336579380099},
+	{6821597325391213635, 15680086862793660351, 14379727918648761842, 112158669263504079},
+	{16525324304538701878, 8854720675493017957, 15193131149660919797, 89726935410803263},
+	{11683123628294281711, 14167553080788828732, 2172916951006009736, 143563096657285222},
+	{9346498902635425369, 265996020405332016, 12806380005030538759, 114850477325828177},
+	{98501492624519649, 14970192075291906906, 2866406374540610360, 91880381860662542},
+	{7457498823583436366, 4597456030749704878, 13361171543858219258, 73504305488530033},
+	{863951673507767215, 18423976093425258775, 17688525655431240489, 117606888781648053},
+	{11759207783031944742, 7360483245256386373, 3082774080119261422, 94085511025318443},
+	{16786063855909376440, 5888386596205109098, 9844916893579229784, 75268408820254754},
+	{1032260466261630041, 5732069739186264235, 4683820585501036685, 120429454112407607},
+	{11893854817235035003, 15653702235574742357, 14815102912626560317, 96343563289926085},
+	{13204432668529938326, 5144264158975973239, 11852082330101248254, 77074850631940868},
+	{13748394640164080674, 11920171469103467506, 15273982913420086883, 123319761011105389},
+	{18377413341615085186, 2157439545798953358, 15908535145477979830, 98655808808884311},
+	{18391279488033978472, 16483346895606803979, 9037479301640473540, 78924647047107449},
+	{7289954292402903616, 15305308588745155398, 3391920438399026695, 126279435275371919},
+	{16900009878148053862, 15933595685738034641, 6402885165461131679, 101023548220297535},
+	{13520007902518443090, 16436225363332338036, 5122308132368905343, 80818838576238028},
+	{6874617385061867651, 472518878138368596, 4506344197048338227, 129310141721980845},
+	{12878391537533314767, 11446061546736425846, 3605075357638670581, 103448113377584676},
+	{17681410859510472460, 1778151607905320030, 17641455545078577758, 82758490702067740},
+	{6154164486765293997, 17602437831616153342, 9779584798416172796, 132413585123308385},
+	{12302029218896055844, 10392601450551012350, 7823667838732938237, 105930868098646708},
+	{9841623375116844675, 8314081160440809880, 13637631900470171236, 84744694478917366},
+	{989202141219310187, 16991878671447206132, 14441513411268453331, 135591511166267786},
+	{4480710527717358473, 6214805307673944259, 7863861914272852342, 108473208933014229},
+	{3584568422173886779, 1282495431397245084, 9980438346160192197, 86778567146411383},
+	{13114007104962039492, 2051992690235592134, 12279352539114397192, 138845707434258213},
+	{14180554498711541917, 1641594152188473707, 17202179660775338400, 111076565947406570},
+	{3965745969485412887, 1313275321750778966, 13761743728620270720, 88861252757925256},
+	{10034542365918570942, 13169286959026977315, 14640092336308612505, 142178004412680410},
+	{8027633892734856754, 10535429567221581852, 11712073869046890004, 113742403530144328},
+	{10111455928929795726, 1049646024293444835, 16748356724721332650, 90993922824115462},
+	{710467113660015935, 11907763263660486838, 6019987750293245473, 72795138259292370},
+	{12204793826081756465, 15363072407114868617, 9631980400469192757, 116472221214867792},
+	{6074486246123494849, 15979806740433805217, 326886690891533559, 93177776971894234},
+	{4859588996898795879, 1715798948121313204, 3950858167455137171, 74542221577515387},
+	{11464691209779983730, 17502673575961742419, 10010721882670129796, 119267554524024619},
+	{12861101782565897307, 14002138860769393935, 11697926320878014160, 95414043619219695},
+	{10288881426052717846, 11201711088615515148, 9358341056702411328, 76331234895375756},
+	{1704815022716707260, 6854691297559093268, 7594648061240037479, 122129975832601210},
+	{12431898462399096778, 9173101852789184937, 6075718448992029983, 97703980666080968},
+	{17324216399403098069, 3649132667489437626, 12239272388677444633, 78163184532864774},
+	{5582653350593494970, 9527961082725010526, 8514789377658180443, 125061095252583639},
+	{11844820309958616623, 243671236696187774, 10501180316868454678, 100048876202066911},
+	{16854553877450713945, 3884285804098860542, 4711595438752853419, 80039100961653529},
+	{8520542130211590695, 2525508471816266545, 14917250331488386117, 128062561538645646},
+	{14195131333653093203, 9399104406936833882, 8244451450448798570, 102450049230916517},
+	{15045453881664384886, 140585896065646459, 17663607604584769826, 81960039384733213},
+	{1936633322211553877, 7603635063188854982, 6125679278884169782, 131136063015573142},
+	{16306701916736884395, 9772256865292994308, 15968589867333066795, 104908850412458513},
+	{9356012718647597193, 15196503121718216093, 1706825449640722466, 83927080329966811},
+	{14969620349836155508, 9557009735781504456, 13798967163650886916, 134283328527946897},
+	{15665045094610834730, 15024305418109024211, 3660476101436888886, 107426662822357518},
+	{12532036075688667784, 15708793149229129692, 10307078510633331755, 85941330257886014},
+	{12672560091618047808, 14066022594540876538, 5423279172787599839, 137506128412617623},
+	{10138048073294438246, 3874120446148880584, 11717320967713900518, 110004902730094098},
+	{8110438458635550597, 17856691615886745760, 16752554403654941060, 88003922184075278},
+	{9287352719074970632, 6434613696967331277, 4667994157396443758, 140806275494520446},
+	{3740533360518066182, 8837039772315775345, 45046511175244683, 112645020395616357},
+	{2992426688414452946, 7069631817852620276, 11104083653165926716, 90116016316493085},
+	{13461987794957293326, 1966356639540185897, 8883266922532741373, 72092813053194468},
+	{17849831657189758999, 14214217067490028405, 10523878261310475873, 115348500885111149},
+	{6901167696267986553, 3992676024508202078, 12108451423790291022, 92278800708088919},
+	{9210282971756299566, 17951536078574202955, 13376109953774143140, 73823040566471135},
+	{14736452754810079305, 10275713652009173112, 2955031852329077409, 118116864906353817},
+	{721115759622332474, 4531222106865428167, 13432071926088992897, 94493491925083053},
+	{11644939051923596949, 3624977685492342533, 18124355170355014964, 75594793540066442},
+	{7563856038852024148, 16868010741013479023, 14241573013600382649, 120951669664106308},
+	{6051084831081619319, 6115710963326962572, 325211966654575150, 96761335731285047},
+	{8530216679607205778, 15960615214887301027, 11328216017549391089, 77409068585028037},
+	{9958997872629618922, 18158286714335860997, 3367750369111384450, 123854509736044860},
+	{588500668619874491, 14526629371468688798, 2694200295289107560, 99083607788835888},
+	{15228195793863540886, 553257052949220068, 9534057865715106695, 79266886231068710},
+	{2229020381730203478, 885211284718752110, 15254492585144170712, 126827017969709936},
+	{9161913934867983429, 8086866657258822334, 8514245253373426246, 101461614375767949},
+	{11018879962636297066, 6469493325807057867, 10500745017440651320, 81169291500614359},
+	{10251510310734254660, 17729886950775113234, 5733145583679311142, 129870866400982975},
+	{4511859433845493405, 6805211931136269941, 4586516466943448914, 103896693120786380},
+	{3609487547076394724, 9133518359650926276, 3669213173554759131, 83117354496629104},
+	{16843226519547962527, 14613629375441482041, 13249438707171435256, 132987767194606566},
+	{2406534771412639052, 4312205870869364987, 6910202150995237882, 106390213755685253},
+	{12993274261355842212, 3449764696695491989, 12906859350280010952, 85112171004548202},
+	{17099890003427437215, 12898321144196607829, 5893579701480376230, 136179473607277124},
+	{2611865558516218802, 14008005730099196587, 8404212575926211307, 108943578885821699},
+	{9468190076296795688, 7517055769337446946, 10412718875482879369, 87154863108657359},
+	{4081057677849142132, 8337940416198004791, 5592303756546876021, 139447780973851775},
+	{14332892586505044675, 2981003518216493509, 4473843005237500817, 111558224779081420},
+	{7776965254462125417, 13452849258798925777, 3579074404190000653, 89246579823265136},
+	{5064446777655580020, 10456512369852550274, 16794565490929732015, 142794527717224217},
+	{362208607382553693, 986512266398219573, 6056954763259964966, 114235622173779374},
+	{7668464515389863601, 789209813118575658, 8534912625349882296, 91388497739023499},
+	{13513469241795711527, 631367850494860526, 10517278915021816160, 73110798191218799},
+	{3174806713163586827, 8388886190275597489, 5759599819809174886, 116977277105950079},
+	{6229194185272779785, 6711108952220477991, 8297028670589250232, 93581821684760063},
+	{1294006533476313505, 5368887161776382393, 14016320565955220832, 74865457347808050},
+	{2070410453562101608, 12279568273584122152, 3979368831818801715, 119784731756492881},
+	{9035025992333501933, 6134305804125387398, 17940890324422682665, 95827785405194304},
+	{18296067238092532516, 8596793458042220241, 18042061074280056455, 76662228324155443},
+	{18205661136722321056, 10065520718125642063, 6731204830396628389, 122659565318648710},
+	{7185831279894036198, 11741765389242423974, 5384963864317302711, 98127652254918968},
+	{13127362653399049605, 13082761126135849502, 11686668720937662815, 78502121803935174},
+	{13625082615954658721, 9864371357591628234, 7630623509274529535, 125603394886296279},
+	{18278763722247547624, 11580845900815212910, 9793847622161533951, 100482715909037023},
+	{18312359792539948422, 12954025535394080651, 15213775727213047807, 80386172727229618},
+	{3474333964870545213, 2279696782920977427, 2205948275089414553, 128617876363567390},
+	{2779467171896436170, 9202455055820602588, 1764758620071531642, 102894301090853912},
+	{13291620181742879906, 11051312859398392393, 12479853340282956283, 82315440872683129},
+	{2819848217079056233, 2924705316069786537, 8899718900226999084, 131704705396293007},
+	{9634576203147065633, 17097159511823470522, 18187821564407330236, 105363764317034405},
+	{15086358592001473153, 9988378794716866094, 14550257251525864189, 84291011453627524},
+	{9380778488234715752, 12292057256805075428, 12212365158215651733, 134865618325804039},
+	{7504622790587772601, 2454948175960239696, 13459240941314431710, 107892494660643231},
+	{17071744676695949051, 16721353799735833049, 7078043938309635044, 86313995728514585},
+	{5178698594262056542, 15686119635351601910, 11324870301295416071, 138102393165623336},
+	{15211005319635376203, 5170198078797460881, 5370547426294422534, 110481914532498669},
+	{15858153070450211286, 11514856092521789351, 7985786755777448350, 88385531625998935},
+	{17994347283236517411, 18423769748034862962, 12777258809243917360, 141416850601598296},
+	{3327431382363482959, 11049666983685980047, 6532458232653223565, 113133480481278637},
+	{6351293920632696691, 1461035957464963391, 16294013030348309822, 90506784385022909},
+	{16149081580731888322, 15926224024939612005, 16724559239020558180, 72405427508018327},
+	{11081135270203380022, 10724563180935737916, 12001899523465251796, 115848684012829324},
+	{5175559401420793695, 8579650544748590333, 13290868433514111760, 92678947210263459},
+	{15208493965362365925, 10553069250540782589, 14322043561553199731, 74143157768210767},
+	{9576195085612144188, 13195561986123341820, 8157874439517478277, 118629052429137228},
+	{7660956068489715350, 10556449588898673456, 13904997181097803268, 94903241943309782},
+	{2439416040049861957, 8445159671118938765, 3745300115394421968, 75922593554647826},
+	{11281763293563599777, 2444209029564571054, 17060526628856806119, 121476149687436521},
+	{12714759449592790145, 1955367223651656843, 9959072488343534572, 97180919749949217},
+	{2793109930190411470, 5253642593663235798, 588560361191007011, 77744735799959374},
+	{779627073562748028, 8405828149861177277, 8320394207389431864, 124391577279934998},
+	{4313050473592108746, 17792708964114672791, 14035012995395366137, 99513261823947998},
+	{18207835637841328290, 14234167171291738232, 159963952090561940, 79610609459158399},
+	{3307095317352753001, 11706621029841050203, 7634639952828719751, 127376975134653438},
+	{13713722698107933370, 13054645638614750485, 13486409591746796447, 101901580107722750},
+	{3592280529002526050, 3065018881407979742, 10789127673397437158, 81521264086178200},
+	{5747648846404041680, 1214681395510857264, 17262604277435899453, 130434022537885120},
+	{15666165521348964313, 8350442745892506457, 13810083421948719562, 104347218030308096},
+	{12532932417079171451, 14059051826197825812, 7358717922817065326, 83477774424246477},
+	{1605947793617122705, 358390033465059361, 15463297491249214846, 133564439078794363},
+	{1284758234893698164, 3976060841513957812, 1302591548773640907, 106851551263035491},
+	{1027806587914958531, 10559546302694986896, 15799468497986554018, 85481241010428392},
+	{12712536984889664620, 16895274084311979033, 10521754337810845136, 136769985616685428},
+	{2791331958427911049, 17205568082191493550, 15796101099732496755, 109415988493348342},
+	{13301112010968059809, 6385756836269374193, 5258183250302176758, 87532790794678674},
+	{2835035143839344078, 13906559752772909033, 15791790829967303459, 140052465271485878},
+	{2268028115071475263, 3746550172734506580, 1565386219748111798, 112041972217188703},
+	{16571817751024821503, 17754635397155246556, 8631006605282310084, 89633577773750962},
+	{11757513142672073112, 2581974932255022228, 17498959383193606459, 143413724438001539},
+	{5716661699395748166, 9444277575287838429, 17688516321296795490, 114730979550401231},
+	{4573329359516598533, 3866073245488360420, 10461464242295526069, 91784783640320985},
+	{7348012302355189150, 6782207411132598659, 8369171393836420855, 73427826912256788},
+	{15446168498510212963, 7162183043070247531, 9701325415396363045, 117484523059610861},
+	{4978237169324349724, 2040397619714287702, 4071711517575180113, 93987618447688689},
+	{7671938550201390102, 12700364539997161131, 6946718028802054413, 75190094758150951},
+	{12275101680322224164, 9252536819769726840, 3736051216599466415, 120304151613041522},
+	{2441383714773958685, 23331826331960826, 14056887417505304102, 96243321290433217},
+	{1953106971819166948, 3708014275807478984, 3866812304520422635, 76994657032346574},
+	{17882366413878308409, 13311520470775787020, 13565597316716496862, 123191451251754518},
+	{14305893131102646727, 10649216376620629616, 18231175482857018136, 98553161001403614},
+	{7755365690140207059, 8519373101296503693, 18274289201027524832, 78842528801122891},
+	{5029887474740510647, 9941648147332495586, 3413421018450667469, 126148046081796627},
+	{15091956424018139487, 4263969703124086145, 13798783258986264945, 100918436865437301},
+	{8384216324472601267, 18168571021466910209, 7349677792447101632, 80734749492349841},
+	{17104094933898072350, 6933620745895594395, 4380786838431541966, 129175599187759746},
+	{6304578317634637234, 16614943040942206486, 18262024729712874865, 103340479350207796},
+	{16111709098333440757, 9602605618011854865, 10920270969028389569, 82672383480166237},
+	{18400036927849684564, 7985471359335147138, 2715038291477782018, 132275813568265980},
+	{11030680727537837328, 13767074716951938357, 2172030633182225614, 105820650854612784},
+	{8824544582030269862, 18392357403045371332, 5426973321287690814, 84656520683690227},
+	{10429922516506521456, 3602330141679221869, 12372506128802215627, 135450433093904363},
+	{12033286827947127488, 2881864113343377495, 17276702532525593148, 108360346475123490},
+	{17005327091841522637, 9684188920158522642, 13821362026020474518, 86688277180098792},
+	{12451128087978794927, 15494702272253636228, 7356783982665117936, 138701243488158068},
+	{2582204840899215295, 16085110632544819306, 13264124815615914995, 110960994790526454},
+	{2065763872719372236, 16557437320777765768, 14300648667234642319, 88768795832421163},
+	{3305222196350995577, 11734504454276783936, 744944979123965772, 142030073331873862},
+	{2644177757080796462, 13076952378163337472, 11664002427524903587, 113624058665499089},
+	{9494039835148457816, 6772213087788759654, 13020550756761833193, 90899246932399271},
+	{14973929497602586899, 9107119284972918046, 6727091790667556231, 72719397545919417},
+	{12890240751938408069, 10882042041214758551, 14452695679810000293, 116351036073471067},
+	{6622843786808816132, 8705633632971806841, 4183458914364179588, 93080828858776854},
+	{12676972658930873552, 18032553350603176442, 7036115946233253993, 74464663087021483},
+	{16593807439547487360, 3026643657771710045, 7568436699231296067, 119143460939234373},
+	{13275045951637989888, 2421314926217368036, 13433446988868857500, 95314768751387498},
+	{14309385576052302234, 9315749570457715075, 18125455220578906646, 76251815001109998},
+	{11826970477457952604, 3837152868506613151, 6864635464474788695, 122002904001775998},
+	{13150925196708272407, 10448419924289111167, 12870406001063651602, 97602323201420798},
+	{3142042527882797279, 8358735939431288934, 17675022430334741928, 78081858561136638},
+	{5027268044612475646, 5995279873606241648, 6143943000084125146, 124930973697818622},
+	{332465620948070194, 12174921528368813965, 15983200844293031086, 99944778958254897},
+	{7644670126242276802, 17118634852178871818, 5407863045950604222, 79955823166603918},
+	{8542123387245732559, 8943071689776643293, 4963232058779056433, 127929317066566269},
+	{6833698709796586047, 18222503796047045604, 7659934461765155469, 102343453653253015},
+	{12845656597321089484, 18267351851579546806, 6127947569412124375, 81874762922602412},
+	{16863701740971832851, 14470367703559633597, 13494064925801309324, 130999620676163859},
+	{13490961392777466281, 508247718621975908, 14484600755382957783, 104799696540931087},
+	{18171466743705793672, 406598174897580726, 4208982974822545580, 83839757232744870},
+	{3248905086735897612, 650557079836129163, 6734372759716072928, 134143611572391792},
+	{9977821698872538736, 520445663868903330, 16455544651998589312, 107314889257913433},
+	{7982257359098030989, 416356531095122664, 2096389277373140480, 85851911406330747},
+	{5392914145073028935, 4355519264494106586, 7043571658538935091, 137363058250129195},
+	{15382377760284154118, 18241810670562926561, 5634857326831148072, 109890446600103356},
+	{15995251022969233618, 3525402092224610279, 818537046723008135, 87912357280082685},
+	{14524355192525042818, 5640643347559376447, 1309659274756813016, 140659771648132296},
+	{15308832968761944578, 15580561122273232127, 15805122678773091705, 112527817318505836},
+	{1179019930783824693, 8775100083076675379, 8954749328276563041, 90022253854804669},
+	{9265129518737940155, 14040160132922680606, 3259552481016769896, 144035606167687471},
+	{14790801244474172770, 3853430476854323838, 17365037243781057210, 115228484934149976},
+	{15521989810321248540, 17840139640451100363, 10202680980282935444, 92182787947319981},
+	{1349545404031267862, 14272111712360880291, 4472795969484438032, 73746230357855985},
+	{16916667905417669872, 8077983480809767172, 7156473551175100852, 117993968572569576},
+	{13533334324334135898, 13841084414131634384, 2035830026198170358, 94395174858055661},
+	{18205365088951129365, 14762216346047217830, 16386059279926177579, 75516139886444528},
+	{14371188883354165690, 8862150894707907236, 4081601959430422188, 120825823818311246},
+	{11496951106683332552, 10779069530508236112, 18022676826511979043, 96660659054648996},
+	{5508212070604755719, 12312604439148499213, 10728792646467672911, 77328527243719197},
+	{1434441683483788503, 16010818287895688418, 2408672975380635365, 123725643589950716},
+	{4836902161528941126, 9119305815574640411, 16684333639272149585, 98980514871960572},
+	{11248219358706973547, 18363491096685443298, 5968769281933899021, 79184411897568458},
+	{3239755714963516383, 18313539310470978308, 5860682036352328111, 126695059036109533},
+	{13659851016196544076, 18340180263118692969, 12067243258565683135, 101356047228887626},
+	{10927880812957235261, 10982795395753044052, 5964445792110636185, 81084837783110101},
+	{13795260485989666094, 10193775003721049837, 2164415637893197250, 129735740452976162},
+	{14725557203533643198, 776322373493019223, 12799578954540288770, 103788592362380929},
+	{4401748133343093912, 4310406713536325702, 13929011978374141339, 83030873889904743},
+	{14421494642832770906, 10585999556400031446, 150326276947164203, 132849398223847590},
+	{15226544529008127048, 15847497274603845803, 120261021557731362, 106279518579078072},
+	{4802537993722680992, 16367346634424986966, 11164255261471916059, 85023614863262457},
+	{11373409604698199910, 362312911886606883, 3105413159387424403, 136037783781219932},
+	{16477425313242380575, 289850329509285506, 13552376971735670492, 108830227024975945},
+	{2113893806368173490, 11299926707833159375, 10841901577388536393, 87064181619980756},
+	{10760927719672898230, 7011836288307324030, 9968344894337837583, 139302690591969210},
+	{15987439805222139231, 12988166660129679870, 7974675915470270066, 111442152473575368},
+	{16479300658919621708, 14079882142845654219, 13758438361860036699, 89153721978860294},
+	{11609485795303753440, 391718540101584812, 10945454934750327750, 142645955166176471},
+	{16666286265726823398, 15070770091048909142, 5067015133058351876, 114116764132941177},
+	{13333029012581458719, 988569628613396344, 15121658550672412471, 91293411306352941},
+	{6977074395323256652, 11858902147116448045, 8407978025796019653, 73034729045082353},
+	{3784621403033389996, 11595545805902496226, 9763416026531721122, 116855566472131765},
+	{10406394751910532643, 1897739015238176334, 7810732821225376898, 93484453177705412},
+	{12014464616270336438, 1518191212190541067, 17316632701206032488, 74787562542164329},
+	{8155096941806807331, 6118454754246776031, 16638565877703921011, 119660100067462927},
+	{10213426368187356188, 12273461432881241471, 5932155072679316162, 95728080053970342},
+	{8170741094549884951, 13508117961046903500, 15813770502369183899, 76582464043176273},
+	{16762534566021726244, 6855593478707404307, 3165939915339232300, 122531942469082038},
+	{13410027652817380995, 12863172412449744092, 9911449561755206486, 98025553975265630},
+	{18106719751737725443, 6601189115217884950, 7929159649404165189, 78420443180212504},
+	{6834658714328898769, 6872553769606705598, 1618608994820933333, 125472709088340007},
+	{12846424600946939661, 5498043015685364478, 12362933640082477636, 100378167270672005},
+	{13966488495499462052, 709085597806381259, 9890346912065982109, 80302533816537604},
+	{7588986333831497991, 15891932215457851308, 4756508615079840404, 128484054106460167},
+	{9760537881807108716, 9024196957624370723, 14873253336289603293, 102787243285168133},
+	{11497779120187597296, 3530008751357586255, 830556224805951665, 82229794628134507},
+	{3639051333332514381, 9337362816914048332, 5018238774431432987, 131567671405015211},
+	{2911241066666011505, 14848587883015059312, 325242204803236066, 105254137124012169},
+	{13397039297558540173, 11878870306412047449, 3949542578584499176, 84203309699209735},
+	{2988518802384112661, 11627494860775455273, 6319268125735198682, 134725295518735576},
+	{17148210300874931422, 16680693518104184864, 1366065685846248622, 107780236414988461},
+	{6339870611216124491, 2276508370257616922, 15850247807644640191, 86224189131990768},
+	{2765095348461978539, 11021111021896007722, 3224303603779962366, 137958702611185230},
+	{9590773908253403478, 5127540002774895854, 2579442883023969893, 110366962088948184},
+	{3983270311860812459, 15170078446445647653, 5752903121161086237, 88293569671158547},
+	{10062581313719210257, 13204079070087305275, 12893993808599648303, 141269711473853675},
+	{15428762680459188852, 17941960885553664866, 10315195046879718642, 113015769179082940},
+	{1274963700141620112, 6974871078959111247, 8252156037503774914, 90412615343266352},
+	{8398668589597116736, 1890548048425378674, 17669771274228750901, 72330092274613081},
+	{6059172113871566132, 6714225692222516202, 2446192335572629179, 115728147639380931},
+	{15915384135322983875, 5371380553778012961, 16714349127425744636, 92582518111504744},
+	{9042958493516476777, 4297104443022410369, 17060828116682506032, 74066014489203795},
+	{7090035960142542196, 10564715923577766914, 8850580912982458035, 118505623182726073},
+	{16740075212339764727, 15830470368346034177, 14459162359869787074, 94804498546180858},
+	{17081408984613722105, 5285678665193006695, 499283443670098690, 75843598836944687},
+	{12572859116414314075, 12146434679050721036, 4488202324614068227, 121349758139111499},
+	{2679589663647630613, 6027798928498666506, 7279910674433164905, 97079806511289199},
+	{2143671730918104491, 8511587957540843528, 9513277354288442247, 77663845209031359},
+	{10808572398952787831, 6239843102581528998, 4153197322635776626, 124262152334450175},
+	{12336206733904140588, 1302525667323312875, 3322557858108621301, 99409721867560140},
+	{6179616572381402148, 15799415792826291593, 2658046286486897040, 79527777494048112},
+	{9887386515810243436, 10521670009554425256, 7942222873120945588, 127244443990476979},
+	{15288606842132015395, 1038638378159719558, 10043127113238666794, 101795555192381583},
+	{12230885473705612316, 11898957146753506616, 15413199320074754081, 81436444153905266},
+	{15880067943187069382, 4280936175837969293, 17282421282635785884, 130298310646248426},
+	{1636007910323924536, 3424748940670375435, 10136588211366718384, 104238648516998741},
+	{1308806328259139629, 2739799152536300348, 4419921754351464384, 83390918813598993},
+	{2094090125214623407, 8073027458799990880, 3382525992220432691, 133425470101758389},
+	{1675272100171698725, 6458421967039992704, 6395369608518256476, 106740376081406711},
+	{16097612939105000273, 16234784017857725132, 1426946872072694857, 85392300865125369},
+	{3620087814116538498, 150212725378987950, 9661812624800132419, 136627681384200590},
+	{6585419066035141121, 3809518995045100683, 7729450099840105935, 109302145107360472},
+	{5268335252828112897, 14115661640261811516, 17251606524097815717, 87441716085888377},
+	{8429336404524980635, 11517012180193167456, 12845175179588863855, 139906745737421404},
+	{6743469123619984508, 12902958558896444288, 13965488958413001407, 111925396589937123},
+	{12773472928379808253, 10322366847117155430, 104344722504670156, 89540317271949699},
+	{1990812611698141589, 16515786955387448689, 7545649185491292896, 143264507635119518},
+	{8971347718842333917, 16901978379051869274, 13415216977876854963, 114611606108095614},
+	{3487729360331956811, 6142885073757674773, 14421522397043394294, 91689284886476491},
+	{10168881117749386095, 4914308059006139818, 7847869102892805112, 73351427909181193},
+	{12580860973657107429, 7862892894409823709, 8867241749886577856, 117362284654689909},
+	{13754037593667596266, 6290314315527858967, 10783142214651172608, 93889827723751927},
+	{3624532445450256367, 5032251452422287174, 1247816142237117440, 75111862179001542},
+	{16867298356946141156, 11740951138617569801, 5685854642321298227, 120178979486402467},
+	{13493838685556912925, 13082109725635966164, 15616730158082769551, 96143183589121973},
+	{18173768577929350986, 14155036595250683254, 1425337682240484671, 76914546871297579},
+	{18009983280461230608, 4201314478691541591, 9659237921068596121, 123063274994076126},
+	{3339940180143253517, 14429098027178964243, 4038041522112966573, 98450619995260901},
+	{13739998588340333784, 15232627236485081717, 17987828476658014551, 78760495996208720},
+	{18294648926602623730, 16993505948892310101, 10333781488943271666, 126016793593933953},
+	{14635719141282098984, 17284153573855758404, 15645722820638437979, 100813434875147162},
+	{11708575313025679187, 10137974044342696400, 5137880627026929737, 80650747900117730},
+	{3976325241873445407, 1463363211980672948, 8220609003243087580, 129041196640188368},
+	{17938455452466397618, 8549388199068359004, 13955184832078290710, 103232957312150694},
+	{3282717917747387125, 10528859373996597527, 14853496680404542891, 82586365849720555},
+	{1562999853653909077, 9467477368910735397, 5318850614937717010, 132138185359552889},
+	{16007795141890768554, 11263330709870498640, 7944429306692083931, 105710548287642311},
+	{5427538484028794197, 1631966938412578266, 2666194630611756822, 84568438630113849},
+	{12373410389187981038, 13679193545685856195, 11644609038462631561, 135309501808182158},
+	{13588077126092295154, 14632703651290595279, 16694384860253925895, 108247601446545726},
+	{10870461700873836123, 8016814106290565900, 9666159073461230393, 86598081157236581},
+	{6324692277172406827, 1758856125839174471, 8087156888054147983, 138556929851578530},
+	{8749102636479835785, 8785782530155160223, 6469725510443318386, 110845543881262824},
+	{14377979738667689275, 7028626024124128178, 8865129223096565032, 88676435105010259},
+	{11936721137642571870, 3867104009114784439, 3116160312728773082, 141882296168016415},
+	{5860028095372147173, 14161729651517558521, 2492928250183018465, 113505836934413132},
+	{12066720105781538385, 3950686091730226170, 13062389044372145742, 90804669547530505},
+	{2274678455141410061, 14228595317609911906, 10449911235497716593, 72643735638024404},
+	{18396880787193897391, 8008357249208217756, 5651811532570615580, 116229977020839047},
+	{3649458185529386943, 17474732243592305175, 15589495670282223433, 92983981616671237},
+	{2919566548423509555, 13979785794873844140, 5092898906741958100, 74387185293336990},
+	{4671306477477615287, 3920913198088599008, 8148638250787132961, 119019496469339184},
+	{11115742811465912876, 3136730558470879206, 10208259415371616692, 95215597175471347},
+	{8892594249172730301, 6198733261518613688, 787909902813472707, 76172477740377078},
+	{10538801983934458158, 9917973218429781901, 16018051103469197624, 121875964384603324},
+	{12120390401889476850, 15313076204227646167, 16503789697517268422, 97500771507682659},
+	{17075009950995402126, 8561112148640206610, 16892380572755725061, 78000617206146127},
+	{5183923033141181463, 10008430623082420254, 12270413657441518805, 124800987529833804},
+	{11525836055996765817, 11696093313207846526, 13505679740695125367, 99840790023867043},
+	{5531320030055502330, 9356874650566277221, 18183241422039920940, 79872632019093634},
+	{8850112048088803728, 3902952996680312584, 18025139831038142535, 127796211230549815},
+	{10769438453212953306, 3122362397344250067, 14420111864830514028, 102236968984439852},
+	{1236853133086541998, 2497889917875400054, 4157391862380590576, 81789575187551882},
+	{5668313827680377520, 307275053858729763, 10341175794550855245, 130863320300083011},
+	{8223999876886212339, 15003215302054625103, 4583591820898773872, 104690656240066409},
+	{10268548716250880195, 8313223426901789759, 7356222271460929421, 83752524992053127},
+	{1672282687033767018, 9611808668300953292, 15459304449079397397, 134004039987285003},
+	{12405872593852744584, 7689446934640762633, 1299397115037786948, 107203231989828003},
+	{13614046889824105991, 2462208732970699783, 8418215321514050205, 85762585591862402},
+	{3335730950009017969, 7628882787495029977, 17158493329164390651, 137220136946979843},
+	{17425980018974855668, 9792455044737934304, 2658748219105781551, 109776109557583875},
+	{13940784015179884535, 4144615221048437120, 2126998575284625241, 87820887646067100},
+	{14926556794803994609, 17699430797903230362, 3403197720455400385, 140513420233707360},
+	{4562547806359375041, 14159544638322584290, 2722558176364320308, 112410736186965888},
+	{18407433504055141325, 7638286895916157108, 9556744170575276893, 89928588949572710},
+	{3626451903294853858, 8531910218723941051, 15290790672920443029, 143885742319316336},
+	{17658556781603524379, 6825528174979152840, 8543283723594444100, 115108593855453069},
+	{17816194240024729827, 9149771354725232595, 10523975793617465603, 92086875084362455},
+	{3184908947794052892, 14698514713264006723, 8419180634893972482, 73669500067489964},
+	{12474551945954305273, 16138925911738590110, 2402642571604625002, 117871200107983943},
+	{9979641556763444219, 12911140729390872088, 9300811686767520648, 94296960086387154},
+	{7983713245410755375, 2950214954028877024, 11129998164155926842, 75437568069109723},
+	{1705894748431477630, 4720343926446203239, 14118648247907572624, 120700108910575557},
+	{1364715798745182104, 86926326415052268, 3916220968842237453, 96560087128460446},
+	{12159819083221876653, 3758889875873952137, 17890372034041431255, 77248069702768356},
+	{15766361718413092321, 17082270245624054389, 2799153551272917745, 123596911524429371},
+	{12613089374730473857, 9976467381757333188, 16996718099985975489, 98877529219543496},
+	{17469169129268199732, 7981173905405866550, 9908025665246870068, 79102023375634797},
+	{9503926533119567955, 12769878248649386481, 1095445805427350816, 126563237401015676},
+	{14981838855979475011, 2837204969435688538, 15633751903309521946, 101250589920812540},
+	{15674819899525490332, 17027159234516192123, 12507001522647617556, 81000471936650032},
+	{17701014209756963884, 5107361886774445458, 5253807177268546798, 129600755098640052},
+	{3092764923579840138, 4085889509419556367, 15271092186040568408, 103680604078912041},
+	{17231607197831513403, 6958060422277555416, 8527524934090544403, 82944483263129633},
+	{12813176257562780152, 3754199046160268020, 9954691079802960722, 132711173221007413},
+	{10250541006050224122, 3003359236928214416, 15342450493326189224, 106168938576805930},
+	{8200432804840179298, 6092036204284481856, 12273960394660951379, 84935150861444744},
+	{2052646043518555906, 6057909112113260647, 8570290187231791237, 135896241378311591},
+	{1642116834814844725, 12225024919174429164, 3166883335043522666, 108716993102649273},
+	{8692391097335696427, 13469368750081453654, 9912204297518638779, 86973594482119418},
+	{6529128126253293636, 6793594741162684554, 12170178061287911724, 139157751171391069},
+	{16291348945228365878, 12813573422413968289, 13425491263772239702, 111326200937112855},
+	{9343730341440782380, 2872161108447353985, 10740393011017791762, 89060960749690284},
+	{7571270916821431161, 15663504217741497346, 6116582373402735849, 142497537199504455},
+	{6057016733457144929, 16220152188935108200, 4893265898722188679, 113998029759603564},
+	{12224311016249536589, 1908075306922355590, 7603961533719661267, 91198423807682851},
+	{17158146442483449918, 8905157875021705118, 2393820412233818690, 72958739046146281},
+	{16384987863747788899, 6869554970550907543, 14898159103799840874, 116733982473834049},
+	{9418641476256320796, 12874341605924546681, 15607876097781783022, 93387185979067239},
+	{156215551521235990, 6610124469997727022, 16175649692967336741, 74709748783253791},
+	{7628642511917798231, 14265547966738273558, 55597805554366523, 119535598053206067},
+	{13481611639018059231, 11412438373390618846, 11112524688669224188, 95628478442564853},
+	{3406591681730626739, 5440601883970584754, 16268717380419199997, 76502782754051882},
+	{1761197876027092458, 16083660643836756253, 11272552549703078702, 122404452406483012},
+	{12477004745047404936, 16556277329811315325, 1639344410278642315, 97923561925186410},
+	{9981603796037923949, 13245021863849052260, 1311475528222913852, 78338849540149128},
+	{15970566073660678318, 2745290908448932000, 16855756104124303457, 125342159264238604},
+	{9087104044186632332, 16953627985726786893, 17173953698041353088, 100273727411390883},
+	{10959032050091216189, 9873553573839519191, 2671116514207351501, 80218981929112707},
+	{6466404835920214932, 12108336903401320383, 7963135237473672725, 128350371086580331},
+	{8862472683478082269, 5997320707979145983, 2681159375237027857, 102680296869264265},
+	{7089978146782465815, 15865903010609047756, 2144927500189622285, 82144237495411412},
+	{7654616220110034981, 10628049558006835117, 7121232815045305980, 131430779992658259},
+	{2434344161346117661, 12191788461147378417, 9386335066778155107, 105144623994126607},
+	{16704870588044535422, 13442779583659813056, 130370423938703439, 84115699195301286},
+	{902351237677884413, 3061703260146149275, 11276639122527656473, 134585118712482057},
+	{721880990142307530, 2449362608116919420, 1642613668538304532, 107668094969985646},
+	{7956202421597666671, 9338187715977356182, 16071486193798284918, 86134475975988516},
+	{5351226245072446026, 3873053901338038922, 18335680280593435223, 137815161561581626},
+	{591632181316046498, 6787791935812341461, 10979195409732837855, 110252129249265301},
+	{11541352189278568168, 1740884733907962845, 5094007513044359961, 88201703399412241},
+	{3708768243878067776, 6474764388994650876, 771714391387155291, 141122725439059586},
+	{10345712224586274867, 16247857955421451670, 15374766772077365525, 112898180351247668},
+	{15655267409152840540, 1930239920111430366, 1231766973436161451, 90318544280998135},
+	{5145516297838451786, 16301587195056785586, 985413578748929160, 72254835424798508},
+	{15611523706025343504, 3946446623639394998, 16334056984965927950, 115607736679677612},
+	{12489218964820274803, 14225203743137246968, 5688547958488921713, 92486189343742090},
+	{6302026357114309519, 312116550284066605, 4550838366791137371, 73988951474993672},
+	{6393893356640984907, 15256781739422147861, 10970690201607730116, 118382322359989875},
+	{16183161129538518896, 8516076576795807965, 8776552161286184093, 94705857887991900},
+	{1878482459405084147, 14191558890920467019, 7021241729028947274, 75764686310393520},
+	{17762967194015775928, 11638447781247016260, 11233986766446315639, 121223498096629632},
+	{10521024940470710419, 5621409410255702685, 1608491783673231865, 96978798477303706},
+	{4727471137634658012, 807778713462651825, 16044188685906226785, 77583038781842964},
+	{14942651449699273465, 8671143571024063566, 14602655453224231886, 124132862050948743},
+	{11954121159759418772, 10626263671561161176, 614077918353654539, 99306289640758995},
+	{9563296927807535018, 12190359751990839264, 491262334682923631, 79445031712607196},
+	{7922577455008235382, 4747180344217701530, 11854066179718408780, 127112050740171513},
+	{13716759593490408952, 11176441904857981870, 16861950573258547670, 101689640592137210},
+	{10973407674792327162, 8941153523886385496, 13489560458606838136, 81351712473709768},
+	{17557452279667723459, 3237799193992485824, 17893947919029030695, 130162739957935629},
+	{2977915379508447797, 6279588169935898983, 18004507149965134879, 104130191966348503},
+	{2382332303606758238, 16091716980174450156, 3335559275746376933, 83304153573078803},
+	{7501080500512723504, 18368049538795299603, 1647546026452292770, 133286645716926085},
+	{13379562029893999450, 14694439631036239682, 1318036821161834216, 106629316573540868},
+	{7014300809173289237, 15444900519570902069, 8433127086413288019, 85303453258832694},
+	{14912230109419173101, 2575747942861981371, 2424956894035529862, 136485525214132311},
+	{15619132902277248804, 9439295983773405743, 16697360774196065182, 109188420171305848},
+	{1427259877596068074, 7551436787018724595, 2289842175131121176, 87350736137044679},
+	{2283615804153708918, 12082298859229959352, 11042445109693614528, 139761177819271486},
+	{16584287902290608427, 13355187902125877804, 5144607273012981299, 111808942255417189},
+	{5888732692348666096, 18062847951184522890, 7805034633152295362, 89447153804333751},
+	{5732623493015955430, 6764463833443774685, 5109357783559851934, 143115446086934002},
+	{896749979670854021, 1722222252013109425, 15155532671073612517, 114492356869547201},
+	{8096097613220503863, 8756475431094308186, 8435077322116979690, 91593885495637761},
+	{17544924534802134060, 3315831530133536225, 3058713042951673429, 73275108396510209},
+	{5935786367231952557, 1615981633471747638, 12272638498206498133, 117240173434416334},
+	{4748629093785562045, 12360831751003129080, 13507459613307108829, 93792138747533067},
+	{109554460286539313, 6199316586060592941, 3427270061161866417, 75033710998026454},
+	{3864635951200373224, 2540208908213128059, 12862329727342806914, 120053937596842326},
+	{6781057575702208902, 2032167126570502447, 6600514967132335208, 96043150077473861},
+	{1735497245819856799, 5315082515998312281, 1591063158963957843, 76834520061979089},
+	{17534190852279412171, 12193480840339209972, 9924398683826153195, 122935232099166542},
+	{17716701496565440060, 2376087042787547331, 560821317577101910, 98348185679333234},
+	{14173361197252352048, 5590218448971948188, 4138005868803591851, 78678548543466587},
+	{15298680286119942630, 5255000703613206778, 10310158204827657285, 125885677669546539},
+	{4860246599412133458, 7893349377632475746, 11937475378604036151, 100708542135637231},
+	{11266894909013527413, 17382725946331711566, 5860631488141318597, 80566833708509785},
+	{14337683039679733537, 13054966255163097213, 9377010381026109756, 128906933933615656},
+	{11470146431743786830, 3065275374646657124, 3812259490078977482, 103125547146892525},
+	{5486768330653119141, 13520266743943056669, 3049807592063181985, 82500437717514020},
+	{16157526958528811271, 3185682716599339054, 4879692147301091177, 132000700348022432},
+	{1857975122597318047, 6237894988021381567, 14971800162066603911, 105600560278417945},
+	{8865077727561675084, 1300967175675194930, 11977440129653283129, 84480448222734356},
+	{14184124364098680135, 2081547481080311888, 11785206577961432360, 135168717156374970},
+	{279253047053213138, 1665237984864249511, 9428165262369145888, 108134973725099976},
+	{223402437642570511, 5021539202633309932, 3853183395153406387, 86507978980079981},
+	{357443900228112817, 4345113909471385568, 17233139876471181189, 138412766368127969},
+	{15043350379150131546, 10854788757060929100, 17475860715918855274, 110730213094502375},
+	{15724029118062015560, 12373179820390653603, 13980688572735084219, 88584170475601900},
+	{10401051329931583603, 8729041268399314796, 3922357642666583135, 141734672760963041},
+	{942143434461446236, 3293884199977541514, 17895281373100907801, 113387738208770432},
+	{11821761191794887959, 10013804989465853857, 6937527468996905594, 90710190567016346},
+	{2078711323952089721, 8011043991572683086, 1860673160455614152, 72568152453613077},
+	{3325938118323343553, 1749623942290561968, 6666425871470892967, 116109043925780923},
+	{10039448124142495489, 1399699153832449574, 12711838326660535020, 92887235140624738},
+	{652860869830175745, 8498456952549780306, 17548168290812248662, 74309788112499790},
+	{15801972650695922484, 17286879938821558812, 9630325191590046243, 118895660979999665},
+	{12641578120556737987, 2761457506831516080, 7704260153272036995, 95116528783999732},
+	{2734564866961569744, 13277212449690943834, 17231454566843360565, 76093223027199785},
+	{11754001416622332236, 2796795845795958518, 9123583233239825289, 121749156843519657},
+	{13092549948039776112, 16994831935604408107, 18366913030817591200, 97399325474815725},
+	{3095342328948000243, 13595865548483526486, 14693530424654072960, 77919460379852580},
+	{16020594170542531359, 3306640803864090761, 5062904605736965121, 124671136607764129},
+	{9127126521692114764, 2645312643091272609, 7739672499331482420, 99736909286211303},
+	{18369747661579422781, 9494947743956838733, 13570435628949006582, 79789527428969042},
+	{14634200999559435156, 4123869946105211004, 6955301747350769239, 127663243886350468},
+	{8018011984905637802, 14367142401109899773, 12942939027364436037, 102130595109080374},
+	{10103758402666420565, 7804365106146009495, 14043700036633459153, 81704476087264299},
+	{1408618185298631611, 16176332984575525516, 11401873614387803675, 130727161739622879},
+	{1126894548238905289, 16630415202402330736, 12810847706252153263, 104581729391698303},
+	{11969562082816855201, 9614983347179954265, 17627375794485543257, 83665383513358642},
+	{8083252888281237351, 4315926911262195855, 13446406012209227919, 133864613621373828},
+	{17534648754850720850, 14520787973235487653, 18135822439251202981, 107091690897099062},
+	{10338370189138666357, 548583934362659153, 7129960321917141739, 85673352717679250},
+	{1783997043654224878, 8256431924464075292, 11407936515067426782, 137077364348286800},
+	{5116546449665290226, 17673191983796991203, 9126349212053941425, 109661891478629440},
+	{11471934789216052827, 14138553587037592962, 7301079369643153140, 87729513182903552},
+	{7287049218519953554, 7864290480292507447, 15371075806170955348, 140367221092645683},
+	{13208337004299783490, 2602083569492095634, 1228814200711033309, 112293776874116547},
+	{10566669603439826792, 16839062114561317800, 12051097804794557616, 89835021499293237},
+	{13217322550761812543, 4806406494846646541, 4524361228703650894, 143736034398869180},
+	{10573858040609450035, 7534474010619227556, 3619488982962920715, 114988827519095344},
+	{8459086432487560028, 9716928023237292368, 6584940001112246895, 91991062015276275},
+	{14145966775473868669, 7773542418589833894, 5267952000889797516, 73592849612221020},
+	{4186802767048638254, 5058970240259913585, 8428723201423676026, 117748559379553632},
+	{10728139843122731250, 11425873821691751514, 17811025005364671790, 94198847503642905},
+	{12271860689240095323, 9140699057353401211, 14248820004291737432, 75359078002914324},
+	{4877581843816511224, 7246420862281621292, 11730065562641048922, 120574524804662919},
+	{11280763104537029625, 2107787875083386710, 13073401264854749461, 96459619843730335},
+	{5335261668887713377, 16443625559034350661, 10458721011883799568, 77167695874984268},
+	{12225767484962251726, 484359191261588795, 13044604804272168987, 123468313399974829},
+	{6091265173227891058, 15144882611976912329, 14125032658159645512, 98774650719979863},
+	{8562360953324223170, 12115906089581529863, 231979682301985440, 79019720575983891},
+	{2631731081093026102, 12006752113846627135, 11439213935908907674, 126431552921574225},
+	{5794733679616331205, 13294750505819212031, 9151371148727126139, 101145242337259380},
+	{4635786943693064964, 14325149219397279948, 7321096918981700911, 80916193869807504},
+	{3727910295166993619, 4473494677326096301, 645708626144990489, 129465910191692007},
+	{14050374680359325865, 18336191000828518333, 11584613345141723360, 103572728153353605},
+	{172253300061729722, 14668952800662814667, 9267690676113378688, 82858182522682884},
+	{7654302909582588202, 8712929222092862174, 3760258637555674932, 132573092036292615},
+	{2434093512924160238, 18038389821900020709, 3008206910044539945, 106058473629034092},
+	{16704670069306969483, 7052014228036195920, 13474611972261362926, 84846778903227273},
+	{15659425666665420204, 215176320632182503, 17870030340876270359, 135754846245163637},
+	{16216889348074246486, 14929536315473387295, 6917326643217195640, 108603876996130910},
+	{12973511478459397189, 11943629052378709836, 5533861314573756512, 86883101596904728},
+	{13378920736051214856, 663062410096384122, 5164829288576100097, 139012962555047565},
+	{14392485403582882208, 11598496372302838267, 4131863430860880077, 111210370044038052},
+	{7824639508124395443, 12968145912584180937, 14373537188914435031, 88968296035230441},
+	{1451376768773301739, 5991638201167048207, 15618961872779275404, 142349273656368706},
+	{12229147859244372361, 4793310560933638565, 8805820683481510000, 113879418925094965},
+	{9783318287395497889, 3834648448746910852, 7044656546785208000, 91103535140075972},
+	{11516003444658308635, 14135765203223259651, 16703771681653897369, 72882828112060777},
+	{3668210252485652522, 15238526695673394796, 11968639431678594498, 116612524979297244},
+	{10313265831472342664, 4812123727054895190, 13264260360084785922, 93290019983437795},
+	{871915035694053485, 14917745425869647122, 10611408288067828737, 74632015986750236},
+	{1395064057110485576, 1732299792939973456, 9599555631424705334, 119411225578800378},
+	{8494748875172209107, 12453886278577709734, 15058342134623584913, 95528980463040302},
+	{10485147914879677609, 9963109022862167787, 4667976078215047284, 76423184370432242},
+	{13086887849065573851, 8562276807095647813, 11158110539885985978, 122277094992691587},
+	{17848207908736279727, 6849821445676518250, 1547790802424968136, 97821675994153270},
+	{10589217512247113459, 1790508341799304277, 1238232641939974509, 78257340795322616},
+	{2185352760627740241, 2864813346878886844, 13049218671329690184, 125211745272516185},
+	{9126979837986012839, 5981199492245019798, 10439374937063752147, 100169396218012948},
+	{14680281499872630918, 4784959593796015838, 15730197579134822364, 80135516974410358},
+	{5041706326086657852, 11345284164815535665, 3032223238164253843, 128216827159056574},
+	{15101411505095057251, 1697529702368607885, 6115127405273313398, 102573461727245259},
+	{4702431574592225155, 12426070206120617278, 8581450738960561041, 82058769381796207},
+	{145192889863739601, 16192363515051077322, 17419669997078807989, 131294031010873931},
+	{11184200756116722651, 12953890812040861857, 10246387182921136068, 105035224808699145},
+	{8947360604893378121, 17741810279116510132, 8197109746336908854, 84028179846959316},
+	{18005125782571315316, 9940152372876864595, 5736677964655233521, 134445087755134906},
+	{7025402996573231606, 573424268817671030, 899993556982276494, 107556070204107925},
+	{9309671212000495608, 4148088229796047147, 719994845585821195, 86044856163286340},
+	{138078680233151680, 6636941167673675436, 1151991752937313912, 137671769861258144},
+	{11178509388412252314, 1620204119397030025, 4610942217091761453, 110137415889006515},
+	{16321505140213622498, 8674860925001444666, 3688753773673409162, 88109932711205212},
+	{7667664150632244380, 2811731035776580497, 9591354852619364983, 140975892337928339},
+	{9823480135247705827, 13317431272846995367, 11362432696837402309, 112780713870342671},
+	{480086478714344015, 10653945018277596294, 5400597342728011524, 90224571096274137},
+	{384069182971475212, 4833807199880166712, 15388524318408140189, 72179656877019309},
+	{614510692754360340, 4044742705066356416, 13553592465227293333, 115487451003230895},
+	{4180957368945398595, 10614491793536905779, 10842873972181834666, 92389960802584716},
+	{18102161154123960169, 1112895805345703976, 4984950363003557410, 73911968642067773},
+	{17895411402372605300, 16538028547520767655, 4286571766063781532, 118259149827308437},
+	{18005677936639994564, 16919771652758524447, 14497303857076756195, 94607319861846749},
+	{10715193534570085328, 17225166136948729881, 15287191900403315279, 75685855889477399},
+	{6076263211086405555, 5424172930666505871, 13391460596419573478, 121097369423163839},
+	{12239708198352945090, 15407384788758935666, 14402517291877569105, 96877895538531071},
+	{2413068929198535426, 8636559016265238210, 7832665018760144961, 77502316430824857},
+	{171561471975746358, 10129145611282470813, 16221612844758142261, 124003706289319771},
+	{137249177580597086, 724618859542156004, 9287941461064603486, 99202965031455817},
+	{11177845786290208639, 7958392717117545449, 51655539367862142, 79362372025164654},
+	{17884553258064333822, 5354730717904252072, 7461346492472400074, 126979795240263446},
+	{6928944976967646411, 4283784574323401658, 2279728379236009736, 101583836192210757},
+	{1853807166832206806, 10805725288942541973, 12891829147614538758, 81267068953768605},
+	{14034137911157261859, 13599811647566156833, 2180182562473710397, 130027310326029769},
+	{14916659143667719810, 7190500503311015143, 5433494864720878641, 104021848260823815},
+	{15622676129676086171, 2063051587906901791, 4346795891776702913, 83217478608659052},
+	{17617584177997917228, 3300882540651042866, 10644222241584634984, 133147965773854483},
+	{3026020898172602813, 13708752476746565263, 15894075422751528633, 106518372619083586},
+	{13488863162763813220, 14656350796139162533, 9025911523459312583, 85214698095266869},
+	{3135436986712549535, 8692766014855018761, 3373411993309169164, 136343516952426991},
+	{17265744848337680921, 6954212811884015008, 17456124853614976624, 109074813561941592},
+	{17501944693412055060, 1874021434765301683, 6586202253408160653, 87259850849553274},
+	{9556367435749736480, 6687783110366393017, 17916621234936877691, 139615761359285238},
+	{3955745133857878861, 9039575303035024737, 3265250543723771183, 111692609087428191},
+	{17921991366053944382, 10921009057169930112, 17369595693946658239, 89354087269942552},
+	{6539093297234849071, 10094916861988067534, 13033957851347011890, 142966539631908084},
+	{12609972267271699903, 11765282304332364350, 14116515095819519835, 114373231705526467},
+	{2709280184333539276, 2033528213982070834, 3914514447171795222, 91498585364421174},
+	{2167424147466831421, 16384217830153297960, 6820960372479346500, 73198868291536939},
+	{10846576265430750920, 15146702084019545766, 18292234225450775047, 117118189266459102},
+	{8677261012344600736, 15806710481957546936, 7255089750876799391, 93694551413167282},
+	{10631157624617590912, 1577321941340306579, 16872118244927170483, 74955641130533825},
+	{2252456940420504166, 17281110365112131820, 8548645118173921156, 119929025808854121},
+	{12870011996562134303, 6446190662605884809, 3149567279797226602, 95943220647083297},
+	{17674707226733528089, 8846301344826618170, 13587700268063512251, 76754576517666637},
+	{6143438674322183003, 10464733336980678750, 6982925169933978309, 122807322428266620},
+	{8604099754199656725, 12061135484326453323, 5586340135947182647, 98245857942613296},
+	{3193930988617815057, 17027606016944983305, 779723294015835794, 78596686354090637},
+	{16178336026014235061, 1418727923918601025, 4936906085167247595, 125754698166545019},
+	{16632017635553298372, 4824331153876791143, 7638873682875708399, 100603758533236015},
+	{5926916478958818051, 7548813737843343238, 6111098946300566719, 80483006826588812},
+	{16861763995817929528, 4699404351065528534, 13467107128822817074, 128772810922542099},
+	{6110713567170522976, 11138221110336243474, 14463034517800163982, 103018248738033679},
+	{4888570853736418381, 5221228073527084456, 15259776428982041509, 82414598990426943},
+	{4132364551236359086, 12043313732385245453, 2279549397919804475, 131863358384683110},
+	{10684589270472907915, 9634650985908196362, 1823639518335843580, 105490686707746488},
+	{8547671416378326332, 15086418418210377736, 8837609244152495510, 84392549366197190},
+	{6297576636721501485, 5691525395427052762, 14140174790643992817, 135028078985915504},
+	{12416758938861021835, 863871501599731886, 15001488647257104577, 108022463188732403},
+	{6244058336346907145, 691097201279785509, 933144473579952692, 86417970550985923},
+	{17369190967638872078, 1105755522047656814, 16250426416695565600, 138268752881577476},
+	{13895352774111097662, 15641999676605766744, 9310992318614542156, 110615002305261981},
+	{7426933404546967807, 5134902111800792749, 3759445040149723402, 88492001844209585},
+	{4504395817791327844, 11905192193623178722, 6015112064239557443, 141587202950735336},
+	{18360911913200703568, 13213502569640453300, 1122740836649735631, 113269762360588269},
+	{14688729530560562854, 10570802055712362640, 4587541484061698828, 90615809888470615},
+	{682937180222719314, 15835339274053710759, 3670033187249359062, 72492647910776492},
+	{15850094747323992195, 14268496394260206244, 9561401914340884823, 115988236657242387},
+	{16369424612601104079, 11414797115408164995, 270423901988887212, 92790589325793910},
+	{5716842060597062617, 1753140062842711350, 216339121591109770, 74232471460635128},
+	{5457598482213389864, 17562419359515979453, 15103537853513416924, 118771954337016204},
+	{676729971028801568, 2981889043387052593, 15772179097552643863, 95017563469612963},
+	{4230732791564951578, 17142906493677283367, 1549696833816384120, 76014050775690371},
+	{3079823651762012201, 1603208686690281125, 13547561378331945563, 121622481241104593},
+	{17221254180377251054, 16039962208319866192, 18216746732149377096, 97297984992883674},
+	{6398305714817980197, 12831969766655892954, 18262746200461412000, 77838387994306939},
+	{6547940328966857991, 9463105182423697757, 18152347476512528231, 124541420790891103},
+	{1549003448431576070, 11259832960680868529, 3453831536984291615, 99633136632712883},
+	{12307249202970991826, 16386563998028515469, 10141762859071253938, 79706509306170306},
+	{16002249910011676597, 15150455952619893781, 8848122945030185655, 127530414889872490},
+	{9112451113267430955, 12120364762095915025, 7078498356024148524, 102024331911897992},
+	{3600612075872034441, 6006942994934821697, 16730845129045049789, 81619465529518393},
+	{13139676950879075751, 13300457606637625038, 4633259318020617723, 130591144847229430},
+	{6822392745961350278, 18019063714793920677, 3706607454416494178, 104472915877783544},
+	{9147263011510990546, 7036553342351315895, 6654634778275105666, 83578332702226835},
+	{7256923188933764226, 3879787718278284786, 10647415645240169066, 133725332323562936},
+	{13184236180630832028, 14171876618848358798, 4828583701450224929, 106980265858850349},
+	{6858040129762755299, 269454850852956069, 7552215775902090267, 85584212687080279},
+	{10972864207620408478, 11499174205590460680, 1015498797217613457, 136934740299328447},
+	{12467640180838237106, 12888688179214278867, 11880445481999821735, 109547792239462757},
+	{13663460959412500008, 2932252913887602447, 2125658756116036742, 87638233791570206},
+	{3414793461350448396, 1002255847478253593, 14469100454011389757, 140221174066512329},
+	{6421183583822269040, 15559199936950244167, 15264629177951022128, 112176939253209863},
+	{12515644496541635879, 8758011134818285010, 1143656898135086733, 89741551402567891},
+	{8956984750240886436, 2944771371483525047, 12897897481241869743, 143586482244108625},
+	{7165587800192709149, 9734514726670640684, 10318317984993495794, 114869185795286900},
+	{13111167869637987966, 11476960596078422870, 8254654387994796635, 91895348636229520},
+	{10488934295710390373, 9181568476862738296, 6603723510395837308, 73516278908983616},
+	{16782294873136624596, 3622463118754650304, 3187259987149519047, 117626046254373786},
+	{6047138269025479030, 10276668124487540890, 17307203248687256530, 94100837003499028},
+	{12216408244704203871, 15600032129073853358, 2777716154724074254, 75280669602799223},
+	{1099509117817174577, 10202656147550524081, 754997032816608484, 120449071364478757},
+	{11947653738479470631, 4472776103298508941, 11672044070479017757, 96359257091583005},
+	{16936820620267397152, 14646267326864538122, 9337635256383214205, 77087405673266404},
+	{1273471289234463180, 12365981278757530027, 3872169965987411759, 123339849077226247},
+	{8397474660871391190, 6203436208264113698, 14165782417015660377, 98671879261780997},
+	{17786026172922843922, 8652097781353201281, 3953928304128707655, 78937503409424798},
+	{17389595432450819305, 10154007635423211727, 2636936471864021925, 126300005455079677},
+	{17601025160702565768, 744508478854748735, 13177595621716948510, 101040004364063741},
+	{10391471313820142291, 15353002042051440281, 6852727682631648484, 80832003491250993},
+	{12937005287370317342, 9807408008314663157, 7275015477468727252, 129331205586001589},
+	{17728301859380074520, 4156577591909820202, 9509361196716892125, 103464964468801271},
+	{3114595043278328647, 18082657332495497455, 3918140142631603376, 82771971575041017},
+	{1294003254503415511, 6796158843541333989, 9958373042952475726, 132435154520065627},
+	{12103249047828463378, 12815624704316887837, 588000804878159934, 105948123616052502},
+	{17061296867746591349, 6563150948711599946, 11538447088128258917, 84758498892842001},
+	{16230028544168815189, 6811692703196649591, 11082817711521393621, 135613598228547202},
+	{16673371650076962474, 12828051792041140319, 1487556539733294250, 108490878582837762},
+	{9649348505319659656, 2883743804149091609, 12258091676012366370, 86792702866270209},
+	{11749608793769545127, 11992687716122367221, 8544900237394055222, 138868324586032335},
+	{16778384664499456748, 2215452543414073130, 6835920189915244178, 111094659668825868},
+	{9733358916857655075, 16529757293698899797, 12847433781416015988, 88875727735060694},
+	{4505327822746517151, 11690216410950598383, 9487847606039894612, 142201164376097111},
+	{18361657517164855013, 16730870758244299352, 3900929270090005366, 113760931500877689},
+	{7310628384248063364, 13384696606595439482, 6810092230813914616, 91008745200702151},
+	{9537851522140361015, 3329059655792530939, 1758724969909221370, 72806996160561721},
+	{15260562435424577623, 16394541893493780472, 13882006396080485161, 116491193856898753},
+	{8519101133597751776, 16804982329536934701, 37558672638657159, 93192955085519003},
+	{14193978536362022067, 6065288234145727114, 7408744567594746374, 74554364068415202},
+	{4263621584469683691, 2325763545149342737, 15543340122893504522, 119286982509464323},
+	{14478943711801477922, 1860610836119474189, 1366625654089072648, 95429586007571459},
+	{7893806154699272015, 12556535113121310321, 4782649338013168441, 76343668806057167},
+	{1562043403293104254, 16401107366252186191, 11341587755562979829, 122149870089691467},
+	{12317681166860214373, 9431537078259838629, 1694572574966563217, 97719896071753174},
+	{9854144933488171498, 3855880847865960580, 5045006874715160897, 78175916857402539},
+	{8387934264097253751, 17237455800811267898, 15450708629028078081, 125081466971844062},
+	{3020998596535892677, 2721918196423283349, 4981869273738641819, 100065173577475250},
+	{9795496506712534788, 5866883371880537002, 3985495418990913455, 80052138861980200},
+	{915399151772414368, 9387013395008859204, 6376792670385461528, 128083422179168320},
+	{11800365765643662464, 14888308345490908009, 5101434136308369222, 102466737743334656},
+	{2061594983031109325, 842600232166995438, 391798494304785055, 81973390194667725},
+	{18055947231817416213, 1348160371467192700, 626877590887656088, 131157424311468360},
+	{3376711341228202001, 8457225926657574807, 501502072710124870, 104925939449174688},
+	{2701369072982561601, 14144478370809880492, 7779899287651920542, 83940751559339750},
+	{11700888146255919207, 7873770134328167494, 12447838860243072868, 134305202494943600},
+	{1982012887520914719, 13677713736946354642, 9958271088194458294, 107444161995954880},
+	{16343005568984373068, 14631519804298994036, 7966616870555566635, 85955329596763904},
+	{7702064836665445293, 12342385242652659489, 1678540548663175647, 137528527354822247},
+	{13540349498816176881, 13563257008864037914, 12410878883156271487, 110022821883857797},
+	{18210977228536762151, 14539954421833140654, 2550005477041196543, 88018257507086238},
+	{10690819491949267826, 15885229445449204401, 390659948524004146, 140829212011337981},
+	{15931353223043234907, 5329485926875542874, 15069923217786844610, 112663369609070384},
+	{1677036134208856956, 7952937556242344623, 15745287388971386011, 90130695687256307},
+	{16099024166334726858, 13741047674477696344, 5217532281693288162, 72104556549805046},
+	{11001043407167921680, 18296327464422403828, 969354021225440413, 115367290479688074},
+	{8800834725734337344, 7258364342054102416, 4464832031722262654, 92293832383750459},
+	{10730016595329380198, 13185389103127102579, 7261214440119720446, 73835065907000367},
+	{2410631293559367024, 17407273750261453804, 15307291918933463037, 118136105451200587},
+	{9307202664331314266, 17615167814951073366, 4867135905662949783, 94508884360960470},
+	{11135110946206961736, 3024087807735127723, 3893708724530359827, 75607107488768376},
+	{10437479884447318131, 1149191677634294034, 17297980403474306693, 120971371982029401},
+	{15728681537041675151, 4608702156849345550, 10149035508037535031, 96777097585623521},
+	{5204247600149519475, 14755008169705207410, 4429879591688117701, 77421678068498817},
+	{4637447345497320836, 1471920183076869917, 10777156161442898646, 123874684909598107},
+	{3709957876397856669, 8556233775945316580, 1243027299670498270, 99099747927678486},
+	{17725361560085926628, 3155638206014342940, 15751817098704039909, 79279798342142788},
+	{13603183237169841312, 8738369944364859028, 3066814469475001915, 126847677347428462},
+	{10882546589735873049, 18058742399717618192, 13521498019805732501, 101478141877942769},
+	{1327339642304877793, 14446993919774094554, 14506547230586496324, 81182513502354215},
+	{16881138686655445762, 12047143827412820316, 4763731495228842503, 129892021603766745},
+	{17194259764066266933, 17016412691414076899, 3810985196183074002, 103913617283013396},
+	{6376710181769192900, 2545083708905530550, 17806183415914100495, 83130893826410716},
+	{2824038661346887993, 15140180378474579850, 2664451762269188529, 133009430122257147},
+	{17016626188045151687, 8422795488037753556, 13199607854041081793, 106407544097805717},
+	{9923952135694211027, 6738236390430202845, 3180988653749044788, 85126035278244574},
+	{1120928158143096350, 14470527039430234876, 12468279475482292307, 136201656445191318},
+	{15654137785482118373, 11576421631544187900, 17353321209869654492, 108961325156153054},
+	{8833961413643784375, 5571788490493439997, 17572005782637633917, 87169060124922443},
+	{17823687076571965323, 8914861584789503995, 5979116363768752328, 139470496199875910},
+	{3190903217031841289, 14510586897315423843, 4783293091015001862, 111576396959900728},
+	{9931420203109293678, 11608469517852339074, 11205332102295822136, 89261117567920582},
+	{1132877066007228591, 14884202413821832196, 3171136104705674125, 142817788108672932},
+	{8284999282289603519, 4528664301573645110, 13604955327990270270, 114254230486938345},
+	{6627999425831682816, 3622931441258916088, 10883964262392216216, 91403384389550676},
+	{5302399540665346253, 13966391597232863840, 5017822595171862649, 73122707511640541},
+	{8483839265064554004, 3899482481863030528, 649818522791159593, 116996332018624866},
+	{17855117856277374173, 6808934800232334745, 15277250077200568967, 93597065614899892},
+	{17973443099763809661, 9136496654927778119, 4843102432276634527, 74877652491919914},
+	{10310764885912543842, 7239697018400624345, 15127661521126435890, 119804243987071862},
+	{869914279246214427, 16859804058946230446, 4723431587417328065, 95843395189657490},
+	{15453326682364612835, 13487843247156984356, 3778745269933862452, 76674716151725992},
+	{6278578618073828919, 10512502751225444001, 9735341246636090247, 122679545842761587},
+	{5022862894459063136, 12099351015722265524, 409575367825051551, 98143636674209270},
+	{4018290315567250509, 5990131997835902096, 327660294260041241, 78514909339367416},
+	{2739915690165690490, 13273560011279353677, 11592302915041796955, 125623854942987865},
+	{13259978996358283362, 10618848009023482941, 9273842332033437564, 100499083954390292},
+	{3229285567602806043, 4805729592476876030, 40376236142929405, 80399267163512234},
+	{12545554537648310315, 15067864977446822294, 7443299607312507694, 128638827461619574},
+	{2657746000634827606, 986245537731726866, 9643988500591916479, 102911061969295659},
+	{5815545615249772408, 8167694059669202139, 11404539615215443506, 82328849575436527},
+	{12994221799141546176, 9378961680728813099, 3489868125377068317, 131726159320698444},
+	{10395377439313236941, 3813820529841140156, 6481243315043564977, 105380927456558755},
+	{15694999580934410199, 14119102868098643094, 5184994652034851981, 84304741965247004},
+	{14043952885269325349, 4143820515248277335, 15674689072739583817, 134887587144395206},
+	{167115863989729309, 10693754041682442515, 8850402443449756730, 107910069715516165},
+	{133692691191783448, 8555003233345954012, 7080321954759805384, 86328055772412932},
+	{14971303564874494809, 6309307543869705772, 15017863942357598938, 138124889235860691},
+	{8287694037157685524, 8736794849837674941, 8324942339144168827, 110499911388688553},
+	{2940806414984238096, 6989435879870139953, 14038651500799155708, 88399929110950842},
+	{1015941449232870630, 11183097407792223925, 7704447142311007840, 141439886577521348},
+	{8191450788870117151, 16325175555717599786, 13542255343332626918, 113151909262017078},
+	{17621207075321824690, 9370791629832169505, 18212501904149922181, 90521527409613662},
+	{3028919216031728783, 14875330933349556251, 7191303893836117098, 72417221927690930},
+	{12224968375134586698, 1664436604907828062, 11506086230137787358, 115867555084305488},
+	{17158672329591490005, 16088944542893903742, 16583566613594050532, 92694044067444390},
+	{17416286678415102327, 5492458004831302347, 13266853290875240426, 74155235253955512},
+	{9419314611754612107, 5098583992988173433, 6469570006432743389, 118648376406328820},
+	{156754059919869040, 7768216009132449070, 5175656005146194711, 94918701125063056},
+	{11193449692161626201, 17282619251531690225, 451175989375045445, 75934960900050445},
+	{17909519507458601922, 9205446728741152744, 721881583000072713, 121495937440080712},
+	{18016964420708791861, 7364357382992922195, 11645551710625789140, 97196749952064569},
+	{18102920351308943812, 9580834721136248079, 13005790183242541635, 77757399961651655},
+	{17896626117868579129, 15329335553817996927, 2362520219478515000, 124411839938642649},
+	{10627952079552952980, 15952817257796307865, 5579364990324722323, 99529471950914119},
+	{1123664034158541738, 5383556176753225646, 8152840807001688182, 79623577560731295},
+	{16555257713621308073, 12303038697547071356, 13044545291202701091, 127397724097170072},
+	{16933554985638956782, 17221128587521477731, 3056938603478340226, 101918179277736058},
+	{13546843988511165426, 17466251684759092508, 9824248512266492827, 81534543422188846},
+	{14296252752134044034, 5809909807163086074, 8340099990142567878, 130455269475502154},
+	{7747653386965324904, 15715974289956199829, 10361428806855964625, 104364215580401723},
+	{17266169153797990893, 1504732987739228893, 15667840674968592347, 83491372464321378},
+	{5489777757625323490, 2407572780382766230, 2932452191498285816, 133586195942914206},
+	{15459868650325989761, 12994104668531943953, 17103357012166269945, 106868956754331364},
+	{4989197290776971163, 14084632549567465486, 17372034424474926279, 85495165403465091},
+	{604018035759333214, 4088668005598393162, 1969813375966509785, 136792264645544147},
+	{4172563243349376894, 14338980848704445499, 12643897144998938797, 109433811716435317},
+	{10716748224163322162, 15160533493705466722, 2736420086515330391, 87547049373148254},
+	{2389401899693674166, 5810109516219195140, 11756969767908349273, 140075278997037206},
+	{5600870334496849656, 8337436427717266435, 5716226999584769095, 112060223197629765},
+	{4480696267597479725, 6669949142173813148, 4572981599667815276, 89648178558103812},
+	{18237160472381698529, 6982569812736190713, 11006119374210414765, 143437085692966099},
+	{7211030748421538177, 9275404664930862894, 12494244314110242135, 114749668554372879},
+	{13147522228221051188, 11109672546686600638, 13684744266030104031, 91799734843498303},
+	{3139320153093020304, 12577086852091190834, 18326493042307903871, 73439787874798642},
+	{8712261059690742810, 16433990148603995011, 14564993608725004901, 117503660599677828},
+	{6969808847752594248, 16836540933625106332, 583948442754272951, 94002928479742263},
+	{1886498263460165075, 17158581561641995389, 7845856383687239007, 75202342783793810},
+	{14086443665761995090, 12696335239659551329, 12553370213899582412, 120323748454070096},
+	{3890457303125775425, 17535765821211461710, 6353347356377755606, 96258998763256077},
+	{10491063471984440987, 2960566212743438398, 16150724329327935455, 77007199010604861},
+	{5717655110949374609, 15804952384615232407, 15717223731324465, 123211518416967779},
+	{884775274017589364, 16333310722434096249, 3701922593726969895, 98569214733574223},
+	{11775866663439802461, 1998602133721546029, 10340235704465396563, 78855371786859378},
+	{394642587794132321, 14265809858180204617, 12855028312402724177, 126168594858975005},
+	{4005062884977216180, 4033950257060343047, 10284022649922179342, 100934875887180004},
+	{10582747937465593591, 17984555464615915730, 11916566934679653796, 80747900709744003},
+	{5864350255719218775, 17707242299159734199, 15377158280745535751, 129196641135590405},
+	{4691480204575375020, 10476445024585877036, 12301726624596428601, 103357312908472324},
+	{63835348918389693, 8381156019668701629, 13530730114419053204, 82685850326777859},
+	{3791485373011333832, 9720500816728012283, 10581121738844754157, 132297360522844575},
+	{3033188298409067066, 397703023898589180, 8464897391075803326, 105837888418275660},
+	{17183945897694894945, 15075557678086512636, 6771917912860642660, 84670310734620528},
+	{16426266992086100943, 1984799396486958279, 7145719845835117934, 135472497175392845},
+	{2072967149443149785, 5277188331931476947, 5716575876668094347, 108377997740314276},
+	{1658373719554519828, 11600448295029002204, 883911886592565154, 86702398192251421},
+	{10032095580771052371, 113973198336851910, 12482305462773835217, 138723837107602273},
+	{8025676464616841897, 91178558669481528, 17364541999702888820, 110979069686081818},
+	{2731192356951563194, 7451640476419405869, 2823587155536580086, 88783255748865455},
+	{4369907771122501111, 4543927132787228744, 4517739448858528138, 142053209198184728},
+	{3495926216898000889, 18392536965197424288, 10992889188570643156, 113642567358547782},
+	{17554136232486042004, 3645983127932208460, 1415613721372693879, 90914053886838226},
+	{14043308985988833603, 2916786502345766768, 15889886236065796396, 72731243109470580},
+	{11401247933356402795, 15734904847978957799, 6977073903995722617, 116369988975152929},
+	{9120998346685122236, 8898575063641255916, 9271007937938488417, 93095991180122343},
+	{3607449862606187466, 7118860050913004733, 14795503979834611380, 74476792944097874},
+	{9461268594911810268, 322129637235076603, 12604759923509647239, 119162868710556599},
+	{3879666061187537891, 7636401339271881929, 13773156753549628114, 95330294968445279},
+	{14171779293175761283, 13487818700901326189, 14707874217581612814, 76264235974756223},
+	{15296149239597397406, 6823114662474480610, 1396505859679118564, 122022777559609958},
+	{4858221762194097278, 16526538174205315458, 8495902317227115497, 97618222047687966},
+	{197228595013367500, 2153184095138521397, 3107373039039782075, 78094577638150373},
+	{315565752021387999, 18202489811189275528, 1282448047721740996, 124951324221040597},
+	{15009847860584751692, 3493945404725689452, 12094004882403123767, 99961059376832477},
+	{8318529473725891031, 6484505138522461885, 2296506276438678367, 79968847501465982},
+	{2241600713735694679, 17753905851119759663, 7363758857043795710, 127950156002345571},
+	{5482629385730466067, 10513775866153897407, 2201658270893126245, 102360124801876457},
+	{8075452323326283177, 1032323063439297279, 12829373060940231966, 81888099841501165},
+	{12920723717322053082, 12719763345728606616, 2080252823794819529, 131020959746401865},
+	{10336578973857642466, 13865159491324795616, 1664202259035855623, 104816767797121492},
+	{4579914364344203650, 11092127593059836493, 12399408251454415468, 83853414237697193},
+	{14706560612434546485, 10368706519411917742, 16149704387585154426, 134165462780315509},
+	{4386550860463816542, 8294965215529534194, 16609112324810033864, 107332370224252407},
+	{3509240688371053234, 2946623357681717032, 5908592230364206445, 85865896179401926},
+	{1925436286651774851, 15782643816516478221, 2075049939098909665, 137385433887043082},
+	{8919046658805240527, 5247417423729361930, 12728086395504858702, 109908347109634465},
+	{18203283771269923391, 15265980383209220513, 10182469116403886961, 87926677687707572},
+	{3299812330838505163, 2289475724683290883, 1534555327278577846, 140682684300332116},
+	{2639849864670804131, 12899627023972363676, 15985039520790503569, 112546147440265692},
+	{13179926335962374274, 6630352804435980617, 5409333987148582209, 90036917952212554},
+	{2641138063830247223, 6919215672355658665, 16033632008921552181, 144059068723540086},
+	{13180956895289928748, 16603418982110257901, 9137556792395331421, 115247254978832069},
+	{6855416701490032675, 13282735185688206321, 10999394248658175460, 92197803983065655},
+	{1794984546450115817, 10626188148550565057, 8799515398926540368, 73758243186452524},
+	{10250672903804005953, 2244505778713262798, 3011178194056733620, 118013189098324039},
+	{821840693559384116, 5484953437712520562, 6098291369987297219, 94410551278659231},
+	{11725518999073238263, 4387962750170016449, 1189284281247927452, 75528441022927385},
+	{11382132769033360574, 10710089215013936642, 1902854849996683923, 120845505636683816},
+	{5416357400484778136, 12257420186753059637, 16279679138964988431, 96676404509347052},
+	{4333085920387822509, 17184633778886268356, 5645045681688170098, 77341123607477642},
+	{18000983916846246983, 9048669972508477753, 12721421905442982481, 123745797771964227},
+	{10711438318735087264, 14617633607490602849, 2798439894870565338, 98996638217571382},
+	{12258499469729980134, 11694106885992482279, 13306798360122183240, 79197310574057105},
+	{8545552707342237245, 263826943878420031, 2844133302485941569, 126715696918491369},
+	{10525790980615700119, 7589759184586556671, 5964655456730663578, 101372557534793095},
+	{12109981599234470419, 13450504977153065983, 4771724365384530862, 81098046027834476},
+	{11997272929291332023, 17831459148702995250, 256061355131428733, 129756873644535162},
+	{9597818343433065619, 14265167318962396200, 11272895528330873956, 103805498915628129},
+	{7678254674746452495, 11412133855169916960, 12707665237406609488, 83044399132502503},
+	{4906509850110503345, 10880716538788046490, 16642915565108664858, 132871038612004005},
+	{11303905509572223323, 16083270860514257838, 13314332452086931886, 106296830889603204},
+	{16421822037141599305, 12866616688411406270, 14340814776411455832, 85037464711682563},
+	{7828171185717007271, 2139842627748698417, 809210753806867393, 136059943538692102},
+	{17330583392799336787, 1711874102198958733, 11715415047271224884, 108847954830953681},
+	{2796420270013738460, 1369499281759166987, 5682983223075069584, 87078363864762945},
+	{15542318876247712505, 9569896480298487825, 9092773156920111334, 139325382183620712},
+	{8744506286256259681, 3966568369496879937, 18342264969761820037, 111460305746896569},
+	{14374302658488828391, 17930649954565145242, 18363160790551366352, 89168244597517255},
+	{11930837809356394456, 13931644668336591095, 10934313191172634548, 142669191356027609},
+	{2165972618001294918, 3766618105185452230, 12436799367680017962, 114135353084822087},
+	{5422126909142946258, 6702643298890272107, 2570741864660193723, 91308282467857670},
+	{4337701527314357007, 12740812268596038332, 2056593491728154978, 73046625974286136},
+	{18008368887928702180, 9317253185527930361, 14358596030990778935, 116874601558857817},
+	{3338648666117230774, 75104918938523643, 4108179195308802502, 93499681247086254},
+	{6360267747635694943, 14817479194118460207, 6975892170988952324, 74799744997669003},
+	{17555126025700932555, 8950571451621895038, 7472078658840413396, 119679591996270405},
+	{17733449635302656367, 3471108346555605707, 5977662927072330717, 95743673597016324},
+	{3118713264016394124, 17534281936212125859, 8471479156399774896, 76594938877613059},
+	{12368638851910051245, 9608107024229849758, 2486320206013908865, 122551902204180895},
+	{17273608711011861642, 7686485619383879806, 1989056164811127092, 98041521763344716},
+	{17508235783551399637, 13527886124990924491, 16348640190816542966, 78433217410675772},
+	{16945130809456508449, 17955268985243568863, 11400429046338827453, 125493147857081236},
+	{6177407018081386113, 18053564002936765414, 5430994422329151639, 100394518285664989},
+	{16009972058690839860, 3374804758123681361, 8034144352605231635, 80315614628531991},
+	{10858560034937702483, 16467734057223621148, 5475933334684549969, 128504983405651186},
+	{16065545657433982633, 13174187245778896918, 691397853005729652, 102803986724520949},
+	{16541785340689096430, 6850000981881207211, 4242467097146494045, 82243189379616759},
+	{8020112471393002671, 18338699200493752185, 14166644984918211118, 131589103007386814},
+	{17484136421340133107, 7292261730911181101, 15022664802676479218, 105271282405909451},
+	{13987309137072106486, 9523158199470855204, 8328783027399273051, 84217025924727561},
+	{15000996989831549730, 479657860185727034, 5947355214355016236, 134747241479564098},
+	{932751147639508815, 4073075102890491951, 12136581800967833635, 107797793183651278},
+	{4435549732853517375, 10637157711796214207, 17087963070258087554, 86238234546921022},
+	{3407530757823717477, 9640754709390122085, 12583345653445298794, 137981175275073636},
+	{2726024606258973981, 7712603767512097668, 6377327708014328712, 110384940220058909},
+	{5870168499749089508, 2480734199267767811, 8791210981153373293, 88307952176047127},
+	{2013571970114722567, 3969174718828428498, 17755286384587307592, 141292723481675403},
+	{8989555205575598700, 3175339775062742798, 3136182663444115104, 113034178785340323},
+	{7191644164460478960, 13608318264275925208, 9887643760239112729, 90427343028272258},
+	{5753315331568383168, 3507956981936919520, 15288812637675110830, 72341874422617806},
+	{1826606901025592422, 16680777615324802202, 17083402590796356681, 115746999076188490},
+	{8839983150304294584, 9655273277517931438, 13666722072637085345, 92597599260950792},
+	{7071986520243435668, 345520992530524504, 3554680028625847630, 74078079408760634},
+	{7625829617647586745, 7931531217532659853, 13066185675285176854, 118524927054017014},
+	{2411314879376159073, 13723922603509948529, 14142297354970051806, 94819941643213611},
+	{16686447162468568551, 3600440453324138176, 7624489069234131122, 75855953314570889},
+	{11940920200982068388, 16828751169544352052, 1131136066548878825, 121369525303313423},
+	{9552736160785654711, 2394954491409750672, 8283606482722923707, 97095620242650738},
+	{263491299144703122, 1915963593127800538, 14005582815662159612, 77676496194120590},
+	{421586078631524995, 6754890563746391184, 3962188431349903763, 124282393910592945},
+	{11405315307130950966, 12782610080480933593, 3169750745079923010, 99425915128474356},
+	{12813601060446671096, 6536739249642836551, 17293195855031579701, 79540732102779484},
+	{13123064067230853107, 10458782799428538482, 16601066923824796552, 127265171364447175},
+	{14187800068526592809, 988328610059010139, 13280853539059837242, 101812137091557740},
+	{7660891240079363924, 11858709332272939081, 10624682831247869793, 81449709673246192},
+	{4878728354643161632, 527190857927150914, 2242097271028950377, 130319535477193908},
+	{7592331498456439629, 421752686341720731, 9172375446306980948, 104255628381755126},
+	{6073865198765151703, 4026750963815286908, 3648551542303674435, 83404502705404101},
+	{17096881947508063371, 17510847986330190022, 16905728911911610065, 133447204328646561},
+	{2609459113780719727, 10319329574322241695, 9835234314787377729, 106757763462917249},
+	{9466264920508396428, 15634161288941614002, 11557536266571812506, 85406210770333799},
+	{388628613845792992, 6567913988597030788, 7424011582289169041, 136649937232534079},
+	{7689600520560455040, 5254331190877624630, 9628558080573245556, 109319949786027263},
+	{9841029231190274356, 7892813767444010027, 15081544093942417091, 87455959828821810},
+	{12056297955162528645, 5249804398426595397, 5683726476598315730, 139929535726114897},
+	{13334387178871933240, 15267889962967007287, 15615027625504383553, 111943628580891917},
+	{3288812113613725945, 12214311970373605830, 5113324470919686196, 89554902864713534},
+	{5262099381781961512, 1096155078888217712, 15560016782955318561, 143287844583541654},
+	{15277725949651300180, 876924063110574169, 16137362241106165172, 114630275666833323},
+	{15911529574462950467, 701539250488459335, 1841843348659201168, 91704220533466659},
+	{5350526030086539727, 11629277844616498438, 5162823493669271257, 73363376426773327},
+	{12250190462880373887, 7538798107160666531, 11949866404612744335, 117381402282837323},
+	{13489501185046209433, 13409736115212353871, 16938590753174016114, 93905121826269858},
+	{18170298577520788193, 3349091262686062450, 2482826158313481922, 75124097461015887},
+	{18004431279807530138, 12737243649781520567, 7661870668043481398, 120198555937625419},
+	{18092893838587934434, 2811097290341395807, 9818845349176695442, 96158844750100335},
+	{18163663885612257870, 13316924276498847615, 7855076279341356353, 76927075800080268},
+	{3236420513786240330, 13928381212914335539, 8878773232204259842, 123083321280128429},
+	{2589136411028992264, 7453356155589558108, 10792367400505318197, 98466657024102743},
+	{9450006758307014458, 5962684924471646486, 16012591549888075204, 78773325619282194},
+	{4051964369065492162, 5850947064412724055, 14552100035595189357, 126037320990851511},
+	{10620269124736214376, 12059455281013999890, 7952331213734241162, 100829856792681209},
+	{4806866485047061178, 5958215410069289589, 10051213785729303253, 80663885434144967},
+	{15069684005559118531, 9533144656110863342, 1324546798199243912, 129062216694631948},
+	{4677049574963474179, 7626515724888690674, 8438335068043215776, 103249773355705558},
+	{11120337289454599989, 9790561394652862862, 14129365683918393267, 82599818684564446},
+	{17792539663127359983, 11975549416702670256, 15228287464785608581, 132159709895303114},
+	{10544682915759977663, 9580439533362136205, 15871978786570397188, 105727767916242491},
+	{12125095147349892454, 11353700441431619287, 9008234214514407427, 84582214332993993},
+	{4642756976792186633, 18165920706290590860, 10723825928481141560, 135331542932790389},
+	{7403554396175659630, 18222085379774383011, 12268409557526823571, 108265234346232311},
+	{13301541146424348350, 7198970674335685762, 6125378831279548534, 86612187476985849},
+	{2835721760569405744, 7829004264195186897, 17179303759531098301, 138579499963177358},
+	{17025972667423165888, 9952552226098059840, 2675396563399147671, 110863599970541887},
+	{2552731689712801741, 15340739410362268519, 13208363694945049106, 88690879976433509},
+	{11463068333024303432, 6098438982870078014, 10065335467686347601, 141905407962293615},
+	{9170454666419442745, 1189402371554152088, 8052268374149078081, 113524326369834892},
+	{3647014918393643873, 8330219526727142317, 17509861143544993434, 90819461095867913},
+	{6606960749456825422, 17732222065607444823, 2939842470610263777, 72655568876694331},
+	{3192439569647100028, 6235462416520449778, 15771794397202153014, 116248910202710929},
+	{17311346914685321315, 12367067562700180468, 16306784332503632734, 92999128162168743},
+	{13849077531748257052, 2514956420676323728, 1977381021777175218, 74399302529734995},
+	{14779826421313390637, 334581458340207642, 3163809634843480349, 119038884047575992},
+	{755814692824981540, 15025060425639807407, 13599094152100515248, 95231107238060793},
+	{7983349383743805879, 8330699525769935602, 18257972951164232845, 76184885790448634},
+	{5394661384506268759, 2261072797006165994, 18144710277637041583, 121895817264717815},
+	{15383775551830745977, 9187555867088753441, 14515768222109633266, 97516653811774252},
+	{15996369256206507105, 14728742323154823399, 4233916948203885966, 78013323049419402},
+	{10836795550962770075, 1429894828596255500, 10463615931868127870, 124821316879071043},
+	{16048134070254036706, 8522613492360825046, 15749590374978322942, 99857053503256834},
+	{5459809626719408719, 3128741979146749714, 16289021114724568677, 79885642802605467},
+	{5046346588009143627, 12384684796118620189, 11305038524591668590, 127817028484168748},
+	{15105123714633045871, 17286445466378716797, 16422728449157155518, 102253622787334998},
+	{1016052527480705727, 10139807558361063115, 2070136315099993445, 81802898229867999},
+	{9004381673452949810, 5155645649151970014, 10690915733643810159, 130884637167788798},
+	{3514156524020449525, 15192562963547306981, 15931430216398868773, 104707709734231038},
+	{13879371663442090590, 8464701556095935261, 1677097728893364049, 83766167787384831},
+	{14828297032023524297, 13543522489753496418, 13751402810455113448, 134025868459815729},
+	{11862637625618819437, 3456120362318976488, 14690471063106001082, 107220694767852583},
+	{16868807729978876196, 2764896289855181190, 684330406259069896, 85776555814282067},
+	{4853999479514739975, 734485249026379582, 4784277464756422157, 137242489302851307},
+	{193850768869881657, 4276937013963013989, 14895468416030868695, 109793991442281045},
+	{3844429429837815649, 3421549611170411191, 11916374732824694956, 87835193153824836},
+	{2461738272998594714, 9163828192614568229, 11687501943035691283, 140536309046119738},
+	{1969390618398875772, 3641713739349744260, 16728699183912373673, 112429047236895790},
+	{8954210124202921264, 10292068620963616054, 13382959347129898938, 89943237789516632},
+	{3258689754498943052, 16467309793541785687, 6655339696440197008, 143909180463226612},
+	{13674998247824885411, 13173847834833428549, 16392318201377888576, 115127344370581289},
+	{14629347413001818652, 10539078267866742839, 16803203375844221184, 92101875496465031},
+	{15392826745143365245, 8431262614293394271, 9753213885933466624, 73681500397172025},
+	{6181778718519832776, 2421973738643699865, 15605142217493546599, 117890400635475240},
+	{8634771789557776544, 5626927805656870215, 12484113773994837279, 94312320508380192},
+	{3218468616904310912, 812193429783585849, 2608593389712049177, 75449856406704154},
+	{5149549787046897459, 12367555931879468328, 11552447053023099329, 120719770250726646},
+	{11498337459121338614, 9894044745503574662, 5552608827676569140, 96575816200581317},
+	{12888018782038981214, 536538166919039083, 15510133506366986282, 77260652960465053},
+	{16931481236520459620, 858461067070462533, 2680120721735716112, 123617044736744086},
+	{9855836174474457373, 8065466483140190673, 16901491836356214182, 98893635789395268},
+	{15263366569063386545, 6452373186512152538, 2453147024859240376, 79114908631516215},
+	{13353340066275687501, 2945099468935623415, 3925035239774784602, 126583853810425944},
+	{6993323238278639678, 17113474834116140025, 6829377006561738004, 101267083048340755},
+	{9284007405364822066, 17380128682034822343, 5463501605249390403, 81013666438672604},
+	{14854411848583715305, 13050810632288074456, 16120300197882845292, 129621866301876166},
+	{15572878293608882567, 17819346135314280211, 9206891343564365910, 103697493041500933},
+	{16147651449629016377, 3187430464025693199, 14744210704335313375, 82957994433200746},
+	{7389498245696874587, 16167935186666840089, 16212039497452680753, 132732791093121194},
+	{2222249781815589346, 5555650519849651425, 16658980412704054926, 106186232874496955},
+	{16535195084420112770, 755171601137810816, 13327184330163243941, 84948986299597564},
+	{630870431878808169, 1208274561820497307, 10255448484035459336, 135918378079356103},
+	{15262091604470687828, 4655968464198308168, 15583056416712188115, 108734702463484882},
+	{12209673283576550263, 14792821215584377504, 5087747503885929845, 86987761970787906},
+	{1088733180012928804, 16289816315451183361, 761698376733667106, 139180419153260650},
+	{11939032988236074013, 9342504237619036365, 609358701386933685, 111344335322608520},
+	{9551226390588859211, 7474003390095229092, 487486961109546948, 89075468258086816},
+	{7903264595458354090, 890358979926635578, 11848025582001006087, 142520749212938905},
+	{6322611676366683272, 11780333628167039432, 9478420465600804869, 114016599370351124},
+	{5058089341093346618, 16802964532017452192, 11272085187222554218, 91213279496280899},
+	{7735820287616587618, 6063673996130141107, 12707016964519953698, 72970623597024719},
+	{1309266015960809218, 13391227208550136095, 9263180699006194947, 116752997755239551},
+	{8426110442252468021, 18091679396323929522, 3721195744463045634, 93402398204191641},
+	{17808934798027705387, 14473343517059143617, 17734351854538077800, 74721918563353312},
+	{17426249232618597649, 8399954368326988495, 13617567708293283188, 119555069701365300},
+	{2872952941869147149, 14098661124145411443, 10894054166634626550, 95644055761092240},
+	{9677059982979138366, 11278928899316329154, 8715243333307701240, 76515244608873792},
+	{8104598343282800739, 3288890979938485354, 17633738148034232308, 122424391374198067},
+	{10173027489368150914, 2631112783950788283, 6728292888943565200, 97939513099358454},
+	{759724362010700085, 5794239041902540950, 9071983125896762483, 78351610479486763},
+	{12283605423442851106, 1892084837560244873, 10825824186692909650, 125362576767178821},
+	{13516233153496191208, 16271063129015837191, 4971310534612417396, 100290061413743057},
+	{14502335337538863290, 1948804058986938783, 15045094871915664887, 80232049130994445},
+	{4756992466352629647, 6807435309121012377, 5625407721355512203, 128371278609591113},
+	{11184291602565924364, 1756599432554899578, 11879023806568230409, 102697022887672890},
+	{1568735652568918845, 5094628360785829986, 9503219045254584327, 82157618310138312},
+	{2509977044110270152, 15530103006741148624, 447755213439693630, 131452189296221300},
+	{5697330450030126445, 12424082405392918899, 358204170751754904, 105161751436977040},
+	{11936561989507921802, 13628614739056245442, 286563336601403923, 84129401149581632},
+	{4341103924245033591, 3359039508780441092, 4147850153304156601, 134607041839330611},
+	{7162231954137937196, 13755278051250083843, 18075675381610966573, 107685633471464488},
+	{9419134378052260080, 7314873626258156751, 3392493861063042289, 86148506777171591},
+	{7691917375399795481, 11703797802013050802, 16496036621926598632, 137837610843474545},
+	{9842882715061746708, 1984340612126619995, 13196829297541278906, 110270088674779636},
+	{495608542565576720, 12655518933927026966, 6868114623291112801, 88216070939823709},
+	{11861020112330653722, 1802086220573691529, 18367681026749601129, 141145713503717934},
+	{2110118460380702331, 8820366605942773870, 18383493636141591226, 112916570802974347},
+	{9066792397788382511, 14434990914238039742, 7328097279429452334, 90333256642379478},
+	{10942782732972616332, 4169295101906611147, 13241175453027382514, 72266605313903582},
+	{13819103558014275808, 17738918607276308805, 6428485465876170729, 115626568502245732},
+	{7365934031669510324, 10501786071079136721, 16210834816926667553, 92501254801796585},
+	{9582096040077518582, 15780126486347130023, 12968667853541334042, 74001003841437268},
+	{11642004849382119408, 6801458304445856421, 17060519750924224145, 118401606146299629},
+	{9313603879505695526, 9130515458298595460, 17337764615481289639, 94721284917039703},
+	{72185474120735775, 18372458810864607338, 2802165248159300741, 75777027933631763},
+	{3804845573335087563, 18327887653157640771, 794115582312970863, 121243244693810821},
+	{3043876458668070050, 18351658937268022940, 15392687724818017983, 96994595755048656},
+	{6124449981676366363, 18370675964556328675, 8624801365112504063, 77595676604038925},
+	{6109771155940275858, 7256988654838663941, 13799682184180006502, 124153082566462280},
+	{12266514554236041333, 16873637368096662122, 11039745747344005201, 99322466053169824},
+	{2434514013905012420, 13498909894477329698, 12521145412617114484, 79457972842535859},
+	{14963268866473750841, 17908907016421817193, 8965786215961652205, 127132756548057375},
+	{902568648953269704, 14327125613137453755, 7172628972769321764, 101706205238445900},
+	{4411403733904526086, 15151049305251873327, 5738103178215457411, 81364964190756720},
+	{3368897159505331414, 16862981258919176677, 9180965085144731858, 130183942705210752},
+	{13763164171829996101, 13490385007135341341, 18412818512341516456, 104147154164168601},
+	{18389228966947817527, 3413610376224452426, 11040905995131302842, 83317723331334881},
+	{18354719902890777074, 1772427787217213559, 10286751962726263901, 133308357330135810},
+	{14683775922312621659, 16175337488741412140, 8229401570181011120, 106646685864108648},
+	{678974293624366358, 1872223546767398743, 13962218885628629543, 85317348691286918},
+	{8465056499282806819, 14063604119053568958, 203457328554345329, 136507757906059070},
+	{17840091643651976425, 14940232109984765489, 162765862843476263, 109206206324847256},
+	{3204026870695850170, 15641534502729722715, 14887607949242422303, 87364965059877804},
+	{8815791807855270595, 10269059945399915051, 12752126274562144716, 139783944095804487},
+	{10741982261026126800, 15593945585803752687, 2823003390165895126, 111827155276643590},
+	{15972283438304722086, 8785807653901091826, 2258402712132716101, 89461724221314872},
+	{14487607057061824368, 10367943431499836599, 7302793154154256085, 143138758754103795},
+	{15279434460391369818, 8294354745199869279, 5842234523323404868, 114511007003283036},
+	{1155501124087364885, 10324832610901805747, 984438803916813571, 91608805602626429},
+	{11992447343495622877, 8259866088721444597, 4476899857875361180, 73287044482101143},
+	{741171675883444988, 9526436927212401033, 3473690957858667565, 117259271171361829},
+	{11660983784932486960, 11310498356511831149, 6468301581028844375, 93807416937089463},
+	{1950089398462168922, 16427096314693285566, 12553338894306896146, 75045933549671570},
+	{6809491852281380597, 457912400315884643, 1638598157181482219, 120073493679474513},
+	{5447593481825104478, 11434376364478438684, 8689576155229006421, 96058794943579610},
+	{4358074785460083582, 5458152276840840624, 6951660924183205137, 76847035954863688},
+	{14351617286219954378, 8733043642945344998, 7433308663951217896, 122955257527781901},
+	{11481293828975963503, 18054481358582006968, 2257298116419063993, 98364206022225521},
+	{1806337433696950156, 18132933901607515898, 16563233752102892487, 78691364817780416},
+	{13958186338140851219, 6876601354120563497, 675732300171255718, 125906183708448667},
+	{3787851441028860329, 5501281083296450798, 11608632284362735544, 100724946966758933},
+	{3030281152823088263, 15469071310862891608, 16665603456974009081, 80579957573407146},
+	{4848449844516941220, 9993118838412985280, 839523827965042268, 128927932117451435},
+	{11257457505097373623, 15373192700214208870, 671619062372033814, 103142345693961148},
+	{1627268374594078252, 4919856530687546450, 7915992879381447698, 82513876555168918},
+	{13671675843576256172, 493072819616253673, 8976239792268405994, 132022202488270269},
+	{7247991860119094615, 7773155885176823585, 10870340648556635118, 105617761990616215},
+	{13177091117579096338, 13597222337625279514, 8696272518845308094, 84494209592492972},
+	{2636601714417002525, 14376858110716626577, 17603384844894403274, 135190735347988755},
+	{16866676630501243313, 15190835303315211584, 14082707875915522619, 108152588278391004},
+	{6114643674917174004, 1084621798426438298, 14955515115474328419, 86522070622712803},
+	{9783429879867478406, 5424743692224211600, 1792731296307463531, 138435312996340486},
+	{448046274410162079, 15407841398005100250, 16191580296013612117, 110748250397072388},
+	{358437019528129663, 12326273118404080200, 1885217792585158724, 88598600317657911},
+	{573499231245007461, 1275292915736976704, 14084394912361984929, 141757760508252657},
+	{458799384996005969, 15777629591557222656, 3888818300405767296, 113406208406602126},
+	{7745737137480625421, 5243406043761957478, 17868449899292255130, 90724966725281700},
+	{13575287339468320984, 4194724835009565982, 14294759919433804104, 72579973380225360},
+	{14341762113665492927, 14090257365499126218, 4424871797384534950, 116127957408360577},
+	{11473409690932394342, 3893508262915480328, 14607943882133358930, 92902365926688461},
+	{12868076567487825797, 17872201869300025555, 7997006290964776820, 74321892741350769},
+	{9520876063754790305, 17527476546654309919, 1727163621317911943, 118915028386161231},
+	{14995398480487652890, 17711330052065358258, 16139126156021970847, 95132022708928984},
+	{15685667599132032636, 10479715226910376283, 16600649739559487001, 76105618167143187},
+	{17718370529127431570, 13078195548314691730, 11803644324327537909, 121768989067429100},
+	{17864045238043855579, 14151905253393663707, 9442915459462030327, 97415191253943280},
+	{17980585005176994787, 3942826573231110319, 7554332367569624262, 77932153003154624},
+	{10322191934573640043, 17376568961395507481, 1018885343885667849, 124691444805047399},
+	{11947102362400822357, 2833208724890675015, 4504457089850444603, 99753155844037919},
+	{2178984260436837240, 13334613424138270982, 7292914486622266005, 79802524675230335},
+	{7175723631440849906, 2888637404911681955, 11668663178595625609, 127684039480368536},
+	{5740578905152679925, 2310909923929345564, 5645581728134590164, 102147231584294829},
+	{15660509568347874910, 9227425568627297097, 8205814197249582454, 81717785267435863},
+	{13988768865130868886, 6485650836034063, 9439953900857421604, 130748456427897381},
+	{122968647878964139, 5188520668827251, 3862614305944026960, 104598765142317905},
+	{14855770177270812604, 4150816535061800, 3090091444755221568, 83679012113854324},
+	{9011837024665658874, 3695990121198009204, 12322843941092175155, 133886419382166918},
+	{18277516063958258069, 10335489726442228009, 17236972782357560770, 107109135505733534},
+	{3553966406940875485, 11957740595895692731, 17478927040627958939, 85687308404586827},
+	{9375695065847311100, 11753687323949287723, 13208888006037093010, 137099693447338924},
+	{121858423194028233, 13092298673901340502, 14256459219571584731, 109679754757871139},
+	{11165533182780953556, 10473838939121072401, 15094516190399178108, 87743803806296911},
+	{17864853092449525690, 5690095858367984872, 16772528275154864327, 140390086090075058},
+	{6913184844475799906, 4552076686694387898, 2349976175898160492, 112312068872060047},
+	{16598594319806370894, 7331010164097420641, 12948027384944259363, 89849655097648037},
+	{732309208496821168, 11729616262555873027, 5959448556943173688, 143759448156236860},
+	{585847366797456934, 16762390639528519068, 4767558845554538950, 115007558524989488},
+	{15226073152405606840, 2341866067397084284, 11192744705927451807, 92006046819991590},
+	{8491509707182575149, 12941539298143398397, 8954195764741961445, 73604837455993272},
+	{2518369087266389269, 5949067618061796143, 18016062038329048636, 117767739929589235},
+	{5704044084555021738, 1069905279707526591, 14412849630663238909, 94214191943671388},
+	{11941932897127838037, 11923970667991752242, 462233260304860157, 75371353554937111},
+	{660348561694989243, 15389004254044893265, 11807619660713507221, 120594165687899377},
+	{7906976478839812041, 1243156959010183642, 2067398099086985131, 96475332550319502},
+	{6325581183071849633, 8373223196691967560, 12721964923495319074, 77180266040255601},
+	{10120929892914959413, 13397157114707148096, 12976446248108689872, 123488425664408962},
+	{8096743914331967530, 14407074506507628800, 3002459369003131251, 98790740531527170},
+	{2788046316723663701, 7836310790464192717, 2401967495202505001, 79032592425221736},
+	{11839571736241682568, 16227446079484618670, 14911194436549738971, 126452147880354777},
+	{16850355018477166701, 1913910419361963966, 4550257919755970531, 101161718304283822},
+	{17169632829523643684, 8909825964973391819, 14708252780030507394, 80929374643427057},
+	{9024668453528278278, 6877023914473606265, 8775809189081170538, 129486999429483292},
+	{7219734762822622622, 5501619131578885012, 18088693795490667400, 103589599543586633},
+	{5775787810258098098, 11779992934746928656, 3402908592166802950, 82871679634869307},
+	{5551911681671046633, 4090593436627444557, 9134002562208795044, 132594687415790891},
+	{15509575789562568276, 3272474749301955645, 3617853235025125712, 106075749932632713},
+	{12407660631650054621, 2617979799441564516, 10272980217503921216, 84860599946106170},
+	{5094861751672446101, 15256814123332234196, 16436768348006273945, 135776959913769872},
+	{11454587030821777527, 4826753669181966710, 5770717048921198510, 108621567931015898},
+	{16542367254141242668, 11240100564829394014, 11995271268620779454, 86897254344812718},
+	{642345903432616006, 3226765644759389131, 15503085215051336804, 139035606951700349},
+	{4203225537488003128, 9960110145291331951, 16091816986782979766, 111228485561360279},
+	{18119975688958043796, 7968088116233065560, 16562802404168294136, 88982788449088223},
+	{17923914658107139103, 1680894541747173927, 4364390958217808679, 142372461518541158},
+	{18028480541227621606, 12412762077623470111, 10870210396058067589, 113897969214832926},
+	{10733435618240186962, 9930209662098776089, 5006819502104543748, 91118375371866341},
+	{15965446124075970216, 11633516544420931194, 316106786941724675, 72894700297493073},
+	{10787318539553911052, 14924277656331579588, 15263166118074400773, 116631520475988916},
+	{1251157202159308195, 15628770939807173994, 8521184079717610295, 93305216380791133},
+	{12068972205953177526, 1434970307620008225, 14195644893257908883, 74644173104632906},
+	{8242309085299353072, 9674650121675833807, 15334334199728833566, 119430676967412650},
+	{13972544897723303104, 4050371282598756722, 12267467359783066853, 95544541573930120},
+	{11178035918178642483, 10618994655562826024, 9813973887826453482, 76435633259144096},
+	{3127462210118186680, 13301042634158611316, 8323660591038504925, 122297013214630554},
+	{2501969768094549344, 14330182922068799376, 10348277287572714263, 97837610571704443},
+	{13069622258701370445, 7774797522913129177, 15657319459541992057, 78270088457363554},
+	{17222046799180282388, 5060978407177186037, 13983664691041456322, 125232141531781687},
+	{10088288624602315588, 7738131540483659153, 3808234123349344411, 100185713225425350},
+	{11759979714423762793, 2501156417645016999, 3046587298679475529, 80148570580340280},
+	{15126618728336110146, 11380547897715847845, 4874539677887160846, 128237712928544448},
+	{15790643797410798440, 12793787132914588599, 11278329371793549323, 102590170342835558},
+	{12632515037928638752, 6545680891589760556, 16401361126918660105, 82072136274268446},
+	{5454628801718180710, 3094391797059796244, 416736099876483906, 131315418038829515},
+	{4363703041374544568, 17232908696615478288, 333388879901187124, 105052334431063612},
+	{7180311247841545978, 10096978142550472307, 11334757548146680669, 84041867544850889},
+	{11488497996546473565, 12465816213338845368, 7067565632808958101, 134466988071761423},
+	{1812100767753358205, 13662001785412986618, 13032750135730987127, 107573590457409138},
+	{8828378243686507211, 10929601428330389294, 17804897738068610348, 86058872365927310},
+	{17814754004640321860, 13798013470586712547, 10041092307200224941, 137694195785483697},
+	{14251803203712257488, 18417108405953190684, 654176216276359306, 110155356628386958},
+	{333396118744075021, 18423035539504462871, 7902038602504908091, 88124285302709566},
+	{15290829048958161326, 14719461604239499300, 5264564134524032300, 140998856484335306},
+	{8543314424424618738, 8086220468649689117, 522302492877315517, 112799085187468245},
+	{10524000354281605314, 17537022819145482263, 417841994301852413, 90239268149974596},
+	{1040502653941463605, 17718967070058296134, 15091668854409123223, 72191414519979676},
+	{16422199505273983060, 17282300867867542844, 16767972537570776511, 115506263231967482},
+	{5759061974735365802, 2757794250068303306, 6035680400572800563, 92405010585573986},
+	{4607249579788292641, 5895584214796552968, 1139195505716330127, 73924008468459189},
+	{14750296957145088872, 2054237114190664102, 9201410438629948850, 118278413549534702},
+	{15489586380457981421, 12711436135578262251, 18429174795129690049, 94622730839627761},
+	{8702320289624474814, 10169148908462609801, 11053991021361841716, 75698184671702209},
+	{6545014833915339055, 16270638253540175682, 6618339189953215776, 121117095474723535},
+	{12614709496616091891, 9327161788090230222, 5294671351962572621, 96893676379778828},
+	{6402418782550963190, 11151078245214094501, 11614434711053878743, 77514941103823062},
+	{2865172422597720457, 17841725192342551202, 3825700278718564696, 124023905766116900},
+	{9670835567561997012, 10584031339132130638, 3060560222974851757, 99219124612893520},
+	{7736668454049597609, 1088527441821883864, 2448448178379881406, 79375299690314816},
+	{4999971896995535528, 5430992721656924506, 14985563529633541219, 127000479504503705},
+	{3999977517596428423, 8034142992067449928, 11988450823706832975, 101600383603602964},
+	{14268028458302873708, 10116663208395870265, 13280109473707376703, 81280306882882371},
+	{11760799089058866963, 5118614689207661455, 13869477528447982079, 130048491012611794},
+	{16787336900730914217, 11473589380849949810, 14784930837500295986, 104038792810089435},
+	{9740520705842821050, 5489522689938049525, 11827944670000236789, 83231034248071548},
+	{827437870380872387, 12472585118642789564, 15235362657258468539, 133169654796914477},
+	{661950296304697910, 6288719280172321328, 4809592496322954185, 106535723837531582},
+	{529560237043758328, 16099021868363588032, 14915720441284094317, 85228579070025265},
+	{8225994008753833971, 11001039730414099558, 5418408632344999292, 136365726512040425},
+	{6580795207003067177, 1422134154847459000, 4334726905875999434, 109092581209632340},
+	{8953984980344364065, 4827056138619877523, 3467781524700799547, 87274064967705872},
+	{18015724783292892827, 15101987451275624683, 9237799254263189598, 139638503948329395},
+	{10723231011892403938, 1013543516794768777, 7390239403410551679, 111710803158663516},
+	{1199887180030102504, 810834813435815022, 2222842707986531020, 89368642526930813},
+	{1919819488048164007, 16054730960464945328, 18313943591746090924, 142989828043089300},
+	{12603902034664262175, 16533133583113866585, 14651154873396872739, 114391862434471440},
+	{13772470442473320063, 16915855681233003591, 11720923898717498191, 91513489947577152},
+	{14707325168720566374, 2464638100760671903, 1998041489490177907, 73210791958061722},
+	{8774325010985264905, 11322118590700895692, 6886215197926194974, 117137267132898755},
+	{3330111194046301601, 12747043687302626877, 5508972158340955979, 93709813706319004},
+	{2664088955237041281, 17576332579325922148, 8096526541414675106, 74967850965055203},
+	{7951891143121176372, 17054085682695744467, 9265093651521569847, 119948561544088325},
+	{10050861729238851421, 6264570916672774927, 7412074921217255878, 95958849235270660},
+	{8040689383391081137, 12390354362822040588, 5929659936973804702, 76767079388216528},
+	{9175754198683819496, 1377822906805713325, 5798107084416177201, 122827327021146445},
+	{3651254544205145274, 15859653584412211953, 4638485667532941760, 98261861616917156},
+	{6610352450106026542, 8998374052787859239, 21439719284443085, 78609489293533725},
+	{17955261549653463114, 14397398484460574782, 34303550855108936, 125775182869653960},
+	{3296162795497039521, 7828569972826549503, 27442840684087149, 100620146295723168},
+	{2636930236397631617, 17330902422486970572, 7400651902031090365, 80496117036578534},
+	{15287134822461941557, 16661397431753421945, 772996599024013615, 128793787258525655},
+	{12229707857969553246, 13329117945402737556, 618397279219210892, 103035029806820524},
+	{2405068656891821950, 6973945541580279722, 4184066638117279037, 82428023845456419},
+	{158761036285004797, 3779615237044626909, 14073204250471467106, 131884838152730270},
+	{127008829028003837, 17781087448603342820, 11258563400377173684, 105507870522184216},
+	{101607063222403070, 14224869958882674256, 5317501905559828624, 84406296417747373},
+	{14919966560123486205, 8002396675244637516, 4818654234153815476, 135050074268395797},
+	{15625322062840699287, 13780614969679530659, 14922969831548783350, 108040059414716637},
+	{8810908835530649107, 3645794346259803881, 4559678235755206034, 86432047531773310},
+	{14097454136849038570, 13211968583499506856, 7295485177208329654, 138291276050837296},
+	{7588614494737320533, 10569574866799605485, 2147039327024753400, 110633020840669837},
+	{17138938040015587396, 1076962263955863741, 12785677905845533690, 88506416672535869},
+	{8975556790315388217, 9101837251813202633, 9389038205127122934, 141610266676057391},
+	{14559143061736131220, 7281469801450562106, 3821881749359788024, 113288213340845913},
+	{579268005163174007, 16893222285386180655, 10436203028971651065, 90630570672676730},
+	{463414404130539206, 13514577828308944524, 8348962423177320852, 72504456538141384},
+	{741463046608862729, 14244626895810490592, 2290293432857982394, 116007130461026215},
+	{15350565696254731476, 15085050331390302796, 1832234746286385915, 92805704368820972},
+	{1212406112778054211, 4689342635628421591, 12533834241254839702, 74244563495056777},
+	{1939849780444886738, 14881645846489295192, 5296739527040102230, 118791301592090844},
+	{16309275083323550683, 15594665491933346476, 7926740436373992107, 95033041273672675},
+	{1979373622433109577, 5097034764062856535, 6341392349099193686, 76026433018938140},
+	{14235044240118706292, 776557993016749809, 10146227758558709898, 121642292830301024},
+	{7698686577353054711, 11689292838639130817, 11806331021588878241, 97313834264240819},
+	{17226995706108174738, 9351434270911304653, 13134413632013012916, 77851067411392655},
+	{16495146685547348611, 7583597203974266799, 2568317737511269050, 124561707858228249},
+	{2128070904212147920, 9756226577921323763, 5744003004750925563, 99649366286582599},
+	{1702456723369718336, 426283632853238364, 8284551218542650774, 79719493029266079},
+	{6413279572133459660, 15439449071532822675, 2187235505442510268, 127551188846825727},
+	{5130623657706767728, 12351559257226258140, 12817834848579739184, 102040951077460581},
+	{4104498926165414183, 9881247405781006512, 6564919064121881024, 81632760861968465},
+	{17635244726090393662, 4741949405023879449, 10503870502595009639, 130612417379149544},
+	{6729498151388494283, 11172257153502924206, 12092445216817918034, 104489933903319635},
+	{5383598521110795426, 12627154537544249688, 9673956173454334427, 83591947122655708},
+	{4924408819035362359, 1756703186361247885, 11788981062785024761, 133747115396249133},
+	{7628875869970200210, 5094711363830908631, 16809882479711840455, 106997692316999306},
+	{17171147140201891138, 386420276322816581, 9758557169027562041, 85598153853599445},
+	{12716440165355384528, 11686318886342237500, 15613691470444099265, 136957046165759112},
+	{2794454502800486976, 1970357479589969354, 5112255546871458766, 109565636932607290},
+	{2235563602240389581, 16333681242639616776, 4089804437497167012, 87652509546085832},
+	{3576901763584623329, 15065843543997655872, 10233035914737377543, 140244015273737331},
+	{17618916669835339956, 15742023649940035020, 4497079917047991711, 112195212218989865},
+	{10405784521126361642, 8904270105210117693, 3597663933638393369, 89756169775191892},
+	{5581208789576447657, 6868134538852367663, 9445611108563339714, 143609871640307027},
+	{8154315846403068449, 1805158816339983807, 177791257366851125, 114887897312245622},
+	{10212801491864365082, 12512173497297718015, 11210279450119211869, 91910317849796497},
+	{4480892378749581743, 6320389983096264089, 1589525930611548849, 73528254279837198},
+	{18237474250225061758, 13801972787695932865, 17300636747946119451, 117645206847739516},
+	{7211281770696228760, 3662880600672925646, 10151160583614985238, 94116165478191613},
+	{16837071860782713978, 17687699739505981809, 15499626096375808836, 75292932382553290},
+	{8492570903542790748, 2474877880016198633, 6352657680491742523, 120468691812085265},
+	{3104707908092322275, 9358599933496779553, 5082126144393394018, 96374953449668212},
+	{9862463955957678467, 7486879946797423642, 15133747359740446184, 77099962759734569},
+	{15779942329532285546, 8289659100133967504, 13145949331358982925, 123359940415575311},
+	{12623953863625828437, 2942378465365263680, 6827410650345276017, 98687952332460249},
+	{6409814276158752427, 17111298031259852237, 9151277335018131136, 78950361865968199},
+	{13945051656595914206, 8931332776306211963, 3573997291803278849, 126320578985549119},
+	{7466692510534821041, 14523763850528790217, 6548546648184533402, 101056463188439295},
+	{9662702823169767156, 4240313450939211527, 5238837318547626722, 80845170550751436},
+	{15460324517071627450, 3095152706760828120, 1003442080192382109, 129352272881202298},
+	{4989561984173481314, 13544168609634393466, 8181451293637726333, 103481818304961838},
+	{15059696031564516021, 7145986072965604449, 13923858664394001713, 82785454643969470},
+	{9338118391535584340, 7744228902003056796, 3831429789320851125, 132456727430351153},
+	{11159843527970377795, 13574080751086266083, 10443841460940501546, 105965381944280922},
+	{5238526007634391913, 18237962230352833513, 976375539268580590, 84772305555424738},
+	{1002943982731206414, 7044646680113071682, 16319596121797370238, 135635688888679580},
+	{802355186184965131, 13014414973574277992, 13055676897437896190, 108508551110943664},
+	{15399279407915613398, 14100880793601332716, 14133890332692227275, 86806840888754931},
+	{9881451793697340144, 15182711640278311700, 15235526902823742994, 138890945422007890},
+	{11594510249699782438, 15835518126964559683, 12188421522258994395, 111112756337606312},
+	{9275608199759825951, 5289716872087827100, 2372039588323374870, 88890205070085050},
+	{14840973119615721521, 8463546995340523360, 3795263341317399792, 142224328112136080},
+	{4494080866208756570, 17838884040498149658, 3036210673053919833, 113779462489708864},
+	{3595264692967005256, 6892409602914699080, 6118317353185046190, 91023569991767091},
+	{17633607013341245498, 1824578867589848940, 1205305067806126629, 72818855993413673},
+	{13456375962378351504, 6608675002885668628, 16685883367457443899, 116510169589461876},
+	{18143798399386501850, 5286940002308534902, 9659357879224044796, 93208135671569501},
+	{18204387534251111803, 15297598446072558891, 4038137488637325513, 74566508537255601},
+	{10680275981092227268, 13408111069490363257, 17529066426045451791, 119306413659608961},
+	{12233569599615692138, 3347791226108469959, 10333904326094451110, 95445130927687169},
+	{17165553309176374357, 6367581795628686290, 11956472275617471211, 76356104742149735},
+	{1639443591488826708, 2809433243522077419, 683611567278402322, 122169767587439577},
+	{8690252502674882013, 5936895409559572258, 11614935698048452827, 97735814069951661},
+	{3262853187397995287, 12128213957131478453, 5602599743696851938, 78188651255961329},
+	{5220565099836792460, 4647747072442724232, 16342857219398783748, 125101842009538126},
+	{487103265127523645, 7407546472696089709, 9384936960777116675, 100081473607630501},
+	{389682612102018916, 2236688363414961444, 3818600753879783017, 80065178886104401},
+	{4312840994105140588, 18336096640431579603, 17177807650433383796, 128104286217767041},
+	{3450272795284112471, 7290179682861443036, 10052897305604796714, 102483428974213633},
+	{10138915865711110623, 16900190190514885398, 15421015473967658017, 81986743179370906},
+	{8843567755653956350, 4904211416372354698, 17294927128864432182, 131178789086993450},
+	{7074854204523165080, 14991415577323614728, 13835941703091545745, 104943031269594760},
+	{13038580993102352711, 11993132461858891782, 11068753362473236596, 83954425015675808},
+	{13483031959479943690, 8120965494748495882, 14020656565215268231, 134327080025081293},
+	{7097076752842044629, 10186121210540707029, 148478807946483615, 107461664020065035},
+	{9367010217015546027, 8148896968432565623, 118783046357186892, 85969331216052028},
+	{11297867532482963319, 13038235149492104997, 14947448133139140320, 137550929945683244},
+	{5348945211244460332, 14119936934335594321, 15647307321253222579, 110040743956546595},
+	{4279156168995568266, 14985298362210385780, 12517845857002578063, 88032595165237276},
+	{14225347499876729871, 12908430935310886278, 12649855741720304255, 140852152264379642},
+	{11380277999901383897, 2948047118764888376, 2741186963892422758, 112681721811503714},
+	{16482920029404927764, 13426484139237641670, 5882298385855848529, 90145377449202971},
+	{13186336023523942212, 10741187311390113336, 1016489893942768500, 72116301959362377},
+	{17408788822896397215, 2428504439256540045, 5315732645050339924, 115386083134979803},
+	{6548333428833297126, 13010849995630963006, 11631283745524092585, 92308866507983842},
+	{12617364372550458347, 3029982367020949758, 1926329366935453422, 73847093206387074},
+	{9119736551855002385, 15916018231459250583, 10460824616580546121, 118155349130219318},
+	{18363835685709732878, 16422163399909310789, 15747357322748257543, 94524279304175454},
+	{11001719733825875979, 5759033090443627985, 16287234672940516358, 75619423443340363},
+	{10224053944637580920, 1835755315225984130, 3923482588253364234, 120991077509344582},
+	{4489894340968154413, 16225999511148428597, 14206832514828422356, 96792862007475665},
+	{10970613102258344177, 9291450794176832554, 11365466011862737885, 77434289605980532},
+	{10174283334129530036, 108926011715290794, 3427350360012739324, 123894863369568852},
+	{8139426667303624029, 14844536068339873928, 13809926732235922428, 99115890695655081},
+	{17579587778068630193, 15564977669413809465, 7358592571046827619, 79292712556524065},
+	{17059294000684077339, 13835917826836364175, 11773748113674924191, 126868340090438504},
+	{13647435200547261871, 11068734261469091340, 13108347305681849676, 101494672072350803},
+	{14607296975179719820, 12544336223917183395, 17865375474029300387, 81195737657880642},
+	{12303628716061820743, 9002891514041762463, 13827205499479239327, 129913180252609028},
+	{17221600602333277241, 7202313211233409970, 18440462029067212108, 103930544202087222},
+	{13777280481866621793, 5761850568986727976, 7373671993769949040, 83144435361669778},
+	{10975602326760863898, 5529612095636854439, 8108526375290008141, 133031096578671645},
+	{8780481861408691119, 734340861767573228, 6486821100232006513, 106424877262937316},
+	{18092431933352683865, 4276821504155968905, 1500108065443694887, 85139901810349853},
+	{10501147019654742567, 6842914406649550249, 17157568163677553112, 136223842896559764},
+	{8400917615723794054, 1784982710577729876, 17415403345683952813, 108979074317247811},
+	{6720734092579035243, 5117334983204094224, 10242973861805251927, 87183259453798249},
+	{10753174548126456389, 809038343642730112, 5320711734662672114, 139493215126077199},
+	{8602539638501165111, 8025928304398004736, 7945918202472048014, 111594572100861759},
+	{10571380525542842412, 13799440273002224435, 10046083376719548734, 89275657680689407},
+	{9535511211384727213, 14700406807319738450, 1316338143783636682, 142841052289103052},
+	{11317757783849692094, 15449674260597701083, 12121116959252640315, 114272841831282441},
+	{12743555041821663998, 8670390593736250543, 6007544752660201929, 91418273465025953},
+	{10194844033457331199, 18004358919214731404, 12184733431611982189, 73134618772020762},
+	{16311750453531729917, 2981532567550197984, 4738178231611530211, 117015390035233220},
+	{13049400362825383934, 17142621313007799680, 3790542585289224168, 93612312028186576},
+	{14128869105002217471, 17403445865148150067, 17789829327199020627, 74889849622549260},
+	{11538144123777816983, 13088118125269398815, 10016982849808881388, 119823759396078817},
+	{9230515299022253586, 10470494500215519052, 634888650363284464, 95859007516863054},
+	{7384412239217802869, 15755093229656235888, 4197259735032537894, 76687206013490443},
+	{11815059582748484591, 10450753908482336128, 3026266761310150308, 122699529621584709},
+	{9452047666198787673, 981905497302048256, 6110362223790030570, 98159623697267767},
+	{14940335762442850785, 11853570842067369574, 15956336223257755425, 78527698957814213},
+	{9147141960940919962, 15276364532565880996, 3394045068760946741, 125644318332502742},
+	{11007062383494646293, 1153045181826973827, 13783282499234488363, 100515454666002193},
+	{16184347536279537681, 15679831404429220354, 18405323628871411336, 80412363732801754},
+	{14826909613821529320, 6640986173377200951, 18380471361968527169, 128659781972482807},
+	{4482830061573402809, 1623440123959850438, 7325679460091001089, 102927825577986246},
+	{10964961678742542894, 1298752099167880350, 2171194753330890548, 82342260462388997},
+	{17543938685988068630, 2078003358668608560, 7163260420071335200, 131747616739822395},
+	{14035150948790454904, 1662402686934886848, 5730608336057068160, 105398093391857916},
+	{14917469573774274247, 16087317408515550771, 895137854103744204, 84318474713486333},
+	{1731858429587376855, 10982312594657239942, 16189615825533632020, 134909559541578132},
+	{5074835558411811807, 1407152446241971307, 5572995030943084970, 107927647633262506},
+	{11438566076213270092, 15883117215961218338, 769047210012557652, 86342118106610005},
+	{18301705721941232148, 10655592286570308048, 1230475536020092244, 138147388970576008},
+	{14641364577552985718, 1145776199772425792, 8363078058299894442, 110517911176460806},
+	{11713091662042388575, 8295318589301761280, 3001113631898005230, 88414328941168645},
+	{294202585558270103, 13272509742882818049, 4801781811036808368, 141462926305869832},
+	{3924710883188526406, 10618007794306254439, 14909471893055177664, 113170341044695865},
+	{10518466336034641771, 12183755050186913874, 11927577514444142131, 90536272835756692},
+	{1036075439343892771, 17125701669633351746, 2163364382071493058, 72429018268605354},
+	{16415115961917869725, 12643727412445721500, 10840080640798209540, 115886429229768566},
+	{9442743954792385457, 6425633115214666877, 4982715697896657309, 92709143383814853},
+	{11243543978575818689, 16208552936397464471, 11364870187801146493, 74167314707051882},
+	{10610972736237489256, 7486940624526391538, 3426397041514193097, 118667703531283012},
+	{1110080559506170758, 9678901314363023554, 13809164077437085447, 94934162825026409},
+	{888064447604936607, 4053772236748508520, 14736680076691578681, 75947330260021127},
+	{16178298375135539863, 2796686764055703308, 8821292863738884597, 121515728416033804},
+	{16631987514850342214, 16994744670212203939, 10746383105733018000, 97212582732827043},
+	{5926892382396453125, 2527749291944032182, 15975804114070235047, 77770066186261634},
+	{5793678997092414676, 15112445311336182461, 14493240138286645105, 124432105898018615},
+	{945594382932021418, 12089956249068945969, 11594592110629316084, 99545684718414892},
+	{756475506345617134, 5982616184513246452, 1896976059019632221, 79636547774731914},
+	{4899709624894897738, 9572185895221194323, 10413859323915232200, 127418476439571062},
+	{3919767699915918191, 279051086693134812, 952389829648365114, 101934781151656850},
+	{17893209418900375845, 3912589684096418172, 761911863718692091, 81547824921325480},
+	{6493042181789139413, 17328189938780000046, 1219058981949907345, 130476519874120768},
+	{8883782560173221854, 2794505506798269067, 8353944815043746523, 104381215899296614},
+	{10796374862880487806, 13303650849664346223, 10372504666776907541, 83504972719437291},
+	{17274199780608780490, 6528446100495312664, 9217309837359231420, 133607956351099666},
+	{13819359824487024392, 1533408065654339808, 3684499055145474813, 106886365080879733},
+	{14744836674331529837, 15984121711491113139, 10326296873600200496, 85509092064703786},
+	{16213041049446627092, 10817199479418139730, 9143377368276500148, 136814547303526058},
+	{9281084024815391351, 4964410768792601461, 14693399524105020765, 109451637842820846},
+	{46169590368492434, 282179800292170846, 8065370804542106289, 87561310274256677},
+	{3763220159331498218, 11519534124693204323, 16593942102009280385, 140098096438810683},
+	{17767971386432839867, 16594324929238384104, 2207107237381693338, 112078477151048547},
+	{17903725923888182217, 13275459943390707283, 12833732234131085640, 89662781720838837},
+	{10199217404511539931, 6483340650457490361, 5776576315642095732, 143460450753342140},
+	{15538071553093052591, 16254718964591723258, 4621261052513676585, 114768360602673712},
+	{12430457242474442073, 5625077542189557960, 14765055286236672238, 91814688482138969},
+	{2565668164495733012, 15568108477977377338, 15501393043731248113, 73451750785711175},
+	{15173115507418903789, 2772880676312341801, 6355484796260445366, 117522801257137881},
+	{1070445961709392061, 13286350985275604411, 1395039022266445969, 94018241005710305},
+	{856356769367513649, 14318429602962393852, 1116031217813156775, 75214592804568244},
+	{12438217275213752808, 11841440920514099193, 9164347577984871487, 120343348487309190},
+	{9950573820171002247, 2094455106927458708, 7331478062387897190, 96274678789847352},
+	{7960459056136801797, 12743610529767697936, 16933228894136048721, 77019743031877881},
+	{9047385675076972552, 5632381588660675405, 1267724527424305692, 123231588851004611},
+	{14616606169545398688, 11884602900412360970, 15771574880907085846, 98585271080803688},
+	{15382633750378229274, 13197031135071799099, 1549213460499937707, 78868216864642951},
+	{9854818741637525545, 17425901001372968236, 13546787981025631301, 126189146983428721},
+	{15262552622793841083, 6562023171614553942, 7148081570078594718, 100951317586742977},
+	{4831344468751252220, 5249618537291643154, 16786511700288606744, 80761054069394381},
+	{15108848779485824198, 8399389659666629046, 1032977017268398528, 129217686511031011},
+	{12087079023588659358, 10408860542475213560, 15583776872782360115, 103374149208824808},
+	{17048360848354748133, 15705786063463991494, 1398975054000157122, 82699319367059847},
+	{5141284468916135074, 14061211257316655422, 5927708901142161719, 132318910987295755},
+	{423678760390997736, 14938317820595234661, 4742167120913729375, 105855128789836604},
+	{338943008312798189, 15640003071218098052, 7483082511472893823, 84684103031869283},
+	{15299704072268118395, 17645307284465136236, 8283583203614719794, 135494564850990853},
+	{1171716813588763746, 6737548198088288343, 14005564192375596482, 108395651880792682},
+	{12005419895096741967, 9079387373212540997, 3825753724416656539, 86716521504634146},
+	{4451276573187145853, 14527019797140065596, 17189252403292381432, 138746434407414633},
+	{18318416517517357976, 11621615837712052476, 2683355478408174176, 110997147525931707},
+	{18344082028755796704, 16675990299653462627, 13214730826952270310, 88797718020745365},
+	{14593135987041633433, 8234840405735988588, 2696825249414080881, 142076348833192585},
+	{15363857604375217070, 2898523509846880547, 2157460199531264705, 113661079066554068},
+	{12291086083500173656, 9697516437361325084, 9104665789108832410, 90928863253243254},
+	{17211566496283959571, 11447361964630970390, 10973081446028976251, 72743090602594603},
+	{16470459949828604344, 7247732699183821655, 13867581498904451679, 116388944964151365},
+	{16865716774604793799, 9487534974088967647, 11094065199123561343, 93111155971321092},
+	{6113875790200014393, 7590027979271174118, 1496554529815028428, 74488924777056874},
+	{9782201264320023028, 15833393581575788912, 9773184877187866131, 119182279643290998},
+	{4136412196714108099, 16356063680002541453, 15197245531234113551, 95345823714632798},
+	{14377176201597017449, 16774199758743943485, 1089749980761559871, 76276658971706239},
+	{4556737848845676302, 8391975540280757961, 9122297598702316441, 122042654354729982},
+	{7334739093818451365, 14092278061708427015, 18365884523187584122, 97634123483783985},
+	{16935837719280492062, 3895124819882920965, 14692707618550067298, 78107298787027188},
+	{1271898647655415036, 17300246156038404515, 1372239301228645737, 124971678059243502},
+	{4706867732866242352, 17529545739572633935, 12165837885208647559, 99977342447394801},
+	{3765494186292993882, 14023636591658107148, 6043321493425007724, 79981873957915841},
+	{2335441883326879887, 3991074472943419821, 2290616759996191713, 127970998332665346},
+	{1868353506661503910, 6882208393096646180, 16589888666964594663, 102376798666132276},
+	{5184031620071113451, 9195115529219227267, 9582562118829765407, 81901438932905821},
+	{8294450592113781522, 11022836032008853304, 7953401760643804005, 131042302292649314},
+	{14014258103174845864, 12507617640348992966, 10052070223256953527, 104833841834119451},
+	{14900755297281787014, 17384791741763015019, 4352307363863652498, 83867073467295561},
+	{1705115587199397284, 16747620342595093062, 18031738226407574967, 134187317547672897},
+	{16121487728727159120, 17087445088817984772, 7046692951642239327, 107349854038138318},
+	{5518492553497906649, 13669956071054387818, 13016051990797612108, 85879883230510654},
+	{8829588085596650639, 7114534454719379216, 9757636741050448404, 137407813168817047},
+	{18131716912703051481, 2002278749033593049, 427411763356538077, 109926250535053638},
+	{18194722344904351508, 1601822999226874439, 7720627040169051108, 87941000428042910},
+	{14354160492879321120, 17320312057730640396, 12353003264270481772, 140705600684868656},
+	{15172677209045367219, 2788203201958781347, 6193053796674475095, 112564480547894925},
+	{4759444137752473129, 2230562561567025078, 4954443037339580076, 90051584438315940},
+	{14993808249887777652, 14636946542732971094, 7927108859743328121, 144082535101305504},
+	{15684395414652132445, 11709557234186376875, 10031035902536572820, 115266028081044403},
+	{1479469887495974987, 16746343416832922147, 15403526351513078902, 92212822464835522},
+	{15940971168964421282, 17086423548208248040, 4944123451726642475, 73770257971868418},
+	{3369460981891612112, 5202184788681734926, 4221248708020717638, 118032412754989469},
+	{10074266414997110336, 15229794275171118910, 7066347781158484433, 94425930203991575},
+	{15438110761481508915, 1115788975911164158, 5653078224926787547, 75540744163193260},
+	{6254233144660862648, 5474611176199772977, 9044925159882860075, 120865190661109216},
+	{12382084145212510765, 690340126217908058, 3546591313164377737, 96692152528887373},
+	{17284364945653829259, 552272100974326446, 10215970680015322836, 77353722023109898},
+	{9208239839336575197, 8262332991042742961, 12656204273282606214, 123765955236975837},
+	{18434638315694991128, 2920517578092284045, 2746265789142264325, 99012764189580670},
+	{14747710652555992902, 2336414062473827236, 2197012631313811460, 79210211351664536},
+	{8838941785121947350, 14806308944183854548, 14583266654327829305, 126736338162663257},
+	{7071153428097557880, 4466349525863262992, 4287915693978442798, 101389070530130606},
+	{1967573927736135981, 7262428435432520717, 18187727814150395531, 81111256424104484},
+	{6837467099119727893, 11619885496692033147, 18032318058414901880, 129778010278567175},
+	{16538020123521513284, 9295908397353626517, 14425854446731921504, 103822408222853740},
+	{9541067284075300304, 11126075532624811537, 11540683557385537203, 83057926578282992},
+	{508312395552839194, 17801720852199698460, 3707698432849218232, 132892682525252788},
+	{406649916442271355, 14241376681759758768, 10344856375763195232, 106314146020202230},
+	{325319933153817084, 4014403715923986368, 8275885100610556186, 85051316816161784},
+	{15277907152013748627, 6423045945478378188, 2173369716751158928, 136082106905858855},
+	{8532976906869088578, 12517134385866523197, 1738695773400927142, 108865685524687084},
+	{14205079154979091509, 6324358693951308234, 5080305433462652037, 87092548419749667},
+	{592033759515084475, 17497671539805913822, 11817837508282153582, 139348077471599467},
+	{15231022266579708873, 17687486046586641380, 2075572377141902219, 111478461977279574},
+	{1116771369038036129, 3081942393043582135, 5349806716455432099, 89182769581823659},
+	{16544229449428499099, 1241759014127821092, 15938388375812512005, 142692431330917854},
+	{9546034744800888956, 4682756026044167197, 16440059515391919927, 114153945064734283},
+	{258130166356890519, 3746204820835333758, 2084001168087804972, 91323156051787427},
+	{11274550577311243385, 6686312671410177329, 12735247378695974947, 73058524841429941},
+	{3281885664730348122, 7008751459514373404, 12997698176429739269, 116893639746287906},
+	{6314857346526188821, 5607001167611498723, 6708809726401881092, 93514911797030325},
+	{5051885877220951057, 15553647378314929948, 5367047781121504873, 74811929437624260},
+	{704319774069701044, 2749742916852425978, 8587276449794407798, 119699087100198816},
+	{11631502263481491805, 5889143148223851105, 3180472345093615915, 95759269680159053},
+	{16683899440269014091, 12090012148062901530, 9923075505558713378, 76607415744127242},
+	{8247495030720870929, 897275363191090833, 1119525549926300113, 122571865190603588},
+	{10287344839318607066, 15475215549520513959, 8274318069424860736, 98057492152482870},
+	{8229875871454885653, 8690823624874500844, 6619454455539888589, 78445993721986296},
+	{2099754950102086075, 13905317799799201351, 3212429499380001096, 125513589955178074},
+	{16437199219049310153, 11124254239839361080, 6259292414245911200, 100410871964142459},
+	{16839108189981358446, 12588752206613399187, 8696782746138639283, 80328697571313967},
+	{12185177845002532220, 1695259456871887084, 17604201208563733177, 128525916114102347},
+	{17126839905485846423, 5045556380239419990, 6704663337367165895, 102820732891281878},
+	{2633425480162946169, 11415142733675356639, 12742428299377553362, 82256586313025502},
+	{524131953518803546, 7196181929654839653, 5630490020036444087, 131610538100840804},
+	{4108654377556953160, 2067596728981961399, 8193740830771065593, 105288430480672643},
+	{3286923502045562528, 16411472642153210412, 13933690294100673120, 84230744384538114},
+	{16327124047498631015, 15190309783219405689, 11225858026335346023, 134769191015260983},
+	{13061699237998904812, 8462899011833614228, 16359384050552097465, 107815352812208786},
+	{14138708205141034173, 3080970394724981059, 9398158425699767649, 86252282249767029},
+	{7864537869258013383, 1240203816818059372, 3969007036893897269, 138003651599627247},
+	{13670327924890231353, 15749558312422088790, 14243252073740848784, 110402921279701797},
+	{7246913525170274759, 8910297835195760709, 4015904029508858381, 88322337023761438},
+	{7905712825530529291, 3188430092087486165, 2736097632472263087, 141315739238018301},
+	{13703267889908244080, 9929441703153809578, 16946273364945451762, 113052591390414640},
+	{10962614311926595264, 564855733039227016, 13557018691956361410, 90442073112331712},
+	{16148789079025096858, 11519931030657112582, 3466917324081268481, 72353658489865370},
+	{3701969637988693033, 11053192019567559486, 5547067718530029570, 115765853583784592},
+	{10340273339874775073, 1463855986170226942, 15505700619049754626, 92612682867027673},
+	{4582869857157909735, 4860433603678091877, 1336514051014072731, 74090146293622139},
+	{11021940586194565899, 7776693765884947003, 9517120111106337016, 118544234069795422},
+	{5128203654213742396, 13600052642191778249, 234998459401248966, 94835387255836338},
+	{11481260552854814563, 14569390928495332922, 7566696397004819819, 75868309804669070},
+	{10991319255083882655, 12242979041366801706, 12106714235207711711, 121389295687470512},
+	{12482404218809016447, 17173080862577262011, 2306673758682348722, 97111436549976410},
+	{17364621004531033804, 6359767060577988962, 1845339006945878978, 77689149239981128},
+	{5647300718798192147, 2796929667440961694, 17709937670081047658, 124302638783969804},
+	{828491760296643395, 13305590178178500325, 17857298950806748449, 99442111027175843},
+	{11730839852463045685, 3265774513058979613, 3217792716419667790, 79553688821740675},
+	{15079994949198962773, 5225239220894367381, 5148468346271468464, 127285902114785080},
+	{12063995959359170218, 7869540191457404228, 4118774677017174771, 101828721691828064},
+	{17029894396971156821, 6295632153165923382, 6984368556355650140, 81462977353462451},
+	{5111738146702388975, 2694313815581656766, 3796292060685219578, 130340763765539922},
+	{400041702620000857, 2155451052465325413, 14105080092773906632, 104272611012431937},
+	{11388079806321731655, 5413709656714170653, 3905366444735304659, 83418088809945550},
+	{3463532431147129355, 16040633080226493692, 6248586311576487454, 133468942095912880},
+	{17528221203885344777, 16521855278923105276, 4998869049261189963, 106775153676730304},
+	{2954530518882544852, 5838786593654663575, 7688444054150862294, 85420122941384243},
+	{8416597644953982086, 13031407364589372043, 8612161671899469347, 136672196706214789},
+	{10422626930705095992, 6735777076929587311, 10579078152261485801, 109337757364971831},
+	{15716799174047897440, 16456668105769400818, 4773913707067278317, 87470205891977465},
+	{6700134604767084288, 11573273710263400017, 7638261931307645308, 139952329427163944},
+	{9049456498555577754, 1879921338726899367, 9799958359788026570, 111961863541731155},
+	{18307611643070193173, 1503937070981519493, 7839966687830421256, 89569490833384924},
+	{7156085740460847137, 2406299313570431190, 1475900256302943040, 143311185333415879},
+	{9414217407110588033, 5614388265598255275, 4870069019784264755, 114648948266732703},
+	{14910071555172291073, 11870208241962424866, 11274752845311232450, 91719158613386162},
+	{860010799912101889, 2117468964086119247, 1641104646765165314, 73375326890708930},
+	{12444063724085093991, 10766647972021611441, 2625767434824264502, 117400523025134288},
+	{6265902164526164870, 8613318377617289153, 9479311577343232248, 93920418420107430},
+	{1323372916879021573, 14269352331577651969, 7583449261874585798, 75136334736085944},
+	{13185443111232165486, 8073568471556601857, 1065472374773606308, 120218135577737511},
+	{6859005674243822065, 10148203591987191809, 15609773158786526339, 96174508462190008},
+	{1797855724653147329, 739865244105932801, 1419772082803490102, 76939606769752007},
+	{2876569159445035726, 8562482020053313128, 5960984147227494486, 123103370831603211},
+	{2301255327556028581, 17918032060268381472, 1079438503040085265, 98482696665282569},
+	{16598399521012464158, 18023774462956615500, 4552899617173978535, 78786157332226055},
+	{8110695159910391036, 10391295067021033185, 7284639387478365657, 126057851731561688},
+	{6488556127928312829, 8313036053616826548, 13206409139466513172, 100846281385249350},
+	{5190844902342650263, 17718475287119192208, 10565127311573210537, 80677025108199480},
+	{8305351843748240421, 13592165200423066240, 16904203698517136860, 129083240173119168},
+	{14022979104482412983, 18252429789822273638, 2455316514587978518, 103266592138495335},
+	{7529034468844020064, 3533897387632087941, 1964253211670382815, 82613273710796268},
+	{978408705924701132, 1964887005469430383, 17900200397640253797, 132181237937274028},
+	{8161424594223581552, 1571909604375544306, 3252113873886472068, 105744990349819223},
+	{17597186119604596211, 16014922942468076737, 9980388728592998300, 84595992279855378},
+	{9708753717657802322, 3487783819497460841, 12279273151006886958, 135353587647768605},
+	{11456351788868152181, 10168924685081789319, 9823418520805509566, 108282870118214884},
+	{12854430245836432068, 8135139748065431455, 11548083631386317976, 86626296094571907},
+	{16877739578596380985, 9326874782162780005, 3719538551250467469, 138602073751315052},
+	{9812842848135194465, 3772151010988313681, 14043677285226104945, 110881659001052041},
+	{471576649024334926, 17775116067758292238, 7545593013438973632, 88705327200841633},
+	{15511917897406577174, 9993441634703715964, 8383600006760447489, 141928523521346613},
+	{8720185503183351416, 616055678279152125, 14085577634892178638, 113542818817077290},
+	{14354846032030501779, 7871542172107142346, 11268462107913742910, 90834255053661832},
+	{415830381398670454, 17365280181911444847, 1636072056847173681, 72667404042929466},
+	{8044026239721693372, 13027053032090670462, 13685761735181208860, 116267846468687145},
+	{17503267436003085668, 10421642425672536369, 10948609388144967088, 93014277174949716},
+	{2934567504576737565, 12026662755279939419, 5069538695774063347, 74411421739959773},
+	{12074005636806600749, 795916334738351454, 4421913098496591033, 119058274783935637},
+	{13348553324187190923, 636733067790681163, 14605576923023003796, 95246619827148509},
+	{18057540288833573385, 509386454232544930, 15373810353160313360, 76197295861718807},
+	{14134669203166076122, 4504367141513982212, 9840701306088860083, 121915673378750092},
+	{3929037733049040252, 3603493713211185770, 493863415387267420, 97532538703000074},
+	{6832579001181142525, 6572143785310858939, 4084439547051724259, 78026030962400059},
+	{14621475216631738362, 6826081241755463979, 13913800904766579461, 124841649539840094},
+	{15386528988047301013, 5460864993404371183, 14820389538555173892, 99873319631872075},
+	{12309223190437840811, 15436738438949227916, 11856311630844139113, 79898655705497660},
+	{8626710660474814327, 2562688613867302727, 523354535641070966, 127837849128796257},
+	{6901368528379851462, 9428848520577662828, 11486730072738587742, 102270279303037005},
+	{5521094822703881169, 164381186978309616, 9189384058190870194, 81816223442429604},
+	{16212449345810030517, 15020405158132936678, 3634968048879661340, 130905957507887367},
+	{12969959476648024414, 4637626497022528696, 13976020883329460042, 104724766006309893},
+	{6686618766576509208, 3710101197618022957, 112770262437837064, 83779812805047915},
+	{3319892397038594086, 13314859545672657378, 180432419900539302, 134047700488076664},
+	{6345262732372785592, 6962538821796215579, 3833694750662341765, 107238160390461331},
+	{5076210185898228474, 1880682242695062140, 17824351059497514705, 85790528312369064},
+	{15500633926920986204, 10387789217795920070, 17450915250970292558, 137264845299790503},
+	{8711158326794878640, 4620882559494825733, 2892685756550503077, 109811876239832403},
+	{14347624290919723559, 3696706047595860586, 9692846234724223108, 87849500991865922},
+	{15577501235987737047, 5914729676153376938, 751158716591115680, 140559201586985476},
+	{16151349803532099961, 1042434926180791227, 15358322232240533837, 112447361269588380},
+	{16610428657567590292, 11901994385170363951, 12286657785792427069, 89957889015670704},
+	{15508639407882413498, 15353842201530671999, 8590606013042152341, 143932622425073127},
+	{5028213896822110152, 1215027316998806630, 17940531254659452843, 115146097940058501},
+	{7711919932199598445, 4661370668340955627, 10663076188985651951, 92116878352046801},
+	{9858884760501589079, 14797142978898495471, 4841112136446611237, 73693502681637441},
+	{4706169172576811557, 1539335877786130815, 367081788830757334, 117909604290619906},
+	{3764935338061449245, 1231468702228904652, 15051060690032247160, 94327683432495924},
+	{17769343529416800689, 4674523776525034044, 15730197366767708051, 75462146745996739},
+	{17362903202841150133, 7479238042440054471, 14100269342602601912, 120739434793594783},
+	{10200973747531009783, 5983390433952043577, 212169029856350560, 96591547834875827},
+	{11850127812766718150, 15854758791387365831, 11237781668110811417, 77273238267900661},
+	{7892158056201018070, 3231521177768323391, 10601753039493477622, 123637181228641058},
+	{2624377630218904133, 2585216942214658713, 15860100061078602744, 98909744982912846},
+	{9478199733658943953, 2068173553771726970, 8998731234120971872, 79127795986330277},
+	{4097073129628579355, 10687775315518583799, 18087318789335465318, 126604473578128443},
+	{3277658503702863484, 4860871437672956716, 3401808587242641285, 101283578862502755},
+	{17379522061929932080, 3888697150138365372, 2721446869794113028, 81026863090002204},
+	{16739188854862160358, 9911264254963294919, 11733012621154401491, 129642980944003526},
+	{9702002269147817963, 550313774486815289, 5697061282181610870, 103714384755202821},
+	{7761601815318254371, 15197646278557093524, 868300211003378372, 82971507804162257},
+	{8729214089767296670, 13248187601465618669, 5078629152347315719, 132754412486659611},
+	{10672720086555747659, 10598550081172494935, 373554507135942252, 106203529989327689},
+	{4848827254502687804, 4789491250196085625, 3988192420450664125, 84962823991462151},
+	{379425977720479840, 284488370829916354, 17449154316946793570, 135940518386339441},
+	{303540782176383872, 14984985955631574376, 10269974638815524532, 108752414709071553},
+	{15000227884708748390, 11987988764505259500, 15594677340536240272, 87001931767257242},
+	{12932318171308266455, 8112735578982684231, 10194088485890343143, 139203090827611588},
+	{2967156907562792518, 2800839648444237062, 15533968418196095161, 111362472662089270},
+	{9752423155534054661, 16998066977723030942, 12427174734556876128, 89089978129671416},
+	{8225179419370666810, 16128860720131118538, 12504781945807181159, 142543965007474266},
+	{13958841164980354095, 12903088576104894830, 6314476741903834604, 114035172005979413},
+	{3788375302500462629, 2943773231400095218, 12430279023006888330, 91228137604783530},
+	{10409397871484190750, 2355018585120076174, 9944223218405510664, 72982510083826824},
+	{1897641335407063907, 78680921450211556, 4842710705223086093, 116772016134122919},
+	{8896810697809471772, 11130991181385900214, 7563517378920379197, 93417612907298335},
+	{3428099743505667095, 1526095315624899525, 6050813903136303358, 74734090325838668},
+	{16553006033834798321, 13509798949225570209, 5991953430276175049, 119574544521341869},
+	{5863707197584018010, 18186536788864276814, 8482911558962850362, 95659635617073495},
+	{1001616943325304085, 7170531801607600805, 6786329247170280290, 76527708493658796},
+	{12670633553546217505, 4094153253088340641, 3479429165988627818, 122444333589854074},
+	{17515204472320794651, 14343369046696403482, 6472892147532812577, 97955466871883259},
+	{2944117133630904751, 7785346422615212463, 8867662532768160385, 78364373497506607},
+	{4710587413809447602, 16145903090926250264, 17877608867170966939, 125382997596010571},
+	{7457818745789468405, 12916722472741000211, 10612738278994863228, 100306398076808457},
+	{2276906181889664401, 10333377978192800169, 1111492993712069936, 80245118461446766},
+	{14711096335249194010, 1776009506140838977, 12846435234165042868, 128392189538314825},
+	{11768877068199355208, 8799505234396491828, 10277148187332034294, 102713751630651860},
+	{2036404025075663520, 10728953002259103786, 8221718549865627435, 82171001304521488},
+	{10636944069604882279, 13476975988872655734, 9465400865043093573, 131473602087234381},
+	{15888252885167726469, 14470929605840034910, 3882971877292564535, 105178881669787505},
+	{12710602308134181176, 11576743684672027928, 3106377501834051628, 84143105335830004},
+	{1890219619305138265, 3765394636507603393, 12348901632418303252, 134628968537328006},
+	{16269570954411751905, 10391013338689903360, 6189772491192732278, 107703174829862405},
+	{1947610319303670554, 15691508300435743335, 4951817992954185822, 86162539863889924},
+	{14184222955111603856, 17727715651213368689, 15301606418210517962, 137860063782223878},
+	{15036727178831193408, 14182172520970694951, 1173238690342683400, 110288051025779103},
+	{15718730557806865050, 277691572550824991, 8317288581757967367, 88230440820623282},
+	{6703224818781432463, 7823004145565140633, 16997010545554658110, 141168705312997251},
+	{9051928669767056294, 2569054501710202183, 9908259621701816165, 112934964250397801},
+	{10930891750555555358, 16812638860335803039, 4237258882619542608, 90347971400318241},
+	{16123411029928264933, 17139459903010552754, 18147202365063275379, 72278377120254592},
+	{7350713574175672277, 1597694141623512145, 14278128525133599315, 115645403392407348},
+	{13259268488824358468, 8656852942782630362, 354456375881148482, 92516322713925879},
+	{17986112420543307421, 3236133539484193966, 3972913915446829109, 74013058171140703},
+	{6641686984417829934, 8867162477916620670, 2667313449973016251, 118420893073825125},
+	{1624000772792353624, 3404381167591386213, 2133850759978413001, 94736714459060100},
+	{4988549432975793223, 17480900193040750263, 1707080607982730400, 75789371567248080},
+	{4292330278019358833, 9522696235155648805, 2731328972772368641, 121262994507596928},
+	{7123213037157397389, 11307505802866429367, 9563760807701715559, 97010395606077542},
+	{13077268059209738558, 5356655827551233170, 272311016677551801, 77608316484862034},
+	{2476884821026030077, 8570649324081973073, 7814395256167903528, 124173306375779254},
+	{1981507856820824061, 17924565903491309428, 9940865019676233145, 99338645100623403},
+	{16342601544424300542, 3271606278567316572, 15331389645224807163, 79470916080498722},
+	{11390767212111239574, 5234570045707706516, 9772828173392050168, 127153465728797956},
+	{9112613769688991659, 7877004851308075536, 4128913723971729811, 101722772583038365},
+	{18358137459976924297, 2612255066304550105, 3303130979177383849, 81378218066430692},
+	{3547578232769706613, 15247654550313011139, 8974358381425724481, 130205148906289107},
+	{13906109030441496260, 1130077196024677941, 18247533149366310555, 104164119125031285},
+	{7435538409611286685, 904061756819742353, 14598026519493048444, 83331295300025028},
+	{11896861455378058695, 5135847625653498088, 1220749542737415571, 133330072480040046},
+	{9517489164302446956, 15176724544748529440, 15733994893157573749, 106664057984032036},
+	{7613991331441957565, 12141379635798823552, 8897847099784148676, 85331246387225629},
+	{15871734945049042427, 979463343568566067, 3168508915428906913, 136529994219561007},
+	{5318690326555413296, 783570674854852854, 13602853576568856500, 109223995375648805},
+	{7944301075986240960, 626856539883882283, 10882282861255085200, 87379196300519044},
+	{16400230536319895858, 8381668093298032299, 6343606133782405350, 139806714080830471},
+	{13120184429055916687, 3015985659896515516, 1385536092284013957, 111845371264664377},
+	{10496147543244733350, 6102137342659122736, 12176475318052942135, 89476297011731501},
+	{2036440810223932066, 2384722118770775732, 12103662879400886770, 143162075218770402},
+	{5318501462921055976, 12975824139242351555, 2304232674036888769, 114529660175016322},
+	{565452355594934458, 6691310496651970921, 12911432583455241985, 91623728140013057},
+	{7831059513959768213, 16421094841547307706, 2950448437280372941, 73298982512010446},
+	{8840346407593718817, 11516356487508051037, 15788763943874327676, 117278372019216713},
+	{3382928311333064731, 12902434004748351153, 1562964710873731171, 93822697615373371},
+	{2706342649066451785, 2943249574314860276, 16007767027666626230, 75058158092298696},
+	{8019497053248233178, 15777245763129507411, 18233729614782781321, 120093052947677914},
+	{2726248827856676219, 12621796610503605929, 18276332506568135380, 96074442358142331},
+	{2180999062285340976, 6408088473660974420, 10931717190512597981, 76859553886513865},
+	{14557644943882276530, 2874243928373738425, 17490747504820156770, 122975286218422184},
+	{15335464769847731548, 5988743957440901063, 17681946818598035739, 98380228974737747},
+	{15957720630620095561, 1101646351210810527, 6766859825394607945, 78704183179790198},
+	{7085608935282601282, 16520029420904938137, 7137626905889462388, 125926693087664317},
+	{16736533592451811995, 13216023536723950509, 16778147968937300880, 100741354470131453},
+	{6010529244477628950, 17951516458862981054, 2354471930924109734, 80593083576105163},
+	{2238149161680385673, 13965031075213128394, 77806274736665252, 128948933721768261},
+	{12858565773570039508, 103978415944771745, 14819640278756973495, 103159146977414608},
+	{17665550248339852253, 7461880362239638042, 787665778779847826, 82527317581931687},
+	{9818136323634211989, 8249659764841510545, 4949614060789666845, 132043708131090699},
+	{11543857873649279914, 10289076626615118759, 7649040063373643799, 105634966504872559},
+	{1856388669435603285, 15609958930775915654, 9808580865440825362, 84507973203898047},
+	{17727617130064606548, 13907887845015734076, 936334125737679287, 135212757126236876},
+	{17871442518793595562, 58263831786856291, 15506462559557784723, 108170205700989500},
+	{17986502829776786773, 7425308694913305679, 12405170047646227778, 86536164560791600},
+	{14021009268675217544, 8191145097119378764, 1401528002524412829, 138457863297266561},
+	{14906156229682084358, 6552916077695503011, 15878617660987171556, 110766290637813248},
+	{11924924983745667487, 8931681676898312732, 1634847684564006275, 88613032510250599},
+	{11701182344509247332, 3222644238811569402, 9994453924786230687, 141780852016400958},
+	{1982248246123577219, 2578115391049255522, 15374260769312805196, 113424681613120766},
+	{5275147411640772099, 13130538757065135387, 8610059800708333833, 90739745290496613},
+	{11598815558796438325, 6815082190910197986, 14266745470050487713, 72591796232397290},
+	{7490058449848570351, 7214782690714406455, 4380048678371228725, 116146873971835665},
+	{5992046759878856281, 5771826152571525164, 3504038942696982980, 92917499177468532},
+	{1104288593161174702, 15685507366282951101, 13871277598383317353, 74333999341974825},
+	{9145559378541700169, 2960718897601259822, 3747300083703756150, 118934398947159721},
+	{14695145132317180781, 17125970377048649150, 17755235325930646212, 95147519157727776},
+	{688069661628013656, 2632729857413188351, 10514839446002606647, 76118015326182221},
+	{8479609088088642495, 523018957119191038, 9445045484120349989, 121788824521891554},
+	{3094338455729003673, 7797112795179173477, 11245385202038190314, 97431059617513243},
+	{6164819579325113262, 17305736680369069751, 16375005791114372897, 77944847694010594},
+	{13553060141662091542, 1863736985397139339, 15131962821557265667, 124711756310416951},
+	{3463750483845852587, 8869687217801532118, 8416221442503902210, 99769405048333561},
+	{6460349201818592393, 3406400959499315371, 3043628339261211445, 79815524038666849},
+	{10336558722909747828, 12828939164682725240, 12248502972301758958, 127704838461866958},
+	{4579898163585887939, 6573802517004269869, 17177500007325227813, 102163870769493566},
+	{11042616160352530998, 8948390828345326218, 10052651191118271927, 81731096615594853},
+	{13978837041822139273, 14317425325352521949, 12394893091047324760, 130769754584951765},
+	{14872418448199621742, 11453940260282017559, 9915914472837859808, 104615803667961412},
+	{15587283573301607717, 9163152208225614047, 554033948786467200, 83692642934369130},
+	{10182258458314931054, 14661043533160982476, 886454318058347520, 133908228694990608},
+	{11835155581393855167, 660788382303055011, 8087861083930498663, 107126582955992486},
+	{9468124465115084133, 4217979520584354332, 2780940052402488607, 85701266364793989},
+	{11459650329442224290, 17816813677160697901, 11828201713327802417, 137122026183670382},
+	{9167720263553779432, 17942799756470468644, 2083863741178421287, 109697620946936306},
+	{18402222655068754515, 3286193360950643945, 16424486251910378323, 87758096757549044},
+	{14686160989142365931, 8947258192262940636, 15211131558830874347, 140412954812078471},
+	{15438277606055803068, 14536504183294173155, 8479556432322789154, 112330363849662777},
+	{8661273270102732132, 7939854531893428201, 17851691590083962293, 89864291079730221},
+	{13858037232164371410, 1635720806803754152, 2737264840940967407, 143782865727568355},
+	{14775778600473407451, 12376623089668734291, 2189811872752773925, 115026292582054684},
+	{11820622880378725961, 13590647286476897756, 5441198312944129463, 92021034065643747},
+	{5767149489561070446, 10872517829181518205, 15421005094581034540, 73616827252514997},
+	{12916787998039623036, 2638633267722787835, 9916212892362013972, 117786923604023996},
+	{17712128027915519076, 9489604243662050914, 4243621499147700854, 94229538883219197},
+	{14169702422332415261, 3902334580187730408, 14462943643543891653, 75383631106575357},
+	{535430987280402477, 6243735328300368654, 8383314570702585352, 120613809770520572},
+	{7807042419308142628, 8684337077382205246, 17774698100787799251, 96491047816416457},
+	{9934982750188424426, 14326167291389584843, 6841060851146418754, 77192838253133166},
+	{12206623585559568758, 4475123592513784133, 3566999732350449361, 123508541205013066},
+	{9765298868447655007, 14648145318236758276, 17610995044848000781, 98806832964010452},
+	{11501587909500034329, 650469810363675651, 6710098406394579979, 79045466371208362},
+	{3645145396232413633, 12108798140807612012, 14425506264973238289, 126472746193933379},
+	{2916116316985930906, 17065736142129910256, 15229753826720500954, 101178196955146703},
+	{9711590683072565372, 6273891284220107558, 1115756617150669794, 80942557564117363},
+	{15538545092916104594, 13727574869494082416, 16542605846408712963, 129508092102587780},
+	{16120184889074793999, 18360757525079086579, 13234084677126970370, 103606473682070224},
+	{1828101467034104229, 18377954834805179587, 14276616556443486619, 82885178945656179},
+	{2924962347254566767, 7268634847236825400, 11774540046083847622, 132616286313049887},
+	{6029318692545563737, 9504256692531370643, 2040934407383257451, 106093029050439910},
+	{8512803768778361313, 3914056539283186191, 1632747525906605961, 84874423240351928},
+	{13620486030045378100, 13641188092336918552, 17369791300418210830, 135799077184563084},
+	{7207040009294392157, 14602299288611445165, 17585181855076478987, 108639261747650467},
+	{9454980822177424049, 15371188245631066455, 6689447854577362543, 86911409398120374},
+	{370574056516237185, 9836505934042065036, 18081814196807600716, 139058255036992598},
+	{296459245212989748, 11558553561975562352, 3397404913220349603, 111246604029594079},
+	{3926516210912302122, 1868145220096629235, 6407272745318190006, 88997283223675263},
+	{13661123566943504041, 10367729981638427422, 6562287577767193686, 142395653157880421},
+	{14618247668296713556, 915486355826921291, 1560481247471844626, 113916522526304337},
+	{15383946949379281168, 8111086714145357679, 12316431442203206670, 91133218021043469},
+	{1239111115277693965, 10178218186058196467, 13542493968504475659, 72906574416834775},
+	{13050624228670041313, 5217102653467383377, 3221246275897609439, 116650519066935641},
+	{3061801753452212404, 4173682122773906702, 17334392279685728844, 93320415253548512},
+	{9828139032245590570, 18096340957186766654, 6488816194264762428, 74656332202838810},
+	{967627192625303619, 6818052643047364708, 10382105910823619886, 119450131524542096},
+	{774101754100242895, 16522488558663622736, 4616335913916985585, 95560105219633677},
+	{7997979032764014963, 5839293217447077542, 14761115175359319438, 76448084175706941},
+	{5418068822938603293, 16721566777399144714, 16239086651091090454, 122316934681131106},
+	{8023803873092792958, 13377253421919315771, 9301920506130962040, 97853547744904885},
+	{2729694283732324043, 10701802737535452617, 7441536404904769632, 78282838195923908},
+	{8056859668713628792, 17122884380056724187, 8217109433105721088, 125252541113478253},
+	{13824185364454723680, 10008958689303469026, 13952385175968397517, 100202032890782602},
+	{11059348291563778944, 11696515766184685544, 3783210511290897367, 80161626312626082},
+	{14005608451760135987, 7646378781669765901, 9742485632807346111, 128258602100201731},
+	{136440317182377820, 17185149469561543691, 4104639691503966565, 102606881680161385},
+	{14866547512713543549, 13748119575649234952, 3283711753203173252, 82085505344129108},
+	{9029080761374028386, 3550247247329224308, 1564589990383166881, 131336808550606573},
+	{18291311053324953678, 6529546612605289769, 8630369621790354151, 105069446840485258},
+	{3565002398434231973, 8912986104826142139, 14282993326916103967, 84055557472388206},
+	{9393352652236681480, 10571428952979917099, 15474091693581945701, 134488891955821130},
+	{7514682121789345184, 4767794347642023356, 12379273354865556561, 107591113564656904},
+	{2322396882689565824, 3814235478113618685, 13592767498634355572, 86072890851725523},
+	{3715835012303305318, 6102776764981789896, 18059079183073058592, 137716625362760837},
+	{2972668009842644255, 8571570226727342240, 7068565716974626227, 110173300290208670},
+	{13446180852099846374, 17925302625607604761, 5654852573579700981, 88138640232166936},
+	{17824540548617843874, 13923088942004526325, 1669066488243700924, 141021824371467098},
+	{6880934809410454453, 3759773524119800414, 8713950820078781386, 112817459497173678},
+	{12883445477012184209, 6697167634037750654, 14349858285546845755, 90253967597738942},
+	{6617407566867837044, 16425780551455931493, 4101188998953655957, 72203174078191154},
+	{17966549736472359917, 455807179136118126, 13940600027809670179, 115525078525105846},
+	{10683890974435977610, 364645743308894501, 7463131207505825820, 92420062820084677},
+	{15925810409032602735, 11359763038872846570, 17038551410230391625, 73936050256067741},
+	{18102599024968343729, 10796923232712733866, 1436240553175254338, 118297680409708387},
+	{10792730405232764660, 8637538586170187093, 12217038886765934440, 94638144327766709},
+	{1255486694702391082, 10599379683678059998, 13462979924154657875, 75710515462213367},
+	{2008778711523825730, 2201612234917254704, 6783372619679811308, 121136824739541388},
+	{1607022969219060584, 16518685046901445056, 12805395725227669692, 96909459791633110},
+	{8664316004859069114, 5836250408037335398, 10244316580182135754, 77527567833306488},
+	{13862905607774510582, 13027349467601646960, 12701557713549506883, 124044108533290381},
+	{14779673300961518789, 14111228388823227891, 6471897356097695183, 99235286826632305},
+	{15513087455511125354, 220936266832851343, 5177517884878156147, 79388229461305844},
+	{13752893484592069597, 11421544471158293119, 15662726245288870481, 127021167138089350},
+	{11002314787673655678, 5447886762184724172, 12530180996231096385, 101616933710471480},
+	{1423154200655103896, 4358309409747779338, 10024144796984877108, 81293546968377184},
+	{2277046721048166233, 10662643870338357264, 4970585230950072403, 130069675149403495},
+	{12889683821064263956, 15908812725754506457, 3976468184760057922, 104055740119522796},
+	{10311747056851411165, 1659003736377874196, 17938569806775687631, 83244592095618236},
+	{12809446476220347541, 6343754792946509037, 2876269987647727947, 133191347352989179},
+	{17626254810460098679, 1385655019615296906, 5990364804860092681, 106553077882391343},
+	{14101003848368078943, 4797872830434147848, 12170989473371894791, 85242462305913074},
+	{425513268937464370, 7676596528694636558, 8405536713169300696, 136387939689460919},
+	{7719108244633792142, 6141277222955709246, 10413778185277350880, 109110351751568735},
+	{2485937780965123391, 4913021778364567397, 8331022548221880704, 87288281401254988},
+	{11356198079028018071, 11550183660125218158, 9640287262413098803, 139661250242007981},
+	{1706260833738593811, 12929495742842084850, 4022880995188568719, 111729000193606385},
+	{5054357481732785372, 14032945409015578203, 3218304796150854975, 89383200154885108},
+	{708274341288635948, 316619765973463186, 1459938859099457638, 143013120247816173},
+	{11634665917256639728, 15010691071746411841, 8546648716763386756, 114410496198252938},
+	{9307732733805311783, 15697901672139039796, 14216016602894530051, 91528396958602350},
+	{67488557560428780, 8868972522969321514, 11372813282315624041, 73222717566881880},
+	{107981692096686048, 6811658407267093776, 18196501251704998466, 117156348107011008},
+	{86385353677348838, 9138675540555585344, 3489154557138267803, 93725078485608807},
+	{3758457097683789394, 7310940432444468275, 13859370089936345212, 74980062788487045},
+	{9702880171035973353, 15386853506653059563, 3728248070188600723, 119968100461579273},
+	{11451652951570689006, 8620133990580537327, 10361296085634701225, 95974480369263418},
+	{9161322361256551205, 14274804821948250508, 15667734497991581626, 76779584295410734},
+	{10968766963268571604, 4392943641407649197, 14000328752560799633, 122847334872657175},
+	{8775013570614857283, 10893052542609940004, 11200263002048639706, 98277867898125740},
+	{7020010856491885827, 5025093219346041680, 8960210401638911765, 78622294318500592},
+	{14921366185128927646, 11729497965695577011, 18025685457364169147, 125795670909600947},
+	{11937092948103142117, 13072947187298371932, 7041850736407514671, 100636536727680758},
+	{5860325543740603370, 14147706564580607869, 13012178218609832383, 80509229382144606},
+	{5687172055243055069, 11568284059103241621, 13440787520291911167, 128814767011431370},
+	{11928435273678264702, 1875929617798772650, 10752630016233528934, 103051813609145096},
+	{9542748218942611761, 1500743694239018120, 4912755198244912824, 82441450887316077},
+	{7889699520824358172, 13469236355008159962, 11549757131933770841, 131906321419705723},
+	{2622410801917576214, 14464737898748438293, 16618503335030837319, 105525057135764578},
+	{2097928641534060971, 4193092689514929988, 2226756223798938886, 84420045708611663},
+	{7046034641196407877, 14087645932707708627, 18320205217045943510, 135072073133778660},
+	{16704874157182857271, 11270116746166166901, 14656164173636754808, 108057658507022928},
+	{5985201696262465171, 5326744582191023198, 656884894683672877, 86446126805618343},
+	{5886973899278033950, 8522791331505637117, 15808411090461517896, 138313802888989348},
+	{1020230304680516837, 10507581879946420017, 1578682428143483347, 110651042311191479},
+	{8194881873228234116, 4716716689215225690, 4952294757256697001, 88520833848953183},
+	{2043764552939443616, 14925444332228181751, 4234322796868804878, 141633334158325093},
+	{12703058086577285862, 8251006651040635077, 10766155866978864549, 113306667326660074},
+	{10162446469261828690, 13979502950316328708, 12302273508325001962, 90645333861328059},
+	{11819305990151373275, 7494253545511152643, 13531167621401911893, 72516267089062447},
+	{15221540769500286917, 11990805672817844229, 6892472935275417736, 116026027342499916},
+	{8487883800858319210, 2213946908770454737, 1824629533478423866, 92820821873999933},
+	{3100958225944745045, 5460506341758274113, 8838401256266559739, 74256657499199946},
+	{1272184346769681749, 8736810146813238581, 6762744380542674936, 118810651998719914},
+	{15775142736383386692, 6989448117450590864, 9099544319176050272, 95048521598975931},
+	{5241416559622888707, 12970256123444293338, 3590286640598929894, 76038817279180745},
+	{12075615310138532254, 9684363353285138371, 5744458624958287831, 121662107646689192},
+	{13349841062852736127, 15126188312111931343, 15663613344192361234, 97329686117351353},
+	{10679872850282188902, 4722253020205724428, 1462844231128158018, 77863748893881083},
+	{6019750116225771273, 176907202845338439, 17097946028772694122, 124581998230209732},
+	{12194497722464437665, 3830874577018181074, 6299659193534334651, 99665598584167786},
+	{6066249363229639809, 14132746105840275829, 1350378540085557397, 79732478867334229},
+	{9705998981167423693, 15233696139860620680, 9539303293620712482, 127571966187734766},
+	{15143496814417759601, 1118910467662765574, 3942093820154659663, 102057572950187813},
+	{12114797451534207681, 15652523633097853752, 10532372685607548376, 81646058360150250},
+	{15694327107712821966, 17665340183472745357, 16851796296972077402, 130633693376240400},
+	{16244810500912167896, 6753574517294375639, 13481437037577661922, 104506954700992320},
+	{9306499585987823994, 16470906058061231481, 10785149630062129537, 83605563760793856},
+	{3822352893354787420, 4217356804446508431, 9877541778615586614, 133768902017270170},
+	{3057882314683829936, 7063234258299117068, 7902033422892469291, 107015121613816136},
+	{2446305851747063949, 16718633850865024624, 2632277923572065109, 85612097291052909},
+	{7603438177537212642, 4613721272932577459, 11590342307199124822, 136979355665684654},
+	{6082750542029770113, 1628203604151644, 12961622660501210181, 109583484532547723},
+	{12244898063107636737, 3690651377625231638, 17747995757884788791, 87666787626038178},
+	{4834441642004577486, 13283739833684191268, 6260700324164200126, 140266860201661086},
+	{3867553313603661989, 3248294237463532368, 1319211444589449778, 112213488161328869},
+	{3094042650882929592, 13666681834196556864, 4744717970413470145, 89770790529063095},
+	{12329165870896507993, 3419946861004939366, 7591548752661552233, 143633264846500952},
+	{6173983881975296071, 2735957488803951493, 17141285446354972756, 114906611877200761},
+	{4939187105580236857, 13256812435268892164, 10023679542342067881, 91925289501760609},
+	{7640698499206099809, 10605449948215113731, 11708292448615564628, 73540231601408487},
+	{4846419969245939047, 16968719917144181970, 3975872658817262112, 117664370562253580},
+	{14945182419622482208, 6196278304231524929, 3180698127053809690, 94131496449802864},
+	{888099491472254797, 8646371458127130267, 6233907316384958075, 75305197159842291},
+	{16178354445323248967, 6455496703519587780, 2595554076732112274, 120488315455747666},
+	{12942683556258599174, 5164397362815670224, 16833838520353331112, 96390652364598132},
+	{17732844474490699985, 7820866704994446502, 6088373186798844243, 77112521891678506},
+	{2547109455991747714, 1445340283765383435, 2362699469394330143, 123380035026685610},
+	{9416385194277218818, 8534969856496127394, 1890159575515464114, 98704028021348488},
+	{3843759340679864731, 17896022329422632885, 8890825289896191937, 78963222417078790},
+	{9839363759829693893, 13876240468108571323, 14225320463833907100, 126341155867326064},
+	{492793378379934468, 14790341189228767382, 15069605185809036003, 101072924693860851},
+	{15151629961671588867, 15521621766124924228, 8366335333905318479, 80858339755088681},
+	{2106515050223080248, 6387850752090327150, 6007438904764688921, 129373343608141890},
+	{16442607299146105491, 1420931786930351396, 4805951123811751137, 103498674886513512},
+	{13154085839316884393, 4826094244286191440, 14912807343275131879, 82798939909210809},
+	{17357188528165104705, 4032401976115995981, 12792445305014480037, 132478303854737295},
+	{2817704378306352795, 14293968025118527755, 10233956244011584029, 105982643083789836},
+	{2254163502645082236, 11435174420094822204, 4497816180467356900, 84786114467031869},
+	{18364056863199772870, 7228232627925984556, 14575203518231591687, 135657783147250990},
+	{3623199046334087327, 16850632546566518615, 11660162814585273349, 108526226517800792},
+	{17655954496034911154, 9791157222511304568, 1949432622184398033, 86820981214240634},
+	{9802783119946306230, 908456297050446017, 10497789824978857500, 138913569942785014},
+	{4152877681215134661, 4416113852382267137, 12087580674724996323, 111130855954228011},
+	{18079697403939749022, 7222239896647724032, 5980715725038086735, 88904684763382409},
+	{3102074143110226172, 487537390410627483, 16947842789544759423, 142247495621411854},
+	{2481659314488180938, 11458076356554232956, 17247623046377717861, 113797996497129483},
+	{1985327451590544750, 12855809899985296688, 2730051992876443319, 91038397197703587},
+	{5277610776014346124, 6595299105246327027, 13252088038526885625, 72830717758162869},
+	{1065479612139133151, 17931176197877943890, 10135294417417286030, 116529148413060591},
+	{15609778948678947814, 10655592143560444788, 4418886719191918501, 93223318730448473},
+	{5109125529459337605, 12213822529590266154, 10913807004837355447, 74578654984358778},
+	{15553298476618760814, 1095371973634874230, 13772742392997858393, 119325847974974045},
+	{1374592337069277681, 8254995208391720031, 11018193914398286714, 95460678379979236},
+	{15857069128623063438, 6603996166713376024, 5125206316776719048, 76368542703983389},
+	{17992612976313080854, 14255742681483311962, 15579027736326571123, 122189668326373422},
+	{7015392751566644037, 11404594145186649570, 5084524559577436252, 97751734661098738},
+	{5612314201253315230, 9123675316149319656, 11446317277145769648, 78201387728878990},
+	{16358400351489125014, 10908531691097001126, 18314107643433231437, 125122220366206384},
+	{5708022651707479365, 5037476538135690578, 18340634929488495473, 100097776292965107},
+	{11945115750849804138, 4029981230508552462, 7293810314106975732, 80078221034372086},
+	{665441127650135005, 2758621154071773617, 4291398873087340525, 128125153654995338},
+	{532352902120108004, 9585594552741239540, 10811816727953693066, 102500122923996270},
+	{15183277580663727696, 3979126827451081308, 8649453382362954453, 82000098339197016},
+	{9535848870094323021, 13745300553405550740, 6460427782296906478, 131200157342715226},
+	{11318027910817368740, 14685589257466350915, 1478993411095614859, 104960125874172181},
+	{9054422328653894992, 11748471405973080732, 15940589987844133180, 83968100699337744},
+	{7108378096362411340, 7729507805331198202, 14436897536324882119, 134348961118940391},
+	{16754748921315660042, 6183606244264958561, 7860169214317995372, 107479168895152313},
+	{9714450322310617711, 4946884995411966849, 13666833000938216944, 85983335116121850},
+	{11853771700955078013, 15293713622142967605, 3420188727791595494, 137573336185794961},
+	{9483017360764062411, 12234970897714374084, 17493546241200917688, 110058668948635968},
+	{7586413888611249929, 6098627903429588944, 2926790548735003181, 88046935158908775},
+	{12138262221777999886, 2379107016003521664, 4682864877976005090, 140875096254254040},
+	{13399958592164310232, 1903285612802817331, 3746291902380804072, 112700077003403232},
+	{10719966873731448186, 5211977304984164188, 14065079966130374227, 90160061602722585},
+	{8575973498985158549, 15237628288213062320, 11252063972904299381, 72128049282178068},
+	{2653511154150522708, 13312158816915168743, 14313953541904968687, 115404878851484909},
+	{5812157738062328490, 6960378238790224671, 15140511648265885273, 92323903081187927},
+	{960377375707952469, 5568302591032179737, 4733711689128887572, 73859122464950342},
+	{12604650245358454919, 16287981775135308225, 11263287517348130438, 118174595943920547},
+	{10083720196286763935, 13030385420108246580, 1631932384394683704, 94539676755136438},
+	{688278527545590502, 3045610706602776618, 8684243536999567610, 75631741404109150},
+	{15858640903040586096, 4872977130564442588, 13894789659199308176, 121010786246574640},
+	{16376261537174379200, 209032889709643747, 11115831727359446541, 96808628997259712},
+	{13101009229739503360, 7545923941251535644, 1513967752403736586, 77446903197807770},
+	{2514870693873653759, 4694780676518636385, 2422348403845978538, 123915045116492432},
+	{2011896555098923008, 3755824541214909108, 13005925167302513800, 99132036093193945},
+	{8988214873562959053, 3004659632971927286, 10404740133842011040, 79305628874555156},
+	{18070492612442644807, 15875501856980814627, 9268886584663397017, 126889006199288250},
+	{18145742904696026169, 5321703856100831055, 7415109267730717614, 101511204959430600},
+	{18205943138498731259, 7946711899622575167, 5932087414184574091, 81208963967544480},
+	{6993416133146508074, 5336041409912299622, 9491339862695318546, 129934342348071168},
+	{1905384091775296136, 7958181942671750021, 14971769519640075483, 103947473878456934},
+	{8903004902904057555, 17434591998363130986, 15666764430453970709, 83157979102765547},
+	{17934156659388402412, 2069905494187637315, 10309427829758711843, 133052766564424876},
+	{18036674142252632253, 5345273210092020175, 4558193449065059151, 106442213251539901},
+	{7050641684318285156, 15344265012299347110, 18403950018219688613, 85153770601231920},
+	{7591677880167345926, 2414731131227493437, 10999575955441950166, 136246032961971073},
+	{2383993489391966418, 5621133719723905073, 16178358393837380779, 108996826369576858},
+	{1907194791513573134, 15564953420004855028, 1874640270844173653, 87197461095661487},
+	{17808906925389358307, 6457181398298216428, 6688773248092588169, 139515937753058379},
+	{10557776725569576323, 12544442748122393789, 9040367413215980858, 111612750202446703},
+	{8446221380455661058, 6346205383756004708, 14610991560056605333, 89290200161957362},
+	{9824605393987147370, 10153928614009607533, 8620191237122927240, 142864320259131780},
+	{15238381944673538542, 8123142891207686026, 6896152989698341792, 114291456207305424},
+	{4812007926255010187, 2809165498224238498, 9206271206500583757, 91433164965844339},
+	{7538955155745918473, 17004727657547032091, 11054365779942377328, 73146531972675471},
+	{12062328249193469557, 16139517807849520376, 10308287618423983079, 117034451156280754},
+	{13339211414096685969, 1843567802053885331, 11935978909481096787, 93627560925024603},
+	{6982020316535438452, 1474854241643108265, 16927480757068698076, 74902048740019682},
+	{7481883691714791200, 17117162045596614517, 12326573952342275628, 119843277984031492},
+	{17053553397597563929, 13693729636477291613, 2482561532389999856, 95874622387225194},
+	{2574796273852320174, 10954983709181833291, 5675398040653910208, 76699697909780155},
+	{11498371667647532924, 13838625119949022942, 9080636865046256333, 122719516655648248},
+	{16577394963601846986, 7381551281217308030, 14643207121520825713, 98175613324518598},
+	{9572567156139567266, 2215892210231936101, 646519252990929601, 78540490659614879},
+	{7937409820339486979, 3545427536371097762, 8413128434269308008, 125664785055383806},
+	{2660579041529679260, 6525690843838788533, 3041153932673536083, 100531828044307045},
+	{16885858492191384701, 12599250304554851472, 2432923146138828866, 80425462435445636},
+	{15949327143280484551, 5401405228320121063, 14960723478047857156, 128680739896713017},
+	{9070112899882477318, 11699821812139917497, 4589881152954465078, 102944591917370414},
+	{10945439134647892178, 1981159820228113351, 7361253737105482386, 82355673533896331},
+	{13823353800694717161, 6859204527106891685, 4399308349884951171, 131769077654234130},
+	{7369334225813863405, 1798014806943603025, 3519446679907960937, 105415262123387304},
+	{2206118565909180401, 16195807104522523713, 6504906158668279072, 84332209698709843},
+	{18287184964422329934, 7466547293526486324, 6718501039127336193, 134931535517935749},
+	{10940399156795953624, 17041284279046920029, 9064149646043779277, 107945228414348599},
+	{8752319325436762900, 9943678608495625700, 10940668531576933745, 86356182731478879},
+	{2935664476473089670, 4841839329367270151, 6437023206297363023, 138169892370366207},
+	{17105926840146113029, 3873471463493816120, 16217665009263621388, 110535913896292965},
+	{2616695027891159453, 10477474800278873543, 12974132007410897110, 88428731117034372},
+	{4186712044625855125, 2006564421478556376, 6001215952889794084, 141485969787254996},
+	{18106764894668325393, 1605251537182845100, 1111623947569924944, 113188775829803997},
+	{10796063100992749991, 16041596488713917373, 11957345602281670924, 90551020663843197},
+	{12326199295536110316, 9143928376229223575, 2187178852341516093, 72440816531074558},
+	{12343221243373955859, 7251587772482937074, 18256881422714067042, 115905306449719292},
+	{17253274624182985334, 9490619032728259982, 7226807508687432987, 92724245159775434},
+	{2734573255120657298, 3903146411440697663, 9470794821691856713, 74179396127820347},
+	{685968393451141353, 6245034258305116261, 395876455739329448, 118687033804512556},
+	{548774714760913082, 8685376221386003332, 15074096423559104851, 94949627043610044},
+	{11507066216034461436, 6948300977108802665, 15748625953589194204, 75959701634888035},
+	{7343259501429407327, 49235119148353295, 6751057452033159111, 121535522615820857},
+	{13253305230627346508, 7418085724802503282, 16468892405852258258, 97228418092656685},
+	{10602644184501877207, 13313166209325823272, 13175113924681806606, 77782734474125348},
+	{9585533065719182884, 10233019490695586266, 17390833464748980247, 124452375158600557},
+	{7668426452575346307, 11875764407298379336, 6533969142315363551, 99561900126880446},
+	{13513438791544097692, 2121913896354882822, 1537826499110380518, 79649520101504357},
+	{6864106807502915015, 3395062234167812516, 6149871213318519152, 127439232162406971},
+	{9180634260744242335, 10094747416818070659, 1230548155912904998, 101951385729925577},
+	{11033856223337304191, 8075797933454456527, 12052484968956054968, 81561108583940461},
+	{10275472327855866059, 1853230249301399474, 11905278320845867303, 130497773734304738},
+	{8220377862284692848, 16239979458408760872, 16902920286160514488, 104398218987443790},
+	{6576302289827754278, 1923937122501277728, 13522336228928411591, 83518575189955032},
+	{3143386034240586198, 17835694654969685658, 6878342707317817252, 133629720303928052},
+	{13582755271618199928, 17957904538717658849, 16570720610079984771, 106903776243142441},
+	{7176855402552649620, 6987626001490306433, 9567227673322077494, 85523020994513953},
+	{11482968644084239391, 14869550417126400616, 11618215462573413667, 136836833591222325},
+	{16565072544751212159, 4516942704217299846, 9294572370058730934, 109469466872977860},
+	{13252058035800969728, 7302902978115750200, 7435657896046984747, 87575573498382288},
+	{2756548783571999948, 11684644764985200321, 8207703818933265272, 140120917597411661},
+	{5894587841599510282, 16726413441471980903, 2876814240404701894, 112096734077929329},
+	{1026321458537697902, 2313084308951853753, 5990800207065671839, 89677387262343463},
+	{1642114333660316643, 7390283709064876328, 5895931516563164619, 143483819619749541},
+	{8692389096412073961, 5912226967251901062, 1027396398508621372, 114787055695799633},
+	{18021957721355390138, 4729781573801520849, 8200614748290717744, 91829644556639706},
+	{18106914991826222434, 3783825259041216679, 2871142983890663872, 73463715645311765},
+	{3145622283728583632, 9743469229207857011, 4593828774225062195, 117541945032498824},
+	{2516497826982866906, 11484124198108195932, 7364411834121960079, 94033556025999059},
+	{2013198261586293525, 16565996987970377392, 9580878282039478386, 75226844820799247},
+	{3221117218538069639, 4369502292301141888, 572009992295524126, 120362951713278796},
+	{2576893774830455711, 14563648278066644480, 15215003252804060593, 96290361370623036},
+	{5750863834606274892, 15340267437195225907, 8482653787501338151, 77032289096498429},
+	{12890730950111950151, 6097683825802809835, 2504199615776410073, 123251662554397487},
+	{10312584760089560121, 4878147060642247868, 13071406136846859028, 98601330043517989},
+	{8250067808071648097, 14970564092739529264, 14146473724219397545, 78881064034814391},
+	{13200108492914636954, 16574204918899426176, 15255660329267215426, 126209702455703026},
+	{17938784423815530210, 5880666305635720294, 8515179448671862018, 100967761964562421},
+	{3282981094826693198, 8393881859250486559, 3122794744195579291, 80774209571649937},
+	{8942118566464619440, 9740862160058868171, 8685820405454837189, 129238735314639899},
+	{10843043667913605876, 15171387357530915183, 10638005139105780074, 103390988251711919},
+	{4985086119588974377, 1069063441799001177, 12199752926026534383, 82712790601369535},
+	{7976137791342359003, 16467896765846043176, 1072860607932903396, 132340464962191257},
+	{10070259047815797526, 2106270968451103571, 11926334930572053687, 105872371969753005},
+	{15434904867736458667, 12753063218986613826, 9541067944457642949, 84697897575802404},
+	{13627801344152602898, 16715552335636671799, 4197662266906497749, 135516636121283847},
+	{10902241075322082318, 9683093053767427116, 14426176257750929169, 108413308897027077},
+	{1343095230773845208, 4057125628272031370, 4162243376716922689, 86730647117621662},
+	{13216998813463883303, 17559447449460981161, 10348938217488986625, 138769035388194659},
+	{10573599050771106642, 17736906774310695252, 11968499388733099623, 111015228310555727},
+	{1080181611133064668, 14189525419448556202, 2196101881502659052, 88812182648444582},
+	{12796337022038634437, 11635194226891958953, 7203111825146164807, 142099492237511331},
+	{6547720802888997227, 16686853010997387809, 2073140645375021522, 113679593790009065},
+	{1548827827569287458, 5970784779314089601, 1658512516300017218, 90943675032007252},
+	{15996457521023071260, 4776627823451271680, 12394856457265744744, 72754940025605801},
+	{7147587959927362399, 7642604517522034689, 12453072702141370944, 116407904040969282},
+	{5718070367941889919, 2424734799275717428, 2583760532229276109, 93126323232775426},
+	{11953153923837332582, 1939787839420573942, 16824403684751062180, 74501058586220340},
+	{4367651019172090838, 3103660543072918308, 8472301821892147872, 119201693737952545},
+	{3494120815337672671, 13550974878684065616, 6777841457513718297, 95361354990362036},
+	{6484645467012048460, 18219477532431073139, 1732924351269064314, 76289083992289629},
+	{14064781561961187858, 7015071163438255083, 10151376591514323550, 122062534387663406},
+	{14941174064310860610, 1922708116008693743, 4431752458469548517, 97650027510130725},
+	{11952939251448688488, 12606212937032685964, 3545401966775638813, 78120022008104580},
+	{4367307543350260288, 16480591884510387220, 5672643146841022101, 124992035212967328},
+	{7183194849422118554, 16873822322350220099, 11916812146956638327, 99993628170373862},
+	{13125253509021515489, 17188406672622086402, 2154752088081490015, 79994902536299090},
+	{6243010355466783490, 9054706602485786628, 3447603340930384025, 127991844058078544},
+	{16062454728599157762, 10933114096730539625, 6447431487486217543, 102393475246462835},
+	{1781917338653595240, 16125188906868252347, 5157945189988974034, 81914780197170268},
+	{10229765371329573030, 11042906992021562462, 4563363489240448132, 131063648315472429},
+	{15562509926547479070, 5144976778875339646, 7340039606134268829, 104850918652377943},
+	{1381961497012252287, 15184027867326002687, 13250729314391235709, 83880734921902354},
+	{2211138395219603659, 2158351699270142360, 10133120458800246166, 134209175875043767},
+	{9147608345659503573, 9105378988899934534, 727798737556376286, 107367340700035014},
+	{11007435491269513182, 7284303191119947627, 4271587804787011352, 85893872560028011},
+	{17611896786031221091, 7965536291050005880, 17902586931884949133, 137430196096044817},
+	{14089517428824976873, 6372429032840004704, 6943371916024138660, 109944156876835854},
+	{203567498834250529, 8787292041013914087, 9244046347561221251, 87955325501468683},
+	{11393754442360531815, 2991620821396531569, 11101125341356043679, 140728520802349893},
+	{5425654739146515129, 13461343101342956225, 16259597902568655589, 112582816641879914},
+	{11719221420801032750, 18147772110558185626, 16697027136796834794, 90066253313503931},
+	{11372056643797831753, 10589691303183545386, 889801715681563409, 144106005301606291},
+	{5408296500296355079, 8471753042546836309, 15469236631512892020, 115284804241285032},
+	{637288385495173740, 17845448878263200017, 4996691675726492969, 92227843393028026},
+	{11577877152621869962, 14276359102610560013, 308004525839284052, 73782274714422421},
+	{11145905814711171292, 706081675725434082, 11560853685568585454, 118051639543075873},
+	{12606073466510847357, 11632911784806078235, 16627380577938689009, 94441311634460698},
+	{2706161143724857240, 1927631798361041942, 2233858018125220238, 75553049307568559},
+	{11708555459443592229, 6773559692119577430, 10952870458484173027, 120884878892109694},
+	{5677495552812963460, 1729498938953751621, 12451645181529248745, 96707903113687755},
+	{852647627508460445, 1383599151163001297, 9961316145223398996, 77366322490950204},
+	{5053585018755447035, 2213758641860802075, 4870059388131707424, 123786115985520327},
+	{353519200262447305, 16528402172456282953, 14964093954731096908, 99028892788416261},
+	{11350861804435688814, 16912070552706936685, 8281926349042967203, 79223114230733009},
+	{3403983628129460809, 12301917625363457404, 2183035714243016556, 126756982769172815},
+	{2723186902503568647, 6152185285548855600, 1746428571394413245, 101405586215338252},
+	{13246595966228585887, 15989794672664815449, 12465189301341261565, 81124468972270601},
+	{2747809472256185804, 18204973846779884073, 12565605252662197858, 129799150355632962},
+	{9576945207288769289, 14563979077423907258, 2673786572645937640, 103839320284506370},
+	{15040253795314836078, 11651183261939125806, 2139029258116750112, 83071456227605096},
+	{12996359628278006755, 14952544404360690967, 14490493257212531149, 132914329964168153},
+	{14086436517364315727, 4583337894004732127, 524348161544293950, 106331463971334523},
+	{11269149213891452582, 11045367944687606348, 7798176158719255806, 85065171177067618},
+	{3273243483258682838, 6604542267274439188, 8787733039208898967, 136104273883308189},
+	{6307943601348856594, 1594284999077641027, 10719535246109029497, 108883419106646551},
+	{5046354881079085275, 8654125628745933468, 4886279382145313274, 87106735285317241},
+	{4384818994984626117, 13846601005993493549, 439349381948680592, 139370776456507586},
+	{14575901640213431863, 9234360569063869, 15108874764526585767, 111496621165206068},
+	{15350070126912655814, 7387488455251095, 1019053367395537644, 89197296932164855},
+	{13492065758834518332, 7390517611012222399, 1630485387832860230, 142715675091463768},
+	{3414954977583794019, 13291111718293598566, 8683085939750108830, 114172540073171014},
+	{2731963982067035216, 14322238189376789176, 10635817566541997387, 91338032058536811},
+	{5874920000395538496, 389744107275700371, 4819305238491687587, 73070425646829449},
+	{13089220815374771916, 11691637015866851563, 15089586011070520785, 116912681034927118},
+	{6782027837557907210, 16732007242177301897, 1003622364630685658, 93530144827941695},
+	{5425622270046325768, 2317559349516110548, 802897891704548527, 74824115862353356},
+	{1302298002590300582, 18746144483866554, 12352683070953008613, 119718585379765369},
+	{15799233661039881758, 11083043359812824212, 13571495271504317213, 95774868303812295},
+	{12639386928831905407, 16245132317334080016, 10857196217203453770, 76619894643049836},
+	{5465623827163407358, 166770004541155764, 9992816318041705387, 122591831428879738},
+	{8061847876472636210, 133416003632924611, 15372950683917184956, 98073465143103790},
+	{17517524745403839937, 14864128061873980981, 12298360547133747964, 78458772114483032},
+	{13270644333678502607, 16403907269514548924, 4919981616446355450, 125534035383172852},
+	{6927166652200891762, 5744428186127818493, 15004031737382815330, 100427228306538281},
+	{9231082136502623733, 906193734160344471, 8313876575164341941, 80341782645230625},
+	{12336159436556680, 12517956418882282124, 13302202520262947105, 128546852232369000},
+	{3699217742291155667, 10014365135105825699, 10641762016210357684, 102837481785895200},
+	{10338071823316745180, 11700840922826570882, 8513409612968286147, 82269985428716160},
+	{5472868473081061318, 3963950217554872119, 13621455380749257836, 131631976685945856},
+	{688945963722938732, 14239206618269628665, 7207815489857495945, 105305581348756685},
+	{551156770978350985, 11391365294615702932, 5766252391885996756, 84244465079005348},
+	{11949897277791092546, 7158138027159393721, 5536655012275684487, 134791144126408557},
+	{9559917822232874037, 9415859236469425300, 15497370454046278559, 107832915301126845},
+	{11337283072528209553, 11222036203917450563, 12397896363237022847, 86266332240901476},
+	{10760955286561314638, 14265909111526010578, 12457936551695415909, 138026131585442362},
+	{12298113043990962034, 7723378474478898139, 2587651611872512081, 110420905268353890},
+	{9838490435192769627, 2489353964841208188, 2070121289498009665, 88336724214683112},
+	{15741584696308431403, 7672315158487843424, 7001542877938725787, 141338758743492979},
+	{12593267757046745122, 2448503312048364416, 9290583117092890953, 113071006994794383},
+	{2695916576153575452, 16716197908606332826, 14811164123158133408, 90456805595835506},
+	{2156733260922860361, 17062307141626976584, 8159582483784596403, 72365444476668405},
+	{7140122032218486901, 5163598538151700595, 13055331974055354246, 115784711162669448},
+	{9401446440516699844, 7820227645263270799, 17822963208728104043, 92627768930135558},
+	{7521157152413359875, 2566833301468706316, 3190324122756752265, 74102215144108447},
+	{8344502629119465477, 7796282097091840429, 8793867411152713947, 118563544230573515},
+	{2986253288553662059, 17305072121899203313, 7035093928922171157, 94850835384458812},
+	{13457049075068660617, 17533406512261272973, 16696121587363467895, 75880668307567049},
+	{6773883261142215694, 16985403975392305788, 15645748095555817663, 121409069292107279},
+	{5419106608913772555, 6209625550830023984, 16205947291186564454, 97127255433685823},
+	{645936472389107721, 16035746884889750157, 1896711388723520593, 77701804346948659},
+	{8412195985306392999, 10899799756855958958, 10413435851441453596, 124322886955117854},
+	{14108454417728935046, 8719839805484767166, 12020097495895073200, 99458309564094283},
+	{14976112348925058360, 14354569473871634379, 16994775626199879206, 79566647651275426},
+	{16583082128796272730, 8209915899226973714, 1366199298726434468, 127306636242040683},
+	{13266465703037018184, 2878583904639668648, 8471657068464968221, 101845308993632546},
+	{10613172562429614547, 13370913567937465888, 3087976840030064253, 81476247194906037},
+	{13291727285145472952, 2946717634990393805, 8630111758790013129, 130361995511849659},
+	{18012079457600199008, 9736071737476135690, 10593438221773920826, 104289596409479727},
+	{3341617121854428237, 15167555019464729199, 1096052947935316014, 83431677127583782},
+	{5346587394967085179, 16889390401659746072, 5443033531438415946, 133490683404134051},
+	{7966618730715578466, 6132814691843976211, 665078010408822434, 106792546723307241},
+	{2683946169830552450, 4906251753475180969, 15289457667294699240, 85434037378645792},
+	{15362360315954614889, 11539351620302199873, 9705737008703877491, 136694459805833268},
+	{4911190623279871265, 12920830110983670222, 15143287236446922639, 109355567844666614},
+	{3928952498623897012, 17715361718270756824, 15803978603899448434, 87484454275733291},
+	{13665021627282055865, 9897834675523659302, 17907668136755296849, 139975126841173266},
+	{3553319672341824046, 7918267740418927442, 10636785694662327156, 111980101472938613},
+	{17600050996841100530, 10023963007077052276, 15888126185213682371, 89584081178350890},
+	{13402686335978119555, 8659643181839462996, 6974257822632340178, 143334529885361425},
+	{14411497883524405967, 14306412174955391043, 5579406258105872142, 114667623908289140},
+	{11529198306819524774, 4066432110480492188, 4463525006484697714, 91734099126631312},
+	{12912707460197530142, 18010540947352035043, 14638866449413489140, 73387279301305049},
+	{5902936677348406934, 17748819071537525100, 12354139874835851655, 117419646882088079},
+	{8411698156620635871, 17888404071971930403, 13572660714610591647, 93935717505670463},
+	{14108056154780329343, 14310723257577544322, 18236826201172293964, 75148574004536370},
+	{15194192218164706302, 11829110767898339946, 10732177848166118727, 120237718407258193},
+	{8466004959789854719, 9463288614318671957, 15964439908016715628, 96190174725806554},
+	{10462152782573794098, 191933261971116919, 16460900741155282826, 76952139780645243},
+	{13050095637376160234, 7685790848637607717, 4201348297396990582, 123123423649032390},
+	{14129425324642838510, 17216679123135817143, 3361078637917592465, 98498738919225912},
+	{11303540259714270808, 6394645669024833068, 13756909354559804942, 78798991135380729},
+	{7017617971317102324, 2852735440955912263, 10943008523069956938, 126078385816609167},
+	{12992792006537502505, 2282188352764729810, 1375709188972144904, 100862708653287334},
+	{17772931234713822651, 9204448311695604494, 4789916165919626246, 80690166922629867},
+	{13679294716574474948, 11037768483971056868, 11353214680213312317, 129104267076207787},
+	{3564738143775759312, 12519563601918755818, 1703874114686829207, 103283413660966230},
+	{2851790515020607450, 2636953252051184008, 1363099291749463366, 82626730928772984},
+	{873516009291061596, 4219125203281894413, 9559656496282962032, 132202769486036774},
+	{4388161622174759600, 18132695421593156823, 11337074011768279948, 105762215588829419},
+	{3510529297739807680, 7127458707790704812, 12759008024156534282, 84609772471063535},
+	{12995544505867512935, 15093282747207038022, 1967668764940903235, 135375635953701657},
+	{14085784419435920671, 4695928568281809771, 12642181456178453558, 108300508762961325},
+	{14957976350290646860, 11135440484109268463, 10113745164942762846, 86640407010369060},
+	{12864715716239304006, 10438007145091008895, 16181992263908420554, 138624651216590496},
+	{10291772572991443205, 8350405716072807116, 9256244996384826120, 110899720973272397},
+	{15612115687876975211, 17748371017083976662, 26298367624040249, 88719776778617918},
+	{13911338656377429367, 13639998368366721367, 14799472647166105692, 141951642845788668},
+	{3750373295618122847, 10911998694693377094, 771531673507153584, 113561314276630935},
+	{10378996265978318924, 12418947770496611998, 617225338805722867, 90849051421304748},
+	{15681894642266475786, 9935158216397289598, 7872477900528398940, 72679241137043798},
+	{17712333798142540611, 12206904331493753034, 8906615826103527981, 116286785819270077},
+	{6791169409030211842, 17144221094678823074, 18193339105108553354, 93029428655416061},
+	{9122284341966079797, 13715376875743058459, 10865322469344932360, 74423542924332849},
+	{10906306132403817352, 10876556556963162565, 6316469506726160807, 119077668678932559},
+	{5035696091181143559, 5011896430828619729, 8742524420122838969, 95262134943146047},
+	{4028556872944914847, 320168329920985460, 18062065980324002145, 76209707954516837},
+	{10135039811453774078, 4201618142615487059, 14141910309550762139, 121935532727226940},
+	{15486729478646839909, 7050643328834299970, 11313528247640609711, 97548426181781552},
+	{1321337138691740958, 13019212292551260623, 1672124968628667122, 78038740945425242},
+	{5803488236648695855, 9762693223856286027, 6364748764547777719, 124861985512680387},
+	{12021488218802777331, 4120805764343118498, 16159845455863953145, 99889588410144309},
+	{2238492945558401218, 6985993426216405122, 16617225179433072839, 79911670728115447},
+	{18338983971861083242, 3798891852462427548, 11830165028125275250, 127858673164984716},
+	{18360535992230776917, 17796508740937583331, 5774783207758309876, 102286938531987773},
+	{14688428793784621533, 17926555807491976988, 11998524195690468547, 81829550825590218},
+	{1365393181603932514, 10235745218277611566, 15508289898362839353, 130927281320944349},
+	{8471012174766966658, 809898545138268606, 16095980733432181806, 104741825056755479},
+	{3087460925071663003, 647918836110614885, 16566133401487655768, 83793460045404383},
+	{16007983924340391774, 12104716582002714785, 4369720553928787289, 134069536072647014},
+	{1738340695246582450, 17062470895085992475, 7185125257884940154, 107255628858117611},
+	{1390672556197265960, 13649976716068793980, 2058751391566041800, 85804503086494089},
+	{9603773719399446182, 10771916301484339398, 10672699855989487527, 137287204938390542},
+	{304321346035736299, 12306881855929381842, 1159462255307769375, 109829763950712434},
+	{15000852335796230332, 13534854299485415796, 4616918618988125823, 87863811160569947},
+	{16622666107790147885, 3209022805467113658, 11076418605122911641, 140582097856911915},
+	{16987481700974028631, 17324613503341332219, 8861134884098329312, 112465678285529532},
+	{2521938916553491936, 17549039617414976099, 18156954351504394419, 89972542628423625},
+	{345753451743676773, 17010416943638230789, 10604382888697479455, 143956068205477801},
+	{276602761394941419, 9918984740168674308, 4794157496216073241, 115164854564382241},
+	{221282209115953135, 556490162651118800, 145977182230948270, 92131883651505793},
+	{7555723396776583155, 7823889759604715686, 7495479375268579262, 73705506921204634},
+	{15778506249584443370, 5139525985883724451, 924720556203995850, 117928811073927415},
+	{8933456184925644373, 4111620788706979561, 739776444963196680, 94343048859141932},
+	{14525462577424336145, 14357343075191314618, 11659867600196288313, 75474439087313545},
+	{15862042494395117185, 835656031854641450, 209044086604509686, 120759102539701673},
+	{16378982810258004072, 4357873640225623483, 7545932898767428395, 96607282031761338},
+	{9413837433464492934, 10864996541664319433, 13415443948497763362, 77285825625409070},
+	{15062139893543188694, 2626599207695269800, 3017966243886869764, 123657321000654513},
+	{4671014285350730309, 13169325810381946810, 9793070624593316457, 98925856800523610},
+	{14804857872506315217, 3156763018821736801, 7834456499674653166, 79140685440418888},
+	{5241028522300552731, 12429518459598599529, 8845781584737534742, 126625096704670221},
+	{15260869262066173154, 17322312397162700269, 3387276453048117470, 101300077363736177},
+	{8519346594911028200, 6479152288246339569, 13777867606664224946, 81040061890988941},
+	{6252256922373824474, 14055992475936053634, 14665890541178939267, 129664099025582306},
+	{16069851982124790549, 176747536523111937, 8043363618201241091, 103731279220465845},
+	{1787835141474101470, 14898793288186130843, 6434690894560992872, 82985023376372676},
+	{13928582670584293321, 1701976372646347409, 2916807801813767950, 132776037402196282},
+	{7453517321725524333, 12429627542342808897, 13401492685676745329, 106220829921757025},
+	{2273465042638509144, 13633050848616157441, 10721194148541396263, 84976663937405620},
+	{11016241697705435276, 18123532543043941582, 17153910637666234021, 135962662299848992},
+	{8812993358164348221, 3430779590209422296, 6344430880649166571, 108770129839879194},
+	{3361045871789568254, 2744623672167537837, 8764893519261243580, 87016103871903355},
+	{9067022209605219529, 4391397875468060539, 14023829630817989728, 139225766195045368},
+	{7253617767684175623, 18270513559342089724, 151017260428660812, 111380612956036295},
+	{2113545399405430175, 7237713217989851133, 120813808342928650, 89104490364829036},
+	{10760370268532508927, 4201643519299941166, 11261348537574416810, 142567184583726457},
+	{15986993844309827788, 14429361259665683902, 1630381200575712801, 114053747666981166},
+	{16478943890189772554, 4164791378248726475, 16061700219428211534, 91242998133584932},
+	{9493806297409907720, 18089228361566622473, 5470662546058748580, 72994398506867946},
+	{4122043631630121382, 3117323675313223695, 1374362444210177083, 116791037610988714},
+	{14365681349529828075, 13561905384476309925, 4788838770110051989, 93432830088790971},
+	{11492545079623862460, 10849524307581047940, 141722201346131268, 74746264071032777},
+	{18388072127398179936, 17359238892129676704, 3916104336895720352, 119594022513652443},
+	{18399806516660454272, 13887391113703741363, 10511581099000396928, 95675218010921954},
+	{14719845213328363418, 3731215261479172444, 12098613693942227866, 76540174408737563},
+	{1415659452873919529, 13348642047850496558, 15668433095565654262, 122464279053980101},
+	{15889922821266776916, 18057611267764217892, 8845397661710613086, 97971423243184081},
+	{16401287071755331856, 7067391384727553667, 3386969314626580146, 78377138594547265},
+	{4105966426357069030, 3929128586080265222, 5419150903402528234, 125403421751275624},
+	{3284773141085655224, 10522000498348032824, 8024669537463932910, 100322737401020499},
+	{10006516142352344826, 12106949213420336582, 10109084444713056651, 80258189920816399},
+	{1253030568796110428, 924374667762986916, 5106488667315159673, 128413103873306239},
+	{8381122084520708989, 11807546178436120502, 7774539748594038061, 102730483098644991},
+	{10394246482358477515, 2067339313265075755, 2530282984133320126, 82184386478915993},
+	{5562747927547833054, 10686440530707941855, 359103959871401878, 131495018366265589},
+	{15518244786263997413, 1170454795082532837, 3976631982639031826, 105196014693012471},
+	{16103944643753108253, 12004410280291757239, 17938700845078866753, 84156811754409976},
+	{3630218541553511266, 8139010004241080614, 2876479648932814543, 134650898807055963},
+	{2904174833242809013, 2821859188650954168, 9679881348630072281, 107720719045644770},
+	{6012688681336157534, 17014882609888404627, 7743905078904057824, 86176575236515816},
+	{13309650704879762377, 8777068102111895787, 5011550496762671873, 137882520378425306},
+	{6958371749161899578, 10711003296431426953, 319891582668227175, 110306016302740245},
+	{12945395028813340309, 8568802637145141562, 255913266134581740, 88244813042192196},
+	{17023283231359434171, 6331386589948405853, 11477507670041061754, 141191700867507513},
+	{13618626585087547337, 16133155716184455652, 16560703765516670049, 112953360694006010},
+	{7205552453328127546, 16595873387689474845, 13248563012413336039, 90362688555204808},
+	{16832488406888233007, 5898001080667759229, 17977548039414489478, 72290150844163846},
+	{4795888562569710871, 16815499358552235414, 2938635159869810902, 115664241350662155},
+	{147362035313858374, 6073701857357967685, 2350908127895848722, 92531393080529724},
+	{14875284887218727992, 1169612671144463824, 5570075317058589301, 74025114464423779},
+	{12732409375324233817, 1871380273831142119, 16290818136777563528, 118440183143078046},
+	{17564625129743207700, 5186453033806824018, 9343305694680140499, 94752146514462437},
+	{17741048918536476484, 459813612303548891, 95946926260291753, 75801717211569950},
+	{17317631825432631404, 15493097038653319519, 153515082016466804, 121282747538511920},
+	{2786059016120374154, 16083826445664565939, 122812065613173443, 97026198030809536},
+	{9607544842380119969, 16556409971273563074, 14855644911458180047, 77620958424647628},
+	{4304025303582460981, 8043511880328149303, 1632938969881626137, 124193533479436206},
+	{18200615501833610078, 13813507133746340088, 16063746434872942202, 99354826783548964},
+	{18249841216208798386, 7361456892255161747, 16540345962640264085, 79483861426839171},
+	{7063653057482615477, 4399633398124438150, 639111837031050274, 127174178282942675},
+	{9340271260728002705, 7209055533241460843, 511289469624840219, 101739342626354140},
+	{93519379098581518, 9456593241335078998, 409031575699872175, 81391474101083312},
+	{149631006557730428, 373153927168485104, 4343799335861705804, 130226358561733299},
+	{11187751249471915312, 7677220771218608729, 7164388283431274966, 104181086849386639},
+	{12639549814319442573, 6141776616974886983, 9420859441486930296, 83344869479509311},
+	{1776535629201556501, 13516191401901729497, 7694677476895267827, 133351791167214898},
+	{12489274947586976170, 10812953121521383597, 13534439611000034908, 106681432933771918},
+	{17370117587553401583, 4961013682475196554, 18206249318283848573, 85345146347017534},
+	{1966746436892070270, 11626970706702224811, 18061952465028426747, 136552234155228055},
+	{8952094778997476862, 1922878935877959202, 14449561972022741398, 109241787324182444},
+	{10851024637939891813, 12606349592928098331, 15248998392360103441, 87393429859345955},
+	{2604244161736185608, 12791461719201136684, 5951653354066613890, 139829487774953529},
+	{9462092958872769133, 13922518190102819670, 8450671497995201435, 111863590219962823},
+	{14948371996582035953, 69968107856524766, 14139234827879981795, 89490872175970258},
+	{12849348750305526555, 14869344231538080919, 486682836156508932, 143185395481552414},
+	{10279479000244421244, 8206126570488554412, 4078695083667117469, 114548316385241931},
+	{844885570711716349, 6564901256390843530, 18020351325901335268, 91638653108193544},
+	{11743954900795104049, 16319967449338405793, 18105629875462978537, 73310922486554835},
+	{343583767562614862, 11354552659973807977, 10522263727031214044, 117297475978487737},
+	{7653564643533912536, 5394293313237136058, 1039113352141150589, 93837980782790190},
+	{17190898159052860998, 8004783465331619169, 831290681712920471, 75070384626232152},
+	{12748041795516936304, 9118304729788680348, 5019413905482583077, 120112615401971443},
+	{17577131065897369690, 7294643783830944278, 11394228753869887108, 96090092321577154},
+	{14061704852717895752, 16903761471290486392, 12804731817837820009, 76872073857261723},
+	{11430681320122902233, 12288623095097136935, 16798222093798601692, 122995318171618757},
+	{12833893870840232110, 13520247290819619871, 6059880045555060707, 98396254537295006},
+	{13956463911414096011, 18194895462139516543, 1158555221702138242, 78717003629836005},
+	{3883598184553002002, 14354437480455585177, 1853688354723421188, 125947205807737608},
+	{10485576177126222248, 7794201169622557818, 8861648313262557597, 100757764646190086},
+	{4699112126959067475, 13614058565181866901, 3399969835868135754, 80606211716952069},
+	{14897277032618328606, 18093144889549076718, 12818649366872837853, 128969938747123310},
+	{8228472811352752562, 3406469467413530405, 10254919493498270283, 103175950997698648},
+	{2893429434340291726, 17482570832898465617, 15582633224282436872, 82540760798158918},
+	{8318835909686377085, 9525369258927993371, 2796120270400437057, 132065217277054270},
+	{14033766357232922315, 241597777658574050, 2236896216320349646, 105652173821643416},
+	{3848315456302517205, 11261324666352590210, 16546912232023921009, 84521739057314732},
+	{17225351174309758498, 10639421836680323689, 11717664312270632322, 135234782491703572},
+	{2712234495222075829, 12200886284086169275, 1995433820332685211, 108187825993362858},
+	{5859136410919570986, 13450057842010845743, 8975044685749968815, 86550260794690286},
+	{16753315886955134224, 14141394917733532542, 6981373867716129458, 138480417271504458},
+	{2334606265338376410, 7623767119444915711, 12963796723656724213, 110784333817203566},
+	{1867685012270701128, 9788362510297842892, 6681688564183469047, 88627467053762853},
+	{6677644834375032128, 15661380016476548627, 7001352887951640152, 141803947286020565},
+	{9031464682241936026, 5150406383697418255, 5601082310361312122, 113443157828816452},
+	{10914520560535459144, 7809673921699844927, 15548912292514780667, 90754526263053161},
+	{8731616448428367315, 13626436766843696588, 8749781019269914210, 72603621010442529},
+	{17659935132227298027, 10734252382724183571, 2931603186606131767, 116165793616708047},
+	{14127948105781838422, 12276750720921257180, 13413328993510636383, 92932634893366437},
+	{11302358484625470738, 9821400576737005744, 3351965565324688460, 74346107914693150},
+	{7015727131175022210, 15714240922779209191, 5363144904519501536, 118953772663509040},
+	{16680628149165748738, 8882043923481457029, 4290515923615601229, 95163018130807232},
+	{13344502519332598990, 3416286324043255300, 14500459183118211953, 76130414504645785},
+	{17661855216190248061, 1776709303727298157, 4753990619279587509, 121808663207433257},
+	{3061437728726467479, 16178762701949479819, 14871238939649400976, 97446930565946605},
+	{2449150182981173984, 9253661346817673532, 11896991151719520781, 77957544452757284},
+	{7607989107511788697, 14805858154908277651, 7967139398525502280, 124732071124411655},
+	{2397042471267520634, 11844686523926622121, 6373711518820401824, 99785656899529324},
+	{5606982791755926831, 16854446848625118343, 8788318029798231782, 79828525519623459},
+	{16349870096293303575, 1141673254606817086, 2993262403451439883, 127725640831397535},
+	{16769244891776553183, 8292036233169274315, 2394609922761151906, 102180512665118028},
+	{17104744728163152870, 10322977801277329775, 9294385567692742171, 81744410132094422},
+	{5231498676609582653, 12827415667301817318, 113621649340746181, 130791056211351076},
+	{4185198941287666122, 2883234904357633208, 14848292578440238238, 104632844969080860},
+	{18105554411997774191, 9685285552969927212, 11878634062752190590, 83706275975264688},
+	{10522142985486887089, 11807108070009973217, 15316465685661594621, 133930041560423501},
+	{12107063203131419994, 2066988826524157927, 8563823733787365374, 107144033248338801},
+	{2306952933021315349, 1653591061219326342, 3161710172287981976, 85715226598671041},
+	{11069822322317925205, 6335094512692832470, 16126782719886502131, 137144362557873665},
+	{5166509043112429841, 1378726795412355653, 12901426175909201705, 109715490046298932},
+	{4133207234489943873, 12171027880555615492, 2942443311243540717, 87772392037039146},
+	{6613131575183910196, 15784295794147074464, 15775955742215396117, 140435827259262633},
+	{8979854074889038480, 12627436635317659571, 1552718149546585924, 112348661807410107},
+	{18251929704136961754, 6412600493512217333, 12310220963862999709, 89878929445928085},
+	{14445692267651497513, 17638858419103368380, 1249609468471247918, 143806287113484937},
+	{11556553814121198010, 14111086735282694704, 12067734019002729304, 115045029690787949},
+	{1866545421813137762, 220822944000424794, 13343536029944093767, 92036023752630359},
+	{1493236337450510210, 14934053614167981128, 14364177638697185336, 73628819002104287},
+	{13457224584146547305, 1758392894217307865, 8225288962947855246, 117806110403366860},
+	{7076430852575327521, 16164109574341487585, 6580231170358284196, 94244888322693488},
+	{9350493496802172340, 16620636474215100391, 12642882565770448003, 75395910658154790},
+	{3892743150657744774, 4456925470292698687, 1781868031523165190, 120633457053047665},
+	{14182240964751926789, 3565540376234158949, 1425494425218532152, 96506765642438132},
+	{277746327575810462, 6541781115729237483, 12208441984400556691, 77205412513950505},
+	{7823091753605117385, 3088152155682959326, 1086763101331339090, 123528660022320809},
+	{6258473402884093908, 6159870539288277784, 4558759295806981595, 98822928017856647},
+	{1317429907565364803, 15995942875656353197, 14715053880871316245, 79058342414285317},
+	{9486585481588404331, 10836113342082523822, 8786690950426464700, 126493347862856508},
+	{7589268385270723465, 16047588303149839704, 14408050389824992406, 101194678290285206},
+	{2382065893474668449, 5459373013036051117, 7837091497118083602, 80955742632228165},
+	{11190003059043290164, 12424345635599592110, 12539346395388933763, 129529188211565064},
+	{1573304817750811485, 2560778878995853042, 13720825931053057334, 103623350569252051},
+	{12326690298426380158, 2048623103196682433, 7287311930100535544, 82898680455401641},
+	{16033355662740297929, 3277796965114691893, 4281001458677036224, 132637888728642626},
+	{1758638085966507374, 2622237572091753515, 18182196425909270272, 106110310982914100},
+	{12474956912998936869, 13165836501899133781, 14545757140727416217, 84888248786331280},
+	{16270582246056388666, 6307943144070972757, 4826467351454314332, 135821198058130049},
+	{1948419352619379964, 1357005700514867883, 7550522695905361789, 108656958446504039},
+	{16316130741063145264, 8464302189895714952, 9729766971466199754, 86925566757203231},
+	{11348413926733391129, 13542883503833143924, 8188929524862098960, 139080906811525170},
+	{12768079956128623226, 10834306803066515139, 6551143619889679168, 111264725449220136},
+	{17593161594386719228, 12356794257195122434, 1551566081169833011, 89011780359376109},
+	{17081012106793019794, 1324126737802644279, 9861203359355553465, 142418848575001774},
+	{2596763241208684866, 4748650204984025747, 11578311502226353095, 113935078860001419},
+	{16834805851934589186, 7488268978729130920, 12951998016522992799, 91148063088001135},
+	{17157193496289581672, 9679963997725215059, 10361598413218394239, 72918450470400908},
+	{1626067890869958412, 730547137392702803, 12889208646407520460, 116669520752641453},
+	{16058249571663608023, 7963135339397982888, 17690064546609837014, 93335616602113162},
+	{16535948472072796741, 2681159456776475987, 6773354007804048965, 74668493281690530},
+	{11700122296348833493, 4289855130842361580, 10837366412486478344, 119469589250704848},
+	{1981400207595246148, 14499930548899620234, 16048590759473003321, 95575671400563878},
+	{8963817795560017565, 15289293253861606510, 1770826163352671687, 76460537120451103},
+	{14342108472896028104, 6016125132469018800, 17590717120331915993, 122336859392721764},
+	{4094989148833001837, 15880946550200946010, 17761922511007443117, 97869487514177411},
+	{10654688948550222116, 1636710795935025838, 10520189194064044171, 78295590011341929},
+	{13358153502938445062, 2618737273496041341, 5764256266276739704, 125272944018147087},
+	{3307825172866935403, 16852385077764474366, 15679451457247122732, 100218355214517669},
+	{13714306582519279292, 9792559247469669169, 16232909980539608509, 80174684171614135},
+	{18253541717288936544, 4600048351725739701, 7525911895153821999, 128279494674582617},
+	{7224135744347328589, 18437433940348233054, 17088775960348788568, 102623595739666093},
+	{5779308595477862871, 11060598337536676120, 2602974324053299885, 82098876591732875},
+	{9246893752764580594, 17696957340058681792, 4164758918485279816, 131358202546772600},
+	{14776212631695485122, 10468217057305035110, 3331807134788223853, 105086562037418080},
+	{752923661130657128, 15753271275327848735, 2665445707830579082, 84069249629934464},
+	{12272724302034782374, 17826536411040737329, 11643410762012747178, 134510799407895142},
+	{13507528256369736222, 14261229128832589863, 1936030980126377096, 107608639526316114},
+	{18184720234579609624, 11408983303066071890, 5238173598843012000, 86086911621052891},
+	{3270110672134003136, 10875675655421894379, 1002380128664998554, 137739058593684626},
+	{6305437352449112832, 8700540524337515503, 15559299361899640136, 110191246874947700},
+	{8733698696701200589, 3271083604728102079, 12447439489519712109, 88152997499958160},
+	{10284569099980010619, 12612431397048783973, 1469159109521987758, 141044795999933057},
+	{15606352909467829142, 10089945117639027178, 12243373731843321176, 112835836799946445},
+	{16174431142316173637, 4382607279369311419, 9794698985474656941, 90268669439957156},
+	{9250196099111028586, 14574132267721180105, 4146410373637815229, 72214935551965725},
+	{3732267314351914768, 12250565184128157199, 6634256597820504367, 115543896883145160},
+	{17743209110449173108, 2421754517818705112, 5307405278256403494, 92435117506516128},
+	{17883916103101248809, 13005450058480695059, 11624621852088943441, 73948094005212902},
+	{13856870505994356802, 17119371278827201772, 3841999704374668213, 118316950408340644},
+	{14774845219537395765, 6316799393577940771, 6762948578241644894, 94653560326672515},
+	{11819876175629916612, 8742788329604262940, 5410358862593315915, 75722848261338012},
+	{4154406622040225286, 17677810142108731028, 12345922994891215787, 121156557218140819},
+	{7012874112374090552, 10452899298945074499, 13566087210654882953, 96925245774512655},
+	{16678345734125003411, 15741017068639880245, 10852869768523906362, 77540196619610124},
+	{859911471406633195, 17806929680339987747, 6296545185412519210, 124064314591376199},
+	{15445324436092947849, 17934892559013900520, 8726584963071925691, 99251451673100959},
+	{12356259548874358279, 14347914047211120416, 10670616785199450876, 79401161338480767},
+	{8701968833973242277, 820569587086330727, 2315591597351480110, 127041858141569228},
+	{6961575067178593822, 8035153299152885228, 9231170907365004734, 101633486513255382},
+	{9258608868484785381, 2738773824580397859, 6239096408183141, 81306789210604306},
+	{7435076560091835963, 8071386934070546898, 11078028998478823995, 130090862736966889},
+	{17016107692299199740, 10146458361998347841, 12551772013524969519, 104072690189573511},
+	{9923537339097449469, 8117166689598678273, 6352068796078065292, 83258152151658809},
+	{4809613298330188180, 5608769073874064591, 17542007703208725114, 133213043442654094},
+	{7537039453406060867, 11865712888583072319, 17722954977308890414, 106570434754123275},
+	{13408329192208669340, 13181919125608368178, 14178363981847112331, 85256347803298620},
+	{10385280263308139975, 13712372971489568439, 4238638297245828114, 136410156485277793},
+	{4618875395904601657, 3591200747707834105, 10769608267280483138, 109128125188222234},
+	{14763146760949412295, 13941007042391998253, 12305035428566296833, 87302500150577787},
+	{1484941929067597733, 3858867194117645590, 4930661426738433641, 139684000240924460},
+	{15945348802221719479, 17844489014261757764, 3944529141390746912, 111747200192739568},
+	{16445627856519285906, 14275591211409406211, 10534320942596418176, 89397760154191654},
+	{487562867237485188, 4394201864545498323, 5786867063928538113, 143036416246706647},
+	{7768747923273808797, 3515361491636398658, 15697540095368561460, 114429132997365317},
+	{6214998338619047037, 13880335637534849896, 5179334446811028521, 91543306397892254},
+	{1282649856153327307, 11104268510027879917, 7832816372190733140, 73234645118313803},
+	{2052239769845323691, 14077480801302697544, 8843157380763262701, 117175432189302085},
+	{1641791815876258953, 7572635826300247712, 7074525904610610161, 93740345751441668},
+	{16070828711668648455, 9747457475782108492, 13038318353172308775, 74992276601153334},
+	{18334628309186016881, 4527885517025642618, 9793262920849963071, 119987642561845335},
+	{18357051462090723828, 18379703672588155387, 7834610336679970456, 95990114049476268},
+	{10996292354930668740, 18393111752812434633, 13646385898827797011, 76792091239581014},
+	{13904718953147159660, 10982234730790343797, 10766170993898744249, 122867345983329623},
+	{14813123977259638051, 1407090155148454391, 15991634424602816046, 98293876786663698},
+	{11850499181807710441, 4815020938860673836, 1725261095456521867, 78635101429330959},
+	{4203403431924695413, 325335872693257492, 10139115382214255634, 125816162286929534},
+	{3362722745539756330, 7638966327638426640, 11800641120513314830, 100652929829543627},
+	{13758224640657536034, 17179219506336472281, 2061815266926831217, 80522343863634902},
+	{3566415351342506038, 16418704765912624681, 6988253241824840271, 128835750181815843},
+	{2853132281074004830, 16824312627472010068, 12969300222943692863, 103068600145452674},
+	{2282505824859203864, 6080752472493787408, 14064788993096864614, 82454880116362139},
+	{14720055764000457152, 6039855141248149529, 11435615944729252413, 131927808186179423},
+	{11776044611200365722, 1142535298256609300, 16527190385267222577, 105542246548943538},
+	{9420835688960292578, 914028238605287440, 2153705863988047092, 84433797239154831},
+	{11383988287594557801, 16219840440736101197, 14513975826606606316, 135094075582647729},
+	{1728493000591825594, 12975872352588880958, 15300529476027195376, 108075260466118183},
+	{12450840844699191445, 14070046696813015089, 1172377136596025331, 86460208372894547},
+	{5163950092551065019, 375981826449362204, 5565152233295550854, 138336333396631275},
+	{11509857703524672662, 3990134275901400086, 4452121786636440683, 110669066717305020},
+	{12897234977561648453, 10570805050204940715, 3561697429309152546, 88535253373844016},
+	{5878180705130996231, 2155892821360263852, 16766762331120375044, 141656405398150425},
+	{1013195749362886662, 5414063071830121405, 13413409864896300035, 113325124318520340},
+	{810556599490309330, 4331250457464097124, 10730727891917040028, 90660099454816272},
+	{4337794094334157787, 3465000365971277699, 1205884684049811376, 72528079563853018},
+	{3251121736192742136, 12922698215037864965, 16686810753447339494, 116044927302164828},
+	{13668943833179924678, 2959460942546471325, 2281402158532140626, 92835941841731863},
+	{14624503881285850066, 6056917568779087383, 9203819356309533147, 74268753473385490},
+	{4952462136347808489, 13380416924788450137, 14726110970095253035, 118830005557416784},
+	{272620894336336468, 14393682354572670433, 15470237590818112751, 95064004445933427},
+	{14975491974436710467, 446899439432405376, 4997492443170669555, 76051203556746742},
+	{1824694270647274808, 4404387917833758926, 11685336723814981611, 121681925690794787},
+	{5149104231259730170, 10902207963750827787, 1969571749568164642, 97345540552635830},
+	{7808632199749694459, 1343068741516841583, 1575657399654531714, 77876432442108664},
+	{5115113890115690488, 16906305245394587826, 9899749468931071388, 124602291907373862},
+	{402742297350642067, 13525044196315670261, 541101945661036464, 99681833525899090},
+	{322193837880513654, 14509384171794446532, 432881556528829171, 79745466820719272},
+	{515510140608821846, 1078921786419652512, 4381959305188036998, 127592746913150835},
+	{412408112487057477, 8241835058619542656, 3505567444150429598, 102074197530520668},
+	{11397972934215376951, 2904119232153723801, 10183151584804164325, 81659358024416534},
+	{18236756694744603122, 12025288400929778728, 5224996091460931950, 130654972839066455},
+	{3521358911569951528, 9620230720743822983, 4179996873168745560, 104523978271253164},
+	{13885133573481692192, 11385533391336968709, 7033346313276906771, 83619182617002531},
+	{14837516088086886861, 3459458167171508642, 3874656471759230188, 133790692187204050},
+	{11870012870469509489, 10146264163221027560, 3099725177407384150, 107032553749763240},
+	{9496010296375607591, 8117011330576822048, 2479780141925907320, 85626042999810592},
+	{15193616474200972145, 16676566943664825600, 7656997041823362035, 137001668799696947},
+	{4776195549876957070, 5962555925448039834, 17193644077684420598, 109601335039757557},
+	{7510305254643475979, 4770044740358431867, 6376217632663715832, 87681068031806046},
+	{12016488407429561567, 3942722769831580664, 2823250582778124685, 140289708850889674},
+	{16991888355427469900, 6843527030607174854, 5947949280964410071, 112231767080711739},
+	{17282859499083886243, 5474821624485739883, 8447708239513438380, 89785413664569391},
+	{16584528754308487019, 1381016969693363167, 6137635553737680762, 143656661863311026},
+	{13267623003446789615, 8483511205238511180, 1220759628248234286, 114925329490648821},
+	{3235400773273611046, 17854855408416539914, 15734002961566228721, 91940263592519056},
+	{17345715877586530130, 6905186697249411284, 8897853554511072654, 73552210874015245},
+	{5617052515686986268, 18426996345082878702, 14236565687217716246, 117683537398424392},
+	{4493642012549589014, 3673550631840571992, 4010554920290352351, 94146829918739514},
+	{14662960054265402181, 2938840505472457593, 6897792750974192204, 75317463934991611},
+	{1324643198373181551, 4702144808755932150, 3657770772074886880, 120507942295986578},
+	{8438412188182365887, 11140413476488566366, 10304914247143730150, 96406353836789262},
+	{14129427380029713356, 1533633151707032446, 865233768231163474, 77125083069431410},
+	{4160339734337989753, 9832510672215072561, 1384374029169861558, 123400132911090256},
+	{3328271787470391803, 11555357352513968372, 15864894482303530539, 98720106328872204},
+	{2662617429976313442, 16622983511494995344, 16381264400584734754, 78976085063097763},
+	{15328234332187832477, 11839378359424351257, 4073930152484113668, 126361736100956422},
+	{4883889836266445335, 9471502687539481006, 14327190566213021904, 101089388880765137},
+	{14975158313238887238, 3887853335289674481, 4083054823486596877, 80871511104612110},
+	{1824160412730757641, 9909914151205389494, 6532887717578555003, 129394417767379376},
+	{8838025959668426760, 11617280135706221918, 1536961359320933679, 103515534213903501},
+	{14449118397218562054, 9293824108564977534, 15986964346424388236, 82812427371122800},
+	{4671845361840147670, 7491420944220143409, 7132398880569469562, 132499883793796481},
+	{14805522733697849106, 13371834384859935373, 2016570289713665326, 105999907035037185},
+	{15533767001700189608, 7008118693146037975, 1613256231770932261, 84799925628029748},
+	{13785980758494572403, 144943464807929791, 17338605229801132911, 135679881004847596},
+	{18407482236279478569, 11184001216072074802, 10181535369098996005, 108543904803878077},
+	{18415334603765493178, 1568503343373839195, 766530665795376158, 86835123843102462},
+	{11017791292315237469, 2509605349398142713, 4915797880014512176, 138936198148963939},
+	{16192930663336010622, 2007684279518514170, 7621987118753520064, 111148958519171151},
+	{12954344530668808498, 1606147423614811336, 2408240880260905728, 88919166815336921},
+	{2280207175360541980, 9948533507267518785, 14921231852643180134, 142270666904539073},
+	{12892212184514164554, 580129176330194381, 868939037888813138, 113816533523631259},
+	{17692467377095152289, 11532149785289886474, 4384500045052960833, 91053226818905007},
+	{17843322716418032155, 9225719828231909179, 14575646480268099636, 72842581455124005},
+	{10102572272559299831, 7382454095687234041, 4874290294719407802, 116548130328198409},
+	{703360188563619219, 2216614461807876910, 7588781050517436565, 93238504262558727},
+	{11630734595076626345, 12841338013672032497, 17139071284639680221, 74590803410046981},
+	{7541128907896871182, 5788745562907610703, 1597072352230116092, 119345285456075171},
+	{2343554311575586622, 12009694079809909209, 16035053140751734166, 95476228364860136},
+	{16632238708228110591, 2229057634364106720, 9138693697859477010, 76380982691888109},
+	{15543535488939245975, 10945189844466391399, 3553863472349432246, 122209572307020975},
+	{12434828391151396780, 5066803060831202796, 2843090777879545797, 97767657845616780},
+	{17326560342404938071, 15121488892890693206, 2274472622303636637, 78214126276493424},
+	{12965101288880259620, 16815684599141288484, 11017853825169639266, 125142602042389478},
+	{17750778660588028342, 17141896494054941110, 16192980689619532059, 100114081633911582},
+	{10511274113728512351, 10024168380502042565, 5575686922211805001, 80091265307129266},
+	{2060643322997978468, 1281274149835626812, 1542401446055067356, 128146024491406826},
+	{5337863473140293098, 12093065764094232419, 15991316415811695177, 102516819593125460},
+	{580941963770324155, 2295754981791565289, 12793053132649356142, 82013455674500368},
+	{8308204771516339295, 3673207970866504462, 16779536197497059504, 131221529079200589},
+	{6646563817213071436, 10317264006177024216, 17112977772739557926, 104977223263360471},
+	{12695948683254277795, 875113575457798726, 10001033403449736018, 83981778610688377},
+	{12934820263723023825, 1400181720732477962, 1244258186551936336, 134370845777101404},
+	{2969158581494598414, 1120145376585982370, 4684755363983459392, 107496676621681123},
+	{2375326865195678731, 896116301268785896, 11126501920670588160, 85997341297344898},
+	{11179220613796906616, 16191181340997698726, 14113054258331030732, 137595746075751837},
+	{8943376491037525293, 16642293887540069304, 3911745777181003939, 110076596860601470},
+	{14533398822313840881, 17003183924773965766, 3129396621744803151, 88061277488481176},
+	{1117345227250683470, 12447699020670703934, 16075081039017416012, 140898043981569881},
+	{11961922626026277746, 17336856846020383793, 9170716016472022486, 112718435185255905},
+	{13258886915562932520, 10180136662074396711, 7336572813177617989, 90174748148204724},
+	{14296458347192256339, 15522806959143338015, 9558607065284004714, 72139798518563779},
+	{738240467056148203, 2700398246177878886, 4225724860228676574, 115423677629702047},
+	{11658638817870649532, 16917713855909944401, 14448626332408672228, 92338942103761637},
+	{5637562239554609303, 13534171084727955521, 4180203436443117136, 73871153683009310},
+	{12709448398029285207, 14275976106080908187, 6688325498308987418, 118193845892814896},
+	{6478209903681517843, 15110129699606636873, 1661311583905279611, 94555076714251917},
+	{1493219108203303951, 1020057315459578529, 12397095711349954659, 75644061371401533},
+	{13457197017351017291, 5321440519477235969, 16146004323418017131, 121030498194242453},
+	{18144455243364634479, 7946501230323699098, 1848757014508682735, 96824398555393963},
+	{10826215379949797260, 13735898613742779925, 8857703241090766834, 77459518844315170},
+	{6253898163693944647, 10909391337762716911, 14172325185745226935, 123935230150904272},
+	{12381816160438976364, 1348815440726352882, 3959162519112360902, 99148184120723418},
+	{2526755298867360445, 1079052352581082306, 10546027644773709368, 79318547296578734},
+	{353459663445866388, 5415832578871642013, 5805597787412204019, 126909675674525975},
+	{11350814174982424080, 8022014877839223933, 4644478229929763215, 101527740539620780},
+	{16459348969469759911, 6417611902271379146, 3715582583943810572, 81222192431696624},
+	{11577563092183974564, 2889481414150385988, 13323629763793917562, 129955507890714598},
+	{16640748103231000298, 2311585131320308790, 18037601440518954696, 103964406312571678},
+	{17001947297326710561, 5538616919798157355, 3362034708189432787, 83171525050057343},
+	{8756371602013185282, 8861787071677051769, 1689906718361182136, 133074440080091749},
+	{10694446096352458549, 7089429657341641415, 5041274189430856032, 106459552064073399},
+	{4866208062340056516, 1982194911131402809, 7722368166286595149, 85167641651258719},
+	{11475281714486000749, 17928907116777885787, 1287742621832821268, 136268226642013951},
+	{5490876556846890276, 18032474508164218953, 15787589356433898307, 109014581313611160},
+	{4392701245477512221, 7047281977047554516, 12630071485147118646, 87211665050888928},
+	{7028321992764019553, 207604719050356256, 16518765561493479511, 139538664081422285},
+	{16690704038436946612, 14923479034207926297, 13215012449194783608, 111630931265137828},
+	{2284516786523826320, 8249434412624430715, 17950707588839647533, 89304745012110262},
+	{3655226858438122112, 13199095060199089144, 13963736883175794760, 142887592019376420},
+	{6613530301492408013, 10559276048159271315, 11170989506540635808, 114310073615501136},
+	{8980173055935836733, 12136769653269327375, 5247442790490598323, 91448058892400909},
+	{18252184888974400356, 2330718093131641253, 7887303047134388982, 73158447113920727},
+	{14446100563391399277, 11107846578494446652, 16309033690156932694, 117053515382273163},
+	{15246229265455029745, 1507579633311736675, 1979180507899815186, 93642812305818531},
+	{4818285782880203150, 12274110150875120310, 16340739665287493441, 74914249844654824},
+	{7709257252608325039, 1191832167690640880, 15077137020234258537, 119862799751447719},
+	{2478056987344749708, 15710860993120153997, 15751058430929317152, 95890239801158175},
+	{5671794404617710090, 5189991165012302551, 12600846744743453722, 76712191840926540},
+	{5385522232646425820, 11993334678761594405, 1714610717879974339, 122739506945482465},
+	{7997766600859050980, 13284016557751185847, 1371688574303979471, 98191605556385972},
+	{6398213280687240784, 18005910875684769324, 12165397303668914546, 78553284445108777},
+	{13926490063841495577, 6673364512644168979, 4707240426902621982, 125685255112174044},
+	{11141192051073196462, 1649342795373424860, 7455141156264007909, 100548204089739235},
+	{12602302455600467493, 5008823051040650211, 5964112925011206327, 80438563271791388},
+	{12784986299476927342, 8014116881665040338, 5853231865276019800, 128701701234866221},
+	{13917337854323452197, 2721944690590121947, 993236677478905517, 102961360987892977},
+	{7444521468716851434, 5866904567214007881, 11862635786208855383, 82369088790314381},
+	{11911234349946962294, 16765744937026233256, 11601519628450347966, 131790542064503010},
+	{2150289850473749189, 9723247134879076282, 9281215702760278373, 105432433651602408},
+	{9098929509862819998, 4089248893161350702, 14803670191692043345, 84345946921281926},
+	{10868938401038601673, 17610844673283892093, 16307174677223448705, 134953515074051082},
+	{8695150720830881339, 6709978109143293028, 5667042112294938318, 107962812059240866},
+	{18024167020890436041, 9057331302056544745, 844284875094040331, 86370249647392693},
+	{17770620789198966695, 3423683639064740623, 16108251059118105823, 138192399435828308},
+	{17905845446101083679, 17496342170219433791, 1818554403068753688, 110553919548662647},
+	{10635327542138956620, 13997073736175547033, 12522889966680733920, 88443135638930117},
+	{17016524067422330592, 7637922718913233960, 5279228687721532980, 141509017022288188},
+	{2545172809712133504, 13489035804614407815, 11602080579661047030, 113207213617830550},
+	{2036138247769706804, 10791228643691526252, 9281664463728837624, 90565770894264440},
+	{16386305857183406736, 12322331729695131324, 7425331570983070099, 72452616715411552},
+	{7771345297783899161, 12337033138028389473, 15569879328314822482, 115924186744658483},
+	{13595773867710939975, 9869626510422711578, 1387857018426127016, 92739349395726787},
+	{7187270279426841657, 15274398837821989909, 12178332058966632582, 74191479516581429},
+	{11499632447082946651, 17060340511031363208, 8417284850120881162, 118706367226530287},
+	{1821008328182536674, 17337621223567000890, 17801874324322435899, 94965093781224229},
+	{8835504292029849986, 2802050534627869742, 17930848274199859043, 75972075024979383},
+	{10447458052505849654, 15551327299630322557, 6553264350268312529, 121555320039967014},
+	{8357966442004679723, 1373015395478527076, 8931960294956560347, 97244256031973611},
+	{10375721968345654102, 8477109945866642307, 3456219421223337954, 77795404825578889},
+	{16601155149353046563, 9874027098644717368, 12908648703441161373, 124472647720926222},
+	{2212877675256706281, 7899221678915773895, 2948221333269108452, 99578118176740978},
+	{1770302140205365025, 6319377343132619116, 9737274696099107408, 79662494541392782},
+	{13900529868554315009, 10111003749012190585, 822244254790930560, 127459991266228452},
+	{3741726265359631361, 710105369725931822, 11725841848058475418, 101967993012982761},
+	{17750776271255346382, 4257433110522655780, 5691324663704870011, 81574394410386209},
+	{9954497960299002594, 6811892976836249249, 16484817091411612664, 130519031056617934},
+	{584900738755381429, 12828212010952820046, 16877202487871200454, 104415224845294347},
+	{11535967035230036113, 6573220794020345713, 6123064360813139717, 83532179876235478},
+	{14768198441626147457, 10517153270432553141, 6107554162559113224, 133651487801976765},
+	{11814558753300917966, 12103071431087952836, 4886043330047290579, 106921190241581412},
+	{2072949373156913727, 5993108330128451946, 14976881108263563433, 85536952193265129},
+	{18074114256018703255, 13278322142947433436, 12894963328995970523, 136859123509224207},
+	{10769942590073052281, 10622657714357946749, 2937273033712955772, 109487298807379366},
+	{1237256442574621178, 15876823800970178046, 17107213685938005910, 87589839045903492},
+	{16737005567087035178, 10645522822584643580, 12614146638533168164, 140143742473445588},
+	{6010906824185807496, 1137720628583894218, 17470014940310355178, 112114993978756470},
+	{1119376644606735674, 8288874132350936021, 13976011952248284142, 89691995183005176},
+	{9169700260854597724, 9572849797019587310, 14982921494113433981, 143507192292808282},
+	{14714457838167498826, 15036977467099490494, 4607639565806926538, 114805753834246626},
+	{703519826308268091, 15718930788421502719, 18443506911613182523, 91844603067397300},
+	{11630862305272345443, 1507098186511471205, 14754805529290546019, 73475682453917840},
+	{7541333244210021738, 9790054727902174575, 5160944773155322014, 117561091926268545},
+	{9722415410109927714, 11521392597063649983, 4128755818524257611, 94048873541014836},
+	{7777932328087942171, 1838416448167099340, 18060399913787047382, 75239098832811868},
+	{12444691724940707474, 2941466317067358944, 6760546973607813872, 120382558132498990},
+	{6266404565210655656, 13421219497879618125, 5408437578886251097, 96306046505999192},
+	{8702472466910434848, 14426324413045604823, 15394796507334731847, 77044837204799353},
+	{10234607132314785433, 4635374987163416101, 2495581523284109017, 123271739527678966},
+	{11877034520593738670, 11086997619214553527, 16753860477594928506, 98617391622143172},
+	{9501627616474990936, 16248295724855463468, 6024390752592122158, 78893913297714538},
+	{4134557742134254528, 171831456575369287, 5949676389405485131, 126230261276343261},
+	{6996995008449313945, 11205511609486026399, 1070392296782477781, 100984209021074609},
+	{9286944821501361480, 8964409287588821119, 4545662652167892548, 80787367216859687},
+	{3791065270176447398, 14343054860142113791, 10962409058210538400, 129259787546975499},
+	{3032852216141157918, 15163792702855601356, 12459276061310341043, 103407830037580399},
+	{9804979402396746981, 4752336532800660438, 13656769663790183158, 82726264030064319},
+	{15687967043834795169, 11293087267222967024, 10782785017838562083, 132362022448102911},
+	{1482327190842105166, 12723818628520283943, 4936879199528939343, 105889617958482329},
+	{1185861752673684133, 2800357273332406508, 7638852174365061798, 84711694366785863},
+	{9276076433761715259, 15548618081557581382, 8532814664242188553, 135538710986857381},
+	{3731512332267461884, 16128243279987975429, 3136902916651840519, 108430968789485905},
+	{10363907495297790154, 16591943438732290666, 2509522333321472415, 86744775031588724},
+	{16582251992476464245, 15479063057745934096, 11393933362798176511, 138791640050541958},
+	{13265801593981171396, 16072599260938657600, 16493844319722361855, 111033312040433566},
+	{6923292460443026794, 9168730594009015757, 9505726641035979161, 88826649632346853},
+	{3698570307225022224, 3601922506188694242, 11519813810915656335, 142122639411754965},
+	{14026902690005748749, 2881538004950955393, 9215851048732525068, 113698111529403972},
+	{153475707778868029, 2305230403960764315, 18440727283211751024, 90958489223523177},
+	{14880175825190735716, 16601579582136252744, 7373884197085580172, 72766791378818542},
+	{1672188431853715207, 15494480887192273422, 15487563530078838599, 116426866206109667},
+	{8716448374966792812, 8706235895011908414, 5011353194579250233, 93141492964887734},
+	{3283809885231523926, 18033035160235257701, 7698431370405310509, 74513194371910187},
+	{8943444631112348605, 3027414553183040059, 16006839007390407139, 119221110995056299},
+	{18222802149115609854, 9800629272030252693, 16494820020654236034, 95376888796045039},
+	{10888892904550577560, 15219201047108022801, 16885204831265299150, 76301511036836031},
+	{2664833388313282803, 16972024045889015836, 1190886026831106378, 122082417658937651},
+	{2131866710650626242, 17266968051453122992, 15710104080432526395, 97665934127150120},
+	{12773539812746231964, 13813574441162498393, 12568083264346021116, 78132747301720096},
+	{1990919626684419526, 7344323846892356137, 12730235593469813140, 125012395682752154},
+	{16350130960315176913, 9564807892255795232, 13873537289517760835, 100009916546201723},
+	{13080104768252141531, 15030543943288456832, 30783387388477698, 80007933236961379},
+	{9860121184977695479, 9291475050293889639, 7427951049305384964, 128012693179138206},
+	{11577445762724066707, 7433180040235111711, 2253012024702397648, 102410154543310565},
+	{12951305424921163689, 13325241661671910015, 1802409619761918118, 81928123634648452},
+	{2275344606164310286, 2873642584965504409, 6573204206360979313, 131084997815437523},
+	{1820275684931448229, 17056309326940044820, 12637260994572604096, 104867998252350018},
+	{5145569362687068906, 17334396276293946179, 17488506425141903923, 83894398601880014},
+	{854213350815489603, 12977638783102672594, 16913563836001315308, 134231037763008023},
+	{683370680652391683, 6692762211740227752, 2462804624575321277, 107384830210406419},
+	{7925394174005733993, 1664860954650271878, 5659592514402167345, 85907864168325135},
+	{8991281863667264065, 2663777527440435005, 9055348023043467752, 137452582669320216},
+	{14571723120417631898, 9509719651436168650, 3554929603692863878, 109962066135456173},
+	{7968029681592195196, 3918426906407024597, 10222641312438111749, 87969652908364938},
+	{1680801046321781343, 9958831864993149679, 12666877285159068475, 140751444653383901},
+	{1344640837057425074, 4277716677252609420, 6444153013385344457, 112601155722707121},
+	{8454410299129760706, 10800870971285908182, 1465973595966365242, 90080924578165697},
+	{3074179424561898242, 12330045591770636869, 12240825320998823163, 72064739662532557},
+	{12297384708782857833, 1281328873123467374, 4827925254630475769, 115303583460052092},
+	{9837907767026286266, 15782458357466415192, 14930386647930111584, 92242866768041673},
+	{11559675028362939336, 5247269056489311507, 876262874118358298, 73794293414433339},
+	{7427433601154971968, 12084979305124808735, 8780718228073193923, 118070869463093342},
+	{5941946880923977575, 9667983444099846988, 18092621026684286108, 94456695570474673},
+	{8442906319481092383, 4045037940537967267, 3406050377121697917, 75565356456379739},
+	{9819301296427837490, 17540107149086478597, 12828378232878537313, 120904570330207582},
+	{476743407658449345, 14032085719269182878, 2884004956819009204, 96723656264166066},
+	{7760092355610580123, 11225668575415346302, 17064599224422848656, 77378925011332852},
+	{12416147768976928196, 14271720905922643760, 12545963500108916557, 123806280018132564},
+	{6243569400439632234, 7728027909996204685, 13726119614829043569, 99045024014506051},
+	{4994855520351705787, 6182422327996963748, 7291546877121324532, 79236019211604841},
+	{613071203078908613, 6202526910053231674, 4287777373910298605, 126777630738567746},
+	{490456962463126890, 1272672713300675016, 18187617158095880177, 101422104590854196},
+	{4081714384712411836, 8396835800124360659, 10860744911734793818, 81137683672683357},
+	{13909440645023679583, 13434937280198977054, 2619796599808028816, 129820293876293372},
+	{3748854886535123020, 18126647453643002290, 13163883724072154022, 103856235101034697},
+	{6688432723970008739, 18190666777656312155, 3152409349773902571, 83084988080827758},
+	{18080189987835834629, 18037020400024368478, 1354506144896333791, 132935980929324413},
+	{7085454360784847057, 18118965134761405106, 8462302545400887679, 106348784743459530},
+	{5668363488627877646, 18184520922551034408, 6769842036320710143, 85079027794767624},
+	{9069381581804604233, 14337838217114013760, 18210444887596956876, 136126444471628198},
+	{10944854080185593710, 15159619388433121331, 3500309465851834531, 108901155577302559},
+	{5066534449406564645, 12127695510746497065, 6489596387423377948, 87120924461842047},
+	{8106455119050503431, 957568743484843688, 14072703034619315041, 139393479138947275},
+	{10174512909982313068, 15523450253755516243, 11258162427695452032, 111514783311157820},
+	{8139610327985850455, 5040062573520592348, 9006529942156361626, 89211826648926256},
+	{13023376524777360727, 11753448932374858080, 7031750277966357955, 142738922638282010},
+	{10418701219821888582, 9402759145899886464, 5625400222373086364, 114191138110625608},
+	{4645612161115600542, 143509687236088525, 11879017807382289738, 91352910488500486},
+	{7405838543634390757, 3804156564530781143, 5813865431163921467, 73082328390800389},
+	{781295225589294241, 17154696947474980799, 16680882319346094993, 116931725425280622},
+	{4314384995213345716, 13723757557979984639, 5966008225993055348, 93545380340224498},
+	{3451507996170676573, 7289657231642077388, 12151504210278264925, 74836304272179598},
+	{16590459238098813486, 7974102755885413497, 15753057921703313557, 119738086835487357},
+	{9583018575737140466, 10068631019450241121, 5223748707878830199, 95790469468389886},
+	{3977066045847802050, 8054904815560192897, 489650151561153836, 76632375574711909},
+	{10052654488098393603, 12887847704896308635, 8162137871981666784, 122611800919539054},
+	{15420821219962535529, 17688975793400867554, 10219059112327243750, 98089440735631243},
+	{4957959346486207777, 3083134190494963074, 15553944919345615647, 78471552588504994},
+	{7932734954377932442, 16001061149017671888, 13818265426727254065, 125554484141607991},
+	{10035536778244256277, 9111500104472227187, 7365263526639892929, 100443587313286393},
+	{11717778237337315345, 18357246527803512719, 13270908450795734989, 80354869850629114},
+	{3991049920772063259, 7235501556034158412, 10165407077047445014, 128567791761006583},
+	{6882188751359560930, 16856447689053057699, 15511023291121776657, 102854233408805266},
+	{16573797445313379714, 2417111707016715189, 8719469818155511003, 82283386727044213},
+	{8071331838791855926, 14935425175452475273, 10261802894306907281, 131653418763270741},
+	{6457065471033484741, 4569642510878159572, 4520093500703615502, 105322735010616593},
+	{16233698821052518762, 3655714008702527657, 10994772430046713048, 84258188008493274},
+	{14905871669458299050, 9538491228665954575, 6523589443849009907, 134813100813589239},
+	{8235348520824728917, 3941444168190853337, 8908220369821118249, 107850480650871391},
+	{17656325260885514103, 3153155334552682669, 3437227481114984276, 86280384520697113},
+	{6114027528965360626, 12423746164768112918, 1810215155042064518, 138048615233115381},
+	{15959268467398019470, 13628345746556400657, 16205567383001292907, 110438892186492304},
+	{1699368329692684607, 7213327782503210203, 16653802721142944649, 88351113749193843},
+	{2718989327508295370, 15230673266747046648, 4509991465377249499, 141361781998710150},
+	{13243237906232367266, 15873887428139547641, 3607993172301799599, 113089425598968120},
+	{10594590324985893813, 16388458757253548436, 2886394537841439679, 90471540479174496},
+	{4786323445246804727, 13110767005802838749, 17066510889240793036, 72377232383339596},
+	{279419882911066917, 6219831950316900706, 1480975719591896596, 115803571813343355},
+	{11291582350554584503, 1286516745511610241, 1184780575673517277, 92642857450674684},
+	{1654568250959846956, 15786608655376929486, 4637173275280724144, 74114285960539747},
+	{6336658016277665453, 17879876219119266531, 11108826055191068954, 118582857536863595},
+	{5069326413022132362, 17993249790037323548, 8887060844152855163, 94866286029490876},
+	{15123507574643436860, 18083948646771769161, 3420299860580373807, 75893028823592701},
+	{13129565675203768006, 6798224946383368719, 16540526221154329062, 121428846117748321},
+	{3124954910679193758, 12817277586590515622, 9543072162181552926, 97143076894198657},
+	{2499963928543355007, 17632519698756233144, 255760100261421694, 77714461515358926},
+	{11378639915153188657, 9765287444300421414, 11477262604644005681, 124343138424574281},
+	{5413563117380640602, 433532325956516485, 5492461268973294222, 99474510739659425},
+	{15398896938130243451, 11414872304990944157, 4393969015178635377, 79579608591727540},
+	{13570188656782658552, 3506400429017869359, 7030350424285816604, 127327373746764064},
+	{3477453295942306196, 10183817972698116134, 9313629154170563606, 101861898997411251},
+	{17539357895721486249, 768356748674672260, 3761554508594540562, 81489519197929001},
+	{5926879744702916060, 15986766056847116910, 17086533657976995868, 130383230716686401},
+	{8430852610504243171, 16478761660219603851, 9979878111639686371, 104306584573349121},
+	{14123379717887215183, 5804311698691862434, 4294553674569838774, 83445267658679297},
+	{4150663474909992677, 1908201088423159249, 10560634694053652362, 133512428253886875},
+	{18077926038895635434, 12594607314964258368, 8448507755242921889, 106809942603109500},
+	{7083643201632687701, 13765034666713317018, 6758806204194337511, 85447954082487600},
+	{265782678386569352, 14645357837257486583, 10814089926710940018, 136716726531980160},
+	{14970021401676896775, 648239825580258296, 8651271941368752015, 109373381225584128},
+	{15665365936083427743, 7897289489948027283, 14299715182578822258, 87498704980467302},
+	{2928492609282022449, 12635663183916843654, 8122149033158474320, 139997927968747684},
+	{9721491716909438606, 13797879361875385246, 10187068041268689779, 111998342374998147},
+	{398495744043730238, 7348954674758397874, 770956803531131177, 89598673899998518},
+	{8016290819953789028, 11758327479613436598, 15990926144617451176, 143357878239997628},
+	{17481079100188762192, 13096010798432659601, 1724694471468229971, 114686302591998103},
+	{13984863280151009754, 14166157453488038004, 8758453206658404623, 91749042073598482},
+	{14877239438862718126, 11332925962790430403, 18074809009552454668, 73399233658878785},
+	{16424885472696528355, 14443332725722778322, 10472950341574375853, 117438773854206057},
+	{5761210748673402038, 11554666180578222658, 999662643775680036, 93951019083364846},
+	{4608968598938721630, 1865035314978757480, 15557125373988185322, 75160815266691876},
+	{3685000943560044285, 17741451762933653261, 17512702968897275868, 120257304426707002},
+	{17705396013815676721, 14193161410346922608, 6631464745634000048, 96205843541365602},
+	{3096270366826810407, 11354529128277538087, 16373218240732931008, 76964674833092481},
+	{16022079031148627621, 7099200161018329969, 371707481979317351, 123143479732947971},
+	{9128314410176991774, 16747406573040394945, 15054761244551095173, 98514783786358376},
+	{10992000342883503742, 17087274073174226279, 8354460180898965815, 78811827029086701},
+	{17587200548613605987, 1514196813885389784, 5988438659954524659, 126098923246538722},
+	{14069760438890884790, 15968752710075953120, 15858797372189350696, 100879138597230977},
+	{187761906886976862, 1706955723835031527, 5308340268267659911, 80703310877784782},
+	{300419051019162979, 17488524417103691736, 12182693243970166180, 129125297404455651},
+	{11308381685041061353, 10301470718941043065, 6056805780434222621, 103300237923564521},
+	{1668007718549028436, 862478945669013806, 1156095809605467774, 82640190338851617},
+	{6358161164420355821, 12448012757296153059, 5539102110110658761, 132224304542162587},
+	{16154575375762015627, 17337107835320743093, 15499328132314257978, 105779443633730069},
+	{12923660300609612501, 6490988638772773828, 16088811320593316706, 84623554906984055},
+	{9609810036749649032, 3006884192552617479, 7295354039239755114, 135397687851174489},
+	{309150399915898579, 9784204983525914630, 9525632046133714414, 108318150280939591},
+	{247320319932718864, 7827363986820731704, 3931156822165061208, 86654520224751673},
+	{395712511892350182, 5145084749429350080, 2600502100722187610, 138647232359602677},
+	{11384616453739611115, 15184114243769211033, 13148448124803481057, 110917785887682141},
+	{5418344348249778569, 1079244950789637857, 6829409685100874523, 88734228710145713},
+	{4980002142457735387, 12794838365489151541, 7237706681419488913, 141974765936233141},
+	{3984001713966188309, 13925219507133231556, 2100816530393680807, 113579812748986513},
+	{17944596630140591940, 11140175605706585244, 9059350853798765292, 90863850199189210},
+	{10666328489370563229, 1533442855081447549, 7247480683039012234, 72691080159351368},
+	{9687427953509080520, 6142857382872226402, 7906620278120509251, 116305728254962189},
+	{371244733323443770, 4914285906297781122, 10014645037238317724, 93044582603969751},
+	{11365042230884485986, 3931428725038224897, 4322367215048743856, 74435666083175801},
+	{7116021125189446607, 9979634774803070159, 17983833988303721139, 119097065733081281},
+	{9382165714893467609, 7983707819842456127, 10697718375901066588, 95277652586465025},
+	{7505732571914774087, 13765663885357785548, 8558174700720853270, 76222122069172020},
+	{8319823300321728216, 3578318142862905261, 13693079521153365233, 121955395310675232},
+	{2966509825515472250, 2862654514290324209, 3575765987438871540, 97564316248540186},
+	{2373207860412377800, 17047518870399900660, 17618008048918738524, 78051452998832148},
+	{7486481391401714803, 12518634933672199763, 6052719989818519700, 124882324798131438},
+	{2299836298379461519, 17393605576421580457, 12220873621338636406, 99905859838505150},
+	{9218566668187389862, 10225535646395354042, 9776698897070909125, 79924687870804120},
+	{18439055483841734102, 16360857034232566467, 15642718235313454600, 127879500593286592},
+	{18440593201815297605, 5709987997902232527, 5135476958766943034, 102303600474629274},
+	{14752474561452238084, 11946688027805606668, 7797730381755464750, 81842880379703419},
+	{8846564039355939641, 8046654400263239700, 1408322166583012631, 130948608607525471},
+	{18145297675710482683, 17505369964436322729, 15884052992234051397, 104758886886020376},
+	{7137540511084565500, 2936249527323327214, 9017893579045330795, 83807109508816301},
+	{11420064817735304799, 15766045687943054512, 7049932096988708625, 134091375214106082},
+	{12825400668930154163, 5234138920870622963, 16707992121816697870, 107273100171284865},
+	{17639018164627943977, 4187311136696498370, 13366393697453358296, 85818480137027892},
+	{6086336174953248423, 3010349003972487070, 6628834656957731981, 137309568219244628},
+	{8558417754704509062, 6097628017919899979, 12681765355050006231, 109847654575395702},
+	{17914780647989338219, 12256800043819740629, 2766714654556184338, 87878123660316562},
+	{17595602592557210181, 1164135996402033391, 8116092262031805265, 140604997856506499},
+	{14076482074045768145, 4620657611863537036, 10182222624367354535, 112483998285205199},
+	{11261185659236614516, 7385874904232739952, 11835126914235793951, 89987198628164159},
+	{3260501795810941932, 11817399846772383924, 7868156618551539352, 143979517805062655},
+	{17365796695616394839, 2075222247934086492, 6294525294841231482, 115183614244050124},
+	{2824590912267384901, 16417573057314910487, 8724969050614895508, 92146891395240099},
+	{5949021544555818244, 5755360816368107743, 10669324055233826730, 73717513116192079},
+	{13207783286031219514, 16587274935672793035, 6002872044148391798, 117948020985907327},
+	{10566226628824975611, 13269819948538234428, 15870344079544444408, 94358416788725861},
+	{1074283673576159843, 14305204773572497866, 9006926448893645203, 75486733430980689},
+	{16476249136689497041, 8130932378748355292, 3343035874004101356, 120778773489569103},
+	{9491650494609687310, 10194094717740594557, 10053126328687101731, 96623018791655282},
+	{7593320395687749848, 15533973403676296292, 663803433465860738, 77298415033324226},
+	{4770615003616579110, 13786311001656343098, 12130131937771108151, 123677464053318761},
+	{3816492002893263288, 3650351171841253832, 6014756735474976198, 98941971242655009},
+	{6742542417056520954, 13988327381698734035, 8501154203121891281, 79153576994124007},
+	{7098719052548523202, 245230922266512517, 17291195539736936374, 126645723190598411},
+	{16747021686264549532, 196184737813210013, 10143607617047638776, 101316578552478729},
+	{2329570904785908656, 156947790250568011, 11804234908380021344, 81053262841982983},
+	{37964632915543526, 3940465279142819141, 15197427038666123827, 129685220547172773},
+	{14787766965300076113, 3152372223314255312, 1089895186707168092, 103748176437738219},
+	{762167128014329921, 17279293037619045543, 4561264964107644796, 82998541150190575},
+	{8598165034306748520, 1821427156997100606, 7298023942572231675, 132797665840304920},
+	{3189183212703488493, 1457141725597680485, 5838419154057785340, 106238132672243936},
+	{17308741829130432087, 15923108639445785680, 981386508504317948, 84990506137795149},
+	{12936591667641050047, 10719578564145615796, 8948916043090729364, 135984809820472238},
+	{17727970963596660684, 1196965221832671990, 14537830463956404138, 108787847856377790},
+	{3114330326651597578, 8336269806949958239, 11630264371165123310, 87030278285102232},
+	{16050974966868287093, 17027380505861843505, 3851027734896556003, 139248445256163572},
+	{12840779973494629675, 13621904404689474804, 14148868632142975772, 111398756204930857},
+	{17651321608279524386, 14586872338493490166, 3940397276230559971, 89119004963944686},
+	{6106021684795777079, 8581600482621942974, 17372682086194626924, 142590407942311497},
+	{4884817347836621663, 3175931571355644056, 6519448039471880893, 114072326353849198},
+	{14975900322495028300, 17298140516052156537, 12594256061061325360, 91257861083079358},
+	{11980720257996022640, 2770465968615994260, 17454102478332880935, 73006288866463486},
+	{11790454783309815578, 15500791994011321786, 2101122262139237233, 116810062186341579},
+	{16811061456131673109, 5021935965725236782, 5370246624453300110, 93448049749073263},
+	{13448849164905338487, 11396246402064010072, 11674894929046460734, 74758439799258610},
+	{14139461034364720932, 7165947799076685146, 233087812764785559, 119613503678813777},
+	{3932871198007956100, 2043409424519437794, 11254516694437559417, 95690802943051021},
+	{14214343402632095849, 9013425169099370881, 5314264540808137210, 76552642354440817},
+	{606856555759891420, 18110829085300903734, 12192172080034929859, 122484227767105307},
+	{485485244607913136, 10799314453498812664, 2375040034544123241, 97987382213684246},
+	{15145783454653971802, 1260753933315229484, 16657427286602939886, 78389905770947396},
+	{16854555897962534236, 5706555108046277498, 826441955371331555, 125423849233515835},
+	{2415598274144296419, 4565244086437021999, 661153564297065244, 100339079386812668},
+	{16689873878283078428, 14720241713375348568, 7907620480921472841, 80271263509450134},
+	{4567705316801463546, 5105642667691006094, 1584146325248625577, 128434021615120215},
+	{18411559512408812129, 15152560578378535844, 1267317060198900461, 102747217292096172},
+	{7350549980443229057, 1054002018477097706, 12081900092384851339, 82197773833676937},
+	{15450228783451076814, 12754449673789087299, 4573644888848120849, 131516438133883100},
+	{1292136582535130482, 13892908553773180163, 3658915911078496679, 105213150507106480},
+	{12101755710253835355, 14803675657760454453, 2927132728862797343, 84170520405685184},
+	{916065062696584952, 8928485793449085833, 12062109995664296396, 134672832649096294},
+	{8111549679641088608, 7142788634759268666, 13339036811273347440, 107738266119277035},
+	{2799890928970960563, 5714230907807414933, 10671229449018677952, 86190612895421628},
+	{790476671611626578, 9142769452491863893, 13384618303687974400, 137904980632674605},
+	{8011078966773121909, 7314215561993491114, 10707694642950379520, 110323984506139684},
+	{13787560802902318174, 9540721264336703214, 12255504529102213939, 88259187604911747},
+	{3613353210934157462, 7886456393454904497, 4851411987595901010, 141214700167858796},
+	{10269380198231146616, 2619816300022013274, 191780775334810485, 112971760134287037},
+	{4526155343843006970, 13163899484243341589, 11221471064493579357, 90377408107429629},
+	{3620924275074405576, 6841770772652762948, 12666525666336773809, 72301926485943703},
+	{5793478840119048921, 14636182050986331040, 16577092251396927771, 115683082377509925},
+	{945434257353328814, 8019596826047154509, 13261673801117542217, 92546465902007940},
+	{15513742664850304344, 17483723905063454576, 10609339040894033773, 74037172721606352},
+	{17443290634276666303, 9527214174391975706, 2217547206462812745, 118459476354570164},
+	{13954632507421333043, 11311120154255490888, 5463386579912160519, 94767581083656131},
+	{95659561711335465, 9048896123404392711, 681360449187818092, 75814064866924905},
+	{14910450557705778036, 18167582612188938660, 1090176718700508947, 121302503787079848},
+	{11928360446164622429, 14534066089751150928, 8250839004444227804, 97042003029663878},
+	{9542688356931697943, 4248555242317100096, 13979368833039202890, 77633602423731102},
+	{11578952556348806386, 10487037202449270477, 7609594873895083331, 124213763877969764},
+	{1884464415595224462, 8389629761959416382, 9777024713857976988, 99371011102375811},
+	{16264966791443820863, 10401052624309443428, 4132270956344471267, 79496808881900649},
+	{14955900422084382410, 9262986569411288839, 13990331159634974674, 127194894211041038},
+	{8275371522925595605, 31691626045210425, 124218483482248770, 101755915368832831},
+	{2930948403598566161, 14782748559803809633, 14856770045753440308, 81404732295066264},
+	{4689517445757705857, 8895002436718454120, 12702785628979773524, 130247571672106023},
+	{14819660400831895656, 18184048393600494265, 17540926132667639465, 104198057337684818},
+	{787681876439785555, 3479192270654664443, 2964694461908380603, 83358445870147855},
+	{12328337446529387857, 1877358818305552785, 4743511139053408965, 133373513392236568},
+	{17241367586707330932, 8880584684128262874, 11173506540726547818, 106698810713789254},
+	{10103745254623954423, 18172514191528341269, 12628154047323148577, 85359048571031403},
+	{5097945963172596107, 10629278632735794415, 16515697660975127401, 136574477713650245},
+	{389007955796166562, 4814074091446725209, 13212558128780101921, 109259582170920196},
+	{15068601623604574543, 14919305717383111136, 6880697688282171213, 87407665736736157},
+	{16731064968283498621, 5424145074103426202, 14698465115993384265, 139852265178777851},
+	{2316805530401067928, 649967244540830639, 8069423278052797089, 111881812143022281},
+	{5542793239062764665, 519973795632664511, 2766189807700327348, 89505449714417825},
+	{16247166811984244111, 15589353331979904510, 4425903692320523756, 143208719543068520},
+	{9308384634845484966, 8782133850842013285, 3540722953856419005, 114566975634454816},
+	{3757358893134477649, 3336358265931700305, 17589973622052776497, 91653580507563852},
+	{6695235929249492443, 6358435427487270567, 6693281268158400551, 73322864406051082},
+	{10712377486799187908, 6484147869237722584, 14398598843795351205, 117316583049681731},
+	{8569901989439350327, 1497969480648267744, 7829530260294370641, 93853266439745385},
+	{6855921591551480261, 15955770843486255488, 6263624208235496512, 75082613151796308},
+	{7280125731740458095, 7082489275868457165, 6332449918434884097, 120132181042874093},
+	{5824100585392366476, 5665991420694765732, 12444657564231727924, 96105744834299274},
+	{4659280468313893181, 11911490766039633232, 13645074866127292662, 76884595867439419},
+	{3765499934560318766, 11679687596179592525, 10764073341577937290, 123015353387903071},
+	{17769795206615896306, 5654401262201763696, 4921909858520439509, 98412282710322457},
+	{6837138535808896398, 834172195019500634, 15005574331042082577, 78729826168257965},
+	{3560724027810413590, 5024024326773111338, 5562174855957780507, 125967721869212745},
+	{2848579222248330872, 15087265905644220040, 4449739884766224405, 100774177495370196},
+	{17036258636766305991, 8380463909773465708, 18317187166780620817, 80619341996296156},
+	{1432572115632717323, 9719393440895634811, 3482057763655621045, 128990947194073851},
+	{12214104136731904828, 4086165937974597525, 17543041469892138129, 103192757755259080},
+	{13460632124127434185, 6958281565121588343, 14034433175913710503, 82554206204207264},
+	{3090267324894343080, 14822599318936451673, 11387046637236205835, 132086729926731623},
+	{17229609118883115757, 790033010923430368, 16488334939272785315, 105669383941385298},
+	{10094338480364582283, 8010724038222564941, 2122621507192497282, 84535507153108239},
+	{1393546309615690359, 5438460831672283260, 10774892040991816298, 135256811444973182},
+	{1114837047692552287, 4350768665337826608, 1241216003309632392, 108205449155978546},
+	{15649264897121683123, 10859312561754081932, 15750368061615347206, 86564359324782836},
+	{13970777391168962027, 2617504839838889799, 17821891269100734884, 138502974919652538},
+	{7487273098193259298, 13162050316096842809, 3189466571054856937, 110802379935722031},
+	{17057864922780338408, 17908337882361294893, 17308968515811526842, 88641903948577624},
+	{16224537432222810483, 2827898908584699567, 16626303181072711979, 141827046317724199},
+	{12979629945778248387, 9641016756351580300, 16990391359600079906, 113461637054179359},
+	{10383703956622598710, 7712813405081264240, 17281661902421974248, 90769309643343487},
+	{8306963165298078968, 6170250724065011392, 6446631892453758752, 72615447714674790},
+}
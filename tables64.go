@@ -1,3 +1,6 @@
+//go:build !ryu_compact
+// +build !ryu_compact
+
 // Code generated by running "go generate". DO NOT EDIT.
 
 // Copyright 2018 Ulf Adams
@@ -19,36 +22,6 @@
 
 package ryu
 
-const pow5NumBits32 = 61
-
-var pow5Split32 = [...]uint64{
-	1152921504606846976, 1441151880758558720, 1801439850948198400, 2251799813685248000,
-	1407374883553280000, 1759218604441600000, 2199023255552000000, 1374389534720000000,
-	1717986918400000000, 2147483648000000000, 1342177280000000000, 1677721600000000000,
-	2097152000000000000, 1310720000000000000, 1638400000000000000, 2048000000000000000,
-	1280000000000000000, 1600000000000000000, 2000000000000000000, 1250000000000000000,
-	1562500000000000000, 1953125000000000000, 1220703125000000000, 1525878906250000000,
-	1907348632812500000, 1192092895507812500, 1490116119384765625, 1862645149230957031,
-	1164153218269348144, 1455191522836685180, 1818989403545856475, 2273736754432320594,
-	1421085471520200371, 1776356839400250464, 2220446049250313080, 1387778780781445675,
-	1734723475976807094, 2168404344971008868, 1355252715606880542, 1694065894508600678,
-	2117582368135750847, 1323488980084844279, 1654361225106055349, 2067951531382569187,
-	1292469707114105741, 1615587133892632177, 2019483917365790221,
-}
-
-const pow5InvNumBits32 = 59
-
-var pow5InvSplit32 = [...]uint64{
-	576460752303423489, 461168601842738791, 368934881474191033, 295147905179352826,
-	472236648286964522, 377789318629571618, 302231454903657294, 483570327845851670,
-	386856262276681336, 309485009821345069, 495176015714152110, 396140812571321688,
-	316912650057057351, 507060240091291761, 405648192073033409, 324518553658426727,
-	519229685853482763, 415383748682786211, 332306998946228969, 531691198313966350,
-	425352958651173080, 340282366920938464, 544451787073501542, 435561429658801234,
-	348449143727040987, 557518629963265579, 446014903970612463, 356811923176489971,
-	570899077082383953, 456719261665907162, 365375409332725730,
-}
-
 const pow5NumBits64 = 121
 
 var pow5Split64 = [...]uint128{
@@ -675,4 +648,54 @@ var pow5InvSplit64 = [...]uint128{
 	{10748990379256517301, 280889552322236860},
 	{8599192303405213841, 224711641857789488},
 	{14258051472207991719, 179769313486231590},
+	{4366138281823235134, 287630901577970545},
+	{3492910625458588108, 230104721262376436},
+	{17551723759334511779, 184083777009901148},
+	{2973332563241878454, 147267021607920919},
+	{12136029730670826172, 235627234572673470},
+	{9708823784536660938, 188501787658138776},
+	{4077710212887418427, 150801430126511021},
+	{17592382784845600453, 241282288202417633},
+	{3005859783650749393, 193025830561934107},
+	{13472734271146330484, 154420664449547285},
+	{3109630760124577158, 247073063119275657},
+	{13555751052325392696, 197658450495420525},
+	{10844600841860314157, 158126760396336420},
+	{17351361346976502651, 253002816634138272},
+	{6502391448097381474, 202402253307310618},
+	{12580610787961725826, 161921802645848494},
+	{9060930816513030351, 259074884233357591},
+	{3559395838468513958, 207259907386686073},
+	{10226214300258631813, 165807925909348858},
+	{12672594065671900577, 265292681454958173},
+	{17516772882021341108, 212234145163966538},
+	{2945371861391341917, 169787316131173231},
+	{15780641422451878037, 271659705809877169},
+	{16313861952703412753, 217327764647901735},
+	{13051089562162730202, 173862211718321388},
+	{17192394484718458000, 278179538749314221},
+	{10064566773032856077, 222543630999451377},
+	{672955788942464215, 178034904799561102},
+	{4766078077049853067, 284855847679297763},
+	{11191560091123703100, 227884678143438210},
+	{8953248072898962480, 182307742514750568},
+	{14541296087802990631, 145846194011800454},
+	{12198027296259054039, 233353910418880727},
+	{2379724207523422585, 186683128335104582},
+	{12971825810244469038, 149346502668083665},
+	{2308177222681598844, 238954404268933865},
+	{1846541778145279076, 191163523415147092},
+	{12545279866741954230, 152930818732117673},
+	{16383098972045216445, 244689309971388277},
+	{5727781548152352509, 195751447977110622},
+	{15650271682747612977, 156601158381688497},
+	{10283039433428539471, 250561853410701596},
+	{4537082732000921253, 200449482728561277},
+	{14697712629826467972, 160359586182849021},
+	{16137642578238528109, 256575337892558434},
+	{16599462877332732811, 205260270314046747},
+	{5900872672382365602, 164208216251237398},
+	{5752047461069874640, 262733146001979837},
+	{15669684413081630682, 210186516801583869},
+	{16225096345207214869, 168149213441267095},
 }
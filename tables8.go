@@ -0,0 +1,58 @@
+// Code generated by running "go generate". DO NOT EDIT.
+
+// Copyright 2018 Ulf Adams
+// Modifications copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+//
+// The code in this file is part of a Go translation of the C code written by
+// Ulf Adams which may be found at https://github.com/ulfjack/ryu. That source
+// code is licensed under Apache 2.0 and this code is derivative work thereof.
+
+package ryu
+
+const (
+	mantBitsE4M3 = 3
+	expBitsE4M3  = 4
+	biasE4M3     = 7
+)
+
+const pow5NumBitsE4M3 = 61
+
+var pow5SplitE4M3 = [...]uint64{
+	1152921504606846976, 1441151880758558720, 1801439850948198400, 2251799813685248000,
+	1407374883553280000, 1759218604441600000,
+}
+
+const pow5InvNumBitsE4M3 = 59
+
+var pow5InvSplitE4M3 = [...]uint64{
+	576460752303423489,
+}
+
+const (
+	mantBitsE5M2 = 2
+	expBitsE5M2  = 5
+	biasE5M2     = 15
+)
+
+const pow5NumBitsE5M2 = 61
+
+var pow5SplitE5M2 = [...]uint64{
+	1152921504606846976, 1441151880758558720, 1801439850948198400, 2251799813685248000,
+	1407374883553280000, 1759218604441600000, 2199023255552000000, 1374389534720000000,
+}
+
+const pow5InvNumBitsE5M2 = 59
+
+var pow5InvSplitE5M2 = [...]uint64{
+	576460752303423489, 461168601842738791, 368934881474191033, 295147905179352826,
+}
@@ -0,0 +1,56 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import "math"
+
+// AppendULP64 appends the size of one unit in the last place (ULP) of f, the
+// distance from |f| to the next float64 of larger magnitude, to b and returns
+// the extended buffer. The size is formatted as by FormatFloat64. For example,
+// the ULP of 1 is appended as "2.220446049250313e-16".
+//
+// The ULP is a power of two computed exactly from the exponent of f. The ULP
+// of ±0 and of subnormals is the smallest subnormal, 5e-324, the ULP of an
+// infinity is +Inf, and the ULP of a NaN is NaN.
+func AppendULP64(b []byte, f float64) []byte {
+	u := math.Float64bits(f) &^ (1 << (mantBits64 + expBits64))
+	exp := u >> mantBits64
+	switch {
+	case exp == 1<<expBits64-1:
+		// Clearing the sign keeps infinities and NaNs as they are.
+	case exp > mantBits64:
+		u = (exp - mantBits64) << mantBits64
+	case exp > 0:
+		u = 1 << (exp - 1)
+	default:
+		u = 1
+	}
+	return AppendFloat64Bits(b, u)
+}
+
+// AppendULP32 is like AppendULP64 for the 32-bit floating point number f.
+// The ULP of ±0 and of subnormals is 1e-45.
+func AppendULP32(b []byte, f float32) []byte {
+	u := math.Float32bits(f) &^ (1 << (mantBits32 + expBits32))
+	exp := u >> mantBits32
+	switch {
+	case exp == 1<<expBits32-1:
+	case exp > mantBits32:
+		u = (exp - mantBits32) << mantBits32
+	case exp > 0:
+		u = 1 << (exp - 1)
+	default:
+		u = 1
+	}
+	return AppendFloat32Bits(b, u)
+}
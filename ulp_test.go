@@ -0,0 +1,68 @@
+// Copyright 2019 Caleb Spare
+//
+// The contents of this file may be used under the terms of the Apache License,
+// Version 2.0.
+//
+//    (See accompanying file LICENSE or copy at
+//     http://www.apache.org/licenses/LICENSE-2.0)
+//
+// Unless required by applicable law or agreed to in writing, this software
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.
+
+package ryu
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestAppendULP64(t *testing.T) {
+	for _, tt := range []struct {
+		x    float64
+		want string
+	}{
+		{1, "2.220446049250313e-16"},
+		{-1.5, "2.220446049250313e-16"},
+		{0, "5e-324"},
+		{math.Copysign(0, -1), "5e-324"},
+		{5e-324, "5e-324"},
+		{math.SmallestNonzeroFloat64 * (1 << 52), "5e-324"},
+		{math.MaxFloat64, "1.99584030953472e+292"},
+		{math.Inf(-1), "+Inf"},
+		{math.NaN(), "NaN"},
+	} {
+		if got := string(AppendULP64(nil, tt.x)); got != tt.want {
+			t.Errorf("AppendULP64(%g): got %q; want %q", tt.x, got, tt.want)
+		}
+	}
+	if got, want := string(AppendULP32(nil, 1)), "1.1920929e-07"; got != want {
+		t.Errorf("AppendULP32(1): got %q; want %q", got, want)
+	}
+	if got, want := string(AppendULP32(nil, 0)), "1e-45"; got != want {
+		t.Errorf("AppendULP32(0): got %q; want %q", got, want)
+	}
+}
+
+func TestAppendULPRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1e4; i++ {
+		x := math.Abs(math.Float64frombits(r.Uint64()))
+		if x >= math.MaxFloat64 || math.IsNaN(x) {
+			continue
+		}
+		want := FormatFloat64(math.Nextafter(x, math.Inf(1)) - x)
+		if got := string(AppendULP64(nil, x)); got != want {
+			t.Fatalf("AppendULP64(%g): got %q; want %q", x, got, want)
+		}
+		y := math.Float32frombits(r.Uint32() &^ (1 << 31))
+		if y >= math.MaxFloat32 || y != y {
+			continue
+		}
+		want = FormatFloat32(math.Nextafter32(y, float32(math.Inf(1))) - y)
+		if got := string(AppendULP32(nil, y)); got != want {
+			t.Fatalf("AppendULP32(%g): got %q; want %q", y, got, want)
+		}
+	}
+}